@@ -155,6 +155,7 @@ require (
 	github.com/observiq/ctimefmt v1.0.0 // indirect
 	github.com/open-telemetry/opentelemetry-collector-contrib/internal/common v0.69.0 // indirect
 	github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal v0.69.0 // indirect
+	github.com/open-telemetry/opentelemetry-collector-contrib/internal/deltacumulative v0.69.0 // indirect
 	github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter v0.69.0 // indirect
 	github.com/open-telemetry/opentelemetry-collector-contrib/internal/sharedcomponent v0.69.0 // indirect
 	github.com/open-telemetry/opentelemetry-collector-contrib/internal/splunk v0.69.0 // indirect
@@ -390,6 +391,8 @@ replace github.com/open-telemetry/opentelemetry-collector-contrib/receiver/flink
 
 replace github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal => ../../internal/coreinternal
 
+replace github.com/open-telemetry/opentelemetry-collector-contrib/internal/deltacumulative => ../../internal/deltacumulative
+
 replace github.com/open-telemetry/opentelemetry-collector-contrib/pkg/batchpersignal => ../../pkg/batchpersignal
 
 replace github.com/open-telemetry/opentelemetry-collector-contrib/receiver/udplogreceiver => ../../receiver/udplogreceiver
@@ -0,0 +1,131 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logmetricsconnector // import "github.com/open-telemetry/opentelemetry-collector-contrib/connector/logmetricsconnector"
+
+import (
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/collector/component"
+)
+
+const (
+	metricTypeGauge     = "gauge"
+	metricTypeSum       = "sum"
+	metricTypeHistogram = "histogram"
+)
+
+// Config defines the configuration for the log metrics connector.
+type Config struct {
+	// Metrics are the metrics emitted from the log records.
+	Metrics []MetricConfig `mapstructure:"metrics"`
+}
+
+// MetricConfig defines a metric emitted from the log records.
+type MetricConfig struct {
+	// Name of the metric.
+	Name string `mapstructure:"name"`
+
+	// Description of the metric.
+	Description string `mapstructure:"description"`
+
+	// Unit of the metric.
+	Unit string `mapstructure:"unit"`
+
+	// Type of the metric, one of gauge, sum or histogram.
+	Type string `mapstructure:"type"`
+
+	// Value is the OTTL expression evaluated against the log records to get the
+	// value of the metric, e.g. `attributes["latency"]`. It is required for gauges
+	// and histograms; sums count the matching log records when it is empty.
+	Value string `mapstructure:"value"`
+
+	// Condition is an optional OTTL condition selecting the log records the metric
+	// is computed from. All the log records are used when it is empty.
+	Condition string `mapstructure:"condition"`
+
+	// Attributes are the attributes of the data points of the metric.
+	Attributes []AttributeConfig `mapstructure:"attributes"`
+
+	// Buckets are the explicit bucket boundaries of a histogram.
+	// The default boundaries are used when empty.
+	Buckets []float64 `mapstructure:"buckets"`
+}
+
+// AttributeConfig defines an attribute of the data points of a metric.
+type AttributeConfig struct {
+	// Key of the attribute.
+	Key string `mapstructure:"key"`
+
+	// Value is the OTTL expression evaluated against the log records to get the
+	// value of the attribute. Defaults to the log record attribute with the same key.
+	// The attribute is omitted when the expression evaluates to nil.
+	Value string `mapstructure:"value"`
+}
+
+var _ component.Config = (*Config)(nil)
+
+// Validate checks if the connector configuration is valid.
+func (cfg *Config) Validate() error {
+	if len(cfg.Metrics) == 0 {
+		return errors.New("at least one metric must be configured")
+	}
+	names := map[string]struct{}{}
+	for i, metric := range cfg.Metrics {
+		if metric.Name == "" {
+			return fmt.Errorf("metrics[%d]: name must not be empty", i)
+		}
+		if _, ok := names[metric.Name]; ok {
+			return fmt.Errorf("metrics[%d]: duplicate metric name %q", i, metric.Name)
+		}
+		names[metric.Name] = struct{}{}
+		if err := metric.validate(); err != nil {
+			return fmt.Errorf("metrics[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (cfg *MetricConfig) validate() error {
+	switch cfg.Type {
+	case metricTypeSum:
+	case metricTypeGauge, metricTypeHistogram:
+		if cfg.Value == "" {
+			return fmt.Errorf("value must be set for %s metrics", cfg.Type)
+		}
+	default:
+		return fmt.Errorf("unsupported metric type %q, must be one of %s, %s or %s",
+			cfg.Type, metricTypeGauge, metricTypeSum, metricTypeHistogram)
+	}
+	if len(cfg.Buckets) > 0 && cfg.Type != metricTypeHistogram {
+		return errors.New("buckets can only be set for histogram metrics")
+	}
+	for i := 1; i < len(cfg.Buckets); i++ {
+		if cfg.Buckets[i] <= cfg.Buckets[i-1] {
+			return errors.New("buckets must be sorted in increasing order")
+		}
+	}
+	keys := map[string]struct{}{}
+	for _, attr := range cfg.Attributes {
+		if attr.Key == "" {
+			return errors.New("attribute key must not be empty")
+		}
+		if _, ok := keys[attr.Key]; ok {
+			return fmt.Errorf("duplicate attribute key %q", attr.Key)
+		}
+		keys[attr.Key] = struct{}{}
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logmetricsconnector
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+)
+
+func TestLoadConfig(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		id           component.ID
+		expected     component.Config
+		errorMessage string
+	}{
+		{
+			id: component.NewIDWithName(typeStr, ""),
+			expected: &Config{
+				Metrics: []MetricConfig{
+					{
+						Name:        "http.server.duration",
+						Description: "Duration of the HTTP requests.",
+						Unit:        "ms",
+						Type:        metricTypeHistogram,
+						Value:       `attributes["duration_ms"]`,
+						Condition:   `attributes["http.route"] != nil`,
+						Attributes: []AttributeConfig{
+							{Key: "http.route"},
+							{Key: "http.method", Value: `attributes["method"]`},
+						},
+						Buckets: []float64{10, 100, 1000},
+					},
+					{
+						Name:      "log.errors",
+						Type:      metricTypeSum,
+						Condition: "severity_number >= SEVERITY_NUMBER_ERROR",
+					},
+				},
+			},
+		},
+		{
+			id:           component.NewIDWithName(typeStr, "no_metrics"),
+			errorMessage: "at least one metric must be configured",
+		},
+		{
+			id:           component.NewIDWithName(typeStr, "invalid_type"),
+			errorMessage: `metrics[0]: unsupported metric type "summary", must be one of gauge, sum or histogram`,
+		},
+		{
+			id:           component.NewIDWithName(typeStr, "missing_value"),
+			errorMessage: "metrics[0]: value must be set for gauge metrics",
+		},
+		{
+			id:           component.NewIDWithName(typeStr, "duplicate_name"),
+			errorMessage: `metrics[1]: duplicate metric name "log.count"`,
+		},
+		{
+			id:           component.NewIDWithName(typeStr, "invalid_buckets"),
+			errorMessage: "metrics[0]: buckets must be sorted in increasing order",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+			require.NoError(t, err)
+
+			factory := NewFactory()
+			cfg := factory.CreateDefaultConfig()
+
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, component.UnmarshalConfig(sub, cfg))
+
+			if tt.expected == nil {
+				assert.EqualError(t, component.ValidateConfig(cfg), tt.errorMessage)
+				return
+			}
+			assert.NoError(t, component.ValidateConfig(cfg))
+			assert.Equal(t, tt.expected, cfg)
+		})
+	}
+}
@@ -0,0 +1,409 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logmetricsconnector // import "github.com/open-telemetry/opentelemetry-collector-contrib/connector/logmetricsconnector"
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottllog"
+)
+
+const scopeName = "otelcol/logmetricsconnector"
+
+// staleStreamAge is the time after which the flush time of a stream without log records is forgotten.
+const staleStreamAge = time.Hour
+
+// metric holds the parsed OTTL statements of a configured metric.
+type metric struct {
+	config     MetricConfig
+	value      *ottl.Statement[ottllog.TransformContext]
+	attributes []attribute
+	bounds     []float64
+}
+
+type attribute struct {
+	key   string
+	value *ottl.Statement[ottllog.TransformContext]
+}
+
+// dataPoint aggregates the values extracted from the log records sharing the same attributes.
+type dataPoint struct {
+	attributes   pcommon.Map
+	start        pcommon.Timestamp
+	last         pcommon.Timestamp
+	lastValue    float64
+	count        uint64
+	sum          float64
+	bucketCounts []uint64
+}
+
+// logMetricsConnector extracts values from the log records and emits them as
+// metrics, once per batch of logs.
+type logMetricsConnector struct {
+	logger          *zap.Logger
+	metrics         []metric
+	metricsConsumer consumer.Metrics
+
+	// now is replaced in tests.
+	now func() time.Time
+
+	mu sync.Mutex
+	// lastFlush holds the time the sums and histograms of every stream were last emitted,
+	// the start time of their next data points so that the delta intervals don't overlap.
+	lastFlush map[string]pcommon.Timestamp
+	// defaultStart is the start time of the streams without a known flush time: the start
+	// of the connector, or the latest flush time of the stale streams removed.
+	defaultStart pcommon.Timestamp
+	lastCleanup  pcommon.Timestamp
+}
+
+func newConnector(settings component.TelemetrySettings, config *Config, metricsConsumer consumer.Metrics) (*logMetricsConnector, error) {
+	parser := ottllog.NewParser(functions[ottllog.TransformContext](), settings)
+
+	metrics := make([]metric, 0, len(config.Metrics))
+	for _, mc := range config.Metrics {
+		valueExpr := mc.Value
+		if valueExpr == "" {
+			valueExpr = "1"
+		}
+		value, err := parseValue(parser, valueExpr, mc.Condition)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse the value of metric %q: %w", mc.Name, err)
+		}
+		m := metric{config: mc, value: value}
+		for _, ac := range mc.Attributes {
+			attrExpr := ac.Value
+			if attrExpr == "" {
+				attrExpr = fmt.Sprintf("attributes[%q]", ac.Key)
+			}
+			attr, err := parseValue(parser, attrExpr, "")
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse attribute %q of metric %q: %w", ac.Key, mc.Name, err)
+			}
+			m.attributes = append(m.attributes, attribute{key: ac.Key, value: attr})
+		}
+		if mc.Type == metricTypeHistogram {
+			m.bounds = mc.Buckets
+			if len(m.bounds) == 0 {
+				m.bounds = defaultBuckets
+			}
+		}
+		metrics = append(metrics, m)
+	}
+
+	return &logMetricsConnector{
+		logger:          settings.Logger,
+		metrics:         metrics,
+		metricsConsumer: metricsConsumer,
+		now:             time.Now,
+		lastFlush:       map[string]pcommon.Timestamp{},
+	}, nil
+}
+
+// parseValue wraps the value expression in a statement, since the OTTL parser
+// only accepts statements, and appends the condition as its where clause.
+func parseValue(parser ottl.Parser[ottllog.TransformContext], expr, condition string) (*ottl.Statement[ottllog.TransformContext], error) {
+	statement := fmt.Sprintf("value(%s)", expr)
+	if condition != "" {
+		statement += " where " + condition
+	}
+	statements, err := parser.ParseStatements([]string{statement})
+	if err != nil {
+		return nil, err
+	}
+	return statements[0], nil
+}
+
+// Start implements the component.Component interface.
+func (c *logMetricsConnector) Start(context.Context, component.Host) error {
+	c.defaultStart = pcommon.NewTimestampFromTime(c.now())
+	c.lastCleanup = c.defaultStart
+	return nil
+}
+
+// Shutdown implements the component.Component interface.
+func (c *logMetricsConnector) Shutdown(context.Context) error {
+	return nil
+}
+
+// Capabilities implements the consumer interface.
+func (c *logMetricsConnector) Capabilities() consumer.Capabilities {
+	return consumer.Capabilities{MutatesData: false}
+}
+
+// ConsumeLogs implements the consumer.Logs interface.
+func (c *logMetricsConnector) ConsumeLogs(ctx context.Context, ld plog.Logs) error {
+	now := pcommon.NewTimestampFromTime(c.now())
+
+	md := pmetric.NewMetrics()
+	rls := ld.ResourceLogs()
+	for i := 0; i < rls.Len(); i++ {
+		rl := rls.At(i)
+		dataPoints := make([]map[string]*dataPoint, len(c.metrics))
+		keys := make([][]string, len(c.metrics))
+		for m := range c.metrics {
+			dataPoints[m] = map[string]*dataPoint{}
+		}
+
+		sls := rl.ScopeLogs()
+		for j := 0; j < sls.Len(); j++ {
+			sl := sls.At(j)
+			records := sl.LogRecords()
+			for k := 0; k < records.Len(); k++ {
+				record := records.At(k)
+				tCtx := ottllog.NewTransformContext(record, sl.Scope(), rl.Resource())
+				ts := record.Timestamp()
+				if ts == 0 {
+					ts = record.ObservedTimestamp()
+				}
+				for m := range c.metrics {
+					value, attrs, ok := c.evaluate(ctx, &c.metrics[m], tCtx)
+					if !ok {
+						continue
+					}
+					key := attributesKey(attrs)
+					dp, found := dataPoints[m][key]
+					if !found {
+						dp = &dataPoint{attributes: attrs, last: ts}
+						if c.metrics[m].config.Type == metricTypeHistogram {
+							dp.bucketCounts = make([]uint64, len(c.metrics[m].bounds)+1)
+						}
+						dataPoints[m][key] = dp
+						keys[m] = append(keys[m], key)
+					}
+					dp.record(value, ts, c.metrics[m].bounds)
+				}
+			}
+		}
+
+		c.setStartTimes(attributesKey(rl.Resource().Attributes()), dataPoints, keys, now)
+
+		var sm pmetric.ScopeMetrics
+		hasMetrics := false
+		for m := range c.metrics {
+			if len(keys[m]) == 0 {
+				continue
+			}
+			if !hasMetrics {
+				rm := md.ResourceMetrics().AppendEmpty()
+				rl.Resource().Attributes().CopyTo(rm.Resource().Attributes())
+				sm = rm.ScopeMetrics().AppendEmpty()
+				sm.Scope().SetName(scopeName)
+				hasMetrics = true
+			}
+			c.metrics[m].appendTo(sm.Metrics(), dataPoints[m], keys[m], now)
+		}
+	}
+
+	if md.ResourceMetrics().Len() == 0 {
+		return nil
+	}
+	return c.metricsConsumer.ConsumeMetrics(ctx, md)
+}
+
+// setStartTimes sets the start time of the sums and histograms of a resource to the
+// previous flush of their stream, and records now as the flush of the streams.
+func (c *logMetricsConnector) setStartTimes(resourceKey string, dataPoints []map[string]*dataPoint, keys [][]string, now pcommon.Timestamp) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.removeStaleStreamsLocked(now)
+
+	for m := range c.metrics {
+		if c.metrics[m].config.Type == metricTypeGauge {
+			continue
+		}
+		for _, key := range keys[m] {
+			stream := c.metrics[m].config.Name + "\x00" + resourceKey + "\x00" + key
+			start, ok := c.lastFlush[stream]
+			if !ok {
+				start = c.defaultStart
+			}
+			dataPoints[m][key].start = start
+			c.lastFlush[stream] = now
+		}
+	}
+}
+
+// removeStaleStreamsLocked forgets the streams not flushed recently, so the memory doesn't grow
+// with every stream ever seen. The streams seen again start at the cutoff of the removal, which
+// isn't before their last flush.
+func (c *logMetricsConnector) removeStaleStreamsLocked(now pcommon.Timestamp) {
+	if now.AsTime().Sub(c.lastCleanup.AsTime()) < staleStreamAge {
+		return
+	}
+	c.lastCleanup = now
+
+	cutoff := pcommon.NewTimestampFromTime(now.AsTime().Add(-staleStreamAge))
+	for stream, flush := range c.lastFlush {
+		if flush <= cutoff {
+			delete(c.lastFlush, stream)
+		}
+	}
+	if cutoff > c.defaultStart {
+		c.defaultStart = cutoff
+	}
+}
+
+// evaluate returns the value and the attributes of the metric for the log record,
+// or false if the log record doesn't match the condition or has no numeric value.
+func (c *logMetricsConnector) evaluate(ctx context.Context, m *metric, tCtx ottllog.TransformContext) (float64, pcommon.Map, bool) {
+	result, matched, err := m.value.Execute(ctx, tCtx)
+	if err != nil {
+		c.logger.Debug("Failed to evaluate metric value", zap.String("metric", m.config.Name), zap.Error(err))
+		return 0, pcommon.Map{}, false
+	}
+	if !matched {
+		return 0, pcommon.Map{}, false
+	}
+	value, ok := toFloat(result)
+	if !ok {
+		c.logger.Debug("Metric value is not numeric", zap.String("metric", m.config.Name), zap.Any("value", result))
+		return 0, pcommon.Map{}, false
+	}
+
+	attrs := pcommon.NewMap()
+	for _, attr := range m.attributes {
+		result, _, err := attr.value.Execute(ctx, tCtx)
+		if err != nil {
+			c.logger.Debug("Failed to evaluate metric attribute",
+				zap.String("metric", m.config.Name), zap.String("attribute", attr.key), zap.Error(err))
+			continue
+		}
+		putAttribute(attrs, attr.key, result)
+	}
+	return value, attrs, true
+}
+
+func (dp *dataPoint) record(value float64, ts pcommon.Timestamp, bounds []float64) {
+	dp.count++
+	dp.sum += value
+	if ts >= dp.last {
+		dp.last = ts
+		dp.lastValue = value
+	}
+	if dp.bucketCounts != nil {
+		bucket := len(bounds)
+		for i, bound := range bounds {
+			if value <= bound {
+				bucket = i
+				break
+			}
+		}
+		dp.bucketCounts[bucket]++
+	}
+}
+
+// appendTo appends the metric with the given data points, in the order they were first seen.
+func (m *metric) appendTo(metrics pmetric.MetricSlice, dataPoints map[string]*dataPoint, keys []string, now pcommon.Timestamp) {
+	out := metrics.AppendEmpty()
+	out.SetName(m.config.Name)
+	out.SetDescription(m.config.Description)
+	out.SetUnit(m.config.Unit)
+
+	switch m.config.Type {
+	case metricTypeGauge:
+		gauge := out.SetEmptyGauge()
+		for _, key := range keys {
+			dp := dataPoints[key]
+			ndp := gauge.DataPoints().AppendEmpty()
+			dp.attributes.CopyTo(ndp.Attributes())
+			ndp.SetTimestamp(dp.last)
+			if dp.last == 0 {
+				ndp.SetTimestamp(now)
+			}
+			ndp.SetDoubleValue(dp.lastValue)
+		}
+	case metricTypeSum:
+		sum := out.SetEmptySum()
+		sum.SetIsMonotonic(m.config.Value == "")
+		sum.SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+		for _, key := range keys {
+			dp := dataPoints[key]
+			ndp := sum.DataPoints().AppendEmpty()
+			dp.attributes.CopyTo(ndp.Attributes())
+			ndp.SetStartTimestamp(dp.start)
+			ndp.SetTimestamp(now)
+			ndp.SetDoubleValue(dp.sum)
+		}
+	case metricTypeHistogram:
+		histogram := out.SetEmptyHistogram()
+		histogram.SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+		for _, key := range keys {
+			dp := dataPoints[key]
+			hdp := histogram.DataPoints().AppendEmpty()
+			dp.attributes.CopyTo(hdp.Attributes())
+			hdp.SetStartTimestamp(dp.start)
+			hdp.SetTimestamp(now)
+			hdp.SetCount(dp.count)
+			hdp.SetSum(dp.sum)
+			hdp.ExplicitBounds().FromRaw(m.bounds)
+			hdp.BucketCounts().FromRaw(dp.bucketCounts)
+		}
+	}
+}
+
+// toFloat converts the result of a value expression to a float.
+func toFloat(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case int64:
+		return float64(v), true
+	case float64:
+		return v, true
+	case string:
+		f, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+		return f, err == nil
+	default:
+		return 0, false
+	}
+}
+
+// putAttribute sets the result of an attribute expression, nil and unsupported results are omitted.
+func putAttribute(attrs pcommon.Map, key string, value interface{}) {
+	switch v := value.(type) {
+	case string:
+		attrs.PutStr(key, v)
+	case int64:
+		attrs.PutInt(key, v)
+	case float64:
+		attrs.PutDouble(key, v)
+	case bool:
+		attrs.PutBool(key, v)
+	}
+}
+
+// attributesKey identifies the attribute set of a data point. The attributes are
+// always inserted in the configured order, so the key doesn't need sorting.
+func attributesKey(attrs pcommon.Map) string {
+	var b strings.Builder
+	attrs.Range(func(k string, v pcommon.Value) bool {
+		fmt.Fprintf(&b, "%s=%s:%s;", k, v.Type(), v.AsString())
+		return true
+	})
+	return b.String()
+}
@@ -0,0 +1,244 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logmetricsconnector
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+func newTestConnector(t *testing.T, sink *consumertest.MetricsSink, metrics ...MetricConfig) *logMetricsConnector {
+	cfg := &Config{Metrics: metrics}
+	require.NoError(t, cfg.Validate())
+	c, err := newConnector(componenttest.NewNopTelemetrySettings(), cfg, sink)
+	require.NoError(t, err)
+	c.now = func() time.Time { return time.Unix(900, 0) }
+	require.NoError(t, c.Start(context.Background(), componenttest.NewNopHost()))
+	c.now = func() time.Time { return time.Unix(1000, 0) }
+	return c
+}
+
+func timestamp(sec int64) pcommon.Timestamp {
+	return pcommon.NewTimestampFromTime(time.Unix(sec, 0))
+}
+
+func accessLogs() plog.Logs {
+	ld := plog.NewLogs()
+	rl := ld.ResourceLogs().AppendEmpty()
+	rl.Resource().Attributes().PutStr("service.name", "frontend")
+	records := rl.ScopeLogs().AppendEmpty().LogRecords()
+
+	record := records.AppendEmpty()
+	record.SetTimestamp(timestamp(100))
+	record.Attributes().PutStr("http.route", "/users")
+	record.Attributes().PutInt("duration_ms", 5)
+
+	record = records.AppendEmpty()
+	record.SetTimestamp(timestamp(101))
+	record.Attributes().PutStr("http.route", "/users")
+	record.Attributes().PutStr("duration_ms", "150")
+	record.SetSeverityNumber(plog.SeverityNumberError)
+
+	record = records.AppendEmpty()
+	record.SetTimestamp(timestamp(102))
+	record.Attributes().PutStr("http.route", "/orders")
+	record.Attributes().PutDouble("duration_ms", 42.5)
+
+	// Not numeric, ignored by the metrics with a value.
+	record = records.AppendEmpty()
+	record.SetTimestamp(timestamp(103))
+	record.Attributes().PutStr("http.route", "/orders")
+	record.Attributes().PutStr("duration_ms", "unknown")
+
+	// No route, ignored by the metrics with a condition on the route.
+	record = records.AppendEmpty()
+	record.SetTimestamp(timestamp(104))
+	record.Attributes().PutInt("duration_ms", 1)
+	record.SetSeverityNumber(plog.SeverityNumberFatal)
+	return ld
+}
+
+func TestConsumeLogsHistogram(t *testing.T) {
+	sink := &consumertest.MetricsSink{}
+	c := newTestConnector(t, sink, MetricConfig{
+		Name:       "http.server.duration",
+		Unit:       "ms",
+		Type:       metricTypeHistogram,
+		Value:      `attributes["duration_ms"]`,
+		Condition:  `attributes["http.route"] != nil`,
+		Attributes: []AttributeConfig{{Key: "http.route"}},
+		Buckets:    []float64{10, 100},
+	})
+
+	require.NoError(t, c.ConsumeLogs(context.Background(), accessLogs()))
+	require.Len(t, sink.AllMetrics(), 1)
+
+	rm := sink.AllMetrics()[0].ResourceMetrics().At(0)
+	serviceName, _ := rm.Resource().Attributes().Get("service.name")
+	assert.Equal(t, "frontend", serviceName.Str())
+	sm := rm.ScopeMetrics().At(0)
+	assert.Equal(t, scopeName, sm.Scope().Name())
+	require.Equal(t, 1, sm.Metrics().Len())
+
+	metric := sm.Metrics().At(0)
+	assert.Equal(t, "http.server.duration", metric.Name())
+	assert.Equal(t, "ms", metric.Unit())
+	require.Equal(t, pmetric.MetricTypeHistogram, metric.Type())
+	assert.Equal(t, pmetric.AggregationTemporalityDelta, metric.Histogram().AggregationTemporality())
+	dps := metric.Histogram().DataPoints()
+	require.Equal(t, 2, dps.Len())
+
+	users := dps.At(0)
+	route, _ := users.Attributes().Get("http.route")
+	assert.Equal(t, "/users", route.Str())
+	assert.Equal(t, uint64(2), users.Count())
+	assert.Equal(t, 155.0, users.Sum())
+	assert.Equal(t, []float64{10, 100}, users.ExplicitBounds().AsRaw())
+	assert.Equal(t, []uint64{1, 0, 1}, users.BucketCounts().AsRaw())
+	assert.Equal(t, timestamp(900), users.StartTimestamp())
+	assert.Equal(t, timestamp(1000), users.Timestamp())
+
+	orders := dps.At(1)
+	route, _ = orders.Attributes().Get("http.route")
+	assert.Equal(t, "/orders", route.Str())
+	assert.Equal(t, uint64(1), orders.Count())
+	assert.Equal(t, 42.5, orders.Sum())
+	assert.Equal(t, []uint64{0, 1, 0}, orders.BucketCounts().AsRaw())
+}
+
+func TestConsumeLogsSum(t *testing.T) {
+	sink := &consumertest.MetricsSink{}
+	c := newTestConnector(t, sink,
+		MetricConfig{
+			Name:      "log.errors",
+			Type:      metricTypeSum,
+			Condition: "severity_number >= SEVERITY_NUMBER_ERROR",
+		},
+		MetricConfig{
+			Name:       "http.server.total_duration",
+			Type:       metricTypeSum,
+			Value:      `attributes["duration_ms"]`,
+			Attributes: []AttributeConfig{{Key: "route", Value: `attributes["http.route"]`}},
+		},
+	)
+
+	require.NoError(t, c.ConsumeLogs(context.Background(), accessLogs()))
+	require.Len(t, sink.AllMetrics(), 1)
+	metrics := sink.AllMetrics()[0].ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	require.Equal(t, 2, metrics.Len())
+
+	errors := metrics.At(0).Sum()
+	assert.True(t, errors.IsMonotonic())
+	require.Equal(t, 1, errors.DataPoints().Len())
+	assert.Equal(t, 2.0, errors.DataPoints().At(0).DoubleValue())
+	assert.Equal(t, 0, errors.DataPoints().At(0).Attributes().Len())
+
+	total := metrics.At(1).Sum()
+	assert.False(t, total.IsMonotonic())
+	require.Equal(t, 3, total.DataPoints().Len())
+	assert.Equal(t, 155.0, total.DataPoints().At(0).DoubleValue())
+	assert.Equal(t, 42.5, total.DataPoints().At(1).DoubleValue())
+	// The attribute is omitted when the log record doesn't have a route.
+	assert.Equal(t, 1.0, total.DataPoints().At(2).DoubleValue())
+	assert.Equal(t, 0, total.DataPoints().At(2).Attributes().Len())
+}
+
+func TestConsumeLogsStartTimes(t *testing.T) {
+	sink := &consumertest.MetricsSink{}
+	c := newTestConnector(t, sink, MetricConfig{
+		Name:       "log.count",
+		Type:       metricTypeSum,
+		Attributes: []AttributeConfig{{Key: "http.route"}},
+	})
+	now := time.Unix(1000, 0)
+	c.now = func() time.Time { return now }
+
+	logs := func(routes ...string) plog.Logs {
+		ld := plog.NewLogs()
+		records := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords()
+		for _, route := range routes {
+			records.AppendEmpty().Attributes().PutStr("http.route", route)
+		}
+		return ld
+	}
+	startTimes := func() map[string]pcommon.Timestamp {
+		metrics := sink.AllMetrics()
+		dps := metrics[len(metrics)-1].ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Sum().DataPoints()
+		result := map[string]pcommon.Timestamp{}
+		for i := 0; i < dps.Len(); i++ {
+			route, _ := dps.At(i).Attributes().Get("http.route")
+			assert.Equal(t, pcommon.NewTimestampFromTime(now), dps.At(i).Timestamp())
+			result[route.Str()] = dps.At(i).StartTimestamp()
+		}
+		return result
+	}
+
+	// The first data points of a stream start with the connector.
+	require.NoError(t, c.ConsumeLogs(context.Background(), logs("/users")))
+	assert.Equal(t, map[string]pcommon.Timestamp{"/users": timestamp(900)}, startTimes())
+
+	// The next ones start at the previous flush of their stream.
+	now = time.Unix(1060, 0)
+	require.NoError(t, c.ConsumeLogs(context.Background(), logs("/users", "/orders")))
+	assert.Equal(t, map[string]pcommon.Timestamp{"/users": timestamp(1000), "/orders": timestamp(900)}, startTimes())
+
+	now = time.Unix(1120, 0)
+	require.NoError(t, c.ConsumeLogs(context.Background(), logs("/users")))
+	assert.Equal(t, map[string]pcommon.Timestamp{"/users": timestamp(1060)}, startTimes())
+	assert.Len(t, c.lastFlush, 2)
+
+	// The stale streams are forgotten, and start after their last flush when seen again.
+	now = time.Unix(1120, 0).Add(staleStreamAge)
+	require.NoError(t, c.ConsumeLogs(context.Background(), logs("/orders")))
+	assert.Equal(t, map[string]pcommon.Timestamp{"/orders": timestamp(1120)}, startTimes())
+	assert.Len(t, c.lastFlush, 1)
+}
+
+func TestConsumeLogsGauge(t *testing.T) {
+	sink := &consumertest.MetricsSink{}
+	c := newTestConnector(t, sink, MetricConfig{
+		Name:  "http.server.last_duration",
+		Type:  metricTypeGauge,
+		Value: `attributes["duration_ms"]`,
+	})
+
+	require.NoError(t, c.ConsumeLogs(context.Background(), accessLogs()))
+	require.Len(t, sink.AllMetrics(), 1)
+	dps := sink.AllMetrics()[0].ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Gauge().DataPoints()
+	require.Equal(t, 1, dps.Len())
+	assert.Equal(t, 1.0, dps.At(0).DoubleValue())
+	assert.Equal(t, timestamp(104), dps.At(0).Timestamp())
+}
+
+func TestConsumeLogsNoMatch(t *testing.T) {
+	sink := &consumertest.MetricsSink{}
+	c := newTestConnector(t, sink, MetricConfig{
+		Name:      "log.count",
+		Type:      metricTypeSum,
+		Condition: `attributes["http.route"] == "/unknown"`,
+	})
+
+	require.NoError(t, c.ConsumeLogs(context.Background(), accessLogs()))
+	assert.Empty(t, sink.AllMetrics())
+}
@@ -0,0 +1,57 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logmetricsconnector
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/connector/connectortest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+)
+
+func TestType(t *testing.T) {
+	factory := NewFactory()
+	assert.Equal(t, component.Type("logmetrics"), factory.Type())
+}
+
+func TestCreateDefaultConfig(t *testing.T) {
+	cfg := NewFactory().CreateDefaultConfig()
+	assert.NoError(t, componenttest.CheckConfigStruct(cfg))
+	// At least one metric must be configured.
+	assert.Error(t, component.ValidateConfig(cfg))
+}
+
+func TestCreateConnector(t *testing.T) {
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig().(*Config)
+	cfg.Metrics = []MetricConfig{{Name: "log.count", Type: metricTypeSum}}
+
+	lc, err := factory.CreateLogsToMetrics(context.Background(), connectortest.NewNopCreateSettings(), cfg, consumertest.NewNop())
+	require.NoError(t, err)
+	require.NoError(t, lc.Start(context.Background(), componenttest.NewNopHost()))
+	assert.NoError(t, lc.Shutdown(context.Background()))
+
+	cfg.Metrics[0].Condition = "attributes[ == 1"
+	_, err = factory.CreateLogsToMetrics(context.Background(), connectortest.NewNopCreateSettings(), cfg, consumertest.NewNop())
+	assert.Error(t, err)
+
+	_, err = factory.CreateTracesToMetrics(context.Background(), connectortest.NewNopCreateSettings(), cfg, consumertest.NewNop())
+	assert.Error(t, err)
+}
@@ -0,0 +1,41 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logmetricsconnector // import "github.com/open-telemetry/opentelemetry-collector-contrib/connector/logmetricsconnector"
+
+import (
+	"context"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+)
+
+// functions returns the functions available to the value expressions and conditions.
+func functions[K any]() map[string]interface{} {
+	return map[string]interface{}{
+		"Concat":      ottlfuncs.Concat[K],
+		"ConvertCase": ottlfuncs.ConvertCase[K],
+		"Int":         ottlfuncs.Int[K],
+		"IsMatch":     ottlfuncs.IsMatch[K],
+		"Split":       ottlfuncs.Split[K],
+		"Substring":   ottlfuncs.Substring[K],
+		// value returns the result of its argument, it wraps the value expressions
+		// since the parsing of standalone expressions is not implemented yet.
+		"value": func(getter ottl.Getter[K]) (ottl.ExprFunc[K], error) {
+			return func(ctx context.Context, tCtx K) (interface{}, error) {
+				return getter.Get(ctx, tCtx)
+			}, nil
+		},
+	}
+}
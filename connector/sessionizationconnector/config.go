@@ -0,0 +1,67 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sessionizationconnector // import "github.com/open-telemetry/opentelemetry-collector-contrib/connector/sessionizationconnector"
+
+import (
+	"errors"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+)
+
+// Config defines the configuration for the sessionization connector.
+type Config struct {
+	// SessionAttribute is the attribute holding the session identifier. It is looked up in
+	// the span or log record attributes first, then in the resource attributes.
+	SessionAttribute string `mapstructure:"session_attribute"`
+
+	// InactivityTimeout is the duration without telemetry after which a session is ended.
+	InactivityTimeout time.Duration `mapstructure:"inactivity_timeout"`
+
+	// FlushInterval is the time between emissions of the metrics of the ended sessions.
+	FlushInterval time.Duration `mapstructure:"flush_interval"`
+
+	// MaxSessions is the maximum number of sessions in progress. When it is reached, the least
+	// recently seen session is ended to track a new one. There is no limit when 0.
+	MaxSessions int `mapstructure:"max_sessions"`
+
+	// DurationBuckets are the explicit bucket boundaries of the session duration histogram.
+	// The default boundaries are used when empty.
+	DurationBuckets []time.Duration `mapstructure:"duration_buckets"`
+}
+
+var _ component.Config = (*Config)(nil)
+
+// Validate checks if the connector configuration is valid.
+func (cfg *Config) Validate() error {
+	if cfg.SessionAttribute == "" {
+		return errors.New("session_attribute must not be empty")
+	}
+	if cfg.InactivityTimeout <= 0 {
+		return errors.New("inactivity_timeout must be positive")
+	}
+	if cfg.FlushInterval <= 0 {
+		return errors.New("flush_interval must be positive")
+	}
+	if cfg.MaxSessions < 0 {
+		return errors.New("max_sessions must not be negative")
+	}
+	for i := 1; i < len(cfg.DurationBuckets); i++ {
+		if cfg.DurationBuckets[i] <= cfg.DurationBuckets[i-1] {
+			return errors.New("duration_buckets must be sorted in increasing order")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,325 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sessionizationconnector // import "github.com/open-telemetry/opentelemetry-collector-contrib/connector/sessionizationconnector"
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap"
+)
+
+const scopeName = "otelcol/sessionizationconnector"
+
+// session holds the state of a session until it is ended.
+type session struct {
+	id       string
+	start    pcommon.Timestamp
+	end      pcommon.Timestamp
+	errors   int64
+	lastSeen time.Time
+}
+
+// sessionConnector groups spans or log records by session and emits the
+// metrics of the sessions ended after InactivityTimeout.
+type sessionConnector struct {
+	logger          *zap.Logger
+	config          *Config
+	metricsConsumer consumer.Metrics
+	durationBounds  []float64
+
+	// now is replaced in tests.
+	now func() time.Time
+
+	mu sync.Mutex
+	// sessions indexes the elements of lru by session identifier.
+	sessions map[string]*list.Element
+	// lru holds the sessions in progress, from the least to the most recently seen.
+	lru *list.List
+	// evicted holds the sessions ended because MaxSessions was reached, until the next flush.
+	evicted   []*session
+	lastFlush time.Time
+
+	done chan struct{}
+	wg   sync.WaitGroup
+}
+
+func newConnector(logger *zap.Logger, config *Config, metricsConsumer consumer.Metrics) *sessionConnector {
+	buckets := config.DurationBuckets
+	if len(buckets) == 0 {
+		buckets = defaultDurationBuckets
+	}
+	bounds := make([]float64, len(buckets))
+	for i, b := range buckets {
+		bounds[i] = b.Seconds()
+	}
+	return &sessionConnector{
+		logger:          logger,
+		config:          config,
+		metricsConsumer: metricsConsumer,
+		durationBounds:  bounds,
+		now:             time.Now,
+		sessions:        map[string]*list.Element{},
+		lru:             list.New(),
+		done:            make(chan struct{}),
+	}
+}
+
+// Start implements the component.Component interface.
+func (c *sessionConnector) Start(context.Context, component.Host) error {
+	c.mu.Lock()
+	c.lastFlush = c.now()
+	c.mu.Unlock()
+
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+		ticker := time.NewTicker(c.config.FlushInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				if err := c.flush(context.Background(), false); err != nil {
+					c.logger.Error("Failed to export session metrics", zap.Error(err))
+				}
+			case <-c.done:
+				return
+			}
+		}
+	}()
+	return nil
+}
+
+// Shutdown implements the component.Component interface. The sessions in
+// progress are ended and their metrics emitted.
+func (c *sessionConnector) Shutdown(ctx context.Context) error {
+	select {
+	case <-c.done:
+		return nil
+	default:
+	}
+	close(c.done)
+	c.wg.Wait()
+	return c.flush(ctx, true)
+}
+
+// Capabilities implements the consumer interface.
+func (c *sessionConnector) Capabilities() consumer.Capabilities {
+	return consumer.Capabilities{MutatesData: false}
+}
+
+// ConsumeTraces implements the consumer.Traces interface.
+func (c *sessionConnector) ConsumeTraces(_ context.Context, td ptrace.Traces) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	rss := td.ResourceSpans()
+	for i := 0; i < rss.Len(); i++ {
+		rs := rss.At(i)
+		ilss := rs.ScopeSpans()
+		for j := 0; j < ilss.Len(); j++ {
+			spans := ilss.At(j).Spans()
+			for k := 0; k < spans.Len(); k++ {
+				span := spans.At(k)
+				id, ok := c.sessionID(span.Attributes(), rs.Resource().Attributes())
+				if !ok {
+					continue
+				}
+				c.observe(id, span.StartTimestamp(), span.EndTimestamp(), span.Status().Code() == ptrace.StatusCodeError, now)
+			}
+		}
+	}
+	return nil
+}
+
+// ConsumeLogs implements the consumer.Logs interface.
+func (c *sessionConnector) ConsumeLogs(_ context.Context, ld plog.Logs) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	rls := ld.ResourceLogs()
+	for i := 0; i < rls.Len(); i++ {
+		rl := rls.At(i)
+		sls := rl.ScopeLogs()
+		for j := 0; j < sls.Len(); j++ {
+			records := sls.At(j).LogRecords()
+			for k := 0; k < records.Len(); k++ {
+				record := records.At(k)
+				id, ok := c.sessionID(record.Attributes(), rl.Resource().Attributes())
+				if !ok {
+					continue
+				}
+				ts := record.Timestamp()
+				if ts == 0 {
+					ts = record.ObservedTimestamp()
+				}
+				c.observe(id, ts, ts, record.SeverityNumber() >= plog.SeverityNumberError, now)
+			}
+		}
+	}
+	return nil
+}
+
+func (c *sessionConnector) sessionID(attrs, resourceAttrs pcommon.Map) (string, bool) {
+	v, ok := attrs.Get(c.config.SessionAttribute)
+	if !ok {
+		v, ok = resourceAttrs.Get(c.config.SessionAttribute)
+	}
+	if !ok {
+		return "", false
+	}
+	id := v.AsString()
+	return id, id != ""
+}
+
+func (c *sessionConnector) observe(id string, start, end pcommon.Timestamp, isError bool, now time.Time) {
+	var s *session
+	if elem, ok := c.sessions[id]; ok {
+		s = elem.Value.(*session)
+		c.lru.MoveToBack(elem)
+	} else {
+		if c.config.MaxSessions > 0 && c.lru.Len() >= c.config.MaxSessions {
+			c.evict()
+		}
+		s = &session{id: id, start: start, end: end}
+		c.sessions[id] = c.lru.PushBack(s)
+	}
+	if start < s.start {
+		s.start = start
+	}
+	if end > s.end {
+		s.end = end
+	}
+	if isError {
+		s.errors++
+	}
+	s.lastSeen = now
+}
+
+// evict ends the least recently seen session to make room for a new one.
+func (c *sessionConnector) evict() {
+	oldest := c.lru.Front()
+	s := c.lru.Remove(oldest).(*session)
+	delete(c.sessions, s.id)
+	c.evicted = append(c.evicted, s)
+}
+
+// flush emits the metrics of the sessions inactive for InactivityTimeout, or
+// of all the sessions if all is set.
+func (c *sessionConnector) flush(ctx context.Context, all bool) error {
+	c.mu.Lock()
+	now := c.now()
+	evicted := len(c.evicted)
+	ended := c.evicted
+	c.evicted = nil
+	// The sessions are ordered by last activity, so the inactive ones are at the front.
+	for elem := c.lru.Front(); elem != nil; elem = c.lru.Front() {
+		s := elem.Value.(*session)
+		if !all && now.Sub(s.lastSeen) < c.config.InactivityTimeout {
+			break
+		}
+		c.lru.Remove(elem)
+		delete(c.sessions, s.id)
+		ended = append(ended, s)
+	}
+	start := c.lastFlush
+	c.lastFlush = now
+	c.mu.Unlock()
+
+	if len(ended) == 0 {
+		return nil
+	}
+	return c.metricsConsumer.ConsumeMetrics(ctx, c.buildMetrics(ended, evicted, start, now))
+}
+
+func (c *sessionConnector) buildMetrics(ended []*session, evicted int, start, now time.Time) pmetric.Metrics {
+	startTs := pcommon.NewTimestampFromTime(start)
+	ts := pcommon.NewTimestampFromTime(now)
+
+	md := pmetric.NewMetrics()
+	sm := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty()
+	sm.Scope().SetName(scopeName)
+
+	var errors int64
+	bucketCounts := make([]uint64, len(c.durationBounds)+1)
+	var durationSum float64
+	for _, s := range ended {
+		errors += s.errors
+		duration := time.Duration(s.end - s.start).Seconds()
+		durationSum += duration
+		bucket := len(c.durationBounds)
+		for i, bound := range c.durationBounds {
+			if duration <= bound {
+				bucket = i
+				break
+			}
+		}
+		bucketCounts[bucket]++
+	}
+
+	count := sm.Metrics().AppendEmpty()
+	count.SetName("session.count")
+	count.SetDescription("Number of sessions ended.")
+	count.SetUnit("{sessions}")
+	appendDeltaSum(count, int64(len(ended)), startTs, ts)
+
+	duration := sm.Metrics().AppendEmpty()
+	duration.SetName("session.duration")
+	duration.SetDescription("Duration of the ended sessions, from their first to their last telemetry.")
+	duration.SetUnit("s")
+	histogram := duration.SetEmptyHistogram()
+	histogram.SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+	dp := histogram.DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(startTs)
+	dp.SetTimestamp(ts)
+	dp.SetCount(uint64(len(ended)))
+	dp.SetSum(durationSum)
+	dp.ExplicitBounds().FromRaw(c.durationBounds)
+	dp.BucketCounts().FromRaw(bucketCounts)
+
+	errorCount := sm.Metrics().AppendEmpty()
+	errorCount.SetName("session.errors")
+	errorCount.SetDescription("Number of error spans and log records in the ended sessions.")
+	errorCount.SetUnit("{errors}")
+	appendDeltaSum(errorCount, errors, startTs, ts)
+
+	evictedCount := sm.Metrics().AppendEmpty()
+	evictedCount.SetName("session.evicted")
+	evictedCount.SetDescription("Number of sessions ended before their inactivity timeout because max_sessions was reached.")
+	evictedCount.SetUnit("{sessions}")
+	appendDeltaSum(evictedCount, int64(evicted), startTs, ts)
+
+	return md
+}
+
+func appendDeltaSum(metric pmetric.Metric, value int64, start, ts pcommon.Timestamp) {
+	sum := metric.SetEmptySum()
+	sum.SetIsMonotonic(true)
+	sum.SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+	dp := sum.DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(value)
+}
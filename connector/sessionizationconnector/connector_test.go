@@ -0,0 +1,186 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sessionizationconnector
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap"
+)
+
+func newTestConnector(t *testing.T, sink *consumertest.MetricsSink, now *time.Time) *sessionConnector {
+	cfg := createDefaultConfig().(*Config)
+	cfg.InactivityTimeout = time.Minute
+	cfg.DurationBuckets = []time.Duration{10 * time.Second, time.Minute}
+	c := newConnector(zap.NewNop(), cfg, sink)
+	c.now = func() time.Time { return *now }
+	c.lastFlush = *now
+	t.Cleanup(func() { close(c.done) })
+	return c
+}
+
+func timestamp(sec int64) pcommon.Timestamp {
+	return pcommon.NewTimestampFromTime(time.Unix(sec, 0))
+}
+
+func TestConsumeTraces(t *testing.T) {
+	sink := &consumertest.MetricsSink{}
+	now := time.Unix(1000, 0)
+	c := newTestConnector(t, sink, &now)
+
+	td := ptrace.NewTraces()
+	rs := td.ResourceSpans().AppendEmpty()
+	rs.Resource().Attributes().PutStr("session.id", "s1")
+	spans := rs.ScopeSpans().AppendEmpty().Spans()
+
+	span := spans.AppendEmpty()
+	span.SetStartTimestamp(timestamp(100))
+	span.SetEndTimestamp(timestamp(101))
+
+	span = spans.AppendEmpty()
+	span.SetStartTimestamp(timestamp(120))
+	span.SetEndTimestamp(timestamp(130))
+	span.Status().SetCode(ptrace.StatusCodeError)
+
+	// The span attribute takes precedence over the resource attribute.
+	span = spans.AppendEmpty()
+	span.Attributes().PutStr("session.id", "s2")
+	span.SetStartTimestamp(timestamp(100))
+	span.SetEndTimestamp(timestamp(105))
+
+	require.NoError(t, c.ConsumeTraces(context.Background(), td))
+
+	// The sessions are still active.
+	now = now.Add(30 * time.Second)
+	require.NoError(t, c.flush(context.Background(), false))
+	assert.Empty(t, sink.AllMetrics())
+
+	now = now.Add(30 * time.Second)
+	require.NoError(t, c.flush(context.Background(), false))
+	require.Len(t, sink.AllMetrics(), 1)
+	assert.Empty(t, c.sessions)
+
+	metrics := sink.AllMetrics()[0].ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	require.Equal(t, 4, metrics.Len())
+
+	count := metrics.At(0)
+	assert.Equal(t, "session.count", count.Name())
+	assert.Equal(t, int64(2), count.Sum().DataPoints().At(0).IntValue())
+	assert.Equal(t, pcommon.NewTimestampFromTime(time.Unix(1030, 0)), count.Sum().DataPoints().At(0).StartTimestamp())
+	assert.Equal(t, pcommon.NewTimestampFromTime(now), count.Sum().DataPoints().At(0).Timestamp())
+
+	duration := metrics.At(1)
+	assert.Equal(t, "session.duration", duration.Name())
+	dp := duration.Histogram().DataPoints().At(0)
+	assert.Equal(t, uint64(2), dp.Count())
+	assert.Equal(t, float64(35), dp.Sum())
+	assert.Equal(t, []float64{10, 60}, dp.ExplicitBounds().AsRaw())
+	assert.Equal(t, []uint64{1, 1, 0}, dp.BucketCounts().AsRaw())
+
+	errors := metrics.At(2)
+	assert.Equal(t, "session.errors", errors.Name())
+	assert.Equal(t, int64(1), errors.Sum().DataPoints().At(0).IntValue())
+
+	evicted := metrics.At(3)
+	assert.Equal(t, "session.evicted", evicted.Name())
+	assert.Equal(t, int64(0), evicted.Sum().DataPoints().At(0).IntValue())
+}
+
+func TestConsumeLogs(t *testing.T) {
+	sink := &consumertest.MetricsSink{}
+	now := time.Unix(1000, 0)
+	c := newTestConnector(t, sink, &now)
+
+	ld := plog.NewLogs()
+	records := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords()
+
+	record := records.AppendEmpty()
+	record.Attributes().PutStr("session.id", "s1")
+	record.SetTimestamp(timestamp(100))
+
+	record = records.AppendEmpty()
+	record.Attributes().PutStr("session.id", "s1")
+	record.SetObservedTimestamp(timestamp(200))
+	record.SetSeverityNumber(plog.SeverityNumberFatal)
+
+	// Log records without a session are ignored.
+	record = records.AppendEmpty()
+	record.SetTimestamp(timestamp(300))
+	record.SetSeverityNumber(plog.SeverityNumberError)
+
+	require.NoError(t, c.ConsumeLogs(context.Background(), ld))
+	require.Len(t, c.sessions, 1)
+
+	// Shutting down ends the sessions in progress.
+	require.NoError(t, c.flush(context.Background(), true))
+	require.Len(t, sink.AllMetrics(), 1)
+
+	metrics := sink.AllMetrics()[0].ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	assert.Equal(t, int64(1), metrics.At(0).Sum().DataPoints().At(0).IntValue())
+	assert.Equal(t, float64(100), metrics.At(1).Histogram().DataPoints().At(0).Sum())
+	assert.Equal(t, []uint64{0, 0, 1}, metrics.At(1).Histogram().DataPoints().At(0).BucketCounts().AsRaw())
+	assert.Equal(t, pmetric.AggregationTemporalityDelta, metrics.At(2).Sum().AggregationTemporality())
+	assert.Equal(t, int64(1), metrics.At(2).Sum().DataPoints().At(0).IntValue())
+}
+
+func TestDefaultDurationBuckets(t *testing.T) {
+	c := newConnector(zap.NewNop(), createDefaultConfig().(*Config), consumertest.NewNop())
+	assert.Equal(t, []float64{1, 10, 30, 60, 300, 900, 1800, 3600}, c.durationBounds)
+}
+
+func TestMaxSessions(t *testing.T) {
+	sink := &consumertest.MetricsSink{}
+	now := time.Unix(1000, 0)
+	c := newTestConnector(t, sink, &now)
+	c.config.MaxSessions = 2
+
+	newLogs := func(id string) plog.Logs {
+		ld := plog.NewLogs()
+		record := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+		record.Attributes().PutStr("session.id", id)
+		record.SetTimestamp(timestamp(100))
+		return ld
+	}
+
+	require.NoError(t, c.ConsumeLogs(context.Background(), newLogs("s1")))
+	now = now.Add(time.Second)
+	require.NoError(t, c.ConsumeLogs(context.Background(), newLogs("s2")))
+	now = now.Add(time.Second)
+	require.NoError(t, c.ConsumeLogs(context.Background(), newLogs("s1")))
+	now = now.Add(time.Second)
+	// s2 is the least recently seen session, it is evicted to track s3.
+	require.NoError(t, c.ConsumeLogs(context.Background(), newLogs("s3")))
+	require.Len(t, c.sessions, 2)
+	assert.Contains(t, c.sessions, "s1")
+	assert.Contains(t, c.sessions, "s3")
+
+	// The evicted session is emitted with the next flush, even though the others are active.
+	require.NoError(t, c.flush(context.Background(), false))
+	require.Len(t, sink.AllMetrics(), 1)
+	metrics := sink.AllMetrics()[0].ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	assert.Equal(t, int64(1), metrics.At(0).Sum().DataPoints().At(0).IntValue())
+	assert.Equal(t, "session.evicted", metrics.At(3).Name())
+	assert.Equal(t, int64(1), metrics.At(3).Sum().DataPoints().At(0).IntValue())
+	assert.Len(t, c.sessions, 2)
+}
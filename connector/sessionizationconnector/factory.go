@@ -0,0 +1,117 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sessionizationconnector // import "github.com/open-telemetry/opentelemetry-collector-contrib/connector/sessionizationconnector"
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/connector"
+	"go.opentelemetry.io/collector/consumer"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/sharedcomponent"
+)
+
+const (
+	// The value of "type" key in configuration.
+	typeStr = "sessionization"
+	// The stability level of the connector.
+	stability = component.StabilityLevelDevelopment
+
+	defaultSessionAttribute  = "session.id"
+	defaultInactivityTimeout = 30 * time.Minute
+	defaultFlushInterval     = time.Minute
+	defaultMaxSessions       = 100000
+)
+
+var defaultDurationBuckets = []time.Duration{
+	time.Second,
+	10 * time.Second,
+	30 * time.Second,
+	time.Minute,
+	5 * time.Minute,
+	15 * time.Minute,
+	30 * time.Minute,
+	time.Hour,
+}
+
+// NewFactory returns a new factory for the Sessionization connector.
+func NewFactory() connector.Factory {
+	return connector.NewFactory(
+		typeStr,
+		createDefaultConfig,
+		connector.WithTracesToMetrics(createTracesToMetrics, stability),
+		connector.WithLogsToMetrics(createLogsToMetrics, stability))
+}
+
+func createDefaultConfig() component.Config {
+	return &Config{
+		SessionAttribute:  defaultSessionAttribute,
+		InactivityTimeout: defaultInactivityTimeout,
+		FlushInterval:     defaultFlushInterval,
+		MaxSessions:       defaultMaxSessions,
+	}
+}
+
+func createTracesToMetrics(
+	_ context.Context,
+	set connector.CreateSettings,
+	cfg component.Config,
+	nextConsumer consumer.Metrics,
+) (connector.Traces, error) {
+	return getOrCreateConnector(set, cfg.(*Config), nextConsumer), nil
+}
+
+func createLogsToMetrics(
+	_ context.Context,
+	set connector.CreateSettings,
+	cfg component.Config,
+	nextConsumer consumer.Metrics,
+) (connector.Logs, error) {
+	return getOrCreateConnector(set, cfg.(*Config), nextConsumer), nil
+}
+
+// This is the map of already created sessionization connectors for particular configurations.
+// The traces and logs pipelines using the same connector share its sessions, so that a session
+// is tracked once whatever the kind of telemetry it is made of.
+var connectors = sharedcomponent.NewSharedComponents()
+
+// sharedConnector is the sessionConnector of a component ID, started and stopped once for all
+// the pipelines it is used in.
+type sharedConnector struct {
+	*sessionConnector
+	shared *sharedcomponent.SharedComponent
+}
+
+func getOrCreateConnector(set connector.CreateSettings, cfg *Config, nextConsumer consumer.Metrics) *sharedConnector {
+	shared := connectors.GetOrAdd(set.ID, func() component.Component {
+		return newConnector(set.Logger, cfg, nextConsumer)
+	})
+	return &sharedConnector{
+		sessionConnector: shared.Unwrap().(*sessionConnector),
+		shared:           shared,
+	}
+}
+
+// Start implements the component.Component interface.
+func (c *sharedConnector) Start(ctx context.Context, host component.Host) error {
+	return c.shared.Start(ctx, host)
+}
+
+// Shutdown implements the component.Component interface.
+func (c *sharedConnector) Shutdown(ctx context.Context) error {
+	return c.shared.Shutdown(ctx)
+}
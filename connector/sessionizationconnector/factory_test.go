@@ -0,0 +1,73 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sessionizationconnector
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/connector/connectortest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+)
+
+func TestType(t *testing.T) {
+	factory := NewFactory()
+	assert.Equal(t, component.Type("sessionization"), factory.Type())
+}
+
+func TestCreateDefaultConfig(t *testing.T) {
+	cfg := NewFactory().CreateDefaultConfig()
+	assert.NoError(t, componenttest.CheckConfigStruct(cfg))
+	assert.NoError(t, component.ValidateConfig(cfg))
+}
+
+func TestCreateConnectors(t *testing.T) {
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig()
+
+	tc, err := factory.CreateTracesToMetrics(context.Background(), connectortest.NewNopCreateSettings(), cfg, consumertest.NewNop())
+	require.NoError(t, err)
+	require.NoError(t, tc.Start(context.Background(), componenttest.NewNopHost()))
+	assert.NoError(t, tc.Shutdown(context.Background()))
+
+	lc, err := factory.CreateLogsToMetrics(context.Background(), connectortest.NewNopCreateSettings(), cfg, consumertest.NewNop())
+	require.NoError(t, err)
+	require.NoError(t, lc.Start(context.Background(), componenttest.NewNopHost()))
+	assert.NoError(t, lc.Shutdown(context.Background()))
+
+	_, err = factory.CreateTracesToTraces(context.Background(), connectortest.NewNopCreateSettings(), cfg, consumertest.NewNop())
+	assert.Error(t, err)
+}
+
+func TestConnectorsShareSessions(t *testing.T) {
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig()
+	set := connectortest.NewNopCreateSettings()
+
+	tc, err := factory.CreateTracesToMetrics(context.Background(), set, cfg, consumertest.NewNop())
+	require.NoError(t, err)
+	lc, err := factory.CreateLogsToMetrics(context.Background(), set, cfg, consumertest.NewNop())
+	require.NoError(t, err)
+	assert.Same(t, tc.(*sharedConnector).sessionConnector, lc.(*sharedConnector).sessionConnector)
+
+	require.NoError(t, tc.Start(context.Background(), componenttest.NewNopHost()))
+	require.NoError(t, lc.Start(context.Background(), componenttest.NewNopHost()))
+	assert.NoError(t, tc.Shutdown(context.Background()))
+	assert.NoError(t, lc.Shutdown(context.Background()))
+}
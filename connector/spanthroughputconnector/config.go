@@ -0,0 +1,38 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spanthroughputconnector // import "github.com/open-telemetry/opentelemetry-collector-contrib/connector/spanthroughputconnector"
+
+import (
+	"errors"
+
+	"go.opentelemetry.io/collector/component"
+)
+
+// Config defines the configuration for the span throughput connector.
+type Config struct {
+	// Observer is the ID of the extension the number of spans of each service is reported to,
+	// such as a jaegerremotesampling extension using the adaptive source.
+	Observer component.ID `mapstructure:"observer"`
+}
+
+var _ component.Config = (*Config)(nil)
+
+// Validate checks if the connector configuration is valid.
+func (cfg *Config) Validate() error {
+	if cfg.Observer == (component.ID{}) {
+		return errors.New("observer must be specified")
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spanthroughputconnector // import "github.com/open-telemetry/opentelemetry-collector-contrib/connector/spanthroughputconnector"
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+const serviceNameAttribute = "service.name"
+
+// spanObserver is implemented by the extensions the span throughput is reported to, such as the
+// jaegerremotesampling extension.
+type spanObserver interface {
+	ObserveSpans(serviceName string, count int)
+}
+
+// throughputConnector forwards the spans it receives unchanged and reports the number of spans of
+// each service to the observer extension.
+type throughputConnector struct {
+	observerID   component.ID
+	observer     spanObserver
+	nextConsumer consumer.Traces
+}
+
+func newConnector(cfg *Config, nextConsumer consumer.Traces) *throughputConnector {
+	return &throughputConnector{
+		observerID:   cfg.Observer,
+		nextConsumer: nextConsumer,
+	}
+}
+
+// Start looks up the observer extension.
+func (c *throughputConnector) Start(_ context.Context, host component.Host) error {
+	extension, ok := host.GetExtensions()[c.observerID]
+	if !ok {
+		return fmt.Errorf("observer extension '%s' not found", c.observerID)
+	}
+	observer, ok := extension.(spanObserver)
+	if !ok {
+		return fmt.Errorf("extension '%s' does not observe spans", c.observerID)
+	}
+	c.observer = observer
+	return nil
+}
+
+// Shutdown implements the component.Component interface.
+func (c *throughputConnector) Shutdown(context.Context) error {
+	return nil
+}
+
+// Capabilities implements the consumer interface.
+func (c *throughputConnector) Capabilities() consumer.Capabilities {
+	return consumer.Capabilities{MutatesData: false}
+}
+
+// ConsumeTraces reports the spans of each service and forwards them to the next consumer.
+func (c *throughputConnector) ConsumeTraces(ctx context.Context, td ptrace.Traces) error {
+	counts := map[string]int{}
+	for i := 0; i < td.ResourceSpans().Len(); i++ {
+		rs := td.ResourceSpans().At(i)
+		serviceName, ok := rs.Resource().Attributes().Get(serviceNameAttribute)
+		if !ok || serviceName.Str() == "" {
+			// the sampling strategies are served per service, spans without service are not reported
+			continue
+		}
+		for j := 0; j < rs.ScopeSpans().Len(); j++ {
+			counts[serviceName.Str()] += rs.ScopeSpans().At(j).Spans().Len()
+		}
+	}
+	for serviceName, count := range counts {
+		if count > 0 {
+			c.observer.ObserveSpans(serviceName, count)
+		}
+	}
+	return c.nextConsumer.ConsumeTraces(ctx, td)
+}
@@ -0,0 +1,100 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spanthroughputconnector
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+type testObserver struct {
+	component.StartFunc
+	component.ShutdownFunc
+	counts map[string]int
+}
+
+func (o *testObserver) ObserveSpans(serviceName string, count int) {
+	o.counts[serviceName] += count
+}
+
+type nonObserver struct {
+	component.StartFunc
+	component.ShutdownFunc
+}
+
+type testHost struct {
+	component.Host
+	extensions map[component.ID]component.Component
+}
+
+func (h *testHost) GetExtensions() map[component.ID]component.Component {
+	return h.extensions
+}
+
+func TestConsumeTraces(t *testing.T) {
+	observerID := component.NewID("jaegerremotesampling")
+	observer := &testObserver{counts: map[string]int{}}
+	host := &testHost{
+		Host:       componenttest.NewNopHost(),
+		extensions: map[component.ID]component.Component{observerID: observer},
+	}
+	sink := &consumertest.TracesSink{}
+
+	c := newConnector(&Config{Observer: observerID}, sink)
+	require.NoError(t, c.Start(context.Background(), host))
+
+	td := ptrace.NewTraces()
+	addSpans(td, "checkout", 2)
+	addSpans(td, "checkout", 3)
+	addSpans(td, "cart", 1)
+	addSpans(td, "", 4)
+
+	require.NoError(t, c.ConsumeTraces(context.Background(), td))
+	assert.Equal(t, map[string]int{"checkout": 5, "cart": 1}, observer.counts)
+	// the spans are forwarded unchanged
+	require.Len(t, sink.AllTraces(), 1)
+	assert.Equal(t, 10, sink.SpanCount())
+	require.NoError(t, c.Shutdown(context.Background()))
+}
+
+func TestStartErrors(t *testing.T) {
+	observerID := component.NewID("jaegerremotesampling")
+	c := newConnector(&Config{Observer: observerID}, consumertest.NewNop())
+	assert.EqualError(t, c.Start(context.Background(), componenttest.NewNopHost()), "observer extension 'jaegerremotesampling' not found")
+
+	host := &testHost{
+		Host:       componenttest.NewNopHost(),
+		extensions: map[component.ID]component.Component{observerID: &nonObserver{}},
+	}
+	assert.EqualError(t, c.Start(context.Background(), host), "extension 'jaegerremotesampling' does not observe spans")
+}
+
+func addSpans(td ptrace.Traces, serviceName string, count int) {
+	rs := td.ResourceSpans().AppendEmpty()
+	if serviceName != "" {
+		rs.Resource().Attributes().PutStr(serviceNameAttribute, serviceName)
+	}
+	spans := rs.ScopeSpans().AppendEmpty().Spans()
+	for i := 0; i < count; i++ {
+		spans.AppendEmpty().SetName("span")
+	}
+}
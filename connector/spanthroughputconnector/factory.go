@@ -0,0 +1,55 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spanthroughputconnector // import "github.com/open-telemetry/opentelemetry-collector-contrib/connector/spanthroughputconnector"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/connector"
+	"go.opentelemetry.io/collector/consumer"
+)
+
+const (
+	// The value of "type" key in configuration.
+	typeStr = "spanthroughput"
+	// The stability level of the connector.
+	stability = component.StabilityLevelDevelopment
+
+	defaultObserver = "jaegerremotesampling"
+)
+
+// NewFactory returns a new factory for the span throughput connector.
+func NewFactory() connector.Factory {
+	return connector.NewFactory(
+		typeStr,
+		createDefaultConfig,
+		connector.WithTracesToTraces(createTracesToTraces, stability))
+}
+
+func createDefaultConfig() component.Config {
+	return &Config{
+		Observer: component.NewID(defaultObserver),
+	}
+}
+
+func createTracesToTraces(
+	_ context.Context,
+	_ connector.CreateSettings,
+	cfg component.Config,
+	nextConsumer consumer.Traces,
+) (connector.Traces, error) {
+	return newConnector(cfg.(*Config), nextConsumer), nil
+}
@@ -0,0 +1,101 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alertmanagerexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/alertmanagerexporter"
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"text/template"
+	"time"
+
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/exporter/exporterhelper"
+)
+
+const (
+	defaultEndpoint       = "http://localhost:9093"
+	defaultTimeout        = 5 * time.Second
+	defaultResolveTimeout = 5 * time.Minute
+)
+
+// Config defines configuration for the Alertmanager exporter.
+type Config struct {
+	confighttp.HTTPClientSettings `mapstructure:",squash"` // squash ensures fields are correctly decoded in embedded struct.
+	exporterhelper.QueueSettings  `mapstructure:"sending_queue"`
+	exporterhelper.RetrySettings  `mapstructure:"retry_on_failure"`
+
+	// GeneratorURL is the URL identifying the source of the alerts in Alertmanager.
+	GeneratorURL string `mapstructure:"generator_url"`
+
+	// EventAttribute is the attribute of the log records that are events to alert on.
+	// When set, the log records without it are dropped.
+	EventAttribute string `mapstructure:"event_attribute"`
+
+	// Labels are the templates of the labels of the alerts, rendered for each log record.
+	Labels map[string]string `mapstructure:"labels"`
+
+	// Annotations are the templates of the annotations of the alerts, rendered for each log record.
+	Annotations map[string]string `mapstructure:"annotations"`
+
+	// DedupKeys are the labels identifying an alert. The log records of a batch rendering the same
+	// values for these labels are sent as a single alert. All the labels are used when empty.
+	DedupKeys []string `mapstructure:"dedup_keys"`
+
+	// ResolveTimeout is the duration after which an alert is resolved when no log record renews it.
+	// When 0, the alerts have no end time and the resolve timeout of Alertmanager applies.
+	ResolveTimeout time.Duration `mapstructure:"resolve_timeout"`
+}
+
+// Validate checks the exporter configuration is valid.
+func (cfg *Config) Validate() error {
+	if cfg.Endpoint == "" {
+		return errors.New(`"endpoint" must be set`)
+	}
+	if u, err := url.Parse(cfg.Endpoint); err != nil || u.Host == "" {
+		return fmt.Errorf(`"endpoint" must be a valid URL: %q`, cfg.Endpoint)
+	}
+	if len(cfg.Labels) == 0 {
+		return errors.New(`"labels" must not be empty`)
+	}
+	if _, err := parseTemplates(cfg.Labels); err != nil {
+		return fmt.Errorf(`invalid "labels": %w`, err)
+	}
+	if _, err := parseTemplates(cfg.Annotations); err != nil {
+		return fmt.Errorf(`invalid "annotations": %w`, err)
+	}
+	for _, key := range cfg.DedupKeys {
+		if _, ok := cfg.Labels[key]; !ok {
+			return fmt.Errorf(`dedup key %q is not in "labels"`, key)
+		}
+	}
+	if cfg.ResolveTimeout < 0 {
+		return errors.New(`"resolve_timeout" must not be negative`)
+	}
+	return nil
+}
+
+// parseTemplates parses the templates by name, the missing keys of the maps render as empty strings.
+func parseTemplates(texts map[string]string) (map[string]*template.Template, error) {
+	templates := make(map[string]*template.Template, len(texts))
+	for name, text := range texts {
+		tmpl, err := template.New(name).Option("missingkey=zero").Parse(text)
+		if err != nil {
+			return nil, err
+		}
+		templates[name] = tmpl
+	}
+	return templates, nil
+}
@@ -0,0 +1,143 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alertmanagerexporter
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+	"go.opentelemetry.io/collector/exporter/exporterhelper"
+)
+
+func TestLoadConfig(t *testing.T) {
+	t.Parallel()
+
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+
+	tests := []struct {
+		id       component.ID
+		expected component.Config
+	}{
+		{
+			id:       component.NewIDWithName(typeStr, ""),
+			expected: createDefaultConfig(),
+		},
+		{
+			id: component.NewIDWithName(typeStr, "allsettings"),
+			expected: &Config{
+				HTTPClientSettings: confighttp.HTTPClientSettings{
+					Endpoint: "http://alertmanager:9093",
+					Timeout:  10 * time.Second,
+				},
+				RetrySettings: exporterhelper.RetrySettings{
+					Enabled:         false,
+					InitialInterval: 99 * time.Second,
+					MaxInterval:     199 * time.Second,
+					MaxElapsedTime:  299 * time.Minute,
+				},
+				QueueSettings: exporterhelper.QueueSettings{
+					Enabled:      false,
+					NumConsumers: 7,
+					QueueSize:    17,
+				},
+				GeneratorURL:   "http://otelcol:8888",
+				EventAttribute: "event.name",
+				Labels: map[string]string{
+					"alertname": "{{ .Event }}",
+					"service":   `{{ index .Resource "service.name" }}`,
+					"severity":  "{{ .SeverityText }}",
+				},
+				Annotations: map[string]string{
+					"description": "{{ .Body }}",
+					"summary":     "{{ .Body }}",
+				},
+				DedupKeys:      []string{"alertname", "service"},
+				ResolveTimeout: 10 * time.Minute,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			factory := NewFactory()
+			cfg := factory.CreateDefaultConfig()
+
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, component.UnmarshalConfig(sub, cfg))
+
+			assert.NoError(t, component.ValidateConfig(cfg))
+			assert.Equal(t, tt.expected, cfg)
+		})
+	}
+}
+
+func TestConfigValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(cfg *Config)
+		err    string
+	}{
+		{
+			name:   "missing endpoint",
+			modify: func(cfg *Config) { cfg.Endpoint = "" },
+			err:    `"endpoint" must be set`,
+		},
+		{
+			name:   "invalid endpoint",
+			modify: func(cfg *Config) { cfg.Endpoint = "alertmanager" },
+			err:    `"endpoint" must be a valid URL: "alertmanager"`,
+		},
+		{
+			name:   "no labels",
+			modify: func(cfg *Config) { cfg.Labels = nil },
+			err:    `"labels" must not be empty`,
+		},
+		{
+			name:   "invalid label template",
+			modify: func(cfg *Config) { cfg.Labels["alertname"] = "{{ .Event" },
+			err:    `invalid "labels": template: alertname:1: unclosed action`,
+		},
+		{
+			name:   "invalid annotation template",
+			modify: func(cfg *Config) { cfg.Annotations["description"] = "{{ end }}" },
+			err:    `invalid "annotations": template: description:1: unexpected {{end}}`,
+		},
+		{
+			name:   "unknown dedup key",
+			modify: func(cfg *Config) { cfg.DedupKeys = []string{"instance"} },
+			err:    `dedup key "instance" is not in "labels"`,
+		},
+		{
+			name:   "negative resolve timeout",
+			modify: func(cfg *Config) { cfg.ResolveTimeout = -time.Second },
+			err:    `"resolve_timeout" must not be negative`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			tt.modify(cfg)
+			assert.EqualError(t, cfg.Validate(), tt.err)
+		})
+	}
+}
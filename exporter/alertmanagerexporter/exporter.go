@@ -0,0 +1,266 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alertmanagerexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/alertmanagerexporter"
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"text/template"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/exporter"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.uber.org/zap"
+)
+
+// alertsPath is the path of the Alertmanager API v2 receiving the alerts.
+const alertsPath = "/api/v2/alerts"
+
+// alert is an alert of the Alertmanager API v2.
+type alert struct {
+	Labels       map[string]string `json:"labels"`
+	Annotations  map[string]string `json:"annotations,omitempty"`
+	StartsAt     string            `json:"startsAt"`
+	EndsAt       string            `json:"endsAt,omitempty"`
+	GeneratorURL string            `json:"generatorURL,omitempty"`
+}
+
+// templateData is the data the label and annotation templates are rendered with.
+type templateData struct {
+	// Event is the value of the event attribute, or the body when no event attribute is configured.
+	Event          string
+	Body           string
+	SeverityText   string
+	SeverityNumber int32
+	Attributes     map[string]string
+	Resource       map[string]string
+}
+
+type alertmanagerExporter struct {
+	config      *Config
+	settings    component.TelemetrySettings
+	logger      *zap.Logger
+	client      *http.Client
+	url         string
+	userAgent   string
+	labels      map[string]*template.Template
+	annotations map[string]*template.Template
+}
+
+func newAlertmanagerExporter(cfg *Config, set exporter.CreateSettings) (*alertmanagerExporter, error) {
+	labels, err := parseTemplates(cfg.Labels)
+	if err != nil {
+		return nil, err
+	}
+	annotations, err := parseTemplates(cfg.Annotations)
+	if err != nil {
+		return nil, err
+	}
+	return &alertmanagerExporter{
+		config:      cfg,
+		settings:    set.TelemetrySettings,
+		logger:      set.Logger,
+		url:         strings.TrimSuffix(cfg.Endpoint, "/") + alertsPath,
+		userAgent:   fmt.Sprintf("%s/%s", set.BuildInfo.Command, set.BuildInfo.Version),
+		labels:      labels,
+		annotations: annotations,
+	}, nil
+}
+
+func (e *alertmanagerExporter) start(_ context.Context, host component.Host) (err error) {
+	e.client, err = e.config.HTTPClientSettings.ToClient(host, e.settings)
+	return err
+}
+
+func (e *alertmanagerExporter) pushLogs(ctx context.Context, ld plog.Logs) error {
+	alerts := e.logsToAlerts(ld)
+	if len(alerts) == 0 {
+		return nil
+	}
+
+	body, err := json.Marshal(alerts)
+	if err != nil {
+		return consumererror.NewPermanent(err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.url, bytes.NewReader(body))
+	if err != nil {
+		return consumererror.NewPermanent(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", e.userAgent)
+
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send alerts to Alertmanager: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+	respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	err = fmt.Errorf("alertmanager returned %q: %s", resp.Status, respBody)
+	if resp.StatusCode >= 400 && resp.StatusCode < 500 && resp.StatusCode != http.StatusTooManyRequests {
+		return consumererror.NewPermanent(err)
+	}
+	return err
+}
+
+// logsToAlerts renders an alert for each log record, the alerts with the same deduplication key
+// are merged into the one of the latest log record.
+func (e *alertmanagerExporter) logsToAlerts(ld plog.Logs) []alert {
+	var alerts []alert
+	var startTimes []time.Time
+	indexes := map[string]int{}
+
+	rls := ld.ResourceLogs()
+	for i := 0; i < rls.Len(); i++ {
+		rl := rls.At(i)
+		resource := attributesToStrings(rl.Resource().Attributes())
+		sls := rl.ScopeLogs()
+		for j := 0; j < sls.Len(); j++ {
+			lrs := sls.At(j).LogRecords()
+			for k := 0; k < lrs.Len(); k++ {
+				lr := lrs.At(k)
+				data := templateData{
+					Event:          lr.Body().AsString(),
+					Body:           lr.Body().AsString(),
+					SeverityText:   lr.SeverityText(),
+					SeverityNumber: int32(lr.SeverityNumber()),
+					Attributes:     attributesToStrings(lr.Attributes()),
+					Resource:       resource,
+				}
+				if e.config.EventAttribute != "" {
+					event, ok := lr.Attributes().Get(e.config.EventAttribute)
+					if !ok {
+						continue
+					}
+					data.Event = event.AsString()
+				}
+
+				a, err := e.renderAlert(data)
+				if err != nil {
+					e.logger.Warn("Failed to render alert, dropping log record", zap.Error(err))
+					continue
+				}
+				startsAt := logTime(lr)
+				a.StartsAt = startsAt.Format(time.RFC3339Nano)
+				if e.config.ResolveTimeout > 0 {
+					a.EndsAt = startsAt.Add(e.config.ResolveTimeout).Format(time.RFC3339Nano)
+				}
+
+				key := e.dedupKey(a.Labels)
+				if index, ok := indexes[key]; ok {
+					if !startsAt.Before(startTimes[index]) {
+						alerts[index] = a
+						startTimes[index] = startsAt
+					}
+					continue
+				}
+				indexes[key] = len(alerts)
+				alerts = append(alerts, a)
+				startTimes = append(startTimes, startsAt)
+			}
+		}
+	}
+	return alerts
+}
+
+// renderAlert renders the labels and annotations of an alert, the ones rendering an empty value are omitted.
+func (e *alertmanagerExporter) renderAlert(data templateData) (alert, error) {
+	labels, err := render(e.labels, data)
+	if err != nil {
+		return alert{}, err
+	}
+	if len(labels) == 0 {
+		return alert{}, fmt.Errorf("all the labels are empty")
+	}
+	annotations, err := render(e.annotations, data)
+	if err != nil {
+		return alert{}, err
+	}
+	return alert{
+		Labels:       labels,
+		Annotations:  annotations,
+		GeneratorURL: e.config.GeneratorURL,
+	}, nil
+}
+
+// dedupKey returns the key identifying the alert with the labels, built from the
+// values of the dedup keys, or of all the labels when there are none.
+func (e *alertmanagerExporter) dedupKey(labels map[string]string) string {
+	names := e.config.DedupKeys
+	if len(names) == 0 {
+		names = make([]string, 0, len(labels))
+		for name := range labels {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+	}
+
+	var b strings.Builder
+	for _, name := range names {
+		b.WriteString(name)
+		b.WriteByte('=')
+		b.WriteString(strings.ReplaceAll(labels[name], ",", `\,`))
+		b.WriteByte(',')
+	}
+	return b.String()
+}
+
+func render(templates map[string]*template.Template, data templateData) (map[string]string, error) {
+	values := make(map[string]string, len(templates))
+	var b strings.Builder
+	for name, tmpl := range templates {
+		b.Reset()
+		if err := tmpl.Execute(&b, data); err != nil {
+			return nil, err
+		}
+		if b.Len() > 0 {
+			values[name] = b.String()
+		}
+	}
+	return values, nil
+}
+
+func attributesToStrings(attributes pcommon.Map) map[string]string {
+	values := make(map[string]string, attributes.Len())
+	attributes.Range(func(k string, v pcommon.Value) bool {
+		values[k] = v.AsString()
+		return true
+	})
+	return values
+}
+
+// logTime returns the time of the log record, its observed time when it has no timestamp,
+// or the current time when it has neither.
+func logTime(lr plog.LogRecord) time.Time {
+	if lr.Timestamp() != 0 {
+		return lr.Timestamp().AsTime()
+	}
+	if lr.ObservedTimestamp() != 0 {
+		return lr.ObservedTimestamp().AsTime()
+	}
+	return time.Now()
+}
@@ -0,0 +1,168 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alertmanagerexporter
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/exporter/exportertest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+)
+
+var testTime = time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC)
+
+func newTestExporter(t *testing.T, endpoint string, modify func(cfg *Config)) *alertmanagerExporter {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = endpoint
+	if modify != nil {
+		modify(cfg)
+	}
+	require.NoError(t, cfg.Validate())
+
+	exp, err := newAlertmanagerExporter(cfg, exportertest.NewNopCreateSettings())
+	require.NoError(t, err)
+	require.NoError(t, exp.start(context.Background(), componenttest.NewNopHost()))
+	return exp
+}
+
+func newTestLogs(records ...func(lr plog.LogRecord)) plog.Logs {
+	ld := plog.NewLogs()
+	rl := ld.ResourceLogs().AppendEmpty()
+	rl.Resource().Attributes().PutStr("service.name", "checkout")
+	lrs := rl.ScopeLogs().AppendEmpty().LogRecords()
+	for _, record := range records {
+		lr := lrs.AppendEmpty()
+		lr.SetTimestamp(pcommon.NewTimestampFromTime(testTime))
+		record(lr)
+	}
+	return ld
+}
+
+func TestPushLogs(t *testing.T) {
+	var received []alert
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, alertsPath, r.URL.Path)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	exp := newTestExporter(t, server.URL, func(cfg *Config) {
+		cfg.GeneratorURL = "http://otelcol"
+		cfg.Labels["service"] = `{{ index .Resource "service.name" }}`
+		cfg.Annotations["pod"] = `{{ index .Attributes "k8s.pod.name" }}`
+	})
+	ld := newTestLogs(func(lr plog.LogRecord) {
+		lr.Body().SetStr("DiskFull")
+		lr.SetSeverityText("critical")
+		lr.Attributes().PutStr("k8s.pod.name", "checkout-1")
+	})
+	require.NoError(t, exp.pushLogs(context.Background(), ld))
+
+	assert.Equal(t, []alert{{
+		Labels:       map[string]string{"alertname": "DiskFull", "severity": "critical", "service": "checkout"},
+		Annotations:  map[string]string{"description": "DiskFull", "pod": "checkout-1"},
+		StartsAt:     "2023-01-01T12:00:00Z",
+		EndsAt:       "2023-01-01T12:05:00Z",
+		GeneratorURL: "http://otelcol",
+	}}, received)
+}
+
+func TestLogsToAlertsEventAttribute(t *testing.T) {
+	exp := newTestExporter(t, "http://localhost:9093", func(cfg *Config) {
+		cfg.EventAttribute = "event.name"
+		cfg.ResolveTimeout = 0
+	})
+	ld := newTestLogs(
+		func(lr plog.LogRecord) {
+			lr.Body().SetStr("not an event")
+		},
+		func(lr plog.LogRecord) {
+			lr.Body().SetStr("the disk is full")
+			lr.Attributes().PutStr("event.name", "DiskFull")
+		},
+	)
+
+	assert.Equal(t, []alert{{
+		Labels:      map[string]string{"alertname": "DiskFull"},
+		Annotations: map[string]string{"description": "the disk is full"},
+		StartsAt:    "2023-01-01T12:00:00Z",
+	}}, exp.logsToAlerts(ld))
+}
+
+func TestLogsToAlertsDeduplication(t *testing.T) {
+	exp := newTestExporter(t, "http://localhost:9093", func(cfg *Config) {
+		cfg.DedupKeys = []string{"alertname"}
+	})
+	ld := newTestLogs(
+		func(lr plog.LogRecord) {
+			lr.Body().SetStr("DiskFull")
+			lr.SetSeverityText("warning")
+			lr.SetTimestamp(pcommon.NewTimestampFromTime(testTime.Add(time.Minute)))
+		},
+		func(lr plog.LogRecord) {
+			lr.Body().SetStr("DiskFull")
+			lr.SetSeverityText("critical")
+		},
+		func(lr plog.LogRecord) {
+			lr.Body().SetStr("HighLatency")
+		},
+	)
+
+	alerts := exp.logsToAlerts(ld)
+	require.Len(t, alerts, 2)
+	assert.Equal(t, map[string]string{"alertname": "DiskFull", "severity": "warning"}, alerts[0].Labels)
+	assert.Equal(t, "2023-01-01T12:01:00Z", alerts[0].StartsAt)
+	assert.Equal(t, map[string]string{"alertname": "HighLatency"}, alerts[1].Labels)
+}
+
+func TestPushLogsErrors(t *testing.T) {
+	tests := []struct {
+		name      string
+		status    int
+		permanent bool
+	}{
+		{name: "bad request", status: http.StatusBadRequest, permanent: true},
+		{name: "too many requests", status: http.StatusTooManyRequests, permanent: false},
+		{name: "internal error", status: http.StatusInternalServerError, permanent: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+			}))
+			defer server.Close()
+
+			exp := newTestExporter(t, server.URL, nil)
+			err := exp.pushLogs(context.Background(), newTestLogs(func(lr plog.LogRecord) {
+				lr.Body().SetStr("DiskFull")
+			}))
+			require.Error(t, err)
+			assert.Equal(t, tt.permanent, consumererror.IsPermanent(err))
+		})
+	}
+}
@@ -0,0 +1,79 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alertmanagerexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/alertmanagerexporter"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/exporter"
+	"go.opentelemetry.io/collector/exporter/exporterhelper"
+)
+
+const (
+	// The value of "type" key in configuration.
+	typeStr = "alertmanager"
+	// The stability level of the exporter.
+	stability = component.StabilityLevelDevelopment
+)
+
+// NewFactory creates a factory for the Alertmanager exporter.
+func NewFactory() exporter.Factory {
+	return exporter.NewFactory(
+		typeStr,
+		createDefaultConfig,
+		exporter.WithLogs(createLogsExporter, stability),
+	)
+}
+
+func createDefaultConfig() component.Config {
+	return &Config{
+		HTTPClientSettings: confighttp.HTTPClientSettings{
+			Endpoint: defaultEndpoint,
+			Timeout:  defaultTimeout,
+		},
+		QueueSettings: exporterhelper.NewDefaultQueueSettings(),
+		RetrySettings: exporterhelper.NewDefaultRetrySettings(),
+		Labels: map[string]string{
+			"alertname": "{{ .Event }}",
+			"severity":  "{{ .SeverityText }}",
+		},
+		Annotations: map[string]string{
+			"description": "{{ .Body }}",
+		},
+		ResolveTimeout: defaultResolveTimeout,
+	}
+}
+
+func createLogsExporter(ctx context.Context, set exporter.CreateSettings, c component.Config) (exporter.Logs, error) {
+	cfg := c.(*Config)
+	exp, err := newAlertmanagerExporter(cfg, set)
+	if err != nil {
+		return nil, err
+	}
+
+	return exporterhelper.NewLogsExporter(
+		ctx,
+		set,
+		cfg,
+		exp.pushLogs,
+		// explicitly disable since we rely on http.Client timeout logic.
+		exporterhelper.WithTimeout(exporterhelper.TimeoutSettings{Timeout: 0}),
+		exporterhelper.WithRetry(cfg.RetrySettings),
+		exporterhelper.WithQueue(cfg.QueueSettings),
+		exporterhelper.WithStart(exp.start),
+	)
+}
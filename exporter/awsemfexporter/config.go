@@ -60,6 +60,10 @@ type Config struct {
 	// MetricDeclarations is the list of rules to be used to set dimensions for exported metrics.
 	MetricDeclarations []*MetricDeclaration `mapstructure:"metric_declarations"`
 
+	// MetricDeclarationValidationBatches is the number of batches after which a warning is logged
+	// for every metric declaration that has not matched any metric yet. Zero disables the check.
+	MetricDeclarationValidationBatches int `mapstructure:"metric_declaration_validation_batches"`
+
 	// MetricDescriptors is the list of override metric descriptors that are sent to the CloudWatch
 	MetricDescriptors []MetricDescriptor `mapstructure:"metric_descriptors"`
 
@@ -119,6 +123,10 @@ func (config *Config) Validate() error {
 	}
 	config.MetricDescriptors = validDescriptors
 
+	if config.MetricDeclarationValidationBatches < 0 {
+		return errors.New("metric_declaration_validation_batches must not be negative")
+	}
+
 	if !isValidRetentionValue(config.LogRetention) {
 		return errors.New("invalid value for retention policy.  Please make sure to use the following values: 0 (Never Expire), 1, 3, 5, 7, 14, 30, 60, 90, 120, 150, 180, 365, 400, 545, 731, 1827, 2192, 2557, 2922, 3288, or 3653")
 	}
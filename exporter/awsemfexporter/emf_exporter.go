@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"strings"
 	"sync"
+	"sync/atomic"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -56,6 +57,9 @@ type emfExporter struct {
 	pusherMapLock sync.Mutex
 	retryCnt      int
 	collectorID   string
+
+	// pushedBatches is the number of batches pushed, used to validate metric declarations.
+	pushedBatches int64
 }
 
 // newEmfPusher func creates an EMF Exporter instance with data push callback func
@@ -144,6 +148,8 @@ func (emf *emfExporter) pushMetricsData(_ context.Context, md pmetric.Metrics) e
 		}
 	}
 
+	defer emf.validateMetricDeclarations(expConfig)
+
 	for _, groupedMetric := range groupedMetrics {
 		cWMetric := translateGroupedMetricToCWMetric(groupedMetric, expConfig)
 		putLogEvent := translateCWMetricToEMF(cWMetric, expConfig)
@@ -186,6 +192,28 @@ func (emf *emfExporter) pushMetricsData(_ context.Context, md pmetric.Metrics) e
 	return nil
 }
 
+// validateMetricDeclarations logs a warning, once, for each metric declaration that has not matched
+// any metric within the configured number of batches after startup.
+func (emf *emfExporter) validateMetricDeclarations(expConfig *Config) {
+	batches := expConfig.MetricDeclarationValidationBatches
+	if batches <= 0 || len(expConfig.MetricDeclarations) == 0 {
+		return
+	}
+	if atomic.AddInt64(&emf.pushedBatches, 1) != int64(batches) {
+		return
+	}
+	for i, declaration := range expConfig.MetricDeclarations {
+		if declaration.matchedMetricCount() == 0 {
+			emf.logger.Warn(
+				"Metric declaration did not match any metric",
+				zap.Int("index", i),
+				zap.Strings("metric_name_selectors", declaration.MetricNameSelectors),
+				zap.Int("batches", batches),
+			)
+		}
+	}
+}
+
 func (emf *emfExporter) getPusher(logGroup, logStream string) cwlogs.Pusher {
 	emf.pusherMapLock.Lock()
 	defer emf.pusherMapLock.Unlock()
@@ -613,6 +613,37 @@ func TestNewExporterWithMetricDeclarations(t *testing.T) {
 	assert.Equal(t, expectedLogs, logs.AllUntimed())
 }
 
+func TestValidateMetricDeclarations(t *testing.T) {
+	matched := &MetricDeclaration{MetricNameSelectors: []string{"a"}}
+	unmatched := &MetricDeclaration{MetricNameSelectors: []string{"b", "c"}}
+	matched.recordMatch()
+	expCfg := &Config{
+		MetricDeclarations:                 []*MetricDeclaration{matched, unmatched},
+		MetricDeclarationValidationBatches: 2,
+	}
+
+	obs, logs := observer.New(zap.WarnLevel)
+	emf := &emfExporter{logger: zap.New(obs)}
+
+	emf.validateMetricDeclarations(expCfg)
+	assert.Equal(t, 0, logs.Len())
+
+	emf.validateMetricDeclarations(expCfg)
+	expectedLogs := []observer.LoggedEntry{{
+		Entry: zapcore.Entry{Level: zap.WarnLevel, Message: "Metric declaration did not match any metric"},
+		Context: []zapcore.Field{
+			zap.Int("index", 1),
+			zap.Strings("metric_name_selectors", []string{"b", "c"}),
+			zap.Int("batches", 2),
+		},
+	}}
+	assert.Equal(t, expectedLogs, logs.AllUntimed())
+
+	// Declarations are only validated once.
+	emf.validateMetricDeclarations(expCfg)
+	assert.Equal(t, 1, logs.Len())
+}
+
 func TestNewExporterWithoutSession(t *testing.T) {
 	exp, err := newEmfPusher(nil, exportertest.NewNopCreateSettings())
 	assert.NotNil(t, err)
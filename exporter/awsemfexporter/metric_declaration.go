@@ -20,6 +20,7 @@ import (
 	"regexp"
 	"sort"
 	"strings"
+	"sync/atomic"
 
 	"go.uber.org/zap"
 )
@@ -30,7 +31,13 @@ type MetricDeclaration struct {
 	// Dimensions is a list of dimension sets (which are lists of dimension names) to be
 	// included in exported metrics. If the metric does not contain any of the specified
 	// dimensions, the metric would be dropped (will only show up in logs).
+	// A dimension set containing the wildcard "*" expands to every set made of its other
+	// dimensions plus at least one of the remaining labels of the metric, limited in size
+	// by MaxWildcardDimensions.
 	Dimensions [][]string `mapstructure:"dimensions"`
+	// (Optional) Maximum number of dimensions in a dimension set generated from a wildcard
+	// dimension set. (Default: 3, Maximum: 10)
+	MaxWildcardDimensions int `mapstructure:"max_wildcard_dimensions"`
 	// MetricNameSelectors is a list of regex strings to be matched against metric names
 	// to determine which metrics should be included with this metric declaration rule.
 	MetricNameSelectors []string `mapstructure:"metric_name_selectors"`
@@ -40,8 +47,21 @@ type MetricDeclaration struct {
 
 	// metricRegexList is a list of compiled regexes for metric name selectors.
 	metricRegexList []*regexp.Regexp
+	// wildcardDimensions holds the non-wildcard dimensions of each wildcard dimension set.
+	wildcardDimensions [][]string
+	// matchedMetrics is the number of metrics this declaration has matched so far.
+	matchedMetrics int64
 }
 
+const (
+	wildcardDimension            = "*"
+	defaultMaxWildcardDimensions = 3
+	maxDimensionSetSize          = 10
+	// maxWildcardDimensionSets bounds the number of dimension sets generated from a wildcard
+	// dimension set, each of them creating a CloudWatch metric.
+	maxWildcardDimensionSets = 30
+)
+
 // LabelMatcher defines a label filtering rule against the labels of incoming metrics. Only metrics that
 // match the rules will be used by the surrounding MetricDeclaration.
 type LabelMatcher struct {
@@ -84,12 +104,20 @@ func (m *MetricDeclaration) init(logger *zap.Logger) (err error) {
 		return errors.New("invalid metric declaration: no metric name selectors defined")
 	}
 
+	if m.MaxWildcardDimensions == 0 {
+		m.MaxWildcardDimensions = defaultMaxWildcardDimensions
+	}
+	if m.MaxWildcardDimensions < 0 || m.MaxWildcardDimensions > maxDimensionSetSize {
+		return errors.New("invalid metric declaration: max_wildcard_dimensions must be between 1 and 10")
+	}
+
 	// Filter out duplicate dimension sets and those with more than 10 elements
 	validDims := make([][]string, 0, len(m.Dimensions))
+	m.wildcardDimensions = nil
 	seen := make(map[string]bool, len(m.Dimensions))
 	for _, dimSet := range m.Dimensions {
 		concatenatedDims := strings.Join(dimSet, ",")
-		if len(dimSet) > 10 {
+		if len(dimSet) > maxDimensionSetSize {
 			logger.Warn("Dropped dimension set: > 10 dimensions specified.", zap.String("dimensions", concatenatedDims))
 			continue
 		}
@@ -103,13 +131,30 @@ func (m *MetricDeclaration) init(logger *zap.Logger) (err error) {
 		// Sort dimensions
 		sort.Strings(dedupedDims)
 
+		isWildcard := false
+		if idx := sort.SearchStrings(dedupedDims, wildcardDimension); idx < len(dedupedDims) && dedupedDims[idx] == wildcardDimension {
+			isWildcard = true
+			dedupedDims = append(append([]string{}, dedupedDims[:idx]...), dedupedDims[idx+1:]...)
+			if len(dedupedDims) >= m.MaxWildcardDimensions {
+				logger.Warn("Dropped wildcard dimension set: no room left for wildcard dimensions.", zap.String("dimensions", concatenatedDims))
+				continue
+			}
+		}
+
 		// Dedup dimension sets
 		key := strings.Join(dedupedDims, ",")
+		if isWildcard {
+			key = wildcardDimension + key
+		}
 		if _, ok := seen[key]; ok {
 			logger.Debug("Dropped dimension set: duplicated dimension set.", zap.String("dimensions", concatenatedDims))
 			continue
 		}
 		seen[key] = true
+		if isWildcard {
+			m.wildcardDimensions = append(m.wildcardDimensions, dedupedDims)
+			continue
+		}
 		validDims = append(validDims, dedupedDims)
 	}
 	m.Dimensions = validDims
@@ -171,9 +216,59 @@ func (m *MetricDeclaration) ExtractDimensions(labels map[string]string) (dimensi
 			dimensions = append(dimensions, dimensionSet)
 		}
 	}
+	for _, fixedDims := range m.wildcardDimensions {
+		dimensions = append(dimensions, m.expandWildcardDimensions(fixedDims, labels)...)
+	}
 	return
 }
 
+// expandWildcardDimensions returns the dimension sets made of the given fixed dimensions plus
+// every combination of the remaining labels, up to MaxWildcardDimensions dimensions per set.
+// At most maxWildcardDimensionSets sets are returned, the first ones in the lexical order of the labels.
+func (m *MetricDeclaration) expandWildcardDimensions(fixedDims []string, labels map[string]string) [][]string {
+	isFixed := make(map[string]bool, len(fixedDims))
+	for _, dim := range fixedDims {
+		if _, ok := labels[dim]; !ok {
+			return nil
+		}
+		isFixed[dim] = true
+	}
+
+	remaining := make([]string, 0, len(labels))
+	for label := range labels {
+		if !isFixed[label] {
+			remaining = append(remaining, label)
+		}
+	}
+	sort.Strings(remaining)
+
+	var dimensions [][]string
+	var expand func(start int, current []string)
+	expand = func(start int, current []string) {
+		for i := start; i < len(remaining) && len(dimensions) < maxWildcardDimensionSets; i++ {
+			next := append(append(make([]string, 0, len(current)+1), current...), remaining[i])
+			dimSet := append(append(make([]string, 0, len(next)), next...), fixedDims...)
+			sort.Strings(dimSet)
+			dimensions = append(dimensions, dimSet)
+			if len(dimSet) < m.MaxWildcardDimensions {
+				expand(i+1, next)
+			}
+		}
+	}
+	expand(0, nil)
+	return dimensions
+}
+
+// recordMatch counts a metric matched by this declaration.
+func (m *MetricDeclaration) recordMatch() {
+	atomic.AddInt64(&m.matchedMetrics, 1)
+}
+
+// matchedMetricCount returns the number of metrics matched by this declaration so far.
+func (m *MetricDeclaration) matchedMetricCount() int64 {
+	return atomic.LoadInt64(&m.matchedMetrics)
+}
+
 // init LabelMatcher with default values and compile regex string.
 func (lm *LabelMatcher) init() (err error) {
 	// Throw error if no label names are specified
@@ -309,6 +309,40 @@ func TestMetricDeclarationInit(t *testing.T) {
 		assert.Equal(t, expectedLogs, logs.AllUntimed())
 	})
 
+	// Test separation of wildcard dimension sets and removal of those without room for wildcard dimensions
+	t.Run("wildcard dimension sets", func(t *testing.T) {
+		m := &MetricDeclaration{
+			Dimensions: [][]string{
+				{"foo"},
+				{"*", "b", "a"},
+				{"a", "b", "*"},
+				{"a", "b", "c", "*"},
+			},
+			MetricNameSelectors: []string{"a.*"},
+		}
+		obs, logs := observer.New(zap.WarnLevel)
+		obsLogger := zap.New(obs)
+		err := m.init(obsLogger)
+		assert.Nil(t, err)
+		assert.Equal(t, defaultMaxWildcardDimensions, m.MaxWildcardDimensions)
+		assert.Equal(t, [][]string{{"foo"}}, m.Dimensions)
+		assert.Equal(t, [][]string{{"a", "b"}}, m.wildcardDimensions)
+		expectedLogs := []observer.LoggedEntry{{
+			Entry:   zapcore.Entry{Level: zap.WarnLevel, Message: "Dropped wildcard dimension set: no room left for wildcard dimensions."},
+			Context: []zapcore.Field{zap.String("dimensions", "a,b,c,*")},
+		}}
+		assert.Equal(t, expectedLogs, logs.AllUntimed())
+	})
+
+	t.Run("invalid max wildcard dimensions", func(t *testing.T) {
+		m := &MetricDeclaration{
+			MetricNameSelectors:   []string{"foo"},
+			MaxWildcardDimensions: 11,
+		}
+		err := m.init(logger)
+		assert.EqualError(t, err, "invalid metric declaration: max_wildcard_dimensions must be between 1 and 10")
+	})
+
 	// Test invalid metric declaration
 	t.Run("invalid metric declaration", func(t *testing.T) {
 		m := &MetricDeclaration{}
@@ -581,6 +615,65 @@ func TestExtractDimensions(t *testing.T) {
 			},
 			[][]string{{}},
 		},
+		{
+			"wildcard dimension set",
+			[][]string{{"*"}},
+			map[string]string{
+				"a": "foo",
+				"b": "bar",
+			},
+			[][]string{{"a"}, {"a", "b"}, {"b"}},
+		},
+		{
+			"wildcard dimension set with fixed dimensions",
+			[][]string{{"a", "*"}},
+			map[string]string{
+				"a": "foo",
+				"b": "bar",
+				"c": "baz",
+				"d": "qux",
+			},
+			[][]string{{"a", "b"}, {"a", "b", "c"}, {"a", "b", "d"}, {"a", "c"}, {"a", "c", "d"}, {"a", "d"}},
+		},
+		{
+			"wildcard dimension set with missing fixed dimension",
+			[][]string{{"a", "*"}},
+			map[string]string{
+				"b": "bar",
+			},
+			nil,
+		},
+		{
+			"wildcard dimension set without remaining labels",
+			[][]string{{"a", "*"}},
+			map[string]string{
+				"a": "foo",
+			},
+			nil,
+		},
+		{
+			"wildcard dimension set expansion is bounded",
+			[][]string{{"*"}},
+			map[string]string{
+				"a": "1", "b": "2", "c": "3", "d": "4", "e": "5",
+				"f": "6", "g": "7", "h": "8", "i": "9", "j": "10",
+			},
+			[][]string{
+				{"a"}, {"a", "b"}, {"a", "b", "c"}, {"a", "b", "d"}, {"a", "b", "e"}, {"a", "b", "f"}, {"a", "b", "g"},
+				{"a", "b", "h"}, {"a", "b", "i"}, {"a", "b", "j"}, {"a", "c"}, {"a", "c", "d"}, {"a", "c", "e"},
+				{"a", "c", "f"}, {"a", "c", "g"}, {"a", "c", "h"}, {"a", "c", "i"}, {"a", "c", "j"}, {"a", "d"},
+				{"a", "d", "e"}, {"a", "d", "f"}, {"a", "d", "g"}, {"a", "d", "h"}, {"a", "d", "i"}, {"a", "d", "j"},
+				{"a", "e"}, {"a", "e", "f"}, {"a", "e", "g"}, {"a", "e", "h"}, {"a", "e", "i"},
+			},
+		},
+		{
+			"wildcard dimension set with regular dimension set",
+			[][]string{{"*"}, {"a"}},
+			map[string]string{
+				"a": "foo",
+			},
+			[][]string{{"a"}, {"a"}},
+		},
 	}
 	logger := zap.NewNop()
 
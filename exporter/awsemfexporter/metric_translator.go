@@ -261,6 +261,12 @@ func groupedMetricToCWMeasurementsWithFilters(groupedMetric *groupedMetric, conf
 		return
 	}
 
+	// The labels are the same for all the metrics of the batch, and so are the dimensions of each declaration
+	declarationDims := make([][][]string, len(metricDeclarations))
+	for i, metricDeclaration := range metricDeclarations {
+		declarationDims[i] = metricDeclaration.ExtractDimensions(labels)
+	}
+
 	// Group metrics by matched metric declarations
 	type metricDeclarationGroup struct {
 		metricDeclIdxList []int
@@ -273,6 +279,10 @@ func groupedMetricToCWMeasurementsWithFilters(groupedMetric *groupedMetric, conf
 		var metricDeclIdx []int
 		for i, metricDeclaration := range metricDeclarations {
 			if metricDeclaration.MatchesName(metricName) {
+				// The metric is only exported with this declaration if some of its dimensions apply
+				if len(declarationDims[i]) > 0 {
+					metricDeclaration.recordMatch()
+				}
 				metricDeclIdx = append(metricDeclIdx, i)
 			}
 		}
@@ -315,8 +325,7 @@ func groupedMetricToCWMeasurementsWithFilters(groupedMetric *groupedMetric, conf
 		var dimensions [][]string
 		// Extract dimensions from matched metric declarations
 		for _, metricDeclIdx := range group.metricDeclIdxList {
-			dims := metricDeclarations[metricDeclIdx].ExtractDimensions(labels)
-			dimensions = append(dimensions, dims...)
+			dimensions = append(dimensions, declarationDims[metricDeclIdx]...)
 		}
 		dimensions = append(dimensions, rollupDimensionArray...)
 
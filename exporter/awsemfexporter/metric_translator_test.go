@@ -1293,6 +1293,36 @@ func TestGroupedMetricToCWMeasurement(t *testing.T) {
 	}
 }
 
+func TestGroupedMetricToCWMeasurementsWithFiltersRecordsMatches(t *testing.T) {
+	withDims := &MetricDeclaration{
+		Dimensions:          [][]string{{"a"}},
+		MetricNameSelectors: []string{"metric.*"},
+	}
+	// The metric names match, but the dimensions don't apply to the labels.
+	withoutDims := &MetricDeclaration{
+		Dimensions:          [][]string{{"b"}},
+		MetricNameSelectors: []string{"metric.*"},
+	}
+	for _, decl := range []*MetricDeclaration{withDims, withoutDims} {
+		assert.NoError(t, decl.init(zap.NewNop()))
+	}
+	config := &Config{
+		MetricDeclarations: []*MetricDeclaration{withDims, withoutDims},
+		logger:             zap.NewNop(),
+	}
+	groupedMetric := &groupedMetric{
+		labels: map[string]string{"a": "A"},
+		metrics: map[string]*metricInfo{
+			"metric1": {value: 1},
+			"metric2": {value: 2},
+		},
+	}
+
+	assert.Len(t, groupedMetricToCWMeasurementsWithFilters(groupedMetric, config), 1)
+	assert.Equal(t, int64(2), withDims.matchedMetricCount())
+	assert.Equal(t, int64(0), withoutDims.matchedMetricCount())
+}
+
 func TestGroupedMetricToCWMeasurementsWithFilters(t *testing.T) {
 	timestamp := int64(1596151098037)
 	namespace := "Namespace"
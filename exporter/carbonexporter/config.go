@@ -25,6 +25,14 @@ import (
 const (
 	DefaultEndpoint    = "localhost:2003"
 	DefaultSendTimeout = 5 * time.Second
+	DefaultBatchSize   = 500
+)
+
+const (
+	// plaintextEncoding sends one metric per line using the Carbon plaintext protocol.
+	plaintextEncoding = "plaintext"
+	// pickleEncoding sends batches of metrics using the Carbon pickle protocol.
+	pickleEncoding = "pickle"
 )
 
 // Config defines configuration for Carbon exporter.
@@ -38,6 +46,25 @@ type Config struct {
 	// data to the Carbon/Graphite backend.
 	// The default value is defined by the DefaultSendTimeout constant.
 	Timeout time.Duration `mapstructure:"timeout"`
+
+	// Encoding is the Carbon protocol used to send the metrics, either
+	// "plaintext" or "pickle". The default value is "plaintext".
+	Encoding string `mapstructure:"encoding"`
+
+	// BatchSize is the maximum number of metrics sent on each message when
+	// using the "pickle" encoding. The default value is defined by the
+	// DefaultBatchSize constant.
+	BatchSize int `mapstructure:"batch_size"`
+
+	// Tags configures which attributes are exported as Graphite tags.
+	Tags TagsConfig `mapstructure:"tags"`
+}
+
+// TagsConfig defines which attributes are exported as Graphite tags.
+type TagsConfig struct {
+	// Include is the list of attribute keys exported as tags, other attributes
+	// are dropped. If empty all attributes are exported as tags.
+	Include []string `mapstructure:"include"`
 }
 
 func (cfg *Config) Validate() error {
@@ -52,5 +79,15 @@ func (cfg *Config) Validate() error {
 		return errors.New("exporter requires a positive timeout")
 	}
 
+	switch cfg.Encoding {
+	case plaintextEncoding:
+	case pickleEncoding:
+		if cfg.BatchSize <= 0 {
+			return errors.New("exporter requires a positive batch_size for the pickle encoding")
+		}
+	default:
+		return fmt.Errorf("exporter has an invalid encoding %q, expected %q or %q", cfg.Encoding, plaintextEncoding, pickleEncoding)
+	}
+
 	return nil
 }
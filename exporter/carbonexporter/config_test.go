@@ -44,8 +44,22 @@ func TestLoadConfig(t *testing.T) {
 		{
 			id: component.NewIDWithName(typeStr, "allsettings"),
 			expected: &Config{
-				Endpoint: "localhost:8080",
-				Timeout:  10 * time.Second,
+				Endpoint:  "localhost:8080",
+				Timeout:   10 * time.Second,
+				Encoding:  "plaintext",
+				BatchSize: DefaultBatchSize,
+			},
+		},
+		{
+			id: component.NewIDWithName(typeStr, "pickle"),
+			expected: &Config{
+				Endpoint:  "localhost:2004",
+				Timeout:   DefaultSendTimeout,
+				Encoding:  "pickle",
+				BatchSize: 1000,
+				Tags: TagsConfig{
+					Include: []string{"host.name", "service.name"},
+				},
 			},
 		},
 	}
@@ -89,6 +103,22 @@ func TestValidateConfig(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "invalid_encoding",
+			config: &Config{
+				Endpoint: DefaultEndpoint,
+				Encoding: "json",
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid_pickle_batch_size",
+			config: &Config{
+				Endpoint: DefaultEndpoint,
+				Encoding: "pickle",
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -28,7 +28,10 @@ import (
 // newCarbonExporter returns a new Carbon exporter.
 func newCarbonExporter(cfg *Config, set exporter.CreateSettings) (exporter.Metrics, error) {
 	sender := carbonSender{
-		connPool: newTCPConnPool(cfg.Endpoint, cfg.Timeout),
+		connPool:  newTCPConnPool(cfg.Endpoint, cfg.Timeout),
+		encoding:  cfg.Encoding,
+		batchSize: cfg.BatchSize,
+		tags:      newTagFilter(cfg.Tags.Include),
 	}
 
 	return exporterhelper.NewMetricsExporter(
@@ -43,13 +46,21 @@ func newCarbonExporter(cfg *Config, set exporter.CreateSettings) (exporter.Metri
 // connections into an implementations of exporterhelper.PushMetricsData so
 // the exporter can leverage the helper and get consistent observability.
 type carbonSender struct {
-	connPool *connPool
+	connPool  *connPool
+	encoding  string
+	batchSize int
+	tags      tagFilter
 }
 
 func (cs *carbonSender) pushMetricsData(_ context.Context, md pmetric.Metrics) error {
-	lines := metricDataToPlaintext(md)
+	var data []byte
+	if cs.encoding == pickleEncoding {
+		data = metricDataToPickle(md, cs.tags, cs.batchSize)
+	} else {
+		data = []byte(metricDataToPlaintext(md, cs.tags))
+	}
 
-	if _, err := cs.connPool.Write([]byte(lines)); err != nil {
+	if _, err := cs.connPool.Write(data); err != nil {
 		// Use the sum of converted and dropped since the write failed for all.
 		return err
 	}
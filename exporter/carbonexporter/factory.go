@@ -38,8 +38,10 @@ func NewFactory() exporter.Factory {
 
 func createDefaultConfig() component.Config {
 	return &Config{
-		Endpoint: DefaultEndpoint,
-		Timeout:  DefaultSendTimeout,
+		Endpoint:  DefaultEndpoint,
+		Timeout:   DefaultSendTimeout,
+		Encoding:  plaintextEncoding,
+		BatchSize: DefaultBatchSize,
 	}
 }
 
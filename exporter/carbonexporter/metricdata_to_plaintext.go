@@ -78,15 +78,59 @@ const (
 // The returned values are:
 //   - a string concatenating all generated "lines" (each single one representing
 //     a single Carbon metric.
-//   - number of time series successfully converted to carbon.
-//   - number of time series that could not be converted to Carbon.
-func metricDataToPlaintext(md pmetric.Metrics) string {
+//
+// Only the attributes allowed by the tag filter are added as tags to the <path>.
+func metricDataToPlaintext(md pmetric.Metrics, tags tagFilter) string {
 	if md.DataPointCount() == 0 {
 		return ""
 	}
 
-	var sb strings.Builder
+	w := &plaintextWriter{}
+	writeMetricData(w, md, tags)
+	return w.sb.String()
+}
 
+// carbonWriter receives each Carbon metric generated from the metrics data and
+// encodes it according to the Carbon protocol in use.
+type carbonWriter interface {
+	writeMetric(path, value, timestamp string)
+}
+
+// plaintextWriter encodes the Carbon metrics per the plaintext protocol.
+type plaintextWriter struct {
+	sb strings.Builder
+}
+
+func (w *plaintextWriter) writeMetric(path, value, timestamp string) {
+	w.sb.WriteString(buildLine(path, value, timestamp))
+}
+
+// tagFilter holds the attribute keys allowed to be exported as Carbon tags, a
+// nil filter allows all attributes.
+type tagFilter map[string]struct{}
+
+func newTagFilter(include []string) tagFilter {
+	if len(include) == 0 {
+		return nil
+	}
+	tags := make(tagFilter, len(include))
+	for _, key := range include {
+		tags[key] = struct{}{}
+	}
+	return tags
+}
+
+func (f tagFilter) allowed(key string) bool {
+	if f == nil {
+		return true
+	}
+	_, ok := f[key]
+	return ok
+}
+
+// writeMetricData translates all data points in the metrics data to Carbon
+// metrics and passes them to the given writer.
+func writeMetricData(w carbonWriter, md pmetric.Metrics, tags tagFilter) {
 	for i := 0; i < md.ResourceMetrics().Len(); i++ {
 		rm := md.ResourceMetrics().At(i)
 		for j := 0; j < rm.ScopeMetrics().Len(); j++ {
@@ -99,22 +143,20 @@ func metricDataToPlaintext(md pmetric.Metrics) string {
 				}
 				switch metric.Type() {
 				case pmetric.MetricTypeGauge:
-					formatNumberDataPoints(&sb, metric.Name(), metric.Gauge().DataPoints())
+					formatNumberDataPoints(w, tags, metric.Name(), metric.Gauge().DataPoints())
 				case pmetric.MetricTypeSum:
-					formatNumberDataPoints(&sb, metric.Name(), metric.Sum().DataPoints())
+					formatNumberDataPoints(w, tags, metric.Name(), metric.Sum().DataPoints())
 				case pmetric.MetricTypeHistogram:
-					formatHistogramDataPoints(&sb, metric.Name(), metric.Histogram().DataPoints())
+					formatHistogramDataPoints(w, tags, metric.Name(), metric.Histogram().DataPoints())
 				case pmetric.MetricTypeSummary:
-					formatSummaryDataPoints(&sb, metric.Name(), metric.Summary().DataPoints())
+					formatSummaryDataPoints(w, tags, metric.Name(), metric.Summary().DataPoints())
 				}
 			}
 		}
 	}
-
-	return sb.String()
 }
 
-func formatNumberDataPoints(sb *strings.Builder, metricName string, dps pmetric.NumberDataPointSlice) {
+func formatNumberDataPoints(w carbonWriter, tags tagFilter, metricName string, dps pmetric.NumberDataPointSlice) {
 	for i := 0; i < dps.Len(); i++ {
 		dp := dps.At(i)
 		var valueStr string
@@ -124,12 +166,12 @@ func formatNumberDataPoints(sb *strings.Builder, metricName string, dps pmetric.
 		case pmetric.NumberDataPointValueTypeDouble:
 			valueStr = formatFloatForValue(dp.DoubleValue())
 		}
-		sb.WriteString(buildLine(buildPath(metricName, dp.Attributes()), valueStr, formatTimestamp(dp.Timestamp())))
+		w.writeMetric(buildPath(metricName, dp.Attributes(), tags), valueStr, formatTimestamp(dp.Timestamp()))
 	}
 }
 
 // formatHistogramDataPoints transforms a slice of histogram data points into a series
-// of Carbon metrics and injects them into the string builder.
+// of Carbon metrics and passes them to the writer.
 //
 // Carbon doesn't have direct support to distribution metrics they will be
 // translated into a series of Carbon metrics:
@@ -143,7 +185,8 @@ func formatNumberDataPoints(sb *strings.Builder, metricName string, dps pmetric.
 // that bucket. This metric specifies the number of events with a value that is
 // less than or equal to the upper bound.
 func formatHistogramDataPoints(
-	sb *strings.Builder,
+	w carbonWriter,
+	tags tagFilter,
 	metricName string,
 	dps pmetric.HistogramDataPointSlice,
 ) {
@@ -151,7 +194,7 @@ func formatHistogramDataPoints(
 		dp := dps.At(i)
 
 		timestampStr := formatTimestamp(dp.Timestamp())
-		formatCountAndSum(sb, metricName, dp.Attributes(), dp.Count(), dp.Sum(), timestampStr)
+		formatCountAndSum(w, tags, metricName, dp.Attributes(), dp.Count(), dp.Sum(), timestampStr)
 		if dp.ExplicitBounds().Len() == 0 {
 			continue
 		}
@@ -163,15 +206,15 @@ func formatHistogramDataPoints(
 		}
 		carbonBounds[len(carbonBounds)-1] = infinityCarbonValue
 
-		bucketPath := buildPath(metricName+distributionBucketSuffix, dp.Attributes())
+		bucketPath := buildPath(metricName+distributionBucketSuffix, dp.Attributes(), tags)
 		for j := 0; j < dp.BucketCounts().Len(); j++ {
-			sb.WriteString(buildLine(bucketPath+distributionUpperBoundTagBeforeValue+carbonBounds[j], formatUint64(dp.BucketCounts().At(j)), timestampStr))
+			w.writeMetric(bucketPath+distributionUpperBoundTagBeforeValue+carbonBounds[j], formatUint64(dp.BucketCounts().At(j)), timestampStr)
 		}
 	}
 }
 
 // formatSummaryDataPoints transforms a slice of summary data points into a series
-// of Carbon metrics and injects them into the string builder.
+// of Carbon metrics and passes them to the writer.
 //
 // Carbon doesn't have direct support to summary metrics they will be
 // translated into a series of Carbon metrics:
@@ -183,7 +226,8 @@ func formatHistogramDataPoints(
 // 3. Each quantile is represented by a metric named "<metricName>.quantile"
 // and will include a tag key "quantile" that specifies the quantile value.
 func formatSummaryDataPoints(
-	sb *strings.Builder,
+	w carbonWriter,
+	tags tagFilter,
 	metricName string,
 	dps pmetric.SummaryDataPointSlice,
 ) {
@@ -191,18 +235,18 @@ func formatSummaryDataPoints(
 		dp := dps.At(i)
 
 		timestampStr := formatTimestamp(dp.Timestamp())
-		formatCountAndSum(sb, metricName, dp.Attributes(), dp.Count(), dp.Sum(), timestampStr)
+		formatCountAndSum(w, tags, metricName, dp.Attributes(), dp.Count(), dp.Sum(), timestampStr)
 
 		if dp.QuantileValues().Len() == 0 {
 			continue
 		}
 
-		quantilePath := buildPath(metricName+summaryQuantileSuffix, dp.Attributes())
+		quantilePath := buildPath(metricName+summaryQuantileSuffix, dp.Attributes(), tags)
 		for j := 0; j < dp.QuantileValues().Len(); j++ {
-			sb.WriteString(buildLine(
+			w.writeMetric(
 				quantilePath+summaryQuantileTagBeforeValue+formatFloatForLabel(dp.QuantileValues().At(j).Quantile()*100),
 				formatFloatForValue(dp.QuantileValues().At(j).Value()),
-				timestampStr))
+				timestampStr)
 		}
 	}
 }
@@ -215,7 +259,8 @@ func formatSummaryDataPoints(
 //
 // 2. The total sum will be represented by a metruc with the original "<metricName>".
 func formatCountAndSum(
-	sb *strings.Builder,
+	w carbonWriter,
+	tags tagFilter,
 	metricName string,
 	attributes pcommon.Map,
 	count uint64,
@@ -223,17 +268,18 @@ func formatCountAndSum(
 	timestampStr string,
 ) {
 	// Build count and sum metrics.
-	countPath := buildPath(metricName+countSuffix, attributes)
+	countPath := buildPath(metricName+countSuffix, attributes, tags)
 	valueStr := formatUint64(count)
-	sb.WriteString(buildLine(countPath, valueStr, timestampStr))
+	w.writeMetric(countPath, valueStr, timestampStr)
 
-	sumPath := buildPath(metricName, attributes)
+	sumPath := buildPath(metricName, attributes, tags)
 	valueStr = formatFloatForValue(sum)
-	sb.WriteString(buildLine(sumPath, valueStr, timestampStr))
+	w.writeMetric(sumPath, valueStr, timestampStr)
 }
 
-// buildPath is used to build the <metric_path> per description above.
-func buildPath(name string, attributes pcommon.Map) string {
+// buildPath is used to build the <metric_path> per description above. Attributes
+// not allowed by the tag filter are not added to the path.
+func buildPath(name string, attributes pcommon.Map, tags tagFilter) string {
 	if attributes.Len() == 0 {
 		return name
 	}
@@ -242,6 +288,9 @@ func buildPath(name string, attributes pcommon.Map) string {
 	sb.WriteString(name)
 
 	attributes.Range(func(k string, v pcommon.Value) bool {
+		if !tags.allowed(k) {
+			return true
+		}
 		value := v.AsString()
 		if value == "" {
 			value = tagValueEmptyPlaceholder
@@ -84,6 +84,7 @@ func TestBuildPath(t *testing.T) {
 	tests := []struct {
 		name       string
 		attributes pcommon.Map
+		tags       tagFilter
 		want       string
 	}{
 		{
@@ -114,10 +115,31 @@ func TestBuildPath(t *testing.T) {
 			}(),
 			want: "int_value;k=1",
 		},
+		{
+			name: "included_tags",
+			attributes: func() pcommon.Map {
+				attr := pcommon.NewMap()
+				attr.PutStr("k0", "v0")
+				attr.PutStr("k1", "v1")
+				return attr
+			}(),
+			tags: newTagFilter([]string{"k1"}),
+			want: "included_tags;k1=v1",
+		},
+		{
+			name: "no_included_tags",
+			attributes: func() pcommon.Map {
+				attr := pcommon.NewMap()
+				attr.PutStr("k0", "v0")
+				return attr
+			}(),
+			tags: newTagFilter([]string{"k1"}),
+			want: "no_included_tags",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := buildPath(tt.name, tt.attributes)
+			got := buildPath(tt.name, tt.attributes, tt.tags)
 			assert.Equal(t, tt.want, got)
 		})
 	}
@@ -287,7 +309,7 @@ func TestToPlaintext(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			gotLines := metricDataToPlaintext(tt.metricsDataFn())
+			gotLines := metricDataToPlaintext(tt.metricsDataFn(), nil)
 			got := strings.Split(gotLines, "\n")
 			got = got[:len(got)-1]
 			assert.Equal(t, tt.wantLinesCount, len(got))
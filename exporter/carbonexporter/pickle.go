@@ -0,0 +1,130 @@
+// Copyright 2019, OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package carbonexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/carbonexporter"
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"strconv"
+
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+// Opcodes of the Python pickle protocol 2 used to encode the Carbon metrics,
+// see https://github.com/python/cpython/blob/main/Lib/pickletools.py.
+const (
+	pickleProto      = 0x80
+	pickleEmptyList  = ']'
+	pickleMark       = '('
+	pickleBinUnicode = 'X'
+	pickleBinFloat   = 'G'
+	pickleTuple2     = 0x86
+	pickleAppends    = 'e'
+	pickleStop       = '.'
+
+	pickleProtocolVersion = 2
+)
+
+// pickleMetric is a single Carbon metric to be encoded with the pickle protocol.
+type pickleMetric struct {
+	path      string
+	value     float64
+	timestamp float64
+}
+
+// pickleWriter accumulates the Carbon metrics to be encoded per the pickle protocol.
+type pickleWriter struct {
+	metrics []pickleMetric
+}
+
+func (w *pickleWriter) writeMetric(path, value, timestamp string) {
+	v, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return
+	}
+	ts, err := strconv.ParseFloat(timestamp, 64)
+	if err != nil {
+		return
+	}
+	w.metrics = append(w.metrics, pickleMetric{path: path, value: v, timestamp: ts})
+}
+
+// metricDataToPickle converts internal metrics data to the Carbon pickle format
+// as defined in https://graphite.readthedocs.io/en/latest/feeding-carbon.html#the-pickle-protocol.
+// The metric paths, including the tags, are built the same way as for the
+// plaintext protocol, see metricDataToPlaintext.
+//
+// The metrics are split in messages of at most batchSize metrics, each message
+// is a pickled list of (path, (timestamp, value)) tuples prefixed by its length
+// as a 4 bytes big-endian unsigned integer. The returned value concatenates
+// all messages.
+func metricDataToPickle(md pmetric.Metrics, tags tagFilter, batchSize int) []byte {
+	if md.DataPointCount() == 0 {
+		return nil
+	}
+
+	w := &pickleWriter{}
+	writeMetricData(w, md, tags)
+	if batchSize <= 0 {
+		batchSize = len(w.metrics)
+	}
+
+	var buf bytes.Buffer
+	for start := 0; start < len(w.metrics); start += batchSize {
+		end := start + batchSize
+		if end > len(w.metrics) {
+			end = len(w.metrics)
+		}
+		payload := pickleMetrics(w.metrics[start:end])
+
+		var header [4]byte
+		binary.BigEndian.PutUint32(header[:], uint32(len(payload)))
+		buf.Write(header[:])
+		buf.Write(payload)
+	}
+
+	return buf.Bytes()
+}
+
+// pickleMetrics encodes the metrics as a list of (path, (timestamp, value))
+// tuples using the pickle protocol 2.
+func pickleMetrics(metrics []pickleMetric) []byte {
+	var buf bytes.Buffer
+	buf.Write([]byte{pickleProto, pickleProtocolVersion, pickleEmptyList, pickleMark})
+	for _, m := range metrics {
+		writePickleString(&buf, m.path)
+		writePickleFloat(&buf, m.timestamp)
+		writePickleFloat(&buf, m.value)
+		buf.Write([]byte{pickleTuple2, pickleTuple2})
+	}
+	buf.Write([]byte{pickleAppends, pickleStop})
+	return buf.Bytes()
+}
+
+func writePickleString(buf *bytes.Buffer, s string) {
+	var size [4]byte
+	binary.LittleEndian.PutUint32(size[:], uint32(len(s)))
+	buf.WriteByte(pickleBinUnicode)
+	buf.Write(size[:])
+	buf.WriteString(s)
+}
+
+func writePickleFloat(buf *bytes.Buffer, f float64) {
+	var value [8]byte
+	binary.BigEndian.PutUint64(value[:], math.Float64bits(f))
+	buf.WriteByte(pickleBinFloat)
+	buf.Write(value[:])
+}
@@ -0,0 +1,93 @@
+// Copyright 2019, OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package carbonexporter
+
+import (
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+func TestToPickle(t *testing.T) {
+	// Expected messages were generated and validated with Python's pickle.loads,
+	// each one is prefixed by its length as a 4 bytes big-endian integer.
+	const (
+		// [("gauge;k1=v1", (1574092046.0, 5.0))]
+		gaugeMsg = "0000002a80025d28580b00000067617567653b6b313d76314741d774af438000004740140000000000008686652e"
+		// [("sum", (1574092046.0, 1.5))]
+		sumMsg = "0000002280025d28580300000073756d4741d774af43800000473ff80000000000008686652e"
+		// [("gauge;k1=v1", (1574092046.0, 5.0)), ("sum", (1574092046.0, 1.5))]
+		bothMsg = "0000004680025d28580b00000067617567653b6b313d76314741d774af438000004740140000000000008686580300000073756d4741d774af43800000473ff80000000000008686652e"
+	)
+
+	md := func() pmetric.Metrics {
+		ts := pcommon.NewTimestampFromTime(time.Unix(1574092046, 0))
+		md := pmetric.NewMetrics()
+		ms := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics()
+
+		gauge := ms.AppendEmpty()
+		gauge.SetName("gauge")
+		dp := gauge.SetEmptyGauge().DataPoints().AppendEmpty()
+		dp.Attributes().PutStr("k0", "v0")
+		dp.Attributes().PutStr("k1", "v1")
+		dp.SetTimestamp(ts)
+		dp.SetIntValue(5)
+
+		sum := ms.AppendEmpty()
+		sum.SetName("sum")
+		dp = sum.SetEmptySum().DataPoints().AppendEmpty()
+		dp.SetTimestamp(ts)
+		dp.SetDoubleValue(1.5)
+		return md
+	}
+
+	tests := []struct {
+		name      string
+		md        pmetric.Metrics
+		batchSize int
+		want      string
+	}{
+		{
+			name:      "empty",
+			md:        pmetric.NewMetrics(),
+			batchSize: DefaultBatchSize,
+		},
+		{
+			name:      "single_batch",
+			md:        md(),
+			batchSize: DefaultBatchSize,
+			want:      bothMsg,
+		},
+		{
+			name:      "multiple_batches",
+			md:        md(),
+			batchSize: 1,
+			want:      gaugeMsg + sumMsg,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want, err := hex.DecodeString(tt.want)
+			require.NoError(t, err)
+			got := metricDataToPickle(tt.md, newTagFilter([]string{"k1"}), tt.batchSize)
+			assert.Equal(t, hex.EncodeToString(want), hex.EncodeToString(got))
+		})
+	}
+}
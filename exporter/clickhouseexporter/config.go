@@ -18,10 +18,14 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
+	"regexp"
 	"strings"
 
 	"go.opentelemetry.io/collector/exporter/exporterhelper"
+	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.uber.org/multierr"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/clickhouseexporter/internal"
 )
 
 // Config defines configuration for Elastic exporter.
@@ -44,6 +48,29 @@ type Config struct {
 	MetricsTableName string `mapstructure:"metrics_table_name"`
 	// TTLDays is The data time-to-live in days, 0 means no ttl.
 	TTLDays uint `mapstructure:"ttl_days"`
+	// CreateSchema controls whether the exporter creates the database and the tables. default is true.
+	// Disable it when the schema is managed outside of the collector.
+	CreateSchema bool `mapstructure:"create_schema"`
+	// PartitionBy is the time granularity of the table partitions, one of `hour`, `day` or `month`. default is `day`.
+	PartitionBy string `mapstructure:"partition_by"`
+	// CompressionCodec is the codec used to compress the table columns. default is `ZSTD(1)`.
+	CompressionCodec string `mapstructure:"compression_codec"`
+	// AsyncInsert enables the asynchronous inserts of ClickHouse, which buffer the data on the server
+	// to write it in larger parts. The exporter still waits for the data to be written.
+	AsyncInsert bool `mapstructure:"async_insert"`
+	// AttributeColumns maps attributes of logs and spans into dedicated columns.
+	AttributeColumns []AttributeColumn `mapstructure:"attribute_columns"`
+}
+
+// AttributeColumn maps an attribute into a dedicated column of the logs and traces tables.
+type AttributeColumn struct {
+	// Name is the name of the column.
+	Name string `mapstructure:"name"`
+	// Attribute is the key of the attribute, looked up in the log record or span attributes
+	// and then in the resource attributes.
+	Attribute string `mapstructure:"attribute"`
+	// LowCardinality stores the column as LowCardinality(String) instead of String.
+	LowCardinality bool `mapstructure:"low_cardinality"`
 }
 
 // QueueSettings is a subset of exporterhelper.QueueSettings.
@@ -54,6 +81,13 @@ type QueueSettings struct {
 
 var (
 	errConfigNoDSN = errors.New("dsn must be specified")
+
+	partitionFuncs = map[string]string{
+		"hour":  "toStartOfHour",
+		"day":   "toDate",
+		"month": "toYYYYMM",
+	}
+	columnNameRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
 )
 
 // Validate validates the clickhouse server configuration.
@@ -65,6 +99,25 @@ func (cfg *Config) Validate() (err error) {
 	if e != nil {
 		err = multierr.Append(err, fmt.Errorf("invalid dsn format:%w", err))
 	}
+	if _, ok := partitionFuncs[cfg.PartitionBy]; !ok {
+		err = multierr.Append(err, fmt.Errorf("invalid partition_by %q, must be one of hour, day or month", cfg.PartitionBy))
+	}
+	if cfg.CompressionCodec == "" {
+		err = multierr.Append(err, errors.New("compression_codec must be specified"))
+	}
+	columns := make(map[string]struct{}, len(cfg.AttributeColumns))
+	for _, column := range cfg.AttributeColumns {
+		if !columnNameRegexp.MatchString(column.Name) {
+			err = multierr.Append(err, fmt.Errorf("invalid attribute column name %q", column.Name))
+		}
+		if _, ok := columns[column.Name]; ok {
+			err = multierr.Append(err, fmt.Errorf("duplicate attribute column %q", column.Name))
+		}
+		columns[column.Name] = struct{}{}
+		if column.Attribute == "" {
+			err = multierr.Append(err, fmt.Errorf("attribute of column %q must be specified", column.Name))
+		}
+	}
 	return err
 }
 
@@ -78,6 +131,68 @@ func parseDSNDatabase(dsn string) (string, error) {
 	return strings.TrimPrefix(u.Path, "/"), nil
 }
 
+// buildDSN returns the DSN with the settings of the asynchronous inserts when they are enabled.
+func (cfg *Config) buildDSN() (string, error) {
+	if !cfg.AsyncInsert {
+		return cfg.DSN, nil
+	}
+	u, err := url.Parse(cfg.DSN)
+	if err != nil {
+		return "", err
+	}
+	query := u.Query()
+	query.Set("async_insert", "1")
+	query.Set("wait_for_async_insert", "1")
+	u.RawQuery = query.Encode()
+	return u.String(), nil
+}
+
+func (cfg *Config) tableSettings() internal.TableSettings {
+	return internal.TableSettings{
+		PartitionFunc:    partitionFuncs[cfg.PartitionBy],
+		CompressionCodec: cfg.CompressionCodec,
+	}
+}
+
+// renderAttributeColumns renders the definitions of the attribute columns for a create table statement.
+func (cfg *Config) renderAttributeColumns() string {
+	var b strings.Builder
+	for _, column := range cfg.AttributeColumns {
+		columnType := "String"
+		if column.LowCardinality {
+			columnType = "LowCardinality(String)"
+		}
+		fmt.Fprintf(&b, "     %s %s CODEC(ZSTD(1)),\n", column.Name, columnType)
+	}
+	return b.String()
+}
+
+// renderAttributeColumnsInsert renders the names and the placeholders of the attribute columns for an insert statement.
+func (cfg *Config) renderAttributeColumnsInsert() (string, string) {
+	var names, placeholders strings.Builder
+	for _, column := range cfg.AttributeColumns {
+		names.WriteString(",\n                        " + column.Name)
+		placeholders.WriteString(",\n                                  ?")
+	}
+	return names.String(), placeholders.String()
+}
+
+// attributeColumnValues returns the values of the attribute columns, from the attributes of a log record or a span
+// and then from the resource attributes.
+func (cfg *Config) attributeColumnValues(attrs, resAttrs pcommon.Map) []interface{} {
+	values := make([]interface{}, len(cfg.AttributeColumns))
+	for i, column := range cfg.AttributeColumns {
+		var value string
+		if v, ok := attrs.Get(column.Attribute); ok {
+			value = v.AsString()
+		} else if v, ok := resAttrs.Get(column.Attribute); ok {
+			value = v.AsString()
+		}
+		values[i] = value
+	}
+	return values
+}
+
 func (cfg *Config) enforcedQueueSettings() exporterhelper.QueueSettings {
 	return exporterhelper.QueueSettings{
 		Enabled:      true,
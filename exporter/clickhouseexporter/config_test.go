@@ -51,6 +51,14 @@ func TestLoadConfig(t *testing.T) {
 			expected: &Config{
 				DSN:              defaultDSN,
 				TTLDays:          3,
+				CreateSchema:     false,
+				PartitionBy:      "hour",
+				CompressionCodec: "LZ4",
+				AsyncInsert:      true,
+				AttributeColumns: []AttributeColumn{
+					{Name: "HttpStatusCode", Attribute: "http.status_code"},
+					{Name: "K8sNamespace", Attribute: "k8s.namespace.name", LowCardinality: true},
+				},
 				LogsTableName:    "otel_logs",
 				TracesTableName:  "otel_traces",
 				MetricsTableName: "otel_metrics",
@@ -85,6 +93,75 @@ func TestLoadConfig(t *testing.T) {
 	}
 }
 
+func TestConfig_Validate(t *testing.T) {
+	tests := map[string]struct {
+		config *Config
+		err    string
+	}{
+		"valid": {
+			config: withDefaultConfig(func(cfg *Config) {
+				cfg.DSN = defaultDSN
+				cfg.AttributeColumns = []AttributeColumn{{Name: "HttpStatusCode", Attribute: "http.status_code"}}
+			}),
+		},
+		"invalid partition_by": {
+			config: withDefaultConfig(func(cfg *Config) {
+				cfg.DSN = defaultDSN
+				cfg.PartitionBy = "week"
+			}),
+			err: `invalid partition_by "week", must be one of hour, day or month`,
+		},
+		"no compression_codec": {
+			config: withDefaultConfig(func(cfg *Config) {
+				cfg.DSN = defaultDSN
+				cfg.CompressionCodec = ""
+			}),
+			err: "compression_codec must be specified",
+		},
+		"invalid attribute columns": {
+			config: withDefaultConfig(func(cfg *Config) {
+				cfg.DSN = defaultDSN
+				cfg.AttributeColumns = []AttributeColumn{
+					{Name: "http.status_code", Attribute: "http.status_code"},
+					{Name: "Namespace", Attribute: "k8s.namespace.name"},
+					{Name: "Namespace"},
+				}
+			}),
+			err: `invalid attribute column name "http.status_code"; duplicate attribute column "Namespace"; attribute of column "Namespace" must be specified`,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tt.config.Validate()
+			if tt.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.err)
+			}
+		})
+	}
+}
+
+func TestConfig_buildDSN(t *testing.T) {
+	cfg := withDefaultConfig(func(cfg *Config) {
+		cfg.DSN = defaultDSN
+	})
+	dsn, err := cfg.buildDSN()
+	require.NoError(t, err)
+	assert.Equal(t, defaultDSN, dsn)
+
+	cfg.AsyncInsert = true
+	dsn, err = cfg.buildDSN()
+	require.NoError(t, err)
+	assert.Equal(t, defaultDSN+"?async_insert=1&wait_for_async_insert=1", dsn)
+
+	cfg.DSN = defaultDSN + "?dial_timeout=200ms"
+	dsn, err = cfg.buildDSN()
+	require.NoError(t, err)
+	assert.Equal(t, defaultDSN+"?async_insert=1&dial_timeout=200ms&wait_for_async_insert=1", dsn)
+}
+
 func withDefaultConfig(fns ...func(*Config)) *Config {
 	cfg := createDefaultConfig().(*Config)
 	for _, fn := range fns {
@@ -49,8 +49,10 @@ func newLogsExporter(logger *zap.Logger, cfg *Config) (*logsExporter, error) {
 		return nil, err
 	}
 
-	if err = createLogsTable(cfg, client); err != nil {
-		return nil, err
+	if cfg.CreateSchema {
+		if err = createLogsTable(cfg, client); err != nil {
+			return nil, err
+		}
 	}
 
 	return &logsExporter{
@@ -92,7 +94,7 @@ func (e *logsExporter) pushLogsData(ctx context.Context, ld plog.Logs) error {
 				for k := 0; k < rs.Len(); k++ {
 					r := rs.At(k)
 					logAttr := attributesToMap(r.Attributes())
-					_, err = statement.ExecContext(ctx,
+					values := []interface{}{
 						r.Timestamp().AsTime(),
 						traceutil.TraceIDToHexOrEmptyString(r.TraceID()),
 						traceutil.SpanIDToHexOrEmptyString(r.SpanID()),
@@ -103,7 +105,9 @@ func (e *logsExporter) pushLogsData(ctx context.Context, ld plog.Logs) error {
 						r.Body().AsString(),
 						resAttr,
 						logAttr,
-					)
+					}
+					values = append(values, e.cfg.attributeColumnValues(r.Attributes(), res.Attributes())...)
+					_, err = statement.ExecContext(ctx, values...)
 					if err != nil {
 						return fmt.Errorf("ExecContext:%w", err)
 					}
@@ -141,7 +145,7 @@ CREATE TABLE IF NOT EXISTS %s (
      Body String CODEC(ZSTD(1)),
      ResourceAttributes Map(LowCardinality(String), String) CODEC(ZSTD(1)),
      LogAttributes Map(LowCardinality(String), String) CODEC(ZSTD(1)),
-     INDEX idx_trace_id TraceId TYPE bloom_filter(0.001) GRANULARITY 1,
+%s     INDEX idx_trace_id TraceId TYPE bloom_filter(0.001) GRANULARITY 1,
      INDEX idx_res_attr_key mapKeys(ResourceAttributes) TYPE bloom_filter(0.01) GRANULARITY 1,
      INDEX idx_res_attr_value mapValues(ResourceAttributes) TYPE bloom_filter(0.01) GRANULARITY 1,
      INDEX idx_log_attr_key mapKeys(LogAttributes) TYPE bloom_filter(0.01) GRANULARITY 1,
@@ -164,7 +168,7 @@ SETTINGS index_granularity=8192, ttl_only_drop_parts = 1;
                         ServiceName,
                         Body,
                         ResourceAttributes,
-                        LogAttributes
+                        LogAttributes%s
                         ) VALUES (
                                   ?,
                                   ?,
@@ -175,7 +179,7 @@ SETTINGS index_granularity=8192, ttl_only_drop_parts = 1;
                                   ?,
                                   ?,
                                   ?,
-                                  ?
+                                  ?%s
                                   )`
 )
 
@@ -183,10 +187,17 @@ var driverName = "clickhouse" // for testing
 
 // newClickhouseClient create a clickhouse client.
 func newClickhouseClient(cfg *Config) (*sql.DB, error) {
-	return sql.Open(driverName, cfg.DSN)
+	dsn, err := cfg.buildDSN()
+	if err != nil {
+		return nil, err
+	}
+	return sql.Open(driverName, dsn)
 }
 
 func createDatabase(cfg *Config) error {
+	if !cfg.CreateSchema {
+		return nil
+	}
 	database, _ := parseDSNDatabase(cfg.DSN)
 	if database == defaultDatabase {
 		return nil
@@ -220,11 +231,12 @@ func renderCreateLogsTableSQL(cfg *Config) string {
 	if cfg.TTLDays > 0 {
 		ttlExpr = fmt.Sprintf(`TTL toDateTime(Timestamp) + toIntervalDay(%d)`, cfg.TTLDays)
 	}
-	return fmt.Sprintf(createLogsTableSQL, cfg.LogsTableName, ttlExpr)
+	return cfg.tableSettings().Apply(fmt.Sprintf(createLogsTableSQL, cfg.LogsTableName, cfg.renderAttributeColumns(), ttlExpr))
 }
 
 func renderInsertLogsSQL(cfg *Config) string {
-	return fmt.Sprintf(insertLogsSQLTemplate, cfg.LogsTableName)
+	columns, placeholders := cfg.renderAttributeColumnsInsert()
+	return fmt.Sprintf(insertLogsSQLTemplate, cfg.LogsTableName, columns, placeholders)
 }
 
 func doWithTx(_ context.Context, db *sql.DB, fn func(tx *sql.Tx) error) error {
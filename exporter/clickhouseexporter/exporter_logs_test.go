@@ -101,6 +101,67 @@ func TestExporter_pushLogsData(t *testing.T) {
 	})
 }
 
+func TestExporter_pushLogsDataWithAttributeColumns(t *testing.T) {
+	var insertSQL string
+	var insertValues []driver.Value
+	initClickhouseTestServer(t, func(query string, values []driver.Value) error {
+		if strings.HasPrefix(query, "INSERT") {
+			insertSQL = query
+			insertValues = values
+		}
+		return nil
+	})
+
+	exporter := newTestLogsExporter(t, defaultDSN, func(cfg *Config) {
+		cfg.AttributeColumns = []AttributeColumn{
+			{Name: "HttpStatusCode", Attribute: "http.status_code"},
+			{Name: "K8sNamespace", Attribute: "k8s.namespace.name", LowCardinality: true},
+			{Name: "Missing", Attribute: "missing"},
+		}
+	})
+	logs := simpleLogs(1)
+	logs.ResourceLogs().At(0).Resource().Attributes().PutStr("k8s.namespace.name", "default")
+	logs.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes().PutInt("http.status_code", 200)
+	mustPushLogsData(t, exporter, logs)
+
+	require.Contains(t, insertSQL, "LogAttributes,\n                        HttpStatusCode,\n                        K8sNamespace,\n                        Missing\n")
+	require.Equal(t, 13, strings.Count(insertSQL, "?"))
+	require.Equal(t, []driver.Value{"200", "default", ""}, insertValues[10:])
+}
+
+func TestExporter_createSchema(t *testing.T) {
+	var queries []string
+	initClickhouseTestServer(t, func(query string, values []driver.Value) error {
+		queries = append(queries, query)
+		return nil
+	})
+
+	newTestLogsExporter(t, defaultDSN, func(cfg *Config) {
+		cfg.CreateSchema = false
+	})
+	require.Empty(t, queries)
+
+	newTestLogsExporter(t, defaultDSN)
+	require.Len(t, queries, 2)
+	require.Equal(t, "CREATE DATABASE IF NOT EXISTS otel", queries[0])
+	require.Contains(t, queries[1], "CREATE TABLE IF NOT EXISTS otel_logs")
+}
+
+func TestRenderCreateLogsTableSQL(t *testing.T) {
+	query := renderCreateLogsTableSQL(withDefaultConfig(func(cfg *Config) {
+		cfg.PartitionBy = "hour"
+		cfg.CompressionCodec = "LZ4"
+		cfg.AttributeColumns = []AttributeColumn{
+			{Name: "K8sNamespace", Attribute: "k8s.namespace.name", LowCardinality: true},
+		}
+	}))
+	require.Contains(t, query, "Timestamp DateTime64(9) CODEC(Delta, LZ4),")
+	require.Contains(t, query, "     K8sNamespace LowCardinality(String) CODEC(LZ4),\n     INDEX idx_trace_id")
+	require.Contains(t, query, "PARTITION BY toStartOfHour(Timestamp)")
+	require.Contains(t, query, "TTL toDateTime(Timestamp) + toIntervalDay(7)")
+	require.NotContains(t, query, "ZSTD")
+}
+
 func newTestLogsExporter(t *testing.T, dsn string, fns ...func(*Config)) *logsExporter {
 	exporter, err := newLogsExporter(zaptest.NewLogger(t), withTestExporterConfig(fns...)(dsn))
 	require.NoError(t, err)
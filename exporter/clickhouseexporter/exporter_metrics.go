@@ -46,8 +46,10 @@ func newMetricsExporter(logger *zap.Logger, cfg *Config) (*metricsExporter, erro
 	}
 
 	internal.SetLogger(logger)
-	if err = internal.NewMetricsTable(cfg.MetricsTableName, cfg.TTLDays, client); err != nil {
-		return nil, err
+	if cfg.CreateSchema {
+		if err = internal.NewMetricsTable(cfg.MetricsTableName, cfg.TTLDays, cfg.tableSettings(), client); err != nil {
+			return nil, err
+		}
 	}
 
 	return &metricsExporter{
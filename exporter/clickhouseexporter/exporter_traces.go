@@ -48,8 +48,10 @@ func newTracesExporter(logger *zap.Logger, cfg *Config) (*tracesExporter, error)
 		return nil, err
 	}
 
-	if err = createTracesTable(cfg, client); err != nil {
-		return nil, err
+	if cfg.CreateSchema {
+		if err = createTracesTable(cfg, client); err != nil {
+			return nil, err
+		}
 	}
 
 	return &tracesExporter{
@@ -94,7 +96,7 @@ func (e *tracesExporter) pushTraceData(ctx context.Context, td ptrace.Traces) er
 					status := r.Status()
 					eventTimes, eventNames, eventAttrs := convertEvents(r.Events())
 					linksTraceIDs, linksSpanIDs, linksTraceStates, linksAttrs := convertLinks(r.Links())
-					_, err = statement.ExecContext(ctx,
+					values := []interface{}{
 						r.StartTimestamp().AsTime(),
 						traceutil.TraceIDToHexOrEmptyString(r.TraceID()),
 						traceutil.SpanIDToHexOrEmptyString(r.SpanID()),
@@ -115,7 +117,9 @@ func (e *tracesExporter) pushTraceData(ctx context.Context, td ptrace.Traces) er
 						linksSpanIDs,
 						linksTraceStates,
 						linksAttrs,
-					)
+					}
+					values = append(values, e.cfg.attributeColumnValues(r.Attributes(), res.Attributes())...)
+					_, err = statement.ExecContext(ctx, values...)
 					if err != nil {
 						return fmt.Errorf("ExecContext:%w", err)
 					}
@@ -179,7 +183,7 @@ CREATE TABLE IF NOT EXISTS %s (
      Duration Int64 CODEC(ZSTD(1)),
      StatusCode LowCardinality(String) CODEC(ZSTD(1)),
      StatusMessage String CODEC(ZSTD(1)),
-     Events Nested (
+%s     Events Nested (
          Timestamp DateTime64(9),
          Name LowCardinality(String),
          Attributes Map(LowCardinality(String), String)
@@ -223,7 +227,7 @@ SETTINGS index_granularity=8192, ttl_only_drop_parts = 1;
                         Links.TraceId,
                         Links.SpanId,
                         Links.TraceState,
-                        Links.Attributes
+                        Links.Attributes%s
                         ) VALUES (
                                   ?,
                                   ?,
@@ -244,7 +248,7 @@ SETTINGS index_granularity=8192, ttl_only_drop_parts = 1;
                                   ?,
                                   ?,
                                   ?,
-                                  ?
+                                  ?%s
                                   )`
 )
 
@@ -288,7 +292,8 @@ func createTracesTable(cfg *Config, db *sql.DB) error {
 }
 
 func renderInsertTracesSQL(cfg *Config) string {
-	return fmt.Sprintf(strings.ReplaceAll(insertTracesSQLTemplate, "'", "`"), cfg.TracesTableName)
+	columns, placeholders := cfg.renderAttributeColumnsInsert()
+	return fmt.Sprintf(strings.ReplaceAll(insertTracesSQLTemplate, "'", "`"), cfg.TracesTableName, columns, placeholders)
 }
 
 func renderCreateTracesTableSQL(cfg *Config) string {
@@ -296,7 +301,7 @@ func renderCreateTracesTableSQL(cfg *Config) string {
 	if cfg.TTLDays > 0 {
 		ttlExpr = fmt.Sprintf(`TTL toDateTime(Timestamp) + toIntervalDay(%d)`, cfg.TTLDays)
 	}
-	return fmt.Sprintf(createTracesTableSQL, cfg.TracesTableName, ttlExpr)
+	return cfg.tableSettings().Apply(fmt.Sprintf(createTracesTableSQL, cfg.TracesTableName, cfg.renderAttributeColumns(), ttlExpr))
 }
 
 func renderCreateTraceIDTsTableSQL(cfg *Config) string {
@@ -304,7 +309,7 @@ func renderCreateTraceIDTsTableSQL(cfg *Config) string {
 	if cfg.TTLDays > 0 {
 		ttlExpr = fmt.Sprintf(`TTL toDateTime(Start) + toIntervalDay(%d)`, cfg.TTLDays)
 	}
-	return fmt.Sprintf(createTraceIDTsTableSQL, cfg.TracesTableName, ttlExpr)
+	return cfg.tableSettings().Apply(fmt.Sprintf(createTraceIDTsTableSQL, cfg.TracesTableName, ttlExpr))
 }
 
 func renderTraceIDTsMaterializedViewSQL(cfg *Config) string {
@@ -47,6 +47,36 @@ func TestExporter_pushTracesData(t *testing.T) {
 	})
 }
 
+func TestExporter_pushTracesDataWithAttributeColumns(t *testing.T) {
+	var insertValues []driver.Value
+	initClickhouseTestServer(t, func(query string, values []driver.Value) error {
+		if strings.HasPrefix(query, "INSERT") {
+			insertValues = values
+		}
+		return nil
+	})
+
+	exporter := newTestTracesExporter(t, defaultDSN, func(cfg *Config) {
+		cfg.AttributeColumns = []AttributeColumn{{Name: "ServiceVersion", Attribute: conventions.AttributeServiceVersion}}
+	})
+	traces := simpleTraces(1)
+	traces.ResourceSpans().At(0).Resource().Attributes().PutStr(conventions.AttributeServiceVersion, "1.0.0")
+	mustPushTracesData(t, exporter, traces)
+
+	require.Len(t, insertValues, 21)
+	require.Equal(t, "1.0.0", insertValues[20])
+}
+
+func TestRenderCreateTracesTableSQL(t *testing.T) {
+	cfg := withDefaultConfig(func(cfg *Config) {
+		cfg.PartitionBy = "month"
+		cfg.AttributeColumns = []AttributeColumn{{Name: "ServiceVersion", Attribute: conventions.AttributeServiceVersion}}
+	})
+	query := renderCreateTracesTableSQL(cfg)
+	require.Contains(t, query, "     ServiceVersion String CODEC(ZSTD(1)),\n     Events Nested")
+	require.Contains(t, query, "PARTITION BY toYYYYMM(Timestamp)")
+}
+
 func newTestTracesExporter(t *testing.T, dsn string, fns ...func(*Config)) *tracesExporter {
 	exporter, err := newTracesExporter(zaptest.NewLogger(t), withTestExporterConfig(fns...)(dsn))
 	require.NoError(t, err)
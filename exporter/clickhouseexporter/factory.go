@@ -50,6 +50,9 @@ func createDefaultConfig() component.Config {
 		TracesTableName:  "otel_traces",
 		MetricsTableName: "otel_metrics",
 		TTLDays:          7,
+		CreateSchema:     true,
+		PartitionBy:      "day",
+		CompressionCodec: "ZSTD(1)",
 	}
 }
 
@@ -61,14 +61,34 @@ func SetLogger(l *zap.Logger) {
 	logger = l
 }
 
+// TableSettings configures the partitioning and the compression of the created tables.
+type TableSettings struct {
+	// PartitionFunc is the function applied to the time column to partition a table, e.g. toDate.
+	PartitionFunc string
+	// CompressionCodec is the codec used to compress the columns.
+	CompressionCodec string
+}
+
+// Apply applies the settings to a create table statement using the default `toDate` partitions
+// and `ZSTD(1)` codec.
+func (s TableSettings) Apply(query string) string {
+	if s.PartitionFunc != "" {
+		query = strings.ReplaceAll(query, "PARTITION BY toDate(", "PARTITION BY "+s.PartitionFunc+"(")
+	}
+	if s.CompressionCodec != "" {
+		query = strings.ReplaceAll(query, "ZSTD(1)", s.CompressionCodec)
+	}
+	return query
+}
+
 // NewMetricsTable create metric tables with an expiry time to storage metric telemetry data
-func NewMetricsTable(tableName string, ttlDays uint, db *sql.DB) error {
+func NewMetricsTable(tableName string, ttlDays uint, settings TableSettings, db *sql.DB) error {
 	var ttlExpr string
 	if ttlDays > 0 {
 		ttlExpr = fmt.Sprintf(`TTL toDateTime(TimeUnix) + toIntervalDay(%d)`, ttlDays)
 	}
 	for table := range supportedMetricTypes {
-		query := fmt.Sprintf(table, tableName, ttlExpr)
+		query := settings.Apply(fmt.Sprintf(table, tableName, ttlExpr))
 		if _, err := db.Exec(query); err != nil {
 			return fmt.Errorf("exec create metrics table sql: %w", err)
 		}
@@ -15,6 +15,7 @@
 package internal
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
@@ -237,3 +238,13 @@ func Test_newPlaceholder(t *testing.T) {
 	expectStr := "(?,?,?,?,?),"
 	require.Equal(t, newPlaceholder(5), &expectStr)
 }
+
+func TestTableSettings_Apply(t *testing.T) {
+	query := fmt.Sprintf(createGaugeTableSQL, "otel_metrics", "")
+	require.Equal(t, query, TableSettings{}.Apply(query))
+
+	query = TableSettings{PartitionFunc: "toStartOfHour", CompressionCodec: "LZ4HC(9)"}.Apply(query)
+	require.Contains(t, query, "TimeUnix DateTime64(9) CODEC(Delta, LZ4HC(9)),")
+	require.Contains(t, query, "PARTITION BY toStartOfHour(TimeUnix)")
+	require.NotContains(t, query, "ZSTD")
+}
@@ -26,6 +26,8 @@ import (
 	"go.opentelemetry.io/collector/pdata/plog/plogotlp"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/grpccompression"
 )
 
 func newLogsExporter(cfg component.Config, set exp.CreateSettings) (*logsExporter, error) {
@@ -55,7 +57,9 @@ type logsExporter struct {
 }
 
 func (e *logsExporter) start(ctx context.Context, host component.Host) (err error) {
-	if e.clientConn, err = e.config.Logs.ToClientConn(ctx, host, e.settings, grpc.WithUserAgent(e.userAgent)); err != nil {
+	negotiator := grpccompression.NewNegotiator(string(e.config.Logs.Compression), e.settings.Logger)
+	dialOpts := append([]grpc.DialOption{grpc.WithUserAgent(e.userAgent)}, negotiator.DialOptions()...)
+	if e.clientConn, err = e.config.Logs.ToClientConn(ctx, host, e.settings, dialOpts...); err != nil {
 		return err
 	}
 
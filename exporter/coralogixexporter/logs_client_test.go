@@ -0,0 +1,102 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package coralogixexporter
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config/configcompression"
+	"go.opentelemetry.io/collector/config/configtls"
+	"go.opentelemetry.io/collector/exporter/exportertest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/plog/plogotlp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/stats"
+)
+
+type mockLogsServer struct{}
+
+func (s *mockLogsServer) Export(context.Context, plogotlp.ExportRequest) (plogotlp.ExportResponse, error) {
+	return plogotlp.NewExportResponse(), nil
+}
+
+// compressionRecorder records the compression of the requests received by the server.
+type compressionRecorder struct {
+	mu           sync.Mutex
+	compressions []string
+}
+
+func (r *compressionRecorder) TagRPC(ctx context.Context, _ *stats.RPCTagInfo) context.Context {
+	return ctx
+}
+
+func (r *compressionRecorder) HandleRPC(_ context.Context, s stats.RPCStats) {
+	if header, ok := s.(*stats.InHeader); ok {
+		r.mu.Lock()
+		r.compressions = append(r.compressions, header.Compression)
+		r.mu.Unlock()
+	}
+}
+
+func (r *compressionRecorder) TagConn(ctx context.Context, _ *stats.ConnTagInfo) context.Context {
+	return ctx
+}
+
+func (r *compressionRecorder) HandleConn(context.Context, stats.ConnStats) {}
+
+func TestLogsExporterCompression(t *testing.T) {
+	// configgrpc registers the snappy and zstd compressors, so that they can be used by the
+	// exporters and decompressed by the server.
+	for _, compression := range []configcompression.CompressionType{configcompression.Snappy, configcompression.Zstd} {
+		t.Run(string(compression), func(t *testing.T) {
+			ln, err := net.Listen("tcp", "localhost:0")
+			require.NoError(t, err)
+			recorder := &compressionRecorder{}
+			srv := grpc.NewServer(grpc.StatsHandler(recorder))
+			plogotlp.RegisterGRPCServer(srv, &mockLogsServer{})
+			go func() {
+				_ = srv.Serve(ln)
+			}()
+			defer srv.Stop()
+
+			cfg := NewFactory().CreateDefaultConfig().(*Config)
+			cfg.Logs.Endpoint = ln.Addr().String()
+			cfg.Logs.TLSSetting = configtls.TLSClientSetting{Insecure: true}
+			cfg.Logs.Compression = compression
+			cfg.PrivateKey = "token"
+
+			exp, err := newLogsExporter(cfg, exportertest.NewNopCreateSettings())
+			require.NoError(t, err)
+			require.NoError(t, exp.start(context.Background(), componenttest.NewNopHost()))
+			defer func() {
+				assert.NoError(t, exp.shutdown(context.Background()))
+			}()
+
+			ld := plog.NewLogs()
+			ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().Body().SetStr("log")
+			require.NoError(t, exp.pushLogs(context.Background(), ld))
+
+			recorder.mu.Lock()
+			defer recorder.mu.Unlock()
+			assert.Equal(t, []string{string(compression)}, recorder.compressions)
+		})
+	}
+}
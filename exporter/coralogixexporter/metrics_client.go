@@ -32,6 +32,8 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/grpccompression"
 )
 
 func newMetricsExporter(cfg component.Config, set exp.CreateSettings) (*exporter, error) {
@@ -61,7 +63,9 @@ type exporter struct {
 }
 
 func (e *exporter) start(ctx context.Context, host component.Host) (err error) {
-	if e.clientConn, err = e.config.Metrics.ToClientConn(ctx, host, e.settings, grpc.WithUserAgent(e.userAgent)); err != nil {
+	negotiator := grpccompression.NewNegotiator(string(e.config.Metrics.Compression), e.settings.Logger)
+	dialOpts := append([]grpc.DialOption{grpc.WithUserAgent(e.userAgent)}, negotiator.DialOptions()...)
+	if e.clientConn, err = e.config.Metrics.ToClientConn(ctx, host, e.settings, dialOpts...); err != nil {
 		return err
 	}
 
@@ -26,6 +26,8 @@ import (
 	"go.opentelemetry.io/collector/pdata/ptrace/ptraceotlp"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/grpccompression"
 )
 
 type tracesExporter struct {
@@ -58,7 +60,9 @@ func newTracesExporter(cfg component.Config, set exp.CreateSettings) (*tracesExp
 }
 
 func (e *tracesExporter) start(ctx context.Context, host component.Host) (err error) {
-	if e.clientConn, err = e.config.Traces.ToClientConn(ctx, host, e.settings, grpc.WithUserAgent(e.userAgent)); err != nil {
+	negotiator := grpccompression.NewNegotiator(string(e.config.Traces.Compression), e.settings.Logger)
+	dialOpts := append([]grpc.DialOption{grpc.WithUserAgent(e.userAgent)}, negotiator.DialOptions()...)
+	if e.clientConn, err = e.config.Traces.ToClientConn(ctx, host, e.settings, dialOpts...); err != nil {
 		return err
 	}
 
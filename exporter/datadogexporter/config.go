@@ -28,6 +28,7 @@ import (
 	"go.opentelemetry.io/collector/exporter/exporterhelper"
 	"go.uber.org/multierr"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/datadogexporter/internal/logs"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/datadogexporter/internal/metadata/valid"
 )
 
@@ -35,6 +36,8 @@ var (
 	errUnsetAPIKey   = errors.New("api.key is not set")
 	errNoMetadata    = errors.New("only_metadata can't be enabled when host_metadata::enabled = false or host_metadata::hostname_source != first_resource")
 	errEmptyEndpoint = errors.New("endpoint cannot be empty")
+
+	errEmptyRemappingKey = errors.New("logs::remapping attribute keys cannot be empty")
 )
 
 const (
@@ -252,6 +255,56 @@ type LogsConfig struct {
 
 	// DumpPayloads report whether payloads should be dumped when logging level is debug.
 	DumpPayloads bool `mapstructure:"dump_payloads"`
+
+	// Remapping defines how OTLP attributes are remapped to Datadog reserved attributes.
+	Remapping LogsRemappingConfig `mapstructure:"remapping"`
+}
+
+// LogsRemappingConfig defines how OTLP attributes are remapped to the Datadog reserved attributes.
+type LogsRemappingConfig struct {
+	// Profile is the set of built-in remappings, one of `datadog` (the remappings done by the
+	// Datadog backend, e.g. `level` to `status`) or `otel` (attributes are sent as is).
+	Profile string `mapstructure:"profile"`
+
+	// Status is the list of attribute keys to use as the log status, the first one found is used.
+	Status []string `mapstructure:"status"`
+
+	// Service is the list of attribute keys to use as the service, the first one found is used.
+	Service []string `mapstructure:"service"`
+
+	// Source is the list of attribute keys to use as the log source, the first one found is used.
+	Source []string `mapstructure:"ddsource"`
+
+	// Tags is the list of attribute keys holding comma separated tags added to the log,
+	// the first one found is used.
+	Tags []string `mapstructure:"ddtags"`
+}
+
+func (r LogsRemappingConfig) validate() error {
+	switch r.Profile {
+	case "", logs.RemappingProfileDatadog, logs.RemappingProfileOTel:
+	default:
+		return fmt.Errorf("%q is not a valid logs remapping profile, valid profiles are %q and %q",
+			r.Profile, logs.RemappingProfileDatadog, logs.RemappingProfileOTel)
+	}
+	for _, keys := range [][]string{r.Status, r.Service, r.Source, r.Tags} {
+		for _, key := range keys {
+			if key == "" {
+				return errEmptyRemappingKey
+			}
+		}
+	}
+	return nil
+}
+
+func (r LogsRemappingConfig) toRemapping() logs.Remapping {
+	return logs.Remapping{
+		Profile: r.Profile,
+		Status:  r.Status,
+		Service: r.Service,
+		Source:  r.Source,
+		Tags:    r.Tags,
+	}
 }
 
 // TagsConfig defines the tag-related configuration
@@ -407,6 +460,10 @@ func (c *Config) Validate() error {
 		return err
 	}
 
+	if err = c.Logs.Remapping.validate(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -101,6 +101,33 @@ func TestValidate(t *testing.T) {
 			},
 			err: "'nobuckets' mode and `send_count_sum_metrics` set to false will send no histogram metrics",
 		},
+		{
+			name: "logs remapping valid",
+			cfg: &Config{
+				API: APIConfig{Key: "notnull"},
+				Logs: LogsConfig{Remapping: LogsRemappingConfig{
+					Profile: "otel",
+					Status:  []string{"log.level"},
+					Source:  []string{"log.source"},
+				}},
+			},
+		},
+		{
+			name: "logs remapping unknown profile",
+			cfg: &Config{
+				API:  APIConfig{Key: "notnull"},
+				Logs: LogsConfig{Remapping: LogsRemappingConfig{Profile: "unknown"}},
+			},
+			err: `"unknown" is not a valid logs remapping profile, valid profiles are "datadog" and "otel"`,
+		},
+		{
+			name: "logs remapping empty key",
+			cfg: &Config{
+				API:  APIConfig{Key: "notnull"},
+				Logs: LogsConfig{Remapping: LogsRemappingConfig{Service: []string{""}}},
+			},
+			err: errEmptyRemappingKey.Error(),
+		},
 		{
 			name: "TLS settings are valid",
 			cfg: &Config{
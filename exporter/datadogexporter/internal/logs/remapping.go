@@ -0,0 +1,77 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logs // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/datadogexporter/internal/logs"
+
+import (
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+const (
+	// RemappingProfileDatadog remaps the well known attributes the same way the Datadog backend
+	// does, e.g. the `level` attribute is used as the log status.
+	RemappingProfileDatadog = "datadog"
+	// RemappingProfileOTel only uses the OpenTelemetry log record fields and semantic conventions,
+	// all the attributes are sent as is.
+	RemappingProfileOTel = "otel"
+)
+
+// Remapping defines how the OTLP attributes are remapped to the Datadog reserved attributes.
+type Remapping struct {
+	// Profile is the set of built-in remappings to apply, RemappingProfileDatadog if empty.
+	Profile string
+	// Status, Service, Source and Tags are the attribute keys remapped to the corresponding
+	// reserved attribute, the first one found is used. They take precedence over the profile.
+	Status  []string
+	Service []string
+	Source  []string
+	Tags    []string
+}
+
+// remappedValues holds the values of the reserved attributes found using the configured keys.
+type remappedValues struct {
+	status  string
+	service string
+	source  string
+	tags    string
+	// keys are the log attributes which were remapped and must not be sent as attributes.
+	keys map[string]struct{}
+}
+
+func (r Remapping) builtin() bool {
+	return r.Profile != RemappingProfileOTel
+}
+
+func (r Remapping) lookup(logAttrs pcommon.Map, resourceAttrs pcommon.Map) remappedValues {
+	values := remappedValues{keys: map[string]struct{}{}}
+	values.status = values.find(r.Status, logAttrs, resourceAttrs)
+	values.service = values.find(r.Service, logAttrs, resourceAttrs)
+	values.source = values.find(r.Source, logAttrs, resourceAttrs)
+	values.tags = values.find(r.Tags, logAttrs, resourceAttrs)
+	return values
+}
+
+// find returns the value of the first key found in the log attributes, then in the resource attributes.
+func (v *remappedValues) find(keys []string, logAttrs pcommon.Map, resourceAttrs pcommon.Map) string {
+	for _, key := range keys {
+		if val, ok := logAttrs.Get(key); ok {
+			v.keys[key] = struct{}{}
+			return val.AsString()
+		}
+		if val, ok := resourceAttrs.Get(key); ok {
+			return val.AsString()
+		}
+	}
+	return ""
+}
@@ -65,8 +65,13 @@ const otelTag = "otel_source:datadog_exporter"
 
 // Transform converts the log record in lr, which came in with the resource in res to a Datadog log item.
 // the variable specifies if the log body should be sent as an attribute or as a plain message.
-func Transform(lr plog.LogRecord, res pcommon.Resource, logger *zap.Logger) datadogV2.HTTPLogItem {
+// The remapping defines which attributes are used as the Datadog reserved attributes.
+func Transform(lr plog.LogRecord, res pcommon.Resource, remapping Remapping, logger *zap.Logger) datadogV2.HTTPLogItem {
 	host, service := extractHostNameAndServiceName(res.Attributes(), lr.Attributes())
+	remapped := remapping.lookup(lr.Attributes(), res.Attributes())
+	if remapped.service != "" {
+		service = remapped.service
+	}
 
 	l := datadogV2.HTTPLogItem{
 		AdditionalProperties: make(map[string]string),
@@ -82,6 +87,13 @@ func Transform(lr plog.LogRecord, res pcommon.Resource, logger *zap.Logger) data
 	// AdditionalProperties are treated as Datadog Log Attributes
 	var status string
 	lr.Attributes().Range(func(k string, v pcommon.Value) bool {
+		if _, ok := remapped.keys[k]; ok {
+			return true
+		}
+		if !remapping.builtin() {
+			l.AdditionalProperties[k] = v.AsString()
+			return true
+		}
 		switch strings.ToLower(k) {
 		// set of remapping are taken from Datadog Backend
 		case "msg", "message", "log":
@@ -130,6 +142,13 @@ func Transform(lr plog.LogRecord, res pcommon.Resource, logger *zap.Logger) data
 		l.AdditionalProperties[otelSpanID] = hex.EncodeToString(spanID[:])
 	}
 
+	if remapped.status != "" {
+		status = remapped.status
+	}
+	if remapped.source != "" {
+		l.Ddsource = datadog.PtrString(remapped.source)
+	}
+
 	// we want to use the serverity that client has set on the log and let Datadog backend
 	// decide the appropriate level
 	if lr.SeverityText() != "" {
@@ -161,6 +180,9 @@ func Transform(lr plog.LogRecord, res pcommon.Resource, logger *zap.Logger) data
 		tagStr := strings.Join(tags, ",")
 		l.Ddtags = datadog.PtrString(tagStr)
 	}
+	if remapped.tags != "" {
+		l.Ddtags = datadog.PtrString(l.GetDdtags() + "," + remapped.tags)
+	}
 
 	return l
 }
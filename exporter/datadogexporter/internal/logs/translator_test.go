@@ -21,6 +21,7 @@ import (
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV2"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/plog"
 	conventions "go.opentelemetry.io/collector/semconv/v1.6.1"
@@ -354,7 +355,7 @@ func TestTransform(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := Transform(tt.args.lr, tt.args.res, testLogger)
+			got := Transform(tt.args.lr, tt.args.res, Remapping{}, testLogger)
 
 			gs, err := got.MarshalJSON()
 			if err != nil {
@@ -373,6 +374,69 @@ func TestTransform(t *testing.T) {
 	}
 }
 
+func TestTransformRemapping(t *testing.T) {
+	testLogger := zaptest.NewLogger(t)
+	newLogRecord := func() plog.LogRecord {
+		l := plog.NewLogRecord()
+		l.Attributes().PutStr("log.level", "warning")
+		l.Attributes().PutStr("level", "info")
+		l.Attributes().PutStr("app", "test")
+		l.Attributes().PutStr("team", "team:backend")
+		return l
+	}
+	res := pcommon.NewResource()
+	res.Attributes().PutStr("log.source", "nginx")
+
+	tests := []struct {
+		name      string
+		remapping Remapping
+		want      datadogV2.HTTPLogItem
+	}{
+		{
+			name: "custom keys on datadog profile",
+			remapping: Remapping{
+				Status:  []string{"log.level"},
+				Service: []string{"missing", "app"},
+				Source:  []string{"log.source"},
+				Tags:    []string{"team"},
+			},
+			want: datadogV2.HTTPLogItem{
+				Ddtags:   datadog.PtrString("otel_source:datadog_exporter,team:backend"),
+				Ddsource: datadog.PtrString("nginx"),
+				Service:  datadog.PtrString("test"),
+				AdditionalProperties: map[string]string{
+					"status": "warning",
+				},
+			},
+		},
+		{
+			name:      "otel profile",
+			remapping: Remapping{Profile: RemappingProfileOTel},
+			want: datadogV2.HTTPLogItem{
+				Ddtags: datadog.PtrString("otel_source:datadog_exporter"),
+				AdditionalProperties: map[string]string{
+					"log.level": "warning",
+					"level":     "info",
+					"app":       "test",
+					"team":      "team:backend",
+					"status":    "",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Transform(newLogRecord(), res, tt.remapping, testLogger)
+
+			gs, err := got.MarshalJSON()
+			require.NoError(t, err)
+			ws, err := tt.want.MarshalJSON()
+			require.NoError(t, err)
+			assert.JSONEq(t, string(ws), string(gs))
+		})
+	}
+}
+
 func TestDeriveStatus(t *testing.T) {
 	type args struct {
 		severity plog.SeverityNumber
@@ -39,6 +39,7 @@ type logsExporter struct {
 	sender         *logs.Sender
 	onceMetadata   *sync.Once
 	sourceProvider source.Provider
+	remapping      logs.Remapping
 }
 
 // newLogsExporter creates a new instance of logsExporter
@@ -72,6 +73,7 @@ func newLogsExporter(ctx context.Context, params exporter.CreateSettings, cfg *C
 		onceMetadata:   onceMetadata,
 		scrubber:       scrub.NewScrubber(),
 		sourceProvider: sourceProvider,
+		remapping:      cfg.Logs.Remapping.toRemapping(),
 	}, nil
 }
 
@@ -105,7 +107,7 @@ func (exp *logsExporter) consumeLogs(ctx context.Context, ld plog.Logs) (err err
 			// iterate over Logs
 			for k := 0; k < lsl.Len(); k++ {
 				log := lsl.At(k)
-				payload = append(payload, logs.Transform(log, res, exp.params.Logger))
+				payload = append(payload, logs.Transform(log, res, exp.remapping, exp.params.Logger))
 			}
 		}
 	}
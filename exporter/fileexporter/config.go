@@ -44,6 +44,19 @@ type Config struct {
 	// Compression Codec used to export telemetry data
 	// Supported compression algorithms:`zstd`
 	Compression string `mapstructure:"compression"`
+
+	// SplitByResource writes each ResourceSpans, ResourceMetrics and ResourceLogs
+	// as its own encoded object, i.e. one line per resource with the json format.
+	SplitByResource bool `mapstructure:"split_by_resource"`
+
+	// PartitionByResourceAttribute is the resource attribute whose value is
+	// inserted before the extension of Path to name the file the data is written to.
+	// Resources without this attribute are written to Path.
+	PartitionByResourceAttribute string `mapstructure:"partition_by_resource_attribute"`
+
+	// MaxOpenFiles is the maximum number of partition files kept open at once. The least
+	// recently written file is closed when a new one must be opened.
+	MaxOpenFiles int `mapstructure:"max_open_files"`
 }
 
 // Rotation an option to rolling log files
@@ -82,6 +95,9 @@ func (cfg *Config) Validate() error {
 	if cfg.Compression != "" && cfg.Compression != compressionZSTD {
 		return errors.New("compression is not supported")
 	}
+	if cfg.PartitionByResourceAttribute != "" && cfg.MaxOpenFiles <= 0 {
+		return errors.New("max_open_files must be positive")
+	}
 	return nil
 }
 
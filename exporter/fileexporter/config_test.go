@@ -45,7 +45,8 @@ func TestLoadConfig(t *testing.T) {
 					MaxBackups:   3,
 					LocalTime:    true,
 				},
-				FormatType: formatTypeJSON,
+				FormatType:   formatTypeJSON,
+				MaxOpenFiles: defaultMaxOpenFiles,
 			},
 		},
 		{
@@ -58,15 +59,17 @@ func TestLoadConfig(t *testing.T) {
 					MaxBackups:   3,
 					LocalTime:    true,
 				},
-				FormatType:  formatTypeProto,
-				Compression: compressionZSTD,
+				FormatType:   formatTypeProto,
+				MaxOpenFiles: defaultMaxOpenFiles,
+				Compression:  compressionZSTD,
 			},
 		},
 		{
 			id: component.NewIDWithName(typeStr, "rotation_with_default_settings"),
 			expected: &Config{
-				Path:       "./foo",
-				FormatType: formatTypeJSON,
+				Path:         "./foo",
+				FormatType:   formatTypeJSON,
+				MaxOpenFiles: defaultMaxOpenFiles,
 				Rotation: &Rotation{
 					MaxBackups: defaultMaxBackups,
 				},
@@ -80,7 +83,18 @@ func TestLoadConfig(t *testing.T) {
 					MaxMegabytes: 1234,
 					MaxBackups:   defaultMaxBackups,
 				},
-				FormatType: formatTypeJSON,
+				FormatType:   formatTypeJSON,
+				MaxOpenFiles: defaultMaxOpenFiles,
+			},
+		},
+		{
+			id: component.NewIDWithName(typeStr, "split_by_resource"),
+			expected: &Config{
+				Path:                         "./foo.json",
+				FormatType:                   formatTypeJSON,
+				SplitByResource:              true,
+				PartitionByResourceAttribute: "service.name",
+				MaxOpenFiles:                 10,
 			},
 		},
 		{
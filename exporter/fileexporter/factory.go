@@ -18,6 +18,7 @@ import (
 	"context"
 	"io"
 	"os"
+	"time"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/exporter"
@@ -36,6 +37,9 @@ const (
 	// the number of old log files to retain
 	defaultMaxBackups = 100
 
+	// the maximum number of partition files kept open
+	defaultMaxOpenFiles = 100
+
 	// the format of encoded telemetry data
 	formatTypeJSON  = "json"
 	formatTypeProto = "proto"
@@ -56,8 +60,9 @@ func NewFactory() exporter.Factory {
 
 func createDefaultConfig() component.Config {
 	return &Config{
-		FormatType: formatTypeJSON,
-		Rotation:   &Rotation{MaxBackups: defaultMaxBackups},
+		FormatType:   formatTypeJSON,
+		Rotation:     &Rotation{MaxBackups: defaultMaxBackups},
+		MaxOpenFiles: defaultMaxOpenFiles,
 	}
 }
 
@@ -133,6 +138,7 @@ func createLogsExporter(
 func newFileExporter(conf *Config, writer io.WriteCloser) *fileExporter {
 	return &fileExporter{
 		path:             conf.Path,
+		rotation:         conf.Rotation,
 		formatType:       conf.FormatType,
 		file:             writer,
 		tracesMarshaler:  tracesMarshalers[conf.FormatType],
@@ -141,23 +147,36 @@ func newFileExporter(conf *Config, writer io.WriteCloser) *fileExporter {
 		exporter:         buildExportFunc(conf),
 		compression:      conf.Compression,
 		compressor:       buildCompressor(conf.Compression),
+		splitByResource:  conf.SplitByResource,
+		partitionBy:      conf.PartitionByResourceAttribute,
+		maxOpenFiles:     conf.MaxOpenFiles,
+		now:              time.Now,
 	}
 }
 
 func buildFileWriter(cfg *Config) (io.WriteCloser, error) {
-	if cfg.Rotation == nil {
-		f, err := os.OpenFile(cfg.Path, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0600)
+	return openFileWriter(cfg.Path, cfg.Rotation, false)
+}
+
+// openFileWriter opens the file at path, truncating it unless appending is requested.
+func openFileWriter(path string, rotation *Rotation, appending bool) (io.WriteCloser, error) {
+	if rotation == nil {
+		flag := os.O_RDWR | os.O_CREATE | os.O_TRUNC
+		if appending {
+			flag = os.O_WRONLY | os.O_CREATE | os.O_APPEND
+		}
+		f, err := os.OpenFile(path, flag, 0600)
 		if err != nil {
 			return nil, err
 		}
 		return newBufferedWriteCloser(f), nil
 	}
 	return &lumberjack.Logger{
-		Filename:   cfg.Path,
-		MaxSize:    cfg.Rotation.MaxMegabytes,
-		MaxAge:     cfg.Rotation.MaxDays,
-		MaxBackups: cfg.Rotation.MaxBackups,
-		LocalTime:  cfg.Rotation.LocalTime,
+		Filename:   path,
+		MaxSize:    rotation.MaxMegabytes,
+		MaxAge:     rotation.MaxDays,
+		MaxBackups: rotation.MaxBackups,
+		LocalTime:  rotation.LocalTime,
 	}, nil
 }
 
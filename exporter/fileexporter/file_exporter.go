@@ -15,16 +15,22 @@
 package fileexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/fileexporter"
 
 import (
+	"container/list"
 	"context"
 	"encoding/binary"
 	"io"
+	"path/filepath"
+	"strings"
 	"sync"
+	"time"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/multierr"
 )
 
 // Marshaler configuration used for marhsaling Protobuf
@@ -41,14 +47,18 @@ var logsMarshalers = map[string]plog.Marshaler{
 	formatTypeProto: &plog.ProtoMarshaler{},
 }
 
+// partitionIdleTimeout is the time after which a partition file that wasn't written to is closed.
+const partitionIdleTimeout = time.Minute
+
 // exportFunc defines how to export encoded telemetry data.
-type exportFunc func(e *fileExporter, buf []byte) error
+type exportFunc func(w io.Writer, buf []byte) error
 
 // fileExporter is the implementation of file exporter that writes telemetry data to a file
 type fileExporter struct {
-	path  string
-	file  io.WriteCloser
-	mutex sync.Mutex
+	path     string
+	rotation *Rotation
+	file     io.WriteCloser
+	mutex    sync.Mutex
 
 	tracesMarshaler  ptrace.Marshaler
 	metricsMarshaler pmetric.Marshaler
@@ -59,6 +69,27 @@ type fileExporter struct {
 
 	formatType string
 	exporter   exportFunc
+
+	splitByResource bool
+	partitionBy     string
+	maxOpenFiles    int
+	// partitions indexes the elements of openFiles by value of the partitionBy attribute.
+	partitions map[string]*list.Element
+	// openFiles holds the open partition files, from the least to the most recently written.
+	openFiles *list.List
+
+	// now is replaced in tests.
+	now func() time.Time
+	// done is closed on Shutdown to stop closing the idle partition files.
+	done chan struct{}
+	wg   sync.WaitGroup
+}
+
+// partitionFile is an open partition file.
+type partitionFile struct {
+	partition string
+	writer    io.WriteCloser
+	lastWrite time.Time
 }
 
 func (e *fileExporter) Capabilities() consumer.Capabilities {
@@ -66,70 +97,267 @@ func (e *fileExporter) Capabilities() consumer.Capabilities {
 }
 
 func (e *fileExporter) ConsumeTraces(_ context.Context, td ptrace.Traces) error {
+	if !e.splitByResource && e.partitionBy == "" {
+		return e.exportTraces("", td)
+	}
+	var errs error
+	batches := make(map[string]ptrace.Traces)
+	for i := 0; i < td.ResourceSpans().Len(); i++ {
+		rs := td.ResourceSpans().At(i)
+		partition := e.partition(rs.Resource())
+		if e.splitByResource {
+			single := ptrace.NewTraces()
+			rs.CopyTo(single.ResourceSpans().AppendEmpty())
+			errs = multierr.Append(errs, e.exportTraces(partition, single))
+			continue
+		}
+		batch, ok := batches[partition]
+		if !ok {
+			batch = ptrace.NewTraces()
+			batches[partition] = batch
+		}
+		rs.CopyTo(batch.ResourceSpans().AppendEmpty())
+	}
+	for partition, batch := range batches {
+		errs = multierr.Append(errs, e.exportTraces(partition, batch))
+	}
+	return errs
+}
+
+func (e *fileExporter) exportTraces(partition string, td ptrace.Traces) error {
 	buf, err := e.tracesMarshaler.MarshalTraces(td)
 	if err != nil {
 		return err
 	}
 	buf = e.compressor(buf)
-	return e.exporter(e, buf)
+	return e.write(partition, buf)
 }
 
 func (e *fileExporter) ConsumeMetrics(_ context.Context, md pmetric.Metrics) error {
+	if !e.splitByResource && e.partitionBy == "" {
+		return e.exportMetrics("", md)
+	}
+	var errs error
+	batches := make(map[string]pmetric.Metrics)
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		rm := md.ResourceMetrics().At(i)
+		partition := e.partition(rm.Resource())
+		if e.splitByResource {
+			single := pmetric.NewMetrics()
+			rm.CopyTo(single.ResourceMetrics().AppendEmpty())
+			errs = multierr.Append(errs, e.exportMetrics(partition, single))
+			continue
+		}
+		batch, ok := batches[partition]
+		if !ok {
+			batch = pmetric.NewMetrics()
+			batches[partition] = batch
+		}
+		rm.CopyTo(batch.ResourceMetrics().AppendEmpty())
+	}
+	for partition, batch := range batches {
+		errs = multierr.Append(errs, e.exportMetrics(partition, batch))
+	}
+	return errs
+}
+
+func (e *fileExporter) exportMetrics(partition string, md pmetric.Metrics) error {
 	buf, err := e.metricsMarshaler.MarshalMetrics(md)
 	if err != nil {
 		return err
 	}
 	buf = e.compressor(buf)
-	return e.exporter(e, buf)
+	return e.write(partition, buf)
 }
 
 func (e *fileExporter) ConsumeLogs(_ context.Context, ld plog.Logs) error {
+	if !e.splitByResource && e.partitionBy == "" {
+		return e.exportLogs("", ld)
+	}
+	var errs error
+	batches := make(map[string]plog.Logs)
+	for i := 0; i < ld.ResourceLogs().Len(); i++ {
+		rl := ld.ResourceLogs().At(i)
+		partition := e.partition(rl.Resource())
+		if e.splitByResource {
+			single := plog.NewLogs()
+			rl.CopyTo(single.ResourceLogs().AppendEmpty())
+			errs = multierr.Append(errs, e.exportLogs(partition, single))
+			continue
+		}
+		batch, ok := batches[partition]
+		if !ok {
+			batch = plog.NewLogs()
+			batches[partition] = batch
+		}
+		rl.CopyTo(batch.ResourceLogs().AppendEmpty())
+	}
+	for partition, batch := range batches {
+		errs = multierr.Append(errs, e.exportLogs(partition, batch))
+	}
+	return errs
+}
+
+func (e *fileExporter) exportLogs(partition string, ld plog.Logs) error {
 	buf, err := e.logsMarshaler.MarshalLogs(ld)
 	if err != nil {
 		return err
 	}
 	buf = e.compressor(buf)
-	return e.exporter(e, buf)
+	return e.write(partition, buf)
 }
 
-func exportMessageAsLine(e *fileExporter, buf []byte) error {
+// partition returns the sanitized value of the partitionBy attribute of the resource,
+// or an empty string when the data must be written to the configured path.
+func (e *fileExporter) partition(resource pcommon.Resource) string {
+	if e.partitionBy == "" {
+		return ""
+	}
+	v, ok := resource.Attributes().Get(e.partitionBy)
+	if !ok {
+		return ""
+	}
+	return strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '-' || r == '_' || r == '.' {
+			return r
+		}
+		return '_'
+	}, v.AsString())
+}
+
+// write exports the encoded data to the file of the partition.
+func (e *fileExporter) write(partition string, buf []byte) error {
 	// Ensure only one write operation happens at a time.
 	e.mutex.Lock()
 	defer e.mutex.Unlock()
-	if _, err := e.file.Write(buf); err != nil {
-		return err
+	if partition == "" {
+		return e.exporter(e.file, buf)
 	}
-	if _, err := io.WriteString(e.file, "\n"); err != nil {
+	pf, err := e.partitionFile(partition)
+	if err != nil {
 		return err
 	}
-	return nil
+	pf.lastWrite = e.now()
+	return e.exporter(pf.writer, buf)
 }
 
-func exportMessageAsBuffer(e *fileExporter, buf []byte) error {
-	// Ensure only one write operation happens at a time.
+// partitionFile returns the open file of the partition, opening it if needed and closing the least
+// recently written file when maxOpenFiles are open. It must be called with mutex held.
+func (e *fileExporter) partitionFile(partition string) (*partitionFile, error) {
+	if elem, ok := e.partitions[partition]; ok {
+		e.openFiles.MoveToBack(elem)
+		return elem.Value.(*partitionFile), nil
+	}
+	if e.partitions == nil {
+		e.partitions = make(map[string]*list.Element)
+		e.openFiles = list.New()
+	}
+	var err error
+	if e.openFiles.Len() >= e.maxOpenFiles {
+		err = e.closePartition(e.openFiles.Front())
+	}
+	// Partition files are closed and reopened as partitions come and go, so they are always appended to.
+	w, openErr := openFileWriter(partitionPath(e.path, partition), e.rotation, true)
+	if openErr != nil {
+		return nil, multierr.Append(err, openErr)
+	}
+	pf := &partitionFile{partition: partition, writer: w}
+	e.partitions[partition] = e.openFiles.PushBack(pf)
+	return pf, err
+}
+
+// closePartition closes the file of the element of openFiles. It must be called with mutex held.
+func (e *fileExporter) closePartition(elem *list.Element) error {
+	pf := e.openFiles.Remove(elem).(*partitionFile)
+	delete(e.partitions, pf.partition)
+	return pf.writer.Close()
+}
+
+// closeIdlePartitions closes the partition files that weren't written to for partitionIdleTimeout.
+func (e *fileExporter) closeIdlePartitions() error {
 	e.mutex.Lock()
 	defer e.mutex.Unlock()
+	if e.openFiles == nil {
+		return nil
+	}
+	now := e.now()
+	var err error
+	for elem := e.openFiles.Front(); elem != nil; elem = e.openFiles.Front() {
+		if now.Sub(elem.Value.(*partitionFile).lastWrite) < partitionIdleTimeout {
+			break
+		}
+		err = multierr.Append(err, e.closePartition(elem))
+	}
+	return err
+}
+
+// partitionPath inserts the partition before the extension of the path, e.g. data.json becomes data.checkout.json.
+func partitionPath(path string, partition string) string {
+	ext := filepath.Ext(path)
+	return strings.TrimSuffix(path, ext) + "." + partition + ext
+}
+
+func exportMessageAsLine(w io.Writer, buf []byte) error {
+	if _, err := w.Write(buf); err != nil {
+		return err
+	}
+	if _, err := io.WriteString(w, "\n"); err != nil {
+		return err
+	}
+	return nil
+}
+
+func exportMessageAsBuffer(w io.Writer, buf []byte) error {
 	// write the size of each message before writing the message itself.  https://developers.google.com/protocol-buffers/docs/techniques
 	// each encoded object is preceded by 4 bytes (an unsigned 32 bit integer)
 	data := make([]byte, 4, 4+len(buf))
 	binary.BigEndian.PutUint32(data, uint32(len(buf)))
 	data = append(data, buf...)
-	if err := binary.Write(e.file, binary.BigEndian, data); err != nil {
+	if err := binary.Write(w, binary.BigEndian, data); err != nil {
 		return err
 	}
 	return nil
 }
 
 func (e *fileExporter) Start(context.Context, component.Host) error {
+	if e.partitionBy == "" {
+		return nil
+	}
+	e.done = make(chan struct{})
+	e.wg.Add(1)
+	go func() {
+		defer e.wg.Done()
+		ticker := time.NewTicker(partitionIdleTimeout / 2)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				// The data was written already, a failure to flush it can't be reported.
+				_ = e.closeIdlePartitions()
+			case <-e.done:
+				return
+			}
+		}
+	}()
 	return nil
 }
 
 // Shutdown stops the exporter and is invoked during shutdown.
 func (e *fileExporter) Shutdown(context.Context) error {
-	return e.file.Close()
+	if e.done != nil {
+		close(e.done)
+		e.wg.Wait()
+	}
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	err := e.file.Close()
+	for _, elem := range e.partitions {
+		err = multierr.Append(err, e.closePartition(elem))
+	}
+	return err
 }
 
-func buildExportFunc(cfg *Config) func(e *fileExporter, buf []byte) error {
+func buildExportFunc(cfg *Config) exportFunc {
 	if cfg.FormatType == formatTypeProto {
 		return exportMessageAsBuffer
 	}
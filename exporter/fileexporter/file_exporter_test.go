@@ -20,8 +20,10 @@ import (
 	"errors"
 	"io"
 	"os"
+	"path/filepath"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/klauspost/compress/zstd"
 	"github.com/stretchr/testify/assert"
@@ -481,11 +483,112 @@ func TestExportMessageAsBuffer(t *testing.T) {
 	marshaler := &plog.ProtoMarshaler{}
 	buf, err := marshaler.MarshalLogs(ld)
 	assert.NoError(t, err)
-	assert.Error(t, exportMessageAsBuffer(fe, buf))
+	assert.Error(t, exportMessageAsBuffer(fe.file, buf))
 	assert.NoError(t, fe.Shutdown(context.Background()))
 
 }
 
+func TestFileLogsExporterSplitByResourceAndPartition(t *testing.T) {
+	conf := &Config{
+		Path:                         filepath.Join(t.TempDir(), "logs.json"),
+		FormatType:                   formatTypeJSON,
+		SplitByResource:              true,
+		PartitionByResourceAttribute: "service.name",
+	}
+	writer, err := buildFileWriter(conf)
+	require.NoError(t, err)
+	fe := newFileExporter(conf, writer)
+
+	ld := plog.NewLogs()
+	for _, service := range []string{"checkout", "cart/v2", "checkout", ""} {
+		rl := ld.ResourceLogs().AppendEmpty()
+		if service != "" {
+			rl.Resource().Attributes().PutStr("service.name", service)
+		}
+		rl.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().Body().SetStr(service)
+	}
+	assert.NoError(t, fe.Start(context.Background(), componenttest.NewNopHost()))
+	assert.NoError(t, fe.ConsumeLogs(context.Background(), ld))
+	assert.NoError(t, fe.Shutdown(context.Background()))
+
+	for path, want := range map[string][]string{
+		conf.Path: {""},
+		filepath.Join(filepath.Dir(conf.Path), "logs.checkout.json"): {"checkout", "checkout"},
+		filepath.Join(filepath.Dir(conf.Path), "logs.cart_v2.json"):  {"cart/v2"},
+	} {
+		fi, err := os.Open(path)
+		require.NoError(t, err)
+		br := bufio.NewReader(fi)
+		var got []string
+		for {
+			buf, isEnd, err := readJSONMessage(br)
+			assert.NoError(t, err)
+			if isEnd {
+				break
+			}
+			line, err := (&plog.JSONUnmarshaler{}).UnmarshalLogs(buf)
+			require.NoError(t, err)
+			require.Equal(t, 1, line.ResourceLogs().Len())
+			got = append(got, line.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Body().Str())
+		}
+		assert.Equal(t, want, got, path)
+		assert.NoError(t, fi.Close())
+	}
+}
+
+func TestFileLogsExporterClosesPartitionFiles(t *testing.T) {
+	conf := &Config{
+		Path:                         filepath.Join(t.TempDir(), "logs.json"),
+		FormatType:                   formatTypeJSON,
+		PartitionByResourceAttribute: "service.name",
+		MaxOpenFiles:                 1,
+	}
+	writer, err := buildFileWriter(conf)
+	require.NoError(t, err)
+	fe := newFileExporter(conf, writer)
+	now := time.Unix(1000, 0)
+	fe.now = func() time.Time { return now }
+
+	consume := func(service string) {
+		ld := plog.NewLogs()
+		rl := ld.ResourceLogs().AppendEmpty()
+		rl.Resource().Attributes().PutStr("service.name", service)
+		rl.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().Body().SetStr(service)
+		require.NoError(t, fe.ConsumeLogs(context.Background(), ld))
+	}
+
+	// The checkout file left by a previous run is appended to.
+	checkoutPath := filepath.Join(filepath.Dir(conf.Path), "logs.checkout.json")
+	require.NoError(t, os.WriteFile(checkoutPath, []byte(`{"resourceLogs":[]}`+"\n"), 0600))
+
+	// Writing to cart closes the checkout file, which is appended to when reopened.
+	consume("checkout")
+	consume("cart")
+	assert.Equal(t, 1, fe.openFiles.Len())
+	consume("checkout")
+	assert.Contains(t, fe.partitions, "checkout")
+
+	now = now.Add(partitionIdleTimeout)
+	require.NoError(t, fe.closeIdlePartitions())
+	assert.Empty(t, fe.partitions)
+	assert.NoError(t, fe.Shutdown(context.Background()))
+
+	fi, err := os.Open(checkoutPath)
+	require.NoError(t, err)
+	br := bufio.NewReader(fi)
+	lines := 0
+	for {
+		_, isEnd, err := readJSONMessage(br)
+		require.NoError(t, err)
+		if isEnd {
+			break
+		}
+		lines++
+	}
+	assert.Equal(t, 3, lines)
+	assert.NoError(t, fi.Close())
+}
+
 // tempFileName provides a temporary file name for testing.
 func tempFileName(t *testing.T) string {
 	tmpfile, err := os.CreateTemp("", "*")
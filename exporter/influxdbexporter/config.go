@@ -15,6 +15,9 @@
 package influxdbexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/influxdbexporter"
 
 import (
+	"errors"
+	"fmt"
+
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/config/configopaque"
@@ -40,6 +43,23 @@ type V1Compatibility struct {
 	Password configopaque.String `mapstructure:"password"`
 }
 
+// V3 is used to specify if the exporter should use the InfluxDB 3 write API.
+type V3 struct {
+	// Enabled is used to specify if the exporter should use the InfluxDB 3 write API.
+	Enabled bool `mapstructure:"enabled"`
+	// Database is used to specify the name of the InfluxDB 3 database that telemetry will be written to.
+	Database string `mapstructure:"database"`
+}
+
+// SchemaHints is used to direct attributes to the tags or the fields of the written points.
+type SchemaHints struct {
+	// Tags is the allowlist of the tag keys. When set, the other tags are written as fields,
+	// so that high-cardinality attributes are kept out of the series key.
+	Tags []string `mapstructure:"tags"`
+	// Fields is the list of the tag keys that are written as fields.
+	Fields []string `mapstructure:"fields"`
+}
+
 // Config defines configuration for the InfluxDB exporter.
 type Config struct {
 	confighttp.HTTPClientSettings `mapstructure:",squash"`
@@ -54,6 +74,10 @@ type Config struct {
 	Token configopaque.String `mapstructure:"token"`
 	// V1Compatibility is used to specify if the exporter should use the v1.X InfluxDB API schema.
 	V1Compatibility V1Compatibility `mapstructure:"v1_compatibility"`
+	// V3 is used to specify if the exporter should use the InfluxDB 3 write API.
+	V3 V3 `mapstructure:"v3"`
+	// SchemaHints is used to direct attributes to the tags or the fields of the written points.
+	SchemaHints SchemaHints `mapstructure:"schema_hints"`
 
 	// MetricsSchema indicates the metrics schema to emit to line protocol.
 	// Options:
@@ -63,5 +87,22 @@ type Config struct {
 }
 
 func (cfg *Config) Validate() error {
+	if cfg.V3.Enabled {
+		if cfg.V1Compatibility.Enabled {
+			return errors.New("v1_compatibility and v3 cannot be enabled together")
+		}
+		if cfg.V3.Database == "" {
+			return errors.New("v3 database must be specified")
+		}
+	}
+	tags := make(map[string]struct{}, len(cfg.SchemaHints.Tags))
+	for _, k := range cfg.SchemaHints.Tags {
+		tags[k] = struct{}{}
+	}
+	for _, k := range cfg.SchemaHints.Fields {
+		if _, found := tags[k]; found {
+			return fmt.Errorf("schema hints key %q cannot be both a tag and a field", k)
+		}
+	}
 	return nil
 }
@@ -67,6 +67,28 @@ func TestLoadConfig(t *testing.T) {
 				MetricsSchema: "telegraf-prometheus-v2",
 			},
 		},
+		{
+			id: component.NewIDWithName(typeStr, "v3"),
+			expected: &Config{
+				HTTPClientSettings: confighttp.HTTPClientSettings{
+					Endpoint: "http://localhost:8181",
+					Timeout:  5 * time.Second,
+					Headers:  map[string]configopaque.String{"User-Agent": "OpenTelemetry -> Influx"},
+				},
+				QueueSettings: exporterhelper.NewDefaultQueueSettings(),
+				RetrySettings: exporterhelper.NewDefaultRetrySettings(),
+				Token:         "my-token",
+				V3: V3{
+					Enabled:  true,
+					Database: "my-database",
+				},
+				SchemaHints: SchemaHints{
+					Tags:   []string{"host.name", "service.name"},
+					Fields: []string{"http.url"},
+				},
+				MetricsSchema: "telegraf-prometheus-v1",
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -83,3 +105,51 @@ func TestLoadConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		mutate  func(cfg *Config)
+		wantErr string
+	}{
+		{
+			name:   "default",
+			mutate: func(cfg *Config) {},
+		},
+		{
+			name: "v1 and v3 enabled",
+			mutate: func(cfg *Config) {
+				cfg.V1Compatibility.Enabled = true
+				cfg.V3 = V3{Enabled: true, Database: "my-database"}
+			},
+			wantErr: "v1_compatibility and v3 cannot be enabled together",
+		},
+		{
+			name: "v3 without database",
+			mutate: func(cfg *Config) {
+				cfg.V3.Enabled = true
+			},
+			wantErr: "v3 database must be specified",
+		},
+		{
+			name: "schema hints key in tags and fields",
+			mutate: func(cfg *Config) {
+				cfg.SchemaHints = SchemaHints{Tags: []string{"host.name"}, Fields: []string{"host.name"}}
+			},
+			wantErr: `schema hints key "host.name" cannot be both a tag and a field`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			tt.mutate(cfg)
+			err := cfg.Validate()
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -37,6 +37,7 @@ type influxHTTPWriter struct {
 	encoderPool sync.Pool
 	httpClient  *http.Client
 	writeURL    string
+	schemaHints schemaHints
 
 	logger common.Logger
 }
@@ -47,7 +48,12 @@ func newInfluxHTTPWriter(logger common.Logger, config *Config, host component.Ho
 		return nil, err
 	}
 	if writeURL.Path == "" || writeURL.Path == "/" {
-		if config.V1Compatibility.Enabled {
+		if config.V3.Enabled {
+			writeURL, err = writeURL.Parse("api/v3/write_lp")
+			if err != nil {
+				return nil, err
+			}
+		} else if config.V1Compatibility.Enabled {
 			writeURL, err = writeURL.Parse("write")
 			if err != nil {
 				return nil, err
@@ -60,9 +66,16 @@ func newInfluxHTTPWriter(logger common.Logger, config *Config, host component.Ho
 		}
 	}
 	queryValues := writeURL.Query()
-	queryValues.Set("precision", "ns")
 
-	if config.V1Compatibility.Enabled {
+	if config.V3.Enabled {
+		queryValues.Set("precision", "nanosecond")
+		queryValues.Set("db", config.V3.Database)
+
+		if config.Token != "" {
+			config.HTTPClientSettings.Headers["Authorization"] = "Bearer " + config.Token
+		}
+	} else if config.V1Compatibility.Enabled {
+		queryValues.Set("precision", "ns")
 		queryValues.Set("db", config.V1Compatibility.DB)
 
 		if config.V1Compatibility.Username != "" && config.V1Compatibility.Password != "" {
@@ -71,6 +84,7 @@ func newInfluxHTTPWriter(logger common.Logger, config *Config, host component.Ho
 			config.HTTPClientSettings.Headers["Authorization"] = configopaque.String("Basic " + string(basicAuth))
 		}
 	} else {
+		queryValues.Set("precision", "ns")
 		queryValues.Set("org", config.Org)
 		queryValues.Set("bucket", config.Bucket)
 
@@ -94,9 +108,10 @@ func newInfluxHTTPWriter(logger common.Logger, config *Config, host component.Ho
 				return e
 			},
 		},
-		httpClient: httpClient,
-		writeURL:   writeURL.String(),
-		logger:     logger,
+		httpClient:  httpClient,
+		writeURL:    writeURL.String(),
+		schemaHints: newSchemaHints(config.SchemaHints),
+		logger:      logger,
 	}, nil
 }
 
@@ -117,6 +132,8 @@ type influxHTTPWriterBatch struct {
 // WritePoint emits a set of line protocol attributes (metrics, tags, fields, timestamp)
 // to the internal line protocol buffer. This method implements otel2influx.InfluxWriter.
 func (b *influxHTTPWriterBatch) WritePoint(_ context.Context, measurement string, tags map[string]string, fields map[string]interface{}, ts time.Time, _ common.InfluxMetricValueType) error {
+	tags, fields = b.w.schemaHints.apply(tags, fields)
+
 	b.encoder.StartLine(measurement)
 	for _, tag := range b.sortTags(tags) {
 		b.encoder.AddTag(tag.k, tag.v)
@@ -167,6 +184,72 @@ func (b *influxHTTPWriterBatch) flushAndClose(ctx context.Context) error {
 	return nil
 }
 
+// schemaHints moves the tags that aren't allowed as tags to the fields of a point.
+type schemaHints struct {
+	tags   map[string]struct{}
+	fields map[string]struct{}
+}
+
+func newSchemaHints(config SchemaHints) schemaHints {
+	var hints schemaHints
+	if len(config.Tags) > 0 {
+		hints.tags = make(map[string]struct{}, len(config.Tags))
+		for _, k := range config.Tags {
+			hints.tags[k] = struct{}{}
+		}
+	}
+	if len(config.Fields) > 0 {
+		hints.fields = make(map[string]struct{}, len(config.Fields))
+		for _, k := range config.Fields {
+			hints.fields[k] = struct{}{}
+		}
+	}
+	return hints
+}
+
+func (h schemaHints) isField(k string) bool {
+	if _, found := h.fields[k]; found {
+		return true
+	}
+	if h.tags == nil {
+		return false
+	}
+	_, found := h.tags[k]
+	return !found
+}
+
+// apply returns the tags and the fields of a point according to the hints.
+// A moved tag doesn't replace a field with the same key.
+func (h schemaHints) apply(tags map[string]string, fields map[string]interface{}) (map[string]string, map[string]interface{}) {
+	if h.tags == nil && h.fields == nil {
+		return tags, fields
+	}
+	var moved bool
+	for k := range tags {
+		if h.isField(k) {
+			moved = true
+			break
+		}
+	}
+	if !moved {
+		return tags, fields
+	}
+
+	newTags := make(map[string]string, len(tags))
+	newFields := make(map[string]interface{}, len(fields)+len(tags))
+	for k, v := range fields {
+		newFields[k] = v
+	}
+	for k, v := range tags {
+		if !h.isField(k) {
+			newTags[k] = v
+		} else if _, found := newFields[k]; !found {
+			newFields[k] = v
+		}
+	}
+	return newTags, newFields
+}
+
 type tag struct {
 	k, v string
 }
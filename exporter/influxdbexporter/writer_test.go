@@ -0,0 +1,104 @@
+// Copyright 2021, OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package influxdbexporter
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/influxdata/influxdb-observability/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.uber.org/zap"
+)
+
+func TestInfluxHTTPWriterV3(t *testing.T) {
+	var gotPath, gotQuery, gotAuth, gotBody string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		gotQuery = r.URL.RawQuery
+		gotAuth = r.Header.Get("Authorization")
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		gotBody = string(body)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(server.Close)
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = server.URL
+	cfg.Token = "my-token"
+	cfg.V3 = V3{Enabled: true, Database: "my-database"}
+	cfg.SchemaHints = SchemaHints{Tags: []string{"host.name"}}
+
+	w, err := newInfluxHTTPWriter(newZapInfluxLogger(zap.NewNop()), cfg, componenttest.NewNopHost(), componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+
+	batch := w.newBatch()
+	err = batch.WritePoint(context.Background(), "m",
+		map[string]string{"host.name": "h1", "http.url": "/a"},
+		map[string]interface{}{"gauge": 1.0},
+		time.Unix(0, 1), common.InfluxMetricValueTypeGauge)
+	require.NoError(t, err)
+	require.NoError(t, batch.flushAndClose(context.Background()))
+
+	assert.Equal(t, "/api/v3/write_lp", gotPath)
+	assert.Equal(t, "db=my-database&precision=nanosecond", gotQuery)
+	assert.Equal(t, "Bearer my-token", gotAuth)
+	assert.Equal(t, "m,host.name=h1 gauge=1,http.url=\"/a\" 1\n", gotBody)
+}
+
+func TestSchemaHintsApply(t *testing.T) {
+	tags := map[string]string{"host.name": "h1", "http.url": "/a", "k8s.pod.uid": "u1"}
+	fields := map[string]interface{}{"gauge": 1.0, "k8s.pod.uid": "f1"}
+
+	tests := []struct {
+		name       string
+		hints      SchemaHints
+		wantTags   map[string]string
+		wantFields map[string]interface{}
+	}{
+		{
+			name:       "no hints",
+			wantTags:   tags,
+			wantFields: fields,
+		},
+		{
+			name:       "fields",
+			hints:      SchemaHints{Fields: []string{"http.url"}},
+			wantTags:   map[string]string{"host.name": "h1", "k8s.pod.uid": "u1"},
+			wantFields: map[string]interface{}{"gauge": 1.0, "k8s.pod.uid": "f1", "http.url": "/a"},
+		},
+		{
+			name:       "tags allowlist",
+			hints:      SchemaHints{Tags: []string{"host.name"}},
+			wantTags:   map[string]string{"host.name": "h1"},
+			wantFields: map[string]interface{}{"gauge": 1.0, "k8s.pod.uid": "f1", "http.url": "/a"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotTags, gotFields := newSchemaHints(tt.hints).apply(tags, fields)
+			assert.Equal(t, tt.wantTags, gotTags)
+			assert.Equal(t, tt.wantFields, gotFields)
+		})
+	}
+}
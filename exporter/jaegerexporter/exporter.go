@@ -35,6 +35,7 @@ import (
 	"google.golang.org/grpc/connectivity"
 	"google.golang.org/grpc/metadata"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/grpccompression"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/translator/jaeger"
 )
 
@@ -131,7 +132,8 @@ func (s *protoGRPCSender) start(ctx context.Context, host component.Host) error
 	if s.clientSettings == nil {
 		return fmt.Errorf("client settings not found")
 	}
-	conn, err := s.clientSettings.ToClientConn(ctx, host, s.settings)
+	negotiator := grpccompression.NewNegotiator(string(s.clientSettings.Compression), s.settings.Logger)
+	conn, err := s.clientSettings.ToClientConn(ctx, host, s.settings, negotiator.DialOptions()...)
 	if err != nil {
 		return err
 	}
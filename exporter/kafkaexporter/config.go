@@ -36,6 +36,13 @@ type Config struct {
 	// The name of the kafka topic to export to (default otlp_spans for traces, otlp_metrics for metrics)
 	Topic string `mapstructure:"topic"`
 
+	// TopicTemplates defines, per signal, a topic name computed from resource attributes.
+	// Data whose resource lacks any of the referenced attributes is sent to Topic.
+	TopicTemplates TopicTemplates `mapstructure:"topic_templates"`
+
+	// Headers defines the record headers whose values are taken from resource attributes.
+	Headers []HeaderFromAttribute `mapstructure:"headers"`
+
 	// Encoding of messages (default "otlp_proto")
 	Encoding string `mapstructure:"encoding"`
 
@@ -50,6 +57,23 @@ type Config struct {
 	Authentication Authentication `mapstructure:"auth"`
 }
 
+// TopicTemplates defines the topic templates of every signal. In a template, every
+// %{attribute} placeholder is replaced by the value of the resource attribute with the same name.
+type TopicTemplates struct {
+	Traces  string `mapstructure:"traces"`
+	Metrics string `mapstructure:"metrics"`
+	Logs    string `mapstructure:"logs"`
+}
+
+// HeaderFromAttribute defines a record header whose value is taken from a resource attribute.
+type HeaderFromAttribute struct {
+	// Key is the key of the record header.
+	Key string `mapstructure:"key"`
+	// FromAttribute is the resource attribute the value of the header is taken from.
+	// The header is omitted if the attribute is missing.
+	FromAttribute string `mapstructure:"from_attribute"`
+}
+
 // Metadata defines configuration for retrieving metadata from the broker.
 type Metadata struct {
 	// Whether to maintain a full set of metadata for all topics, or just
@@ -111,6 +135,36 @@ func (cfg *Config) Validate() error {
 		return err
 	}
 
+	for _, template := range []string{cfg.TopicTemplates.Traces, cfg.TopicTemplates.Metrics, cfg.TopicTemplates.Logs} {
+		if template == "" {
+			continue
+		}
+		if _, err = parseTopicTemplate(template); err != nil {
+			return err
+		}
+	}
+
+	return cfg.validateHeaders()
+}
+
+func (cfg *Config) validateHeaders() error {
+	if len(cfg.Headers) == 0 {
+		return nil
+	}
+	for _, h := range cfg.Headers {
+		if h.Key == "" || h.FromAttribute == "" {
+			return fmt.Errorf("headers require both key and from_attribute to be set")
+		}
+	}
+	if cfg.ProtocolVersion != "" {
+		version, err := sarama.ParseKafkaVersion(cfg.ProtocolVersion)
+		if err != nil {
+			return err
+		}
+		if !version.IsAtLeast(sarama.V0_11_0_0) {
+			return fmt.Errorf("headers require protocol_version 0.11.0 or later. configured value %v", cfg.ProtocolVersion)
+		}
+	}
 	return nil
 }
 
@@ -55,7 +55,13 @@ func TestLoadConfig(t *testing.T) {
 					NumConsumers: 2,
 					QueueSize:    10,
 				},
-				Topic:    "spans",
+				Topic: "spans",
+				TopicTemplates: TopicTemplates{
+					Traces: "otlp_spans.%{service.namespace}",
+				},
+				Headers: []HeaderFromAttribute{
+					{Key: "tenant", FromAttribute: "tenant.id"},
+				},
 				Encoding: "otlp_proto",
 				Brokers:  []string{"foo:123", "bar:456"},
 				Authentication: Authentication{
@@ -107,6 +113,37 @@ func TestValidate_err_compression(t *testing.T) {
 	assert.Equal(t, err.Error(), "producer.compression should be one of 'none', 'gzip', 'snappy', 'lz4', or 'zstd'. configured value idk")
 }
 
+func TestValidate_err_topic_template(t *testing.T) {
+	config := &Config{
+		Producer: Producer{
+			Compression: "none",
+		},
+		TopicTemplates: TopicTemplates{
+			Logs: "otlp_logs.%{service.name",
+		},
+	}
+
+	err := config.Validate()
+	assert.EqualError(t, err, `unclosed placeholder in topic template "otlp_logs.%{service.name"`)
+}
+
+func TestValidate_err_headers(t *testing.T) {
+	config := &Config{
+		Producer: Producer{
+			Compression: "none",
+		},
+		Headers: []HeaderFromAttribute{{Key: "tenant"}},
+	}
+	assert.EqualError(t, config.Validate(), "headers require both key and from_attribute to be set")
+
+	config.Headers = []HeaderFromAttribute{{Key: "tenant", FromAttribute: "tenant.id"}}
+	config.ProtocolVersion = "0.10.2"
+	assert.EqualError(t, config.Validate(), "headers require protocol_version 0.11.0 or later. configured value 0.10.2")
+
+	config.ProtocolVersion = "2.0.0"
+	assert.NoError(t, config.Validate())
+}
+
 func Test_saramaProducerCompressionCodec(t *testing.T) {
 	tests := map[string]struct {
 		compression         string
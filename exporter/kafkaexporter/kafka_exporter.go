@@ -34,6 +34,7 @@ var errUnrecognizedEncoding = fmt.Errorf("unrecognized encoding")
 type kafkaTracesProducer struct {
 	producer  sarama.SyncProducer
 	topic     string
+	router    *messageRouter
 	marshaler TracesMarshaler
 	logger    *zap.Logger
 }
@@ -48,7 +49,7 @@ func (ke kafkaErrors) Error() string {
 }
 
 func (e *kafkaTracesProducer) tracesPusher(_ context.Context, td ptrace.Traces) error {
-	messages, err := e.marshaler.Marshal(td, e.topic)
+	messages, err := e.marshal(td)
 	if err != nil {
 		return consumererror.NewPermanent(err)
 	}
@@ -65,6 +66,22 @@ func (e *kafkaTracesProducer) tracesPusher(_ context.Context, td ptrace.Traces)
 	return nil
 }
 
+func (e *kafkaTracesProducer) marshal(td ptrace.Traces) ([]*sarama.ProducerMessage, error) {
+	if e.router == nil {
+		return e.marshaler.Marshal(td, e.topic)
+	}
+	var messages []*sarama.ProducerMessage
+	for _, routed := range e.router.splitTraces(td) {
+		routedMessages, err := e.marshaler.Marshal(routed.traces, routed.topic)
+		if err != nil {
+			return nil, err
+		}
+		addHeaders(routedMessages, routed.headers)
+		messages = append(messages, routedMessages...)
+	}
+	return messages, nil
+}
+
 func (e *kafkaTracesProducer) Close(context.Context) error {
 	return e.producer.Close()
 }
@@ -73,12 +90,13 @@ func (e *kafkaTracesProducer) Close(context.Context) error {
 type kafkaMetricsProducer struct {
 	producer  sarama.SyncProducer
 	topic     string
+	router    *messageRouter
 	marshaler MetricsMarshaler
 	logger    *zap.Logger
 }
 
 func (e *kafkaMetricsProducer) metricsDataPusher(_ context.Context, md pmetric.Metrics) error {
-	messages, err := e.marshaler.Marshal(md, e.topic)
+	messages, err := e.marshal(md)
 	if err != nil {
 		return consumererror.NewPermanent(err)
 	}
@@ -95,6 +113,22 @@ func (e *kafkaMetricsProducer) metricsDataPusher(_ context.Context, md pmetric.M
 	return nil
 }
 
+func (e *kafkaMetricsProducer) marshal(md pmetric.Metrics) ([]*sarama.ProducerMessage, error) {
+	if e.router == nil {
+		return e.marshaler.Marshal(md, e.topic)
+	}
+	var messages []*sarama.ProducerMessage
+	for _, routed := range e.router.splitMetrics(md) {
+		routedMessages, err := e.marshaler.Marshal(routed.metrics, routed.topic)
+		if err != nil {
+			return nil, err
+		}
+		addHeaders(routedMessages, routed.headers)
+		messages = append(messages, routedMessages...)
+	}
+	return messages, nil
+}
+
 func (e *kafkaMetricsProducer) Close(context.Context) error {
 	return e.producer.Close()
 }
@@ -103,12 +137,13 @@ func (e *kafkaMetricsProducer) Close(context.Context) error {
 type kafkaLogsProducer struct {
 	producer  sarama.SyncProducer
 	topic     string
+	router    *messageRouter
 	marshaler LogsMarshaler
 	logger    *zap.Logger
 }
 
 func (e *kafkaLogsProducer) logsDataPusher(_ context.Context, ld plog.Logs) error {
-	messages, err := e.marshaler.Marshal(ld, e.topic)
+	messages, err := e.marshal(ld)
 	if err != nil {
 		return consumererror.NewPermanent(err)
 	}
@@ -125,6 +160,22 @@ func (e *kafkaLogsProducer) logsDataPusher(_ context.Context, ld plog.Logs) erro
 	return nil
 }
 
+func (e *kafkaLogsProducer) marshal(ld plog.Logs) ([]*sarama.ProducerMessage, error) {
+	if e.router == nil {
+		return e.marshaler.Marshal(ld, e.topic)
+	}
+	var messages []*sarama.ProducerMessage
+	for _, routed := range e.router.splitLogs(ld) {
+		routedMessages, err := e.marshaler.Marshal(routed.logs, routed.topic)
+		if err != nil {
+			return nil, err
+		}
+		addHeaders(routedMessages, routed.headers)
+		messages = append(messages, routedMessages...)
+	}
+	return messages, nil
+}
+
 func (e *kafkaLogsProducer) Close(context.Context) error {
 	return e.producer.Close()
 }
@@ -173,6 +224,10 @@ func newMetricsExporter(config Config, set exporter.CreateSettings, marshalers m
 	if marshaler == nil {
 		return nil, errUnrecognizedEncoding
 	}
+	router, err := newMessageRouter(config.Topic, config.TopicTemplates.Metrics, config.Headers)
+	if err != nil {
+		return nil, err
+	}
 	producer, err := newSaramaProducer(config)
 	if err != nil {
 		return nil, err
@@ -181,6 +236,7 @@ func newMetricsExporter(config Config, set exporter.CreateSettings, marshalers m
 	return &kafkaMetricsProducer{
 		producer:  producer,
 		topic:     config.Topic,
+		router:    router,
 		marshaler: marshaler,
 		logger:    set.Logger,
 	}, nil
@@ -193,6 +249,10 @@ func newTracesExporter(config Config, set exporter.CreateSettings, marshalers ma
 	if marshaler == nil {
 		return nil, errUnrecognizedEncoding
 	}
+	router, err := newMessageRouter(config.Topic, config.TopicTemplates.Traces, config.Headers)
+	if err != nil {
+		return nil, err
+	}
 	producer, err := newSaramaProducer(config)
 	if err != nil {
 		return nil, err
@@ -200,6 +260,7 @@ func newTracesExporter(config Config, set exporter.CreateSettings, marshalers ma
 	return &kafkaTracesProducer{
 		producer:  producer,
 		topic:     config.Topic,
+		router:    router,
 		marshaler: marshaler,
 		logger:    set.Logger,
 	}, nil
@@ -210,6 +271,10 @@ func newLogsExporter(config Config, set exporter.CreateSettings, marshalers map[
 	if marshaler == nil {
 		return nil, errUnrecognizedEncoding
 	}
+	router, err := newMessageRouter(config.Topic, config.TopicTemplates.Logs, config.Headers)
+	if err != nil {
+		return nil, err
+	}
 	producer, err := newSaramaProducer(config)
 	if err != nil {
 		return nil, err
@@ -218,6 +283,7 @@ func newLogsExporter(config Config, set exporter.CreateSettings, marshalers map[
 	return &kafkaLogsProducer{
 		producer:  producer,
 		topic:     config.Topic,
+		router:    router,
 		marshaler: marshaler,
 		logger:    set.Logger,
 	}, nil
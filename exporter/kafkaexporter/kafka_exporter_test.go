@@ -151,6 +151,56 @@ func TestTracesPusher(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestTracesPusher_routed(t *testing.T) {
+	c := sarama.NewConfig()
+	producer := mocks.NewSyncProducer(t, c)
+	expected := map[string]string{
+		"otlp_spans.checkout": "team-a",
+		"otlp_spans":          "",
+	}
+	checker := func(msg *sarama.ProducerMessage) error {
+		tenant, ok := expected[msg.Topic]
+		if !ok {
+			return fmt.Errorf("unexpected topic %q", msg.Topic)
+		}
+		delete(expected, msg.Topic)
+		if tenant == "" {
+			if len(msg.Headers) != 0 {
+				return fmt.Errorf("unexpected headers on topic %q", msg.Topic)
+			}
+			return nil
+		}
+		if len(msg.Headers) != 1 || string(msg.Headers[0].Key) != "tenant" || string(msg.Headers[0].Value) != tenant {
+			return fmt.Errorf("unexpected headers on topic %q: %v", msg.Topic, msg.Headers)
+		}
+		return nil
+	}
+	producer.ExpectSendMessageWithMessageCheckerFunctionAndSucceed(checker)
+	producer.ExpectSendMessageWithMessageCheckerFunctionAndSucceed(checker)
+
+	router, err := newMessageRouter("otlp_spans", "otlp_spans.%{service.name}", []HeaderFromAttribute{{Key: "tenant", FromAttribute: "tenant.id"}})
+	require.NoError(t, err)
+	p := kafkaTracesProducer{
+		producer:  producer,
+		topic:     "otlp_spans",
+		router:    router,
+		marshaler: newPdataTracesMarshaler(&ptrace.ProtoMarshaler{}, defaultEncoding),
+	}
+	t.Cleanup(func() {
+		require.NoError(t, p.Close(context.Background()))
+	})
+
+	td := ptrace.NewTraces()
+	rs := td.ResourceSpans().AppendEmpty()
+	rs.Resource().Attributes().PutStr("service.name", "checkout")
+	rs.Resource().Attributes().PutStr("tenant.id", "team-a")
+	rs.ScopeSpans().AppendEmpty().Spans().AppendEmpty().SetName("first")
+	td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans().AppendEmpty().SetName("second")
+
+	require.NoError(t, p.tracesPusher(context.Background(), td))
+	assert.Empty(t, expected)
+}
+
 func TestTracesPusher_err(t *testing.T) {
 	c := sarama.NewConfig()
 	producer := mocks.NewSyncProducer(t, c)
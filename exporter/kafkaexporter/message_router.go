@@ -0,0 +1,236 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kafkaexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/kafkaexporter"
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Shopify/sarama"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+const (
+	templatePlaceholderStart = "%{"
+	templatePlaceholderEnd   = "}"
+
+	// maxTopicLength is the maximum length of a topic name accepted by Kafka.
+	maxTopicLength = 249
+)
+
+// topicTemplate is a topic name where every %{attribute} placeholder is replaced by
+// the value of the resource attribute with the same name.
+type topicTemplate struct {
+	literals   []string
+	attributes []string
+}
+
+func parseTopicTemplate(template string) (*topicTemplate, error) {
+	t := &topicTemplate{}
+	rest := template
+	for {
+		start := strings.Index(rest, templatePlaceholderStart)
+		if start < 0 {
+			t.literals = append(t.literals, rest)
+			return t, nil
+		}
+		end := strings.Index(rest[start:], templatePlaceholderEnd)
+		if end < 0 {
+			return nil, fmt.Errorf("unclosed placeholder in topic template %q", template)
+		}
+		attribute := rest[start+len(templatePlaceholderStart) : start+end]
+		if attribute == "" {
+			return nil, fmt.Errorf("empty placeholder in topic template %q", template)
+		}
+		t.literals = append(t.literals, rest[:start])
+		t.attributes = append(t.attributes, attribute)
+		rest = rest[start+end+len(templatePlaceholderEnd):]
+	}
+}
+
+// render returns the topic for the given resource attributes, and false if any of the
+// attributes referenced by the template is missing or empty.
+func (t *topicTemplate) render(attrs pcommon.Map) (string, bool) {
+	var sb strings.Builder
+	for i, literal := range t.literals {
+		sb.WriteString(literal)
+		if i >= len(t.attributes) {
+			break
+		}
+		v, ok := attrs.Get(t.attributes[i])
+		if !ok || v.AsString() == "" {
+			return "", false
+		}
+		sb.WriteString(v.AsString())
+	}
+	return sanitizeTopic(sb.String()), true
+}
+
+// sanitizeTopic replaces the characters that are not allowed in a Kafka topic name
+// with underscores and truncates the name to the maximum length accepted by Kafka.
+func sanitizeTopic(topic string) string {
+	sanitized := strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '.', r == '_', r == '-':
+			return r
+		default:
+			return '_'
+		}
+	}, topic)
+	if len(sanitized) > maxTopicLength {
+		sanitized = sanitized[:maxTopicLength]
+	}
+	return sanitized
+}
+
+// messageRouter computes the topic and the record headers of the data of every resource.
+type messageRouter struct {
+	topic    string
+	template *topicTemplate
+	headers  []HeaderFromAttribute
+}
+
+// newMessageRouter returns a router for the given configuration, or nil if neither a topic
+// template nor headers are configured and all data is sent to the fixed topic.
+func newMessageRouter(topic string, template string, headers []HeaderFromAttribute) (*messageRouter, error) {
+	if template == "" && len(headers) == 0 {
+		return nil, nil
+	}
+	r := &messageRouter{
+		topic:   topic,
+		headers: headers,
+	}
+	if template != "" {
+		var err error
+		if r.template, err = parseTopicTemplate(template); err != nil {
+			return nil, err
+		}
+	}
+	return r, nil
+}
+
+func (r *messageRouter) route(res pcommon.Resource) (string, []sarama.RecordHeader) {
+	topic := r.topic
+	if r.template != nil {
+		if rendered, ok := r.template.render(res.Attributes()); ok {
+			topic = rendered
+		}
+	}
+
+	var headers []sarama.RecordHeader
+	for _, h := range r.headers {
+		if v, ok := res.Attributes().Get(h.FromAttribute); ok {
+			headers = append(headers, sarama.RecordHeader{Key: []byte(h.Key), Value: []byte(v.AsString())})
+		}
+	}
+	return topic, headers
+}
+
+// routeKey identifies the data that can be sent in the same messages.
+func routeKey(topic string, headers []sarama.RecordHeader) string {
+	var sb strings.Builder
+	sb.WriteString(topic)
+	for _, h := range headers {
+		sb.WriteByte(0)
+		sb.Write(h.Key)
+		sb.WriteByte(0)
+		sb.Write(h.Value)
+	}
+	return sb.String()
+}
+
+type routedTraces struct {
+	topic   string
+	headers []sarama.RecordHeader
+	traces  ptrace.Traces
+}
+
+func (r *messageRouter) splitTraces(td ptrace.Traces) []routedTraces {
+	var routed []routedTraces
+	indexes := map[string]int{}
+	for i := 0; i < td.ResourceSpans().Len(); i++ {
+		rs := td.ResourceSpans().At(i)
+		topic, headers := r.route(rs.Resource())
+		key := routeKey(topic, headers)
+		idx, ok := indexes[key]
+		if !ok {
+			idx = len(routed)
+			indexes[key] = idx
+			routed = append(routed, routedTraces{topic: topic, headers: headers, traces: ptrace.NewTraces()})
+		}
+		rs.CopyTo(routed[idx].traces.ResourceSpans().AppendEmpty())
+	}
+	return routed
+}
+
+type routedMetrics struct {
+	topic   string
+	headers []sarama.RecordHeader
+	metrics pmetric.Metrics
+}
+
+func (r *messageRouter) splitMetrics(md pmetric.Metrics) []routedMetrics {
+	var routed []routedMetrics
+	indexes := map[string]int{}
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		rm := md.ResourceMetrics().At(i)
+		topic, headers := r.route(rm.Resource())
+		key := routeKey(topic, headers)
+		idx, ok := indexes[key]
+		if !ok {
+			idx = len(routed)
+			indexes[key] = idx
+			routed = append(routed, routedMetrics{topic: topic, headers: headers, metrics: pmetric.NewMetrics()})
+		}
+		rm.CopyTo(routed[idx].metrics.ResourceMetrics().AppendEmpty())
+	}
+	return routed
+}
+
+type routedLogs struct {
+	topic   string
+	headers []sarama.RecordHeader
+	logs    plog.Logs
+}
+
+func (r *messageRouter) splitLogs(ld plog.Logs) []routedLogs {
+	var routed []routedLogs
+	indexes := map[string]int{}
+	for i := 0; i < ld.ResourceLogs().Len(); i++ {
+		rl := ld.ResourceLogs().At(i)
+		topic, headers := r.route(rl.Resource())
+		key := routeKey(topic, headers)
+		idx, ok := indexes[key]
+		if !ok {
+			idx = len(routed)
+			indexes[key] = idx
+			routed = append(routed, routedLogs{topic: topic, headers: headers, logs: plog.NewLogs()})
+		}
+		rl.CopyTo(routed[idx].logs.ResourceLogs().AppendEmpty())
+	}
+	return routed
+}
+
+func addHeaders(messages []*sarama.ProducerMessage, headers []sarama.RecordHeader) {
+	if len(headers) == 0 {
+		return
+	}
+	for _, m := range messages {
+		m.Headers = append(m.Headers, headers...)
+	}
+}
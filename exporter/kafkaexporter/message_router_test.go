@@ -0,0 +1,125 @@
+// Copyright  OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kafkaexporter
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Shopify/sarama"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+func TestTopicTemplate(t *testing.T) {
+	attrs := pcommon.NewMap()
+	attrs.PutStr("service.namespace", "shop")
+	attrs.PutStr("service.name", "check out/v2")
+	attrs.PutInt("shard", 3)
+	attrs.PutStr("empty", "")
+
+	tests := []struct {
+		template string
+		expected string
+		ok       bool
+	}{
+		{template: "otlp_spans", expected: "otlp_spans", ok: true},
+		{template: "%{service.namespace}", expected: "shop", ok: true},
+		{template: "otlp_spans.%{service.namespace}.%{shard}", expected: "otlp_spans.shop.3", ok: true},
+		{template: "otlp_spans.%{service.name}", expected: "otlp_spans.check_out_v2", ok: true},
+		{template: "otlp_spans.%{missing}", ok: false},
+		{template: "otlp_spans.%{empty}", ok: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.template, func(t *testing.T) {
+			tmpl, err := parseTopicTemplate(tt.template)
+			require.NoError(t, err)
+			topic, ok := tmpl.render(attrs)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.expected, topic)
+		})
+	}
+}
+
+func TestParseTopicTemplate_err(t *testing.T) {
+	_, err := parseTopicTemplate("otlp_spans.%{service.name")
+	assert.EqualError(t, err, `unclosed placeholder in topic template "otlp_spans.%{service.name"`)
+
+	_, err = parseTopicTemplate("otlp_spans.%{}")
+	assert.EqualError(t, err, `empty placeholder in topic template "otlp_spans.%{}"`)
+}
+
+func TestSanitizeTopic(t *testing.T) {
+	assert.Equal(t, "a.b_c-d_e", sanitizeTopic("a.b_c-d e"))
+	assert.Len(t, sanitizeTopic(strings.Repeat("a", 300)), maxTopicLength)
+}
+
+func TestNewMessageRouter_disabled(t *testing.T) {
+	r, err := newMessageRouter("otlp_spans", "", nil)
+	require.NoError(t, err)
+	assert.Nil(t, r)
+}
+
+func TestMessageRouter_splitMetrics(t *testing.T) {
+	r, err := newMessageRouter("otlp_metrics", "otlp_metrics.%{k8s.namespace.name}", []HeaderFromAttribute{{Key: "cluster", FromAttribute: "k8s.cluster.name"}})
+	require.NoError(t, err)
+
+	md := pmetric.NewMetrics()
+	for _, ns := range []string{"a", "b", "a", ""} {
+		rm := md.ResourceMetrics().AppendEmpty()
+		if ns != "" {
+			rm.Resource().Attributes().PutStr("k8s.namespace.name", ns)
+		}
+		rm.Resource().Attributes().PutStr("k8s.cluster.name", "prod")
+		rm.ScopeMetrics().AppendEmpty().Metrics().AppendEmpty().SetName("m")
+	}
+
+	routed := r.splitMetrics(md)
+	require.Len(t, routed, 3)
+	assert.Equal(t, "otlp_metrics.a", routed[0].topic)
+	assert.Equal(t, 2, routed[0].metrics.ResourceMetrics().Len())
+	assert.Equal(t, "otlp_metrics.b", routed[1].topic)
+	assert.Equal(t, 1, routed[1].metrics.ResourceMetrics().Len())
+	assert.Equal(t, "otlp_metrics", routed[2].topic)
+	assert.Equal(t, []sarama.RecordHeader{{Key: []byte("cluster"), Value: []byte("prod")}}, routed[2].headers)
+	// The original data must be left untouched.
+	assert.Equal(t, 4, md.ResourceMetrics().Len())
+}
+
+func TestMessageRouter_splitLogs_headers(t *testing.T) {
+	r, err := newMessageRouter("otlp_logs", "", []HeaderFromAttribute{{Key: "tenant", FromAttribute: "tenant.id"}})
+	require.NoError(t, err)
+
+	ld := plog.NewLogs()
+	ld.ResourceLogs().AppendEmpty().Resource().Attributes().PutStr("tenant.id", "t1")
+	ld.ResourceLogs().AppendEmpty().Resource().Attributes().PutStr("tenant.id", "t2")
+	ld.ResourceLogs().AppendEmpty()
+
+	routed := r.splitLogs(ld)
+	require.Len(t, routed, 3)
+	for _, rl := range routed {
+		assert.Equal(t, "otlp_logs", rl.topic)
+	}
+	assert.Equal(t, []sarama.RecordHeader{{Key: []byte("tenant"), Value: []byte("t1")}}, routed[0].headers)
+	assert.Equal(t, []sarama.RecordHeader{{Key: []byte("tenant"), Value: []byte("t2")}}, routed[1].headers)
+	assert.Empty(t, routed[2].headers)
+
+	messages := []*sarama.ProducerMessage{{Topic: "otlp_logs"}}
+	addHeaders(messages, routed[0].headers)
+	assert.Equal(t, routed[0].headers, messages[0].Headers)
+}
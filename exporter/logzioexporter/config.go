@@ -28,12 +28,15 @@ type Config struct {
 	confighttp.HTTPClientSettings `mapstructure:",squash"`          // confighttp client settings https://pkg.go.dev/go.opentelemetry.io/collector/config/confighttp#HTTPClientSettings
 	exporterhelper.QueueSettings  `mapstructure:"sending_queue"`    // exporter helper queue settings https://pkg.go.dev/go.opentelemetry.io/collector/exporter/exporterhelper#QueueSettings
 	exporterhelper.RetrySettings  `mapstructure:"retry_on_failure"` // exporter helper retry settings https://pkg.go.dev/go.opentelemetry.io/collector/exporter/exporterhelper#RetrySettings
-	Token                         configopaque.String               `mapstructure:"account_token"`    // Your Logz.io Account Token, can be found at https://app.logz.io/#/dashboard/settings/general
-	Region                        string                            `mapstructure:"region"`           // Your Logz.io 2-letter region code, can be found at https://docs.logz.io/user-guide/accounts/account-region.html#available-regions
-	CustomEndpoint                string                            `mapstructure:"custom_endpoint"`  // **Deprecation** Custom endpoint to ship traces to. Use only for dev and tests.
-	DrainInterval                 int                               `mapstructure:"drain_interval"`   // **Deprecation** Queue drain interval in seconds. Defaults to `3`.
-	QueueCapacity                 int64                             `mapstructure:"queue_capacity"`   // **Deprecation** Queue capacity in bytes. Defaults to `20 * 1024 * 1024` ~ 20mb.
-	QueueMaxLength                int                               `mapstructure:"queue_max_length"` // **Deprecation** Max number of items allowed in the queue. Defaults to `500000`.
+	Token                         configopaque.String               `mapstructure:"account_token"`         // Your Logz.io Account Token, can be found at https://app.logz.io/#/dashboard/settings/general
+	Region                        string                            `mapstructure:"region"`                // Your Logz.io 2-letter region code, can be found at https://docs.logz.io/user-guide/accounts/account-region.html#available-regions
+	CustomEndpoint                string                            `mapstructure:"custom_endpoint"`       // **Deprecation** Custom endpoint to ship traces to. Use only for dev and tests.
+	DrainInterval                 int                               `mapstructure:"drain_interval"`        // **Deprecation** Queue drain interval in seconds. Defaults to `3`.
+	QueueCapacity                 int64                             `mapstructure:"queue_capacity"`        // **Deprecation** Queue capacity in bytes. Defaults to `20 * 1024 * 1024` ~ 20mb.
+	QueueMaxLength                int                               `mapstructure:"queue_max_length"`      // **Deprecation** Max number of items allowed in the queue. Defaults to `500000`.
+	MetricsToken                  configopaque.String               `mapstructure:"metrics_account_token"` // Your Logz.io Metrics Account Token. Defaults to `account_token`.
+	TokenAttribute                string                            `mapstructure:"token_attribute"`       // Resource attribute holding the token of the sub-account the metrics of the resource are shipped to.
+	MetricsEndpoint               string                            `mapstructure:"metrics_endpoint"`      // Logz.io Prometheus listener the metrics are shipped to. Defaults to the listener of `region`.
 }
 
 func (c *Config) Validate() error {
@@ -41,8 +41,11 @@ func TestLoadConfig(t *testing.T) {
 	require.NoError(t, component.UnmarshalConfig(sub, cfg))
 
 	expected := &Config{
-		Token:  "token",
-		Region: "eu",
+		Token:           "token",
+		Region:          "eu",
+		MetricsToken:    "metricsToken",
+		TokenAttribute:  "logzio.token",
+		MetricsEndpoint: "https://prometheus.example.com:8053",
 	}
 	expected.RetrySettings = exporterhelper.NewDefaultRetrySettings()
 	expected.RetrySettings.MaxInterval = 5 * time.Second
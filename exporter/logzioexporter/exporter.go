@@ -22,22 +22,30 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"sort"
 	"strconv"
 	"time"
 
+	gogoproto "github.com/gogo/protobuf/proto"
+	"github.com/golang/snappy"
 	"github.com/hashicorp/go-hclog"
 	"github.com/jaegertracing/jaeger/model"
 	"github.com/jaegertracing/jaeger/pkg/cache"
+	"github.com/prometheus/prometheus/prompb"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer/consumererror"
 	"go.opentelemetry.io/collector/exporter"
 	"go.opentelemetry.io/collector/exporter/exporterhelper"
+	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/multierr"
 	"google.golang.org/genproto/googleapis/rpc/status"
 	"google.golang.org/protobuf/proto"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/translator/jaeger"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/translator/prometheusremotewrite"
 )
 
 const (
@@ -48,11 +56,12 @@ const (
 
 // logzioExporter implements an OpenTelemetry trace exporter that exports all spans to Logz.io
 type logzioExporter struct {
-	config       *Config
-	client       *http.Client
-	logger       hclog.Logger
-	settings     component.TelemetrySettings
-	serviceCache cache.Cache
+	config          *Config
+	client          *http.Client
+	logger          hclog.Logger
+	settings        component.TelemetrySettings
+	serviceCache    cache.Cache
+	metricsEndpoint string
 }
 
 func newLogzioExporter(cfg *Config, params exporter.CreateSettings) (*logzioExporter, error) {
@@ -121,6 +130,26 @@ func newLogzioLogsExporter(config *Config, set exporter.CreateSettings) (exporte
 	)
 }
 
+func newLogzioMetricsExporter(config *Config, set exporter.CreateSettings) (exporter.Metrics, error) {
+	exporter, err := newLogzioExporter(config, set)
+	if err != nil {
+		return nil, err
+	}
+	exporter.metricsEndpoint = generateMetricsEndpoint(config)
+	config.checkAndWarnDeprecatedOptions(exporter.logger)
+	return exporterhelper.NewMetricsExporter(
+		context.TODO(),
+		set,
+		config,
+		exporter.pushMetricData,
+		exporterhelper.WithStart(exporter.start),
+		// disable since we rely on http.Client timeout logic.
+		exporterhelper.WithTimeout(exporterhelper.TimeoutSettings{Timeout: 0}),
+		exporterhelper.WithQueue(config.QueueSettings),
+		exporterhelper.WithRetry(config.RetrySettings),
+	)
+}
+
 func (exporter *logzioExporter) start(_ context.Context, host component.Host) error {
 	client, err := exporter.config.HTTPClientSettings.ToClient(host, exporter.settings)
 	if err != nil {
@@ -158,6 +187,127 @@ func (exporter *logzioExporter) pushLogData(ctx context.Context, ld plog.Logs) e
 	return err
 }
 
+// pushMetricData ships the metrics to the Logz.io Prometheus listener in the Prometheus remote write format,
+// one request per account token. Only the metrics of the tokens whose request failed with a retryable error are
+// returned for retry.
+func (exporter *logzioExporter) pushMetricData(ctx context.Context, md pmetric.Metrics) error {
+	metricsByToken := map[string]pmetric.Metrics{}
+	var tokens []string
+	resourceMetrics := md.ResourceMetrics()
+	for i := 0; i < resourceMetrics.Len(); i++ {
+		token := exporter.metricsToken(resourceMetrics.At(i).Resource())
+		tokenMetrics, ok := metricsByToken[token]
+		if !ok {
+			tokenMetrics = pmetric.NewMetrics()
+			metricsByToken[token] = tokenMetrics
+			tokens = append(tokens, token)
+		}
+		resourceMetrics.At(i).CopyTo(tokenMetrics.ResourceMetrics().AppendEmpty())
+	}
+
+	var errs, permanentErrs error
+	failed := pmetric.NewMetrics()
+	for _, token := range tokens {
+		tsMap, err := prometheusremotewrite.FromMetrics(exporter.withoutTokenAttribute(metricsByToken[token]), prometheusremotewrite.Settings{})
+		if err != nil {
+			// Export anyway, since there may be points that were successfully converted.
+			permanentErrs = multierr.Append(permanentErrs, consumererror.NewPermanent(err))
+		}
+		if len(tsMap) == 0 {
+			continue
+		}
+		err = exporter.exportMetrics(ctx, token, tsMap)
+		switch {
+		case err == nil:
+		case consumererror.IsPermanent(err):
+			permanentErrs = multierr.Append(permanentErrs, err)
+		default:
+			errs = multierr.Append(errs, err)
+			metricsByToken[token].ResourceMetrics().MoveAndAppendTo(failed.ResourceMetrics())
+		}
+	}
+	if failed.ResourceMetrics().Len() == 0 {
+		return permanentErrs
+	}
+	// A permanent error would drop the retryable metrics too, so it is only logged.
+	if permanentErrs != nil {
+		exporter.logger.Error("Dropping metrics that failed with a permanent error", "error", permanentErrs)
+	}
+	return consumererror.NewMetrics(errs, failed)
+}
+
+// metricsToken returns the token of the account the metrics of the resource are shipped to:
+// the value of the token attribute of the resource if set, the metrics account token otherwise.
+func (exporter *logzioExporter) metricsToken(resource pcommon.Resource) string {
+	if exporter.config.TokenAttribute != "" {
+		if value, ok := resource.Attributes().Get(exporter.config.TokenAttribute); ok && value.AsString() != "" {
+			return value.AsString()
+		}
+	}
+	if exporter.config.MetricsToken != "" {
+		return string(exporter.config.MetricsToken)
+	}
+	return string(exporter.config.Token)
+}
+
+// withoutTokenAttribute returns a copy of the metrics without the token attribute, so that the token
+// is not shipped as a label of the target_info metric. The metrics themselves keep it to be routed on retry.
+func (exporter *logzioExporter) withoutTokenAttribute(md pmetric.Metrics) pmetric.Metrics {
+	if exporter.config.TokenAttribute == "" {
+		return md
+	}
+	stripped := pmetric.NewMetrics()
+	md.CopyTo(stripped)
+	resourceMetrics := stripped.ResourceMetrics()
+	for i := 0; i < resourceMetrics.Len(); i++ {
+		resourceMetrics.At(i).Resource().Attributes().Remove(exporter.config.TokenAttribute)
+	}
+	return stripped
+}
+
+// exportMetrics sends a Snappy-compressed WriteRequest to the Logz.io Prometheus listener, authenticated with the token.
+// 5xx responses are retryable, any other non-2xx response is a permanent error.
+func (exporter *logzioExporter) exportMetrics(ctx context.Context, token string, tsMap map[string]*prompb.TimeSeries) error {
+	writeReq := &prompb.WriteRequest{Timeseries: make([]prompb.TimeSeries, 0, len(tsMap))}
+	for _, ts := range tsMap {
+		samples := ts.Samples
+		// Prometheus requires the samples to be sorted by timestamp.
+		sort.Slice(samples, func(i, j int) bool {
+			return samples[i].Timestamp < samples[j].Timestamp
+		})
+		writeReq.Timeseries = append(writeReq.Timeseries, *ts)
+	}
+	data, err := gogoproto.Marshal(writeReq)
+	if err != nil {
+		return consumererror.NewPermanent(err)
+	}
+	exporter.logger.Debug(fmt.Sprintf("Preparing to make HTTP request with %d bytes", len(data)))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, exporter.metricsEndpoint, bytes.NewReader(snappy.Encode(nil, data)))
+	if err != nil {
+		return consumererror.NewPermanent(err)
+	}
+	req.Header.Set("Content-Encoding", "snappy")
+	req.Header.Set("Content-Type", "application/x-protobuf")
+	req.Header.Set("X-Prometheus-Remote-Write-Version", "0.1.0")
+	req.Header.Set("Authorization", "Bearer "+token)
+	resp, err := exporter.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to make an HTTP request: %w", err)
+	}
+	defer resp.Body.Close()
+	exporter.logger.Debug(fmt.Sprintf("Response status code: %d", resp.StatusCode))
+	if resp.StatusCode >= 200 && resp.StatusCode <= 299 {
+		return nil
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 256))
+	rerr := fmt.Errorf("error exporting metrics, request to %s responded with HTTP Status Code %d, err = %v: %s",
+		exporter.metricsEndpoint, resp.StatusCode, err, body)
+	if resp.StatusCode >= 500 && resp.StatusCode <= 599 {
+		return rerr
+	}
+	return consumererror.NewPermanent(rerr)
+}
+
 func (exporter *logzioExporter) pushTraceData(ctx context.Context, traces ptrace.Traces) error {
 	// a buffer to store logzio span and services bytes
 	var dataBuffer bytes.Buffer
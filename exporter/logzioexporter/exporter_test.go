@@ -19,22 +19,30 @@ import (
 	"compress/gzip"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"sort"
 	"strings"
 	"testing"
 	"time"
 
+	gogoproto "github.com/gogo/protobuf/proto"
+	"github.com/golang/snappy"
+	"github.com/prometheus/prometheus/prompb"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/config/configcompression"
 	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/exporter/exporterhelper"
 	"go.opentelemetry.io/collector/exporter/exportertest"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/pdata/ptrace"
 	conventions "go.opentelemetry.io/collector/semconv/v1.6.1"
 
@@ -182,6 +190,43 @@ func testTracesExporter(td ptrace.Traces, t *testing.T, cfg *Config) error {
 	return nil
 }
 
+// Metrics
+func newTestMetrics(tokens ...string) pmetric.Metrics {
+	md := pmetric.NewMetrics()
+	for i, token := range tokens {
+		rm := md.ResourceMetrics().AppendEmpty()
+		rm.Resource().Attributes().PutStr(conventions.AttributeServiceName, testService)
+		if token != "" {
+			rm.Resource().Attributes().PutStr("logzio.token", token)
+		}
+		m := rm.ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+		m.SetName(fmt.Sprintf("metric-%d", i))
+		dp := m.SetEmptyGauge().DataPoints().AppendEmpty()
+		dp.SetTimestamp(TestLogTimestamp)
+		dp.SetIntValue(int64(i))
+	}
+	return md
+}
+
+func testMetricsExporter(md pmetric.Metrics, t *testing.T, cfg *Config) error {
+	params := exportertest.NewNopCreateSettings()
+	exporter, err := createMetricsExporter(context.Background(), params, cfg)
+	if err != nil {
+		return err
+	}
+	err = exporter.Start(context.Background(), componenttest.NewNopHost())
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+	err = exporter.ConsumeMetrics(ctx, md)
+	if err != nil {
+		return err
+	}
+	require.NoError(t, exporter.Shutdown(ctx))
+	return nil
+}
+
 // Tests
 func TestExportErrors(tester *testing.T) {
 	type ExportErrorsTest struct {
@@ -312,3 +357,105 @@ func TestPushLogsData(tester *testing.T) {
 	assert.Equal(tester, testService, jsonLog["service.name"])
 
 }
+
+func decodeWriteRequest(t *testing.T, body []byte) *prompb.WriteRequest {
+	data, err := snappy.Decode(nil, body)
+	require.NoError(t, err)
+	writeReq := &prompb.WriteRequest{}
+	require.NoError(t, gogoproto.Unmarshal(data, writeReq))
+	return writeReq
+}
+
+func metricNames(writeReq *prompb.WriteRequest) []string {
+	var names []string
+	for _, ts := range writeReq.Timeseries {
+		for _, label := range ts.Labels {
+			if label.Name == "__name__" {
+				names = append(names, label.Value)
+			}
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+func TestPushMetricsData(tester *testing.T) {
+	recordedRequests := map[string][]byte{}
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(tester, "snappy", req.Header.Get("Content-Encoding"))
+		assert.Equal(tester, "application/x-protobuf", req.Header.Get("Content-Type"))
+		assert.Equal(tester, "0.1.0", req.Header.Get("X-Prometheus-Remote-Write-Version"))
+		recordedRequests[req.Header.Get("Authorization")], _ = io.ReadAll(req.Body)
+		rw.WriteHeader(http.StatusOK)
+	}))
+	cfg := Config{
+		Token:           "token",
+		MetricsToken:    "metricsToken",
+		TokenAttribute:  "logzio.token",
+		MetricsEndpoint: server.URL,
+		QueueSettings:   exporterhelper.QueueSettings{Enabled: false},
+		HTTPClientSettings: confighttp.HTTPClientSettings{
+			Compression: configcompression.Gzip,
+		},
+	}
+	defer server.Close()
+	md := newTestMetrics("", "subAccountToken", "subAccountToken")
+	err := testMetricsExporter(md, tester, &cfg)
+	require.NoError(tester, err)
+	require.Len(tester, recordedRequests, 2)
+
+	writeReq := decodeWriteRequest(tester, recordedRequests["Bearer metricsToken"])
+	assert.Equal(tester, []string{"metric_0"}, metricNames(writeReq))
+	for _, ts := range writeReq.Timeseries {
+		if len(ts.Samples) > 0 {
+			assert.Equal(tester, TestLogTimeUnixMilli, ts.Samples[0].Timestamp)
+		}
+	}
+
+	writeReq = decodeWriteRequest(tester, recordedRequests["Bearer subAccountToken"])
+	// the sub-account token is not shipped as a label of a target_info metric
+	assert.Equal(tester, []string{"metric_1", "metric_2"}, metricNames(writeReq))
+}
+
+func TestPushMetricsDataFailedTokens(tester *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		switch req.Header.Get("Authorization") {
+		case "Bearer retryableToken":
+			rw.WriteHeader(http.StatusServiceUnavailable)
+		case "Bearer invalidToken":
+			rw.WriteHeader(http.StatusUnauthorized)
+		default:
+			rw.WriteHeader(http.StatusOK)
+		}
+	}))
+	defer server.Close()
+	cfg := &Config{
+		Token:           "token",
+		TokenAttribute:  "logzio.token",
+		MetricsEndpoint: server.URL,
+	}
+	exporter, err := newLogzioExporter(cfg, exportertest.NewNopCreateSettings())
+	require.NoError(tester, err)
+	exporter.metricsEndpoint = generateMetricsEndpoint(cfg)
+	require.NoError(tester, exporter.start(context.Background(), componenttest.NewNopHost()))
+
+	err = exporter.pushMetricData(context.Background(), newTestMetrics("", "retryableToken", "invalidToken", "retryableToken"))
+	require.Error(tester, err)
+	assert.False(tester, consumererror.IsPermanent(err))
+	var metricsErr consumererror.Metrics
+	require.True(tester, errors.As(err, &metricsErr))
+	// only the metrics of the token that failed with a retryable error are retried, with their token.
+	failed := metricsErr.Data()
+	require.Equal(tester, 2, failed.ResourceMetrics().Len())
+	for i := 0; i < failed.ResourceMetrics().Len(); i++ {
+		token, ok := failed.ResourceMetrics().At(i).Resource().Attributes().Get("logzio.token")
+		require.True(tester, ok)
+		assert.Equal(tester, "retryableToken", token.Str())
+	}
+	assert.Equal(tester, "metric-1", failed.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Name())
+	assert.Equal(tester, "metric-3", failed.ResourceMetrics().At(1).ScopeMetrics().At(0).Metrics().At(0).Name())
+
+	err = exporter.pushMetricData(context.Background(), newTestMetrics("invalidToken"))
+	require.Error(tester, err)
+	assert.True(tester, consumererror.IsPermanent(err))
+}
@@ -41,7 +41,8 @@ func NewFactory() exporter.Factory {
 		typeStr,
 		createDefaultConfig,
 		exporter.WithTraces(createTracesExporter, stability),
-		exporter.WithLogs(createLogsExporter, component.StabilityLevelBeta))
+		exporter.WithLogs(createLogsExporter, component.StabilityLevelBeta),
+		exporter.WithMetrics(createMetricsExporter, component.StabilityLevelAlpha))
 
 }
 
@@ -87,6 +88,37 @@ func getListenerURL(region string) string {
 	return url
 }
 
+func getMetricsListenerURL(region string) string {
+	var url string
+	lowerCaseRegion := strings.ToLower(region)
+	switch lowerCaseRegion {
+	case "us":
+		url = "https://listener.logz.io:8053"
+	case "ca":
+		url = "https://listener-ca.logz.io:8053"
+	case "eu":
+		url = "https://listener-eu.logz.io:8053"
+	case "uk":
+		url = "https://listener-uk.logz.io:8053"
+	case "au":
+		url = "https://listener-au.logz.io:8053"
+	case "nl":
+		url = "https://listener-nl.logz.io:8053"
+	case "wa":
+		url = "https://listener-wa.logz.io:8053"
+	default:
+		url = "https://listener.logz.io:8053"
+	}
+	return url
+}
+
+func generateMetricsEndpoint(cfg *Config) string {
+	if cfg.MetricsEndpoint != "" {
+		return cfg.MetricsEndpoint
+	}
+	return getMetricsListenerURL(cfg.Region)
+}
+
 func generateEndpoint(cfg *Config) (string, error) {
 	defaultURL := fmt.Sprintf("%s/?token=%s", getListenerURL(""), cfg.Token)
 	switch {
@@ -110,3 +142,8 @@ func createLogsExporter(_ context.Context, params exporter.CreateSettings, cfg c
 	exporterConfig := cfg.(*Config)
 	return newLogzioLogsExporter(exporterConfig, params)
 }
+
+func createMetricsExporter(_ context.Context, params exporter.CreateSettings, cfg component.Config) (exporter.Metrics, error) {
+	exporterConfig := cfg.(*Config)
+	return newLogzioMetricsExporter(exporterConfig, params)
+}
@@ -51,6 +51,22 @@ func TestCreateTracesExporter(t *testing.T) {
 	assert.NotNil(t, exporter)
 }
 
+func TestCreateMetricsExporter(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig()
+
+	sub, err := cm.Sub(component.NewIDWithName(typeStr, "2").String())
+	require.NoError(t, err)
+	require.NoError(t, component.UnmarshalConfig(sub, cfg))
+
+	params := exportertest.NewNopCreateSettings()
+	exporter, err := factory.CreateMetricsExporter(context.Background(), params, cfg)
+	assert.Nil(t, err)
+	assert.NotNil(t, exporter)
+}
+
 func TestGenerateUrl(t *testing.T) {
 	type generateURLTest struct {
 		endpoint string
@@ -104,3 +120,50 @@ func TestGetListenerURL(t *testing.T) {
 		require.Equal(t, output, test.expected)
 	}
 }
+
+func TestGenerateMetricsEndpoint(t *testing.T) {
+	type generateMetricsEndpointTest struct {
+		endpoint string
+		region   string
+		expected string
+	}
+	var generateMetricsEndpointTests = []generateMetricsEndpointTest{
+		{"", "", "https://listener.logz.io:8053"},
+		{"", "us", "https://listener.logz.io:8053"},
+		{"", "EU", "https://listener-eu.logz.io:8053"},
+		{"", "not-valid", "https://listener.logz.io:8053"},
+		{"https://doesnotexist.com", "", "https://doesnotexist.com"},
+		{"https://doesnotexist.com", "eu", "https://doesnotexist.com"},
+	}
+	for _, test := range generateMetricsEndpointTests {
+		cfg := &Config{
+			Region:          test.region,
+			Token:           "token",
+			MetricsEndpoint: test.endpoint,
+		}
+		require.Equal(t, test.expected, generateMetricsEndpoint(cfg))
+	}
+}
+
+func TestGetMetricsListenerURL(t *testing.T) {
+	type getMetricsListenerURLTest struct {
+		arg1     string
+		expected string
+	}
+	var getMetricsListenerURLTests = []getMetricsListenerURLTest{
+		{"us", "https://listener.logz.io:8053"},
+		{"eu", "https://listener-eu.logz.io:8053"},
+		{"au", "https://listener-au.logz.io:8053"},
+		{"ca", "https://listener-ca.logz.io:8053"},
+		{"nl", "https://listener-nl.logz.io:8053"},
+		{"uk", "https://listener-uk.logz.io:8053"},
+		{"wa", "https://listener-wa.logz.io:8053"},
+		{"not-valid", "https://listener.logz.io:8053"},
+		{"", "https://listener.logz.io:8053"},
+		{"US", "https://listener.logz.io:8053"},
+	}
+	for _, test := range getMetricsListenerURLTests {
+		output := getMetricsListenerURL(test.arg1)
+		require.Equal(t, test.expected, output)
+	}
+}
@@ -29,6 +29,7 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/grpccompression"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/translator/opencensus"
 )
 
@@ -80,7 +81,8 @@ func newOcExporter(_ context.Context, cfg *Config, settings component.TelemetryS
 
 // start creates the gRPC client Connection
 func (oce *ocExporter) start(ctx context.Context, host component.Host) error {
-	clientConn, err := oce.cfg.GRPCClientSettings.ToClientConn(ctx, host, oce.settings)
+	negotiator := grpccompression.NewNegotiator(string(oce.cfg.GRPCClientSettings.Compression), oce.settings.Logger)
+	clientConn, err := oce.cfg.GRPCClientSettings.ToClientConn(ctx, host, oce.settings, negotiator.DialOptions()...)
 	if err != nil {
 		return err
 	}
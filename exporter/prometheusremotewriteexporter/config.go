@@ -53,6 +53,29 @@ type Config struct {
 
 	// CreatedMetric allows customizing creation of _created metrics
 	CreatedMetric *CreatedMetric `mapstructure:"export_created_metric,omitempty"`
+
+	// Filter allows dropping metrics before they are translated to time series.
+	Filter FilterSettings `mapstructure:"filter"`
+}
+
+// FilterSettings defines the metrics that are exported. The matchers are evaluated
+// against the OTLP metric names and data point attributes, before translation.
+type FilterSettings struct {
+	// Include drops the data points that don't match. If nil, all data points are included.
+	Include *MetricMatchConfig `mapstructure:"include"`
+	// Exclude drops the data points that match. If nil, no data point is excluded.
+	Exclude *MetricMatchConfig `mapstructure:"exclude"`
+}
+
+// MetricMatchConfig matches data points by metric name and attribute values.
+// A data point matches when its metric name matches any of MetricNames, if any,
+// and when its attributes match all of Labels, if any.
+type MetricMatchConfig struct {
+	// MetricNames are regular expressions matching the whole metric name.
+	MetricNames []string `mapstructure:"metric_names"`
+	// Labels are regular expressions matching the whole value of the attribute with the given name.
+	// A missing attribute is matched as an empty value.
+	Labels map[string]string `mapstructure:"labels"`
 }
 
 type CreatedMetric struct {
@@ -98,6 +121,10 @@ func (cfg *Config) Validate() error {
 		return fmt.Errorf("remote write consumer number can't be negative")
 	}
 
+	if _, err := newMetricsFilter(cfg.Filter); err != nil {
+		return err
+	}
+
 	if cfg.TargetInfo == nil {
 		cfg.TargetInfo = &TargetInfo{
 			Enabled: true,
@@ -93,6 +93,27 @@ func TestLoadConfig(t *testing.T) {
 			id:           component.NewIDWithName(typeStr, "negative_num_consumers"),
 			errorMessage: "remote write consumer number can't be negative",
 		},
+		{
+			id: component.NewIDWithName(typeStr, "filter"),
+			expected: func() component.Config {
+				cfg := createDefaultConfig().(*Config)
+				cfg.HTTPClientSettings.Endpoint = "localhost:8888"
+				cfg.Filter = FilterSettings{
+					Include: &MetricMatchConfig{
+						MetricNames: []string{"http_.*", "rpc_.*"},
+					},
+					Exclude: &MetricMatchConfig{
+						MetricNames: []string{"http_client_.*"},
+						Labels:      map[string]string{"env": "dev|test"},
+					},
+				}
+				return cfg
+			}(),
+		},
+		{
+			id:           component.NewIDWithName(typeStr, "empty_exclude_filter"),
+			errorMessage: "invalid exclude filter: filter match config must specify metric_names or labels",
+		},
 	}
 
 	for _, tt := range tests {
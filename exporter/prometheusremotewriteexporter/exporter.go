@@ -55,6 +55,7 @@ type prwExporter struct {
 
 	wal              *prweWAL
 	exporterSettings prometheusremotewrite.Settings
+	filter           *metricsFilter
 }
 
 // newPRWExporter initializes a new prwExporter instance and sets fields accordingly.
@@ -69,6 +70,14 @@ func newPRWExporter(cfg *Config, set exporter.CreateSettings) (*prwExporter, err
 		return nil, errors.New("invalid endpoint")
 	}
 
+	filter, err := newMetricsFilter(cfg.Filter)
+	if err != nil {
+		return nil, err
+	}
+	if filter != nil {
+		filter.exporter = set.ID.String()
+	}
+
 	userAgentHeader := fmt.Sprintf("%s/%s", strings.ReplaceAll(strings.ToLower(set.BuildInfo.Description), " ", "-"), set.BuildInfo.Version)
 
 	prwe := &prwExporter{
@@ -85,6 +94,7 @@ func newPRWExporter(cfg *Config, set exporter.CreateSettings) (*prwExporter, err
 			DisableTargetInfo:   !cfg.TargetInfo.Enabled,
 			ExportCreatedMetric: cfg.CreatedMetric.Enabled,
 		},
+		filter: filter,
 	}
 	if cfg.WAL == nil {
 		return prwe, nil
@@ -137,6 +147,9 @@ func (prwe *prwExporter) PushMetrics(ctx context.Context, md pmetric.Metrics) er
 	case <-prwe.closeChan:
 		return errors.New("shutdown has been called")
 	default:
+		if prwe.filter != nil {
+			prwe.filter.filter(ctx, md)
+		}
 		tsMap, err := prometheusremotewrite.FromMetrics(md, prwe.exporterSettings)
 		if err != nil {
 			err = consumererror.NewPermanent(err)
@@ -17,11 +17,14 @@ package prometheusremotewriteexporter // import "github.com/open-telemetry/opent
 import (
 	"context"
 	"errors"
+	"sync"
 	"time"
 
+	"go.opencensus.io/stats/view"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/config/configopaque"
+	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/exporter"
 	"go.opentelemetry.io/collector/exporter/exporterhelper"
 
@@ -35,8 +38,15 @@ const (
 	stability = component.StabilityLevelBeta
 )
 
+var once sync.Once
+
 // NewFactory creates a new Prometheus Remote Write exporter.
 func NewFactory() exporter.Factory {
+	once.Do(func() {
+		// TODO: as with other -contrib factories registering metrics, this is causing the error being ignored
+		_ = view.Register(MetricViews()...)
+	})
+
 	return exporter.NewFactory(
 		typeStr,
 		createDefaultConfig,
@@ -76,6 +86,8 @@ func createMetricsExporter(ctx context.Context, set exporter.CreateSettings,
 		exporterhelper.WithRetry(prwCfg.RetrySettings),
 		exporterhelper.WithStart(prwe.Start),
 		exporterhelper.WithShutdown(prwe.Shutdown),
+		// The filter removes data points from the incoming metrics.
+		exporterhelper.WithCapabilities(consumer.Capabilities{MutatesData: prwe.filter != nil}),
 	)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,227 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheusremotewriteexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/prometheusremotewriteexporter"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+	"go.opentelemetry.io/collector/obsreport"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+var (
+	tagExporterKey, _ = tag.NewKey("exporter")
+	tagFilterKey, _   = tag.NewKey("filter")
+
+	statFilteredDataPoints = stats.Int64("filtered_data_points", "Number of data points dropped by the include and exclude filters before translation", stats.UnitDimensionless)
+)
+
+// MetricViews return the metrics views according to given telemetry level.
+func MetricViews() []*view.View {
+	return []*view.View{
+		{
+			Name:        obsreport.BuildExporterCustomMetricName(typeStr, statFilteredDataPoints.Name()),
+			Measure:     statFilteredDataPoints,
+			Description: statFilteredDataPoints.Description(),
+			TagKeys:     []tag.Key{tagExporterKey, tagFilterKey},
+			Aggregation: view.Sum(),
+		},
+	}
+}
+
+var errEmptyMatchConfig = errors.New("filter match config must specify metric_names or labels")
+
+// metricMatcher is the compiled form of a MetricMatchConfig.
+type metricMatcher struct {
+	names  []*regexp.Regexp
+	labels map[string]*regexp.Regexp
+}
+
+func newMetricMatcher(cfg *MetricMatchConfig) (*metricMatcher, error) {
+	if cfg == nil {
+		return nil, nil
+	}
+	if len(cfg.MetricNames) == 0 && len(cfg.Labels) == 0 {
+		return nil, errEmptyMatchConfig
+	}
+
+	m := &metricMatcher{labels: make(map[string]*regexp.Regexp, len(cfg.Labels))}
+	for _, name := range cfg.MetricNames {
+		re, err := regexp.Compile("^(?:" + name + ")$")
+		if err != nil {
+			return nil, fmt.Errorf("invalid metric name regexp %q: %w", name, err)
+		}
+		m.names = append(m.names, re)
+	}
+	for label, value := range cfg.Labels {
+		re, err := regexp.Compile("^(?:" + value + ")$")
+		if err != nil {
+			return nil, fmt.Errorf("invalid regexp %q for label %q: %w", value, label, err)
+		}
+		m.labels[label] = re
+	}
+	return m, nil
+}
+
+func (m *metricMatcher) matchName(name string) bool {
+	if len(m.names) == 0 {
+		return true
+	}
+	for _, re := range m.names {
+		if re.MatchString(name) {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *metricMatcher) matchLabels(attrs pcommon.Map) bool {
+	for label, re := range m.labels {
+		var value string
+		if v, ok := attrs.Get(label); ok {
+			value = v.AsString()
+		}
+		if !re.MatchString(value) {
+			return false
+		}
+	}
+	return true
+}
+
+// metricsFilter drops the data points that won't be exported, so that no time is spent translating them.
+type metricsFilter struct {
+	include *metricMatcher
+	exclude *metricMatcher
+	// exporter is the ID of the exporter the dropped data points are recorded for.
+	exporter string
+}
+
+// newMetricsFilter returns nil if no filter is configured.
+func newMetricsFilter(cfg FilterSettings) (*metricsFilter, error) {
+	include, err := newMetricMatcher(cfg.Include)
+	if err != nil {
+		return nil, fmt.Errorf("invalid include filter: %w", err)
+	}
+	exclude, err := newMetricMatcher(cfg.Exclude)
+	if err != nil {
+		return nil, fmt.Errorf("invalid exclude filter: %w", err)
+	}
+	if include == nil && exclude == nil {
+		return nil, nil
+	}
+	return &metricsFilter{include: include, exclude: exclude}, nil
+}
+
+// filter removes from md the data points that are not included or are excluded, and the
+// metrics left without data points. The dropped data points are recorded.
+func (f *metricsFilter) filter(ctx context.Context, md pmetric.Metrics) {
+	var notIncluded, excluded int64
+	rms := md.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		sms := rms.At(i).ScopeMetrics()
+		for j := 0; j < sms.Len(); j++ {
+			sms.At(j).Metrics().RemoveIf(func(metric pmetric.Metric) bool {
+				includeName := f.include == nil || f.include.matchName(metric.Name())
+				excludeName := f.exclude != nil && f.exclude.matchName(metric.Name())
+
+				// The data points are only checked when the decision depends on their attributes.
+				if !includeName || (excludeName && len(f.exclude.labels) == 0) {
+					count := int64(dataPointCount(metric))
+					if !includeName {
+						notIncluded += count
+					} else {
+						excluded += count
+					}
+					return true
+				}
+				checkInclude := f.include != nil && len(f.include.labels) > 0
+				if !checkInclude && !excludeName {
+					return false
+				}
+
+				return removeDataPointsIf(metric, func(attrs pcommon.Map) bool {
+					if checkInclude && !f.include.matchLabels(attrs) {
+						notIncluded++
+						return true
+					}
+					if excludeName && f.exclude.matchLabels(attrs) {
+						excluded++
+						return true
+					}
+					return false
+				})
+			})
+		}
+	}
+
+	if notIncluded > 0 {
+		f.recordFiltered(ctx, "include", notIncluded)
+	}
+	if excluded > 0 {
+		f.recordFiltered(ctx, "exclude", excluded)
+	}
+}
+
+func (f *metricsFilter) recordFiltered(ctx context.Context, filter string, count int64) {
+	_ = stats.RecordWithTags(
+		ctx,
+		[]tag.Mutator{tag.Upsert(tagExporterKey, f.exporter), tag.Upsert(tagFilterKey, filter)},
+		statFilteredDataPoints.M(count),
+	)
+}
+
+// removeDataPointsIf removes the data points of the metric whose attributes match f, and
+// returns true if the metric had data points and none are left.
+func removeDataPointsIf(metric pmetric.Metric, f func(pcommon.Map) bool) bool {
+	if dataPointCount(metric) == 0 {
+		return false
+	}
+	switch metric.Type() {
+	case pmetric.MetricTypeGauge:
+		metric.Gauge().DataPoints().RemoveIf(func(dp pmetric.NumberDataPoint) bool { return f(dp.Attributes()) })
+	case pmetric.MetricTypeSum:
+		metric.Sum().DataPoints().RemoveIf(func(dp pmetric.NumberDataPoint) bool { return f(dp.Attributes()) })
+	case pmetric.MetricTypeHistogram:
+		metric.Histogram().DataPoints().RemoveIf(func(dp pmetric.HistogramDataPoint) bool { return f(dp.Attributes()) })
+	case pmetric.MetricTypeExponentialHistogram:
+		metric.ExponentialHistogram().DataPoints().RemoveIf(func(dp pmetric.ExponentialHistogramDataPoint) bool { return f(dp.Attributes()) })
+	case pmetric.MetricTypeSummary:
+		metric.Summary().DataPoints().RemoveIf(func(dp pmetric.SummaryDataPoint) bool { return f(dp.Attributes()) })
+	}
+	return dataPointCount(metric) == 0
+}
+
+func dataPointCount(metric pmetric.Metric) int {
+	switch metric.Type() {
+	case pmetric.MetricTypeGauge:
+		return metric.Gauge().DataPoints().Len()
+	case pmetric.MetricTypeSum:
+		return metric.Sum().DataPoints().Len()
+	case pmetric.MetricTypeHistogram:
+		return metric.Histogram().DataPoints().Len()
+	case pmetric.MetricTypeExponentialHistogram:
+		return metric.ExponentialHistogram().DataPoints().Len()
+	case pmetric.MetricTypeSummary:
+		return metric.Summary().DataPoints().Len()
+	}
+	return 0
+}
@@ -0,0 +1,258 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheusremotewriteexporter
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+func TestNewMetricsFilter(t *testing.T) {
+	tests := []struct {
+		name         string
+		cfg          FilterSettings
+		wantNil      bool
+		errorMessage string
+	}{
+		{
+			name:    "no filter",
+			wantNil: true,
+		},
+		{
+			name: "include and exclude",
+			cfg: FilterSettings{
+				Include: &MetricMatchConfig{MetricNames: []string{"http_.*"}},
+				Exclude: &MetricMatchConfig{Labels: map[string]string{"env": "dev"}},
+			},
+		},
+		{
+			name:         "empty include",
+			cfg:          FilterSettings{Include: &MetricMatchConfig{}},
+			errorMessage: "invalid include filter: filter match config must specify metric_names or labels",
+		},
+		{
+			name:         "invalid metric name",
+			cfg:          FilterSettings{Exclude: &MetricMatchConfig{MetricNames: []string{"http_("}}},
+			errorMessage: "invalid exclude filter: invalid metric name regexp \"http_(\": error parsing regexp: missing closing ): `^(?:http_()$`",
+		},
+		{
+			name:         "invalid label",
+			cfg:          FilterSettings{Include: &MetricMatchConfig{Labels: map[string]string{"env": "["}}},
+			errorMessage: "invalid include filter: invalid regexp \"[\" for label \"env\": error parsing regexp: missing closing ]: `[)$`",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := newMetricsFilter(tt.cfg)
+			if tt.errorMessage != "" {
+				assert.EqualError(t, err, tt.errorMessage)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantNil, f == nil)
+		})
+	}
+}
+
+func TestMetricsFilter(t *testing.T) {
+	tests := []struct {
+		name     string
+		cfg      FilterSettings
+		expected map[string][]string
+	}{
+		{
+			name: "include metric names",
+			cfg: FilterSettings{
+				Include: &MetricMatchConfig{MetricNames: []string{"http_.*"}},
+			},
+			expected: map[string][]string{
+				"http_requests": {"prod", "dev", ""},
+				"http_latency":  {"prod", "dev"},
+			},
+		},
+		{
+			name: "names match the whole metric name",
+			cfg: FilterSettings{
+				Include: &MetricMatchConfig{MetricNames: []string{"http"}},
+			},
+			expected: map[string][]string{},
+		},
+		{
+			name: "exclude metric names",
+			cfg: FilterSettings{
+				Exclude: &MetricMatchConfig{MetricNames: []string{"http_latency", "rpc_.*"}},
+			},
+			expected: map[string][]string{
+				"http_requests": {"prod", "dev", ""},
+			},
+		},
+		{
+			name: "include labels",
+			cfg: FilterSettings{
+				Include: &MetricMatchConfig{Labels: map[string]string{"env": "prod"}},
+			},
+			expected: map[string][]string{
+				"http_requests": {"prod"},
+				"http_latency":  {"prod"},
+				"rpc_requests":  {"prod"},
+			},
+		},
+		{
+			name: "exclude labels matches missing attributes as empty",
+			cfg: FilterSettings{
+				Exclude: &MetricMatchConfig{Labels: map[string]string{"env": "dev|"}},
+			},
+			expected: map[string][]string{
+				"http_requests": {"prod"},
+				"http_latency":  {"prod"},
+				"rpc_requests":  {"prod"},
+			},
+		},
+		{
+			name: "include names and exclude names with labels",
+			cfg: FilterSettings{
+				Include: &MetricMatchConfig{MetricNames: []string{"http_.*", "rpc_.*"}},
+				Exclude: &MetricMatchConfig{
+					MetricNames: []string{"http_.*"},
+					Labels:      map[string]string{"env": "dev"},
+				},
+			},
+			expected: map[string][]string{
+				"http_requests": {"prod", ""},
+				"http_latency":  {"prod"},
+				"rpc_requests":  {"prod", "dev"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := newMetricsFilter(tt.cfg)
+			require.NoError(t, err)
+
+			md := generateFilterTestMetrics()
+			f.filter(context.Background(), md)
+			assert.Equal(t, tt.expected, filterTestMetricsEnvs(md))
+		})
+	}
+}
+
+func TestMetricsFilterRecordsDroppedDataPoints(t *testing.T) {
+	views := MetricViews()
+	view.Unregister(views...)
+	require.NoError(t, view.Register(views...))
+	defer view.Unregister(views...)
+
+	for _, exporter := range []string{"prometheusremotewrite/a", "prometheusremotewrite/b"} {
+		f, err := newMetricsFilter(FilterSettings{
+			Include: &MetricMatchConfig{MetricNames: []string{"http_.*"}},
+			Exclude: &MetricMatchConfig{Labels: map[string]string{"env": "dev"}},
+		})
+		require.NoError(t, err)
+		f.exporter = exporter
+		f.filter(context.Background(), generateFilterTestMetrics())
+	}
+
+	rows, err := view.RetrieveData(views[0].Name)
+	require.NoError(t, err)
+	filtered := map[string]int64{}
+	for _, row := range rows {
+		filtered[tagValue(row.Tags, tagExporterKey)+" "+tagValue(row.Tags, tagFilterKey)] = int64(row.Data.(*view.SumData).Value)
+	}
+	assert.Equal(t, map[string]int64{
+		"prometheusremotewrite/a include": 2,
+		"prometheusremotewrite/a exclude": 2,
+		"prometheusremotewrite/b include": 2,
+		"prometheusremotewrite/b exclude": 2,
+	}, filtered)
+}
+
+func tagValue(tags []tag.Tag, key tag.Key) string {
+	for _, t := range tags {
+		if t.Key == key {
+			return t.Value
+		}
+	}
+	return ""
+}
+
+// generateFilterTestMetrics returns a gauge, a histogram and a sum with one data point per env attribute value.
+// An empty value stands for a data point without the attribute.
+func generateFilterTestMetrics() pmetric.Metrics {
+	md := pmetric.NewMetrics()
+	metrics := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics()
+
+	requests := metrics.AppendEmpty()
+	requests.SetName("http_requests")
+	requests.SetEmptyGauge()
+	for _, env := range []string{"prod", "dev", ""} {
+		dp := requests.Gauge().DataPoints().AppendEmpty()
+		if env != "" {
+			dp.Attributes().PutStr("env", env)
+		}
+	}
+
+	latency := metrics.AppendEmpty()
+	latency.SetName("http_latency")
+	latency.SetEmptyHistogram()
+	for _, env := range []string{"prod", "dev"} {
+		latency.Histogram().DataPoints().AppendEmpty().Attributes().PutStr("env", env)
+	}
+
+	rpc := metrics.AppendEmpty()
+	rpc.SetName("rpc_requests")
+	rpc.SetEmptySum()
+	for _, env := range []string{"prod", "dev"} {
+		rpc.Sum().DataPoints().AppendEmpty().Attributes().PutStr("env", env)
+	}
+	return md
+}
+
+func filterTestMetricsEnvs(md pmetric.Metrics) map[string][]string {
+	envs := map[string][]string{}
+	metrics := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	for i := 0; i < metrics.Len(); i++ {
+		metric := metrics.At(i)
+		envs[metric.Name()] = []string{}
+		add := func(attrs pcommon.Map) {
+			var env string
+			if v, ok := attrs.Get("env"); ok {
+				env = v.Str()
+			}
+			envs[metric.Name()] = append(envs[metric.Name()], env)
+		}
+		switch metric.Type() {
+		case pmetric.MetricTypeGauge:
+			for j := 0; j < metric.Gauge().DataPoints().Len(); j++ {
+				add(metric.Gauge().DataPoints().At(j).Attributes())
+			}
+		case pmetric.MetricTypeHistogram:
+			for j := 0; j < metric.Histogram().DataPoints().Len(); j++ {
+				add(metric.Histogram().DataPoints().At(j).Attributes())
+			}
+		case pmetric.MetricTypeSum:
+			for j := 0; j < metric.Sum().DataPoints().Len(); j++ {
+				add(metric.Sum().DataPoints().At(j).Attributes())
+			}
+		}
+	}
+	return envs
+}
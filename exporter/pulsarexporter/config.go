@@ -15,7 +15,12 @@
 package pulsarexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/pulsarexporter"
 
 import (
+	"errors"
+	"fmt"
+	"time"
+
 	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/linkedin/goavro/v2"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config/configopaque"
 	"go.opentelemetry.io/collector/exporter/exporterhelper"
@@ -38,6 +43,36 @@ type Config struct {
 	// Configure whether the Pulsar client accept untrusted TLS certificate from broker (default: false)
 	TLSAllowInsecureConnection bool           `mapstructure:"tls_allow_insecure_connection"`
 	Authentication             Authentication `mapstructure:"auth"`
+	// Producer configures how the messages are batched and keyed
+	Producer Producer `mapstructure:"producer"`
+	// Schema configures the schema registered on the topic (default: no schema)
+	Schema Schema `mapstructure:"schema"`
+}
+
+type Producer struct {
+	// Disable the batching of the messages by the producer (default: false)
+	DisableBatching bool `mapstructure:"disable_batching"`
+	// The batch builder used by the producer, "default" or "key_based" (default: "default").
+	// The key based batch builder groups the messages with the same key in the same batch.
+	BatchBuilder string `mapstructure:"batch_builder"`
+	// Maximum delay before a batch of messages is sent (default: 10ms)
+	BatchingMaxPublishDelay time.Duration `mapstructure:"batching_max_publish_delay"`
+	// Maximum number of messages in a batch (default: 1000)
+	BatchingMaxMessages uint `mapstructure:"batching_max_messages"`
+	// Maximum size of a batch in bytes (default: 128KB)
+	BatchingMaxSize uint `mapstructure:"batching_max_size"`
+	// Resource attribute whose value is used as the message key. When set, the data
+	// is split by resource so that each message carries a single key.
+	KeyAttribute string `mapstructure:"key_attribute"`
+}
+
+type Schema struct {
+	// Type of the schema, "json" or "avro"
+	Type string `mapstructure:"type"`
+	// Avro definition of the JSON schema, required for the "json" type
+	Definition string `mapstructure:"definition"`
+	// Properties of the schema
+	Properties map[string]string `mapstructure:"properties"`
 }
 
 type Authentication struct {
@@ -76,7 +111,31 @@ var _ component.Config = (*Config)(nil)
 
 // Validate checks if the exporter configuration is valid
 func (cfg *Config) Validate() error {
+	switch cfg.Producer.BatchBuilder {
+	case "", defaultBatchBuilder, keyBasedBatchBuilder:
+	default:
+		return fmt.Errorf("producer.batch_builder must be either %q or %q, got %q", defaultBatchBuilder, keyBasedBatchBuilder, cfg.Producer.BatchBuilder)
+	}
 
+	switch cfg.Schema.Type {
+	case "":
+	case schemaTypeJSON:
+		if cfg.Encoding != "otlp_json" && cfg.Encoding != "jaeger_json" {
+			return fmt.Errorf("schema.type %q requires a JSON encoding, got %q", schemaTypeJSON, cfg.Encoding)
+		}
+		if cfg.Schema.Definition == "" {
+			return errors.New("schema.definition is required for the json schema type")
+		}
+		if _, err := goavro.NewCodec(cfg.Schema.Definition); err != nil {
+			return fmt.Errorf("schema.definition is not a valid Avro schema: %w", err)
+		}
+	case schemaTypeAvro:
+		if cfg.Schema.Definition != "" {
+			return errors.New("schema.definition is not supported for the avro schema type")
+		}
+	default:
+		return fmt.Errorf("schema.type must be either %q or %q, got %q", schemaTypeJSON, schemaTypeAvro, cfg.Schema.Type)
+	}
 	return nil
 }
 
@@ -124,3 +183,27 @@ func (cfg *Config) clientOptions() pulsar.ClientOptions {
 
 	return options
 }
+
+func (cfg *Config) producerOptions() pulsar.ProducerOptions {
+	options := pulsar.ProducerOptions{
+		Topic:                   cfg.Topic,
+		SendTimeout:             cfg.Timeout,
+		DisableBatching:         cfg.Producer.DisableBatching,
+		BatchingMaxPublishDelay: cfg.Producer.BatchingMaxPublishDelay,
+		BatchingMaxMessages:     cfg.Producer.BatchingMaxMessages,
+		BatchingMaxSize:         cfg.Producer.BatchingMaxSize,
+	}
+
+	if cfg.Producer.BatchBuilder == keyBasedBatchBuilder {
+		options.BatcherBuilderType = pulsar.KeyBasedBatchBuilder
+	}
+
+	switch cfg.Schema.Type {
+	case schemaTypeJSON:
+		options.Schema = pulsar.NewJSONSchema(cfg.Schema.Definition, cfg.Schema.Properties)
+	case schemaTypeAvro:
+		options.Schema = pulsar.NewAvroSchema(avroPayloadSchema, cfg.Schema.Properties)
+	}
+
+	return options
+}
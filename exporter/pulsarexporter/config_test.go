@@ -61,6 +61,29 @@ func TestLoadConfig(t *testing.T) {
 				Authentication:        Authentication{TLS: &TLS{CertFile: "cert.pem", KeyFile: "key.pem"}},
 			},
 		},
+		{
+			id: component.NewIDWithName(typeStr, "key_shared"),
+			expected: &Config{
+				TimeoutSettings: exporterhelper.NewDefaultTimeoutSettings(),
+				RetrySettings:   exporterhelper.NewDefaultRetrySettings(),
+				QueueSettings:   exporterhelper.NewDefaultQueueSettings(),
+				Endpoint:        defaultBroker,
+				Topic:           "spans",
+				Encoding:        "otlp_json",
+				Producer: Producer{
+					BatchBuilder:            keyBasedBatchBuilder,
+					BatchingMaxPublishDelay: 50 * time.Millisecond,
+					BatchingMaxMessages:     500,
+					BatchingMaxSize:         65536,
+					KeyAttribute:            "service.name",
+				},
+				Schema: Schema{
+					Type:       schemaTypeJSON,
+					Definition: `{"type":"record","name":"ExportTraceServiceRequest","fields":[]}`,
+					Properties: map[string]string{"owner": "otel"},
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -97,3 +120,86 @@ func TestClientOptions(t *testing.T) {
 	}, &options)
 
 }
+
+func TestValidateConfig(t *testing.T) {
+	tests := []struct {
+		name        string
+		config      *Config
+		expectedErr string
+	}{
+		{
+			name:        "invalid batch builder",
+			config:      &Config{Encoding: defaultEncoding, Producer: Producer{BatchBuilder: "unknown"}},
+			expectedErr: `producer.batch_builder must be either "default" or "key_based", got "unknown"`,
+		},
+		{
+			name:        "invalid schema type",
+			config:      &Config{Encoding: defaultEncoding, Schema: Schema{Type: "protobuf"}},
+			expectedErr: `schema.type must be either "json" or "avro", got "protobuf"`,
+		},
+		{
+			name:        "json schema with proto encoding",
+			config:      &Config{Encoding: defaultEncoding, Schema: Schema{Type: schemaTypeJSON, Definition: `{"type":"string"}`}},
+			expectedErr: `schema.type "json" requires a JSON encoding, got "otlp_proto"`,
+		},
+		{
+			name:        "json schema without definition",
+			config:      &Config{Encoding: "otlp_json", Schema: Schema{Type: schemaTypeJSON}},
+			expectedErr: "schema.definition is required for the json schema type",
+		},
+		{
+			name:        "json schema with invalid definition",
+			config:      &Config{Encoding: "otlp_json", Schema: Schema{Type: schemaTypeJSON, Definition: `{"type":"unknown"}`}},
+			expectedErr: "schema.definition is not a valid Avro schema",
+		},
+		{
+			name:        "avro schema with definition",
+			config:      &Config{Encoding: defaultEncoding, Schema: Schema{Type: schemaTypeAvro, Definition: `{"type":"string"}`}},
+			expectedErr: "schema.definition is not supported for the avro schema type",
+		},
+		{
+			name:   "avro schema",
+			config: &Config{Encoding: defaultEncoding, Schema: Schema{Type: schemaTypeAvro}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.Validate()
+			if tt.expectedErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.expectedErr)
+		})
+	}
+}
+
+func TestProducerOptions(t *testing.T) {
+	cfg := &Config{
+		TimeoutSettings: exporterhelper.TimeoutSettings{Timeout: 5 * time.Second},
+		Topic:           "spans",
+		Producer: Producer{
+			BatchBuilder:            keyBasedBatchBuilder,
+			BatchingMaxPublishDelay: 50 * time.Millisecond,
+			BatchingMaxMessages:     500,
+			BatchingMaxSize:         65536,
+		},
+	}
+
+	options := cfg.producerOptions()
+	assert.Equal(t, pulsar.ProducerOptions{
+		Topic:                   "spans",
+		SendTimeout:             5 * time.Second,
+		BatchingMaxPublishDelay: 50 * time.Millisecond,
+		BatchingMaxMessages:     500,
+		BatchingMaxSize:         65536,
+		BatcherBuilderType:      pulsar.KeyBasedBatchBuilder,
+	}, options)
+
+	cfg.Schema = Schema{Type: schemaTypeAvro}
+	options = cfg.producerOptions()
+	require.NotNil(t, options.Schema)
+	assert.Equal(t, pulsar.AVRO, options.Schema.GetSchemaInfo().Type)
+}
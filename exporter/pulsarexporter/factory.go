@@ -33,6 +33,12 @@ const (
 	defaultLogsTopic    = "otlp_logs"
 	defaultEncoding     = "otlp_proto"
 	defaultBroker       = "pulsar://localhost:6650"
+
+	defaultBatchBuilder  = "default"
+	keyBasedBatchBuilder = "key_based"
+
+	schemaTypeJSON = "json"
+	schemaTypeAvro = "avro"
 )
 
 // FactoryOption applies changes to pulsarExporterFactory.
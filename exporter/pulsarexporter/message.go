@@ -0,0 +1,153 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pulsarexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/pulsarexporter"
+
+import (
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/linkedin/goavro/v2"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+// avroPayloadSchema is the schema of the messages published with the avro schema type,
+// the marshaled payload is wrapped in a record along with its encoding.
+const avroPayloadSchema = `{"type":"record","name":"Payload","namespace":"io.opentelemetry.pulsar",` +
+	`"fields":[{"name":"encoding","type":"string"},{"name":"payload","type":"bytes"}]}`
+
+// avroPayloadCodec encodes the payload records with goavro native types. The pulsar avro
+// schema encodes the message values through JSON, which turns the payload bytes into base64 text.
+var avroPayloadCodec = mustNewCodec(avroPayloadSchema)
+
+func mustNewCodec(schema string) *goavro.Codec {
+	codec, err := goavro.NewCodec(schema)
+	if err != nil {
+		panic(err)
+	}
+	return codec
+}
+
+// messageOptions holds the settings applied to the marshaled messages.
+type messageOptions struct {
+	keyAttribute string
+	schemaType   string
+	encoding     string
+}
+
+func newMessageOptions(config Config) messageOptions {
+	return messageOptions{
+		keyAttribute: config.Producer.KeyAttribute,
+		schemaType:   config.Schema.Type,
+		encoding:     config.Encoding,
+	}
+}
+
+// apply sets the key of the messages and wraps their payload according to the schema type.
+func (o messageOptions) apply(messages []*pulsar.ProducerMessage, key string) error {
+	for _, message := range messages {
+		if key != "" {
+			message.Key = key
+		}
+		if o.schemaType == schemaTypeAvro {
+			payload, err := avroPayloadCodec.BinaryFromNative(nil, map[string]interface{}{
+				"encoding": o.encoding,
+				"payload":  message.Payload,
+			})
+			if err != nil {
+				return err
+			}
+			message.Payload = payload
+		}
+	}
+	return nil
+}
+
+// resourceKey returns the value of the key attribute of the resource, or an empty string if it is not set.
+func resourceKey(resource pcommon.Resource, keyAttribute string) string {
+	if value, ok := resource.Attributes().Get(keyAttribute); ok {
+		return value.AsString()
+	}
+	return ""
+}
+
+type keyedTraces struct {
+	key    string
+	traces ptrace.Traces
+}
+
+// splitTracesByKey groups the resource spans by the value of the key attribute of their resource.
+func splitTracesByKey(td ptrace.Traces, keyAttribute string) []keyedTraces {
+	var result []keyedTraces
+	indexes := map[string]int{}
+	rss := td.ResourceSpans()
+	for i := 0; i < rss.Len(); i++ {
+		key := resourceKey(rss.At(i).Resource(), keyAttribute)
+		index, ok := indexes[key]
+		if !ok {
+			index = len(result)
+			indexes[key] = index
+			result = append(result, keyedTraces{key: key, traces: ptrace.NewTraces()})
+		}
+		rss.At(i).CopyTo(result[index].traces.ResourceSpans().AppendEmpty())
+	}
+	return result
+}
+
+type keyedMetrics struct {
+	key     string
+	metrics pmetric.Metrics
+}
+
+// splitMetricsByKey groups the resource metrics by the value of the key attribute of their resource.
+func splitMetricsByKey(md pmetric.Metrics, keyAttribute string) []keyedMetrics {
+	var result []keyedMetrics
+	indexes := map[string]int{}
+	rms := md.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		key := resourceKey(rms.At(i).Resource(), keyAttribute)
+		index, ok := indexes[key]
+		if !ok {
+			index = len(result)
+			indexes[key] = index
+			result = append(result, keyedMetrics{key: key, metrics: pmetric.NewMetrics()})
+		}
+		rms.At(i).CopyTo(result[index].metrics.ResourceMetrics().AppendEmpty())
+	}
+	return result
+}
+
+type keyedLogs struct {
+	key  string
+	logs plog.Logs
+}
+
+// splitLogsByKey groups the resource logs by the value of the key attribute of their resource.
+func splitLogsByKey(ld plog.Logs, keyAttribute string) []keyedLogs {
+	var result []keyedLogs
+	indexes := map[string]int{}
+	rls := ld.ResourceLogs()
+	for i := 0; i < rls.Len(); i++ {
+		key := resourceKey(rls.At(i).Resource(), keyAttribute)
+		index, ok := indexes[key]
+		if !ok {
+			index = len(result)
+			indexes[key] = index
+			result = append(result, keyedLogs{key: key, logs: plog.NewLogs()})
+		}
+		rls.At(i).CopyTo(result[index].logs.ResourceLogs().AppendEmpty())
+	}
+	return result
+}
@@ -35,11 +35,12 @@ type PulsarTracesProducer struct {
 	producer  pulsar.Producer
 	topic     string
 	marshaler TracesMarshaler
+	options   messageOptions
 	logger    *zap.Logger
 }
 
 func (e *PulsarTracesProducer) tracesPusher(ctx context.Context, td ptrace.Traces) error {
-	messages, err := e.marshaler.Marshal(td, e.topic)
+	messages, err := e.marshal(td)
 	if err != nil {
 		return consumererror.NewPermanent(err)
 	}
@@ -58,6 +59,33 @@ func (e *PulsarTracesProducer) tracesPusher(ctx context.Context, td ptrace.Trace
 	return errs
 }
 
+// marshal marshals the traces into messages, one set of messages per key when a key attribute is configured.
+func (e *PulsarTracesProducer) marshal(td ptrace.Traces) ([]*pulsar.ProducerMessage, error) {
+	if e.options.keyAttribute == "" {
+		messages, err := e.marshaler.Marshal(td, e.topic)
+		if err != nil {
+			return nil, err
+		}
+		if err = e.options.apply(messages, ""); err != nil {
+			return nil, err
+		}
+		return messages, nil
+	}
+
+	var messages []*pulsar.ProducerMessage
+	for _, keyed := range splitTracesByKey(td, e.options.keyAttribute) {
+		keyedMessages, err := e.marshaler.Marshal(keyed.traces, e.topic)
+		if err != nil {
+			return nil, err
+		}
+		if err = e.options.apply(keyedMessages, keyed.key); err != nil {
+			return nil, err
+		}
+		messages = append(messages, keyedMessages...)
+	}
+	return messages, nil
+}
+
 func (e *PulsarTracesProducer) Close(context.Context) error {
 	e.producer.Close()
 	e.client.Close()
@@ -69,11 +97,12 @@ type PulsarMetricsProducer struct {
 	producer  pulsar.Producer
 	topic     string
 	marshaler MetricsMarshaler
+	options   messageOptions
 	logger    *zap.Logger
 }
 
 func (e *PulsarMetricsProducer) metricsDataPusher(ctx context.Context, md pmetric.Metrics) error {
-	messages, err := e.marshaler.Marshal(md, e.topic)
+	messages, err := e.marshal(md)
 	if err != nil {
 		return consumererror.NewPermanent(err)
 	}
@@ -92,6 +121,33 @@ func (e *PulsarMetricsProducer) metricsDataPusher(ctx context.Context, md pmetri
 	return errs
 }
 
+// marshal marshals the metrics into messages, one set of messages per key when a key attribute is configured.
+func (e *PulsarMetricsProducer) marshal(md pmetric.Metrics) ([]*pulsar.ProducerMessage, error) {
+	if e.options.keyAttribute == "" {
+		messages, err := e.marshaler.Marshal(md, e.topic)
+		if err != nil {
+			return nil, err
+		}
+		if err = e.options.apply(messages, ""); err != nil {
+			return nil, err
+		}
+		return messages, nil
+	}
+
+	var messages []*pulsar.ProducerMessage
+	for _, keyed := range splitMetricsByKey(md, e.options.keyAttribute) {
+		keyedMessages, err := e.marshaler.Marshal(keyed.metrics, e.topic)
+		if err != nil {
+			return nil, err
+		}
+		if err = e.options.apply(keyedMessages, keyed.key); err != nil {
+			return nil, err
+		}
+		messages = append(messages, keyedMessages...)
+	}
+	return messages, nil
+}
+
 func (e *PulsarMetricsProducer) Close(context.Context) error {
 	e.producer.Close()
 	e.client.Close()
@@ -103,11 +159,12 @@ type PulsarLogsProducer struct {
 	producer  pulsar.Producer
 	topic     string
 	marshaler LogsMarshaler
+	options   messageOptions
 	logger    *zap.Logger
 }
 
 func (e *PulsarLogsProducer) logsDataPusher(ctx context.Context, ld plog.Logs) error {
-	messages, err := e.marshaler.Marshal(ld, e.topic)
+	messages, err := e.marshal(ld)
 	if err != nil {
 		return consumererror.NewPermanent(err)
 	}
@@ -126,6 +183,33 @@ func (e *PulsarLogsProducer) logsDataPusher(ctx context.Context, ld plog.Logs) e
 	return errs
 }
 
+// marshal marshals the logs into messages, one set of messages per key when a key attribute is configured.
+func (e *PulsarLogsProducer) marshal(ld plog.Logs) ([]*pulsar.ProducerMessage, error) {
+	if e.options.keyAttribute == "" {
+		messages, err := e.marshaler.Marshal(ld, e.topic)
+		if err != nil {
+			return nil, err
+		}
+		if err = e.options.apply(messages, ""); err != nil {
+			return nil, err
+		}
+		return messages, nil
+	}
+
+	var messages []*pulsar.ProducerMessage
+	for _, keyed := range splitLogsByKey(ld, e.options.keyAttribute) {
+		keyedMessages, err := e.marshaler.Marshal(keyed.logs, e.topic)
+		if err != nil {
+			return nil, err
+		}
+		if err = e.options.apply(keyedMessages, keyed.key); err != nil {
+			return nil, err
+		}
+		messages = append(messages, keyedMessages...)
+	}
+	return messages, nil
+}
+
 func (e *PulsarLogsProducer) Close(context.Context) error {
 	e.producer.Close()
 	e.client.Close()
@@ -141,10 +225,7 @@ func newPulsarProducer(config Config) (pulsar.Client, pulsar.Producer, error) {
 		return nil, nil, err
 	}
 
-	producer, err := client.CreateProducer(pulsar.ProducerOptions{
-		Topic:       config.Topic,
-		SendTimeout: config.Timeout,
-	})
+	producer, err := client.CreateProducer(config.producerOptions())
 
 	if err != nil {
 		return nil, nil, err
@@ -168,6 +249,7 @@ func newMetricsExporter(config Config, set exporter.CreateSettings, marshalers m
 		producer:  producer,
 		topic:     config.Topic,
 		marshaler: marshaler,
+		options:   newMessageOptions(config),
 		logger:    set.Logger,
 	}, nil
 
@@ -187,6 +269,7 @@ func newTracesExporter(config Config, set exporter.CreateSettings, marshalers ma
 		producer:  producer,
 		topic:     config.Topic,
 		marshaler: marshaler,
+		options:   newMessageOptions(config),
 		logger:    set.Logger,
 	}, nil
 }
@@ -206,6 +289,7 @@ func newLogsExporter(config Config, set exporter.CreateSettings, marshalers map[
 		producer:  producer,
 		topic:     config.Topic,
 		marshaler: marshaler,
+		options:   newMessageOptions(config),
 		logger:    set.Logger,
 	}, nil
 
@@ -20,9 +20,12 @@ import (
 	"testing"
 
 	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/linkedin/goavro/v2"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/consumer/consumererror"
 	"go.opentelemetry.io/collector/exporter/exportertest"
+	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/pdata/ptrace"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/testdata"
@@ -74,6 +77,79 @@ func Test_tracerPublisher_marshaler_err(t *testing.T) {
 	assert.True(t, consumererror.IsPermanent(err))
 }
 
+func Test_tracerPublisher_key_attribute(t *testing.T) {
+	traces := ptrace.NewTraces()
+	for _, service := range []string{"a", "b", "a"} {
+		rs := traces.ResourceSpans().AppendEmpty()
+		rs.Resource().Attributes().PutStr("service.name", service)
+		rs.ScopeSpans().AppendEmpty().Spans().AppendEmpty().SetName("span-" + service)
+	}
+
+	mProducer := &mockProducer{name: "producer1", topic: "default"}
+	producer := PulsarTracesProducer{
+		producer:  mProducer,
+		marshaler: tracesMarshalers()[defaultEncoding],
+		options:   messageOptions{keyAttribute: "service.name"},
+	}
+	require.NoError(t, producer.tracesPusher(context.Background(), traces))
+
+	require.Len(t, mProducer.messages, 2)
+	unmarshaler := &ptrace.ProtoUnmarshaler{}
+	for i, key := range []string{"a", "b"} {
+		assert.Equal(t, key, mProducer.messages[i].Key)
+		td, err := unmarshaler.UnmarshalTraces(mProducer.messages[i].Payload)
+		require.NoError(t, err)
+		for j := 0; j < td.ResourceSpans().Len(); j++ {
+			value, _ := td.ResourceSpans().At(j).Resource().Attributes().Get("service.name")
+			assert.Equal(t, key, value.Str())
+		}
+	}
+	assert.Equal(t, 2, splitTracesByKey(traces, "service.name")[0].traces.ResourceSpans().Len())
+}
+
+func Test_metricsPublisher_avro_schema(t *testing.T) {
+	mProducer := &mockProducer{name: "producer1", topic: "default"}
+	producer := PulsarMetricsProducer{
+		producer:  mProducer,
+		marshaler: metricsMarshalers()[defaultEncoding],
+		options:   messageOptions{schemaType: schemaTypeAvro, encoding: defaultEncoding},
+	}
+	md := testdata.GenerateMetricsTwoMetrics()
+	require.NoError(t, producer.metricsDataPusher(context.Background(), md))
+
+	require.Len(t, mProducer.messages, 1)
+	message := mProducer.messages[0]
+	assert.Nil(t, message.Value)
+	assert.Empty(t, message.Key)
+
+	// the payload is avro binary readable by other avro consumers
+	codec, err := goavro.NewCodec(avroPayloadSchema)
+	require.NoError(t, err)
+	native, rest, err := codec.NativeFromBinary(message.Payload)
+	require.NoError(t, err)
+	assert.Empty(t, rest)
+	record, ok := native.(map[string]interface{})
+	require.True(t, ok)
+	assert.Equal(t, defaultEncoding, record["encoding"])
+
+	expected, err := (&pmetric.ProtoMarshaler{}).MarshalMetrics(md)
+	require.NoError(t, err)
+	assert.Equal(t, expected, record["payload"])
+}
+
+func Test_logsPublisher_key_attribute_missing(t *testing.T) {
+	mProducer := &mockProducer{name: "producer1", topic: "default"}
+	producer := PulsarLogsProducer{
+		producer:  mProducer,
+		marshaler: logsMarshalers()[defaultEncoding],
+		options:   messageOptions{keyAttribute: "service.name"},
+	}
+	require.NoError(t, producer.logsDataPusher(context.Background(), testdata.GenerateLogsTwoLogRecordsSameResource()))
+
+	require.Len(t, mProducer.messages, 1)
+	assert.Empty(t, mProducer.messages[0].Key)
+}
+
 type customTraceMarshaler struct {
 	encoding string
 }
@@ -87,8 +163,9 @@ func (c *customTraceMarshaler) Encoding() string {
 }
 
 type mockProducer struct {
-	topic string
-	name  string
+	topic    string
+	name     string
+	messages []*pulsar.ProducerMessage
 }
 
 func (c *mockProducer) Topic() string {
@@ -103,8 +180,8 @@ func (c *mockProducer) Send(context.Context, *pulsar.ProducerMessage) (pulsar.Me
 	return nil, nil
 }
 
-func (c *mockProducer) SendAsync(context.Context, *pulsar.ProducerMessage, func(pulsar.MessageID, *pulsar.ProducerMessage, error)) {
-
+func (c *mockProducer) SendAsync(_ context.Context, message *pulsar.ProducerMessage, _ func(pulsar.MessageID, *pulsar.ProducerMessage, error)) {
+	c.messages = append(c.messages, message)
 }
 
 func (c *mockProducer) LastSequenceID() int64 {
@@ -20,4 +20,10 @@ type Config struct {
 	DSN string `mapstructure:"dsn"`
 	// InsecureSkipVerify controls whether the client verifies the Sentry server certificate chain
 	InsecureSkipVerify bool `mapstructure:"insecure_skip_verify"`
+	// FingerprintAttributes are the attributes whose values make the fingerprint of the events sent for
+	// error logs. Events without any of these attributes are grouped using the default Sentry rules.
+	FingerprintAttributes []string `mapstructure:"fingerprint_attributes"`
+	// ReleaseHealth controls whether request sessions derived from the transactions are sent to Sentry.
+	// The release and environment of the sessions are read from the resource attributes.
+	ReleaseHealth bool `mapstructure:"release_health"`
 }
@@ -44,6 +44,14 @@ func TestLoadConfig(t *testing.T) {
 				DSN: "https://key@host/path/42",
 			},
 		},
+		{
+			id: component.NewIDWithName(typeStr, "logs"),
+			expected: &Config{
+				DSN:                   "https://key@host/path/42",
+				FingerprintAttributes: []string{"exception.type", "code.function"},
+				ReleaseHealth:         true,
+			},
+		},
 	}
 
 	for _, tt := range tests {
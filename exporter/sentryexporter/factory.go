@@ -34,6 +34,7 @@ func NewFactory() exporter.Factory {
 		typeStr,
 		createDefaultConfig,
 		exporter.WithTraces(createTracesExporter, stability),
+		exporter.WithLogs(createLogsExporter, stability),
 	)
 }
 
@@ -55,3 +56,16 @@ func createTracesExporter(
 	exp, err := CreateSentryExporter(sentryConfig, params)
 	return exp, err
 }
+
+func createLogsExporter(
+	_ context.Context,
+	params exporter.CreateSettings,
+	config component.Config,
+) (exporter.Logs, error) {
+	sentryConfig, ok := config.(*Config)
+	if !ok {
+		return nil, fmt.Errorf("unexpected config type: %T", config)
+	}
+
+	return createSentryLogsExporter(sentryConfig, params)
+}
@@ -42,6 +42,10 @@ func TestCreateExporter(t *testing.T) {
 	assert.Nil(t, err)
 	assert.NotNil(t, te, "failed to create trace exporter")
 
+	le, err := factory.CreateLogsExporter(context.Background(), params, eCfg)
+	assert.Nil(t, err)
+	assert.NotNil(t, le, "failed to create logs exporter")
+
 	me, err := factory.CreateMetricsExporter(context.Background(), params, eCfg)
 	assert.Error(t, err)
 	assert.Nil(t, me)
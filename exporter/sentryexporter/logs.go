@@ -0,0 +1,127 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sentryexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/sentryexporter"
+
+import (
+	"context"
+
+	"github.com/getsentry/sentry-go"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	conventions "go.opentelemetry.io/collector/semconv/v1.6.1"
+)
+
+// pushLogData takes incoming OpenTelemetry logs, converts the error logs into Sentry events
+// and sends them using Sentry's transport.
+func (s *SentryExporter) pushLogData(_ context.Context, ld plog.Logs) error {
+	var events []*sentry.Event
+
+	resourceLogs := ld.ResourceLogs()
+	for i := 0; i < resourceLogs.Len(); i++ {
+		rl := resourceLogs.At(i)
+		resourceAttrs := rl.Resource().Attributes()
+
+		scopeLogs := rl.ScopeLogs()
+		for j := 0; j < scopeLogs.Len(); j++ {
+			sl := scopeLogs.At(j)
+
+			records := sl.LogRecords()
+			for k := 0; k < records.Len(); k++ {
+				record := records.At(k)
+				if record.SeverityNumber() < plog.SeverityNumberError {
+					continue
+				}
+				events = append(events, sentryEventFromLog(record, sl.Scope(), resourceAttrs, s.fingerprintAttributes))
+			}
+		}
+	}
+
+	if len(events) == 0 {
+		return nil
+	}
+
+	s.transport.SendEvents(events)
+
+	return nil
+}
+
+// sentryEventFromLog creates a sentry event from an error log record.
+func sentryEventFromLog(record plog.LogRecord, scope pcommon.InstrumentationScope, resourceAttrs pcommon.Map, fingerprintAttributes []string) *sentry.Event {
+	event := sentry.NewEvent()
+	event.EventID = generateEventID()
+
+	event.Level = sentry.LevelError
+	if record.SeverityNumber() >= plog.SeverityNumberFatal {
+		event.Level = sentry.LevelFatal
+	}
+	event.Message = record.Body().AsString()
+	event.Logger = scope.Name()
+
+	attrs := record.Attributes()
+	tags := generateTagsFromAttributes(attrs)
+	for k, v := range generateTagsFromAttributes(resourceAttrs) {
+		tags[k] = v
+	}
+	event.Tags = tags
+
+	var exceptionMessage, exceptionType string
+	if v, ok := attrs.Get(conventions.AttributeExceptionMessage); ok {
+		exceptionMessage = v.AsString()
+	}
+	if v, ok := attrs.Get(conventions.AttributeExceptionType); ok {
+		exceptionType = v.AsString()
+	}
+	if exceptionMessage != "" || exceptionType != "" {
+		event.Exception = []sentry.Exception{{
+			Value: exceptionMessage,
+			Type:  exceptionType,
+		}}
+	}
+
+	for _, key := range fingerprintAttributes {
+		v, ok := attrs.Get(key)
+		if !ok {
+			v, ok = resourceAttrs.Get(key)
+		}
+		if ok {
+			event.Fingerprint = append(event.Fingerprint, v.AsString())
+		}
+	}
+
+	if release, ok := resourceAttrs.Get(conventions.AttributeServiceVersion); ok {
+		event.Release = release.AsString()
+	}
+	if environment, ok := resourceAttrs.Get(conventions.AttributeDeploymentEnvironment); ok {
+		event.Environment = environment.AsString()
+	}
+
+	if traceID := record.TraceID(); !traceID.IsEmpty() {
+		event.Contexts["trace"] = sentry.TraceContext{
+			TraceID: sentry.TraceID(traceID),
+			SpanID:  sentry.SpanID(record.SpanID()),
+		}.Map()
+	}
+
+	event.Sdk.Name = otelSentryExporterName
+	event.Sdk.Version = otelSentryExporterVersion
+
+	timestamp := record.Timestamp()
+	if timestamp == 0 {
+		timestamp = record.ObservedTimestamp()
+	}
+	event.Timestamp = unixNanoToTime(timestamp)
+
+	return event
+}
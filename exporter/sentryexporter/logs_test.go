@@ -0,0 +1,101 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sentryexporter
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/getsentry/sentry-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+)
+
+func TestPushLogData(t *testing.T) {
+	ld := plog.NewLogs()
+	rl := ld.ResourceLogs().AppendEmpty()
+	rl.Resource().Attributes().PutStr("service.name", "checkout")
+	rl.Resource().Attributes().PutStr("service.version", "1.2.3")
+	rl.Resource().Attributes().PutStr("deployment.environment", "production")
+	rl.Resource().Attributes().PutStr("code.function", "resource-function")
+	sl := rl.ScopeLogs().AppendEmpty()
+	sl.Scope().SetName("checkout.logger")
+
+	info := sl.LogRecords().AppendEmpty()
+	info.SetSeverityNumber(plog.SeverityNumberInfo)
+	info.Body().SetStr("order placed")
+
+	errorLog := sl.LogRecords().AppendEmpty()
+	errorLog.SetSeverityNumber(plog.SeverityNumberError)
+	errorLog.SetTimestamp(pcommon.NewTimestampFromTime(time.Unix(100, 0)))
+	errorLog.Body().SetStr("payment failed")
+	errorLog.Attributes().PutStr("exception.type", "PaymentError")
+	errorLog.Attributes().PutStr("exception.message", "card declined")
+	errorLog.SetTraceID([16]byte{1, 2, 3, 4, 5, 6, 7, 8, 8, 7, 6, 5, 4, 3, 2, 1})
+	errorLog.SetSpanID([8]byte{1, 2, 3, 4, 4, 3, 2, 1})
+
+	fatalLog := sl.LogRecords().AppendEmpty()
+	fatalLog.SetSeverityNumber(plog.SeverityNumberFatal)
+	fatalLog.SetObservedTimestamp(pcommon.NewTimestampFromTime(time.Unix(200, 0)))
+	fatalLog.Body().SetStr("out of memory")
+	fatalLog.Attributes().PutStr("code.function", "allocate")
+
+	transport := &mockTransport{}
+	s := &SentryExporter{
+		transport:             transport,
+		fingerprintAttributes: []string{"exception.type", "code.function"},
+	}
+
+	require.NoError(t, s.pushLogData(context.Background(), ld))
+	require.True(t, transport.called)
+	require.Len(t, transport.transactions, 2)
+
+	event := transport.transactions[0]
+	assert.Equal(t, sentry.LevelError, event.Level)
+	assert.Equal(t, "payment failed", event.Message)
+	assert.Equal(t, "checkout.logger", event.Logger)
+	assert.Equal(t, "1.2.3", event.Release)
+	assert.Equal(t, "production", event.Environment)
+	assert.Equal(t, []string{"PaymentError", "resource-function"}, event.Fingerprint)
+	assert.Equal(t, []sentry.Exception{{Type: "PaymentError", Value: "card declined"}}, event.Exception)
+	assert.Equal(t, "checkout", event.Tags["service.name"])
+	assert.Equal(t, time.Unix(100, 0).UTC(), event.Timestamp)
+	assert.Equal(t, sentry.TraceContext{
+		TraceID: TraceIDFromHex("01020304050607080807060504030201"),
+		SpanID:  SpanIDFromHex("0102030404030201"),
+	}.Map(), event.Contexts["trace"])
+
+	event = transport.transactions[1]
+	assert.Equal(t, sentry.LevelFatal, event.Level)
+	assert.Equal(t, []string{"allocate"}, event.Fingerprint)
+	assert.Empty(t, event.Exception)
+	assert.NotContains(t, event.Contexts, "trace")
+	assert.Equal(t, time.Unix(200, 0).UTC(), event.Timestamp)
+}
+
+func TestPushLogDataWithoutErrors(t *testing.T) {
+	ld := plog.NewLogs()
+	record := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+	record.SetSeverityNumber(plog.SeverityNumberWarn)
+
+	transport := &mockTransport{}
+	s := &SentryExporter{transport: transport}
+
+	require.NoError(t, s.pushLogData(context.Background(), ld))
+	assert.False(t, transport.called)
+}
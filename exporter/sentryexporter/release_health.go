@@ -0,0 +1,131 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sentryexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/sentryexporter"
+
+import (
+	"sort"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	conventions "go.opentelemetry.io/collector/semconv/v1.6.1"
+)
+
+// sessionAggregate counts the request sessions started within a minute.
+// See https://develop.sentry.dev/sdk/sessions/#session-aggregates-payload.
+type sessionAggregate struct {
+	Started time.Time `json:"started"`
+	Exited  int       `json:"exited,omitempty"`
+	Errored int       `json:"errored,omitempty"`
+}
+
+type sessionAttributes struct {
+	Release     string `json:"release"`
+	Environment string `json:"environment,omitempty"`
+}
+
+// sessionAggregates is the payload of a "sessions" envelope item.
+type sessionAggregates struct {
+	Aggregates []sessionAggregate `json:"aggregates"`
+	Attributes sessionAttributes  `json:"attrs"`
+}
+
+// aggregateSessions counts every transaction as a request session of the release
+// of its resource. Transactions of resources without a release are ignored.
+func aggregateSessions(td ptrace.Traces) []*sessionAggregates {
+	aggregates := make(map[sessionAttributes]map[time.Time]*sessionAggregate)
+
+	resourceSpans := td.ResourceSpans()
+	for i := 0; i < resourceSpans.Len(); i++ {
+		rs := resourceSpans.At(i)
+		attrs, ok := sessionAttributesFromResource(rs.Resource().Attributes())
+		if !ok {
+			continue
+		}
+
+		ilss := rs.ScopeSpans()
+		for j := 0; j < ilss.Len(); j++ {
+			spans := ilss.At(j).Spans()
+			for k := 0; k < spans.Len(); k++ {
+				span := spans.At(k)
+				if !spanIsTransaction(span) {
+					continue
+				}
+
+				byMinute, found := aggregates[attrs]
+				if !found {
+					byMinute = make(map[time.Time]*sessionAggregate)
+					aggregates[attrs] = byMinute
+				}
+				started := unixNanoToTime(span.StartTimestamp()).Truncate(time.Minute)
+				aggregate, found := byMinute[started]
+				if !found {
+					aggregate = &sessionAggregate{Started: started}
+					byMinute[started] = aggregate
+				}
+				if spanIsErrored(span) {
+					aggregate.Errored++
+				} else {
+					aggregate.Exited++
+				}
+			}
+		}
+	}
+
+	sessions := make([]*sessionAggregates, 0, len(aggregates))
+	for attrs, byMinute := range aggregates {
+		s := &sessionAggregates{Attributes: attrs}
+		for _, aggregate := range byMinute {
+			s.Aggregates = append(s.Aggregates, *aggregate)
+		}
+		sort.Slice(s.Aggregates, func(i, j int) bool {
+			return s.Aggregates[i].Started.Before(s.Aggregates[j].Started)
+		})
+		sessions = append(sessions, s)
+	}
+	sort.Slice(sessions, func(i, j int) bool {
+		if sessions[i].Attributes.Release != sessions[j].Attributes.Release {
+			return sessions[i].Attributes.Release < sessions[j].Attributes.Release
+		}
+		return sessions[i].Attributes.Environment < sessions[j].Attributes.Environment
+	})
+	return sessions
+}
+
+func sessionAttributesFromResource(attrs pcommon.Map) (sessionAttributes, bool) {
+	release, ok := attrs.Get(conventions.AttributeServiceVersion)
+	if !ok || release.AsString() == "" {
+		return sessionAttributes{}, false
+	}
+	s := sessionAttributes{Release: release.AsString()}
+	if environment, ok := attrs.Get(conventions.AttributeDeploymentEnvironment); ok {
+		s.Environment = environment.AsString()
+	}
+	return s, true
+}
+
+// spanIsErrored determines if a span failed, either through its status or an exception event.
+func spanIsErrored(s ptrace.Span) bool {
+	if s.Status().Code() == ptrace.StatusCodeError {
+		return true
+	}
+	events := s.Events()
+	for i := 0; i < events.Len(); i++ {
+		if events.At(i).Name() == "exception" {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,144 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sentryexporter
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/getsentry/sentry-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap"
+)
+
+func TestAggregateSessions(t *testing.T) {
+	td := ptrace.NewTraces()
+	minute := time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	rs := td.ResourceSpans().AppendEmpty()
+	rs.Resource().Attributes().PutStr("service.version", "1.2.3")
+	rs.Resource().Attributes().PutStr("deployment.environment", "production")
+	spans := rs.ScopeSpans().AppendEmpty().Spans()
+
+	span := spans.AppendEmpty()
+	span.SetStartTimestamp(pcommon.NewTimestampFromTime(minute.Add(10 * time.Second)))
+
+	span = spans.AppendEmpty()
+	span.SetStartTimestamp(pcommon.NewTimestampFromTime(minute.Add(20 * time.Second)))
+	span.Status().SetCode(ptrace.StatusCodeError)
+
+	span = spans.AppendEmpty()
+	span.SetStartTimestamp(pcommon.NewTimestampFromTime(minute.Add(70 * time.Second)))
+	span.Events().AppendEmpty().SetName("exception")
+
+	// Child spans aren't sessions.
+	span = spans.AppendEmpty()
+	span.SetParentSpanID([8]byte{1, 2, 3, 4, 5, 6, 7, 8})
+	span.SetStartTimestamp(pcommon.NewTimestampFromTime(minute))
+
+	// Resources without a release are ignored.
+	td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans().AppendEmpty()
+
+	rs = td.ResourceSpans().AppendEmpty()
+	rs.Resource().Attributes().PutStr("service.version", "1.0.0")
+	span = rs.ScopeSpans().AppendEmpty().Spans().AppendEmpty()
+	span.SetStartTimestamp(pcommon.NewTimestampFromTime(minute))
+
+	assert.Equal(t, []*sessionAggregates{
+		{
+			Aggregates: []sessionAggregate{{Started: minute, Exited: 1}},
+			Attributes: sessionAttributes{Release: "1.0.0"},
+		},
+		{
+			Aggregates: []sessionAggregate{
+				{Started: minute, Exited: 1, Errored: 1},
+				{Started: minute.Add(time.Minute), Errored: 1},
+			},
+			Attributes: sessionAttributes{Release: "1.2.3", Environment: "production"},
+		},
+	}, aggregateSessions(td))
+}
+
+func TestPushTraceDataReleaseHealth(t *testing.T) {
+	td := ptrace.NewTraces()
+	rs := td.ResourceSpans().AppendEmpty()
+	rs.Resource().Attributes().PutStr("service.version", "1.2.3")
+	rs.ScopeSpans().AppendEmpty().Spans().AppendEmpty()
+
+	transport := &mockTransport{}
+	s := &SentryExporter{transport: transport, logger: zap.NewNop()}
+	require.NoError(t, s.pushTraceData(context.Background(), td))
+	assert.Empty(t, transport.sessions)
+
+	s.releaseHealth = true
+	require.NoError(t, s.pushTraceData(context.Background(), td))
+	require.Len(t, transport.sessions, 1)
+	assert.Equal(t, "1.2.3", transport.sessions[0].Attributes.Release)
+}
+
+func TestSendSessions(t *testing.T) {
+	var gotPath, gotAuth, gotBody string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		gotAuth = r.Header.Get("X-Sentry-Auth")
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		gotBody = string(body)
+	}))
+	t.Cleanup(server.Close)
+
+	transport := newSentryTransport()
+	transport.Configure(sentry.ClientOptions{Dsn: strings.Replace(server.URL, "http://", "http://key@", 1) + "/42"})
+
+	started := time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)
+	err := transport.SendSessions(context.Background(), []*sessionAggregates{{
+		Aggregates: []sessionAggregate{{Started: started, Exited: 2, Errored: 1}},
+		Attributes: sessionAttributes{Release: "1.2.3", Environment: "production"},
+	}})
+	require.NoError(t, err)
+
+	assert.Equal(t, "/api/42/envelope/", gotPath)
+	assert.Contains(t, gotAuth, "sentry_key=key")
+	lines := strings.Split(strings.TrimSpace(gotBody), "\n")
+	require.Len(t, lines, 3)
+	assert.Contains(t, lines[0], `"sent_at"`)
+	assert.Equal(t, `{"type":"sessions"}`, lines[1])
+	assert.Equal(t, `{"aggregates":[{"started":"2023-01-01T10:00:00Z","exited":2,"errored":1}],"attrs":{"release":"1.2.3","environment":"production"}}`, lines[2])
+}
+
+func TestSendSessionsError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	t.Cleanup(server.Close)
+
+	transport := newSentryTransport()
+	transport.Configure(sentry.ClientOptions{Dsn: strings.Replace(server.URL, "http://", "http://key@", 1) + "/42"})
+	err := transport.SendSessions(context.Background(), []*sessionAggregates{{}})
+	assert.EqualError(t, err, "sending sessions failed with status code 429")
+
+	transport = newSentryTransport()
+	transport.Configure(sentry.ClientOptions{})
+	err = transport.SendSessions(context.Background(), []*sessionAggregates{{}})
+	assert.EqualError(t, err, "invalid or missing DSN")
+}
@@ -31,6 +31,7 @@ import (
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/ptrace"
 	conventions "go.opentelemetry.io/collector/semconv/v1.6.1"
+	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/traceutil"
 )
@@ -81,12 +82,23 @@ var canonicalCodesGrpcMap = map[string]sentry.SpanStatus{
 
 // SentryExporter defines the Sentry Exporter.
 type SentryExporter struct {
-	transport transport
+	transport             transport
+	fingerprintAttributes []string
+	releaseHealth         bool
+	logger                *zap.Logger
 }
 
 // pushTraceData takes an incoming OpenTelemetry trace, converts them into Sentry spans and transactions
 // and sends them using Sentry's transport.
-func (s *SentryExporter) pushTraceData(_ context.Context, td ptrace.Traces) error {
+func (s *SentryExporter) pushTraceData(ctx context.Context, td ptrace.Traces) error {
+	if s.releaseHealth {
+		if sessions := aggregateSessions(td); len(sessions) > 0 {
+			if err := s.transport.SendSessions(ctx, sessions); err != nil {
+				s.logger.Warn("Failed to send release health sessions", zap.Error(err))
+			}
+		}
+	}
+
 	var exceptionEvents []*sentry.Event
 	resourceSpans := td.ResourceSpans()
 	if resourceSpans.Len() == 0 {
@@ -475,8 +487,7 @@ func generateEventID() sentry.EventID {
 	return sentry.EventID(uuid())
 }
 
-// CreateSentryExporter returns a new Sentry Exporter.
-func CreateSentryExporter(config *Config, set exporter.CreateSettings) (exporter.Traces, error) {
+func newSentryExporter(config *Config, set exporter.CreateSettings) *SentryExporter {
 	transport := newSentryTransport()
 
 	clientOptions := sentry.ClientOptions{
@@ -489,23 +500,46 @@ func CreateSentryExporter(config *Config, set exporter.CreateSettings) (exporter
 
 	transport.Configure(clientOptions)
 
-	s := &SentryExporter{
-		transport: transport,
+	return &SentryExporter{
+		transport:             transport,
+		fingerprintAttributes: config.FingerprintAttributes,
+		releaseHealth:         config.ReleaseHealth,
+		logger:                set.Logger,
 	}
+}
+
+func (s *SentryExporter) shutdown(ctx context.Context) error {
+	allEventsFlushed := s.transport.Flush(ctx)
+
+	if !allEventsFlushed {
+		s.logger.Warn("Could not flush all events, reached timeout")
+	}
+
+	return nil
+}
+
+// CreateSentryExporter returns a new Sentry Exporter.
+func CreateSentryExporter(config *Config, set exporter.CreateSettings) (exporter.Traces, error) {
+	s := newSentryExporter(config, set)
 
 	return exporterhelper.NewTracesExporter(
 		context.TODO(),
 		set,
 		config,
 		s.pushTraceData,
-		exporterhelper.WithShutdown(func(ctx context.Context) error {
-			allEventsFlushed := transport.Flush(ctx)
+		exporterhelper.WithShutdown(s.shutdown),
+	)
+}
 
-			if !allEventsFlushed {
-				set.Logger.Warn("Could not flush all events, reached timeout")
-			}
+// createSentryLogsExporter returns a new Sentry Exporter sending error logs as Sentry events.
+func createSentryLogsExporter(config *Config, set exporter.CreateSettings) (exporter.Logs, error) {
+	s := newSentryExporter(config, set)
 
-			return nil
-		}),
+	return exporterhelper.NewLogsExporter(
+		context.TODO(),
+		set,
+		config,
+		s.pushLogData,
+		exporterhelper.WithShutdown(s.shutdown),
 	)
 }
@@ -663,6 +663,7 @@ func TestGenerateTransactions(t *testing.T) {
 type mockTransport struct {
 	called       bool
 	transactions []*sentry.Event
+	sessions     []*sessionAggregates
 }
 
 func (t *mockTransport) SendEvents(transactions []*sentry.Event) {
@@ -670,6 +671,11 @@ func (t *mockTransport) SendEvents(transactions []*sentry.Event) {
 	t.called = true
 }
 
+func (t *mockTransport) SendSessions(ctx context.Context, sessions []*sessionAggregates) error {
+	t.sessions = sessions
+	return nil
+}
+
 func (t *mockTransport) Configure(options sentry.ClientOptions) {}
 func (t *mockTransport) Flush(ctx context.Context) bool {
 	return true
@@ -15,7 +15,12 @@
 package sentryexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/sentryexporter"
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
 	"time"
 
 	"github.com/getsentry/sentry-go"
@@ -24,12 +29,16 @@ import (
 // transport is used by exporter to send events to Sentry
 type transport interface {
 	SendEvents(events []*sentry.Event)
+	SendSessions(ctx context.Context, sessions []*sessionAggregates) error
 	Configure(options sentry.ClientOptions)
 	Flush(ctx context.Context) bool
 }
 
 type sentryTransport struct {
 	httpTransport *sentry.HTTPTransport
+	// dsn and client are used to send the envelopes not supported by httpTransport.
+	dsn    *sentry.Dsn
+	client *http.Client
 }
 
 // newSentryTransport returns a new pre-configured instance of sentryTransport.
@@ -42,6 +51,15 @@ func newSentryTransport() *sentryTransport {
 
 func (t *sentryTransport) Configure(options sentry.ClientOptions) {
 	t.httpTransport.Configure(options)
+
+	// An invalid DSN is already reported by httpTransport, which then drops the events.
+	if dsn, err := sentry.NewDsn(options.Dsn); err == nil {
+		t.dsn = dsn
+	}
+	t.client = &http.Client{Timeout: 30 * time.Second}
+	if options.HTTPTransport != nil {
+		t.client.Transport = options.HTTPTransport
+	}
 }
 
 func (t *sentryTransport) Flush(ctx context.Context) bool {
@@ -67,3 +85,54 @@ func (t *sentryTransport) SendEvents(transactions []*sentry.Event) {
 		bufferCounter++
 	}
 }
+
+// SendSessions sends release health session aggregates to Sentry in a single envelope.
+func (t *sentryTransport) SendSessions(ctx context.Context, sessions []*sessionAggregates) error {
+	if t.dsn == nil {
+		return errors.New("invalid or missing DSN")
+	}
+
+	body, err := sessionsEnvelope(sessions, time.Now())
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, t.dsn.EnvelopeAPIURL().String(), bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	for k, v := range t.dsn.RequestHeaders() {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := t.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("sending sessions failed with status code %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// sessionsEnvelope encodes session aggregates as a Sentry envelope, see
+// https://develop.sentry.dev/sdk/envelopes/.
+func sessionsEnvelope(sessions []*sessionAggregates, sentAt time.Time) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+
+	if err := enc.Encode(map[string]string{"sent_at": sentAt.UTC().Format(time.RFC3339)}); err != nil {
+		return nil, err
+	}
+	for _, s := range sessions {
+		if err := enc.Encode(map[string]string{"type": "sessions"}); err != nil {
+			return nil, err
+		}
+		if err := enc.Encode(s); err != nil {
+			return nil, err
+		}
+	}
+	return buf.Bytes(), nil
+}
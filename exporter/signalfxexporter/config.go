@@ -20,6 +20,7 @@ import (
 	"net/url"
 	"time"
 
+	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/config/configopaque"
 	"go.opentelemetry.io/collector/config/configtls"
@@ -84,6 +85,10 @@ type Config struct {
 	// `delta_metric` specified in TranslationRules. Default is 3600s.
 	DeltaTranslationTTL int64 `mapstructure:"delta_translation_ttl"`
 
+	// StorageID is the ID of the storage extension persisting the most recent datapoints used by the delta
+	// translation, so that deltas are computed across restarts. The datapoints are kept in memory if not set.
+	StorageID *component.ID `mapstructure:"storage"`
+
 	// SyncHostMetadata defines if the exporter should scrape host metadata and
 	// sends it as property updates to SignalFx backend.
 	// IMPORTANT: Host metadata synchronization relies on `resourcedetection` processor.
@@ -29,13 +29,16 @@ import (
 	"go.opentelemetry.io/collector/config/configtls"
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/exporter"
+	"go.opentelemetry.io/collector/extension/experimental/storage"
 	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.uber.org/multierr"
 	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/signalfxexporter/internal/dimensions"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/signalfxexporter/internal/hostmetadata"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/signalfxexporter/internal/translation"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/deltacumulative"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/splunk"
 	metadata "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/experimentalmetricmetadata"
 )
@@ -63,6 +66,7 @@ func (sme *signalfMetadataExporter) ConsumeMetadata(metadata []*metadata.Metadat
 
 type signalfxExporter struct {
 	config             *Config
+	id                 component.ID
 	logger             *zap.Logger
 	telemetrySettings  component.TelemetrySettings
 	pushMetricsData    func(ctx context.Context, md pmetric.Metrics) (droppedTimeSeries int, err error)
@@ -70,6 +74,8 @@ type signalfxExporter struct {
 	pushLogsData       func(ctx context.Context, ld plog.Logs) (droppedLogRecords int, err error)
 	hostMetadataSyncer *hostmetadata.Syncer
 	converter          *translation.MetricsConverter
+	metricTranslator   *translation.MetricTranslator
+	storageClient      storage.Client
 }
 
 type exporterOptions struct {
@@ -112,18 +118,30 @@ func newSignalFxExporter(
 
 	return &signalfxExporter{
 		config:            config,
+		id:                createSettings.ID,
 		logger:            createSettings.Logger,
 		telemetrySettings: createSettings.TelemetrySettings,
 		converter:         converter,
+		metricTranslator:  options.metricTranslator,
 	}, nil
 }
 
-func (se *signalfxExporter) start(_ context.Context, host component.Host) (err error) {
+func (se *signalfxExporter) start(ctx context.Context, host component.Host) (err error) {
 	options, err := se.config.getOptionsFromConfig()
 	if err != nil {
 		return err
 	}
 
+	if se.metricTranslator != nil {
+		se.storageClient, err = deltacumulative.GetStorageClient(ctx, host, se.config.StorageID, component.KindExporter, se.id)
+		if err != nil {
+			return fmt.Errorf("failed to set up storage: %w", err)
+		}
+		if err = se.metricTranslator.Start(ctx, se.storageClient); err != nil {
+			return err
+		}
+	}
+
 	headers := buildHeaders(se.config)
 	client, err := se.createClient(host)
 	if err != nil {
@@ -177,6 +195,14 @@ func (se *signalfxExporter) start(_ context.Context, host component.Host) (err e
 	return nil
 }
 
+func (se *signalfxExporter) shutdown(ctx context.Context) error {
+	if se.storageClient == nil {
+		return nil
+	}
+	err := se.metricTranslator.Shutdown(ctx)
+	return multierr.Append(err, se.storageClient.Close(ctx))
+}
+
 func newGzipPool() sync.Pool {
 	return sync.Pool{New: func() interface{} {
 		return gzip.NewWriter(nil)
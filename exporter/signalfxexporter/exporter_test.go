@@ -50,6 +50,7 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/signalfxexporter/internal/dimensions"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/signalfxexporter/internal/translation"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/signalfxexporter/internal/translation/dpfilters"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/storagetest"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/splunk"
 	metadata "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/experimentalmetricmetadata"
 )
@@ -1136,6 +1137,27 @@ func TestTLSExporterInit(t *testing.T) {
 	}
 }
 
+func TestSignalFxExporterDeltaTranslationStorage(t *testing.T) {
+	storageID := storagetest.NewStorageID("storage")
+	cfg := &Config{
+		APIURL:      "https://test",
+		IngestURL:   "https://test",
+		AccessToken: "random",
+		StorageID:   &storageID,
+	}
+
+	sfx, err := newSignalFxExporter(cfg, exportertest.NewNopCreateSettings())
+	require.NoError(t, err)
+	err = sfx.start(context.Background(), componenttest.NewNopHost())
+	assert.EqualError(t, err, "failed to set up storage: storage extension 'test_storage/storage' not found")
+
+	sfx, err = newSignalFxExporter(cfg, exportertest.NewNopCreateSettings())
+	require.NoError(t, err)
+	host := storagetest.NewStorageHost().WithFileBackedStorageExtension("storage", t.TempDir())
+	require.NoError(t, sfx.start(context.Background(), host))
+	require.NoError(t, sfx.shutdown(context.Background()))
+}
+
 func TestTLSIngestConnection(t *testing.T) {
 	metricsPayload := pmetric.NewMetrics()
 	rm := metricsPayload.ResourceMetrics().AppendEmpty()
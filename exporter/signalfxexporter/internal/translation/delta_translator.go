@@ -15,24 +15,32 @@
 package translation // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/signalfxexporter/internal/translation"
 
 import (
+	"context"
+	"time"
+
 	"github.com/gogo/protobuf/proto"
 	sfxpb "github.com/signalfx/com_signalfx_metrics_protobuf/model"
+	"go.opentelemetry.io/collector/extension/experimental/storage"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.uber.org/zap"
 
-	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/common/ttlmap"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/deltacumulative"
 )
 
 type deltaTranslator struct {
-	prevPts *ttlmap.TTLMap
+	tracker *deltacumulative.Tracker
 }
 
 func newDeltaTranslator(ttl int64) *deltaTranslator {
-	sweepIntervalSeconds := ttl / 2
-	if sweepIntervalSeconds == 0 {
-		sweepIntervalSeconds = 1
-	}
-	m := ttlmap.New(sweepIntervalSeconds, ttl)
-	m.Start()
-	return &deltaTranslator{prevPts: m}
+	return &deltaTranslator{tracker: deltacumulative.NewTracker(zap.NewNop(), time.Duration(ttl)*time.Second)}
+}
+
+func (t *deltaTranslator) start(ctx context.Context, client storage.Client) error {
+	return t.tracker.Start(ctx, client)
+}
+
+func (t *deltaTranslator) shutdown(ctx context.Context) error {
+	return t.tracker.Shutdown(ctx)
 }
 
 func (t *deltaTranslator) translate(pts []*sfxpb.DataPoint, tr Rule) []*sfxpb.DataPoint {
@@ -55,53 +63,35 @@ func (t *deltaTranslator) deltaPt(deltaMetricName string, currPt *sfxpb.DataPoin
 	// check if we have a previous point for this metric + dimensions
 	dimKey := stringifyDimensions(currPt.Dimensions, nil)
 	fullKey := currPt.Metric + ":" + dimKey
-	v := t.prevPts.Get(fullKey)
-	// without proto.Clone here, points' DoubleValue are converted into IntValues, presumably by other translators
-	t.prevPts.Put(fullKey, proto.Clone(currPt))
-	if v == nil {
-		// no previous point, so we can't calculate a delta
-		return nil
-	}
-	prevPt := v.(*sfxpb.DataPoint)
-	var deltaPt *sfxpb.DataPoint
+
+	point := deltacumulative.Point{Timestamp: pcommon.Timestamp(currPt.GetTimestamp() * int64(time.Millisecond))}
 	switch {
-	case currPt.Value.DoubleValue != nil && prevPt.Value.DoubleValue != nil:
-		deltaPt = doubleDeltaPt(currPt, prevPt, deltaMetricName)
-	case currPt.Value.IntValue != nil && prevPt.Value.IntValue != nil:
-		deltaPt = intDeltaPt(currPt, prevPt, deltaMetricName)
+	case currPt.Value.DoubleValue != nil:
+		point.IsFloat = true
+		point.FloatValue = *currPt.Value.DoubleValue
+	case currPt.Value.IntValue != nil:
+		point.IntValue = *currPt.Value.IntValue
 	default:
 		return nil
 	}
-	return deltaPt
-}
 
-func doubleDeltaPt(currPt *sfxpb.DataPoint, prevPt *sfxpb.DataPoint, deltaMetricName string) *sfxpb.DataPoint {
-	delta := *currPt.Value.DoubleValue - *prevPt.Value.DoubleValue
-	if delta < 0 {
-		// assume a reset, emit the current value
-		delta = *currPt.Value.DoubleValue
-	}
-	deltaPt := basePt(currPt, deltaMetricName)
-	*deltaPt.Value.DoubleValue = delta
-	return deltaPt
-}
-
-func intDeltaPt(currPt *sfxpb.DataPoint, prevPt *sfxpb.DataPoint, deltaMetricName string) *sfxpb.DataPoint {
-	delta := *currPt.Value.IntValue - *prevPt.Value.IntValue
-	if delta < 0 {
-		// assume a reset, emit the current value
-		delta = *currPt.Value.IntValue
+	// A decreasing value is assumed to be a reset, in which case the delta is the current value.
+	delta, ok := t.tracker.ToDelta(fullKey, point, true)
+	if !ok {
+		// no previous point, so we can't calculate a delta
+		return nil
 	}
-	deltaPt := basePt(currPt, deltaMetricName)
-	*deltaPt.Value.IntValue = delta
-	return deltaPt
-}
 
-var metricTypeGauge = sfxpb.MetricType_GAUGE
-
-func basePt(currPt *sfxpb.DataPoint, deltaMetricName string) *sfxpb.DataPoint {
+	// without proto.Clone here, points' DoubleValue are converted into IntValues, presumably by other translators
 	deltaPt := proto.Clone(currPt).(*sfxpb.DataPoint)
 	deltaPt.Metric = deltaMetricName
 	deltaPt.MetricType = &metricTypeGauge
+	if delta.IsFloat {
+		*deltaPt.Value.DoubleValue = delta.FloatValue
+	} else {
+		*deltaPt.Value.IntValue = delta.IntValue
+	}
 	return deltaPt
 }
+
+var metricTypeGauge = sfxpb.MetricType_GAUGE
@@ -15,12 +15,14 @@
 package translation // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/signalfxexporter/internal/translation"
 
 import (
+	"context"
 	"fmt"
 	"sort"
 	"strings"
 
 	"github.com/gogo/protobuf/proto"
 	sfxpb "github.com/signalfx/com_signalfx_metrics_protobuf/model"
+	"go.opentelemetry.io/collector/extension/experimental/storage"
 	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/signalfxexporter/internal/translation/dpfilters"
@@ -255,6 +257,17 @@ func NewMetricTranslator(rules []Rule, ttl int64) (*MetricTranslator, error) {
 	}, nil
 }
 
+// Start loads the state of the delta translation persisted by a previous run from the storage client. A nil client
+// keeps the state in memory only.
+func (mp *MetricTranslator) Start(ctx context.Context, client storage.Client) error {
+	return mp.deltaTranslator.start(ctx, client)
+}
+
+// Shutdown persists the state of the delta translation to the storage client given to Start.
+func (mp *MetricTranslator) Shutdown(ctx context.Context) error {
+	return mp.deltaTranslator.shutdown(ctx)
+}
+
 func validateTranslationRules(rules []Rule) error {
 	var renameDimensionKeysFound bool
 	for _, tr := range rules {
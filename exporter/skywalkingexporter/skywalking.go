@@ -26,6 +26,8 @@ import (
 	"google.golang.org/grpc/metadata"
 	metricpb "skywalking.apache.org/repo/goapi/collect/language/agent/v3"
 	logpb "skywalking.apache.org/repo/goapi/collect/logging/v3"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/grpccompression"
 )
 
 // See https://godoc.org/google.golang.org/grpc#ClientConn.NewStream
@@ -66,7 +68,8 @@ func newSwExporter(_ context.Context, cfg *Config, settings component.TelemetryS
 
 // start creates the gRPC client Connection
 func (oce *swExporter) start(ctx context.Context, host component.Host) error {
-	clientConn, err := oce.cfg.GRPCClientSettings.ToClientConn(ctx, host, oce.settings)
+	negotiator := grpccompression.NewNegotiator(string(oce.cfg.GRPCClientSettings.Compression), oce.settings.Logger)
+	clientConn, err := oce.cfg.GRPCClientSettings.ToClientConn(ctx, host, oce.settings, negotiator.DialOptions()...)
 	if err != nil {
 		return err
 	}
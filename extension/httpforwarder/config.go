@@ -15,7 +15,11 @@
 package httpforwarder // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/httpforwarder"
 
 import (
+	"fmt"
+	"strings"
+
 	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/config/configopaque"
 )
 
 // Config defines configuration for http forwarder extension.
@@ -26,4 +30,51 @@ type Config struct {
 
 	// Egress holds config settings to use for forwarded requests.
 	Egress confighttp.HTTPClientSettings `mapstructure:"egress"`
+
+	// Routes forward the requests matching a path prefix to a different target
+	// and mutate their headers. Requests not matching any route are forwarded
+	// to the egress endpoint.
+	Routes []RouteConfig `mapstructure:"routes"`
+}
+
+// RouteConfig defines how the requests matching a path prefix are forwarded.
+type RouteConfig struct {
+	// PathPrefix is the URL path prefix of the requests handled by the route.
+	// When several routes match a request the one with the longest prefix is used.
+	PathPrefix string `mapstructure:"path_prefix"`
+
+	// Endpoint is the target to which the requests of the route are forwarded.
+	// Defaults to the egress endpoint.
+	Endpoint string `mapstructure:"endpoint"`
+
+	// RequestHeaders mutates the headers of the forwarded requests.
+	RequestHeaders HeadersConfig `mapstructure:"request_headers"`
+
+	// ResponseHeaders mutates the headers of the responses sent back to the client.
+	ResponseHeaders HeadersConfig `mapstructure:"response_headers"`
+}
+
+// HeadersConfig defines the headers to add to and remove from an HTTP message.
+type HeadersConfig struct {
+	// Add are the headers added to the message.
+	Add map[string]configopaque.String `mapstructure:"add"`
+
+	// Remove are the names of the headers removed from the message, they are
+	// removed before the headers in Add are added.
+	Remove []string `mapstructure:"remove"`
+}
+
+// Validate checks if the extension configuration is valid.
+func (cfg *Config) Validate() error {
+	prefixes := make(map[string]struct{}, len(cfg.Routes))
+	for i, route := range cfg.Routes {
+		if !strings.HasPrefix(route.PathPrefix, "/") {
+			return fmt.Errorf("'routes[%d].path_prefix' must start with '/': %q", i, route.PathPrefix)
+		}
+		if _, ok := prefixes[route.PathPrefix]; ok {
+			return fmt.Errorf("'routes[%d].path_prefix' is duplicated: %q", i, route.PathPrefix)
+		}
+		prefixes[route.PathPrefix] = struct{}{}
+	}
+	return nil
 }
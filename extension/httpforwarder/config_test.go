@@ -53,6 +53,41 @@ func TestLoadConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			id: component.NewIDWithName(typeStr, "routes"),
+			expected: &Config{
+				Ingress: confighttp.HTTPServerSettings{
+					Endpoint: ":6060",
+				},
+				Egress: confighttp.HTTPClientSettings{
+					Endpoint: "http://target/",
+					Timeout:  10 * time.Second,
+				},
+				Routes: []RouteConfig{
+					{
+						PathPrefix: "/api/v2",
+						Endpoint:   "http://target-v2/",
+						RequestHeaders: HeadersConfig{
+							Add: map[string]configopaque.String{
+								"x-api-version": "2",
+							},
+							Remove: []string{"authorization"},
+						},
+						ResponseHeaders: HeadersConfig{
+							Remove: []string{"server"},
+						},
+					},
+					{
+						PathPrefix: "/api",
+						ResponseHeaders: HeadersConfig{
+							Add: map[string]configopaque.String{
+								"x-forwarded-by": "otel",
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.id.String(), func(t *testing.T) {
@@ -68,3 +103,33 @@ func TestLoadConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestLoadInvalidConfig(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		id          component.ID
+		expectedErr string
+	}{
+		{
+			id:          component.NewIDWithName(typeStr, "invalid_prefix"),
+			expectedErr: `'routes[0].path_prefix' must start with '/': "api"`,
+		},
+		{
+			id:          component.NewIDWithName(typeStr, "duplicate_prefix"),
+			expectedErr: `'routes[1].path_prefix' is duplicated: "/api"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+			require.NoError(t, err)
+			factory := NewFactory()
+			cfg := factory.CreateDefaultConfig()
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, component.UnmarshalConfig(sub, cfg))
+			assert.EqualError(t, component.ValidateConfig(cfg), tt.expectedErr)
+		})
+	}
+}
@@ -21,6 +21,8 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"sort"
+	"strings"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/extension"
@@ -29,12 +31,19 @@ import (
 
 type httpForwarder struct {
 	forwardTo  *url.URL
+	routes     []route
 	httpClient *http.Client
 	server     *http.Server
 	settings   component.TelemetrySettings
 	config     *Config
 }
 
+// route is a RouteConfig with its parsed target.
+type route struct {
+	RouteConfig
+	forwardTo *url.URL
+}
+
 var _ extension.Extension = (*httpForwarder)(nil)
 
 func (h *httpForwarder) Start(_ context.Context, host component.Host) error {
@@ -74,10 +83,16 @@ func (h *httpForwarder) Shutdown(_ context.Context) error {
 }
 
 func (h *httpForwarder) forwardRequest(writer http.ResponseWriter, request *http.Request) {
+	forwardTo := h.forwardTo
+	rt := h.matchRoute(request.URL.Path)
+	if rt != nil && rt.forwardTo != nil {
+		forwardTo = rt.forwardTo
+	}
+
 	forwarderRequest := request.Clone(request.Context())
-	forwarderRequest.URL.Host = h.forwardTo.Host
-	forwarderRequest.URL.Scheme = h.forwardTo.Scheme
-	forwarderRequest.Host = h.forwardTo.Host
+	forwarderRequest.URL.Host = forwardTo.Host
+	forwarderRequest.URL.Scheme = forwardTo.Scheme
+	forwarderRequest.Host = forwardTo.Host
 	// Clear RequestURI to avoid getting "http: Request.RequestURI can't be set in client requests" error.
 	forwarderRequest.RequestURI = ""
 
@@ -85,6 +100,9 @@ func (h *httpForwarder) forwardRequest(writer http.ResponseWriter, request *http
 	for k, v := range h.config.Egress.Headers {
 		forwarderRequest.Header.Add(k, string(v))
 	}
+	if rt != nil {
+		mutateHeaders(forwarderRequest.Header, rt.RequestHeaders)
+	}
 
 	// Add "Via" header for tracking purposes on both the outgoing requests and responses.
 	// See https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Via.
@@ -105,6 +123,9 @@ func (h *httpForwarder) forwardRequest(writer http.ResponseWriter, request *http
 		writer.Header().Set(k, response.Header.Get(k))
 	}
 	addViaHeader(writer.Header(), response.Proto, request.Host)
+	if rt != nil {
+		mutateHeaders(writer.Header(), rt.ResponseHeaders)
+	}
 
 	writer.WriteHeader(response.StatusCode)
 	written, err := io.Copy(writer, response.Body)
@@ -117,10 +138,30 @@ func (h *httpForwarder) forwardRequest(writer http.ResponseWriter, request *http
 	}
 }
 
+// matchRoute returns the route with the longest prefix matching the path, or nil if there is none.
+func (h *httpForwarder) matchRoute(path string) *route {
+	// Routes are sorted by decreasing prefix length so the first match is the longest one.
+	for i := range h.routes {
+		if strings.HasPrefix(path, h.routes[i].PathPrefix) {
+			return &h.routes[i]
+		}
+	}
+	return nil
+}
+
 func addViaHeader(header http.Header, protocol string, host string) {
 	header.Add("Via", fmt.Sprintf("%s %s", protocol, host))
 }
 
+func mutateHeaders(header http.Header, cfg HeadersConfig) {
+	for _, k := range cfg.Remove {
+		header.Del(k)
+	}
+	for k, v := range cfg.Add {
+		header.Add(k, string(v))
+	}
+}
+
 func newHTTPForwarder(config *Config, settings component.TelemetrySettings) (extension.Extension, error) {
 	if config.Egress.Endpoint == "" {
 		return nil, errors.New("'egress.endpoint' config option cannot be empty")
@@ -131,9 +172,24 @@ func newHTTPForwarder(config *Config, settings component.TelemetrySettings) (ext
 		return nil, fmt.Errorf("enter a valid URL for 'egress.endpoint': %w", err)
 	}
 
+	routes := make([]route, 0, len(config.Routes))
+	for i, routeConfig := range config.Routes {
+		rt := route{RouteConfig: routeConfig}
+		if routeConfig.Endpoint != "" {
+			if rt.forwardTo, err = url.Parse(routeConfig.Endpoint); err != nil {
+				return nil, fmt.Errorf("enter a valid URL for 'routes[%d].endpoint': %w", i, err)
+			}
+		}
+		routes = append(routes, rt)
+	}
+	sort.SliceStable(routes, func(i, j int) bool {
+		return len(routes[i].PathPrefix) > len(routes[j].PathPrefix)
+	})
+
 	h := &httpForwarder{
 		config:    config,
 		forwardTo: url,
+		routes:    routes,
 		settings:  settings,
 	}
 
@@ -261,6 +261,144 @@ func TestExtension(t *testing.T) {
 	}
 }
 
+func TestExtensionRoutes(t *testing.T) {
+	listenAt := testutil.GetAvailableLocalAddress(t)
+
+	newBackend := func(name string) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Server", name)
+			w.Header().Set("X-Api-Version", r.Header.Get("X-Api-Version"))
+			w.Header().Set("X-Authorization", r.Header.Get("Authorization"))
+			w.WriteHeader(http.StatusOK)
+			_, err := w.Write([]byte(name))
+			assert.NoError(t, err)
+		}))
+	}
+	defaultBackend := newBackend("default")
+	defer defaultBackend.Close()
+	v2Backend := newBackend("v2")
+	defer v2Backend.Close()
+
+	config := &Config{
+		Ingress: confighttp.HTTPServerSettings{
+			Endpoint: listenAt,
+		},
+		Egress: confighttp.HTTPClientSettings{
+			Endpoint: defaultBackend.URL,
+		},
+		Routes: []RouteConfig{
+			{
+				PathPrefix: "/api",
+				ResponseHeaders: HeadersConfig{
+					Add: map[string]configopaque.String{
+						"X-Forwarded-By": "otel",
+					},
+				},
+			},
+			{
+				PathPrefix: "/api/v2",
+				Endpoint:   v2Backend.URL,
+				RequestHeaders: HeadersConfig{
+					Add: map[string]configopaque.String{
+						"X-Api-Version": "2",
+					},
+					Remove: []string{"Authorization"},
+				},
+				ResponseHeaders: HeadersConfig{
+					Remove: []string{"Server"},
+				},
+			},
+		},
+	}
+
+	hf, err := newHTTPForwarder(config, componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	require.NoError(t, hf.Start(ctx, componenttest.NewNopHost()))
+	defer func() {
+		require.NoError(t, hf.Shutdown(ctx))
+	}()
+
+	tests := []struct {
+		name            string
+		path            string
+		expectedBody    string
+		expectedHeaders map[string]string
+	}{
+		{
+			name:         "No matching route",
+			path:         "/other",
+			expectedBody: "default",
+			expectedHeaders: map[string]string{
+				"Server":          "default",
+				"X-Api-Version":   "",
+				"X-Authorization": "secret",
+				"X-Forwarded-By":  "",
+			},
+		},
+		{
+			name:         "Route without endpoint",
+			path:         "/api/v1/dosomething",
+			expectedBody: "default",
+			expectedHeaders: map[string]string{
+				"Server":          "default",
+				"X-Api-Version":   "",
+				"X-Authorization": "secret",
+				"X-Forwarded-By":  "otel",
+			},
+		},
+		{
+			name:         "Longest prefix wins",
+			path:         "/api/v2/dosomething",
+			expectedBody: "v2",
+			expectedHeaders: map[string]string{
+				"Server":          "",
+				"X-Api-Version":   "2",
+				"X-Authorization": "",
+				"X-Forwarded-By":  "",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			response, err := http.DefaultClient.Do(httpRequest(t, clientRequestArgs{
+				method: "GET",
+				url:    fmt.Sprintf("http://%s%s", listenAt, test.path),
+				headers: map[string]string{
+					"Authorization": "secret",
+				},
+			}))
+			require.NoError(t, err)
+			defer response.Body.Close()
+
+			assert.Equal(t, http.StatusOK, response.StatusCode)
+			assert.Equal(t, test.expectedBody, string(readBody(response.Body)))
+			for k, v := range test.expectedHeaders {
+				assert.Equal(t, v, response.Header.Get(k), k)
+			}
+		})
+	}
+}
+
+func TestInvalidRouteEndpoint(t *testing.T) {
+	config := &Config{
+		Egress: confighttp.HTTPClientSettings{
+			Endpoint: "http://target/",
+		},
+		Routes: []RouteConfig{
+			{
+				PathPrefix: "/api",
+				Endpoint:   "\x7f",
+			},
+		},
+	}
+	_, err := newHTTPForwarder(config, componenttest.NewNopTelemetrySettings())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "enter a valid URL for 'routes[0].endpoint'")
+}
+
 func httpRequest(t *testing.T, args clientRequestArgs) *http.Request {
 	r, err := http.NewRequest(args.method, args.url, io.NopCloser(strings.NewReader(args.body)))
 	require.NoError(t, err)
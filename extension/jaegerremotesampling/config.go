@@ -24,9 +24,12 @@ import (
 )
 
 var (
-	errTooManySources     = errors.New("too many sources specified, has to be either 'file' or 'remote'")
-	errNoSources          = errors.New("no sources specified, has to be either 'file' or 'remote'")
-	errAtLeastOneProtocol = errors.New("no protocols selected to serve the strategies, use 'grpc', 'http', or both")
+	errTooManySources             = errors.New("too many sources specified, has to be either 'file', 'remote' or 'adaptive'")
+	errNoSources                  = errors.New("no sources specified, has to be either 'file', 'remote' or 'adaptive'")
+	errAtLeastOneProtocol         = errors.New("no protocols selected to serve the strategies, use 'grpc', 'http', or both")
+	errInvalidTargetRate          = errors.New("'target_spans_per_second' must be positive")
+	errInvalidCalculationInterval = errors.New("'calculation_interval' must not be negative")
+	errInvalidProbability         = errors.New("'initial_sampling_probability' and 'min_sampling_probability' must be within [0, 1]")
 )
 
 // Config has the configuration for the extension enabling the health check
@@ -46,10 +49,29 @@ type Source struct {
 	// File specifies a local file as the strategies source
 	File string `mapstructure:"file"`
 
+	// Adaptive serves probabilistic strategies adjusted from the span throughput observed per service
+	Adaptive *AdaptiveSettings `mapstructure:"adaptive"`
+
 	// ReloadInterval determines the periodicity to refresh the strategies
 	ReloadInterval time.Duration `mapstructure:"reload_interval"`
 }
 
+// AdaptiveSettings configures the adaptive source. The span throughput is reported to the extension
+// by components seeing the span stream, such as connectors, through the SpanObserver interface.
+type AdaptiveSettings struct {
+	// TargetSpansPerSecond is the global rate of sampled spans the served probabilities aim for
+	TargetSpansPerSecond float64 `mapstructure:"target_spans_per_second"`
+
+	// CalculationInterval determines the periodicity to recalculate the probabilities, defaults to 1m
+	CalculationInterval time.Duration `mapstructure:"calculation_interval"`
+
+	// InitialSamplingProbability is served to services without observed spans, defaults to 0.001
+	InitialSamplingProbability float64 `mapstructure:"initial_sampling_probability"`
+
+	// MinSamplingProbability is the lowest probability served to any service, defaults to 0.00001
+	MinSamplingProbability float64 `mapstructure:"min_sampling_probability"`
+}
+
 var _ component.Config = (*Config)(nil)
 
 // Validate checks if the extension configuration is valid
@@ -58,13 +80,42 @@ func (cfg *Config) Validate() error {
 		return errAtLeastOneProtocol
 	}
 
-	if cfg.Source.File != "" && cfg.Source.Remote != nil {
+	sources := 0
+	if cfg.Source.File != "" {
+		sources++
+	}
+	if cfg.Source.Remote != nil {
+		sources++
+	}
+	if cfg.Source.Adaptive != nil {
+		sources++
+	}
+
+	if sources > 1 {
 		return errTooManySources
 	}
 
-	if cfg.Source.File == "" && cfg.Source.Remote == nil {
+	if sources == 0 {
 		return errNoSources
 	}
 
 	return nil
 }
+
+// Validate checks if the adaptive source configuration is valid
+func (cfg *AdaptiveSettings) Validate() error {
+	if cfg.TargetSpansPerSecond <= 0 {
+		return errInvalidTargetRate
+	}
+
+	if cfg.CalculationInterval < 0 {
+		return errInvalidCalculationInterval
+	}
+
+	if cfg.InitialSamplingProbability < 0 || cfg.InitialSamplingProbability > 1 ||
+		cfg.MinSamplingProbability < 0 || cfg.MinSamplingProbability > 1 {
+		return errInvalidProbability
+	}
+
+	return nil
+}
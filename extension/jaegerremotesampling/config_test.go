@@ -64,6 +64,22 @@ func TestLoadConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			id: component.NewIDWithName(typeStr, "2"),
+			expected: &Config{
+				HTTPServerSettings: &confighttp.HTTPServerSettings{Endpoint: ":5778"},
+				GRPCServerSettings: &configgrpc.GRPCServerSettings{NetAddr: confignet.NetAddr{
+					Endpoint:  ":14250",
+					Transport: "tcp",
+				}},
+				Source: Source{
+					Adaptive: &AdaptiveSettings{
+						TargetSpansPerSecond: 100,
+						CalculationInterval:  30 * time.Second,
+					},
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.id.String(), func(t *testing.T) {
@@ -110,6 +126,26 @@ func TestValidate(t *testing.T) {
 			},
 			expected: errTooManySources,
 		},
+		{
+			desc: "adaptive and file sources",
+			cfg: Config{
+				GRPCServerSettings: &configgrpc.GRPCServerSettings{},
+				Source: Source{
+					Adaptive: &AdaptiveSettings{TargetSpansPerSecond: 1},
+					File:     "/tmp/some-file",
+				},
+			},
+			expected: errTooManySources,
+		},
+		{
+			desc: "adaptive source",
+			cfg: Config{
+				GRPCServerSettings: &configgrpc.GRPCServerSettings{},
+				Source: Source{
+					Adaptive: &AdaptiveSettings{TargetSpansPerSecond: 1},
+				},
+			},
+		},
 	}
 	for _, tC := range testCases {
 		t.Run(tC.desc, func(t *testing.T) {
@@ -118,3 +154,47 @@ func TestValidate(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateAdaptive(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		cfg      AdaptiveSettings
+		expected error
+	}{
+		{
+			desc:     "missing target rate",
+			cfg:      AdaptiveSettings{},
+			expected: errInvalidTargetRate,
+		},
+		{
+			desc: "negative calculation interval",
+			cfg: AdaptiveSettings{
+				TargetSpansPerSecond: 1,
+				CalculationInterval:  -time.Second,
+			},
+			expected: errInvalidCalculationInterval,
+		},
+		{
+			desc: "probability out of range",
+			cfg: AdaptiveSettings{
+				TargetSpansPerSecond:       1,
+				InitialSamplingProbability: 1.5,
+			},
+			expected: errInvalidProbability,
+		},
+		{
+			desc: "valid",
+			cfg: AdaptiveSettings{
+				TargetSpansPerSecond:       1,
+				CalculationInterval:        time.Second,
+				InitialSamplingProbability: 0.1,
+				MinSamplingProbability:     0.01,
+			},
+		},
+	}
+	for _, tC := range testCases {
+		t.Run(tC.desc, func(t *testing.T) {
+			assert.Equal(t, tC.expected, tC.cfg.Validate())
+		})
+	}
+}
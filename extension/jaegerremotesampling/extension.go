@@ -17,6 +17,7 @@ package jaegerremotesampling // import "github.com/open-telemetry/opentelemetry-
 import (
 	"context"
 	"fmt"
+	"time"
 
 	grpcStore "github.com/jaegertracing/jaeger/cmd/agent/app/configmanager/grpc"
 	"github.com/jaegertracing/jaeger/cmd/collector/app/sampling/strategystore"
@@ -28,7 +29,21 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/jaegerremotesampling/internal"
 )
 
+const (
+	defaultCalculationInterval        = time.Minute
+	defaultInitialSamplingProbability = 0.001
+	defaultMinSamplingProbability     = 0.00001
+)
+
 var _ extension.Extension = (*jrsExtension)(nil)
+var _ SpanObserver = (*jrsExtension)(nil)
+
+// SpanObserver is implemented by the extension to receive the span throughput used by the adaptive
+// source. Components seeing the span stream, such as the spanthroughput connector, find the extension
+// through component.Host and report the number of spans observed per service.
+type SpanObserver interface {
+	ObserveSpans(serviceName string, count int)
+}
 
 type jrsExtension struct {
 	cfg       *Config
@@ -37,6 +52,7 @@ type jrsExtension struct {
 	httpServer    component.Component
 	grpcServer    component.Component
 	samplingStore strategystore.StrategyStore
+	adaptiveStore *internal.AdaptiveStrategyStore
 
 	closers []func() error
 }
@@ -82,6 +98,18 @@ func (jrse *jrsExtension) Start(ctx context.Context, host component.Host) error
 		})
 	}
 
+	if jrse.cfg.Source.Adaptive != nil {
+		ss, err := internal.NewAdaptiveStrategyStore(jrse.telemetry.Logger, adaptiveOptions(jrse.cfg.Source.Adaptive))
+		if err != nil {
+			return fmt.Errorf("failed to create the adaptive strategy store: %w", err)
+		}
+
+		ss.Start()
+		jrse.adaptiveStore = ss
+		jrse.samplingStore = ss
+		jrse.closers = append(jrse.closers, ss.Close)
+	}
+
 	if jrse.cfg.HTTPServerSettings != nil {
 		httpServer, err := internal.NewHTTP(jrse.telemetry, *jrse.cfg.HTTPServerSettings, jrse.samplingStore)
 		if err != nil {
@@ -131,3 +159,30 @@ func (jrse *jrsExtension) Shutdown(ctx context.Context) error {
 
 	return nil
 }
+
+// ObserveSpans reports spans seen for the given service to the adaptive source, it is a no-op for
+// the other sources.
+func (jrse *jrsExtension) ObserveSpans(serviceName string, count int) {
+	if jrse.adaptiveStore != nil {
+		jrse.adaptiveStore.ObserveSpans(serviceName, count)
+	}
+}
+
+func adaptiveOptions(cfg *AdaptiveSettings) internal.AdaptiveOptions {
+	opts := internal.AdaptiveOptions{
+		TargetSpansPerSecond:       cfg.TargetSpansPerSecond,
+		CalculationInterval:        cfg.CalculationInterval,
+		InitialSamplingProbability: cfg.InitialSamplingProbability,
+		MinSamplingProbability:     cfg.MinSamplingProbability,
+	}
+	if opts.CalculationInterval == 0 {
+		opts.CalculationInterval = defaultCalculationInterval
+	}
+	if opts.InitialSamplingProbability == 0 {
+		opts.InitialSamplingProbability = defaultInitialSamplingProbability
+	}
+	if opts.MinSamplingProbability == 0 {
+		opts.MinSamplingProbability = defaultMinSamplingProbability
+	}
+	return opts
+}
@@ -84,6 +84,28 @@ func TestStartAndShutdownRemote(t *testing.T) {
 	assert.NoError(t, e.Shutdown(context.Background()))
 }
 
+func TestAdaptiveObserveSpans(t *testing.T) {
+	// prepare
+	cfg := testConfig()
+	cfg.Source.Adaptive = &AdaptiveSettings{TargetSpansPerSecond: 10}
+
+	e := newExtension(cfg, componenttest.NewNopTelemetrySettings())
+	require.NotNil(t, e)
+	require.NoError(t, e.Start(context.Background(), componenttest.NewNopHost()))
+
+	// test
+	var observer SpanObserver = e
+	observer.ObserveSpans("foo", 10)
+
+	// verify
+	resp, err := e.samplingStore.GetSamplingStrategy(context.Background(), "foo")
+	require.NoError(t, err)
+	assert.Equal(t, api_v2.SamplingStrategyType_PROBABILISTIC, resp.StrategyType)
+	assert.Equal(t, defaultInitialSamplingProbability, resp.ProbabilisticSampling.SamplingRate)
+
+	assert.NoError(t, e.Shutdown(context.Background()))
+}
+
 type samplingServer struct {
 	api_v2.UnimplementedSamplingManagerServer
 }
@@ -0,0 +1,185 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/jaegerremotesampling/internal"
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/jaegertracing/jaeger/cmd/collector/app/sampling/strategystore"
+	"github.com/jaegertracing/jaeger/proto-gen/api_v2"
+	"go.uber.org/zap"
+)
+
+var _ strategystore.StrategyStore = (*AdaptiveStrategyStore)(nil)
+
+var errInvalidTargetRate = errors.New("the target spans per second must be positive")
+
+// AdaptiveOptions holds the parameters of the adaptive strategy store.
+type AdaptiveOptions struct {
+	TargetSpansPerSecond       float64
+	CalculationInterval        time.Duration
+	InitialSamplingProbability float64
+	MinSamplingProbability     float64
+}
+
+// AdaptiveStrategyStore serves probabilistic strategies, recalculating the probability of each
+// service periodically so that the spans observed across all services approach a global target rate.
+type AdaptiveStrategyStore struct {
+	logger  *zap.Logger
+	options AdaptiveOptions
+
+	mu            sync.Mutex
+	observed      map[string]int64
+	probabilities map[string]float64
+	lastRun       time.Time
+
+	stopCh     chan struct{}
+	shutdownWG sync.WaitGroup
+}
+
+// NewAdaptiveStrategyStore returns a new adaptive strategy store. Start has to be called for the
+// probabilities to be recalculated.
+func NewAdaptiveStrategyStore(logger *zap.Logger, options AdaptiveOptions) (*AdaptiveStrategyStore, error) {
+	if options.TargetSpansPerSecond <= 0 {
+		return nil, errInvalidTargetRate
+	}
+
+	return &AdaptiveStrategyStore{
+		logger:        logger,
+		options:       options,
+		observed:      map[string]int64{},
+		probabilities: map[string]float64{},
+		stopCh:        make(chan struct{}),
+	}, nil
+}
+
+// GetSamplingStrategy returns the current probabilistic strategy for the given service.
+func (s *AdaptiveStrategyStore) GetSamplingStrategy(_ context.Context, serviceName string) (*api_v2.SamplingStrategyResponse, error) {
+	s.mu.Lock()
+	probability := s.probabilityLocked(serviceName)
+	s.mu.Unlock()
+
+	return &api_v2.SamplingStrategyResponse{
+		StrategyType: api_v2.SamplingStrategyType_PROBABILISTIC,
+		ProbabilisticSampling: &api_v2.ProbabilisticSamplingStrategy{
+			SamplingRate: probability,
+		},
+	}, nil
+}
+
+// ObserveSpans records that the given number of sampled spans has been seen for the service.
+func (s *AdaptiveStrategyStore) ObserveSpans(serviceName string, count int) {
+	if count <= 0 {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.observed[serviceName] += int64(count)
+	if _, ok := s.probabilities[serviceName]; !ok {
+		s.probabilities[serviceName] = s.options.InitialSamplingProbability
+	}
+}
+
+// Start launches the periodic recalculation of the probabilities.
+func (s *AdaptiveStrategyStore) Start() {
+	s.mu.Lock()
+	s.lastRun = time.Now()
+	s.mu.Unlock()
+
+	s.shutdownWG.Add(1)
+	go func() {
+		defer s.shutdownWG.Done()
+
+		ticker := time.NewTicker(s.options.CalculationInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case now := <-ticker.C:
+				s.recalculate(now)
+			case <-s.stopCh:
+				return
+			}
+		}
+	}()
+}
+
+// Close stops the periodic recalculation of the probabilities.
+func (s *AdaptiveStrategyStore) Close() error {
+	close(s.stopCh)
+	s.shutdownWG.Wait()
+	return nil
+}
+
+func (s *AdaptiveStrategyStore) probabilityLocked(serviceName string) float64 {
+	if probability, ok := s.probabilities[serviceName]; ok {
+		return probability
+	}
+	return s.options.InitialSamplingProbability
+}
+
+type serviceRate struct {
+	name string
+	rate float64
+}
+
+// recalculate estimates the incoming span rate of each service from the spans observed under the
+// probability served since the last run, and shares the target rate between the services: services
+// below their fair share are sampled entirely and the remainder is split among the busier ones.
+func (s *AdaptiveStrategyStore) recalculate(now time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	elapsed := now.Sub(s.lastRun).Seconds()
+	s.lastRun = now
+	if elapsed <= 0 || len(s.observed) == 0 {
+		return
+	}
+
+	rates := make([]serviceRate, 0, len(s.observed))
+	for name, count := range s.observed {
+		rates = append(rates, serviceRate{
+			name: name,
+			rate: float64(count) / elapsed / s.probabilityLocked(name),
+		})
+	}
+	sort.Slice(rates, func(i, j int) bool {
+		return rates[i].rate < rates[j].rate
+	})
+
+	remaining := s.options.TargetSpansPerSecond
+	for i, sr := range rates {
+		share := remaining / float64(len(rates)-i)
+		probability := 1.0
+		if sr.rate > share {
+			probability = share / sr.rate
+		}
+		if probability < s.options.MinSamplingProbability {
+			probability = s.options.MinSamplingProbability
+		}
+		remaining -= sr.rate * probability
+		if remaining < 0 {
+			remaining = 0
+		}
+		s.probabilities[sr.name] = probability
+	}
+
+	s.logger.Debug("recalculated adaptive sampling probabilities", zap.Int("services", len(rates)))
+	s.observed = map[string]int64{}
+}
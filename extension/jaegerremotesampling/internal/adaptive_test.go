@@ -0,0 +1,120 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jaegertracing/jaeger/proto-gen/api_v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestAdaptiveInvalidTargetRate(t *testing.T) {
+	s, err := NewAdaptiveStrategyStore(zap.NewNop(), AdaptiveOptions{})
+	assert.Equal(t, errInvalidTargetRate, err)
+	assert.Nil(t, s)
+}
+
+func TestAdaptiveInitialStrategy(t *testing.T) {
+	s, err := NewAdaptiveStrategyStore(zap.NewNop(), AdaptiveOptions{
+		TargetSpansPerSecond:       10,
+		CalculationInterval:        time.Minute,
+		InitialSamplingProbability: 0.5,
+		MinSamplingProbability:     0.001,
+	})
+	require.NoError(t, err)
+
+	resp, err := s.GetSamplingStrategy(context.Background(), "foo")
+	require.NoError(t, err)
+	assert.Equal(t, api_v2.SamplingStrategyType_PROBABILISTIC, resp.StrategyType)
+	assert.Equal(t, 0.5, resp.ProbabilisticSampling.SamplingRate)
+}
+
+func TestAdaptiveRecalculate(t *testing.T) {
+	s, err := NewAdaptiveStrategyStore(zap.NewNop(), AdaptiveOptions{
+		TargetSpansPerSecond:       10,
+		CalculationInterval:        time.Minute,
+		InitialSamplingProbability: 0.5,
+		MinSamplingProbability:     0.001,
+	})
+	require.NoError(t, err)
+
+	now := time.Now()
+	s.lastRun = now.Add(-10 * time.Second)
+
+	// with a probability of 0.5, these are estimated to 2, 20 and 200 spans per second
+	s.ObserveSpans("quiet", 10)
+	s.ObserveSpans("busy", 100)
+	s.ObserveSpans("noisy", 1000)
+	s.ObserveSpans("ignored", 0)
+	s.recalculate(now)
+
+	// "quiet" fits its share of 10/3 and is fully sampled, the remaining 8 spans per
+	// second are shared between "busy" and "noisy"
+	assert.InDelta(t, 1.0, strategyProbability(t, s, "quiet"), 1e-9)
+	assert.InDelta(t, 4.0/20, strategyProbability(t, s, "busy"), 1e-9)
+	assert.InDelta(t, 4.0/200, strategyProbability(t, s, "noisy"), 1e-9)
+	assert.InDelta(t, 0.5, strategyProbability(t, s, "ignored"), 1e-9)
+
+	// services without new observations keep their probability
+	s.ObserveSpans("quiet", 20)
+	s.recalculate(now.Add(10 * time.Second))
+	assert.InDelta(t, 1.0, strategyProbability(t, s, "quiet"), 1e-9)
+	assert.InDelta(t, 4.0/20, strategyProbability(t, s, "busy"), 1e-9)
+}
+
+func TestAdaptiveMinProbability(t *testing.T) {
+	s, err := NewAdaptiveStrategyStore(zap.NewNop(), AdaptiveOptions{
+		TargetSpansPerSecond:       1,
+		CalculationInterval:        time.Minute,
+		InitialSamplingProbability: 1,
+		MinSamplingProbability:     0.01,
+	})
+	require.NoError(t, err)
+
+	now := time.Now()
+	s.lastRun = now.Add(-time.Second)
+	s.ObserveSpans("noisy", 100000)
+	s.recalculate(now)
+
+	assert.Equal(t, 0.01, strategyProbability(t, s, "noisy"))
+}
+
+func TestAdaptiveStartAndClose(t *testing.T) {
+	s, err := NewAdaptiveStrategyStore(zap.NewNop(), AdaptiveOptions{
+		TargetSpansPerSecond:       1,
+		CalculationInterval:        time.Millisecond,
+		InitialSamplingProbability: 1,
+		MinSamplingProbability:     0.01,
+	})
+	require.NoError(t, err)
+
+	s.Start()
+	s.ObserveSpans("noisy", 100000)
+	assert.Eventually(t, func() bool {
+		return strategyProbability(t, s, "noisy") < 1
+	}, time.Second, time.Millisecond)
+	assert.NoError(t, s.Close())
+}
+
+func strategyProbability(t *testing.T, s *AdaptiveStrategyStore, service string) float64 {
+	resp, err := s.GetSamplingStrategy(context.Background(), service)
+	require.NoError(t, err)
+	return resp.ProbabilisticSampling.SamplingRate
+}
@@ -18,6 +18,8 @@ import (
 	"errors"
 	"fmt"
 	"time"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/docker"
 )
 
 // Config defines configuration for docker observer
@@ -26,6 +28,10 @@ type Config struct {
 	// The URL of the docker server.  Default is "unix:///var/run/docker.sock"
 	Endpoint string `mapstructure:"endpoint"`
 
+	// The container runtime API served by the endpoint, either "docker" or "cri" for a CRI
+	// runtime like containerd. Default is "docker"
+	Runtime string `mapstructure:"runtime"`
+
 	// The maximum amount of time to wait for docker API responses.  Default is 5s
 	Timeout time.Duration `mapstructure:"timeout"`
 
@@ -62,8 +68,14 @@ func (config Config) Validate() error {
 	if config.Endpoint == "" {
 		return errors.New("endpoint must be specified")
 	}
-	if config.DockerAPIVersion < minimalRequiredDockerAPIVersion {
-		return fmt.Errorf("api_version must be at least %v", minimalRequiredDockerAPIVersion)
+	switch config.Runtime {
+	case "", docker.RuntimeDocker:
+		if config.DockerAPIVersion < minimalRequiredDockerAPIVersion {
+			return fmt.Errorf("api_version must be at least %v", minimalRequiredDockerAPIVersion)
+		}
+	case docker.RuntimeCRI:
+	default:
+		return fmt.Errorf("runtime must be either %q or %q, got %q", docker.RuntimeDocker, docker.RuntimeCRI, config.Runtime)
 	}
 	if config.Timeout == 0 {
 		return fmt.Errorf("timeout must be specified")
@@ -40,6 +40,7 @@ func TestLoadConfig(t *testing.T) {
 			id: component.NewIDWithName(typeStr, "all_settings"),
 			expected: &Config{
 				Endpoint:              "unix:///var/run/docker.sock",
+				Runtime:               "docker",
 				CacheSyncInterval:     5 * time.Minute,
 				Timeout:               20 * time.Second,
 				ExcludedImages:        []string{"excluded", "image"},
@@ -49,6 +50,15 @@ func TestLoadConfig(t *testing.T) {
 				DockerAPIVersion:      1.22,
 			},
 		},
+		{
+			id: component.NewIDWithName(typeStr, "cri"),
+			expected: func() component.Config {
+				cfg := NewFactory().CreateDefaultConfig().(*Config)
+				cfg.Endpoint = "unix:///run/containerd/containerd.sock"
+				cfg.Runtime = "cri"
+				return cfg
+			}(),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.id.String(), func(t *testing.T) {
@@ -66,9 +76,15 @@ func TestValidateConfig(t *testing.T) {
 	cfg = &Config{Endpoint: "someEndpoint"}
 	assert.Equal(t, "api_version must be at least 1.22", component.ValidateConfig(cfg).Error())
 
+	cfg = &Config{Endpoint: "someEndpoint", Runtime: "podman", DockerAPIVersion: 1.22}
+	assert.Equal(t, `runtime must be either "docker" or "cri", got "podman"`, component.ValidateConfig(cfg).Error())
+
 	cfg = &Config{Endpoint: "someEndpoint", DockerAPIVersion: 1.22}
 	assert.Equal(t, "timeout must be specified", component.ValidateConfig(cfg).Error())
 
+	cfg = &Config{Endpoint: "someEndpoint", Runtime: "cri"}
+	assert.Equal(t, "timeout must be specified", component.ValidateConfig(cfg).Error())
+
 	cfg = &Config{Endpoint: "someEndpoint", DockerAPIVersion: 1.22, Timeout: 5 * time.Minute}
 	assert.Equal(t, "cache_sync_interval must be specified", component.ValidateConfig(cfg).Error())
 
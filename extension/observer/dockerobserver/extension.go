@@ -68,7 +68,7 @@ func (d *dockerObserver) Start(ctx context.Context, host component.Host) error {
 	d.ctx = dCtx
 
 	// Create new Docker client
-	dConfig, err := docker.NewConfig(d.config.Endpoint, d.config.Timeout, d.config.ExcludedImages, d.config.DockerAPIVersion)
+	dConfig, err := docker.NewConfig(d.config.Endpoint, d.config.Runtime, d.config.Timeout, d.config.ExcludedImages, d.config.DockerAPIVersion)
 	if err != nil {
 		return err
 	}
@@ -113,6 +113,9 @@ func (d *dockerObserver) Start(ctx context.Context, host component.Host) error {
 
 func (d *dockerObserver) Shutdown(ctx context.Context) error {
 	d.cancel()
+	if d.dClient != nil {
+		return d.dClient.Close()
+	}
 	return nil
 }
 
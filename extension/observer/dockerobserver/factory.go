@@ -20,6 +20,8 @@ import (
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/extension"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/docker"
 )
 
 const (
@@ -40,6 +42,7 @@ func NewFactory() extension.Factory {
 func createDefaultConfig() component.Config {
 	return &Config{
 		Endpoint:          "unix:///var/run/docker.sock",
+		Runtime:           docker.RuntimeDocker,
 		Timeout:           5 * time.Second,
 		CacheSyncInterval: 60 * time.Minute,
 		DockerAPIVersion:  defaultDockerAPIVersion,
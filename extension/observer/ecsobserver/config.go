@@ -48,6 +48,13 @@ type Config struct {
 	TaskDefinitions []TaskDefinitionConfig `mapstructure:"task_definitions" yaml:"task_definitions"`
 	// DockerLabels is a list of docker labels for filtering containers within tasks.
 	DockerLabels []DockerLabelConfig `mapstructure:"docker_labels" yaml:"docker_labels"`
+	// CapacityProviders is a list of capacity provider names, e.g. FARGATE_SPOT, tasks must be running on (optional).
+	CapacityProviders []string `mapstructure:"capacity_providers" yaml:"capacity_providers"`
+	// TagFilters is a list of task or service tags tasks must all match (optional).
+	TagFilters []TagFilterConfig `mapstructure:"tag_filters" yaml:"tag_filters"`
+	// ExportTags is a list of task and service tag keys attached as labels to the targets.
+	// Empty means all tags are attached.
+	ExportTags []string `mapstructure:"export_tags" yaml:"export_tags"`
 }
 
 // Validate overrides the embedded noop validation so that load config can trigger
@@ -73,6 +80,11 @@ func (c *Config) Validate() error {
 			return err
 		}
 	}
+	for _, t := range c.TagFilters {
+		if err := t.validate(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -121,5 +133,16 @@ func exampleConfig() *Config {
 				PortLabel: "ECS_PROMETHEUS_EXPORTER_PORT",
 			},
 		},
+		CapacityProviders: []string{"FARGATE", "FARGATE_SPOT"},
+		TagFilters: []TagFilterConfig{
+			{
+				Key:          "team",
+				ValuePattern: "^(web|api)$",
+			},
+			{
+				Key: "monitored",
+			},
+		},
+		ExportTags: []string{"team"},
 	}
 }
@@ -115,6 +115,13 @@ func TestConfig_Validate(t *testing.T) {
 				DockerLabels: []DockerLabelConfig{{PortLabel: ""}},
 			},
 		},
+		{
+			reason: "tag filter",
+			cfg: Config{
+				ClusterName: "c1",
+				TagFilters:  []TagFilterConfig{{Key: "team", ValuePattern: "*"}}, // invalid regex
+			},
+		},
 	}
 
 	for _, tCase := range cases {
@@ -62,13 +62,35 @@ func (s *commonExportSetting) hasContainerPort(containerPort int) bool {
 type taskExporter struct {
 	logger  *zap.Logger
 	cluster string
+	// exportTags is nil when all the task and service tags are exported.
+	exportTags map[string]bool
 }
 
-func newTaskExporter(logger *zap.Logger, cluster string) *taskExporter {
-	return &taskExporter{
+func newTaskExporter(logger *zap.Logger, cluster string, exportTags []string) *taskExporter {
+	e := &taskExporter{
 		logger:  logger,
 		cluster: cluster,
 	}
+	if len(exportTags) > 0 {
+		e.exportTags = make(map[string]bool, len(exportTags))
+		for _, k := range exportTags {
+			e.exportTags[k] = true
+		}
+	}
+	return e
+}
+
+// selectTags removes the tags not listed in export_tags.
+func (e *taskExporter) selectTags(tags map[string]string) map[string]string {
+	if e.exportTags == nil || tags == nil {
+		return tags
+	}
+	for k := range tags {
+		if !e.exportTags[k] {
+			delete(tags, k)
+		}
+	}
+	return tags
 }
 
 // exportTasks loops a list of tasks and export prometheus scrape targets.
@@ -109,11 +131,12 @@ func (e *taskExporter) exportTask(task *taskAnnotated) ([]prometheusECSTarget, e
 		TaskStartedBy:          aws.StringValue(task.Task.StartedBy),
 		TaskLaunchType:         aws.StringValue(task.Task.LaunchType),
 		TaskGroup:              aws.StringValue(task.Task.Group),
-		TaskTags:               task.TaskTags(),
+		TaskTags:               e.selectTags(task.TaskTags()),
 		HealthStatus:           aws.StringValue(task.Task.HealthStatus),
 	}
 	if task.Service != nil {
 		baseTarget.ServiceName = aws.StringValue(task.Service.ServiceName)
+		baseTarget.ServiceTags = e.selectTags(task.ServiceTags())
 	}
 	if task.EC2 != nil {
 		ec2 := task.EC2
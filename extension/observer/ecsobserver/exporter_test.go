@@ -28,8 +28,21 @@ import (
 	"go.uber.org/zap/zaptest/observer"
 )
 
+func TestTaskExporter_SelectTags(t *testing.T) {
+	t.Run("all", func(t *testing.T) {
+		exp := newTaskExporter(zap.NewExample(), "ecs-cluster-1", nil)
+		assert.Equal(t, map[string]string{"a": "1", "b": "2"}, exp.selectTags(map[string]string{"a": "1", "b": "2"}))
+	})
+
+	t.Run("selected", func(t *testing.T) {
+		exp := newTaskExporter(zap.NewExample(), "ecs-cluster-1", []string{"a", "c"})
+		assert.Equal(t, map[string]string{"a": "1"}, exp.selectTags(map[string]string{"a": "1", "b": "2"}))
+		assert.Nil(t, exp.selectTags(nil))
+	})
+}
+
 func TestTaskExporter(t *testing.T) {
-	exp := newTaskExporter(zap.NewExample(), "ecs-cluster-1")
+	exp := newTaskExporter(zap.NewExample(), "ecs-cluster-1", nil)
 
 	t.Run("invalid ip", func(t *testing.T) {
 		_, err := exp.exportTask(&taskAnnotated{
@@ -178,6 +178,7 @@ func (f *taskFetcher) getAllTasks(ctx context.Context) ([]*ecs.Task, error) {
 		descRes, err := svc.DescribeTasksWithContext(ctx, &ecs.DescribeTasksInput{
 			Cluster: cluster,
 			Tasks:   listRes.TaskArns,
+			Include: []*string{aws.String(ecs.TaskFieldTags)},
 		})
 		if err != nil {
 			return nil, fmt.Errorf("ecs.DescribeTasks failed: %w", err)
@@ -369,6 +370,7 @@ func (f *taskFetcher) getAllServices(ctx context.Context) ([]*ecs.Service, error
 		desc := &ecs.DescribeServicesInput{
 			Cluster:  cluster,
 			Services: servicesToDescribe[i:end],
+			Include:  []*string{aws.String(ecs.ServiceFieldTags)},
 		}
 		res, err := svc.DescribeServicesWithContext(ctx, desc)
 		if err != nil {
@@ -46,6 +46,7 @@ func TestFetcher_FetchAndDecorate(t *testing.T) {
 			task.StartedBy = aws.String("deploy1")
 		}
 		task.TaskDefinitionArn = aws.String("d0:1")
+		task.Tags = []*ecs.Tag{{Key: aws.String("team"), Value: aws.String("web")}}
 	}))
 	// Setting container instance and ec2 is same as previous sub test
 	c.SetContainerInstances(ecsmock.GenContainerInstances("ci", nInstances, func(i int, ci *ecs.ContainerInstance) {
@@ -54,6 +55,7 @@ func TestFetcher_FetchAndDecorate(t *testing.T) {
 	c.SetEc2Instances(ecsmock.GenEc2Instances("i-", nInstances, nil))
 	// Service
 	c.SetServices(ecsmock.GenServices("s", 2, func(i int, s *ecs.Service) {
+		s.Tags = []*ecs.Tag{{Key: aws.String("env"), Value: aws.String("prod")}}
 		if i == 0 {
 			s.LaunchType = aws.String(ecs.LaunchTypeFargate)
 			s.Deployments = []*ecs.Deployment{
@@ -78,6 +80,8 @@ func TestFetcher_FetchAndDecorate(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, nTasks, len(tasks))
 	assert.Equal(t, "s0", aws.StringValue(tasks[0].Service.ServiceArn))
+	assert.Equal(t, map[string]string{"team": "web"}, tasks[0].TaskTags())
+	assert.Equal(t, map[string]string{"env": "prod"}, tasks[0].ServiceTags())
 }
 
 func TestFetcher_GetAllTasks(t *testing.T) {
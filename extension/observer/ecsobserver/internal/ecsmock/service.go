@@ -140,6 +140,12 @@ func (c *Cluster) DescribeTasksWithContext(_ context.Context, input *ecs.Describ
 			})
 			continue
 		}
+		// Like the real API, tags are only returned when explicitly requested.
+		if !includes(input.Include, ecs.TaskFieldTags) {
+			withoutTags := *task
+			withoutTags.Tags = nil
+			task = &withoutTags
+		}
 		tasks = append(tasks, task)
 	}
 	return &ecs.DescribeTasksOutput{Failures: failures, Tasks: tasks}, nil
@@ -256,6 +262,11 @@ func (c *Cluster) DescribeServicesWithContext(_ context.Context, input *ecs.Desc
 			})
 			continue
 		}
+		if !includes(input.Include, ecs.ServiceFieldTags) {
+			withoutTags := *svc
+			withoutTags.Tags = nil
+			svc = &withoutTags
+		}
 		services = append(services, svc)
 	}
 	return &ecs.DescribeServicesOutput{Failures: failures, Services: services}, nil
@@ -429,6 +440,15 @@ func checkCluster(reqClusterName *string, mockClusterName string) error {
 	}
 }
 
+func includes(include []*string, field string) bool {
+	for _, f := range include {
+		if aws.StringValue(f) == field {
+			return true
+		}
+	}
+	return false
+}
+
 // pagination Start
 
 type pageInput struct {
@@ -29,6 +29,7 @@ type serviceDiscovery struct {
 	logger   *zap.Logger
 	cfg      Config
 	fetcher  *taskFetcher
+	selector *taskSelector
 	filter   *taskFilter
 	exporter *taskExporter
 }
@@ -46,12 +47,17 @@ func newDiscovery(cfg Config, opts serviceDiscoveryOptions) (*serviceDiscovery,
 	if err != nil {
 		return nil, fmt.Errorf("init matchers failed: %w", err)
 	}
+	selector, err := newTaskSelector(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("init task selector failed: %w", err)
+	}
 	filter := newTaskFilter(opts.Logger, matchers)
-	exporter := newTaskExporter(opts.Logger, cfg.ClusterName)
+	exporter := newTaskExporter(opts.Logger, cfg.ClusterName, cfg.ExportTags)
 	return &serviceDiscovery{
 		logger:   opts.Logger,
 		cfg:      cfg,
 		fetcher:  opts.Fetcher,
+		selector: selector,
 		filter:   filter,
 		exporter: exporter,
 	}, nil
@@ -101,13 +107,13 @@ func (s *serviceDiscovery) runAndWriteFile(ctx context.Context) error {
 	}
 }
 
-// discover fetch tasks, filter by matching result and export them.
+// discover fetch tasks, select and filter them by matching result and export them.
 func (s *serviceDiscovery) discover(ctx context.Context) ([]prometheusECSTarget, error) {
 	tasks, err := s.fetcher.fetchAndDecorate(ctx)
 	if err != nil {
 		return nil, err
 	}
-	filtered, err := s.filter.filter(tasks)
+	filtered, err := s.filter.filter(s.selector.selectTasks(tasks))
 	if err != nil {
 		return nil, err
 	}
@@ -42,6 +42,7 @@ type prometheusECSTarget struct {
 	Job                    string            `label:"job"`
 	ClusterName            string            `label:"cluster_name"`
 	ServiceName            string            `label:"service_name"`
+	ServiceTags            map[string]string `label:"service_tags"`
 	TaskDefinitionFamily   string            `label:"task_definition_family"`
 	TaskDefinitionRevision int               `label:"task_definition_revision"`
 	TaskStartedBy          string            `label:"task_started_by"`
@@ -67,6 +68,7 @@ const (
 	labelJob                    = "job"
 	labelClusterName            = labelPrefix + "cluster_name"
 	labelServiceName            = labelPrefix + "service_name"
+	labelPrefixServiceTags      = labelPrefix + "service_tags"
 	labelTaskDefinitionFamily   = labelPrefix + "task_definition_family"
 	labelTaskDefinitionRevision = labelPrefix + "task_definition_revision"
 	labelTaskStartedBy          = labelPrefix + "task_started_by"
@@ -111,6 +113,7 @@ func (t *prometheusECSTarget) ToLabels() map[string]string {
 	}
 	trimEmptyValueByKeyPrefix(labels, labelPrefix+"ec2_")
 	addTagsToLabels(t.TaskTags, labelPrefixTaskTags, labels)
+	addTagsToLabels(t.ServiceTags, labelPrefixServiceTags, labels)
 	addTagsToLabels(t.ContainerLabels, labelPrefixContainerLabels, labels)
 	addTagsToLabels(t.EC2Tags, labelPrefixEC2Tags, labels)
 	return labels
@@ -32,4 +32,14 @@ func TestTargetToLabels(t *testing.T) {
 		assert.Equal(t, "sanitized", m["__meta_ecs_task_tags_a_b"])
 		assert.Equal(t, "same", m["__meta_ecs_task_tags_ab"])
 	})
+
+	t.Run("service tags", func(t *testing.T) {
+		pt := prometheusECSTarget{
+			ServiceTags: map[string]string{
+				"team": "web",
+			},
+		}
+		m := pt.ToLabels()
+		assert.Equal(t, "web", m["__meta_ecs_service_tags_team"])
+	})
 }
@@ -56,6 +56,18 @@ func (t *taskAnnotated) TaskTags() map[string]string {
 	return tags
 }
 
+// ServiceTags returns the tags of the service managing the task, if any.
+func (t *taskAnnotated) ServiceTags() map[string]string {
+	if t.Service == nil || len(t.Service.Tags) == 0 {
+		return nil
+	}
+	tags := make(map[string]string, len(t.Service.Tags))
+	for _, tag := range t.Service.Tags {
+		tags[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+	}
+	return tags
+}
+
 // EC2Tags returns ec2 instance tags as it is. Sanitize to prometheus label format is done during export.
 // NOTE: the tag to string conversion is duplicated because the Tag struct is defined in each service's own API package.
 // i.e. services don't import a common package that includes tag definition.
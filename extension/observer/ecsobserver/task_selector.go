@@ -0,0 +1,125 @@
+// Copyright  OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecsobserver // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer/ecsobserver"
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+)
+
+// TagFilterConfig selects tasks based on a task or service tag.
+type TagFilterConfig struct {
+	// Key is mandatory, it is the tag key.
+	Key string `mapstructure:"key" yaml:"key"`
+	// ValuePattern is optional, empty string means the tag only needs to exist.
+	ValuePattern string `mapstructure:"value_pattern" yaml:"value_pattern"`
+}
+
+func (t *TagFilterConfig) validate() error {
+	_, err := t.newTagMatcher()
+	return err
+}
+
+func (t *TagFilterConfig) newTagMatcher() (*tagMatcher, error) {
+	if t.Key == "" {
+		return nil, fmt.Errorf("tag filter key is empty")
+	}
+	m := &tagMatcher{key: t.Key}
+	if t.ValuePattern != "" {
+		valueRegex, err := regexp.Compile(t.ValuePattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid tag value pattern %w", err)
+		}
+		m.valueRegex = valueRegex
+	}
+	return m, nil
+}
+
+type tagMatcher struct {
+	key        string
+	valueRegex *regexp.Regexp
+}
+
+func (m *tagMatcher) match(tags map[string]string) bool {
+	v, ok := tags[m.key]
+	if !ok {
+		return false
+	}
+	return m.valueRegex == nil || m.valueRegex.MatchString(v)
+}
+
+// taskSelector drops tasks before they are matched by the container level matchers.
+// Unlike matchers, which are OR'ed, a task needs to pass all the conditions of the selector.
+type taskSelector struct {
+	capacityProviders map[string]bool
+	tagMatchers       []*tagMatcher
+}
+
+func newTaskSelector(cfg Config) (*taskSelector, error) {
+	s := &taskSelector{}
+	if len(cfg.CapacityProviders) > 0 {
+		s.capacityProviders = make(map[string]bool, len(cfg.CapacityProviders))
+		for _, p := range cfg.CapacityProviders {
+			s.capacityProviders[p] = true
+		}
+	}
+	for _, tf := range cfg.TagFilters {
+		m, err := tf.newTagMatcher()
+		if err != nil {
+			return nil, err
+		}
+		s.tagMatchers = append(s.tagMatchers, m)
+	}
+	return s, nil
+}
+
+// selectTasks returns the tasks passing all the conditions, in their original order.
+func (s *taskSelector) selectTasks(tasks []*taskAnnotated) []*taskAnnotated {
+	if s.capacityProviders == nil && len(s.tagMatchers) == 0 {
+		return tasks
+	}
+	var selected []*taskAnnotated
+	for _, t := range tasks {
+		if s.selectTask(t) {
+			selected = append(selected, t)
+		}
+	}
+	return selected
+}
+
+func (s *taskSelector) selectTask(t *taskAnnotated) bool {
+	if s.capacityProviders != nil && !s.capacityProviders[aws.StringValue(t.Task.CapacityProviderName)] {
+		return false
+	}
+	if len(s.tagMatchers) == 0 {
+		return true
+	}
+	// Task tags take precedence over the tags of the service managing the task.
+	tags := t.ServiceTags()
+	if tags == nil {
+		tags = make(map[string]string)
+	}
+	for k, v := range t.TaskTags() {
+		tags[k] = v
+	}
+	for _, m := range s.tagMatchers {
+		if !m.match(tags) {
+			return false
+		}
+	}
+	return true
+}
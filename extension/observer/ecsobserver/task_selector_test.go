@@ -0,0 +1,98 @@
+// Copyright  OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecsobserver
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTagFilterConfig_Validate(t *testing.T) {
+	assert.Error(t, (&TagFilterConfig{}).validate())
+	assert.Error(t, (&TagFilterConfig{Key: "team", ValuePattern: "*"}).validate())
+	assert.NoError(t, (&TagFilterConfig{Key: "team"}).validate())
+	assert.NoError(t, (&TagFilterConfig{Key: "team", ValuePattern: "^web$"}).validate())
+}
+
+func TestTaskSelector(t *testing.T) {
+	genTasks := func() []*taskAnnotated {
+		return []*taskAnnotated{
+			{
+				Task: &ecs.Task{
+					TaskArn:              aws.String("t0"),
+					CapacityProviderName: aws.String("FARGATE"),
+					Tags:                 []*ecs.Tag{{Key: aws.String("team"), Value: aws.String("web")}},
+				},
+			},
+			{
+				Task: &ecs.Task{
+					TaskArn:              aws.String("t1"),
+					CapacityProviderName: aws.String("FARGATE_SPOT"),
+				},
+				Service: &ecs.Service{
+					Tags: []*ecs.Tag{{Key: aws.String("team"), Value: aws.String("api")}},
+				},
+			},
+			{
+				Task: &ecs.Task{
+					TaskArn: aws.String("t2"),
+					Tags:    []*ecs.Tag{{Key: aws.String("team"), Value: aws.String("db")}},
+				},
+				Service: &ecs.Service{
+					Tags: []*ecs.Tag{{Key: aws.String("team"), Value: aws.String("web")}},
+				},
+			},
+		}
+	}
+	selectedArns := func(t *testing.T, cfg Config) []string {
+		s, err := newTaskSelector(cfg)
+		require.NoError(t, err)
+		var arns []string
+		for _, task := range s.selectTasks(genTasks()) {
+			arns = append(arns, aws.StringValue(task.Task.TaskArn))
+		}
+		return arns
+	}
+
+	t.Run("no condition", func(t *testing.T) {
+		assert.Equal(t, []string{"t0", "t1", "t2"}, selectedArns(t, Config{}))
+	})
+
+	t.Run("capacity provider", func(t *testing.T) {
+		assert.Equal(t, []string{"t1"}, selectedArns(t, Config{CapacityProviders: []string{"FARGATE_SPOT"}}))
+	})
+
+	t.Run("tag exists", func(t *testing.T) {
+		assert.Equal(t, []string{"t0", "t1", "t2"}, selectedArns(t, Config{TagFilters: []TagFilterConfig{{Key: "team"}}}))
+		assert.Nil(t, selectedArns(t, Config{TagFilters: []TagFilterConfig{{Key: "env"}}}))
+	})
+
+	t.Run("task tag overrides service tag", func(t *testing.T) {
+		cfg := Config{TagFilters: []TagFilterConfig{{Key: "team", ValuePattern: "^(web|api)$"}}}
+		assert.Equal(t, []string{"t0", "t1"}, selectedArns(t, cfg))
+	})
+
+	t.Run("all conditions", func(t *testing.T) {
+		cfg := Config{
+			CapacityProviders: []string{"FARGATE", "FARGATE_SPOT"},
+			TagFilters:        []TagFilterConfig{{Key: "team", ValuePattern: "^web$"}},
+		}
+		assert.Equal(t, []string{"t0"}, selectedArns(t, cfg))
+	})
+}
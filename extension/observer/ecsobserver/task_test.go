@@ -51,6 +51,20 @@ func TestTask_Tags(t *testing.T) {
 		assert.Equal(t, map[string]string{"k": "v"}, task.TaskTags())
 	})
 
+	t.Run("service", func(t *testing.T) {
+		task := taskAnnotated{Task: &ecs.Task{}}
+		assert.Equal(t, map[string]string(nil), task.ServiceTags())
+		task.Service = &ecs.Service{
+			Tags: []*ecs.Tag{
+				{
+					Key:   aws.String("k"),
+					Value: aws.String("v"),
+				},
+			},
+		}
+		assert.Equal(t, map[string]string{"k": "v"}, task.ServiceTags())
+	})
+
 	t.Run("container", func(t *testing.T) {
 		task := taskAnnotated{Definition: &ecs.TaskDefinition{ContainerDefinitions: []*ecs.ContainerDefinition{{}}}}
 		assert.Equal(t, map[string]string(nil), task.ContainerLabels(0))
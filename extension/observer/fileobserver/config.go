@@ -0,0 +1,62 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileobserver // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer/fileobserver"
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"time"
+)
+
+const defaultRefreshInterval = 10 * time.Second
+
+// defaultInclude selects the descriptor files when no include patterns are configured.
+var defaultInclude = []string{"*.yaml", "*.yml", "*.json"}
+
+// Config defines configuration for the file observer.
+type Config struct {
+	// Directory is the directory containing the endpoint descriptor files.
+	Directory string `mapstructure:"directory"`
+
+	// Include is a list of glob patterns, matched against file names in Directory,
+	// selecting the descriptor files to read. If empty, YAML and JSON files are read.
+	Include []string `mapstructure:"include"`
+
+	// RefreshInterval determines how often the directory is read for
+	// added, changed or removed endpoint descriptors.
+	RefreshInterval time.Duration `mapstructure:"refresh_interval"`
+}
+
+func (c Config) Validate() error {
+	if c.Directory == "" {
+		return errors.New("directory must be specified")
+	}
+	if c.RefreshInterval <= 0 {
+		return errors.New("refresh_interval must be positive")
+	}
+	for _, pattern := range c.Include {
+		if _, err := filepath.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid include pattern %q: %w", pattern, err)
+		}
+	}
+	return nil
+}
+
+func defaultConfig() Config {
+	return Config{
+		RefreshInterval: defaultRefreshInterval,
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileobserver
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+)
+
+func TestLoadConfig(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		id          component.ID
+		expected    component.Config
+		expectedErr string
+	}{
+		{
+			id: component.NewID(typeStr),
+			expected: &Config{
+				Directory:       "/var/run/discovery",
+				RefreshInterval: 10 * time.Second,
+			},
+		},
+		{
+			id: component.NewIDWithName(typeStr, "all-settings"),
+			expected: &Config{
+				Directory:       "/var/run/discovery",
+				Include:         []string{"*.endpoints.yaml"},
+				RefreshInterval: time.Minute,
+			},
+		},
+		{
+			id:          component.NewIDWithName(typeStr, "missing-directory"),
+			expectedErr: "directory must be specified",
+		},
+		{
+			id:          component.NewIDWithName(typeStr, "invalid-include"),
+			expectedErr: `invalid include pattern "[*.yaml": syntax error in pattern`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+			require.NoError(t, err)
+			factory := NewFactory()
+			cfg := factory.CreateDefaultConfig()
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, component.UnmarshalConfig(sub, cfg))
+			if tt.expectedErr != "" {
+				assert.EqualError(t, component.ValidateConfig(cfg), tt.expectedErr)
+				return
+			}
+			assert.NoError(t, component.ValidateConfig(cfg))
+			assert.Equal(t, tt.expected, cfg)
+		})
+	}
+}
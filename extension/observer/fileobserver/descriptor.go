@@ -0,0 +1,148 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileobserver // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer/fileobserver"
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer"
+)
+
+// endpointDescriptor is a single endpoint as written by an external discovery tool.
+// Descriptor files contain a list of them, either in YAML or JSON.
+type endpointDescriptor struct {
+	ID     string `yaml:"id"`
+	Target string `yaml:"target"`
+	// Type is the observer endpoint type, either "hostport" (default) or "container".
+	Type      string `yaml:"type"`
+	Port      uint16 `yaml:"port"`
+	Transport string `yaml:"transport"`
+	Command   string `yaml:"command"`
+
+	// hostport only
+	ProcessName string `yaml:"process_name"`
+
+	// container only
+	Name        string            `yaml:"name"`
+	Image       string            `yaml:"image"`
+	Tag         string            `yaml:"tag"`
+	ContainerID string            `yaml:"container_id"`
+	Host        string            `yaml:"host"`
+	Labels      map[string]string `yaml:"labels"`
+}
+
+// readEndpoints parses the descriptor file at path. JSON being a subset of YAML,
+// both formats are handled by the YAML decoder.
+func readEndpoints(path string) ([]observer.Endpoint, error) {
+	content, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, err
+	}
+
+	var descriptors []endpointDescriptor
+	if err = yaml.Unmarshal(content, &descriptors); err != nil {
+		return nil, err
+	}
+
+	endpoints := make([]observer.Endpoint, 0, len(descriptors))
+	for i, d := range descriptors {
+		endpoint, err := d.toEndpoint(filepath.Base(path))
+		if err != nil {
+			return nil, fmt.Errorf("invalid endpoint at index %d: %w", i, err)
+		}
+		endpoints = append(endpoints, endpoint)
+	}
+	return endpoints, nil
+}
+
+// toEndpoint converts the descriptor to an observer.Endpoint whose ID is prefixed
+// with the file name so descriptors of different files cannot collide.
+func (d endpointDescriptor) toEndpoint(fileName string) (observer.Endpoint, error) {
+	if d.Target == "" {
+		return observer.Endpoint{}, errors.New("target must be specified")
+	}
+
+	id := d.ID
+	if id == "" {
+		id = d.Target
+	}
+
+	port := d.Port
+	host := d.Host
+	if h, p, err := net.SplitHostPort(d.Target); err == nil {
+		if host == "" {
+			host = h
+		}
+		if port == 0 {
+			parsed, err := strconv.ParseUint(p, 10, 16)
+			if err != nil {
+				return observer.Endpoint{}, fmt.Errorf("invalid port in target %q: %w", d.Target, err)
+			}
+			port = uint16(parsed)
+		}
+	} else if host == "" {
+		host = d.Target
+	}
+
+	transport := observer.Transport(d.Transport)
+	if transport == "" {
+		transport = observer.ProtocolTCP
+	}
+
+	var details observer.EndpointDetails
+	switch observer.EndpointType(d.Type) {
+	case "", observer.HostPortType:
+		details = &observer.HostPort{
+			ProcessName: d.ProcessName,
+			Command:     d.Command,
+			Port:        port,
+			Transport:   transport,
+			IsIPv6:      isIPv6(host),
+		}
+	case observer.ContainerType:
+		details = &observer.Container{
+			Name:          d.Name,
+			Image:         d.Image,
+			Tag:           d.Tag,
+			Port:          port,
+			AlternatePort: port,
+			Command:       d.Command,
+			ContainerID:   d.ContainerID,
+			Host:          host,
+			Transport:     transport,
+			Labels:        d.Labels,
+		}
+	default:
+		return observer.Endpoint{}, fmt.Errorf("unsupported endpoint type %q", d.Type)
+	}
+
+	return observer.Endpoint{
+		ID:      observer.EndpointID(fileName + "/" + id),
+		Target:  d.Target,
+		Details: details,
+	}, nil
+}
+
+func isIPv6(host string) bool {
+	ip := net.ParseIP(host)
+	return ip != nil && ip.To4() == nil
+}
@@ -0,0 +1,131 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileobserver // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer/fileobserver"
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"go.opentelemetry.io/collector/extension"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer"
+)
+
+var _ extension.Extension = (*fileObserver)(nil)
+var _ observer.EndpointsLister = (*fileObserver)(nil)
+var _ observer.Observable = (*fileObserver)(nil)
+
+type fileObserver struct {
+	extension.Extension
+	*observer.EndpointsWatcher
+	config *Config
+	logger *zap.Logger
+
+	// lastEndpoints are the endpoints of every descriptor file at the last successful read of the
+	// directory. They are reported again when the directory or a file can't be read.
+	lastEndpoints map[string][]observer.Endpoint
+}
+
+func (e *fileObserver) Shutdown(ctx context.Context) error {
+	e.StopListAndWatch()
+	return nil
+}
+
+// ListEndpoints is invoked by an observer.EndpointsWatcher helper to report the endpoints
+// described by the files of the configured directory. It's required to implement observer.EndpointsLister
+func (e *fileObserver) ListEndpoints() []observer.Endpoint {
+	files, err := e.descriptorFiles()
+	if err != nil {
+		e.logger.Warn("error listing endpoint descriptor files, keeping the last known endpoints",
+			zap.String("directory", e.config.Directory), zap.Error(err))
+		files = make([]string, 0, len(e.lastEndpoints))
+		for file := range e.lastEndpoints {
+			files = append(files, file)
+		}
+		sort.Strings(files)
+		return endpointsOf(files, e.lastEndpoints)
+	}
+
+	current := make(map[string][]observer.Endpoint, len(files))
+	for _, file := range files {
+		fileEndpoints, err := readEndpoints(file)
+		if err != nil {
+			e.logger.Warn("error reading endpoint descriptor file, keeping its last known endpoints",
+				zap.String("file", file), zap.Error(err))
+			fileEndpoints = e.lastEndpoints[file]
+		}
+		current[file] = fileEndpoints
+	}
+	e.lastEndpoints = current
+	return endpointsOf(files, current)
+}
+
+func endpointsOf(files []string, byFile map[string][]observer.Endpoint) []observer.Endpoint {
+	var endpoints []observer.Endpoint
+	for _, file := range files {
+		endpoints = append(endpoints, byFile[file]...)
+	}
+	return endpoints
+}
+
+// descriptorFiles returns the sorted paths of the regular files of the directory
+// matching any of the include patterns. Symbolic links are followed, as Kubernetes
+// ConfigMap and Secret volumes present their files as links.
+func (e *fileObserver) descriptorFiles() ([]string, error) {
+	entries, err := os.ReadDir(e.config.Directory)
+	if err != nil {
+		return nil, err
+	}
+
+	include := e.config.Include
+	if len(include) == 0 {
+		include = defaultInclude
+	}
+
+	var files []string
+	for _, entry := range entries {
+		if !matchesAny(include, entry.Name()) {
+			continue
+		}
+		path := filepath.Join(e.config.Directory, entry.Name())
+		if entry.Type()&os.ModeSymlink != 0 {
+			info, err := os.Stat(path)
+			if err != nil {
+				e.logger.Warn("error following endpoint descriptor file link", zap.String("file", path), zap.Error(err))
+				continue
+			}
+			if !info.Mode().IsRegular() {
+				continue
+			}
+		} else if !entry.Type().IsRegular() {
+			continue
+		}
+		files = append(files, path)
+	}
+	sort.Strings(files)
+	return files, nil
+}
+
+func matchesAny(patterns []string, name string) bool {
+	for _, pattern := range patterns {
+		if matched, _ := filepath.Match(pattern, name); matched {
+			return true
+		}
+	}
+	return false
+}
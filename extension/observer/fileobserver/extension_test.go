@@ -0,0 +1,142 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileobserver
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer"
+)
+
+func newTestObserver(dir string) *fileObserver {
+	cfg := defaultConfig()
+	cfg.Directory = dir
+	return &fileObserver{config: &cfg, logger: zap.NewNop()}
+}
+
+func TestListEndpoints(t *testing.T) {
+	endpoints := newTestObserver(filepath.Join("testdata", "endpoints")).ListEndpoints()
+
+	// invalid.yml is skipped as a whole and ignored.txt doesn't match the include patterns.
+	assert.Equal(t, []observer.Endpoint{
+		{
+			ID:     "dns.json/[::1]:53",
+			Target: "[::1]:53",
+			Details: &observer.HostPort{
+				Port:      53,
+				Transport: observer.ProtocolUDP,
+				IsIPv6:    true,
+			},
+		},
+		{
+			ID:     "redis.yaml/redis-1",
+			Target: "10.0.0.5:6379",
+			Details: &observer.HostPort{
+				ProcessName: "redis-server",
+				Command:     "redis-server /etc/redis.conf",
+				Port:        6379,
+				Transport:   observer.ProtocolTCP,
+			},
+		},
+		{
+			ID:     "redis.yaml/nginx",
+			Target: "10.0.0.6:80",
+			Details: &observer.Container{
+				Name:          "nginx",
+				Image:         "nginx",
+				Tag:           "1.23",
+				Port:          80,
+				AlternatePort: 80,
+				ContainerID:   "abc123",
+				Host:          "10.0.0.6",
+				Transport:     observer.ProtocolTCP,
+				Labels:        map[string]string{"team": "web"},
+			},
+		},
+	}, endpoints)
+}
+
+func TestListEndpointsMissingDirectory(t *testing.T) {
+	assert.Empty(t, newTestObserver(filepath.Join("testdata", "does-not-exist")).ListEndpoints())
+}
+
+func TestListEndpointsReflectsDirectoryChanges(t *testing.T) {
+	dir := t.TempDir()
+	obs := newTestObserver(dir)
+	assert.Empty(t, obs.ListEndpoints())
+
+	path := filepath.Join(dir, "app.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("- target: localhost:8080\n"), 0600))
+	endpoints := obs.ListEndpoints()
+	require.Len(t, endpoints, 1)
+	assert.Equal(t, observer.EndpointID("app.yaml/localhost:8080"), endpoints[0].ID)
+
+	require.NoError(t, os.Remove(path))
+	assert.Empty(t, obs.ListEndpoints())
+}
+
+func TestListEndpointsFollowsSymlinks(t *testing.T) {
+	// Kubernetes ConfigMap volumes link the files to a hidden data directory.
+	dir := t.TempDir()
+	dataDir := filepath.Join(dir, "..data")
+	require.NoError(t, os.Mkdir(dataDir, 0700))
+	require.NoError(t, os.WriteFile(filepath.Join(dataDir, "app.yaml"), []byte("- target: localhost:8080\n"), 0600))
+	require.NoError(t, os.Symlink(filepath.Join("..data", "app.yaml"), filepath.Join(dir, "app.yaml")))
+	require.NoError(t, os.Symlink("does-not-exist.yaml", filepath.Join(dir, "broken.yaml")))
+
+	endpoints := newTestObserver(dir).ListEndpoints()
+	require.Len(t, endpoints, 1)
+	assert.Equal(t, observer.EndpointID("app.yaml/localhost:8080"), endpoints[0].ID)
+}
+
+func TestListEndpointsKeepsLastKnownEndpoints(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "discovery")
+	require.NoError(t, os.Mkdir(dir, 0700))
+	obs := newTestObserver(dir)
+
+	path := filepath.Join(dir, "app.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("- target: localhost:8080\n"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "db.yaml"), []byte("- target: localhost:5432\n"), 0600))
+	require.Len(t, obs.ListEndpoints(), 2)
+
+	// A file that can't be read keeps its endpoints, the others are updated.
+	require.NoError(t, os.WriteFile(path, []byte("- target: [\n"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "db.yaml"), []byte("- target: localhost:5433\n"), 0600))
+	endpoints := obs.ListEndpoints()
+	require.Len(t, endpoints, 2)
+	assert.Equal(t, observer.EndpointID("app.yaml/localhost:8080"), endpoints[0].ID)
+	assert.Equal(t, observer.EndpointID("db.yaml/localhost:5433"), endpoints[1].ID)
+
+	// The directory can't be read.
+	require.NoError(t, os.RemoveAll(dir))
+	assert.Equal(t, endpoints, obs.ListEndpoints())
+}
+
+func TestDescriptorToEndpointErrors(t *testing.T) {
+	_, err := endpointDescriptor{}.toEndpoint("file.yaml")
+	assert.EqualError(t, err, "target must be specified")
+
+	_, err = endpointDescriptor{Target: "localhost:http"}.toEndpoint("file.yaml")
+	assert.ErrorContains(t, err, `invalid port in target "localhost:http"`)
+
+	_, err = endpointDescriptor{Target: "localhost", Type: "pod"}.toEndpoint("file.yaml")
+	assert.EqualError(t, err, `unsupported endpoint type "pod"`)
+}
@@ -0,0 +1,67 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileobserver // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer/fileobserver"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/extension"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer"
+)
+
+const (
+	typeStr component.Type = "file_observer"
+)
+
+// NewFactory creates a factory for the file observer extension.
+func NewFactory() extension.Factory {
+	return extension.NewFactory(
+		typeStr,
+		createDefaultConfig,
+		createExtension,
+		component.StabilityLevelAlpha,
+	)
+}
+
+func createDefaultConfig() component.Config {
+	cfg := defaultConfig()
+	return &cfg
+}
+
+type baseExtension struct {
+	component.StartFunc
+	component.ShutdownFunc
+}
+
+func createExtension(
+	_ context.Context,
+	params extension.CreateSettings,
+	cfg component.Config,
+) (extension.Extension, error) {
+	obsCfg := cfg.(*Config)
+
+	e := &fileObserver{
+		config: obsCfg,
+		logger: params.TelemetrySettings.Logger,
+	}
+	e.Extension = baseExtension{
+		ShutdownFunc: e.Shutdown,
+	}
+	e.EndpointsWatcher = observer.NewEndpointsWatcher(e, obsCfg.RefreshInterval, params.TelemetrySettings.Logger)
+
+	return e, nil
+}
@@ -0,0 +1,77 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ratelimiterextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/ratelimiterextension"
+
+import (
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/collector/component"
+)
+
+// Limit is the budget of a rate limiting key.
+type Limit struct {
+	// Rate is the number of items per second added to the budget.
+	Rate float64 `mapstructure:"rate"`
+
+	// Burst is the maximum number of items accepted at once.
+	Burst int `mapstructure:"burst"`
+}
+
+// Config defines the configuration for the rate limiter extension.
+type Config struct {
+	// Limit is the budget applied to every key without an override.
+	Limit `mapstructure:",squash"`
+
+	// MetadataKey is the client metadata key holding the rate limiting key, e.g. a tenant header.
+	// The receivers must be configured with include_metadata for the metadata to be available.
+	MetadataKey string `mapstructure:"metadata_key"`
+
+	// Attribute is the resource attribute holding the rate limiting key. It is used when
+	// MetadataKey isn't set or isn't present in the client metadata.
+	Attribute string `mapstructure:"attribute"`
+
+	// ClientAddress keys the requests by the IP address of the client when neither the
+	// metadata nor the attribute key is found.
+	ClientAddress bool `mapstructure:"client_address"`
+
+	// Overrides are the budgets of specific keys.
+	Overrides map[string]Limit `mapstructure:"overrides"`
+}
+
+var _ component.Config = (*Config)(nil)
+
+// Validate checks if the extension configuration is valid.
+func (cfg *Config) Validate() error {
+	if err := cfg.Limit.validate(); err != nil {
+		return err
+	}
+	for key, limit := range cfg.Overrides {
+		if err := limit.validate(); err != nil {
+			return fmt.Errorf("overrides %q: %w", key, err)
+		}
+	}
+	return nil
+}
+
+func (l Limit) validate() error {
+	if l.Rate <= 0 {
+		return errors.New("rate must be positive")
+	}
+	if l.Burst <= 0 {
+		return errors.New("burst must be positive")
+	}
+	return nil
+}
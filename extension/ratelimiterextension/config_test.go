@@ -0,0 +1,95 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ratelimiterextension
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+)
+
+func TestLoadConfig(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		id       component.ID
+		expected component.Config
+	}{
+		{
+			id:       component.NewID(typeStr),
+			expected: NewFactory().CreateDefaultConfig(),
+		},
+		{
+			id: component.NewIDWithName(typeStr, "tenant"),
+			expected: &Config{
+				Limit:       Limit{Rate: 100, Burst: 200},
+				MetadataKey: "X-Tenant",
+				Attribute:   "tenant.id",
+				Overrides: map[string]Limit{
+					"premium": {Rate: 1000, Burst: 2000},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+			require.NoError(t, err)
+			factory := NewFactory()
+			cfg := factory.CreateDefaultConfig()
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, component.UnmarshalConfig(sub, cfg))
+			assert.NoError(t, component.ValidateConfig(cfg))
+			assert.Equal(t, tt.expected, cfg)
+		})
+	}
+}
+
+func TestValidateConfig(t *testing.T) {
+	tests := []struct {
+		name        string
+		config      *Config
+		expectedErr string
+	}{
+		{
+			name:        "zero rate",
+			config:      &Config{Limit: Limit{Burst: 1}},
+			expectedErr: "rate must be positive",
+		},
+		{
+			name:        "zero burst",
+			config:      &Config{Limit: Limit{Rate: 1}},
+			expectedErr: "burst must be positive",
+		},
+		{
+			name: "invalid override",
+			config: &Config{
+				Limit:     Limit{Rate: 1, Burst: 1},
+				Overrides: map[string]Limit{"premium": {Rate: 1}},
+			},
+			expectedErr: `overrides "premium": burst must be positive`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.EqualError(t, tt.config.Validate(), tt.expectedErr)
+		})
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ratelimiterextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/ratelimiterextension"
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/extension"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// RateLimiter is implemented by the rate limiter extension. Receivers call
+// Limit for every request before passing its data to the pipeline.
+type RateLimiter interface {
+	extension.Extension
+
+	// Limit takes n items from the budget of the rate limiting key of the request.
+	// The key is read from the client metadata of ctx, then from attrs, which are
+	// typically the resource attributes of the request. A *RateLimitedError is
+	// returned when the budget is exceeded.
+	Limit(ctx context.Context, attrs pcommon.Map, n int) error
+}
+
+// GetRateLimiter returns the rate limiter extension with the given ID.
+func GetRateLimiter(host component.Host, id component.ID) (RateLimiter, error) {
+	ext, ok := host.GetExtensions()[id]
+	if !ok {
+		return nil, fmt.Errorf("rate limiter extension '%s' not found", id)
+	}
+	limiter, ok := ext.(RateLimiter)
+	if !ok {
+		return nil, fmt.Errorf("non-rate-limiter extension '%s' found", id)
+	}
+	return limiter, nil
+}
+
+// RateLimitedError is returned when a request exceeds the budget of its key.
+type RateLimitedError struct {
+	// Key is the rate limiting key of the request.
+	Key string
+	// RetryAfter is the duration after which the request would be accepted.
+	RetryAfter time.Duration
+}
+
+func (e *RateLimitedError) Error() string {
+	return fmt.Sprintf("rate limit exceeded for key %q, retry after %s", e.Key, e.RetryAfter)
+}
+
+// GRPCStatus returns a ResourceExhausted status carrying the retry delay, so
+// that gRPC servers return it to the clients.
+func (e *RateLimitedError) GRPCStatus() *status.Status {
+	st := status.New(codes.ResourceExhausted, e.Error())
+	if detailed, err := st.WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(e.RetryAfter)}); err == nil {
+		return detailed
+	}
+	return st
+}
+
+// WriteHTTP writes a 429 Too Many Requests response with a Retry-After header.
+func (e *RateLimitedError) WriteHTTP(w http.ResponseWriter) {
+	seconds := int(math.Ceil(e.RetryAfter.Seconds()))
+	if seconds < 1 {
+		seconds = 1
+	}
+	w.Header().Set("Retry-After", strconv.Itoa(seconds))
+	http.Error(w, e.Error(), http.StatusTooManyRequests)
+}
@@ -0,0 +1,174 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ratelimiterextension
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/client"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type testHost struct {
+	component.Host
+	extensions map[component.ID]component.Component
+}
+
+func (h *testHost) GetExtensions() map[component.ID]component.Component {
+	return h.extensions
+}
+
+func withTenant(tenant string) context.Context {
+	return client.NewContext(context.Background(), client.Info{
+		Metadata: client.NewMetadata(map[string][]string{"X-Tenant": {tenant}}),
+	})
+}
+
+func TestLimit(t *testing.T) {
+	cfg := &Config{
+		Limit:       Limit{Rate: 10, Burst: 10},
+		MetadataKey: "X-Tenant",
+		Attribute:   "tenant.id",
+		Overrides: map[string]Limit{
+			"premium": {Rate: 100, Burst: 100},
+		},
+	}
+	now := time.Unix(1000, 0)
+	limiter := newRateLimiter(cfg)
+	limiter.now = func() time.Time { return now }
+
+	attrs := pcommon.NewMap()
+
+	require.NoError(t, limiter.Limit(withTenant("a"), attrs, 10))
+	err := limiter.Limit(withTenant("a"), attrs, 5)
+	var limited *RateLimitedError
+	require.ErrorAs(t, err, &limited)
+	assert.Equal(t, "a", limited.Key)
+	assert.Equal(t, 500*time.Millisecond, limited.RetryAfter)
+
+	// The budget of another key is independent.
+	require.NoError(t, limiter.Limit(withTenant("b"), attrs, 10))
+	require.NoError(t, limiter.Limit(withTenant("premium"), attrs, 100))
+
+	// The attribute is used when the metadata is missing.
+	attrs.PutStr("tenant.id", "a")
+	assert.Error(t, limiter.Limit(context.Background(), attrs, 1))
+
+	// The rejected request didn't consume the budget.
+	now = now.Add(500 * time.Millisecond)
+	assert.NoError(t, limiter.Limit(context.Background(), attrs, 5))
+
+	// A request larger than the burst is never accepted.
+	err = limiter.Limit(withTenant("c"), attrs, 11)
+	require.ErrorAs(t, err, &limited)
+	assert.Equal(t, maxRetryAfter, limited.RetryAfter)
+}
+
+func TestLimitByClientAddress(t *testing.T) {
+	cfg := &Config{
+		Limit:         Limit{Rate: 1, Burst: 1},
+		MetadataKey:   "X-Tenant",
+		ClientAddress: true,
+	}
+	limiter := newRateLimiter(cfg)
+
+	fromAddr := func(addr net.Addr) context.Context {
+		return client.NewContext(context.Background(), client.Info{Addr: addr})
+	}
+	attrs := pcommon.NewMap()
+
+	require.NoError(t, limiter.Limit(fromAddr(&net.IPAddr{IP: net.IPv4(10, 0, 0, 1)}), attrs, 1))
+	err := limiter.Limit(fromAddr(&net.TCPAddr{IP: net.IPv4(10, 0, 0, 1), Port: 1234}), attrs, 1)
+	var limited *RateLimitedError
+	require.ErrorAs(t, err, &limited)
+	assert.Equal(t, "10.0.0.1", limited.Key)
+
+	require.NoError(t, limiter.Limit(fromAddr(&net.IPAddr{IP: net.IPv6loopback}), attrs, 1))
+	assert.Contains(t, limiter.limiters, "::1")
+
+	// The metadata key takes precedence over the client address.
+	require.NoError(t, limiter.Limit(withTenant("a"), attrs, 1))
+}
+
+func TestIdleKeysExpire(t *testing.T) {
+	cfg := &Config{
+		Limit:       Limit{Rate: 1, Burst: 120},
+		MetadataKey: "X-Tenant",
+	}
+	now := time.Unix(1000, 0)
+	limiter := newRateLimiter(cfg)
+	limiter.now = func() time.Time { return now }
+
+	attrs := pcommon.NewMap()
+	require.NoError(t, limiter.Limit(withTenant("a"), attrs, 120))
+	require.NoError(t, limiter.Limit(withTenant("b"), attrs, 1))
+
+	// The budget of a is refilled after 2 minutes, it must be kept until then.
+	now = now.Add(time.Minute)
+	require.NoError(t, limiter.Limit(withTenant("c"), attrs, 1))
+	assert.Len(t, limiter.limiters, 3)
+	assert.Error(t, limiter.Limit(withTenant("a"), attrs, 120))
+
+	now = now.Add(3 * time.Minute)
+	require.NoError(t, limiter.Limit(withTenant("c"), attrs, 1))
+	assert.Len(t, limiter.limiters, 1)
+	assert.Contains(t, limiter.limiters, "c")
+}
+
+func TestRateLimitedError(t *testing.T) {
+	err := &RateLimitedError{Key: "a", RetryAfter: 1500 * time.Millisecond}
+
+	rec := httptest.NewRecorder()
+	err.WriteHTTP(rec)
+	assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+	assert.Equal(t, "2", rec.Header().Get("Retry-After"))
+
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.ResourceExhausted, st.Code())
+	require.Len(t, st.Details(), 1)
+	info, ok := st.Details()[0].(*errdetails.RetryInfo)
+	require.True(t, ok)
+	assert.Equal(t, 1500*time.Millisecond, info.RetryDelay.AsDuration())
+}
+
+func TestGetRateLimiter(t *testing.T) {
+	id := component.NewID(typeStr)
+	host := &testHost{extensions: map[component.ID]component.Component{
+		id:                       newRateLimiter(createDefaultConfig().(*Config)),
+		component.NewID("other"): nil,
+	}}
+
+	limiter, err := GetRateLimiter(host, id)
+	require.NoError(t, err)
+	assert.NotNil(t, limiter)
+
+	_, err = GetRateLimiter(host, component.NewIDWithName(typeStr, "missing"))
+	assert.EqualError(t, err, "rate limiter extension 'rate_limiter/missing' not found")
+
+	_, err = GetRateLimiter(host, component.NewID("other"))
+	assert.EqualError(t, err, "non-rate-limiter extension 'other' found")
+}
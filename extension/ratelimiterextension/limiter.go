@@ -0,0 +1,156 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ratelimiterextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/ratelimiterextension"
+
+import (
+	"context"
+	"net"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/client"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"golang.org/x/time/rate"
+)
+
+// maxRetryAfter is returned when a request can never fit in the burst of its key.
+const maxRetryAfter = time.Minute
+
+// minIdleAge is the minimum time a key is kept after its last request, and the interval
+// between the removals of the idle keys.
+const minIdleAge = time.Minute
+
+type rateLimiter struct {
+	config *Config
+
+	// now is replaced in tests.
+	now func() time.Time
+
+	mu          sync.Mutex
+	limiters    map[string]*keyLimiter
+	lastCleanup time.Time
+}
+
+// keyLimiter is the budget of a rate limiting key.
+type keyLimiter struct {
+	*rate.Limiter
+	lastUsed time.Time
+	// idleAfter is the time after which the budget is full again, so the key can be
+	// forgotten without granting it more than its burst.
+	idleAfter time.Duration
+}
+
+var _ RateLimiter = (*rateLimiter)(nil)
+
+func newRateLimiter(cfg *Config) *rateLimiter {
+	return &rateLimiter{
+		config:   cfg,
+		now:      time.Now,
+		limiters: map[string]*keyLimiter{},
+	}
+}
+
+func (r *rateLimiter) Start(context.Context, component.Host) error {
+	return nil
+}
+
+func (r *rateLimiter) Shutdown(context.Context) error {
+	return nil
+}
+
+func (r *rateLimiter) Limit(ctx context.Context, attrs pcommon.Map, n int) error {
+	key := r.key(ctx, attrs)
+	now := r.now()
+	limiter := r.limiter(key, now)
+
+	reservation := limiter.ReserveN(now, n)
+	if !reservation.OK() {
+		return &RateLimitedError{Key: key, RetryAfter: maxRetryAfter}
+	}
+	if delay := reservation.DelayFrom(now); delay > 0 {
+		reservation.CancelAt(now)
+		return &RateLimitedError{Key: key, RetryAfter: delay}
+	}
+	return nil
+}
+
+// key returns the rate limiting key of the request, empty if none is found.
+func (r *rateLimiter) key(ctx context.Context, attrs pcommon.Map) string {
+	if r.config.MetadataKey != "" {
+		if values := client.FromContext(ctx).Metadata.Get(r.config.MetadataKey); len(values) > 0 {
+			return values[0]
+		}
+	}
+	if r.config.Attribute != "" {
+		if v, ok := attrs.Get(r.config.Attribute); ok {
+			return v.AsString()
+		}
+	}
+	if r.config.ClientAddress {
+		if addr := client.FromContext(ctx).Addr; addr != nil {
+			return clientIP(addr)
+		}
+	}
+	return ""
+}
+
+func clientIP(addr net.Addr) string {
+	host, _, err := net.SplitHostPort(addr.String())
+	if err != nil {
+		return addr.String()
+	}
+	return host
+}
+
+func (r *rateLimiter) limiter(key string, now time.Time) *rate.Limiter {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.cleanupLocked(now)
+
+	limiter, ok := r.limiters[key]
+	if !ok {
+		limit := r.config.Limit
+		if override, found := r.config.Overrides[key]; found {
+			limit = override
+		}
+		idleAfter := minIdleAge
+		if refill := time.Duration(float64(limit.Burst) / limit.Rate * float64(time.Second)); refill > idleAfter {
+			idleAfter = refill
+		}
+		limiter = &keyLimiter{
+			Limiter:   rate.NewLimiter(rate.Limit(limit.Rate), limit.Burst),
+			idleAfter: idleAfter,
+		}
+		r.limiters[key] = limiter
+	}
+	limiter.lastUsed = now
+	return limiter.Limiter
+}
+
+// cleanupLocked removes the keys without requests since their budget was refilled.
+func (r *rateLimiter) cleanupLocked(now time.Time) {
+	if now.Sub(r.lastCleanup) < minIdleAge {
+		return
+	}
+	r.lastCleanup = now
+
+	for key, limiter := range r.limiters {
+		if now.Sub(limiter.lastUsed) >= limiter.idleAfter {
+			delete(r.limiters, key)
+		}
+	}
+}
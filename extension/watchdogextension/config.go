@@ -0,0 +1,73 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package watchdogextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/watchdogextension"
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/confighttp"
+)
+
+// Config defines the configuration for the watchdog extension.
+type Config struct {
+	confighttp.HTTPServerSettings `mapstructure:",squash"`
+
+	// Path is the path of the readiness endpoint. The default path is "/".
+	Path string `mapstructure:"path"`
+
+	// CheckInterval is the interval at which the exporters are checked.
+	CheckInterval time.Duration `mapstructure:"check_interval"`
+
+	// StallTimeout is the time after which an exporter with queued items that
+	// completed no send attempt is considered stalled.
+	StallTimeout time.Duration `mapstructure:"stall_timeout"`
+
+	// Exporters are the IDs of the exporters to watch, e.g. otlp/backend.
+	// All the exporters are watched if empty.
+	Exporters []string `mapstructure:"exporters"`
+
+	// RestartOnStall reports a fatal error to the collector when an exporter stalls,
+	// so that the collector shuts down and is restarted by its supervisor.
+	RestartOnStall bool `mapstructure:"restart_on_stall"`
+}
+
+var _ component.Config = (*Config)(nil)
+
+var (
+	errNoEndpointProvided   = errors.New("bad config: endpoint must be specified")
+	errInvalidPath          = errors.New("bad config: path must start with /")
+	errInvalidCheckInterval = errors.New("bad config: check_interval must be positive")
+	errInvalidStallTimeout  = errors.New("bad config: stall_timeout must not be shorter than check_interval")
+)
+
+// Validate checks if the extension configuration is valid.
+func (cfg *Config) Validate() error {
+	if cfg.Endpoint == "" {
+		return errNoEndpointProvided
+	}
+	if !strings.HasPrefix(cfg.Path, "/") {
+		return errInvalidPath
+	}
+	if cfg.CheckInterval <= 0 {
+		return errInvalidCheckInterval
+	}
+	if cfg.StallTimeout < cfg.CheckInterval {
+		return errInvalidStallTimeout
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package watchdogextension
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+)
+
+func TestLoadConfig(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		id          component.ID
+		expected    component.Config
+		expectedErr error
+	}{
+		{
+			id:       component.NewID(typeStr),
+			expected: NewFactory().CreateDefaultConfig(),
+		},
+		{
+			id: component.NewIDWithName(typeStr, "1"),
+			expected: &Config{
+				HTTPServerSettings: confighttp.HTTPServerSettings{
+					Endpoint: "localhost:13",
+				},
+				Path:           "/ready",
+				CheckInterval:  30 * time.Second,
+				StallTimeout:   10 * time.Minute,
+				Exporters:      []string{"otlp/backend", "prometheusremotewrite"},
+				RestartOnStall: true,
+			},
+		},
+		{
+			id:          component.NewIDWithName(typeStr, "missingendpoint"),
+			expectedErr: errNoEndpointProvided,
+		},
+		{
+			id:          component.NewIDWithName(typeStr, "invalidpath"),
+			expectedErr: errInvalidPath,
+		},
+		{
+			id:          component.NewIDWithName(typeStr, "invalidcheckinterval"),
+			expectedErr: errInvalidCheckInterval,
+		},
+		{
+			id:          component.NewIDWithName(typeStr, "invalidstalltimeout"),
+			expectedErr: errInvalidStallTimeout,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+			require.NoError(t, err)
+			factory := NewFactory()
+			cfg := factory.CreateDefaultConfig()
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, component.UnmarshalConfig(sub, cfg))
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, component.ValidateConfig(cfg), tt.expectedErr)
+				return
+			}
+			assert.NoError(t, component.ValidateConfig(cfg))
+			assert.Equal(t, tt.expected, cfg)
+		})
+	}
+}
@@ -0,0 +1,126 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package watchdogextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/watchdogextension"
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.uber.org/zap"
+)
+
+type watchdog struct {
+	config   Config
+	logger   *zap.Logger
+	settings component.TelemetrySettings
+	monitor  *monitor
+	server   *http.Server
+	stopCh   chan struct{}
+	doneCh   chan struct{}
+}
+
+type readinessResponse struct {
+	Status           string            `json:"status"`
+	StalledExporters []stalledExporter `json:"stalled_exporters"`
+}
+
+func newWatchdog(config Config, settings component.TelemetrySettings) *watchdog {
+	return &watchdog{
+		config:   config,
+		logger:   settings.Logger,
+		settings: settings,
+		monitor:  newMonitor(config, readCollectorMetrics),
+	}
+}
+
+func (w *watchdog) Start(_ context.Context, host component.Host) error {
+	w.logger.Info("Starting watchdog extension", zap.Any("config", w.config))
+	ln, err := w.config.ToListener()
+	if err != nil {
+		return fmt.Errorf("failed to bind to address %s: %w", w.config.Endpoint, err)
+	}
+
+	w.server, err = w.config.ToServer(host, w.settings, nil)
+	if err != nil {
+		return err
+	}
+	mux := http.NewServeMux()
+	mux.Handle(w.config.Path, w.handler())
+	w.server.Handler = mux
+
+	w.stopCh = make(chan struct{})
+	w.doneCh = make(chan struct{})
+	go func() {
+		// The listener ownership goes to the server.
+		if errHTTP := w.server.Serve(ln); !errors.Is(errHTTP, http.ErrServerClosed) && errHTTP != nil {
+			host.ReportFatalError(errHTTP)
+		}
+	}()
+	go w.watch(host)
+	return nil
+}
+
+func (w *watchdog) watch(host component.Host) {
+	defer close(w.doneCh)
+
+	ticker := time.NewTicker(w.config.CheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-w.stopCh:
+			return
+		case now := <-ticker.C:
+			for _, stalled := range w.monitor.check(now) {
+				w.logger.Warn("Exporter made no progress with items in its queue",
+					zap.String("exporter", stalled.Exporter),
+					zap.Int64("queue_size", stalled.QueueSize),
+					zap.Time("last_progress", stalled.LastProgress))
+				if w.config.RestartOnStall {
+					host.ReportFatalError(fmt.Errorf("exporter %s made no progress for %s with %d items in its queue",
+						stalled.Exporter, now.Sub(stalled.LastProgress), stalled.QueueSize))
+				}
+			}
+		}
+	}
+}
+
+// handler returns the readiness of the collector, which is not ready while an exporter is stalled.
+func (w *watchdog) handler() http.Handler {
+	return http.HandlerFunc(func(rw http.ResponseWriter, _ *http.Request) {
+		resp := readinessResponse{Status: "ok", StalledExporters: w.monitor.stalled()}
+		status := http.StatusOK
+		if len(resp.StalledExporters) > 0 {
+			resp.Status = "stalled"
+			status = http.StatusServiceUnavailable
+		}
+		rw.Header().Set("Content-Type", "application/json")
+		rw.WriteHeader(status)
+		_ = json.NewEncoder(rw).Encode(resp)
+	})
+}
+
+func (w *watchdog) Shutdown(context.Context) error {
+	if w.server == nil {
+		return nil
+	}
+	close(w.stopCh)
+	<-w.doneCh
+	return w.server.Close()
+}
@@ -0,0 +1,114 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package watchdogextension
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/common/testutil"
+)
+
+func TestWatchdogReadiness(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = testutil.GetAvailableLocalAddress(t)
+	cfg.Path = "/ready"
+
+	metrics := &fakeMetrics{
+		queueSizes: map[string]int64{"otlp": 10},
+		sent:       map[string]int64{"otlp": 100},
+	}
+	w := newWatchdog(*cfg, componenttest.NewNopTelemetrySettings())
+	w.monitor = newMonitor(*cfg, metrics.read)
+	require.NoError(t, w.Start(context.Background(), componenttest.NewNopHost()))
+	t.Cleanup(func() { require.NoError(t, w.Shutdown(context.Background())) })
+
+	resp := getReadiness(t, "http://"+cfg.Endpoint+"/ready")
+	assert.Equal(t, readinessResponse{Status: "ok", StalledExporters: []stalledExporter{}}, resp)
+
+	start := time.Now().Truncate(time.Second).UTC()
+	w.monitor.check(start)
+	w.monitor.check(start.Add(cfg.StallTimeout))
+	resp = getReadiness(t, "http://"+cfg.Endpoint+"/ready")
+	assert.Equal(t, readinessResponse{
+		Status:           "stalled",
+		StalledExporters: []stalledExporter{{Exporter: "otlp", QueueSize: 10, LastProgress: start}},
+	}, resp)
+}
+
+func getReadiness(t *testing.T, url string) readinessResponse {
+	resp, err := http.Get(url)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	var body readinessResponse
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	if body.Status == "ok" {
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	} else {
+		assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+	}
+	return body
+}
+
+func TestWatchdogRestartOnStall(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = testutil.GetAvailableLocalAddress(t)
+	cfg.CheckInterval = 10 * time.Millisecond
+	cfg.StallTimeout = 10 * time.Millisecond
+	cfg.RestartOnStall = true
+
+	metrics := &fakeMetrics{
+		queueSizes: map[string]int64{"otlp": 10},
+		sent:       map[string]int64{"otlp": 100},
+	}
+	w := newWatchdog(*cfg, componenttest.NewNopTelemetrySettings())
+	w.monitor = newMonitor(*cfg, metrics.read)
+	host := &fatalErrorHost{Host: componenttest.NewNopHost()}
+	require.NoError(t, w.Start(context.Background(), host))
+	t.Cleanup(func() { require.NoError(t, w.Shutdown(context.Background())) })
+
+	require.Eventually(t, func() bool {
+		return host.err() != nil
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Contains(t, host.err().Error(), "exporter otlp made no progress")
+}
+
+type fatalErrorHost struct {
+	component.Host
+	mu       sync.Mutex
+	fatalErr error
+}
+
+func (h *fatalErrorHost) ReportFatalError(err error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.fatalErr = err
+}
+
+func (h *fatalErrorHost) err() error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.fatalErr
+}
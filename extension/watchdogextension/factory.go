@@ -0,0 +1,61 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package watchdogextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/watchdogextension"
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/extension"
+)
+
+const (
+	// The value of extension "type" in configuration.
+	typeStr = "watchdog"
+
+	// Use 0.0.0.0 to make the readiness endpoint accessible
+	// in container orchestration environments like Kubernetes.
+	defaultEndpoint = "0.0.0.0:13134"
+
+	defaultCheckInterval = 10 * time.Second
+	defaultStallTimeout  = 5 * time.Minute
+)
+
+// NewFactory creates a factory for the watchdog extension.
+func NewFactory() extension.Factory {
+	return extension.NewFactory(
+		typeStr,
+		createDefaultConfig,
+		createExtension,
+		component.StabilityLevelDevelopment,
+	)
+}
+
+func createDefaultConfig() component.Config {
+	return &Config{
+		HTTPServerSettings: confighttp.HTTPServerSettings{
+			Endpoint: defaultEndpoint,
+		},
+		Path:          "/",
+		CheckInterval: defaultCheckInterval,
+		StallTimeout:  defaultStallTimeout,
+	}
+}
+
+func createExtension(_ context.Context, set extension.CreateSettings, cfg component.Config) (extension.Extension, error) {
+	return newWatchdog(*cfg.(*Config), set.TelemetrySettings), nil
+}
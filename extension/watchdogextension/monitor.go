@@ -0,0 +1,169 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package watchdogextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/watchdogextension"
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opencensus.io/metric/metricdata"
+	"go.opencensus.io/metric/metricproducer"
+)
+
+const (
+	exporterLabel          = "exporter"
+	queueSizeMetric        = "exporter/queue_size"
+	sentMetricPrefix       = "exporter/sent_"
+	sendFailedMetricPrefix = "exporter/send_failed_"
+)
+
+// exporterState tracks the progress of an exporter. The progress is the number of items whose
+// send attempt completed, successfully or not: an exporter whose requests hang makes no progress.
+type exporterState struct {
+	progress     float64
+	queueSize    int64
+	lastProgress time.Time
+	stalled      bool
+}
+
+// stalledExporter describes an exporter that made no progress with items in its queue.
+type stalledExporter struct {
+	Exporter     string    `json:"exporter"`
+	QueueSize    int64     `json:"queue_size"`
+	LastProgress time.Time `json:"last_progress"`
+}
+
+// monitor detects the stalled exporters from the exporter metrics recorded by the collector.
+type monitor struct {
+	mu           sync.Mutex
+	read         func() []*metricdata.Metric
+	exporters    map[string]bool
+	stallTimeout time.Duration
+	states       map[string]*exporterState
+}
+
+func newMonitor(cfg Config, read func() []*metricdata.Metric) *monitor {
+	exporters := make(map[string]bool, len(cfg.Exporters))
+	for _, exporter := range cfg.Exporters {
+		exporters[exporter] = true
+	}
+	return &monitor{
+		read:         read,
+		exporters:    exporters,
+		stallTimeout: cfg.StallTimeout,
+		states:       map[string]*exporterState{},
+	}
+}
+
+// readCollectorMetrics returns the metrics of all the producers registered with the collector,
+// which include the views of the exporters and the queue size gauges.
+func readCollectorMetrics() []*metricdata.Metric {
+	var metrics []*metricdata.Metric
+	for _, producer := range metricproducer.GlobalManager().GetAll() {
+		metrics = append(metrics, producer.Read()...)
+	}
+	return metrics
+}
+
+// check updates the state of the exporters, and returns the exporters that stalled since the last check.
+func (m *monitor) check(now time.Time) []stalledExporter {
+	progress := map[string]float64{}
+	queueSizes := map[string]int64{}
+	for _, metric := range m.read() {
+		name := metric.Descriptor.Name
+		isQueueSize := name == queueSizeMetric
+		if !isQueueSize && !strings.HasPrefix(name, sentMetricPrefix) && !strings.HasPrefix(name, sendFailedMetricPrefix) {
+			continue
+		}
+		labelIndex := -1
+		for i, key := range metric.Descriptor.LabelKeys {
+			if key.Key == exporterLabel {
+				labelIndex = i
+			}
+		}
+		if labelIndex < 0 {
+			continue
+		}
+
+		for _, ts := range metric.TimeSeries {
+			if labelIndex >= len(ts.LabelValues) || len(ts.Points) == 0 {
+				continue
+			}
+			exporter := ts.LabelValues[labelIndex].Value
+			if len(m.exporters) > 0 && !m.exporters[exporter] {
+				continue
+			}
+			value := pointValue(ts.Points[len(ts.Points)-1])
+			if isQueueSize {
+				queueSizes[exporter] += int64(value)
+			} else {
+				progress[exporter] += value
+			}
+		}
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var stalled []stalledExporter
+	for exporter, queueSize := range queueSizes {
+		state, ok := m.states[exporter]
+		if !ok {
+			state = &exporterState{progress: progress[exporter], lastProgress: now}
+			m.states[exporter] = state
+		}
+		state.queueSize = queueSize
+
+		if progress[exporter] != state.progress || queueSize == 0 {
+			state.progress = progress[exporter]
+			state.lastProgress = now
+			state.stalled = false
+			continue
+		}
+		if !state.stalled && now.Sub(state.lastProgress) >= m.stallTimeout {
+			state.stalled = true
+			stalled = append(stalled, stalledExporter{Exporter: exporter, QueueSize: queueSize, LastProgress: state.lastProgress})
+		}
+	}
+	sort.Slice(stalled, func(i, j int) bool { return stalled[i].Exporter < stalled[j].Exporter })
+	return stalled
+}
+
+// stalled returns the exporters that are currently stalled.
+func (m *monitor) stalled() []stalledExporter {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	stalled := []stalledExporter{}
+	for exporter, state := range m.states {
+		if state.stalled {
+			stalled = append(stalled, stalledExporter{Exporter: exporter, QueueSize: state.queueSize, LastProgress: state.lastProgress})
+		}
+	}
+	sort.Slice(stalled, func(i, j int) bool { return stalled[i].Exporter < stalled[j].Exporter })
+	return stalled
+}
+
+func pointValue(point metricdata.Point) float64 {
+	switch v := point.Value.(type) {
+	case int64:
+		return float64(v)
+	case float64:
+		return v
+	}
+	return 0
+}
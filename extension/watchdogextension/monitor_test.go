@@ -0,0 +1,109 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package watchdogextension
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.opencensus.io/metric/metricdata"
+)
+
+// fakeMetrics holds the exporter metrics returned to the monitor.
+type fakeMetrics struct {
+	queueSizes map[string]int64
+	sent       map[string]int64
+	failed     map[string]float64
+}
+
+func (f *fakeMetrics) read() []*metricdata.Metric {
+	queueSize := &metricdata.Metric{
+		Descriptor: metricdata.Descriptor{Name: queueSizeMetric, LabelKeys: []metricdata.LabelKey{{Key: exporterLabel}}},
+	}
+	for exporter, size := range f.queueSizes {
+		queueSize.TimeSeries = append(queueSize.TimeSeries, &metricdata.TimeSeries{
+			LabelValues: []metricdata.LabelValue{metricdata.NewLabelValue(exporter)},
+			Points:      []metricdata.Point{metricdata.NewInt64Point(time.Now(), size)},
+		})
+	}
+	sent := &metricdata.Metric{
+		Descriptor: metricdata.Descriptor{Name: "exporter/sent_metric_points", LabelKeys: []metricdata.LabelKey{{Key: "service_instance_id"}, {Key: exporterLabel}}},
+	}
+	for exporter, count := range f.sent {
+		sent.TimeSeries = append(sent.TimeSeries, &metricdata.TimeSeries{
+			LabelValues: []metricdata.LabelValue{metricdata.NewLabelValue("id"), metricdata.NewLabelValue(exporter)},
+			Points:      []metricdata.Point{metricdata.NewInt64Point(time.Now(), count)},
+		})
+	}
+	failed := &metricdata.Metric{
+		Descriptor: metricdata.Descriptor{Name: "exporter/send_failed_metric_points", LabelKeys: []metricdata.LabelKey{{Key: exporterLabel}}},
+	}
+	for exporter, count := range f.failed {
+		failed.TimeSeries = append(failed.TimeSeries, &metricdata.TimeSeries{
+			LabelValues: []metricdata.LabelValue{metricdata.NewLabelValue(exporter)},
+			Points:      []metricdata.Point{metricdata.NewFloat64Point(time.Now(), count)},
+		})
+	}
+	unrelated := &metricdata.Metric{
+		Descriptor: metricdata.Descriptor{Name: "receiver/accepted_metric_points", LabelKeys: []metricdata.LabelKey{{Key: "receiver"}}},
+		TimeSeries: []*metricdata.TimeSeries{{
+			LabelValues: []metricdata.LabelValue{metricdata.NewLabelValue("otlp")},
+			Points:      []metricdata.Point{metricdata.NewInt64Point(time.Now(), 10)},
+		}},
+	}
+	return []*metricdata.Metric{queueSize, sent, failed, unrelated}
+}
+
+func TestMonitor(t *testing.T) {
+	metrics := &fakeMetrics{
+		queueSizes: map[string]int64{"otlp": 10, "otlp/idle": 0, "otlp/failing": 10},
+		sent:       map[string]int64{"otlp": 100, "otlp/idle": 100},
+		failed:     map[string]float64{"otlp/failing": 5},
+	}
+	m := newMonitor(Config{StallTimeout: time.Minute}, metrics.read)
+	start := time.Now()
+
+	assert.Empty(t, m.check(start))
+	assert.Empty(t, m.check(start.Add(30*time.Second)))
+
+	// only the failing exporter makes progress, since its send attempts complete
+	metrics.failed["otlp/failing"] = 10
+	assert.Equal(t, []stalledExporter{
+		{Exporter: "otlp", QueueSize: 10, LastProgress: start},
+	}, m.check(start.Add(time.Minute)))
+	assert.Empty(t, m.check(start.Add(2*time.Minute)), "a stalled exporter is only returned once")
+	assert.Equal(t, []stalledExporter{
+		{Exporter: "otlp", QueueSize: 10, LastProgress: start},
+	}, m.stalled())
+
+	metrics.sent["otlp"] = 110
+	assert.Empty(t, m.check(start.Add(3*time.Minute)))
+	assert.Empty(t, m.stalled(), "an exporter making progress again is no longer stalled")
+}
+
+func TestMonitorExporters(t *testing.T) {
+	metrics := &fakeMetrics{
+		queueSizes: map[string]int64{"otlp": 10, "otlp/backend": 10},
+		sent:       map[string]int64{"otlp": 100, "otlp/backend": 100},
+	}
+	m := newMonitor(Config{StallTimeout: time.Minute, Exporters: []string{"otlp/backend"}}, metrics.read)
+	start := time.Now()
+
+	assert.Empty(t, m.check(start))
+	assert.Equal(t, []stalledExporter{
+		{Exporter: "otlp/backend", QueueSize: 10, LastProgress: start},
+	}, m.check(start.Add(time.Minute)))
+}
@@ -471,6 +471,7 @@ require (
 	github.com/open-telemetry/opentelemetry-collector-contrib/internal/aws/metrics v0.69.0 // indirect
 	github.com/open-telemetry/opentelemetry-collector-contrib/internal/aws/proxy v0.69.0 // indirect
 	github.com/open-telemetry/opentelemetry-collector-contrib/internal/aws/xray v0.69.0 // indirect
+	github.com/open-telemetry/opentelemetry-collector-contrib/internal/deltacumulative v0.69.0 // indirect
 	github.com/open-telemetry/opentelemetry-collector-contrib/internal/docker v0.69.0 // indirect
 	github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter v0.69.0 // indirect
 	github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig v0.69.0 // indirect
@@ -617,6 +618,7 @@ require (
 	k8s.io/api v0.26.0 // indirect
 	k8s.io/apimachinery v0.26.0 // indirect
 	k8s.io/client-go v0.26.0 // indirect
+	k8s.io/cri-api v0.26.0 // indirect
 	k8s.io/klog v1.0.0 // indirect
 	k8s.io/klog/v2 v2.80.1 // indirect
 	k8s.io/kube-openapi v0.0.0-20221207184640-f3cff1453715 // indirect
@@ -651,6 +653,8 @@ replace github.com/open-telemetry/opentelemetry-collector-contrib/internal/commo
 
 replace github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal => ./internal/coreinternal
 
+replace github.com/open-telemetry/opentelemetry-collector-contrib/internal/deltacumulative => ./internal/deltacumulative
+
 replace github.com/open-telemetry/opentelemetry-collector-contrib/internal/docker => ./internal/docker
 
 replace github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter => ./internal/filter
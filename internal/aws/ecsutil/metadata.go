@@ -16,17 +16,25 @@ package ecsutil // import "github.com/open-telemetry/opentelemetry-collector-con
 
 // TaskMetadata defines task metadata for a task
 type TaskMetadata struct {
-	AvailabilityZone string              `json:"AvailabilityZone,omitempty"`
-	Cluster          string              `json:"Cluster,omitempty"`
-	Containers       []ContainerMetadata `json:"Containers,omitempty"`
-	Family           string              `json:"Family,omitempty"`
-	KnownStatus      string              `json:"KnownStatus,omitempty"`
-	LaunchType       string              `json:"LaunchType,omitempty"`
-	Limits           Limits              `json:"Limits,omitempty"`
-	PullStartedAt    string              `json:"PullStartedAt,omitempty"`
-	PullStoppedAt    string              `json:"PullStoppedAt,omitempty"`
-	Revision         string              `json:"Revision,omitempty"`
-	TaskARN          string              `json:"TaskARN,omitempty"`
+	AvailabilityZone        string                   `json:"AvailabilityZone,omitempty"`
+	Cluster                 string                   `json:"Cluster,omitempty"`
+	Containers              []ContainerMetadata      `json:"Containers,omitempty"`
+	EphemeralStorageMetrics *EphemeralStorageMetrics `json:"EphemeralStorageMetrics,omitempty"`
+	Family                  string                   `json:"Family,omitempty"`
+	KnownStatus             string                   `json:"KnownStatus,omitempty"`
+	LaunchType              string                   `json:"LaunchType,omitempty"`
+	Limits                  Limits                   `json:"Limits,omitempty"`
+	PullStartedAt           string                   `json:"PullStartedAt,omitempty"`
+	PullStoppedAt           string                   `json:"PullStoppedAt,omitempty"`
+	Revision                string                   `json:"Revision,omitempty"`
+	TaskARN                 string                   `json:"TaskARN,omitempty"`
+}
+
+// EphemeralStorageMetrics defines the ephemeral storage usage of a Fargate task
+// (platform version 1.4.0 or later). Values are reported in MiB.
+type EphemeralStorageMetrics struct {
+	Utilized *uint64 `json:"Utilized,omitempty"`
+	Reserved *uint64 `json:"Reserved,omitempty"`
 }
 
 // ContainerMetadata defines container metadata for a container
@@ -0,0 +1,105 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package golden // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/comparetest/golden"
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+const (
+	gzipExt = ".gz"
+	zstdExt = ".zst"
+)
+
+// compressionExt returns the compression extension of the file, or an empty string
+// if the file is not compressed.
+func compressionExt(filePath string) string {
+	ext := strings.ToLower(filepath.Ext(filePath))
+	if ext == gzipExt || ext == zstdExt {
+		return ext
+	}
+	return ""
+}
+
+// contentExt returns the extension of the file content, ignoring the compression extension,
+// e.g. ".json" for "expected.json.gz".
+func contentExt(filePath string) string {
+	if ext := filepath.Ext(filePath); compressionExt(filePath) != "" {
+		filePath = strings.TrimSuffix(filePath, ext)
+	}
+	return strings.ToLower(filepath.Ext(filePath))
+}
+
+// readFile reads the specified file, decompressing it if it has a .gz or .zst extension.
+func readFile(filePath string) ([]byte, error) {
+	b, err := os.ReadFile(filepath.Clean(filePath))
+	if err != nil {
+		return nil, err
+	}
+
+	switch compressionExt(filePath) {
+	case gzipExt:
+		r, err := gzip.NewReader(bytes.NewReader(b))
+		if err != nil {
+			return nil, err
+		}
+		defer r.Close()
+		return io.ReadAll(r)
+	case zstdExt:
+		d, err := zstd.NewReader(nil)
+		if err != nil {
+			return nil, err
+		}
+		defer d.Close()
+		return d.DecodeAll(b, nil)
+	default:
+		return b, nil
+	}
+}
+
+// writeFile writes the specified file, compressing it if it has a .gz or .zst extension.
+// The compressed content only depends on the data, so that rewriting a file with the
+// same data doesn't change it.
+func writeFile(filePath string, b []byte) error {
+	switch compressionExt(filePath) {
+	case gzipExt:
+		var buf bytes.Buffer
+		w := gzip.NewWriter(&buf)
+		if _, err := w.Write(b); err != nil {
+			return err
+		}
+		if err := w.Close(); err != nil {
+			return err
+		}
+		b = buf.Bytes()
+	case zstdExt:
+		e, err := zstd.NewWriter(nil)
+		if err != nil {
+			return err
+		}
+		b = e.EncodeAll(b, nil)
+		if err = e.Close(); err != nil {
+			return err
+		}
+	}
+	return os.WriteFile(filePath, b, 0600)
+}
@@ -0,0 +1,130 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package golden
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+func TestReadCompressedMetrics(t *testing.T) {
+	expectedMetrics, err := ReadMetrics(filepath.Join("testdata", "roundtrip", "expected.json"))
+	require.NoError(t, err)
+
+	for _, name := range []string{"expected.json.gz", "expected.json.zst"} {
+		t.Run(name, func(t *testing.T) {
+			actualMetrics, err := ReadMetrics(filepath.Join("testdata", "roundtrip", name))
+			require.NoError(t, err)
+			require.Equal(t, expectedMetrics, actualMetrics)
+		})
+	}
+}
+
+func TestCompressedRoundTrip(t *testing.T) {
+	metrics := pmetric.NewMetrics()
+	testMetrics().CopyTo(metrics.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics())
+	logs := CreateTestLogs()
+	traces := CreateTestTraces()
+
+	tcs := []struct {
+		name  string
+		magic []byte
+	}{
+		{
+			name:  "golden.json.gz",
+			magic: []byte{0x1f, 0x8b},
+		},
+		{
+			name:  "golden.json.zst",
+			magic: []byte{0x28, 0xb5, 0x2f, 0xfd},
+		},
+		{
+			name:  "golden.yaml.gz",
+			magic: []byte{0x1f, 0x8b},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			dir := t.TempDir()
+
+			metricsFile := filepath.Join(dir, "metrics-"+tc.name)
+			if isYAML(metricsFile) {
+				require.NoError(t, writeMetricsYAML(metricsFile, metrics))
+			} else {
+				require.NoError(t, writeMetrics(metricsFile, metrics))
+			}
+			b, err := os.ReadFile(metricsFile)
+			require.NoError(t, err)
+			require.Equal(t, tc.magic, b[:len(tc.magic)], "the file should be compressed")
+
+			actualMetrics, err := ReadMetrics(metricsFile)
+			require.NoError(t, err)
+			require.Equal(t, metrics, actualMetrics)
+			MaybeUpdate(t, metricsFile, metrics)
+
+			if isYAML(tc.name) {
+				return
+			}
+
+			logsFile := filepath.Join(dir, "logs-"+tc.name)
+			require.NoError(t, writeLogs(logsFile, logs))
+			actualLogs, err := ReadLogs(logsFile)
+			require.NoError(t, err)
+			require.Equal(t, logs, actualLogs)
+			MaybeUpdate(t, logsFile, logs)
+
+			tracesFile := filepath.Join(dir, "traces-"+tc.name)
+			require.NoError(t, writeTraces(tracesFile, traces))
+			actualTraces, err := ReadTraces(tracesFile)
+			require.NoError(t, err)
+			require.Equal(t, traces, actualTraces)
+			MaybeUpdate(t, tracesFile, traces)
+		})
+	}
+}
+
+func TestCompressedWriteIsStable(t *testing.T) {
+	metrics := pmetric.NewMetrics()
+	testMetrics().CopyTo(metrics.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics())
+
+	for _, name := range []string{"metrics.json.gz", "metrics.json.zst"} {
+		t.Run(name, func(t *testing.T) {
+			file := filepath.Join(t.TempDir(), name)
+
+			require.NoError(t, writeMetrics(file, metrics))
+			first, err := os.ReadFile(file)
+			require.NoError(t, err)
+
+			require.NoError(t, writeMetrics(file, metrics))
+			second, err := os.ReadFile(file)
+			require.NoError(t, err)
+
+			require.Equal(t, first, second, "rewriting the same data should not change the file")
+		})
+	}
+}
+
+func TestContentExt(t *testing.T) {
+	require.Equal(t, ".json", contentExt("expected.json"))
+	require.Equal(t, ".json", contentExt("expected.json.gz"))
+	require.Equal(t, ".yaml", contentExt("expected.YAML.ZST"))
+	require.True(t, isYAML("expected.yml.gz"))
+	require.False(t, isYAML("expected.json.zst"))
+}
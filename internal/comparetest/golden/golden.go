@@ -16,8 +16,6 @@ package golden // import "github.com/open-telemetry/opentelemetry-collector-cont
 
 import (
 	"encoding/json"
-	"os"
-	"path/filepath"
 	"testing"
 
 	"go.opentelemetry.io/collector/pdata/plog"
@@ -25,9 +23,14 @@ import (
 	"go.opentelemetry.io/collector/pdata/ptrace"
 )
 
-// ReadMetrics reads a pmetric.Metrics from the specified file
+// ReadMetrics reads a pmetric.Metrics from the specified file, which is read as YAML if it has a
+// .yaml or .yml extension and as JSON otherwise. Files with an additional .gz or .zst extension
+// are decompressed with gzip or zstd.
 func ReadMetrics(filePath string) (pmetric.Metrics, error) {
-	expectedFileBytes, err := os.ReadFile(filePath)
+	if isYAML(filePath) {
+		return ReadMetricsYAML(filePath)
+	}
+	expectedFileBytes, err := readFile(filePath)
 	if err != nil {
 		return pmetric.Metrics{}, err
 	}
@@ -35,7 +38,8 @@ func ReadMetrics(filePath string) (pmetric.Metrics, error) {
 	return unmarshaller.UnmarshalMetrics(expectedFileBytes)
 }
 
-// WriteMetrics writes a pmetric.Metrics to the specified file
+// WriteMetrics writes a pmetric.Metrics to the specified file, compressed with gzip or zstd
+// if it has a .gz or .zst extension
 func WriteMetrics(t *testing.T, filePath string, metrics pmetric.Metrics) error {
 	if err := writeMetrics(filePath, metrics); err != nil {
 		return err
@@ -48,29 +52,34 @@ func WriteMetrics(t *testing.T, filePath string, metrics pmetric.Metrics) error
 
 // writeMetrics writes a pmetric.Metrics to the specified file
 func writeMetrics(filePath string, metrics pmetric.Metrics) error {
+	b, err := marshalMetrics(metrics)
+	if err != nil {
+		return err
+	}
+	return writeFile(filePath, b)
+}
+
+// marshalMetrics returns the indented JSON representation of a pmetric.Metrics, as written to the golden files
+func marshalMetrics(metrics pmetric.Metrics) ([]byte, error) {
 	unmarshaler := &pmetric.JSONMarshaler{}
 	fileBytes, err := unmarshaler.MarshalMetrics(metrics)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	var jsonVal map[string]interface{}
 	if err = json.Unmarshal(fileBytes, &jsonVal); err != nil {
-		return err
+		return nil, err
 	}
 	b, err := json.MarshalIndent(jsonVal, "", "   ")
 	if err != nil {
-		return err
-	}
-	b = append(b, []byte("\n")...)
-	if err := os.WriteFile(filePath, b, 0600); err != nil {
-		return err
+		return nil, err
 	}
-	return nil
+	return append(b, []byte("\n")...), nil
 }
 
-// ReadLogs reads a plog.Logs from the specified file
+// ReadLogs reads a plog.Logs from the specified file, decompressing it if it has a .gz or .zst extension
 func ReadLogs(filePath string) (plog.Logs, error) {
-	b, err := os.ReadFile(filepath.Clean(filePath))
+	b, err := readFile(filePath)
 	if err != nil {
 		return plog.Logs{}, err
 	}
@@ -79,9 +88,10 @@ func ReadLogs(filePath string) (plog.Logs, error) {
 	return unmarshaler.UnmarshalLogs(b)
 }
 
-// WriteLogs writes a plog.Logs to the specified file
-func WriteLogs(t *testing.T, filePath string, metrics plog.Logs) error {
-	if err := writeLogs(filePath, metrics); err != nil {
+// WriteLogs writes a plog.Logs to the specified file, compressed with gzip or zstd
+// if it has a .gz or .zst extension
+func WriteLogs(t *testing.T, filePath string, logs plog.Logs) error {
+	if err := writeLogs(filePath, logs); err != nil {
 		return err
 	}
 	t.Logf("Golden file successfully written to %s.", filePath)
@@ -92,26 +102,34 @@ func WriteLogs(t *testing.T, filePath string, metrics plog.Logs) error {
 
 // writeLogs writes a plog.Logs to the specified file
 func writeLogs(filePath string, logs plog.Logs) error {
+	b, err := marshalLogs(logs)
+	if err != nil {
+		return err
+	}
+	return writeFile(filePath, b)
+}
+
+// marshalLogs returns the indented JSON representation of a plog.Logs, as written to the golden files
+func marshalLogs(logs plog.Logs) ([]byte, error) {
 	unmarshaler := &plog.JSONMarshaler{}
 	fileBytes, err := unmarshaler.MarshalLogs(logs)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	var jsonVal map[string]interface{}
 	if err = json.Unmarshal(fileBytes, &jsonVal); err != nil {
-		return err
+		return nil, err
 	}
 	b, err := json.MarshalIndent(jsonVal, "", "    ")
 	if err != nil {
-		return err
+		return nil, err
 	}
-	b = append(b, []byte("\n")...)
-	return os.WriteFile(filePath, b, 0600)
+	return append(b, []byte("\n")...), nil
 }
 
-// ReadTraces reads a ptrace.Traces from the specified file
+// ReadTraces reads a ptrace.Traces from the specified file, decompressing it if it has a .gz or .zst extension
 func ReadTraces(filePath string) (ptrace.Traces, error) {
-	b, err := os.ReadFile(filepath.Clean(filePath))
+	b, err := readFile(filePath)
 	if err != nil {
 		return ptrace.Traces{}, err
 	}
@@ -120,6 +138,8 @@ func ReadTraces(filePath string) (ptrace.Traces, error) {
 	return unmarshaler.UnmarshalTraces(b)
 }
 
+// WriteTraces writes a ptrace.Traces to the specified file, compressed with gzip or zstd
+// if it has a .gz or .zst extension
 func WriteTraces(t *testing.T, filePath string, traces ptrace.Traces) error {
 	if err := writeTraces(filePath, traces); err != nil {
 		return err
@@ -132,19 +152,27 @@ func WriteTraces(t *testing.T, filePath string, traces ptrace.Traces) error {
 
 // writeTraces writes a ptrace.Traces to the specified file
 func writeTraces(filePath string, traces ptrace.Traces) error {
+	b, err := marshalTraces(traces)
+	if err != nil {
+		return err
+	}
+	return writeFile(filePath, b)
+}
+
+// marshalTraces returns the indented JSON representation of a ptrace.Traces, as written to the golden files
+func marshalTraces(traces ptrace.Traces) ([]byte, error) {
 	unmarshaler := &ptrace.JSONMarshaler{}
 	fileBytes, err := unmarshaler.MarshalTraces(traces)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	var jsonVal map[string]interface{}
 	if err = json.Unmarshal(fileBytes, &jsonVal); err != nil {
-		return err
+		return nil, err
 	}
 	b, err := json.MarshalIndent(jsonVal, "", "    ")
 	if err != nil {
-		return err
+		return nil, err
 	}
-	b = append(b, []byte("\n")...)
-	return os.WriteFile(filePath, b, 0600)
+	return append(b, []byte("\n")...), nil
 }
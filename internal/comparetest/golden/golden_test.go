@@ -77,6 +77,70 @@ func TestRoundTrip(t *testing.T) {
 	require.Equal(t, expectedMetrics, actualMetrics)
 }
 
+func TestNormalizeMetrics(t *testing.T) {
+	newMetrics := func(reversed bool, ts time.Time) pmetric.Metrics {
+		metrics := pmetric.NewMetrics()
+		resources := []string{"resource-a", "resource-b"}
+		names := []string{"metric.a", "metric.b"}
+		values := []string{"value-a", "value-b"}
+		if reversed {
+			resources = []string{"resource-b", "resource-a"}
+			names = []string{"metric.b", "metric.a"}
+			values = []string{"value-b", "value-a"}
+		}
+		for _, resource := range resources {
+			rm := metrics.ResourceMetrics().AppendEmpty()
+			if reversed {
+				rm.Resource().Attributes().PutStr("service.name", resource)
+				rm.Resource().Attributes().PutStr("host.name", "host")
+			} else {
+				rm.Resource().Attributes().PutStr("host.name", "host")
+				rm.Resource().Attributes().PutStr("service.name", resource)
+			}
+			ms := rm.ScopeMetrics().AppendEmpty().Metrics()
+			for _, name := range names {
+				m := ms.AppendEmpty()
+				m.SetName(name)
+				dps := m.SetEmptySum().DataPoints()
+				for _, value := range values {
+					dp := dps.AppendEmpty()
+					dp.Attributes().PutStr("key", value)
+					dp.SetStartTimestamp(pcommon.NewTimestampFromTime(ts))
+					dp.SetTimestamp(pcommon.NewTimestampFromTime(ts.Add(time.Second)))
+					dp.SetIntValue(int64(len(value)))
+				}
+			}
+		}
+		return metrics
+	}
+
+	expected := newMetrics(false, time.Unix(1000, 0))
+	actual := newMetrics(true, time.Unix(2000, 0))
+	NormalizeMetrics(expected)
+	NormalizeMetrics(actual)
+	require.Equal(t, expected, actual)
+
+	rm := actual.ResourceMetrics().At(0)
+	require.Equal(t, map[string]interface{}{"host.name": "host", "service.name": "resource-a"}, rm.Resource().Attributes().AsRaw())
+	ms := rm.ScopeMetrics().At(0).Metrics()
+	require.Equal(t, "metric.a", ms.At(0).Name())
+	require.Equal(t, "metric.b", ms.At(1).Name())
+	dp := ms.At(0).Sum().DataPoints().At(0)
+	require.Equal(t, map[string]interface{}{"key": "value-a"}, dp.Attributes().AsRaw())
+	require.Equal(t, pcommon.Timestamp(0), dp.StartTimestamp())
+	require.Equal(t, pcommon.Timestamp(0), dp.Timestamp())
+
+	expectedFile := filepath.Join(t.TempDir(), "expected.json")
+	require.NoError(t, writeMetrics(expectedFile, expected))
+	actualFile := filepath.Join(t.TempDir(), "actual.json")
+	require.NoError(t, writeMetrics(actualFile, actual))
+	expectedBytes, err := os.ReadFile(expectedFile)
+	require.NoError(t, err)
+	actualBytes, err := os.ReadFile(actualFile)
+	require.NoError(t, err)
+	require.Equal(t, expectedBytes, actualBytes)
+}
+
 func testMetrics() pmetric.MetricSlice {
 	slice := pmetric.NewMetricSlice()
 
@@ -237,7 +301,7 @@ func TestReadTraces(t *testing.T) {
 func TestWriteTraces(t *testing.T) {
 	traces := CreateTestTraces()
 
-	actualFile := filepath.Join("./testdata/traces-roundtrip", "traces.json")
+	actualFile := filepath.Join(t.TempDir(), "traces.json")
 	require.NoError(t, writeTraces(actualFile, traces))
 
 	actualBytes, err := os.ReadFile(actualFile)
@@ -255,6 +319,17 @@ func TestWriteTraces(t *testing.T) {
 	require.Equal(t, expectedBytes, actualBytes)
 }
 
+func TestTracesRoundTrip(t *testing.T) {
+	expectedTraces := CreateTestTraces()
+
+	tempDir := filepath.Join(t.TempDir(), "traces.json")
+	require.NoError(t, writeTraces(tempDir, expectedTraces))
+
+	actualTraces, err := ReadTraces(tempDir)
+	require.NoError(t, err)
+	require.Equal(t, expectedTraces, actualTraces)
+}
+
 func CreateTestTraces() ptrace.Traces {
 	traces := ptrace.NewTraces()
 
@@ -0,0 +1,162 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package golden // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/comparetest/golden"
+
+import (
+	"encoding/json"
+	"sort"
+	"testing"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+// WriteNormalizedMetrics writes a normalized copy of the pmetric.Metrics to the specified file,
+// see NormalizeMetrics. The metrics compared to the file must be normalized the same way.
+func WriteNormalizedMetrics(t *testing.T, filePath string, metrics pmetric.Metrics) error {
+	normalized := pmetric.NewMetrics()
+	metrics.CopyTo(normalized)
+	NormalizeMetrics(normalized)
+	if err := writeMetrics(filePath, normalized); err != nil {
+		return err
+	}
+	t.Logf("Golden file successfully written to %s.", filePath)
+	t.Log("NOTE: The WriteNormalizedMetrics call must be removed in order to pass the test.")
+	t.Fail()
+	return nil
+}
+
+// NormalizeMetrics sorts the resources, scopes, metrics, data points and attributes of the
+// pmetric.Metrics in a deterministic order and zeroes their timestamps, so that the files
+// written from them only differ when the metrics do.
+func NormalizeMetrics(metrics pmetric.Metrics) {
+	rms := metrics.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		rm := rms.At(i)
+		sortAttributes(rm.Resource().Attributes())
+		sms := rm.ScopeMetrics()
+		for j := 0; j < sms.Len(); j++ {
+			sm := sms.At(j)
+			sortAttributes(sm.Scope().Attributes())
+			ms := sm.Metrics()
+			for k := 0; k < ms.Len(); k++ {
+				normalizeMetric(ms.At(k))
+			}
+			ms.Sort(func(a, b pmetric.Metric) bool {
+				return a.Name() < b.Name()
+			})
+		}
+		sms.Sort(func(a, b pmetric.ScopeMetrics) bool {
+			if a.Scope().Name() != b.Scope().Name() {
+				return a.Scope().Name() < b.Scope().Name()
+			}
+			return a.Scope().Version() < b.Scope().Version()
+		})
+	}
+	rms.Sort(func(a, b pmetric.ResourceMetrics) bool {
+		return attributesKey(a.Resource().Attributes()) < attributesKey(b.Resource().Attributes())
+	})
+}
+
+func normalizeMetric(metric pmetric.Metric) {
+	switch metric.Type() {
+	case pmetric.MetricTypeGauge:
+		normalizeNumberDataPoints(metric.Gauge().DataPoints())
+	case pmetric.MetricTypeSum:
+		normalizeNumberDataPoints(metric.Sum().DataPoints())
+	case pmetric.MetricTypeHistogram:
+		dps := metric.Histogram().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			dp := dps.At(i)
+			dp.SetStartTimestamp(0)
+			dp.SetTimestamp(0)
+			sortAttributes(dp.Attributes())
+			normalizeExemplars(dp.Exemplars())
+		}
+		dps.Sort(func(a, b pmetric.HistogramDataPoint) bool {
+			return attributesKey(a.Attributes()) < attributesKey(b.Attributes())
+		})
+	case pmetric.MetricTypeExponentialHistogram:
+		dps := metric.ExponentialHistogram().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			dp := dps.At(i)
+			dp.SetStartTimestamp(0)
+			dp.SetTimestamp(0)
+			sortAttributes(dp.Attributes())
+			normalizeExemplars(dp.Exemplars())
+		}
+		dps.Sort(func(a, b pmetric.ExponentialHistogramDataPoint) bool {
+			return attributesKey(a.Attributes()) < attributesKey(b.Attributes())
+		})
+	case pmetric.MetricTypeSummary:
+		dps := metric.Summary().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			dp := dps.At(i)
+			dp.SetStartTimestamp(0)
+			dp.SetTimestamp(0)
+			sortAttributes(dp.Attributes())
+		}
+		dps.Sort(func(a, b pmetric.SummaryDataPoint) bool {
+			return attributesKey(a.Attributes()) < attributesKey(b.Attributes())
+		})
+	}
+}
+
+func normalizeNumberDataPoints(dps pmetric.NumberDataPointSlice) {
+	for i := 0; i < dps.Len(); i++ {
+		dp := dps.At(i)
+		dp.SetStartTimestamp(0)
+		dp.SetTimestamp(0)
+		sortAttributes(dp.Attributes())
+		normalizeExemplars(dp.Exemplars())
+	}
+	dps.Sort(func(a, b pmetric.NumberDataPoint) bool {
+		return attributesKey(a.Attributes()) < attributesKey(b.Attributes())
+	})
+}
+
+func normalizeExemplars(exemplars pmetric.ExemplarSlice) {
+	for i := 0; i < exemplars.Len(); i++ {
+		exemplars.At(i).SetTimestamp(0)
+		sortAttributes(exemplars.At(i).FilteredAttributes())
+	}
+}
+
+// sortAttributes sorts the attributes by key, so that they are written in the same order.
+func sortAttributes(attributes pcommon.Map) {
+	keys := make([]string, 0, attributes.Len())
+	attributes.Range(func(k string, _ pcommon.Value) bool {
+		keys = append(keys, k)
+		return true
+	})
+	sort.Strings(keys)
+
+	sorted := pcommon.NewMap()
+	sorted.EnsureCapacity(len(keys))
+	for _, k := range keys {
+		v, _ := attributes.Get(k)
+		v.CopyTo(sorted.PutEmpty(k))
+	}
+	sorted.CopyTo(attributes)
+}
+
+// attributesKey returns the JSON representation of the attributes, in which the keys are sorted.
+func attributesKey(attributes pcommon.Map) string {
+	b, err := json.Marshal(attributes.AsRaw())
+	if err != nil {
+		return ""
+	}
+	return string(b)
+}
@@ -0,0 +1,87 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package golden // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/comparetest/golden"
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+// UpdateEnv is the environment variable that makes MaybeUpdate rewrite the golden files, like the
+// -update flag. It is useful when running the tests of several packages, not all of them having the flag.
+const UpdateEnv = "UPDATE_GOLDEN"
+
+var update = flag.Bool("update", false, "rewrite the golden files with the actual results")
+
+// MaybeUpdate rewrites the golden file with the actual pmetric.Metrics, plog.Logs or ptrace.Traces
+// when the tests are run with the -update flag or the UPDATE_GOLDEN environment variable set to true.
+// Otherwise, it reads the golden file and fails the test if it differs from the actual result.
+// The golden file is in YAML if it has a .yaml or .yml extension, and in JSON otherwise. It is
+// compressed with gzip or zstd if it has an additional .gz or .zst extension.
+func MaybeUpdate(t *testing.T, filePath string, actual interface{}) {
+	t.Helper()
+
+	var b []byte
+	var err error
+	switch data := actual.(type) {
+	case pmetric.Metrics:
+		b, err = marshalMetrics(data)
+	case plog.Logs:
+		b, err = marshalLogs(data)
+	case ptrace.Traces:
+		b, err = marshalTraces(data)
+	default:
+		t.Fatalf("unsupported golden file content %T", actual)
+	}
+	require.NoError(t, err)
+
+	if shouldUpdate() {
+		content := b
+		if isYAML(filePath) {
+			content, err = jsonToYAML(b)
+			require.NoError(t, err)
+		}
+		require.NoError(t, os.MkdirAll(filepath.Dir(filePath), 0700))
+		require.NoError(t, writeFile(filePath, content))
+		t.Logf("Golden file successfully written to %s.", filePath)
+		return
+	}
+
+	var expected []byte
+	if isYAML(filePath) {
+		expected, err = readYAMLAsJSON(filePath)
+	} else {
+		expected, err = readFile(filePath)
+	}
+	require.NoError(t, err, "run the test with -update or %s=true to write the golden file", UpdateEnv)
+	assert.JSONEq(t, string(expected), string(b), "run the test with -update or %s=true to rewrite the golden file %s", UpdateEnv, filePath)
+}
+
+func shouldUpdate() bool {
+	if *update {
+		return true
+	}
+	updateEnv, _ := strconv.ParseBool(os.Getenv(UpdateEnv))
+	return updateEnv
+}
@@ -0,0 +1,49 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package golden
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+func TestMaybeUpdate(t *testing.T) {
+	metrics := pmetric.NewMetrics()
+	testMetrics().CopyTo(metrics.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics())
+
+	expectedFile := filepath.Join("testdata", "roundtrip", "expected.json")
+	MaybeUpdate(t, expectedFile, metrics)
+	MaybeUpdate(t, filepath.Join("testdata", "roundtrip", "expected.yaml"), metrics)
+	MaybeUpdate(t, filepath.Join("testdata", "logs-roundtrip", "expected.json"), CreateTestLogs())
+	MaybeUpdate(t, filepath.Join("testdata", "traces-roundtrip", "expected.json"), CreateTestTraces())
+}
+
+func TestMaybeUpdateWritesFile(t *testing.T) {
+	t.Setenv(UpdateEnv, "true")
+	actualFile := filepath.Join(t.TempDir(), "new", "metrics.json")
+	metrics := pmetric.NewMetrics()
+	testMetrics().CopyTo(metrics.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics())
+	MaybeUpdate(t, actualFile, metrics)
+
+	actualMetrics, err := ReadMetrics(actualFile)
+	require.NoError(t, err)
+	require.Equal(t, metrics, actualMetrics)
+
+	t.Setenv(UpdateEnv, "false")
+	MaybeUpdate(t, actualFile, metrics)
+}
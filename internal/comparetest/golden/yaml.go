@@ -0,0 +1,96 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package golden // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/comparetest/golden"
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"gopkg.in/yaml.v3"
+)
+
+// ReadMetricsYAML reads a pmetric.Metrics from the specified YAML file
+func ReadMetricsYAML(filePath string) (pmetric.Metrics, error) {
+	b, err := readYAMLAsJSON(filePath)
+	if err != nil {
+		return pmetric.Metrics{}, err
+	}
+	unmarshaller := &pmetric.JSONUnmarshaler{}
+	return unmarshaller.UnmarshalMetrics(b)
+}
+
+// WriteMetricsYAML writes a pmetric.Metrics to the specified YAML file
+func WriteMetricsYAML(t *testing.T, filePath string, metrics pmetric.Metrics) error {
+	if err := writeMetricsYAML(filePath, metrics); err != nil {
+		return err
+	}
+	t.Logf("Golden file successfully written to %s.", filePath)
+	t.Log("NOTE: The WriteMetricsYAML call must be removed in order to pass the test.")
+	t.Fail()
+	return nil
+}
+
+// writeMetricsYAML writes a pmetric.Metrics to the specified YAML file
+func writeMetricsYAML(filePath string, metrics pmetric.Metrics) error {
+	b, err := marshalMetrics(metrics)
+	if err != nil {
+		return err
+	}
+	if b, err = jsonToYAML(b); err != nil {
+		return err
+	}
+	return writeFile(filePath, b)
+}
+
+// isYAML returns whether the file is a YAML file according to its extension,
+// ignoring the compression extension.
+func isYAML(filePath string) bool {
+	ext := contentExt(filePath)
+	return ext == ".yaml" || ext == ".yml"
+}
+
+// readYAMLAsJSON reads a YAML file and returns its content as JSON, the representation
+// the pdata unmarshalers expect.
+func readYAMLAsJSON(filePath string) ([]byte, error) {
+	b, err := readFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+	var val interface{}
+	if err = yaml.Unmarshal(b, &val); err != nil {
+		return nil, err
+	}
+	return json.Marshal(val)
+}
+
+// jsonToYAML converts the JSON representation of pdata to YAML.
+func jsonToYAML(b []byte) ([]byte, error) {
+	var val interface{}
+	if err := json.Unmarshal(b, &val); err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(val); err != nil {
+		return nil, err
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
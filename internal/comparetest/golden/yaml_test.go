@@ -0,0 +1,50 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package golden
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+func TestReadMetricsYAML(t *testing.T) {
+	expectedMetrics := pmetric.NewMetrics()
+	testMetrics().CopyTo(expectedMetrics.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics())
+
+	expectedFile := filepath.Join("testdata", "roundtrip", "expected.yaml")
+	actualMetrics, err := ReadMetricsYAML(expectedFile)
+	require.NoError(t, err)
+	require.Equal(t, expectedMetrics, actualMetrics)
+
+	// ReadMetrics detects the YAML files by their extension.
+	actualMetrics, err = ReadMetrics(expectedFile)
+	require.NoError(t, err)
+	require.Equal(t, expectedMetrics, actualMetrics)
+}
+
+func TestMetricsYAMLRoundTrip(t *testing.T) {
+	expectedMetrics := pmetric.NewMetrics()
+	testMetrics().CopyTo(expectedMetrics.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics())
+
+	tempFile := filepath.Join(t.TempDir(), "metrics.yml")
+	require.NoError(t, writeMetricsYAML(tempFile, expectedMetrics))
+
+	actualMetrics, err := ReadMetrics(tempFile)
+	require.NoError(t, err)
+	require.Equal(t, expectedMetrics, actualMetrics)
+}
@@ -114,6 +114,9 @@ func CompareResourceLogs(expected, actual plog.ResourceLogs) error {
 		if eil.Version() != ail.Version() {
 			return fmt.Errorf("instrumentation library Version does not match expected: %s, actual: %s", eil.Version(), ail.Version())
 		}
+		if eAttrs, aAttrs := eil.Attributes().AsRaw(), ail.Attributes().AsRaw(); !reflect.DeepEqual(eAttrs, aAttrs) {
+			return fmt.Errorf("instrumentation library Attributes does not match expected: %v, actual: %v", eAttrs, aAttrs)
+		}
 		if err := CompareLogRecordSlices(eilm.LogRecords(), ailm.LogRecords()); err != nil {
 			return err
 		}
@@ -20,6 +20,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/plog"
 	"go.uber.org/multierr"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/comparetest/golden"
@@ -90,6 +91,55 @@ func TestCompareLogs(t *testing.T) {
 				reason: "An instrumentation library with a different name is a different library.",
 			},
 		},
+		{
+			name: "scope-attributes-mismatch",
+			withoutOptions: expectation{
+				err:    errors.New("instrumentation library Attributes does not match expected: map[library.mode:sync], actual: map[library.mode:async]"),
+				reason: "An instrumentation scope with different attributes should cause a failure.",
+			},
+		},
+		{
+			name: "ignore-scope-attribute",
+			compareOptions: []LogsCompareOption{
+				IgnoreScopeAttributeValue("library.instance"),
+			},
+			withoutOptions: expectation{
+				err:    errors.New("instrumentation library Attributes does not match expected: map[library.instance:a-random-id library.mode:sync], actual: map[library.instance:a-different-random-id library.mode:sync]"),
+				reason: "An unpredictable scope attribute will cause failures if not ignored.",
+			},
+			withOptions: expectation{
+				err:    nil,
+				reason: "The unpredictable scope attribute was ignored.",
+			},
+		},
+		{
+			name: "ignore-scope-version",
+			compareOptions: []LogsCompareOption{
+				IgnoreScopeVersion(),
+			},
+			withoutOptions: expectation{
+				err:    errors.New("instrumentation library Version does not match expected: 1.0, actual: 2.0"),
+				reason: "An unpredictable scope version will cause failures if not ignored.",
+			},
+			withOptions: expectation{
+				err:    nil,
+				reason: "The scope version was ignored.",
+			},
+		},
+		{
+			name: "ignore-scope-version-name-mismatch",
+			compareOptions: []LogsCompareOption{
+				IgnoreScopeVersion(),
+			},
+			withoutOptions: expectation{
+				err:    errors.New("instrumentation library Name does not match expected: one, actual: two"),
+				reason: "An instrumentation library with a different name is a different library.",
+			},
+			withOptions: expectation{
+				err:    errors.New("instrumentation library Name does not match expected: one, actual: two"),
+				reason: "The scope name should still be compared when the version is ignored.",
+			},
+		},
 		{
 			name: "resource-instrumentation-library-version-mismatch",
 			withoutOptions: expectation{
@@ -240,6 +290,80 @@ func TestCompareLogs(t *testing.T) {
 				reason: "Using IgnoreObservedTimestamp option should mute failure caused by wrong ObservedTimestamp.",
 			},
 		},
+		{
+			name: "match-log-body-attribute",
+			compareOptions: []LogsCompareOption{
+				MatchLogBody(`^started worker pid=\d+ at \S+$`, LogRecordsWithAttribute("source", "app")),
+			},
+			withoutOptions: expectation{
+				err: multierr.Combine(
+					errors.New("log record with attributes: map[source:app], does not match expected"),
+					errors.New("log record Body doesn't match expected: started worker pid=123 at 2023-01-16T10:00:00Z, actual: started worker pid=4567 at 2023-01-16T10:05:12Z"),
+				),
+				reason: "A log record body with an unpredictable part should cause a failure.",
+			},
+			withOptions: expectation{
+				err:    nil,
+				reason: "The bodies of the selected log records matching the pattern should be considered equal.",
+			},
+		},
+		{
+			name: "match-log-body-not-selected",
+			compareOptions: []LogsCompareOption{
+				MatchLogBody(`^started worker pid=\d+ at \S+$`, LogRecordsWithAttribute("source", "db")),
+			},
+			withoutOptions: expectation{
+				err: multierr.Combine(
+					errors.New("log record with attributes: map[source:app], does not match expected"),
+					errors.New("log record Body doesn't match expected: started worker pid=123 at 2023-01-16T10:00:00Z, actual: started worker pid=4567 at 2023-01-16T10:05:12Z"),
+				),
+				reason: "A log record body with an unpredictable part should cause a failure.",
+			},
+			withOptions: expectation{
+				err: multierr.Combine(
+					errors.New("log record with attributes: map[source:app], does not match expected"),
+					errors.New("log record Body doesn't match expected: started worker pid=123 at 2023-01-16T10:00:00Z, actual: started worker pid=4567 at 2023-01-16T10:05:12Z"),
+				),
+				reason: "The bodies of the log records that are not selected should still be compared.",
+			},
+		},
+		{
+			name: "match-log-body-severity",
+			compareOptions: []LogsCompareOption{
+				MatchLogBody(`^connection lost after \d+ms$`, LogRecordsWithSeverity(plog.SeverityNumberError)),
+			},
+			withoutOptions: expectation{
+				err: multierr.Combine(
+					errors.New("log record with attributes: map[source:db], does not match expected"),
+					errors.New("log record Body doesn't match expected: connection lost after 12ms, actual: connection lost after 40ms"),
+				),
+				reason: "A log record body with an unpredictable part should cause a failure.",
+			},
+			withOptions: expectation{
+				err:    nil,
+				reason: "The bodies of the log records with the selected severity matching the pattern should be considered equal.",
+			},
+		},
+		{
+			name: "match-log-body-pattern-mismatch",
+			compareOptions: []LogsCompareOption{
+				MatchLogBody(`^connection lost after \d+ms$`),
+			},
+			withoutOptions: expectation{
+				err: multierr.Combine(
+					errors.New("log record with attributes: map[source:db], does not match expected"),
+					errors.New("log record Body doesn't match expected: connection lost after 12ms, actual: connection refused"),
+				),
+				reason: "A log record body with a wrong value should cause a failure.",
+			},
+			withOptions: expectation{
+				err: multierr.Combine(
+					errors.New("log record with attributes: map[source:db], does not match expected"),
+					errors.New("log record Body doesn't match expected: , actual: connection refused"),
+				),
+				reason: "A body that doesn't match the pattern should still be reported.",
+			},
+		},
 	}
 
 	for _, tc := range tcs {
@@ -15,9 +15,11 @@
 package comparetest // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/comparetest"
 
 import (
+	"encoding/hex"
 	"fmt"
 	"reflect"
 
+	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.uber.org/multierr"
 )
@@ -27,14 +29,76 @@ func CompareMetrics(expected, actual pmetric.Metrics, options ...MetricsCompareO
 	expected.CopyTo(exp)
 	actual.CopyTo(act)
 
+	var withDiff, withTimestamps, withStartTimestamps bool
 	for _, option := range options {
 		option.applyOnMetrics(exp, act)
+		switch option.(type) {
+		case includeUnifiedDiff:
+			withDiff = true
+		case compareTimestamps:
+			withTimestamps = true
+		case compareStartTimestamps:
+			withStartTimestamps = true
+		}
+	}
+
+	err := compareResourceMetricsSlices(exp, act)
+	if err == nil && (withTimestamps || withStartTimestamps) {
+		err = compareNumberDataPointTimestamps(exp, act, withTimestamps, withStartTimestamps)
+	}
+	if err != nil && withDiff {
+		err = multierr.Append(err, newMetricsDiffError(exp, act))
 	}
+	return err
+}
+
+// compareNumberDataPointTimestamps compares the timestamps of the gauge and sum data points, which are
+// otherwise ignored. It must only be called once the metrics are known to match, so that the resources,
+// scopes, metrics and data points are at the same positions in both expected and actual.
+func compareNumberDataPointTimestamps(exp, act pmetric.Metrics, timestamps, startTimestamps bool) error {
+	erms, arms := exp.ResourceMetrics(), act.ResourceMetrics()
+	for i := 0; i < erms.Len(); i++ {
+		esms, asms := erms.At(i).ScopeMetrics(), arms.At(i).ScopeMetrics()
+		for j := 0; j < esms.Len(); j++ {
+			ems, ams := esms.At(j).Metrics(), asms.At(j).Metrics()
+			for k := 0; k < ems.Len(); k++ {
+				var edps, adps pmetric.NumberDataPointSlice
+				switch ams.At(k).Type() {
+				case pmetric.MetricTypeGauge:
+					edps, adps = ems.At(k).Gauge().DataPoints(), ams.At(k).Gauge().DataPoints()
+				case pmetric.MetricTypeSum:
+					edps, adps = ems.At(k).Sum().DataPoints(), ams.At(k).Sum().DataPoints()
+				default:
+					// The timestamps of the other data point types are always compared.
+					continue
+				}
+				for l := 0; l < edps.Len(); l++ {
+					edp, adp := edps.At(l), adps.At(l)
+					var err error
+					if startTimestamps && edp.StartTimestamp() != adp.StartTimestamp() {
+						err = &DataPointValueMismatchError{Field: "StartTimestamp", Expected: edp.StartTimestamp(), Actual: adp.StartTimestamp()}
+					} else if timestamps && edp.Timestamp() != adp.Timestamp() {
+						err = &DataPointValueMismatchError{Field: "Timestamp", Expected: edp.Timestamp(), Actual: adp.Timestamp()}
+					}
+					if err != nil {
+						return multierr.Combine(
+							&MetricDataPointsMismatchError{MetricName: ams.At(k).Name()},
+							&DataPointMismatchError{Attributes: adp.Attributes().AsRaw()},
+							err,
+						)
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
 
+// compareResourceMetricsSlices compares the resources of the metrics after the options are applied.
+func compareResourceMetricsSlices(exp, act pmetric.Metrics) error {
 	expectedMetrics, actualMetrics := exp.ResourceMetrics(), act.ResourceMetrics()
 	if expectedMetrics.Len() != actualMetrics.Len() {
-		return fmt.Errorf("number of resources does not match expected: %d, actual: %d", expectedMetrics.Len(),
-			actualMetrics.Len())
+		return &ResourceCountMismatchError{Expected: expectedMetrics.Len(), Actual: actualMetrics.Len()}
 	}
 
 	numResources := expectedMetrics.Len()
@@ -42,36 +106,31 @@ func CompareMetrics(expected, actual pmetric.Metrics, options ...MetricsCompareO
 	// Keep track of matching resources so that each can only be matched once
 	matchingResources := make(map[pmetric.ResourceMetrics]pmetric.ResourceMetrics, numResources)
 
+	actualIndex := newAttributesIndex(numResources, func(i int) pcommon.Map { return actualMetrics.At(i).Resource().Attributes() })
+
 	var errs error
 	var outOfOrderErrs error
 	for e := 0; e < numResources; e++ {
 		er := expectedMetrics.At(e)
-		var foundMatch bool
-		for a := 0; a < numResources; a++ {
-			ar := actualMetrics.At(a)
-			if _, ok := matchingResources[ar]; ok {
-				continue
-			}
-			if reflect.DeepEqual(er.Resource().Attributes().AsRaw(), ar.Resource().Attributes().AsRaw()) {
-				foundMatch = true
-				matchingResources[ar] = er
-				if e != a {
-					outOfOrderErrs = multierr.Append(outOfOrderErrs,
-						fmt.Errorf("ResourceMetrics with attributes %v expected at index %d, "+
-							"found a at index %d", er.Resource().Attributes().AsRaw(), e, a))
-				}
-				break
-			}
+		a := actualIndex.match(er.Resource().Attributes())
+		if a < 0 {
+			errs = multierr.Append(errs, &MissingResourceError{Attributes: er.Resource().Attributes().AsRaw()})
+			continue
 		}
 
-		if !foundMatch {
-			errs = multierr.Append(errs, fmt.Errorf("missing expected resource with attributes: %v", er.Resource().Attributes().AsRaw()))
+		matchingResources[actualMetrics.At(a)] = er
+		if e != a {
+			outOfOrderErrs = multierr.Append(outOfOrderErrs, &ResourceOrderError{
+				Attributes:    er.Resource().Attributes().AsRaw(),
+				ExpectedIndex: e,
+				ActualIndex:   a,
+			})
 		}
 	}
 
 	for i := 0; i < numResources; i++ {
 		if _, ok := matchingResources[actualMetrics.At(i)]; !ok {
-			errs = multierr.Append(errs, fmt.Errorf("extra resource with attributes: %v", actualMetrics.At(i).Resource().Attributes().AsRaw()))
+			errs = multierr.Append(errs, &ExtraResourceError{Attributes: actualMetrics.At(i).Resource().Attributes().AsRaw()})
 		}
 	}
 
@@ -92,12 +151,19 @@ func CompareMetrics(expected, actual pmetric.Metrics, options ...MetricsCompareO
 }
 
 func CompareResourceMetrics(expected, actual pmetric.ResourceMetrics) error {
+	if expected.SchemaUrl() != actual.SchemaUrl() {
+		return &ResourceSchemaURLMismatchError{
+			Attributes: actual.Resource().Attributes().AsRaw(),
+			Expected:   expected.SchemaUrl(),
+			Actual:     actual.SchemaUrl(),
+		}
+	}
+
 	eilms := expected.ScopeMetrics()
 	ailms := actual.ScopeMetrics()
 
 	if eilms.Len() != ailms.Len() {
-		return fmt.Errorf("number of instrumentation libraries does not match expected: %d, actual: %d", eilms.Len(),
-			ailms.Len())
+		return &ScopeCountMismatchError{Expected: eilms.Len(), Actual: ailms.Len()}
 	}
 
 	for i := 0; i < eilms.Len(); i++ {
@@ -105,10 +171,16 @@ func CompareResourceMetrics(expected, actual pmetric.ResourceMetrics) error {
 		eil, ail := eilm.Scope(), ailm.Scope()
 
 		if eil.Name() != ail.Name() {
-			return fmt.Errorf("instrumentation library Name does not match expected: %s, actual: %s", eil.Name(), ail.Name())
+			return &ScopeMismatchError{Field: "Name", Expected: eil.Name(), Actual: ail.Name()}
 		}
 		if eil.Version() != ail.Version() {
-			return fmt.Errorf("instrumentation library Version does not match expected: %s, actual: %s", eil.Version(), ail.Version())
+			return &ScopeMismatchError{Field: "Version", Expected: eil.Version(), Actual: ail.Version()}
+		}
+		if eAttrs, aAttrs := eil.Attributes().AsRaw(), ail.Attributes().AsRaw(); !reflect.DeepEqual(eAttrs, aAttrs) {
+			return &ScopeMismatchError{Field: "Attributes", Expected: fmt.Sprint(eAttrs), Actual: fmt.Sprint(aAttrs)}
+		}
+		if eilm.SchemaUrl() != ailm.SchemaUrl() {
+			return &ScopeMismatchError{Field: "SchemaURL", Expected: eilm.SchemaUrl(), Actual: ailm.SchemaUrl()}
 		}
 
 		if err := CompareMetricSlices(eilm.Metrics(), ailm.Metrics()); err != nil {
@@ -123,7 +195,7 @@ func CompareResourceMetrics(expected, actual pmetric.ResourceMetrics) error {
 // expected and actual values are clones before options are applied.
 func CompareMetricSlices(expected, actual pmetric.MetricSlice) error {
 	if expected.Len() != actual.Len() {
-		return fmt.Errorf("number of metrics does not match expected: %d, actual: %d", expected.Len(), actual.Len())
+		return &MetricCountMismatchError{Expected: expected.Len(), Actual: actual.Len()}
 	}
 
 	expectedByName, actualByName := metricsByName(expected), metricsByName(actual)
@@ -132,12 +204,12 @@ func CompareMetricSlices(expected, actual pmetric.MetricSlice) error {
 	for name := range actualByName {
 		_, ok := expectedByName[name]
 		if !ok {
-			errs = multierr.Append(errs, fmt.Errorf("unexpected metric: %s", name))
+			errs = multierr.Append(errs, &UnexpectedMetricError{MetricName: name})
 		}
 	}
 	for name := range expectedByName {
 		if _, ok := actualByName[name]; !ok {
-			errs = multierr.Append(errs, fmt.Errorf("missing expected metric: %s", name))
+			errs = multierr.Append(errs, &MissingMetricError{MetricName: name})
 		}
 	}
 
@@ -149,51 +221,50 @@ func CompareMetricSlices(expected, actual pmetric.MetricSlice) error {
 		actualMetric := actual.At(i)
 		expectedMetric := expected.At(i)
 		if actualMetric.Name() != expectedMetric.Name() {
-			return fmt.Errorf("metrics are out of order, metric %s expected at index %d, actual: %s",
-				expectedMetric.Name(), i, actualMetric.Name())
+			return &MetricOrderError{ExpectedMetricName: expectedMetric.Name(), ActualMetricName: actualMetric.Name(), Index: i}
 		}
 		if actualMetric.Description() != expectedMetric.Description() {
-			return fmt.Errorf("metric Description does not match expected: %s, actual: %s", expectedMetric.Description(), actualMetric.Description())
+			return &MetricMismatchError{MetricName: actualMetric.Name(), Field: "Description", Expected: expectedMetric.Description(), Actual: actualMetric.Description()}
 		}
 		if actualMetric.Unit() != expectedMetric.Unit() {
-			return fmt.Errorf("metric Unit does not match expected: %s, actual: %s", expectedMetric.Unit(), actualMetric.Unit())
+			return &MetricMismatchError{MetricName: actualMetric.Name(), Field: "Unit", Expected: expectedMetric.Unit(), Actual: actualMetric.Unit()}
 		}
 		if actualMetric.Type() != expectedMetric.Type() {
-			return fmt.Errorf("metric DataType does not match expected: %s, actual: %s", expectedMetric.Type(), actualMetric.Type())
+			return &MetricMismatchError{MetricName: actualMetric.Name(), Field: "DataType", Expected: expectedMetric.Type(), Actual: actualMetric.Type()}
 		}
 
 		switch actualMetric.Type() {
 		case pmetric.MetricTypeGauge:
 			if err := CompareNumberDataPointSlices(expectedMetric.Gauge().DataPoints(), actualMetric.Gauge().DataPoints()); err != nil {
-				return multierr.Combine(fmt.Errorf("datapoints for metric: `%s`, do not match expected", actualMetric.Name()), err)
+				return multierr.Combine(&MetricDataPointsMismatchError{MetricName: actualMetric.Name()}, err)
 			}
 		case pmetric.MetricTypeSum:
 			if actualMetric.Sum().AggregationTemporality() != expectedMetric.Sum().AggregationTemporality() {
-				return fmt.Errorf("metric AggregationTemporality does not match expected: %s, actual: %s", expectedMetric.Sum().AggregationTemporality(), actualMetric.Sum().AggregationTemporality())
+				return &MetricMismatchError{MetricName: actualMetric.Name(), Field: "AggregationTemporality", Expected: expectedMetric.Sum().AggregationTemporality(), Actual: actualMetric.Sum().AggregationTemporality()}
 			}
 			if actualMetric.Sum().IsMonotonic() != expectedMetric.Sum().IsMonotonic() {
-				return fmt.Errorf("metric IsMonotonic does not match expected: %t, actual: %t", expectedMetric.Sum().IsMonotonic(), actualMetric.Sum().IsMonotonic())
+				return &MetricMismatchError{MetricName: actualMetric.Name(), Field: "IsMonotonic", Expected: expectedMetric.Sum().IsMonotonic(), Actual: actualMetric.Sum().IsMonotonic()}
 			}
 			if err := CompareNumberDataPointSlices(expectedMetric.Sum().DataPoints(), actualMetric.Sum().DataPoints()); err != nil {
-				return multierr.Combine(fmt.Errorf("datapoints for metric: `%s`, do not match expected", actualMetric.Name()), err)
+				return multierr.Combine(&MetricDataPointsMismatchError{MetricName: actualMetric.Name()}, err)
 			}
 		case pmetric.MetricTypeHistogram:
 			if actualMetric.Histogram().AggregationTemporality() != expectedMetric.Histogram().AggregationTemporality() {
-				return fmt.Errorf("metric AggregationTemporality does not match expected: %s, actual: %s", expectedMetric.Histogram().AggregationTemporality(), actualMetric.Histogram().AggregationTemporality())
+				return &MetricMismatchError{MetricName: actualMetric.Name(), Field: "AggregationTemporality", Expected: expectedMetric.Histogram().AggregationTemporality(), Actual: actualMetric.Histogram().AggregationTemporality()}
 			}
 			if err := CompareHistogramDataPointSlices(expectedMetric.Histogram().DataPoints(), actualMetric.Histogram().DataPoints()); err != nil {
-				return multierr.Combine(fmt.Errorf("datapoints for metric: `%s`, do not match expected", actualMetric.Name()), err)
+				return multierr.Combine(&MetricDataPointsMismatchError{MetricName: actualMetric.Name()}, err)
 			}
 		case pmetric.MetricTypeExponentialHistogram:
 			if actualMetric.ExponentialHistogram().AggregationTemporality() != expectedMetric.ExponentialHistogram().AggregationTemporality() {
-				return fmt.Errorf("metric AggregationTemporality does not match expected: %s, actual: %s", expectedMetric.ExponentialHistogram().AggregationTemporality(), actualMetric.ExponentialHistogram().AggregationTemporality())
+				return &MetricMismatchError{MetricName: actualMetric.Name(), Field: "AggregationTemporality", Expected: expectedMetric.ExponentialHistogram().AggregationTemporality(), Actual: actualMetric.ExponentialHistogram().AggregationTemporality()}
 			}
 			if err := CompareExponentialHistogramDataPointSlices(expectedMetric.ExponentialHistogram().DataPoints(), actualMetric.ExponentialHistogram().DataPoints()); err != nil {
-				return multierr.Combine(fmt.Errorf("datapoints for metric: `%s`, do not match expected", actualMetric.Name()), err)
+				return multierr.Combine(&MetricDataPointsMismatchError{MetricName: actualMetric.Name()}, err)
 			}
 		case pmetric.MetricTypeSummary:
 			if err := CompareSummaryDataPointSlices(expectedMetric.Summary().DataPoints(), actualMetric.Summary().DataPoints()); err != nil {
-				return multierr.Combine(fmt.Errorf("datapoints for metric: `%s`, do not match expected", actualMetric.Name()), err)
+				return multierr.Combine(&MetricDataPointsMismatchError{MetricName: actualMetric.Name()}, err)
 			}
 		}
 	}
@@ -204,7 +275,7 @@ func CompareMetricSlices(expected, actual pmetric.MetricSlice) error {
 // an error if they don't match. The error describes what didn't match.
 func CompareNumberDataPointSlices(expected, actual pmetric.NumberDataPointSlice) error {
 	if expected.Len() != actual.Len() {
-		return fmt.Errorf("number of datapoints does not match expected: %d, actual: %d", expected.Len(), actual.Len())
+		return &DataPointCountMismatchError{Expected: expected.Len(), Actual: actual.Len()}
 	}
 
 	numPoints := expected.Len()
@@ -212,36 +283,31 @@ func CompareNumberDataPointSlices(expected, actual pmetric.NumberDataPointSlice)
 	// Keep track of matching data points so that each point can only be matched once
 	matchingDPS := make(map[pmetric.NumberDataPoint]pmetric.NumberDataPoint, numPoints)
 
+	actualIndex := newAttributesIndex(numPoints, func(i int) pcommon.Map { return actual.At(i).Attributes() })
+
 	var errs error
 	var outOfOrderErrs error
 	for e := 0; e < numPoints; e++ {
 		edp := expected.At(e)
-		var foundMatch bool
-		for a := 0; a < numPoints; a++ {
-			adp := actual.At(a)
-			if _, ok := matchingDPS[adp]; ok {
-				continue
-			}
-			if reflect.DeepEqual(edp.Attributes().AsRaw(), adp.Attributes().AsRaw()) {
-				foundMatch = true
-				matchingDPS[adp] = edp
-				if e != a {
-					outOfOrderErrs = multierr.Append(outOfOrderErrs, fmt.Errorf("datapoints are out of order, "+
-						"datapoint with attributes %v expected at index %d, "+
-						"found a at index %d", edp.Attributes().AsRaw(), e, a))
-				}
-				break
-			}
+		a := actualIndex.match(edp.Attributes())
+		if a < 0 {
+			errs = multierr.Append(errs, &MissingDataPointError{Attributes: edp.Attributes().AsRaw()})
+			continue
 		}
 
-		if !foundMatch {
-			errs = multierr.Append(errs, fmt.Errorf("metric missing expected datapoint with attributes: %v", edp.Attributes().AsRaw()))
+		matchingDPS[actual.At(a)] = edp
+		if e != a {
+			outOfOrderErrs = multierr.Append(outOfOrderErrs, &DataPointOrderError{
+				Attributes:    edp.Attributes().AsRaw(),
+				ExpectedIndex: e,
+				ActualIndex:   a,
+			})
 		}
 	}
 
 	for i := 0; i < numPoints; i++ {
 		if _, ok := matchingDPS[actual.At(i)]; !ok {
-			errs = multierr.Append(errs, fmt.Errorf("metric has extra datapoint with attributes: %v", actual.At(i).Attributes().AsRaw()))
+			errs = multierr.Append(errs, &ExtraDataPointError{Attributes: actual.At(i).Attributes().AsRaw()})
 		}
 	}
 
@@ -254,7 +320,7 @@ func CompareNumberDataPointSlices(expected, actual pmetric.NumberDataPointSlice)
 
 	for adp, edp := range matchingDPS {
 		if err := CompareNumberDataPoints(edp, adp); err != nil {
-			return multierr.Combine(fmt.Errorf("datapoint with attributes: %v, does not match expected", adp.Attributes().AsRaw()), err)
+			return multierr.Combine(&DataPointMismatchError{Attributes: adp.Attributes().AsRaw()}, err)
 		}
 	}
 	return nil
@@ -264,22 +330,25 @@ func CompareNumberDataPointSlices(expected, actual pmetric.NumberDataPointSlice)
 // an error if they don't match. The error describes what didn't match.
 func CompareNumberDataPoints(expected, actual pmetric.NumberDataPoint) error {
 	if expected.ValueType() != actual.ValueType() {
-		return fmt.Errorf("metric datapoint types don't match: expected type: %s, actual type: %s", expected.ValueType(), actual.ValueType())
+		return &DataPointValueTypeMismatchError{Expected: expected.ValueType(), Actual: actual.ValueType()}
 	}
 	if expected.IntValue() != actual.IntValue() {
-		return fmt.Errorf("metric datapoint IntVal doesn't match expected: %d, actual: %d", expected.IntValue(), actual.IntValue())
+		return &DataPointValueMismatchError{Field: "IntVal", Expected: expected.IntValue(), Actual: actual.IntValue()}
 	}
 	if expected.DoubleValue() != actual.DoubleValue() {
-		return fmt.Errorf("metric datapoint DoubleVal doesn't match expected: %f, actual: %f", expected.DoubleValue(), actual.DoubleValue())
+		return &DataPointValueMismatchError{Field: "DoubleVal", Expected: expected.DoubleValue(), Actual: actual.DoubleValue()}
 	}
-	return nil
+	if expected.Flags() != actual.Flags() {
+		return &DataPointValueMismatchError{Field: "Flags", Expected: expected.Flags(), Actual: actual.Flags()}
+	}
+	return compareExemplarSlices(expected.Exemplars(), actual.Exemplars())
 }
 
 // CompareHistogramDataPointSlices compares each part of two given HistogramDataPointSlices and returns
 // an error if they don't match. The error describes what didn't match.
 func CompareHistogramDataPointSlices(expected, actual pmetric.HistogramDataPointSlice) error {
 	if expected.Len() != actual.Len() {
-		return fmt.Errorf("number of datapoints does not match expected: %d, actual: %d", expected.Len(), actual.Len())
+		return &DataPointCountMismatchError{Expected: expected.Len(), Actual: actual.Len()}
 	}
 
 	numPoints := expected.Len()
@@ -287,36 +356,31 @@ func CompareHistogramDataPointSlices(expected, actual pmetric.HistogramDataPoint
 	// Keep track of matching data points so that each point can only be matched once
 	matchingDPS := make(map[pmetric.HistogramDataPoint]pmetric.HistogramDataPoint, numPoints)
 
+	actualIndex := newAttributesIndex(numPoints, func(i int) pcommon.Map { return actual.At(i).Attributes() })
+
 	var errs error
 	var outOfOrderErrs error
 	for e := 0; e < numPoints; e++ {
 		edp := expected.At(e)
-		var foundMatch bool
-		for a := 0; a < numPoints; a++ {
-			adp := actual.At(a)
-			if _, ok := matchingDPS[adp]; ok {
-				continue
-			}
-			if reflect.DeepEqual(edp.Attributes().AsRaw(), adp.Attributes().AsRaw()) {
-				foundMatch = true
-				matchingDPS[adp] = edp
-				if e != a {
-					outOfOrderErrs = multierr.Append(outOfOrderErrs,
-						fmt.Errorf("datapoint with attributes %v expected at index %d, "+
-							"found a at index %d", edp.Attributes().AsRaw(), e, a))
-				}
-				break
-			}
+		a := actualIndex.match(edp.Attributes())
+		if a < 0 {
+			errs = multierr.Append(errs, &MissingDataPointError{Attributes: edp.Attributes().AsRaw()})
+			continue
 		}
 
-		if !foundMatch {
-			errs = multierr.Append(errs, fmt.Errorf("metric missing expected datapoint with attributes: %v", edp.Attributes().AsRaw()))
+		matchingDPS[actual.At(a)] = edp
+		if e != a {
+			outOfOrderErrs = multierr.Append(outOfOrderErrs, &DataPointOrderError{
+				Attributes:    edp.Attributes().AsRaw(),
+				ExpectedIndex: e,
+				ActualIndex:   a,
+			})
 		}
 	}
 
 	for i := 0; i < numPoints; i++ {
 		if _, ok := matchingDPS[actual.At(i)]; !ok {
-			errs = multierr.Append(errs, fmt.Errorf("metric has extra datapoint with attributes: %v", actual.At(i).Attributes().AsRaw()))
+			errs = multierr.Append(errs, &ExtraDataPointError{Attributes: actual.At(i).Attributes().AsRaw()})
 		}
 	}
 
@@ -329,7 +393,7 @@ func CompareHistogramDataPointSlices(expected, actual pmetric.HistogramDataPoint
 
 	for adp, edp := range matchingDPS {
 		if err := CompareHistogramDataPoints(edp, adp); err != nil {
-			return multierr.Combine(fmt.Errorf("datapoint with attributes: %v, does not match expected", adp.Attributes().AsRaw()), err)
+			return multierr.Combine(&DataPointMismatchError{Attributes: adp.Attributes().AsRaw()}, err)
 		}
 	}
 	return nil
@@ -339,52 +403,52 @@ func CompareHistogramDataPointSlices(expected, actual pmetric.HistogramDataPoint
 // an error if they don't match. The error describes what didn't match.
 func CompareHistogramDataPoints(expected, actual pmetric.HistogramDataPoint) error {
 	if expected.HasSum() != actual.HasSum() {
-		return fmt.Errorf("metric datapoint HasSum doesn't match expected: %t, actual: %t", expected.HasSum(), actual.HasSum())
+		return &DataPointValueMismatchError{Field: "HasSum", Expected: expected.HasSum(), Actual: actual.HasSum()}
 	}
 	if expected.HasSum() && expected.Sum() != actual.Sum() {
-		return fmt.Errorf("metric datapoint Sum doesn't match expected: %f, actual: %f", expected.Sum(), actual.Sum())
+		return &DataPointValueMismatchError{Field: "Sum", Expected: expected.Sum(), Actual: actual.Sum()}
 	}
 	if expected.HasMin() != actual.HasMin() {
-		return fmt.Errorf("metric datapoint HasMin doesn't match expected: %t, actual: %t", expected.HasMin(), actual.HasMin())
+		return &DataPointValueMismatchError{Field: "HasMin", Expected: expected.HasMin(), Actual: actual.HasMin()}
 	}
 	if expected.HasMin() && expected.Min() != actual.Min() {
-		return fmt.Errorf("metric datapoint Min doesn't match expected: %f, actual: %f", expected.Min(), actual.Min())
+		return &DataPointValueMismatchError{Field: "Min", Expected: expected.Min(), Actual: actual.Min()}
 	}
 	if expected.HasMax() != actual.HasMax() {
-		return fmt.Errorf("metric datapoint HasMax doesn't match expected: %t, actual: %t", expected.HasMax(), actual.HasMax())
+		return &DataPointValueMismatchError{Field: "HasMax", Expected: expected.HasMax(), Actual: actual.HasMax()}
 	}
 	if expected.HasMax() && expected.Max() != actual.Max() {
-		return fmt.Errorf("metric datapoint Max doesn't match expected: %f, actual: %f", expected.Max(), actual.Max())
+		return &DataPointValueMismatchError{Field: "Max", Expected: expected.Max(), Actual: actual.Max()}
 	}
 	if expected.Count() != actual.Count() {
-		return fmt.Errorf("metric datapoint Count doesn't match expected: %d, actual: %d", expected.Count(), actual.Count())
+		return &DataPointValueMismatchError{Field: "Count", Expected: expected.Count(), Actual: actual.Count()}
 	}
 	if expected.StartTimestamp() != actual.StartTimestamp() {
-		return fmt.Errorf("metric datapoint StartTimestamp doesn't match expected: %d, actual: %d", expected.StartTimestamp(), actual.StartTimestamp())
+		return &DataPointValueMismatchError{Field: "StartTimestamp", Expected: expected.StartTimestamp(), Actual: actual.StartTimestamp()}
 	}
 	if expected.Timestamp() != actual.Timestamp() {
-		return fmt.Errorf("metric datapoint Timestamp doesn't match expected: %d, actual: %d", expected.Timestamp(), actual.Timestamp())
+		return &DataPointValueMismatchError{Field: "Timestamp", Expected: expected.Timestamp(), Actual: actual.Timestamp()}
 	}
 	if expected.Flags() != actual.Flags() {
-		return fmt.Errorf("metric datapoint Flags doesn't match expected: %d, actual: %d", expected.Flags(), actual.Flags())
+		return &DataPointValueMismatchError{Field: "Flags", Expected: expected.Flags(), Actual: actual.Flags()}
 	}
 	if !reflect.DeepEqual(expected.BucketCounts(), actual.BucketCounts()) {
-		return fmt.Errorf("metric datapoint BucketCounts doesn't match expected: %v, actual: %v", expected.BucketCounts().AsRaw(), actual.BucketCounts().AsRaw())
+		return &DataPointValueMismatchError{Field: "BucketCounts", Expected: expected.BucketCounts().AsRaw(), Actual: actual.BucketCounts().AsRaw()}
 	}
 	if !reflect.DeepEqual(expected.ExplicitBounds(), actual.ExplicitBounds()) {
-		return fmt.Errorf("metric datapoint ExplicitBounds doesn't match expected: %v, actual: %v", expected.ExplicitBounds().AsRaw(), actual.ExplicitBounds().AsRaw())
+		return &DataPointValueMismatchError{Field: "ExplicitBounds", Expected: expected.ExplicitBounds().AsRaw(), Actual: actual.ExplicitBounds().AsRaw()}
 	}
 	if !reflect.DeepEqual(expected.Attributes().AsRaw(), actual.Attributes().AsRaw()) {
-		return fmt.Errorf("metric datapoint Attributes doesn't match expected: %v, actual: %v", expected.Attributes().AsRaw(), actual.Attributes().AsRaw())
+		return &DataPointValueMismatchError{Field: "Attributes", Expected: expected.Attributes().AsRaw(), Actual: actual.Attributes().AsRaw()}
 	}
-	return nil
+	return compareExemplarSlices(expected.Exemplars(), actual.Exemplars())
 }
 
 // CompareExponentialHistogramDataPointSlices compares each part of two given ExponentialHistogramDataPointSlices and returns
 // an error if they don't match. The error describes what didn't match.
 func CompareExponentialHistogramDataPointSlices(expected, actual pmetric.ExponentialHistogramDataPointSlice) error {
 	if expected.Len() != actual.Len() {
-		return fmt.Errorf("number of datapoints does not match expected: %d, actual: %d", expected.Len(), actual.Len())
+		return &DataPointCountMismatchError{Expected: expected.Len(), Actual: actual.Len()}
 	}
 
 	numPoints := expected.Len()
@@ -392,36 +456,31 @@ func CompareExponentialHistogramDataPointSlices(expected, actual pmetric.Exponen
 	// Keep track of matching data points so that each point can only be matched once
 	matchingDPS := make(map[pmetric.ExponentialHistogramDataPoint]pmetric.ExponentialHistogramDataPoint, numPoints)
 
+	actualIndex := newAttributesIndex(numPoints, func(i int) pcommon.Map { return actual.At(i).Attributes() })
+
 	var errs error
 	var outOfOrderErrs error
 	for e := 0; e < numPoints; e++ {
 		edp := expected.At(e)
-		var foundMatch bool
-		for a := 0; a < numPoints; a++ {
-			adp := actual.At(a)
-			if _, ok := matchingDPS[adp]; ok {
-				continue
-			}
-			if reflect.DeepEqual(edp.Attributes().AsRaw(), adp.Attributes().AsRaw()) {
-				foundMatch = true
-				matchingDPS[adp] = edp
-				if e != a {
-					outOfOrderErrs = multierr.Append(outOfOrderErrs,
-						fmt.Errorf("datapoint with attributes %v expected at index %d, "+
-							"found a at index %d", edp.Attributes().AsRaw(), e, a))
-				}
-				break
-			}
+		a := actualIndex.match(edp.Attributes())
+		if a < 0 {
+			errs = multierr.Append(errs, &MissingDataPointError{Attributes: edp.Attributes().AsRaw()})
+			continue
 		}
 
-		if !foundMatch {
-			errs = multierr.Append(errs, fmt.Errorf("metric missing expected datapoint with attributes: %v", edp.Attributes().AsRaw()))
+		matchingDPS[actual.At(a)] = edp
+		if e != a {
+			outOfOrderErrs = multierr.Append(outOfOrderErrs, &DataPointOrderError{
+				Attributes:    edp.Attributes().AsRaw(),
+				ExpectedIndex: e,
+				ActualIndex:   a,
+			})
 		}
 	}
 
 	for i := 0; i < numPoints; i++ {
 		if _, ok := matchingDPS[actual.At(i)]; !ok {
-			errs = multierr.Append(errs, fmt.Errorf("metric has extra datapoint with attributes: %v", actual.At(i).Attributes().AsRaw()))
+			errs = multierr.Append(errs, &ExtraDataPointError{Attributes: actual.At(i).Attributes().AsRaw()})
 		}
 	}
 
@@ -434,7 +493,7 @@ func CompareExponentialHistogramDataPointSlices(expected, actual pmetric.Exponen
 
 	for adp, edp := range matchingDPS {
 		if err := CompareExponentialHistogramDataPoints(edp, adp); err != nil {
-			return multierr.Combine(fmt.Errorf("datapoint with attributes: %v, does not match expected", adp.Attributes().AsRaw()), err)
+			return multierr.Combine(&DataPointMismatchError{Attributes: adp.Attributes().AsRaw()}, err)
 		}
 	}
 	return nil
@@ -444,59 +503,57 @@ func CompareExponentialHistogramDataPointSlices(expected, actual pmetric.Exponen
 // an error if they don't match. The error describes what didn't match.
 func CompareExponentialHistogramDataPoints(expected, actual pmetric.ExponentialHistogramDataPoint) error {
 	if expected.HasSum() != actual.HasSum() {
-		return fmt.Errorf("metric datapoint HasSum doesn't match expected: %t, actual: %t", expected.HasSum(), actual.HasSum())
+		return &DataPointValueMismatchError{Field: "HasSum", Expected: expected.HasSum(), Actual: actual.HasSum()}
 	}
 	if expected.HasSum() && expected.Sum() != actual.Sum() {
-		return fmt.Errorf("metric datapoint Sum doesn't match expected: %f, actual: %f", expected.Sum(), actual.Sum())
+		return &DataPointValueMismatchError{Field: "Sum", Expected: expected.Sum(), Actual: actual.Sum()}
 	}
 	if expected.HasMin() != actual.HasMin() {
-		return fmt.Errorf("metric datapoint HasMin doesn't match expected: %t, actual: %t", expected.HasMin(), actual.HasMin())
+		return &DataPointValueMismatchError{Field: "HasMin", Expected: expected.HasMin(), Actual: actual.HasMin()}
 	}
 	if expected.HasMin() && expected.Min() != actual.Min() {
-		return fmt.Errorf("metric datapoint Min doesn't match expected: %f, actual: %f", expected.Min(), actual.Min())
+		return &DataPointValueMismatchError{Field: "Min", Expected: expected.Min(), Actual: actual.Min()}
 	}
 	if expected.HasMax() != actual.HasMax() {
-		return fmt.Errorf("metric datapoint HasMax doesn't match expected: %t, actual: %t", expected.HasMax(), actual.HasMax())
+		return &DataPointValueMismatchError{Field: "HasMax", Expected: expected.HasMax(), Actual: actual.HasMax()}
 	}
 	if expected.HasMax() && expected.Max() != actual.Max() {
-		return fmt.Errorf("metric datapoint Max doesn't match expected: %f, actual: %f", expected.Max(), actual.Max())
+		return &DataPointValueMismatchError{Field: "Max", Expected: expected.Max(), Actual: actual.Max()}
 	}
 	if expected.Count() != actual.Count() {
-		return fmt.Errorf("metric datapoint Count doesn't match expected: %d, actual: %d", expected.Count(), actual.Count())
+		return &DataPointValueMismatchError{Field: "Count", Expected: expected.Count(), Actual: actual.Count()}
 	}
 	if expected.ZeroCount() != actual.ZeroCount() {
-		return fmt.Errorf("metric datapoint ZeroCount doesn't match expected: %d, actual: %d", expected.ZeroCount(), actual.ZeroCount())
+		return &DataPointValueMismatchError{Field: "ZeroCount", Expected: expected.ZeroCount(), Actual: actual.ZeroCount()}
 	}
 	if expected.StartTimestamp() != actual.StartTimestamp() {
-		return fmt.Errorf("metric datapoint StartTimestamp doesn't match expected: %d, actual: %d", expected.StartTimestamp(), actual.StartTimestamp())
+		return &DataPointValueMismatchError{Field: "StartTimestamp", Expected: expected.StartTimestamp(), Actual: actual.StartTimestamp()}
 	}
 	if expected.Timestamp() != actual.Timestamp() {
-		return fmt.Errorf("metric datapoint Timestamp doesn't match expected: %d, actual: %d", expected.Timestamp(), actual.Timestamp())
+		return &DataPointValueMismatchError{Field: "Timestamp", Expected: expected.Timestamp(), Actual: actual.Timestamp()}
 	}
 	if expected.Flags() != actual.Flags() {
-		return fmt.Errorf("metric datapoint Flags doesn't match expected: %d, actual: %d", expected.Flags(), actual.Flags())
+		return &DataPointValueMismatchError{Field: "Flags", Expected: expected.Flags(), Actual: actual.Flags()}
 	}
 	if expected.Scale() != actual.Scale() {
-		return fmt.Errorf("metric datapoint Scale doesn't match expected: %v, actual: %v", expected.Scale(), actual.Scale())
+		return &DataPointValueMismatchError{Field: "Scale", Expected: expected.Scale(), Actual: actual.Scale()}
 	}
 	if expected.Negative().Offset() != actual.Negative().Offset() {
-		return fmt.Errorf("metric datapoint Negative Offset doesn't match expected: %v, actual: %v", expected.Negative().Offset(), actual.Negative().Offset())
+		return &DataPointValueMismatchError{Field: "Negative Offset", Expected: expected.Negative().Offset(), Actual: actual.Negative().Offset()}
 	}
 	if !reflect.DeepEqual(expected.Negative().BucketCounts(), actual.Negative().BucketCounts()) {
-		return fmt.Errorf("metric datapoint Negative BucketCounts doesn't match expected: %v, actual: %v",
-			expected.Negative().BucketCounts().AsRaw(), actual.Negative().BucketCounts().AsRaw())
+		return &DataPointValueMismatchError{Field: "Negative BucketCounts", Expected: expected.Negative().BucketCounts().AsRaw(), Actual: actual.Negative().BucketCounts().AsRaw()}
 	}
 	if expected.Positive().Offset() != actual.Positive().Offset() {
-		return fmt.Errorf("metric datapoint Positive Offset doesn't match expected: %v, actual: %v", expected.Positive().Offset(), actual.Positive().Offset())
+		return &DataPointValueMismatchError{Field: "Positive Offset", Expected: expected.Positive().Offset(), Actual: actual.Positive().Offset()}
 	}
 	if !reflect.DeepEqual(expected.Positive().BucketCounts(), actual.Positive().BucketCounts()) {
-		return fmt.Errorf("metric datapoint Positive BucketCounts doesn't match expected: %v, actual: %v",
-			expected.Positive().BucketCounts().AsRaw(), actual.Positive().BucketCounts().AsRaw())
+		return &DataPointValueMismatchError{Field: "Positive BucketCounts", Expected: expected.Positive().BucketCounts().AsRaw(), Actual: actual.Positive().BucketCounts().AsRaw()}
 	}
 	if !reflect.DeepEqual(expected.Attributes().AsRaw(), actual.Attributes().AsRaw()) {
-		return fmt.Errorf("metric datapoint Attributes doesn't match expected: %v, actual: %v", expected.Attributes().AsRaw(), actual.Attributes().AsRaw())
+		return &DataPointValueMismatchError{Field: "Attributes", Expected: expected.Attributes().AsRaw(), Actual: actual.Attributes().AsRaw()}
 	}
-	return nil
+	return compareExemplarSlices(expected.Exemplars(), actual.Exemplars())
 }
 
 // CompareSummaryDataPointSlices compares each part of two given SummaryDataPoint slices and returns
@@ -504,40 +561,35 @@ func CompareExponentialHistogramDataPoints(expected, actual pmetric.ExponentialH
 func CompareSummaryDataPointSlices(expected, actual pmetric.SummaryDataPointSlice) error {
 	numPoints := expected.Len()
 	if numPoints != actual.Len() {
-		return fmt.Errorf("metric datapoint slice length doesn't match expected: %d, actual: %d", numPoints, actual.Len())
+		return &DataPointCountMismatchError{Expected: numPoints, Actual: actual.Len()}
 	}
 
 	matchingDPS := map[pmetric.SummaryDataPoint]pmetric.SummaryDataPoint{}
+	actualIndex := newAttributesIndex(numPoints, func(i int) pcommon.Map { return actual.At(i).Attributes() })
+
 	var errs error
 	var outOfOrderErrs error
 	for e := 0; e < numPoints; e++ {
 		edp := expected.At(e)
-		var foundMatch bool
-		for a := 0; a < numPoints; a++ {
-			adp := actual.At(a)
-			if _, ok := matchingDPS[adp]; ok {
-				continue
-			}
-			if reflect.DeepEqual(edp.Attributes().AsRaw(), adp.Attributes().AsRaw()) {
-				foundMatch = true
-				matchingDPS[adp] = edp
-				if e != a {
-					outOfOrderErrs = multierr.Append(outOfOrderErrs,
-						fmt.Errorf("datapoint with attributes %v expected at index %d, "+
-							"found a at index %d", edp.Attributes().AsRaw(), e, a))
-				}
-				break
-			}
+		a := actualIndex.match(edp.Attributes())
+		if a < 0 {
+			errs = multierr.Append(errs, &MissingDataPointError{Attributes: edp.Attributes().AsRaw()})
+			continue
 		}
 
-		if !foundMatch {
-			errs = multierr.Append(errs, fmt.Errorf("metric missing expected datapoint with attributes: %v", edp.Attributes().AsRaw()))
+		matchingDPS[actual.At(a)] = edp
+		if e != a {
+			outOfOrderErrs = multierr.Append(outOfOrderErrs, &DataPointOrderError{
+				Attributes:    edp.Attributes().AsRaw(),
+				ExpectedIndex: e,
+				ActualIndex:   a,
+			})
 		}
 	}
 
 	for i := 0; i < numPoints; i++ {
 		if _, ok := matchingDPS[actual.At(i)]; !ok {
-			errs = multierr.Append(errs, fmt.Errorf("metric has extra datapoint with attributes: %v", actual.At(i).Attributes().AsRaw()))
+			errs = multierr.Append(errs, &ExtraDataPointError{Attributes: actual.At(i).Attributes().AsRaw()})
 		}
 	}
 
@@ -550,7 +602,7 @@ func CompareSummaryDataPointSlices(expected, actual pmetric.SummaryDataPointSlic
 
 	for adp, edp := range matchingDPS {
 		if err := CompareSummaryDataPoints(edp, adp); err != nil {
-			return multierr.Combine(fmt.Errorf("datapoint with attributes: %v, does not match expected", adp.Attributes().AsRaw()), err)
+			return multierr.Combine(&DataPointMismatchError{Attributes: adp.Attributes().AsRaw()}, err)
 		}
 	}
 	return nil
@@ -560,37 +612,68 @@ func CompareSummaryDataPointSlices(expected, actual pmetric.SummaryDataPointSlic
 // an error if they don't match. The error describes what didn't match.
 func CompareSummaryDataPoints(expected, actual pmetric.SummaryDataPoint) error {
 	if expected.Count() != actual.Count() {
-		return fmt.Errorf("metric datapoint Count doesn't match expected: %d, actual: %d", expected.Count(), actual.Count())
+		return &DataPointValueMismatchError{Field: "Count", Expected: expected.Count(), Actual: actual.Count()}
 	}
 	if expected.Sum() != actual.Sum() {
-		return fmt.Errorf("metric datapoint Sum doesn't match expected: %f, actual: %f", expected.Sum(), actual.Sum())
+		return &DataPointValueMismatchError{Field: "Sum", Expected: expected.Sum(), Actual: actual.Sum()}
 	}
 	if expected.StartTimestamp() != actual.StartTimestamp() {
-		return fmt.Errorf("metric datapoint StartTimestamp doesn't match expected: %d, actual: %d", expected.StartTimestamp(), actual.StartTimestamp())
+		return &DataPointValueMismatchError{Field: "StartTimestamp", Expected: expected.StartTimestamp(), Actual: actual.StartTimestamp()}
 	}
 	if expected.Timestamp() != actual.Timestamp() {
-		return fmt.Errorf("metric datapoint Timestamp doesn't match expected: %d, actual: %d", expected.Timestamp(), actual.Timestamp())
+		return &DataPointValueMismatchError{Field: "Timestamp", Expected: expected.Timestamp(), Actual: actual.Timestamp()}
 	}
 	if expected.Flags() != actual.Flags() {
-		return fmt.Errorf("metric datapoint Flags doesn't match expected: %d, actual: %d", expected.Flags(), actual.Flags())
+		return &DataPointValueMismatchError{Field: "Flags", Expected: expected.Flags(), Actual: actual.Flags()}
 	}
 	if !reflect.DeepEqual(expected.Attributes().AsRaw(), actual.Attributes().AsRaw()) {
-		return fmt.Errorf("metric datapoint Attributes doesn't match expected: %v, actual: %v", expected.Attributes().AsRaw(), actual.Attributes().AsRaw())
+		return &DataPointValueMismatchError{Field: "Attributes", Expected: expected.Attributes().AsRaw(), Actual: actual.Attributes().AsRaw()}
 	}
 	if expected.QuantileValues().Len() != actual.QuantileValues().Len() {
-		return fmt.Errorf("metric datapoint QuantileValues length doesn't match expected: %d, actual: %d", expected.QuantileValues().Len(), actual.QuantileValues().Len())
+		return &DataPointValueMismatchError{Field: "QuantileValues length", Expected: expected.QuantileValues().Len(), Actual: actual.QuantileValues().Len()}
 	}
 
 	for i := 0; i < expected.QuantileValues().Len(); i++ {
 		eqv, acv := expected.QuantileValues().At(i), actual.QuantileValues().At(i)
 		if eqv.Quantile() != acv.Quantile() {
-			return fmt.Errorf("metric datapoint quantile doesn't match expected: %f, actual: %f", eqv.Quantile(), acv.Quantile())
+			return &DataPointValueMismatchError{Field: "quantile", Expected: eqv.Quantile(), Actual: acv.Quantile()}
 		}
 		if eqv.Value() != acv.Value() {
-			return fmt.Errorf("metric datapoint value at quantile %f doesn't match expected: %f, actual: %f",
-				eqv.Quantile(), eqv.Value(), acv.Value())
+			return &DataPointValueMismatchError{Field: fmt.Sprintf("value at quantile %f", eqv.Quantile()), Expected: eqv.Value(), Actual: acv.Value()}
 		}
 	}
 
 	return nil
 }
+
+// compareExemplarSlices compares the exemplars of two data points and returns
+// an error if they don't match.
+func compareExemplarSlices(expected, actual pmetric.ExemplarSlice) error {
+	expectedRaw, actualRaw := exemplarsAsRaw(expected), exemplarsAsRaw(actual)
+	if !reflect.DeepEqual(expectedRaw, actualRaw) {
+		return &DataPointValueMismatchError{Field: "Exemplars", Expected: expectedRaw, Actual: actualRaw}
+	}
+	return nil
+}
+
+func exemplarsAsRaw(exemplars pmetric.ExemplarSlice) []map[string]any {
+	raw := make([]map[string]any, 0, exemplars.Len())
+	for i := 0; i < exemplars.Len(); i++ {
+		exemplar := exemplars.At(i)
+		traceID, spanID := exemplar.TraceID(), exemplar.SpanID()
+		e := map[string]any{
+			"Timestamp":          exemplar.Timestamp(),
+			"TraceID":            hex.EncodeToString(traceID[:]),
+			"SpanID":             hex.EncodeToString(spanID[:]),
+			"FilteredAttributes": exemplar.FilteredAttributes().AsRaw(),
+		}
+		switch exemplar.ValueType() {
+		case pmetric.ExemplarValueTypeInt:
+			e["IntVal"] = exemplar.IntValue()
+		case pmetric.ExemplarValueTypeDouble:
+			e["DoubleVal"] = exemplar.DoubleValue()
+		}
+		raw = append(raw, e)
+	}
+	return raw
+}
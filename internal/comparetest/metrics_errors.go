@@ -0,0 +1,283 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package comparetest // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/comparetest"
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/pmezard/go-difflib/difflib"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+// The errors below are returned by CompareMetrics and the functions it relies on.
+// When several differences are found, the errors are combined with multierr and
+// can be inspected individually with errors.As or multierr.Errors.
+
+// ResourceCountMismatchError is returned when the number of resources doesn't match.
+type ResourceCountMismatchError struct {
+	Expected int
+	Actual   int
+}
+
+func (e *ResourceCountMismatchError) Error() string {
+	return fmt.Sprintf("number of resources does not match expected: %d, actual: %d", e.Expected, e.Actual)
+}
+
+// MissingResourceError is returned when an expected resource is not found.
+type MissingResourceError struct {
+	Attributes map[string]any
+}
+
+func (e *MissingResourceError) Error() string {
+	return fmt.Sprintf("missing expected resource with attributes: %v", e.Attributes)
+}
+
+// ExtraResourceError is returned when an actual resource is not expected.
+type ExtraResourceError struct {
+	Attributes map[string]any
+}
+
+func (e *ExtraResourceError) Error() string {
+	return fmt.Sprintf("extra resource with attributes: %v", e.Attributes)
+}
+
+// ResourceOrderError is returned when a resource is not found at the expected index.
+type ResourceOrderError struct {
+	Attributes    map[string]any
+	ExpectedIndex int
+	ActualIndex   int
+}
+
+func (e *ResourceOrderError) Error() string {
+	return fmt.Sprintf("ResourceMetrics with attributes %v expected at index %d, found a at index %d",
+		e.Attributes, e.ExpectedIndex, e.ActualIndex)
+}
+
+// ResourceSchemaURLMismatchError is returned when the schema URL of a resource doesn't match.
+type ResourceSchemaURLMismatchError struct {
+	Attributes map[string]any
+	Expected   string
+	Actual     string
+}
+
+func (e *ResourceSchemaURLMismatchError) Error() string {
+	return fmt.Sprintf("resource with attributes %v SchemaURL does not match expected: %s, actual: %s",
+		e.Attributes, e.Expected, e.Actual)
+}
+
+// ScopeCountMismatchError is returned when the number of scopes of a resource doesn't match.
+type ScopeCountMismatchError struct {
+	Expected int
+	Actual   int
+}
+
+func (e *ScopeCountMismatchError) Error() string {
+	return fmt.Sprintf("number of instrumentation libraries does not match expected: %d, actual: %d", e.Expected, e.Actual)
+}
+
+// ScopeMismatchError is returned when a field of a scope, e.g. Name, Version, Attributes or SchemaURL, doesn't match.
+type ScopeMismatchError struct {
+	Field    string
+	Expected string
+	Actual   string
+}
+
+func (e *ScopeMismatchError) Error() string {
+	return fmt.Sprintf("instrumentation library %s does not match expected: %s, actual: %s", e.Field, e.Expected, e.Actual)
+}
+
+// MetricCountMismatchError is returned when the number of metrics of a scope doesn't match.
+type MetricCountMismatchError struct {
+	Expected int
+	Actual   int
+}
+
+func (e *MetricCountMismatchError) Error() string {
+	return fmt.Sprintf("number of metrics does not match expected: %d, actual: %d", e.Expected, e.Actual)
+}
+
+// MissingMetricError is returned when an expected metric is not found.
+type MissingMetricError struct {
+	MetricName string
+}
+
+func (e *MissingMetricError) Error() string {
+	return fmt.Sprintf("missing expected metric: %s", e.MetricName)
+}
+
+// UnexpectedMetricError is returned when an actual metric is not expected.
+type UnexpectedMetricError struct {
+	MetricName string
+}
+
+func (e *UnexpectedMetricError) Error() string {
+	return fmt.Sprintf("unexpected metric: %s", e.MetricName)
+}
+
+// MetricOrderError is returned when a metric is not found at the expected index.
+type MetricOrderError struct {
+	ExpectedMetricName string
+	ActualMetricName   string
+	Index              int
+}
+
+func (e *MetricOrderError) Error() string {
+	return fmt.Sprintf("metrics are out of order, metric %s expected at index %d, actual: %s",
+		e.ExpectedMetricName, e.Index, e.ActualMetricName)
+}
+
+// MetricMismatchError is returned when a field of a metric, e.g. Unit or DataType, doesn't match.
+type MetricMismatchError struct {
+	MetricName string
+	Field      string
+	Expected   any
+	Actual     any
+}
+
+func (e *MetricMismatchError) Error() string {
+	return fmt.Sprintf("metric %s does not match expected: %v, actual: %v", e.Field, e.Expected, e.Actual)
+}
+
+// MetricDataPointsMismatchError is returned along with the errors describing
+// the differences between the data points of a metric.
+type MetricDataPointsMismatchError struct {
+	MetricName string
+}
+
+func (e *MetricDataPointsMismatchError) Error() string {
+	return fmt.Sprintf("datapoints for metric: `%s`, do not match expected", e.MetricName)
+}
+
+// DataPointCountMismatchError is returned when the number of data points of a metric doesn't match.
+type DataPointCountMismatchError struct {
+	Expected int
+	Actual   int
+}
+
+func (e *DataPointCountMismatchError) Error() string {
+	return fmt.Sprintf("number of datapoints does not match expected: %d, actual: %d", e.Expected, e.Actual)
+}
+
+// MissingDataPointError is returned when an expected data point is not found.
+type MissingDataPointError struct {
+	Attributes map[string]any
+}
+
+func (e *MissingDataPointError) Error() string {
+	return fmt.Sprintf("metric missing expected datapoint with attributes: %v", e.Attributes)
+}
+
+// ExtraDataPointError is returned when an actual data point is not expected.
+type ExtraDataPointError struct {
+	Attributes map[string]any
+}
+
+func (e *ExtraDataPointError) Error() string {
+	return fmt.Sprintf("metric has extra datapoint with attributes: %v", e.Attributes)
+}
+
+// DataPointOrderError is returned when a data point is not found at the expected index.
+type DataPointOrderError struct {
+	Attributes    map[string]any
+	ExpectedIndex int
+	ActualIndex   int
+}
+
+func (e *DataPointOrderError) Error() string {
+	return fmt.Sprintf("datapoints are out of order, datapoint with attributes %v expected at index %d, found a at index %d",
+		e.Attributes, e.ExpectedIndex, e.ActualIndex)
+}
+
+// DataPointMismatchError is returned along with the error describing
+// the difference between two data points.
+type DataPointMismatchError struct {
+	Attributes map[string]any
+}
+
+func (e *DataPointMismatchError) Error() string {
+	return fmt.Sprintf("datapoint with attributes: %v, does not match expected", e.Attributes)
+}
+
+// DataPointValueTypeMismatchError is returned when the value type of a number data point doesn't match.
+type DataPointValueTypeMismatchError struct {
+	Expected pmetric.NumberDataPointValueType
+	Actual   pmetric.NumberDataPointValueType
+}
+
+func (e *DataPointValueTypeMismatchError) Error() string {
+	return fmt.Sprintf("metric datapoint types don't match: expected type: %s, actual type: %s", e.Expected, e.Actual)
+}
+
+// DataPointValueMismatchError is returned when a field of a data point, e.g. IntVal or Count, doesn't match.
+type DataPointValueMismatchError struct {
+	Field    string
+	Expected any
+	Actual   any
+}
+
+func (e *DataPointValueMismatchError) Error() string {
+	verb := "%v"
+	switch reflect.ValueOf(e.Expected).Kind() {
+	case reflect.Bool:
+		verb = "%t"
+	case reflect.Float32, reflect.Float64:
+		verb = "%f"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		verb = "%d"
+	}
+	return fmt.Sprintf("metric datapoint %s doesn't match expected: "+verb+", actual: "+verb, e.Field, e.Expected, e.Actual)
+}
+
+// MetricsDiffError is returned along with the other errors when the IncludeUnifiedDiff option is used.
+type MetricsDiffError struct {
+	// Diff is the unified diff of the JSON representation of the expected and actual metrics.
+	Diff string
+}
+
+func (e *MetricsDiffError) Error() string {
+	return "expected and actual metrics differ:\n" + e.Diff
+}
+
+func newMetricsDiffError(expected, actual pmetric.Metrics) *MetricsDiffError {
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(metricsToIndentedJSON(expected)),
+		B:        difflib.SplitLines(metricsToIndentedJSON(actual)),
+		FromFile: "expected",
+		ToFile:   "actual",
+		Context:  3,
+	})
+	if err != nil {
+		diff = fmt.Sprintf("failed to compute diff: %v", err)
+	}
+	return &MetricsDiffError{Diff: diff}
+}
+
+// metricsToIndentedJSON returns the indented OTLP JSON representation of the metrics, one field per line.
+func metricsToIndentedJSON(metrics pmetric.Metrics) string {
+	raw, err := (&pmetric.JSONMarshaler{}).MarshalMetrics(metrics)
+	if err != nil {
+		return fmt.Sprintf("failed to marshal metrics: %v", err)
+	}
+	var buf bytes.Buffer
+	if err = json.Indent(&buf, raw, "", "  "); err != nil {
+		return string(raw)
+	}
+	buf.WriteString("\n")
+	return buf.String()
+}
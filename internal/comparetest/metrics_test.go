@@ -20,6 +20,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.uber.org/multierr"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/comparetest/golden"
@@ -80,6 +81,111 @@ func TestCompareMetrics(t *testing.T) {
 				reason: "An instrumentation library with a different name is a different library.",
 			},
 		},
+		{
+			name: "scope-attributes-mismatch",
+			withoutOptions: expectation{
+				err:    errors.New("instrumentation library Attributes does not match expected: map[library.mode:sync], actual: map[library.mode:async]"),
+				reason: "An instrumentation scope with different attributes should cause a failure.",
+			},
+		},
+		{
+			name: "ignore-scope-attribute",
+			compareOptions: []MetricsCompareOption{
+				IgnoreScopeAttributeValue("library.instance"),
+			},
+			withoutOptions: expectation{
+				err:    errors.New("instrumentation library Attributes does not match expected: map[library.instance:a-random-id library.mode:sync], actual: map[library.instance:a-different-random-id library.mode:sync]"),
+				reason: "An unpredictable scope attribute will cause failures if not ignored.",
+			},
+			withOptions: expectation{
+				err:    nil,
+				reason: "The unpredictable scope attribute was ignored.",
+			},
+		},
+		{
+			name: "ignore-scope-version",
+			compareOptions: []MetricsCompareOption{
+				IgnoreScopeVersion(),
+			},
+			withoutOptions: expectation{
+				err:    errors.New("instrumentation library Version does not match expected: 1.0, actual: 2.0"),
+				reason: "An unpredictable scope version will cause failures if not ignored.",
+			},
+			withOptions: expectation{
+				err:    nil,
+				reason: "The scope version was ignored.",
+			},
+		},
+		{
+			name: "resource-schema-url-mismatch",
+			withoutOptions: expectation{
+				err:    errors.New("resource with attributes map[host.name:host1] SchemaURL does not match expected: https://opentelemetry.io/schemas/1.9.0, actual: https://opentelemetry.io/schemas/1.6.1"),
+				reason: "A resource with a different schema URL should cause a failure.",
+			},
+		},
+		{
+			name: "scope-schema-url-mismatch",
+			withoutOptions: expectation{
+				err:    errors.New("instrumentation library SchemaURL does not match expected: https://opentelemetry.io/schemas/1.9.0, actual: "),
+				reason: "A scope with a missing schema URL should cause a failure.",
+			},
+		},
+		{
+			name: "ignore-schema-url",
+			compareOptions: []MetricsCompareOption{
+				IgnoreSchemaURL(),
+			},
+			withoutOptions: expectation{
+				err:    errors.New("resource with attributes map[host.name:host1] SchemaURL does not match expected: https://opentelemetry.io/schemas/1.9.0, actual: https://opentelemetry.io/schemas/1.6.1"),
+				reason: "Different resource and scope schema URLs will cause failures if not ignored.",
+			},
+			withOptions: expectation{
+				err:    nil,
+				reason: "The resource and scope schema URLs were ignored.",
+			},
+		},
+		{
+			name: "ignore-scope-version-name-mismatch",
+			compareOptions: []MetricsCompareOption{
+				IgnoreScopeVersion(),
+			},
+			withoutOptions: expectation{
+				err:    errors.New("instrumentation library Name does not match expected: one, actual: two"),
+				reason: "An instrumentation library with a different name is a different library.",
+			},
+			withOptions: expectation{
+				err:    errors.New("instrumentation library Name does not match expected: one, actual: two"),
+				reason: "The scope name should still be compared when the version is ignored.",
+			},
+		},
+		{
+			name: "ignore-metric-description",
+			compareOptions: []MetricsCompareOption{
+				IgnoreMetricDescription(),
+			},
+			withoutOptions: expectation{
+				err:    errors.New("metric Description does not match expected: Gauge One, actual: Gauge One (deprecated)"),
+				reason: "A metric description that differs across versions will cause failures if not ignored.",
+			},
+			withOptions: expectation{
+				err:    nil,
+				reason: "The metric descriptions were ignored.",
+			},
+		},
+		{
+			name: "ignore-metric-unit",
+			compareOptions: []MetricsCompareOption{
+				IgnoreMetricUnit(),
+			},
+			withoutOptions: expectation{
+				err:    errors.New("metric Unit does not match expected: 1, actual: {items}"),
+				reason: "A metric unit that differs across versions will cause failures if not ignored.",
+			},
+			withOptions: expectation{
+				err:    nil,
+				reason: "The metric units were ignored.",
+			},
+		},
 		{
 			name: "resource-instrumentation-library-version-mismatch",
 			withoutOptions: expectation{
@@ -359,6 +465,46 @@ func TestCompareMetrics(t *testing.T) {
 				),
 			},
 		},
+		{
+			name: "ignore-exp-histogram-scale",
+			compareOptions: []MetricsCompareOption{
+				IgnoreExponentialHistogramScale(),
+			},
+			withoutOptions: expectation{
+				err: multierr.Combine(
+					errors.New("datapoints for metric: `exponential_histogram.one`, do not match expected"),
+					errors.New("datapoint with attributes: map[], does not match expected"),
+					errors.New("metric datapoint Scale doesn't match expected: 1, actual: 0"),
+				),
+				reason: "A histogram rescaled by the component under test will cause failures if the scale is not ignored.",
+			},
+			withOptions: expectation{
+				err:    nil,
+				reason: "Both histograms describe the same distribution once downscaled to the common scale.",
+			},
+		},
+		{
+			name: "ignore-exp-histogram-scale-mismatch",
+			compareOptions: []MetricsCompareOption{
+				IgnoreExponentialHistogramScale("exponential_histogram.one"),
+			},
+			withoutOptions: expectation{
+				err: multierr.Combine(
+					errors.New("datapoints for metric: `exponential_histogram.one`, do not match expected"),
+					errors.New("datapoint with attributes: map[], does not match expected"),
+					errors.New("metric datapoint Scale doesn't match expected: 1, actual: 0"),
+				),
+				reason: "A histogram rescaled by the component under test will cause failures if the scale is not ignored.",
+			},
+			withOptions: expectation{
+				err: multierr.Combine(
+					errors.New("datapoints for metric: `exponential_histogram.one`, do not match expected"),
+					errors.New("datapoint with attributes: map[], does not match expected"),
+					errors.New("metric datapoint Positive BucketCounts doesn't match expected: [1 5 4], actual: [1 4 5]"),
+				),
+				reason: "Histograms describing different distributions should cause a failure at the common scale.",
+			},
+		},
 		{
 			name: "summary-data-point-count-mismatch",
 			withoutOptions: expectation{
@@ -405,7 +551,43 @@ func TestCompareMetrics(t *testing.T) {
 			name: "ignore-timestamp",
 			withoutOptions: expectation{
 				err:    nil,
-				reason: "Timestamps are always ignored, so no error is expected.",
+				reason: "Timestamps are ignored by default, so no error is expected.",
+			},
+		},
+		{
+			name: "compare-timestamps",
+			compareOptions: []MetricsCompareOption{
+				CompareTimestamps(),
+			},
+			withoutOptions: expectation{
+				err:    nil,
+				reason: "Timestamps are ignored by default, so no error is expected.",
+			},
+			withOptions: expectation{
+				err: multierr.Combine(
+					errors.New("datapoints for metric: `gauge.one`, do not match expected"),
+					errors.New("datapoint with attributes: map[], does not match expected"),
+					errors.New("metric datapoint Timestamp doesn't match expected: 1000000000, actual: 1500000000"),
+				),
+				reason: "A data point timestamp that differs from the expected timestamp should cause a failure when compared.",
+			},
+		},
+		{
+			name: "compare-start-timestamps",
+			compareOptions: []MetricsCompareOption{
+				CompareStartTimestamps(),
+			},
+			withoutOptions: expectation{
+				err:    nil,
+				reason: "Start timestamps are ignored by default, so no error is expected.",
+			},
+			withOptions: expectation{
+				err: multierr.Combine(
+					errors.New("datapoints for metric: `sum.one`, do not match expected"),
+					errors.New("datapoint with attributes: map[], does not match expected"),
+					errors.New("metric datapoint StartTimestamp doesn't match expected: 1000000000, actual: 1500000000"),
+				),
+				reason: "Only the start timestamps are compared, so the gauge timestamp mismatch is still ignored.",
 			},
 		},
 		{
@@ -422,6 +604,156 @@ func TestCompareMetrics(t *testing.T) {
 				reason: "An unpredictable data point value will cause failures if not ignored.",
 			},
 		},
+		{
+			name: "ignore-data-point-value-with-attributes",
+			compareOptions: []MetricsCompareOption{
+				IgnoreMetricValuesWithAttributes(map[string]string{"state": "used"}),
+			},
+			withoutOptions: expectation{
+				err: multierr.Combine(
+					errors.New("datapoints for metric: `sum.one`, do not match expected"),
+					errors.New("datapoint with attributes: map[state:used], does not match expected"),
+					errors.New("metric datapoint IntVal doesn't match expected: 123, actual: 654"),
+				),
+				reason: "An unpredictable data point value will cause failures if not ignored.",
+			},
+			withOptions: expectation{
+				err:    nil,
+				reason: "The value of the data point with the given attributes was ignored.",
+			},
+		},
+		{
+			name: "ignore-data-point-value-with-attributes-mismatch",
+			compareOptions: []MetricsCompareOption{
+				IgnoreMetricValuesWithAttributes(map[string]string{"state": "used"}, "sum.one"),
+			},
+			withoutOptions: expectation{
+				err: multierr.Combine(
+					errors.New("datapoints for metric: `sum.one`, do not match expected"),
+					errors.New("datapoint with attributes: map[state:free], does not match expected"),
+					errors.New("metric datapoint IntVal doesn't match expected: 10, actual: 20"),
+				),
+				reason: "A data point value mismatch will cause failures.",
+			},
+			withOptions: expectation{
+				err: multierr.Combine(
+					errors.New("datapoints for metric: `sum.one`, do not match expected"),
+					errors.New("datapoint with attributes: map[state:free], does not match expected"),
+					errors.New("metric datapoint IntVal doesn't match expected: 10, actual: 20"),
+				),
+				reason: "The values of the data points with other attributes should still be compared.",
+			},
+		},
+		{
+			name: "ignore-data-point-value-with-attributes-histogram",
+			compareOptions: []MetricsCompareOption{
+				IgnoreMetricValuesWithAttributes(map[string]string{"state": "used"}),
+			},
+			withoutOptions: expectation{
+				err: multierr.Combine(
+					errors.New("datapoints for metric: `histogram.one`, do not match expected"),
+					errors.New("datapoint with attributes: map[state:used], does not match expected"),
+					errors.New("metric datapoint Count doesn't match expected: 123, actual: 654"),
+				),
+				reason: "An unpredictable histogram data point will cause failures if not ignored.",
+			},
+			withOptions: expectation{
+				err:    nil,
+				reason: "The count and bucket counts of the histogram data point with the given attributes were ignored.",
+			},
+		},
+		{
+			name: "ignore-data-point-value-with-attributes-summary",
+			compareOptions: []MetricsCompareOption{
+				IgnoreMetricValuesWithAttributes(map[string]string{"state": "used"}),
+			},
+			withoutOptions: expectation{
+				err: multierr.Combine(
+					errors.New("datapoints for metric: `summary.one`, do not match expected"),
+					errors.New("datapoint with attributes: map[state:used], does not match expected"),
+					errors.New("metric datapoint Count doesn't match expected: 123, actual: 654"),
+				),
+				reason: "An unpredictable summary data point will cause failures if not ignored.",
+			},
+			withOptions: expectation{
+				err:    nil,
+				reason: "The count and quantile values of the summary data point with the given attributes were ignored.",
+			},
+		},
+		{
+			name: "allow-extra-resources",
+			compareOptions: []MetricsCompareOption{
+				AllowExtraResources(),
+			},
+			withoutOptions: expectation{
+				err:    errors.New("number of resources does not match expected: 1, actual: 2"),
+				reason: "An extra resource should cause a failure.",
+			},
+			withOptions: expectation{
+				err:    nil,
+				reason: "The extra resource was allowed.",
+			},
+		},
+		{
+			name: "ignore-metrics",
+			compareOptions: []MetricsCompareOption{
+				IgnoreMetrics("node.pressure.cpu", "node.handles"),
+			},
+			withoutOptions: expectation{
+				err:    errors.New("number of metrics does not match expected: 2, actual: 1"),
+				reason: "A metric missing on a platform should cause a failure.",
+			},
+			withOptions: expectation{
+				err:    nil,
+				reason: "The metric missing on a platform was ignored.",
+			},
+		},
+		{
+			name: "ignore-metrics-extra",
+			compareOptions: []MetricsCompareOption{
+				IgnoreMetrics("node.pressure.cpu", "node.handles"),
+			},
+			withoutOptions: expectation{
+				err:    errors.New("number of metrics does not match expected: 1, actual: 2"),
+				reason: "A metric only emitted on a platform should cause a failure.",
+			},
+			withOptions: expectation{
+				err:    nil,
+				reason: "The metric only emitted on a platform was ignored.",
+			},
+		},
+		{
+			name: "allow-extra-metrics",
+			compareOptions: []MetricsCompareOption{
+				AllowExtraMetrics(),
+			},
+			withoutOptions: expectation{
+				err:    errors.New("number of metrics does not match expected: 1, actual: 2"),
+				reason: "A metric slice with an extra metric should cause a failure.",
+			},
+			withOptions: expectation{
+				err:    nil,
+				reason: "The extra metric was allowed.",
+			},
+		},
+		{
+			name: "allow-extra-metrics-value-mismatch",
+			compareOptions: []MetricsCompareOption{
+				AllowExtraMetrics(),
+			},
+			withoutOptions: expectation{
+				err:    errors.New("number of metrics does not match expected: 1, actual: 2"),
+				reason: "A metric slice with an extra metric should cause a failure.",
+			},
+			withOptions: expectation{
+				err: multierr.Combine(
+					errors.New("datapoints for metric: `gauge.one`, do not match expected"),
+					errors.New("datapoint with attributes: map[], does not match expected"),
+					errors.New("metric datapoint IntVal doesn't match expected: 123, actual: 456"),
+				),
+				reason: "The expected metrics should still be compared.",
+			},
+		},
 		{
 			name: "ignore-data-point-value-int-mismatch",
 			compareOptions: []MetricsCompareOption{
@@ -436,6 +768,56 @@ func TestCompareMetrics(t *testing.T) {
 				reason: "An unpredictable data point value will cause failures if not ignored.",
 			},
 		},
+		{
+			name: "tolerance-data-point-value-double",
+			compareOptions: []MetricsCompareOption{
+				CompareMetricValuesWithTolerance(0.1),
+			},
+			withoutOptions: expectation{
+				err: multierr.Combine(
+					errors.New("datapoints for metric: `gauge.one`, do not match expected"),
+					errors.New("datapoint with attributes: map[], does not match expected"),
+					errors.New("metric datapoint DoubleVal doesn't match expected: 123.456000, actual: 123.500000"),
+				),
+				reason: "A data point value that differs from the expected value should cause a failure.",
+			},
+		},
+		{
+			name: "tolerance-data-point-value-int",
+			compareOptions: []MetricsCompareOption{
+				CompareMetricValuesWithRelativeTolerance(0.05, "sum.one"),
+			},
+			withoutOptions: expectation{
+				err: multierr.Combine(
+					errors.New("datapoints for metric: `sum.one`, do not match expected"),
+					errors.New("datapoint with attributes: map[], does not match expected"),
+					errors.New("metric datapoint IntVal doesn't match expected: 100, actual: 103"),
+				),
+				reason: "A data point value that differs from the expected value should cause a failure.",
+			},
+		},
+		{
+			name: "tolerance-data-point-value-exceeded",
+			compareOptions: []MetricsCompareOption{
+				CompareMetricValuesWithTolerance(0.1),
+			},
+			withoutOptions: expectation{
+				err: multierr.Combine(
+					errors.New("datapoints for metric: `gauge.one`, do not match expected"),
+					errors.New("datapoint with attributes: map[], does not match expected"),
+					errors.New("metric datapoint DoubleVal doesn't match expected: 123.456000, actual: 124.500000"),
+				),
+				reason: "A data point value that differs from the expected value should cause a failure.",
+			},
+			withOptions: expectation{
+				err: multierr.Combine(
+					errors.New("datapoints for metric: `gauge.one`, do not match expected"),
+					errors.New("datapoint with attributes: map[], does not match expected"),
+					errors.New("metric datapoint DoubleVal doesn't match expected: 123.456000, actual: 124.500000"),
+				),
+				reason: "A data point value outside of the tolerance should still cause a failure.",
+			},
+		},
 		{
 			name: "ignore-subsequent-data-points-all",
 			compareOptions: []MetricsCompareOption{
@@ -462,6 +844,84 @@ func TestCompareMetrics(t *testing.T) {
 				reason: "An unpredictable data point value will cause failures if not ignored.",
 			},
 		},
+		{
+			name: "ignore-exemplars",
+			compareOptions: []MetricsCompareOption{
+				IgnoreExemplars(),
+			},
+			withoutOptions: expectation{
+				err: multierr.Combine(
+					errors.New("datapoints for metric: `sum.one`, do not match expected"),
+					errors.New("datapoint with attributes: map[], does not match expected"),
+					errors.New("metric datapoint Exemplars doesn't match expected: "+
+						"[map[FilteredAttributes:map[] IntVal:7 SpanID:0102030405060708 Timestamp:1970-01-01 00:00:01 +0000 UTC TraceID:0102030405060708090a0b0c0d0e0f10]], "+
+						"actual: [map[FilteredAttributes:map[] IntVal:7 SpanID:0102030405060708 Timestamp:1970-01-01 00:00:01 +0000 UTC TraceID:1112131415161718191a1b1c1d1e1f20]]"),
+				),
+				reason: "Exemplars with unpredictable trace IDs will cause failures if not ignored.",
+			},
+		},
+		{
+			name: "ignore-exemplars-one",
+			compareOptions: []MetricsCompareOption{
+				IgnoreExemplars("sum.one"),
+			},
+			withoutOptions: expectation{
+				err: multierr.Combine(
+					errors.New("datapoints for metric: `sum.one`, do not match expected"),
+					errors.New("datapoint with attributes: map[], does not match expected"),
+					errors.New("metric datapoint Exemplars doesn't match expected: "+
+						"[map[FilteredAttributes:map[] IntVal:7 SpanID:0102030405060708 Timestamp:1970-01-01 00:00:01 +0000 UTC TraceID:0102030405060708090a0b0c0d0e0f10]], "+
+						"actual: [map[FilteredAttributes:map[] IntVal:7 SpanID:0102030405060708 Timestamp:1970-01-01 00:00:01 +0000 UTC TraceID:1112131415161718191a1b1c1d1e1f20]]"),
+				),
+				reason: "Exemplars with unpredictable trace IDs will cause failures if not ignored.",
+			},
+			withOptions: expectation{
+				err: multierr.Combine(
+					errors.New("datapoints for metric: `histogram.one`, do not match expected"),
+					errors.New("datapoint with attributes: map[], does not match expected"),
+					errors.New("metric datapoint Exemplars doesn't match expected: "+
+						"[map[DoubleVal:1.5 FilteredAttributes:map[] SpanID:0000000000000000 Timestamp:1970-01-01 00:00:01 +0000 UTC TraceID:00000000000000000000000000000000]], "+
+						"actual: [map[DoubleVal:1.5 FilteredAttributes:map[] SpanID:0000000000000000 Timestamp:1970-01-01 00:00:02 +0000 UTC TraceID:00000000000000000000000000000000]]"),
+				),
+				reason: "The exemplars of the other metrics should still be compared.",
+			},
+		},
+		{
+			name: "ignore-data-point-flags",
+			compareOptions: []MetricsCompareOption{
+				IgnoreDataPointFlags(),
+			},
+			withoutOptions: expectation{
+				err: multierr.Combine(
+					errors.New("datapoints for metric: `gauge.one`, do not match expected"),
+					errors.New("datapoint with attributes: map[], does not match expected"),
+					errors.New("metric datapoint Flags doesn't match expected: 0, actual: 1"),
+				),
+				reason: "Data point flags that are set intermittently will cause failures if not ignored.",
+			},
+		},
+		{
+			name: "ignore-data-point-flags-one",
+			compareOptions: []MetricsCompareOption{
+				IgnoreDataPointFlags("gauge.one"),
+			},
+			withoutOptions: expectation{
+				err: multierr.Combine(
+					errors.New("datapoints for metric: `gauge.one`, do not match expected"),
+					errors.New("datapoint with attributes: map[], does not match expected"),
+					errors.New("metric datapoint Flags doesn't match expected: 0, actual: 1"),
+				),
+				reason: "Data point flags that are set intermittently will cause failures if not ignored.",
+			},
+			withOptions: expectation{
+				err: multierr.Combine(
+					errors.New("datapoints for metric: `histogram.one`, do not match expected"),
+					errors.New("datapoint with attributes: map[], does not match expected"),
+					errors.New("metric datapoint Flags doesn't match expected: 0, actual: 1"),
+				),
+				reason: "The flags of the other metrics should still be compared.",
+			},
+		},
 		{
 			name: "ignore-single-metric",
 			compareOptions: []MetricsCompareOption{
@@ -520,6 +980,50 @@ func TestCompareMetrics(t *testing.T) {
 				reason: "Although the unpredictable attribute was ignored on one metric, it was not ignored on another.",
 			},
 		},
+		{
+			name: "match-attribute-value",
+			compareOptions: []MetricsCompareOption{
+				MatchMetricAttributeValue("version", `^\d+\.\d+\.\d+$`),
+			},
+			withoutOptions: expectation{
+				err: multierr.Combine(
+					errors.New("datapoints for metric: `gauge.one`, do not match expected"),
+					errors.New("metric missing expected datapoint with attributes: map[attribute.two:value A version:1.0.0]"),
+					errors.New("metric missing expected datapoint with attributes: map[attribute.two:value B version:1.0.0]"),
+					errors.New("metric has extra datapoint with attributes: map[attribute.two:value A version:1.2.3]"),
+					errors.New("metric has extra datapoint with attributes: map[attribute.two:value B version:1.2.3]"),
+				),
+				reason: "An unpredictable attribute value will cause failures if not matched.",
+			},
+			withOptions: expectation{
+				err:    nil,
+				reason: "The unpredictable attribute values matched the pattern on all metrics that carried it.",
+			},
+		},
+		{
+			name: "match-attribute-value-mismatch",
+			compareOptions: []MetricsCompareOption{
+				MatchMetricAttributeValue("version", `^\d+\.\d+\.\d+$`, "gauge.one"),
+			},
+			withoutOptions: expectation{
+				err: multierr.Combine(
+					errors.New("datapoints for metric: `gauge.one`, do not match expected"),
+					errors.New("metric missing expected datapoint with attributes: map[attribute.two:value A version:1.0.0]"),
+					errors.New("metric missing expected datapoint with attributes: map[attribute.two:value B version:1.0.0]"),
+					errors.New("metric has extra datapoint with attributes: map[attribute.two:value A version:1.2.3]"),
+					errors.New("metric has extra datapoint with attributes: map[attribute.two:value B version:unknown]"),
+				),
+				reason: "An unpredictable attribute value will cause failures if not matched.",
+			},
+			withOptions: expectation{
+				err: multierr.Combine(
+					errors.New("datapoints for metric: `gauge.one`, do not match expected"),
+					errors.New("metric missing expected datapoint with attributes: map[attribute.two:value B version:]"),
+					errors.New("metric has extra datapoint with attributes: map[attribute.two:value B version:unknown]"),
+				),
+				reason: "An attribute value that does not match the pattern should still cause a failure.",
+			},
+		},
 		{
 			name: "ignore-one-resource-attribute",
 			compareOptions: []MetricsCompareOption{
@@ -537,6 +1041,59 @@ func TestCompareMetrics(t *testing.T) {
 				reason: "The unpredictable resource attribute was ignored on each resource that carried it.",
 			},
 		},
+		{
+			name: "ignore-resource-attribute-glob",
+			compareOptions: []MetricsCompareOption{
+				IgnoreResourceAttributeValue("k8s.pod.labels.*"),
+			},
+			withoutOptions: expectation{
+				err: multierr.Combine(
+					errors.New("missing expected resource with attributes: map[k8s.pod.labels.app:expected-app k8s.pod.labels.version:v1 k8s.pod.name:pod-a]"),
+					errors.New("extra resource with attributes: map[k8s.pod.labels.app:actual-app k8s.pod.labels.version:v2 k8s.pod.name:pod-a]"),
+				),
+				reason: "Unpredictable resource attributes will cause failures if not ignored.",
+			},
+			withOptions: expectation{
+				err:    nil,
+				reason: "The resource attributes matching the pattern were ignored.",
+			},
+		},
+		{
+			name: "ignore-attribute-value-glob",
+			compareOptions: []MetricsCompareOption{
+				IgnoreMetricAttributeValue("label.*"),
+			},
+			withoutOptions: expectation{
+				err: multierr.Combine(
+					errors.New("datapoints for metric: `gauge.one`, do not match expected"),
+					errors.New("metric missing expected datapoint with attributes: map[label.one:expected-one label.two:expected-two state:used]"),
+					errors.New("metric has extra datapoint with attributes: map[label.one:actual-one label.two:actual-two state:used]"),
+				),
+				reason: "Unpredictable attribute values will cause failures if not ignored.",
+			},
+			withOptions: expectation{
+				err:    nil,
+				reason: "The values of the attributes matching the pattern were ignored.",
+			},
+		},
+		{
+			name: "ignore-attribute-value-glob-non-string",
+			compareOptions: []MetricsCompareOption{
+				IgnoreMetricAttributeValue("label.*"),
+			},
+			withoutOptions: expectation{
+				err: multierr.Combine(
+					errors.New("datapoints for metric: `gauge.one`, do not match expected"),
+					errors.New("metric missing expected datapoint with attributes: map[label.count:1 label.enabled:true label.one:expected-one state:used]"),
+					errors.New("metric has extra datapoint with attributes: map[label.count:2 label.enabled:false label.one:actual-one state:used]"),
+				),
+				reason: "Unpredictable attribute values will cause failures if not ignored.",
+			},
+			withOptions: expectation{
+				err:    nil,
+				reason: "The values of the matching attributes were ignored whatever their type.",
+			},
+		},
 		{
 			name: "ignore-resource-order",
 			compareOptions: []MetricsCompareOption{
@@ -694,3 +1251,106 @@ func TestCompareMetrics(t *testing.T) {
 		})
 	}
 }
+
+func TestCompareMetricsErrorTypes(t *testing.T) {
+	tcs := []struct {
+		name     string
+		validate func(t *testing.T, err error)
+	}{
+		{
+			name: "resource-extra",
+			validate: func(t *testing.T, err error) {
+				var target *ResourceCountMismatchError
+				require.True(t, errors.As(err, &target))
+				require.Equal(t, &ResourceCountMismatchError{Expected: 1, Actual: 2}, target)
+			},
+		},
+		{
+			name: "metric-slice-missing",
+			validate: func(t *testing.T, err error) {
+				var target *MetricCountMismatchError
+				require.True(t, errors.As(err, &target))
+				require.Equal(t, &MetricCountMismatchError{Expected: 1, Actual: 0}, target)
+			},
+		},
+		{
+			name: "metric-type-expect-gauge",
+			validate: func(t *testing.T, err error) {
+				var target *MetricMismatchError
+				require.True(t, errors.As(err, &target))
+				require.Equal(t, "DataType", target.Field)
+				require.Equal(t, pmetric.MetricTypeGauge, target.Expected)
+				require.Equal(t, pmetric.MetricTypeSum, target.Actual)
+			},
+		},
+		{
+			name: "data-point-value-int-mismatch",
+			validate: func(t *testing.T, err error) {
+				var metricErr *MetricDataPointsMismatchError
+				require.True(t, errors.As(err, &metricErr))
+				require.Equal(t, "sum.one", metricErr.MetricName)
+
+				var dataPointErr *DataPointMismatchError
+				require.True(t, errors.As(err, &dataPointErr))
+				require.Equal(t, map[string]any{}, dataPointErr.Attributes)
+
+				var valueErr *DataPointValueMismatchError
+				require.True(t, errors.As(err, &valueErr))
+				require.Equal(t, &DataPointValueMismatchError{Field: "IntVal", Expected: int64(123), Actual: int64(654)}, valueErr)
+			},
+		},
+		{
+			name: "data-point-attribute-extra",
+			validate: func(t *testing.T, err error) {
+				var missingErr *MissingDataPointError
+				require.True(t, errors.As(err, &missingErr))
+				var extraErr *ExtraDataPointError
+				require.True(t, errors.As(err, &extraErr))
+			},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			dir := filepath.Join("testdata", "metrics", tc.name)
+
+			expected, err := golden.ReadMetrics(filepath.Join(dir, "expected.json"))
+			require.NoError(t, err)
+
+			actual, err := golden.ReadMetrics(filepath.Join(dir, "actual.json"))
+			require.NoError(t, err)
+
+			err = CompareMetrics(expected, actual)
+			require.Error(t, err)
+			tc.validate(t, err)
+		})
+	}
+}
+
+func TestCompareMetricsUnifiedDiff(t *testing.T) {
+	dir := filepath.Join("testdata", "metrics", "data-point-value-int-mismatch")
+
+	expected, err := golden.ReadMetrics(filepath.Join(dir, "expected.json"))
+	require.NoError(t, err)
+
+	actual, err := golden.ReadMetrics(filepath.Join(dir, "actual.json"))
+	require.NoError(t, err)
+
+	var diffErr *MetricsDiffError
+	err = CompareMetrics(expected, actual)
+	require.Error(t, err)
+	require.False(t, errors.As(err, &diffErr))
+
+	err = CompareMetrics(expected, actual, IncludeUnifiedDiff())
+	require.Error(t, err)
+	require.True(t, errors.As(err, &diffErr))
+	require.Contains(t, diffErr.Diff, "--- expected")
+	require.Contains(t, diffErr.Diff, "+++ actual")
+	require.Regexp(t, `(?m)^-\s+"asInt": "123"`, diffErr.Diff)
+	require.Regexp(t, `(?m)^\+\s+"asInt": "654"`, diffErr.Diff)
+
+	var valueErr *DataPointValueMismatchError
+	require.True(t, errors.As(err, &valueErr))
+
+	require.NoError(t, CompareMetrics(expected, expected, IncludeUnifiedDiff()))
+}
@@ -15,7 +15,11 @@
 package comparetest // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/comparetest"
 
 import (
-	"fmt"
+	"encoding/binary"
+	"math"
+	"reflect"
+	"regexp"
+	"strings"
 	"time"
 
 	"go.opentelemetry.io/collector/pdata/pcommon"
@@ -93,39 +97,326 @@ func maskDataPointSliceValues(dataPoints pmetric.NumberDataPointSlice) {
 	}
 }
 
+// IgnoreMetricValuesWithAttributes is a MetricsCompareOption that clears the values of the data points
+// whose attributes include all the given attributes, the other data points are still compared.
+// If metric names are specified, only the data points within those metrics are cleared.
+func IgnoreMetricValuesWithAttributes(attributes map[string]string, metricNames ...string) MetricsCompareOption {
+	return ignoreMetricValuesWithAttributes{
+		attributes:  attributes,
+		metricNames: metricNames,
+	}
+}
+
+type ignoreMetricValuesWithAttributes struct {
+	attributes  map[string]string
+	metricNames []string
+}
+
+func (opt ignoreMetricValuesWithAttributes) applyOnMetrics(expected, actual pmetric.Metrics) {
+	opt.maskMetricValues(expected)
+	opt.maskMetricValues(actual)
+}
+
+func (opt ignoreMetricValuesWithAttributes) maskMetricValues(metrics pmetric.Metrics) {
+	metricNameSet := make(map[string]bool, len(opt.metricNames))
+	for _, metricName := range opt.metricNames {
+		metricNameSet[metricName] = true
+	}
+	rms := metrics.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		ilms := rms.At(i).ScopeMetrics()
+		for j := 0; j < ilms.Len(); j++ {
+			ms := ilms.At(j).Metrics()
+			for k := 0; k < ms.Len(); k++ {
+				if len(opt.metricNames) > 0 && !metricNameSet[ms.At(k).Name()] {
+					continue
+				}
+				opt.maskDataPointValues(ms.At(k))
+			}
+		}
+	}
+}
+
+// maskDataPointValues clears the values of the matching data points of the metric, whatever its type.
+func (opt ignoreMetricValuesWithAttributes) maskDataPointValues(metric pmetric.Metric) {
+	switch metric.Type() {
+	case pmetric.MetricTypeGauge:
+		opt.maskNumberDataPointValues(metric.Gauge().DataPoints())
+	case pmetric.MetricTypeSum:
+		opt.maskNumberDataPointValues(metric.Sum().DataPoints())
+	case pmetric.MetricTypeHistogram:
+		dataPoints := metric.Histogram().DataPoints()
+		for i := 0; i < dataPoints.Len(); i++ {
+			dataPoint := dataPoints.At(i)
+			if !opt.matches(dataPoint.Attributes()) {
+				continue
+			}
+			dataPoint.SetCount(0)
+			if dataPoint.HasSum() {
+				dataPoint.SetSum(0)
+			}
+			if dataPoint.HasMin() {
+				dataPoint.SetMin(0)
+			}
+			if dataPoint.HasMax() {
+				dataPoint.SetMax(0)
+			}
+			// The number of buckets is given by the explicit bounds, so only the counts are cleared.
+			dataPoint.BucketCounts().FromRaw(make([]uint64, dataPoint.BucketCounts().Len()))
+		}
+	case pmetric.MetricTypeExponentialHistogram:
+		dataPoints := metric.ExponentialHistogram().DataPoints()
+		for i := 0; i < dataPoints.Len(); i++ {
+			dataPoint := dataPoints.At(i)
+			if !opt.matches(dataPoint.Attributes()) {
+				continue
+			}
+			dataPoint.SetCount(0)
+			dataPoint.SetZeroCount(0)
+			if dataPoint.HasSum() {
+				dataPoint.SetSum(0)
+			}
+			if dataPoint.HasMin() {
+				dataPoint.SetMin(0)
+			}
+			if dataPoint.HasMax() {
+				dataPoint.SetMax(0)
+			}
+			// The populated buckets depend on the values, so they are cleared altogether.
+			dataPoint.Positive().SetOffset(0)
+			dataPoint.Positive().BucketCounts().FromRaw(nil)
+			dataPoint.Negative().SetOffset(0)
+			dataPoint.Negative().BucketCounts().FromRaw(nil)
+		}
+	case pmetric.MetricTypeSummary:
+		dataPoints := metric.Summary().DataPoints()
+		for i := 0; i < dataPoints.Len(); i++ {
+			dataPoint := dataPoints.At(i)
+			if !opt.matches(dataPoint.Attributes()) {
+				continue
+			}
+			dataPoint.SetCount(0)
+			dataPoint.SetSum(0)
+			quantileValues := dataPoint.QuantileValues()
+			for j := 0; j < quantileValues.Len(); j++ {
+				quantileValues.At(j).SetValue(0)
+			}
+		}
+	}
+}
+
+func (opt ignoreMetricValuesWithAttributes) maskNumberDataPointValues(dataPoints pmetric.NumberDataPointSlice) {
+	for i := 0; i < dataPoints.Len(); i++ {
+		dataPoint := dataPoints.At(i)
+		if opt.matches(dataPoint.Attributes()) {
+			dataPoint.SetIntValue(0)
+			dataPoint.SetDoubleValue(0)
+		}
+	}
+}
+
+// matches returns true if the data point attributes include all the attributes of the option.
+func (opt ignoreMetricValuesWithAttributes) matches(attributes pcommon.Map) bool {
+	for name, value := range opt.attributes {
+		actual, ok := attributes.Get(name)
+		if !ok || actual.AsString() != value {
+			return false
+		}
+	}
+	return true
+}
+
+// CompareMetricValuesWithTolerance is a MetricsCompareOption that accepts data point values
+// that differ from the expected values by at most epsilon.
+func CompareMetricValuesWithTolerance(epsilon float64, metricNames ...string) MetricsCompareOption {
+	return metricValuesTolerance{
+		epsilon:     epsilon,
+		metricNames: metricNames,
+	}
+}
+
+// CompareMetricValuesWithRelativeTolerance is a MetricsCompareOption that accepts data point values
+// that differ from the expected values by at most epsilon times the expected value.
+func CompareMetricValuesWithRelativeTolerance(epsilon float64, metricNames ...string) MetricsCompareOption {
+	return metricValuesTolerance{
+		epsilon:     epsilon,
+		relative:    true,
+		metricNames: metricNames,
+	}
+}
+
+type metricValuesTolerance struct {
+	epsilon     float64
+	relative    bool
+	metricNames []string
+}
+
+// applyOnMetrics sets the values of the actual data points that are within the tolerance of
+// the matching expected data points to the expected values.
+func (opt metricValuesTolerance) applyOnMetrics(expected, actual pmetric.Metrics) {
+	metricNameSet := make(map[string]bool, len(opt.metricNames))
+	for _, metricName := range opt.metricNames {
+		metricNameSet[metricName] = true
+	}
+
+	erms, arms := expected.ResourceMetrics(), actual.ResourceMetrics()
+	for i := 0; i < erms.Len(); i++ {
+		for j := 0; j < arms.Len(); j++ {
+			if !reflect.DeepEqual(erms.At(i).Resource().Attributes().AsRaw(), arms.At(j).Resource().Attributes().AsRaw()) {
+				continue
+			}
+			opt.applyOnScopeMetrics(erms.At(i).ScopeMetrics(), arms.At(j).ScopeMetrics(), metricNameSet)
+		}
+	}
+}
+
+func (opt metricValuesTolerance) applyOnScopeMetrics(expected, actual pmetric.ScopeMetricsSlice, metricNameSet map[string]bool) {
+	for i := 0; i < expected.Len(); i++ {
+		for j := 0; j < actual.Len(); j++ {
+			es, as := expected.At(i).Scope(), actual.At(j).Scope()
+			if es.Name() != as.Name() || es.Version() != as.Version() {
+				continue
+			}
+			actualByName := metricsByName(actual.At(j).Metrics())
+			ems := expected.At(i).Metrics()
+			for k := 0; k < ems.Len(); k++ {
+				em := ems.At(k)
+				if len(metricNameSet) > 0 && !metricNameSet[em.Name()] {
+					continue
+				}
+				am, ok := actualByName[em.Name()]
+				if !ok || em.Type() != am.Type() {
+					continue
+				}
+				if em.Type() != pmetric.MetricTypeGauge && em.Type() != pmetric.MetricTypeSum {
+					continue
+				}
+				opt.applyOnDataPointSlices(getDataPointSlice(em), getDataPointSlice(am))
+			}
+		}
+	}
+}
+
+func (opt metricValuesTolerance) applyOnDataPointSlices(expected, actual pmetric.NumberDataPointSlice) {
+	for i := 0; i < expected.Len(); i++ {
+		edp := expected.At(i)
+		for j := 0; j < actual.Len(); j++ {
+			adp := actual.At(j)
+			if edp.ValueType() != adp.ValueType() || !reflect.DeepEqual(edp.Attributes().AsRaw(), adp.Attributes().AsRaw()) {
+				continue
+			}
+			switch edp.ValueType() {
+			case pmetric.NumberDataPointValueTypeInt:
+				if opt.withinTolerance(float64(edp.IntValue()), float64(adp.IntValue())) {
+					adp.SetIntValue(edp.IntValue())
+				}
+			case pmetric.NumberDataPointValueTypeDouble:
+				if opt.withinTolerance(edp.DoubleValue(), adp.DoubleValue()) {
+					adp.SetDoubleValue(edp.DoubleValue())
+				}
+			}
+		}
+	}
+}
+
+func (opt metricValuesTolerance) withinTolerance(expected, actual float64) bool {
+	tolerance := opt.epsilon
+	if opt.relative {
+		tolerance *= math.Abs(expected)
+	}
+	return math.Abs(expected-actual) <= tolerance
+}
+
+// IncludeUnifiedDiff is a MetricsCompareOption that adds a unified diff of the JSON representation
+// of the expected and actual metrics to the returned error. The diff is computed after all the
+// other options are applied.
+func IncludeUnifiedDiff() MetricsCompareOption {
+	return includeUnifiedDiff{}
+}
+
+type includeUnifiedDiff struct{}
+
+func (opt includeUnifiedDiff) applyOnMetrics(_, _ pmetric.Metrics) {}
+
+// CompareTimestamps is a MetricsCompareOption that makes the timestamps of the gauge and sum data
+// points part of the comparison. They are ignored by default, which suits scrapers reporting the
+// current time, but not tests verifying that timestamps are preserved or rewritten.
+func CompareTimestamps() MetricsCompareOption {
+	return compareTimestamps{}
+}
+
+type compareTimestamps struct{}
+
+func (opt compareTimestamps) applyOnMetrics(_, _ pmetric.Metrics) {}
+
+// CompareStartTimestamps is a MetricsCompareOption that makes the start timestamps of the gauge and
+// sum data points part of the comparison. They are ignored by default.
+func CompareStartTimestamps() MetricsCompareOption {
+	return compareStartTimestamps{}
+}
+
+type compareStartTimestamps struct{}
+
+func (opt compareStartTimestamps) applyOnMetrics(_, _ pmetric.Metrics) {}
+
 // IgnoreMetricAttributeValue is a MetricsCompareOption that clears value of the metric attribute.
+// The attribute name can be a glob pattern, e.g. `k8s.pod.labels.*`, to clear the values of all
+// the matching attributes.
 func IgnoreMetricAttributeValue(attributeName string, metricNames ...string) MetricsCompareOption {
 	return ignoreMetricAttributeValue{
-		attributeName: attributeName,
+		attributeName: newAttributeNameMatcher(attributeName),
 		metricNames:   metricNames,
 	}
 }
 
 type ignoreMetricAttributeValue struct {
-	attributeName string
+	attributeName attributeNameMatcher
 	metricNames   []string
 }
 
 func (opt ignoreMetricAttributeValue) applyOnMetrics(expected, actual pmetric.Metrics) {
-	maskMetricAttributeValue(expected, opt)
-	maskMetricAttributeValue(actual, opt)
+	maskMetricAttributeValue(expected, opt.attributeName, nil, opt.metricNames...)
+	maskMetricAttributeValue(actual, opt.attributeName, nil, opt.metricNames...)
+}
+
+// MatchMetricAttributeValue is a MetricsCompareOption that clears value of the metric attribute
+// if it matches the regular expression. Values that do not match are left untouched so they are
+// still reported as differences. The attribute name can be a glob pattern.
+func MatchMetricAttributeValue(attributeName string, pattern string, metricNames ...string) MetricsCompareOption {
+	return matchMetricAttributeValue{
+		attributeName: newAttributeNameMatcher(attributeName),
+		pattern:       regexp.MustCompile(pattern),
+		metricNames:   metricNames,
+	}
+}
+
+type matchMetricAttributeValue struct {
+	attributeName attributeNameMatcher
+	pattern       *regexp.Regexp
+	metricNames   []string
+}
+
+func (opt matchMetricAttributeValue) applyOnMetrics(expected, actual pmetric.Metrics) {
+	maskMetricAttributeValue(expected, opt.attributeName, opt.pattern, opt.metricNames...)
+	maskMetricAttributeValue(actual, opt.attributeName, opt.pattern, opt.metricNames...)
 }
 
-func maskMetricAttributeValue(metrics pmetric.Metrics, opt ignoreMetricAttributeValue) {
+func maskMetricAttributeValue(metrics pmetric.Metrics, attributeName attributeNameMatcher, pattern *regexp.Regexp, metricNames ...string) {
 	rms := metrics.ResourceMetrics()
 	for i := 0; i < rms.Len(); i++ {
 		ilms := rms.At(i).ScopeMetrics()
 		for j := 0; j < ilms.Len(); j++ {
-			maskMetricSliceAttributeValues(ilms.At(j).Metrics(), opt.attributeName, opt.metricNames...)
+			maskMetricSliceAttributeValues(ilms.At(j).Metrics(), attributeName, pattern, metricNames...)
 		}
 	}
 }
 
-// maskMetricSliceAttributeValues sets the value of the specified attribute to
+// maskMetricSliceAttributeValues sets the value of the matching attributes to
 // the zero value associated with the attribute data type.
+// If a pattern is specified, only the values matching it will be masked.
 // If metric names are specified, only the data points within those metrics will be masked.
 // Otherwise, all data points with the attribute will be masked.
-func maskMetricSliceAttributeValues(metrics pmetric.MetricSlice, attributeName string, metricNames ...string) {
+func maskMetricSliceAttributeValues(metrics pmetric.MetricSlice, attributeName attributeNameMatcher, pattern *regexp.Regexp, metricNames ...string) {
 	metricNameSet := make(map[string]bool, len(metricNames))
 	for _, metricName := range metricNames {
 		metricNameSet[metricName] = true
@@ -134,7 +425,7 @@ func maskMetricSliceAttributeValues(metrics pmetric.MetricSlice, attributeName s
 	for i := 0; i < metrics.Len(); i++ {
 		if len(metricNames) == 0 || metricNameSet[metrics.At(i).Name()] {
 			dps := getDataPointSlice(metrics.At(i))
-			maskDataPointSliceAttributeValues(dps, attributeName)
+			maskDataPointSliceAttributeValues(dps, attributeName, pattern)
 
 			// If attribute values are ignored, some data points may become
 			// indistinguishable from each other, but sorting by value allows
@@ -152,33 +443,86 @@ func maskMetricSliceAttributeValues(metrics pmetric.MetricSlice, attributeName s
 	}
 }
 
-// maskDataPointSliceAttributeValues sets the value of the specified attribute to
+// maskDataPointSliceAttributeValues sets the value of the matching attributes to
 // the zero value associated with the attribute data type.
-func maskDataPointSliceAttributeValues(dataPoints pmetric.NumberDataPointSlice, attributeName string) {
+// If a pattern is specified, only the values matching it will be masked.
+func maskDataPointSliceAttributeValues(dataPoints pmetric.NumberDataPointSlice, attributeName attributeNameMatcher, pattern *regexp.Regexp) {
 	for i := 0; i < dataPoints.Len(); i++ {
-		attributes := dataPoints.At(i).Attributes()
-		attribute, ok := attributes.Get(attributeName)
-		if ok {
+		dataPoints.At(i).Attributes().Range(func(k string, attribute pcommon.Value) bool {
+			if !attributeName.match(k) {
+				return true
+			}
+			if pattern != nil && !pattern.MatchString(attribute.AsString()) {
+				return true
+			}
+			// A glob pattern can match attributes of any type.
 			switch attribute.Type() {
 			case pcommon.ValueTypeStr:
 				attribute.SetStr("")
-			default:
-				panic(fmt.Sprintf("data type not supported: %s", attribute.Type()))
+			case pcommon.ValueTypeInt:
+				attribute.SetInt(0)
+			case pcommon.ValueTypeDouble:
+				attribute.SetDouble(0)
+			case pcommon.ValueTypeBool:
+				attribute.SetBool(false)
+			case pcommon.ValueTypeBytes:
+				attribute.SetEmptyBytes()
+			case pcommon.ValueTypeMap:
+				attribute.SetEmptyMap()
+			case pcommon.ValueTypeSlice:
+				attribute.SetEmptySlice()
 			}
+			return true
+		})
+	}
+}
+
+// attributeNameMatcher matches attribute names against a literal name, or against a glob
+// pattern if the name contains a `*`, which matches any sequence of characters, or a `?`,
+// which matches a single character.
+type attributeNameMatcher struct {
+	name    string
+	pattern *regexp.Regexp
+}
+
+func newAttributeNameMatcher(name string) attributeNameMatcher {
+	if !strings.ContainsAny(name, "*?") {
+		return attributeNameMatcher{name: name}
+	}
+	var b strings.Builder
+	b.WriteString("^")
+	for _, r := range name {
+		switch r {
+		case '*':
+			b.WriteString(".*")
+		case '?':
+			b.WriteString(".")
+		default:
+			b.WriteString(regexp.QuoteMeta(string(r)))
 		}
 	}
+	b.WriteString("$")
+	return attributeNameMatcher{name: name, pattern: regexp.MustCompile(b.String())}
+}
+
+func (m attributeNameMatcher) match(name string) bool {
+	if m.pattern == nil {
+		return name == m.name
+	}
+	return m.pattern.MatchString(name)
 }
 
 // IgnoreResourceAttributeValue is a CompareOption that removes a resource attribute
-// from all resources.
+// from all resources. The attribute name can be a glob pattern, e.g. `k8s.pod.labels.*`,
+// to remove all the matching attributes.
 func IgnoreResourceAttributeValue(attributeName string) CompareOption {
 	return ignoreResourceAttributeValue{
-		attributeName: attributeName,
+		attributeName: newAttributeNameMatcher(attributeName),
 	}
 }
 
 type ignoreResourceAttributeValue struct {
-	attributeName string
+	attributeName attributeNameMatcher
 }
 
 func (opt ignoreResourceAttributeValue) applyOnMetrics(expected, actual pmetric.Metrics) {
@@ -218,11 +562,444 @@ func (opt ignoreResourceAttributeValue) maskTracesResourceAttributeValue(traces
 }
 
 func (opt ignoreResourceAttributeValue) maskResourceAttributeValue(res pcommon.Resource) {
-	if _, ok := res.Attributes().Get(opt.attributeName); ok {
-		res.Attributes().Remove(opt.attributeName)
+	res.Attributes().RemoveIf(func(k string, _ pcommon.Value) bool {
+		return opt.attributeName.match(k)
+	})
+}
+
+// IgnoreScopeAttributeValue is a CompareOption that removes an attribute from all instrumentation
+// scopes. The attribute name can be a glob pattern to remove all the matching attributes.
+func IgnoreScopeAttributeValue(attributeName string) CompareOption {
+	return ignoreScopeAttributeValue{
+		attributeName: newAttributeNameMatcher(attributeName),
+	}
+}
+
+type ignoreScopeAttributeValue struct {
+	attributeName attributeNameMatcher
+}
+
+func (opt ignoreScopeAttributeValue) applyOnMetrics(expected, actual pmetric.Metrics) {
+	opt.maskMetricsScopeAttributeValue(expected)
+	opt.maskMetricsScopeAttributeValue(actual)
+}
+
+func (opt ignoreScopeAttributeValue) maskMetricsScopeAttributeValue(metrics pmetric.Metrics) {
+	rms := metrics.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		sms := rms.At(i).ScopeMetrics()
+		for j := 0; j < sms.Len(); j++ {
+			opt.maskScopeAttributeValue(sms.At(j).Scope())
+		}
+	}
+}
+
+func (opt ignoreScopeAttributeValue) applyOnLogs(expected, actual plog.Logs) {
+	opt.maskLogsScopeAttributeValue(expected)
+	opt.maskLogsScopeAttributeValue(actual)
+}
+
+func (opt ignoreScopeAttributeValue) maskLogsScopeAttributeValue(logs plog.Logs) {
+	rls := logs.ResourceLogs()
+	for i := 0; i < rls.Len(); i++ {
+		sls := rls.At(i).ScopeLogs()
+		for j := 0; j < sls.Len(); j++ {
+			opt.maskScopeAttributeValue(sls.At(j).Scope())
+		}
+	}
+}
+
+func (opt ignoreScopeAttributeValue) applyOnTraces(expected, actual ptrace.Traces) {
+	opt.maskTracesScopeAttributeValue(expected)
+	opt.maskTracesScopeAttributeValue(actual)
+}
+
+func (opt ignoreScopeAttributeValue) maskTracesScopeAttributeValue(traces ptrace.Traces) {
+	rss := traces.ResourceSpans()
+	for i := 0; i < rss.Len(); i++ {
+		sss := rss.At(i).ScopeSpans()
+		for j := 0; j < sss.Len(); j++ {
+			opt.maskScopeAttributeValue(sss.At(j).Scope())
+		}
+	}
+}
+
+func (opt ignoreScopeAttributeValue) maskScopeAttributeValue(scope pcommon.InstrumentationScope) {
+	scope.Attributes().RemoveIf(func(k string, _ pcommon.Value) bool {
+		return opt.attributeName.match(k)
+	})
+}
+
+// IgnoreScopeVersion is a CompareOption that clears the version of the instrumentation scopes.
+// The scope names are still compared.
+func IgnoreScopeVersion() CompareOption {
+	return ignoreScopeVersion{}
+}
+
+type ignoreScopeVersion struct{}
+
+func (opt ignoreScopeVersion) applyOnMetrics(expected, actual pmetric.Metrics) {
+	maskMetricsScopeVersion(expected)
+	maskMetricsScopeVersion(actual)
+}
+
+func maskMetricsScopeVersion(metrics pmetric.Metrics) {
+	rms := metrics.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		sms := rms.At(i).ScopeMetrics()
+		for j := 0; j < sms.Len(); j++ {
+			sms.At(j).Scope().SetVersion("")
+		}
+	}
+}
+
+func (opt ignoreScopeVersion) applyOnLogs(expected, actual plog.Logs) {
+	maskLogsScopeVersion(expected)
+	maskLogsScopeVersion(actual)
+}
+
+func maskLogsScopeVersion(logs plog.Logs) {
+	rls := logs.ResourceLogs()
+	for i := 0; i < rls.Len(); i++ {
+		sls := rls.At(i).ScopeLogs()
+		for j := 0; j < sls.Len(); j++ {
+			sls.At(j).Scope().SetVersion("")
+		}
+	}
+}
+
+func (opt ignoreScopeVersion) applyOnTraces(expected, actual ptrace.Traces) {
+	maskTracesScopeVersion(expected)
+	maskTracesScopeVersion(actual)
+}
+
+func maskTracesScopeVersion(traces ptrace.Traces) {
+	rss := traces.ResourceSpans()
+	for i := 0; i < rss.Len(); i++ {
+		sss := rss.At(i).ScopeSpans()
+		for j := 0; j < sss.Len(); j++ {
+			sss.At(j).Scope().SetVersion("")
+		}
+	}
+}
+
+// IgnoreMetrics is a MetricsCompareOption that removes the metrics with the given names from
+// both the expected and the actual metrics, e.g. when a metric is only emitted on some platforms.
+func IgnoreMetrics(metricNames ...string) MetricsCompareOption {
+	return ignoreMetrics{
+		metricNames: metricNames,
+	}
+}
+
+type ignoreMetrics struct {
+	metricNames []string
+}
+
+func (opt ignoreMetrics) applyOnMetrics(expected, actual pmetric.Metrics) {
+	removeMetrics(expected, opt.metricNames)
+	removeMetrics(actual, opt.metricNames)
+}
+
+func removeMetrics(metrics pmetric.Metrics, metricNames []string) {
+	metricNameSet := make(map[string]bool, len(metricNames))
+	for _, metricName := range metricNames {
+		metricNameSet[metricName] = true
+	}
+	rms := metrics.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		sms := rms.At(i).ScopeMetrics()
+		for j := 0; j < sms.Len(); j++ {
+			sms.At(j).Metrics().RemoveIf(func(m pmetric.Metric) bool {
+				return metricNameSet[m.Name()]
+			})
+		}
+	}
+}
+
+// IgnoreSchemaURL is a MetricsCompareOption that clears the schema URLs of all resources and scopes.
+func IgnoreSchemaURL() MetricsCompareOption {
+	return ignoreSchemaURL{}
+}
+
+type ignoreSchemaURL struct{}
+
+func (opt ignoreSchemaURL) applyOnMetrics(expected, actual pmetric.Metrics) {
+	maskSchemaURL(expected)
+	maskSchemaURL(actual)
+}
+
+func maskSchemaURL(metrics pmetric.Metrics) {
+	rms := metrics.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		rms.At(i).SetSchemaUrl("")
+		sms := rms.At(i).ScopeMetrics()
+		for j := 0; j < sms.Len(); j++ {
+			sms.At(j).SetSchemaUrl("")
+		}
 	}
 }
 
+// IgnoreMetricDescription is a MetricsCompareOption that clears the description of all metrics.
+func IgnoreMetricDescription() MetricsCompareOption {
+	return ignoreMetricDescription{}
+}
+
+type ignoreMetricDescription struct{}
+
+func (opt ignoreMetricDescription) applyOnMetrics(expected, actual pmetric.Metrics) {
+	maskMetricDescription(expected)
+	maskMetricDescription(actual)
+}
+
+func maskMetricDescription(metrics pmetric.Metrics) {
+	rms := metrics.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		sms := rms.At(i).ScopeMetrics()
+		for j := 0; j < sms.Len(); j++ {
+			ms := sms.At(j).Metrics()
+			for k := 0; k < ms.Len(); k++ {
+				ms.At(k).SetDescription("")
+			}
+		}
+	}
+}
+
+// IgnoreMetricUnit is a MetricsCompareOption that clears the unit of all metrics.
+func IgnoreMetricUnit() MetricsCompareOption {
+	return ignoreMetricUnit{}
+}
+
+type ignoreMetricUnit struct{}
+
+func (opt ignoreMetricUnit) applyOnMetrics(expected, actual pmetric.Metrics) {
+	maskMetricUnit(expected)
+	maskMetricUnit(actual)
+}
+
+func maskMetricUnit(metrics pmetric.Metrics) {
+	rms := metrics.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		sms := rms.At(i).ScopeMetrics()
+		for j := 0; j < sms.Len(); j++ {
+			ms := sms.At(j).Metrics()
+			for k := 0; k < ms.Len(); k++ {
+				ms.At(k).SetUnit("")
+			}
+		}
+	}
+}
+
+// IgnoreExemplars is a MetricsCompareOption that clears the exemplars of the data points,
+// of all metrics if no metric names are given.
+func IgnoreExemplars(metricNames ...string) MetricsCompareOption {
+	return ignoreExemplars{
+		metricNames: metricNames,
+	}
+}
+
+type ignoreExemplars struct {
+	metricNames []string
+}
+
+func (opt ignoreExemplars) applyOnMetrics(expected, actual pmetric.Metrics) {
+	maskExemplars(expected, opt.metricNames...)
+	maskExemplars(actual, opt.metricNames...)
+}
+
+func maskExemplars(metrics pmetric.Metrics, metricNames ...string) {
+	metricNameSet := make(map[string]bool, len(metricNames))
+	for _, metricName := range metricNames {
+		metricNameSet[metricName] = true
+	}
+
+	rms := metrics.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		sms := rms.At(i).ScopeMetrics()
+		for j := 0; j < sms.Len(); j++ {
+			ms := sms.At(j).Metrics()
+			for k := 0; k < ms.Len(); k++ {
+				if len(metricNames) == 0 || metricNameSet[ms.At(k).Name()] {
+					maskMetricExemplars(ms.At(k))
+				}
+			}
+		}
+	}
+}
+
+// maskMetricExemplars removes the exemplars of all the data points of the metric.
+func maskMetricExemplars(metric pmetric.Metric) {
+	switch metric.Type() {
+	case pmetric.MetricTypeGauge, pmetric.MetricTypeSum:
+		dps := getDataPointSlice(metric)
+		for i := 0; i < dps.Len(); i++ {
+			dps.At(i).Exemplars().RemoveIf(func(pmetric.Exemplar) bool { return true })
+		}
+	case pmetric.MetricTypeHistogram:
+		dps := metric.Histogram().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			dps.At(i).Exemplars().RemoveIf(func(pmetric.Exemplar) bool { return true })
+		}
+	case pmetric.MetricTypeExponentialHistogram:
+		dps := metric.ExponentialHistogram().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			dps.At(i).Exemplars().RemoveIf(func(pmetric.Exemplar) bool { return true })
+		}
+	}
+}
+
+// IgnoreDataPointFlags is a MetricsCompareOption that resets the flags of the data points,
+// e.g. FLAG_NO_RECORDED_VALUE, of all metrics if no metric names are given.
+func IgnoreDataPointFlags(metricNames ...string) MetricsCompareOption {
+	return ignoreDataPointFlags{
+		metricNames: metricNames,
+	}
+}
+
+type ignoreDataPointFlags struct {
+	metricNames []string
+}
+
+func (opt ignoreDataPointFlags) applyOnMetrics(expected, actual pmetric.Metrics) {
+	maskDataPointFlags(expected, opt.metricNames...)
+	maskDataPointFlags(actual, opt.metricNames...)
+}
+
+func maskDataPointFlags(metrics pmetric.Metrics, metricNames ...string) {
+	metricNameSet := make(map[string]bool, len(metricNames))
+	for _, metricName := range metricNames {
+		metricNameSet[metricName] = true
+	}
+
+	rms := metrics.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		sms := rms.At(i).ScopeMetrics()
+		for j := 0; j < sms.Len(); j++ {
+			ms := sms.At(j).Metrics()
+			for k := 0; k < ms.Len(); k++ {
+				if len(metricNames) == 0 || metricNameSet[ms.At(k).Name()] {
+					maskMetricDataPointFlags(ms.At(k))
+				}
+			}
+		}
+	}
+}
+
+// maskMetricDataPointFlags resets the flags of all the data points of the metric.
+func maskMetricDataPointFlags(metric pmetric.Metric) {
+	switch metric.Type() {
+	case pmetric.MetricTypeGauge, pmetric.MetricTypeSum:
+		dps := getDataPointSlice(metric)
+		for i := 0; i < dps.Len(); i++ {
+			dps.At(i).SetFlags(pmetric.DefaultDataPointFlags)
+		}
+	case pmetric.MetricTypeHistogram:
+		dps := metric.Histogram().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			dps.At(i).SetFlags(pmetric.DefaultDataPointFlags)
+		}
+	case pmetric.MetricTypeExponentialHistogram:
+		dps := metric.ExponentialHistogram().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			dps.At(i).SetFlags(pmetric.DefaultDataPointFlags)
+		}
+	case pmetric.MetricTypeSummary:
+		dps := metric.Summary().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			dps.At(i).SetFlags(pmetric.DefaultDataPointFlags)
+		}
+	}
+}
+
+// IgnoreExponentialHistogramScale is a MetricsCompareOption that downscales the exponential histogram
+// data points of all metrics, or only of the given ones, to the lowest scale found for the metric in
+// the expected and actual metrics. Two histograms describing the same distribution at different
+// scales are then compared bucket by bucket.
+func IgnoreExponentialHistogramScale(metricNames ...string) MetricsCompareOption {
+	return ignoreExponentialHistogramScale{
+		metricNames: metricNames,
+	}
+}
+
+type ignoreExponentialHistogramScale struct {
+	metricNames []string
+}
+
+func (opt ignoreExponentialHistogramScale) applyOnMetrics(expected, actual pmetric.Metrics) {
+	minScales := make(map[string]int32)
+	findMinScale := func(name string, dp pmetric.ExponentialHistogramDataPoint) {
+		if scale, ok := minScales[name]; !ok || dp.Scale() < scale {
+			minScales[name] = dp.Scale()
+		}
+	}
+	opt.forEachDataPoint(expected, findMinScale)
+	opt.forEachDataPoint(actual, findMinScale)
+
+	downscale := func(name string, dp pmetric.ExponentialHistogramDataPoint) {
+		downscaleExponentialHistogramDataPoint(dp, minScales[name])
+	}
+	opt.forEachDataPoint(expected, downscale)
+	opt.forEachDataPoint(actual, downscale)
+}
+
+// forEachDataPoint calls f with each data point of the selected exponential histograms.
+func (opt ignoreExponentialHistogramScale) forEachDataPoint(metrics pmetric.Metrics, f func(string, pmetric.ExponentialHistogramDataPoint)) {
+	metricNameSet := make(map[string]bool, len(opt.metricNames))
+	for _, metricName := range opt.metricNames {
+		metricNameSet[metricName] = true
+	}
+
+	rms := metrics.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		sms := rms.At(i).ScopeMetrics()
+		for j := 0; j < sms.Len(); j++ {
+			ms := sms.At(j).Metrics()
+			for k := 0; k < ms.Len(); k++ {
+				metric := ms.At(k)
+				if metric.Type() != pmetric.MetricTypeExponentialHistogram {
+					continue
+				}
+				if len(opt.metricNames) > 0 && !metricNameSet[metric.Name()] {
+					continue
+				}
+				dps := metric.ExponentialHistogram().DataPoints()
+				for l := 0; l < dps.Len(); l++ {
+					f(metric.Name(), dps.At(l))
+				}
+			}
+		}
+	}
+}
+
+// downscaleExponentialHistogramDataPoint merges the buckets of the data point so that it has the given scale.
+// Each decrement of the scale merges pairs of adjacent buckets.
+func downscaleExponentialHistogramDataPoint(dp pmetric.ExponentialHistogramDataPoint, scale int32) {
+	if dp.Scale() <= scale {
+		return
+	}
+	by := dp.Scale() - scale
+	downscaleExponentialHistogramBuckets(dp.Positive(), by)
+	downscaleExponentialHistogramBuckets(dp.Negative(), by)
+	dp.SetScale(scale)
+}
+
+// downscaleExponentialHistogramBuckets merges each 2^by adjacent buckets into one. Bucket indexes are
+// shifted arithmetically, so that negative indexes are rounded towards negative infinity.
+func downscaleExponentialHistogramBuckets(buckets pmetric.ExponentialHistogramDataPointBuckets, by int32) {
+	offset := buckets.Offset()
+	counts := buckets.BucketCounts().AsRaw()
+	newOffset := offset >> by
+	buckets.SetOffset(newOffset)
+	if len(counts) == 0 {
+		return
+	}
+
+	newCounts := make([]uint64, (offset+int32(len(counts))-1)>>by-newOffset+1)
+	for i, count := range counts {
+		newCounts[(offset+int32(i))>>by-newOffset] += count
+	}
+	buckets.BucketCounts().FromRaw(newCounts)
+}
+
 // IgnoreSubsequentDataPoints is a MetricsCompareOption that ignores data points after the first.
 func IgnoreSubsequentDataPoints(metricNames ...string) MetricsCompareOption {
 	return ignoreSubsequentDataPoints{
@@ -289,6 +1066,139 @@ func maskObservedTimestamp(logs plog.Logs, ts pcommon.Timestamp) {
 	}
 }
 
+// LogRecordSelector selects the log records a LogsCompareOption applies to.
+type LogRecordSelector func(plog.LogRecord) bool
+
+// LogRecordsWithSeverity selects the log records with the given severity number.
+func LogRecordsWithSeverity(severity plog.SeverityNumber) LogRecordSelector {
+	return func(lr plog.LogRecord) bool {
+		return lr.SeverityNumber() == severity
+	}
+}
+
+// LogRecordsWithAttribute selects the log records having the attribute with the given value.
+func LogRecordsWithAttribute(attributeName, attributeValue string) LogRecordSelector {
+	return func(lr plog.LogRecord) bool {
+		v, ok := lr.Attributes().Get(attributeName)
+		return ok && v.AsString() == attributeValue
+	}
+}
+
+// MatchLogBody is a LogsCompareOption that clears the string body of the log records if it
+// matches the regular expression, e.g. when it contains a timestamp or a process ID. Bodies that
+// do not match are left untouched so they are still reported as differences. If selectors are
+// given, only the log records selected by all of them are considered.
+func MatchLogBody(pattern string, selectors ...LogRecordSelector) LogsCompareOption {
+	return matchLogBody{
+		pattern:   regexp.MustCompile(pattern),
+		selectors: selectors,
+	}
+}
+
+type matchLogBody struct {
+	pattern   *regexp.Regexp
+	selectors []LogRecordSelector
+}
+
+func (opt matchLogBody) applyOnLogs(expected, actual plog.Logs) {
+	opt.maskLogBody(expected)
+	opt.maskLogBody(actual)
+}
+
+func (opt matchLogBody) maskLogBody(logs plog.Logs) {
+	rls := logs.ResourceLogs()
+	for i := 0; i < rls.Len(); i++ {
+		sls := rls.At(i).ScopeLogs()
+		for j := 0; j < sls.Len(); j++ {
+			lrs := sls.At(j).LogRecords()
+			for k := 0; k < lrs.Len(); k++ {
+				lr := lrs.At(k)
+				if lr.Body().Type() == pcommon.ValueTypeStr && opt.selected(lr) && opt.pattern.MatchString(lr.Body().Str()) {
+					lr.Body().SetStr("")
+				}
+			}
+		}
+	}
+}
+
+func (opt matchLogBody) selected(lr plog.LogRecord) bool {
+	for _, selector := range opt.selectors {
+		if !selector(lr) {
+			return false
+		}
+	}
+	return true
+}
+
+// AllowExtraResources is a MetricsCompareOption that removes the actual resources that have
+// no expected resource with the same attributes, so that only the expected resources are compared.
+// It should be passed after the options changing the resource attributes.
+func AllowExtraResources() MetricsCompareOption {
+	return allowExtraResources{}
+}
+
+type allowExtraResources struct{}
+
+func (opt allowExtraResources) applyOnMetrics(expected, actual pmetric.Metrics) {
+	erms := expected.ResourceMetrics()
+	actual.ResourceMetrics().RemoveIf(func(arm pmetric.ResourceMetrics) bool {
+		_, ok := findResourceMetrics(erms, arm)
+		return !ok
+	})
+}
+
+// AllowExtraMetrics is a MetricsCompareOption that removes the actual metrics that are not
+// expected within the same resource and scope, so that only the expected metrics are compared.
+// It should be passed after the options changing the resource attributes or the scopes.
+func AllowExtraMetrics() MetricsCompareOption {
+	return allowExtraMetrics{}
+}
+
+type allowExtraMetrics struct{}
+
+func (opt allowExtraMetrics) applyOnMetrics(expected, actual pmetric.Metrics) {
+	arms := actual.ResourceMetrics()
+	for i := 0; i < arms.Len(); i++ {
+		erm, ok := findResourceMetrics(expected.ResourceMetrics(), arms.At(i))
+		if !ok {
+			continue
+		}
+		asms := arms.At(i).ScopeMetrics()
+		for j := 0; j < asms.Len(); j++ {
+			esm, ok := findScopeMetrics(erm.ScopeMetrics(), asms.At(j))
+			if !ok {
+				continue
+			}
+			expectedByName := metricsByName(esm.Metrics())
+			asms.At(j).Metrics().RemoveIf(func(m pmetric.Metric) bool {
+				_, ok := expectedByName[m.Name()]
+				return !ok
+			})
+		}
+	}
+}
+
+// findResourceMetrics returns the resource metrics of the slice with the same resource attributes.
+func findResourceMetrics(rms pmetric.ResourceMetricsSlice, rm pmetric.ResourceMetrics) (pmetric.ResourceMetrics, bool) {
+	attrs := rm.Resource().Attributes().AsRaw()
+	for i := 0; i < rms.Len(); i++ {
+		if reflect.DeepEqual(rms.At(i).Resource().Attributes().AsRaw(), attrs) {
+			return rms.At(i), true
+		}
+	}
+	return pmetric.ResourceMetrics{}, false
+}
+
+// findScopeMetrics returns the scope metrics of the slice with the same scope name and version.
+func findScopeMetrics(sms pmetric.ScopeMetricsSlice, sm pmetric.ScopeMetrics) (pmetric.ScopeMetrics, bool) {
+	for i := 0; i < sms.Len(); i++ {
+		if sms.At(i).Scope().Name() == sm.Scope().Name() && sms.At(i).Scope().Version() == sm.Scope().Version() {
+			return sms.At(i), true
+		}
+	}
+	return pmetric.ScopeMetrics{}, false
+}
+
 // IgnoreResourceOrder is a CompareOption that ignores the order of resource traces/metrics/logs.
 func IgnoreResourceOrder() CompareOption {
 	return ignoreResourceOrder{}
@@ -392,3 +1302,113 @@ func (opt ignoreLogRecordsOrder) applyOnLogs(expected, actual plog.Logs) {
 	sortLogRecordSlices(expected)
 	sortLogRecordSlices(actual)
 }
+
+// IgnoreTraceIDs is a TracesCompareOption that replaces the trace IDs of the spans and of their links
+// with IDs numbered in the order they appear. The spans are still expected to be grouped in the same
+// traces, but not with the same IDs.
+func IgnoreTraceIDs() TracesCompareOption {
+	return ignoreTraceIDs{}
+}
+
+type ignoreTraceIDs struct{}
+
+func (opt ignoreTraceIDs) applyOnTraces(expected, actual ptrace.Traces) {
+	normalizeTraceIDs(expected)
+	normalizeTraceIDs(actual)
+}
+
+func normalizeTraceIDs(traces ptrace.Traces) {
+	ids := map[pcommon.TraceID]pcommon.TraceID{}
+	normalize := func(id pcommon.TraceID) pcommon.TraceID {
+		if id.IsEmpty() {
+			return id
+		}
+		normalized, ok := ids[id]
+		if !ok {
+			binary.BigEndian.PutUint64(normalized[8:], uint64(len(ids)+1))
+			ids[id] = normalized
+		}
+		return normalized
+	}
+	forEachSpan(traces, func(span ptrace.Span) {
+		span.SetTraceID(normalize(span.TraceID()))
+		links := span.Links()
+		for i := 0; i < links.Len(); i++ {
+			links.At(i).SetTraceID(normalize(links.At(i).TraceID()))
+		}
+	})
+}
+
+// IgnoreSpanIDs is a TracesCompareOption that replaces the span IDs, the parent span IDs and the span IDs
+// of the links with IDs numbered in the order they appear. The parent/child relationships and the links
+// between the spans are still verified, but not the IDs themselves.
+func IgnoreSpanIDs() TracesCompareOption {
+	return ignoreSpanIDs{}
+}
+
+type ignoreSpanIDs struct{}
+
+func (opt ignoreSpanIDs) applyOnTraces(expected, actual ptrace.Traces) {
+	normalizeSpanIDs(expected)
+	normalizeSpanIDs(actual)
+}
+
+func normalizeSpanIDs(traces ptrace.Traces) {
+	ids := map[pcommon.SpanID]pcommon.SpanID{}
+	normalize := func(id pcommon.SpanID) pcommon.SpanID {
+		if id.IsEmpty() {
+			return id
+		}
+		normalized, ok := ids[id]
+		if !ok {
+			binary.BigEndian.PutUint64(normalized[:], uint64(len(ids)+1))
+			ids[id] = normalized
+		}
+		return normalized
+	}
+	forEachSpan(traces, func(span ptrace.Span) {
+		span.SetSpanID(normalize(span.SpanID()))
+		span.SetParentSpanID(normalize(span.ParentSpanID()))
+		links := span.Links()
+		for i := 0; i < links.Len(); i++ {
+			links.At(i).SetSpanID(normalize(links.At(i).SpanID()))
+		}
+	})
+}
+
+// IgnoreSpanTimestamps is a TracesCompareOption that clears the start and end timestamps of the spans and
+// the timestamps of their events.
+func IgnoreSpanTimestamps() TracesCompareOption {
+	return ignoreSpanTimestamps{}
+}
+
+type ignoreSpanTimestamps struct{}
+
+func (opt ignoreSpanTimestamps) applyOnTraces(expected, actual ptrace.Traces) {
+	maskSpanTimestamps(expected)
+	maskSpanTimestamps(actual)
+}
+
+func maskSpanTimestamps(traces ptrace.Traces) {
+	forEachSpan(traces, func(span ptrace.Span) {
+		span.SetStartTimestamp(0)
+		span.SetEndTimestamp(0)
+		events := span.Events()
+		for i := 0; i < events.Len(); i++ {
+			events.At(i).SetTimestamp(0)
+		}
+	})
+}
+
+func forEachSpan(traces ptrace.Traces, f func(ptrace.Span)) {
+	rss := traces.ResourceSpans()
+	for i := 0; i < rss.Len(); i++ {
+		sss := rss.At(i).ScopeSpans()
+		for j := 0; j < sss.Len(); j++ {
+			spans := sss.At(j).Spans()
+			for k := 0; k < spans.Len(); k++ {
+				f(spans.At(k))
+			}
+		}
+	}
+}
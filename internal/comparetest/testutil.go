@@ -25,10 +25,12 @@ type expectation struct {
 	reason string
 }
 
+// validate checks that err has the same message as the expected error, the
+// types of the errors are checked separately.
 func (e expectation) validate(t *testing.T, err error) {
 	if e.err == nil {
 		require.NoError(t, err, e.reason)
 		return
 	}
-	require.Equal(t, e.err, err, e.reason)
+	require.EqualError(t, err, e.err.Error(), e.reason)
 }
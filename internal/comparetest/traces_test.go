@@ -52,6 +52,112 @@ func TestCompareTraces(t *testing.T) {
 				reason: "The unpredictable resource attribute was ignored on each resource that carried it.",
 			},
 		},
+		{
+			name: "scope-attributes-mismatch",
+			withoutOptions: expectation{
+				err:    errors.New("instrumentation library Attributes does not match expected: map[library.mode:sync], actual: map[library.mode:async]"),
+				reason: "An instrumentation scope with different attributes should cause a failure.",
+			},
+		},
+		{
+			name: "ignore-scope-attribute",
+			compareOptions: []TracesCompareOption{
+				IgnoreScopeAttributeValue("library.instance"),
+			},
+			withoutOptions: expectation{
+				err:    errors.New("instrumentation library Attributes does not match expected: map[library.instance:a-random-id library.mode:sync], actual: map[library.instance:a-different-random-id library.mode:sync]"),
+				reason: "An unpredictable scope attribute will cause failures if not ignored.",
+			},
+			withOptions: expectation{
+				err:    nil,
+				reason: "The unpredictable scope attribute was ignored.",
+			},
+		},
+		{
+			name: "ignore-scope-version",
+			compareOptions: []TracesCompareOption{
+				IgnoreScopeVersion(),
+			},
+			withoutOptions: expectation{
+				err:    errors.New("instrumentation library Version does not match expected: 1.0, actual: 2.0"),
+				reason: "An unpredictable scope version will cause failures if not ignored.",
+			},
+			withOptions: expectation{
+				err:    nil,
+				reason: "The scope version was ignored.",
+			},
+		},
+		{
+			name: "ignore-trace-ids",
+			compareOptions: []TracesCompareOption{
+				IgnoreTraceIDs(),
+			},
+			withoutOptions: expectation{
+				err: multierr.Combine(
+					errors.New("span with attributes: map[span.role:parent], does not match expected map[span.role:parent]"),
+					errors.New("span TraceID doesn't match expected: [75 249 47 53 119 179 77 166 163 206 146 157 14 14 71 54], actual: [140 139 23 101 167 176 172 240 182 106 164 98 63 203 123 213]"),
+				),
+				reason: "A random trace ID will cause failures if not ignored.",
+			},
+			withOptions: expectation{
+				err:    nil,
+				reason: "The trace IDs were ignored.",
+			},
+		},
+		{
+			name: "ignore-span-ids",
+			compareOptions: []TracesCompareOption{
+				IgnoreSpanIDs(),
+			},
+			withoutOptions: expectation{
+				err: multierr.Combine(
+					errors.New("span with attributes: map[span.role:parent], does not match expected map[span.role:parent]"),
+					errors.New("span SpanID doesn't match expected: [0 240 103 170 11 169 2 183], actual: [253 13 168 131 187 39 205 107]"),
+				),
+				reason: "A random span ID will cause failures if not ignored.",
+			},
+			withOptions: expectation{
+				err:    nil,
+				reason: "The span IDs were ignored, and the parent/child relationship is the same.",
+			},
+		},
+		{
+			name: "span-ids-inconsistent-parent",
+			compareOptions: []TracesCompareOption{
+				IgnoreSpanIDs(),
+			},
+			withoutOptions: expectation{
+				err: multierr.Combine(
+					errors.New("span with attributes: map[span.role:parent], does not match expected map[span.role:parent]"),
+					errors.New("span SpanID doesn't match expected: [0 240 103 170 11 169 2 183], actual: [253 13 168 131 187 39 205 107]"),
+				),
+				reason: "A random span ID will cause failures if not ignored.",
+			},
+			withOptions: expectation{
+				err: multierr.Combine(
+					errors.New("span with attributes: map[span.role:child], does not match expected map[span.role:child]"),
+					errors.New("span ParentSpanID doesn't match expected: [0 0 0 0 0 0 0 3], actual: [0 0 0 0 0 0 0 1]"),
+				),
+				reason: "A child span that doesn't refer to its parent span should cause a failure even if the span IDs are ignored.",
+			},
+		},
+		{
+			name: "ignore-span-timestamps",
+			compareOptions: []TracesCompareOption{
+				IgnoreSpanTimestamps(),
+			},
+			withoutOptions: expectation{
+				err: multierr.Combine(
+					errors.New("span with attributes: map[span.role:parent], does not match expected map[span.role:parent]"),
+					errors.New("span StartTimestamp doesn't match expected: 1673879495825860785, actual: 1673879494838206464"),
+				),
+				reason: "Span timestamps will cause failures if not ignored.",
+			},
+			withOptions: expectation{
+				err:    nil,
+				reason: "The span and event timestamps were ignored.",
+			},
+		},
 		{
 			name: "ignore-resource-order",
 			compareOptions: []TracesCompareOption{
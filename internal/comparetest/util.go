@@ -17,7 +17,9 @@ package comparetest // import "github.com/open-telemetry/opentelemetry-collector
 import (
 	"bytes"
 	"fmt"
+	"reflect"
 
+	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/pdata/ptrace"
@@ -34,6 +36,54 @@ func metricsByName(metricSlice pmetric.MetricSlice) map[string]pmetric.Metric {
 	return byName
 }
 
+// attributesIndex matches the items of a slice, e.g. data points, by their attributes. The items are
+// looked up by the hash of their attributes, so that matching two slices doesn't compare every pair
+// of items, which is slow for the slices of tens of thousands of data points.
+type attributesIndex struct {
+	attributes func(i int) pcommon.Map
+	hashes     [][16]byte
+	byHash     map[[16]byte][]int
+}
+
+func newAttributesIndex(numItems int, attributes func(i int) pcommon.Map) *attributesIndex {
+	idx := &attributesIndex{
+		attributes: attributes,
+		hashes:     make([][16]byte, numItems),
+		byHash:     make(map[[16]byte][]int, numItems),
+	}
+	for i := 0; i < numItems; i++ {
+		hash := pdatautil.MapHash(attributes(i))
+		idx.hashes[i] = hash
+		idx.byHash[hash] = append(idx.byHash[hash], i)
+	}
+	return idx
+}
+
+// match returns the index of the first item not matched yet that has the given attributes, and marks
+// it as matched. It returns -1 if there is no such item. The attributes of the items with the same hash
+// are compared in full.
+func (idx *attributesIndex) match(attributes pcommon.Map) int {
+	raw := attributes.AsRaw()
+	for _, i := range idx.byHash[pdatautil.MapHash(attributes)] {
+		if reflect.DeepEqual(raw, idx.attributes(i).AsRaw()) {
+			idx.take(i)
+			return i
+		}
+	}
+	return -1
+}
+
+// take removes the item from the index, so that it can't be matched again.
+func (idx *attributesIndex) take(i int) {
+	candidates := idx.byHash[idx.hashes[i]]
+	for j, c := range candidates {
+		if c == i {
+			idx.byHash[idx.hashes[i]] = append(candidates[:j:j], candidates[j+1:]...)
+			return
+		}
+	}
+}
+
 func getDataPointSlice(metric pmetric.Metric) pmetric.NumberDataPointSlice {
 	var dataPointSlice pmetric.NumberDataPointSlice
 	switch metric.Type() {
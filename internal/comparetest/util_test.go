@@ -0,0 +1,89 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package comparetest
+
+import (
+	"math"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+func TestAttributesIndex(t *testing.T) {
+	attributes := []pcommon.Map{pcommon.NewMap(), pcommon.NewMap(), pcommon.NewMap(), pcommon.NewMap()}
+	attributes[0].PutStr("key", "a")
+	attributes[1].PutStr("key", "b")
+	attributes[2].PutStr("key", "a")
+	// -0 is equal to 0, but its hash isn't the same.
+	attributes[3].PutDouble("value", math.Copysign(0, -1))
+	idx := newAttributesIndex(len(attributes), func(i int) pcommon.Map { return attributes[i] })
+
+	lookup := pcommon.NewMap()
+	lookup.PutStr("key", "a")
+	assert.Equal(t, 0, idx.match(lookup))
+	assert.Equal(t, 2, idx.match(lookup))
+	assert.Equal(t, -1, idx.match(lookup))
+
+	lookup = pcommon.NewMap()
+	lookup.PutStr("key", "b")
+	assert.Equal(t, 1, idx.match(lookup))
+
+	lookup = pcommon.NewMap()
+	lookup.PutDouble("value", 0)
+	assert.Equal(t, 3, idx.match(lookup))
+	assert.Equal(t, -1, idx.match(lookup))
+}
+
+func TestCompareMetricsManyDataPoints(t *testing.T) {
+	expected := manyDataPointsMetrics(20000, false)
+	require.NoError(t, CompareMetrics(expected, manyDataPointsMetrics(20000, false)))
+
+	err := CompareMetrics(expected, manyDataPointsMetrics(20000, true))
+	var orderErr *DataPointOrderError
+	require.ErrorAs(t, err, &orderErr)
+	require.NoError(t, CompareMetrics(expected, manyDataPointsMetrics(20000, true), IgnoreMetricDataPointsOrder()))
+}
+
+func BenchmarkCompareMetricsManyDataPoints(b *testing.B) {
+	expected, actual := manyDataPointsMetrics(20000, false), manyDataPointsMetrics(20000, true)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = CompareMetrics(expected, actual)
+	}
+}
+
+// manyDataPointsMetrics returns a gauge with a data point per index, e.g. the stats of many indices,
+// in the reverse order if reversed is true.
+func manyDataPointsMetrics(numPoints int, reversed bool) pmetric.Metrics {
+	metrics := pmetric.NewMetrics()
+	metric := metrics.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+	metric.SetName("index.documents")
+	dps := metric.SetEmptyGauge().DataPoints()
+	for i := 0; i < numPoints; i++ {
+		n := i
+		if reversed {
+			n = numPoints - 1 - i
+		}
+		dp := dps.AppendEmpty()
+		dp.Attributes().PutStr("index", "index-"+strconv.Itoa(n))
+		dp.Attributes().PutStr("cluster", "cluster")
+		dp.SetIntValue(int64(n))
+	}
+	return metrics
+}
@@ -53,6 +53,17 @@ type ActionKeyValue struct {
 	// no extraction will occur.
 	RegexPattern string `mapstructure:"pattern"`
 
+	// ExtractedTypes specifies, per named matcher group of the EXTRACT
+	// action, the type the extracted value is converted to.
+	// The set of types are {string, int, double, bool}. Groups not listed
+	// are inserted as strings. If a value cannot be converted, it is left
+	// as a string.
+	ExtractedTypes map[string]string `mapstructure:"extracted_types"`
+
+	// DeleteSource specifies whether the attribute specified by `key` is
+	// deleted once the EXTRACT action has matched its value.
+	DeleteSource bool `mapstructure:"delete_source"`
+
 	// FromAttribute specifies the attribute to use to populate
 	// the value. If the attribute doesn't exist, no action is performed.
 	FromAttribute string `mapstructure:"from_attribute"`
@@ -157,6 +168,11 @@ type attributeAction struct {
 	AttrNames []string
 	// Number of non empty strings in above array
 
+	// Target types of the extracted attributes, keyed by attribute name.
+	ExtractedTypes map[string]string
+	// Whether the source attribute is removed after a successful extraction.
+	DeleteSource bool
+
 	// TODO https://go.opentelemetry.io/collector/issues/296
 	// Do benchmark testing between having action be of type string vs integer.
 	// The reason is attributes processor will most likely be commonly used
@@ -192,6 +208,10 @@ func NewAttrProc(settings *Settings) (*AttrProc, error) {
 			}
 		}
 
+		if a.Action != EXTRACT && (len(a.ExtractedTypes) > 0 || a.DeleteSource) {
+			return nil, fmt.Errorf("error creating AttrProc. Action \"%s\" does not use the \"extracted_types\" or \"delete_source\" fields. These must not be specified for %d-th action", a.Action, i)
+		}
+
 		action := attributeAction{
 			Key:    a.Key,
 			Action: a.Action,
@@ -265,8 +285,20 @@ func NewAttrProc(settings *Settings) (*AttrProc, error) {
 					return nil, fmt.Errorf("error creating AttrProc. Field \"pattern\" contains at least one unnamed matcher group at the %d-th actions", i)
 				}
 			}
+			for name, typ := range a.ExtractedTypes {
+				if !containsString(attrNames[1:], name) {
+					return nil, fmt.Errorf("error creating AttrProc. Field \"extracted_types\" references \"%s\" which is not a named matcher group of \"pattern\" at the %d-th actions", name, i)
+				}
+				switch typ {
+				case stringConversionTarget, intConversionTarget, doubleConversionTarget, boolConversionTarget:
+				default:
+					return nil, fmt.Errorf("error creating AttrProc due to invalid value \"%s\" in field \"extracted_types\" for action \"%s\" at the %d-th action", typ, a.Action, i)
+				}
+			}
 			action.Regex = re
 			action.AttrNames = attrNames
+			action.ExtractedTypes = a.ExtractedTypes
+			action.DeleteSource = a.DeleteSource
 		case CONVERT:
 			if valueSourceCount > 0 || a.RegexPattern != "" {
 				return nil, fmt.Errorf("error creating AttrProc. Action \"%s\" does not use value sources or \"pattern\" field. These must not be specified for %d-th action", a.Action, i)
@@ -341,7 +373,7 @@ func (ap *AttrProc) Process(ctx context.Context, logger *zap.Logger, attrs pcomm
 				hashAttribute(k, attrs)
 			}
 		case EXTRACT:
-			extractAttributes(action, attrs)
+			extractAttributes(logger, action, attrs)
 		case CONVERT:
 			convertAttribute(logger, action, attrs)
 		}
@@ -415,7 +447,7 @@ func convertAttribute(logger *zap.Logger, action attributeAction, attrs pcommon.
 	}
 }
 
-func extractAttributes(action attributeAction, attrs pcommon.Map) {
+func extractAttributes(logger *zap.Logger, action attributeAction, attrs pcommon.Map) {
 	value, found := attrs.Get(action.Key)
 
 	// Extracting values only functions on strings.
@@ -433,8 +465,27 @@ func extractAttributes(action attributeAction, attrs pcommon.Map) {
 	// Start from index 1, which is the first submatch (index 0 is the entire
 	// match).
 	for i := 1; i < len(matches); i++ {
-		attrs.PutStr(action.AttrNames[i], matches[i])
+		name := action.AttrNames[i]
+		attrs.PutStr(name, matches[i])
+		if typ, ok := action.ExtractedTypes[name]; ok {
+			value, _ := attrs.Get(name)
+			convertValue(logger, name, typ, value)
+		}
+	}
+
+	// Keep the source if one of the matcher groups has overwritten it.
+	if action.DeleteSource && !containsString(action.AttrNames[1:], action.Key) {
+		attrs.Remove(action.Key)
+	}
+}
+
+func containsString(values []string, s string) bool {
+	for _, v := range values {
+		if v == s {
+			return true
+		}
 	}
+	return false
 }
 
 func getMatchingKeys(regexp *regexp.Regexp, attrs pcommon.Map) []string {
@@ -28,6 +28,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/client"
 	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.uber.org/zap"
 )
 
 // Common structure for all the Tests
@@ -427,6 +428,94 @@ func TestAttributes_Extract(t *testing.T) {
 	}
 }
 
+func TestAttributes_ExtractTyped(t *testing.T) {
+	testCases := []testCase{
+		{
+			name: "No extract with no pattern matching keeps source",
+			inputAttributes: map[string]interface{}{
+				"http.log": "not a log line",
+			},
+			expectedAttributes: map[string]interface{}{
+				"http.log": "not a log line",
+			},
+		},
+		{
+			name: "Extract converts typed groups and deletes source",
+			inputAttributes: map[string]interface{}{
+				"http.log": "GET 404 0.25 cached=true",
+			},
+			expectedAttributes: map[string]interface{}{
+				"method":   "GET",
+				"status":   int64(404),
+				"duration": 0.25,
+				"cached":   true,
+			},
+		},
+		{
+			name: "Extract leaves unconvertible values as strings",
+			inputAttributes: map[string]interface{}{
+				"http.log": "POST 2xx 1.5 cached=maybe",
+			},
+			expectedAttributes: map[string]interface{}{
+				"method":   "POST",
+				"status":   "2xx",
+				"duration": 1.5,
+				"cached":   "maybe",
+			},
+		},
+	}
+
+	cfg := &Settings{
+		Actions: []ActionKeyValue{
+			{
+				Key:          "http.log",
+				RegexPattern: `^(?P<method>\w+) (?P<status>\S+) (?P<duration>\S+) cached=(?P<cached>\w+)$`,
+				ExtractedTypes: map[string]string{
+					"status":   "int",
+					"duration": "double",
+					"cached":   "bool",
+				},
+				DeleteSource: true,
+				Action:       EXTRACT,
+			},
+		},
+	}
+
+	ap, err := NewAttrProc(cfg)
+	require.Nil(t, err)
+	require.NotNil(t, ap)
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			inputMap := pcommon.NewMap()
+			assert.NoError(t, inputMap.FromRaw(tt.inputAttributes))
+			ap.Process(context.TODO(), zap.NewNop(), inputMap)
+			require.Equal(t, tt.expectedAttributes, inputMap.AsRaw())
+		})
+	}
+}
+
+func TestAttributes_ExtractDeleteSourceOverwritten(t *testing.T) {
+	cfg := &Settings{
+		Actions: []ActionKeyValue{
+			{Key: "version", RegexPattern: "^v(?P<version>\\d+)$", ExtractedTypes: map[string]string{"version": "int"}, DeleteSource: true, Action: EXTRACT},
+		},
+	}
+
+	ap, err := NewAttrProc(cfg)
+	require.Nil(t, err)
+
+	runIndividualTestCase(t, testCase{
+		name: "Source overwritten by matcher group is kept",
+		inputAttributes: map[string]interface{}{
+			"version": "v2",
+		},
+		expectedAttributes: map[string]interface{}{
+			"version": int64(2),
+		},
+	}, ap)
+}
+
 func TestAttributes_UpsertFromAttribute(t *testing.T) {
 
 	testCases := []testCase{
@@ -901,6 +990,34 @@ func TestInvalidConfig(t *testing.T) {
 			},
 			errorString: "error creating AttrProc. Field \"pattern\" contains at least one unnamed matcher group at the 0-th actions",
 		},
+		{
+			name: "extracted types for non extract action",
+			actionLists: []ActionKeyValue{
+				{Key: "aa", Value: "bb", ExtractedTypes: map[string]string{"aa": "int"}, Action: INSERT},
+			},
+			errorString: "error creating AttrProc. Action \"insert\" does not use the \"extracted_types\" or \"delete_source\" fields. These must not be specified for 0-th action",
+		},
+		{
+			name: "delete source for non extract action",
+			actionLists: []ActionKeyValue{
+				{Key: "aa", DeleteSource: true, Action: DELETE},
+			},
+			errorString: "error creating AttrProc. Action \"delete\" does not use the \"extracted_types\" or \"delete_source\" fields. These must not be specified for 0-th action",
+		},
+		{
+			name: "extracted types with unknown group",
+			actionLists: []ActionKeyValue{
+				{Key: "aa", RegexPattern: "(?P<operation_website>.*?)$", ExtractedTypes: map[string]string{"operation": "int"}, Action: EXTRACT},
+			},
+			errorString: "error creating AttrProc. Field \"extracted_types\" references \"operation\" which is not a named matcher group of \"pattern\" at the 0-th actions",
+		},
+		{
+			name: "extracted types with invalid type",
+			actionLists: []ActionKeyValue{
+				{Key: "aa", RegexPattern: "(?P<operation_website>.*?)$", ExtractedTypes: map[string]string{"operation_website": "float"}, Action: EXTRACT},
+			},
+			errorString: "error creating AttrProc due to invalid value \"float\" in field \"extracted_types\" for action \"extract\" at the 0-th action",
+		},
 	}
 
 	for _, tc := range testcase {
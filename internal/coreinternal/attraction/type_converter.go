@@ -25,6 +25,7 @@ const (
 	stringConversionTarget = "string"
 	intConversionTarget    = "int"
 	doubleConversionTarget = "double"
+	boolConversionTarget   = "bool"
 )
 
 func convertValue(logger *zap.Logger, key string, to string, v pcommon.Value) {
@@ -79,6 +80,24 @@ func convertValue(logger *zap.Logger, key string, to string, v pcommon.Value) {
 		default:
 			logger.Debug("Unable to convert type", zap.String("key", key), zap.String("from", v.Type().String()), zap.String("to", doubleConversionTarget))
 		}
+	case boolConversionTarget:
+		switch v.Type() {
+		case pcommon.ValueTypeInt:
+			v.SetBool(v.Int() != 0)
+		case pcommon.ValueTypeDouble:
+			v.SetBool(v.Double() != 0)
+		case pcommon.ValueTypeBool:
+		case pcommon.ValueTypeStr:
+			s := v.Str()
+			b, err := strconv.ParseBool(s)
+			if err == nil {
+				v.SetBool(b)
+			} else {
+				logger.Debug("String could not be converted to bool", zap.String("key", key), zap.String("value", s), zap.Error(err))
+			}
+		default:
+			logger.Debug("Unable to convert type", zap.String("key", key), zap.String("from", v.Type().String()), zap.String("to", boolConversionTarget))
+		}
 	default: // No-op
 	}
 }
@@ -0,0 +1,148 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package grpccompression provides the compression negotiation shared by the gRPC exporters:
+// when the server can't decompress the configured compression, e.g. zstd or snappy, the
+// exporter falls back to gzip, then to no compression, instead of failing every request.
+package grpccompression // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/grpccompression"
+
+import (
+	"context"
+	"strings"
+	"sync/atomic"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/encoding"
+	"google.golang.org/grpc/status"
+)
+
+// gzipName is the name of the gzip compressor, which most gRPC servers support.
+const gzipName = "gzip"
+
+// Negotiator falls back to a more widely supported compression when the server doesn't
+// support the current one. The fallback is kept for all the subsequent requests.
+type Negotiator struct {
+	logger       *zap.Logger
+	compressions []string
+	current      int32
+}
+
+// NewNegotiator returns a Negotiator starting with the configured compression, as set by the
+// compression setting of configgrpc.GRPCClientSettings, or nil if no compression is configured.
+func NewNegotiator(compression string, logger *zap.Logger) *Negotiator {
+	compression = strings.ToLower(compression)
+	if compression == "" || compression == "none" || compression == encoding.Identity {
+		return nil
+	}
+
+	compressions := []string{compression}
+	if compression != gzipName {
+		compressions = append(compressions, gzipName)
+	}
+	compressions = append(compressions, encoding.Identity)
+
+	return &Negotiator{logger: logger, compressions: compressions}
+}
+
+// DialOptions returns the options to pass to configgrpc.GRPCClientSettings.ToClientConn so that
+// the requests are compressed with the negotiated compression. It is safe to call on a nil Negotiator.
+func (n *Negotiator) DialOptions() []grpc.DialOption {
+	if n == nil {
+		return nil
+	}
+	return []grpc.DialOption{
+		grpc.WithChainUnaryInterceptor(n.unaryInterceptor),
+		grpc.WithChainStreamInterceptor(n.streamInterceptor),
+	}
+}
+
+// Compression returns the name of the compression currently used.
+func (n *Negotiator) Compression() string {
+	return n.compressions[atomic.LoadInt32(&n.current)]
+}
+
+// unaryInterceptor retries the request with the fallback compressions until the server accepts it.
+func (n *Negotiator) unaryInterceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn,
+	invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	for {
+		current := atomic.LoadInt32(&n.current)
+		err := invoker(ctx, method, req, reply, cc, n.callOptions(current, opts)...)
+		if !isUnsupportedCompression(err) || !n.fallback(current) {
+			return err
+		}
+	}
+}
+
+// streamInterceptor can't retry the messages already sent on the stream, so the fallback
+// compression is only used by the subsequent streams.
+func (n *Negotiator) streamInterceptor(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string,
+	streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	current := atomic.LoadInt32(&n.current)
+	stream, err := streamer(ctx, desc, cc, method, n.callOptions(current, opts)...)
+	if err != nil {
+		if isUnsupportedCompression(err) {
+			n.fallback(current)
+		}
+		return nil, err
+	}
+	return &negotiatedStream{ClientStream: stream, negotiator: n, compression: current}, nil
+}
+
+func (n *Negotiator) callOptions(current int32, opts []grpc.CallOption) []grpc.CallOption {
+	// The last compressor option takes precedence over the one set with the default call options.
+	return append(opts[:len(opts):len(opts)], grpc.UseCompressor(n.compressions[current]))
+}
+
+// fallback moves on to the next compression if the given one is still the current one,
+// and returns false if there is no compression left to try.
+func (n *Negotiator) fallback(current int32) bool {
+	next := current + 1
+	if int(next) >= len(n.compressions) {
+		return false
+	}
+	if atomic.CompareAndSwapInt32(&n.current, current, next) {
+		n.logger.Warn("The server does not support the compression, falling back",
+			zap.String("compression", n.compressions[current]),
+			zap.String("fallback", n.compressions[next]),
+		)
+	}
+	return true
+}
+
+// isUnsupportedCompression returns whether the server rejected the request because it has no
+// decompressor for its compression. The status message is implementation specific, e.g.
+// `grpc: Decompressor is not installed for grpc-encoding "zstd"` for grpc-go servers.
+func isUnsupportedCompression(err error) bool {
+	if err == nil {
+		return false
+	}
+	s, ok := status.FromError(err)
+	return ok && s.Code() == codes.Unimplemented && strings.Contains(strings.ToLower(s.Message()), "decompressor")
+}
+
+type negotiatedStream struct {
+	grpc.ClientStream
+	negotiator  *Negotiator
+	compression int32
+}
+
+func (s *negotiatedStream) RecvMsg(m interface{}) error {
+	err := s.ClientStream.RecvMsg(m)
+	if isUnsupportedCompression(err) {
+		s.negotiator.fallback(s.compression)
+	}
+	return err
+}
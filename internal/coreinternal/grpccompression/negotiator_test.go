@@ -0,0 +1,157 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpccompression
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeServer accepts the requests compressed with the supported compressions only,
+// like a gRPC server without the decompressors of the other compressions.
+type fakeServer struct {
+	supported map[string]bool
+	received  []string
+}
+
+func (s *fakeServer) handle(opts []grpc.CallOption) error {
+	compression := ""
+	for _, opt := range opts {
+		if c, ok := opt.(grpc.CompressorCallOption); ok {
+			compression = c.CompressorType
+		}
+	}
+	s.received = append(s.received, compression)
+	if !s.supported[compression] {
+		return status.Errorf(codes.Unimplemented, "grpc: Decompressor is not installed for grpc-encoding %q", compression)
+	}
+	return nil
+}
+
+func (s *fakeServer) invoker(_ context.Context, _ string, _, _ interface{}, _ *grpc.ClientConn, opts ...grpc.CallOption) error {
+	return s.handle(opts)
+}
+
+func TestNewNegotiator(t *testing.T) {
+	assert.Nil(t, NewNegotiator("", zap.NewNop()))
+	assert.Nil(t, NewNegotiator("none", zap.NewNop()))
+	assert.Nil(t, (*Negotiator)(nil).DialOptions())
+
+	assert.Equal(t, []string{"zstd", "gzip", "identity"}, NewNegotiator("zstd", zap.NewNop()).compressions)
+	assert.Equal(t, []string{"snappy", "gzip", "identity"}, NewNegotiator("snappy", zap.NewNop()).compressions)
+	assert.Equal(t, []string{"gzip", "identity"}, NewNegotiator("gzip", zap.NewNop()).compressions)
+	assert.Len(t, NewNegotiator("zstd", zap.NewNop()).DialOptions(), 2)
+}
+
+func TestUnaryInterceptor(t *testing.T) {
+	tests := []struct {
+		name                string
+		compression         string
+		supported           []string
+		expectedReceived    []string
+		expectedCompression string
+	}{
+		{
+			name:                "supported",
+			compression:         "zstd",
+			supported:           []string{"zstd", "gzip"},
+			expectedReceived:    []string{"zstd", "zstd"},
+			expectedCompression: "zstd",
+		},
+		{
+			name:                "fallback to gzip",
+			compression:         "zstd",
+			supported:           []string{"gzip"},
+			expectedReceived:    []string{"zstd", "gzip", "gzip"},
+			expectedCompression: "gzip",
+		},
+		{
+			name:                "fallback to no compression",
+			compression:         "snappy",
+			supported:           []string{"identity"},
+			expectedReceived:    []string{"snappy", "gzip", "identity", "identity"},
+			expectedCompression: "identity",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := &fakeServer{supported: map[string]bool{}}
+			for _, s := range tt.supported {
+				server.supported[s] = true
+			}
+			n := NewNegotiator(tt.compression, zap.NewNop())
+
+			// The first request negotiates the compression, the second one uses it directly.
+			for i := 0; i < 2; i++ {
+				require.NoError(t, n.unaryInterceptor(context.Background(), "/test", nil, nil, nil, server.invoker))
+			}
+			assert.Equal(t, tt.expectedReceived, server.received)
+			assert.Equal(t, tt.expectedCompression, n.Compression())
+		})
+	}
+}
+
+func TestUnaryInterceptorOtherErrors(t *testing.T) {
+	n := NewNegotiator("zstd", zap.NewNop())
+	calls := 0
+	invoker := func(context.Context, string, interface{}, interface{}, *grpc.ClientConn, ...grpc.CallOption) error {
+		calls++
+		return status.Error(codes.Unimplemented, "unknown service")
+	}
+
+	err := n.unaryInterceptor(context.Background(), "/test", nil, nil, nil, invoker)
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+	assert.Equal(t, 1, calls, "only unsupported compressions should be retried")
+	assert.Equal(t, "zstd", n.Compression())
+
+	assert.False(t, isUnsupportedCompression(nil))
+	assert.False(t, isUnsupportedCompression(errors.New("decompressor")))
+}
+
+type fakeStream struct {
+	grpc.ClientStream
+	err error
+}
+
+func (s *fakeStream) RecvMsg(interface{}) error {
+	return s.err
+}
+
+func TestStreamInterceptor(t *testing.T) {
+	server := &fakeServer{supported: map[string]bool{"gzip": true}}
+	n := NewNegotiator("zstd", zap.NewNop())
+	streamer := func(_ context.Context, _ *grpc.StreamDesc, _ *grpc.ClientConn, _ string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		// The server reports the error when the first message is received.
+		return &fakeStream{err: server.handle(opts)}, nil
+	}
+
+	for i, expectedErr := range []bool{true, false} {
+		stream, err := n.streamInterceptor(context.Background(), &grpc.StreamDesc{}, nil, "/test", streamer)
+		require.NoError(t, err)
+		assert.Equal(t, expectedErr, stream.RecvMsg(nil) != nil, fmt.Sprintf("stream %d", i))
+	}
+	assert.Equal(t, []string{"zstd", "gzip"}, server.received)
+	assert.Equal(t, "gzip", n.Compression())
+}
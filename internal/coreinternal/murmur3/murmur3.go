@@ -12,10 +12,11 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-package probabilisticsamplerprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/probabilisticsamplerprocessor"
+package murmur3 // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/murmur3"
 
-// hash is a murmur3 hash function, see http://en.wikipedia.org/wiki/MurmurHash
-func hash(key []byte, seed uint32) (hash uint32) {
+// Hash is a murmur3 hash function, see http://en.wikipedia.org/wiki/MurmurHash
+// It is used by the sampling processors to hash trace and log record IDs.
+func Hash(key []byte, seed uint32) (hash uint32) {
 	const (
 		c1 = 0xcc9e2d51
 		c2 = 0x1b873593
@@ -12,7 +12,7 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-package probabilisticsamplerprocessor
+package murmur3
 
 import (
 	"math/rand"
@@ -34,7 +34,7 @@ func TestHashHasNoCollisions(t *testing.T) {
 	seen := make(map[uint32]bool)
 	for i := 1; i <= len(fullKey); i++ {
 		key := fullKey[:i]
-		hash := hash(key, 1)
+		hash := Hash(key, 1)
 		require.False(t, seen[hash], "Unexpected duplicated hash")
 		seen[hash] = true
 	}
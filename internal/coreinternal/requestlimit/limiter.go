@@ -0,0 +1,208 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package requestlimit provides guards shared by the receivers accepting spans
+// over legacy HTTP protocols: a cap on the request size, before and after
+// decompression, a cap on the number of spans per request and a rate limit
+// delegated to the rate limiter extension.
+package requestlimit // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/requestlimit"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+var errNegativeLimit = errors.New("request limits must not be negative")
+
+// Settings configures the limits applied to inbound requests. A zero value disables the associated limit.
+type Settings struct {
+	// MaxRequestBytes is the maximum size of a request body, both as received on the wire
+	// and once decompressed.
+	MaxRequestBytes int64 `mapstructure:"max_request_bytes"`
+
+	// MaxSpansPerRequest is the maximum number of spans accepted in a single request.
+	MaxSpansPerRequest int `mapstructure:"max_spans_per_request"`
+
+	// RateLimiter is the ID of the rate limiter extension limiting the rate of the spans accepted.
+	RateLimiter *component.ID `mapstructure:"rate_limiter"`
+}
+
+// Validate checks if the limits are valid.
+func (s *Settings) Validate() error {
+	if s.MaxRequestBytes < 0 || s.MaxSpansPerRequest < 0 {
+		return errNegativeLimit
+	}
+	return nil
+}
+
+// RateLimiter is the interface implemented by the rate limiter extension.
+type RateLimiter interface {
+	Limit(ctx context.Context, attrs pcommon.Map, n int) error
+}
+
+// httpError is implemented by the errors of the rate limiter extension, which
+// write their own response, e.g. with a Retry-After header.
+type httpError interface {
+	error
+	WriteHTTP(w http.ResponseWriter)
+}
+
+// TooManySpansError is returned by Check when a request holds more spans than allowed.
+type TooManySpansError struct {
+	Count int
+	Max   int
+}
+
+func (e *TooManySpansError) Error() string {
+	return fmt.Sprintf("request contains %d spans, the maximum allowed is %d", e.Count, e.Max)
+}
+
+// TooLargeError is returned when reading a request body larger than allowed.
+type TooLargeError struct {
+	Max int64
+}
+
+func (e *TooLargeError) Error() string {
+	return fmt.Sprintf("request body exceeds the maximum of %d bytes", e.Max)
+}
+
+// Limiter enforces the configured Settings.
+type Limiter struct {
+	settings    Settings
+	rateLimiter RateLimiter
+}
+
+// NewLimiter creates a Limiter from the given settings.
+func NewLimiter(settings Settings) *Limiter {
+	return &Limiter{settings: settings}
+}
+
+// Start looks up the rate limiter extension, if one is configured.
+func (l *Limiter) Start(host component.Host) error {
+	if l.settings.RateLimiter == nil {
+		return nil
+	}
+	ext, ok := host.GetExtensions()[*l.settings.RateLimiter]
+	if !ok {
+		return fmt.Errorf("rate limiter extension '%s' not found", l.settings.RateLimiter)
+	}
+	rateLimiter, ok := ext.(RateLimiter)
+	if !ok {
+		return fmt.Errorf("non-rate-limiter extension '%s' found", l.settings.RateLimiter)
+	}
+	l.rateLimiter = rateLimiter
+	return nil
+}
+
+// Handler wraps the given handler, rejecting requests declaring a body larger than
+// allowed with 413. Bodies sent without a declared length fail with a *TooLargeError
+// once more than the maximum size is read.
+func (l *Limiter) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if max := l.settings.MaxRequestBytes; max > 0 {
+			if r.ContentLength > max {
+				http.Error(w, (&TooLargeError{Max: max}).Error(), http.StatusRequestEntityTooLarge)
+				return
+			}
+			r.Body = &maxBytesReader{ReadCloser: r.Body, max: max}
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// ReadBody reads a request body, once decompressed, returning a *TooLargeError if it is
+// larger than the maximum size, so that small compressed bodies can't expand without bound.
+func (l *Limiter) ReadBody(r io.Reader) ([]byte, error) {
+	max := l.settings.MaxRequestBytes
+	if max <= 0 {
+		return io.ReadAll(r)
+	}
+	body, err := io.ReadAll(io.LimitReader(r, max+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(body)) > max {
+		return nil, &TooLargeError{Max: max}
+	}
+	return body, nil
+}
+
+// Check returns a *TooManySpansError if the traces hold more spans than allowed, and the
+// error of the rate limiter extension if their spans exceed the budget of the request.
+// The rate limiting key is looked up in the client metadata of ctx, then in the
+// attributes of the first resource, the legacy protocols sending the spans of a single
+// process per request.
+func (l *Limiter) Check(ctx context.Context, td ptrace.Traces) error {
+	count := td.SpanCount()
+	if max := l.settings.MaxSpansPerRequest; max > 0 && count > max {
+		return &TooManySpansError{Count: count, Max: max}
+	}
+	if l.rateLimiter == nil {
+		return nil
+	}
+	attrs := pcommon.NewMap()
+	if td.ResourceSpans().Len() > 0 {
+		attrs = td.ResourceSpans().At(0).Resource().Attributes()
+	}
+	return l.rateLimiter.Limit(ctx, attrs, count)
+}
+
+// WriteError writes the response to a failed request: 413 for requests too large,
+// the response of the rate limiter extension, typically 429, for requests over their
+// rate, and the given status code for the errors not raised by the limiter.
+func WriteError(w http.ResponseWriter, err error, statusCode int) {
+	var tooLarge *TooLargeError
+	var tooMany *TooManySpansError
+	var limited httpError
+	switch {
+	case errors.As(err, &tooLarge), errors.As(err, &tooMany):
+		http.Error(w, err.Error(), http.StatusRequestEntityTooLarge)
+	case errors.As(err, &limited):
+		limited.WriteHTTP(w)
+	default:
+		http.Error(w, err.Error(), statusCode)
+	}
+}
+
+// maxBytesReader fails with a *TooLargeError once more than max bytes are read,
+// unlike http.MaxBytesReader whose error can't be told apart from other read errors.
+type maxBytesReader struct {
+	io.ReadCloser
+	max  int64
+	read int64
+}
+
+func (r *maxBytesReader) Read(p []byte) (int, error) {
+	if r.read > r.max {
+		return 0, &TooLargeError{Max: r.max}
+	}
+	// read at most one byte past the maximum, to find out if the body is larger
+	if rest := r.max + 1 - r.read; int64(len(p)) > rest {
+		p = p[:rest]
+	}
+	n, err := r.ReadCloser.Read(p)
+	r.read += int64(n)
+	if r.read > r.max {
+		return n - 1, &TooLargeError{Max: r.max}
+	}
+	return n, err
+}
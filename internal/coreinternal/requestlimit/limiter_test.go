@@ -0,0 +1,248 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package requestlimit
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+type testRateLimiter struct {
+	component.StartFunc
+	component.ShutdownFunc
+
+	key   string
+	count int
+	err   error
+}
+
+func (r *testRateLimiter) Limit(_ context.Context, attrs pcommon.Map, n int) error {
+	if v, ok := attrs.Get("tenant"); ok {
+		r.key = v.Str()
+	}
+	r.count = n
+	return r.err
+}
+
+type limitedError struct{}
+
+func (limitedError) Error() string {
+	return "rate limited"
+}
+
+func (limitedError) WriteHTTP(w http.ResponseWriter) {
+	w.Header().Set("Retry-After", "1")
+	http.Error(w, "rate limited", http.StatusTooManyRequests)
+}
+
+type testHost struct {
+	component.Host
+	extensions map[component.ID]component.Component
+}
+
+func (h *testHost) GetExtensions() map[component.ID]component.Component {
+	return h.extensions
+}
+
+func newTraces(tenant string, spans int) ptrace.Traces {
+	td := ptrace.NewTraces()
+	rs := td.ResourceSpans().AppendEmpty()
+	rs.Resource().Attributes().PutStr("tenant", tenant)
+	ss := rs.ScopeSpans().AppendEmpty()
+	for i := 0; i < spans; i++ {
+		ss.Spans().AppendEmpty()
+	}
+	return td
+}
+
+func TestValidate(t *testing.T) {
+	assert.NoError(t, (&Settings{}).Validate())
+	assert.NoError(t, (&Settings{MaxRequestBytes: 10, MaxSpansPerRequest: 5}).Validate())
+	assert.Equal(t, errNegativeLimit, (&Settings{MaxSpansPerRequest: -1}).Validate())
+	assert.Equal(t, errNegativeLimit, (&Settings{MaxRequestBytes: -1}).Validate())
+}
+
+func TestCheckSpans(t *testing.T) {
+	assert.NoError(t, NewLimiter(Settings{}).Check(context.Background(), newTraces("a", 100)))
+
+	l := NewLimiter(Settings{MaxSpansPerRequest: 10})
+	assert.NoError(t, l.Check(context.Background(), newTraces("a", 10)))
+
+	err := l.Check(context.Background(), newTraces("a", 11))
+	var tooMany *TooManySpansError
+	require.ErrorAs(t, err, &tooMany)
+	assert.Equal(t, 11, tooMany.Count)
+	assert.Equal(t, 10, tooMany.Max)
+}
+
+func TestCheckRate(t *testing.T) {
+	rateLimiterID := component.NewID("rate_limiter")
+	rateLimiter := &testRateLimiter{}
+	host := &testHost{
+		Host: componenttest.NewNopHost(),
+		extensions: map[component.ID]component.Component{
+			rateLimiterID: rateLimiter,
+			component.NewID("other"): &struct {
+				component.StartFunc
+				component.ShutdownFunc
+			}{},
+		},
+	}
+
+	l := NewLimiter(Settings{RateLimiter: &rateLimiterID})
+	require.NoError(t, l.Start(host))
+
+	require.NoError(t, l.Check(context.Background(), newTraces("a", 3)))
+	assert.Equal(t, "a", rateLimiter.key)
+	assert.Equal(t, 3, rateLimiter.count)
+
+	rateLimiter.err = limitedError{}
+	assert.Equal(t, limitedError{}, l.Check(context.Background(), newTraces("a", 3)))
+
+	// empty traces are passed to the rate limiter without attributes
+	rateLimiter.key = ""
+	assert.Equal(t, limitedError{}, l.Check(context.Background(), ptrace.NewTraces()))
+	assert.Equal(t, "", rateLimiter.key)
+	assert.Equal(t, 0, rateLimiter.count)
+
+	missingID := component.NewID("missing")
+	assert.EqualError(t, NewLimiter(Settings{RateLimiter: &missingID}).Start(host), "rate limiter extension 'missing' not found")
+
+	otherID := component.NewID("other")
+	assert.EqualError(t, NewLimiter(Settings{RateLimiter: &otherID}).Start(host), "non-rate-limiter extension 'other' found")
+}
+
+func TestReadBody(t *testing.T) {
+	body, err := NewLimiter(Settings{}).ReadBody(strings.NewReader("123456"))
+	require.NoError(t, err)
+	assert.Equal(t, "123456", string(body))
+
+	l := NewLimiter(Settings{MaxRequestBytes: 5})
+	body, err = l.ReadBody(strings.NewReader("12345"))
+	require.NoError(t, err)
+	assert.Equal(t, "12345", string(body))
+
+	_, err = l.ReadBody(strings.NewReader("123456"))
+	var tooLarge *TooLargeError
+	require.ErrorAs(t, err, &tooLarge)
+	assert.EqualValues(t, 5, tooLarge.Max)
+
+	// the limit applies to the decompressed body
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	_, err = gz.Write(bytes.Repeat([]byte("0"), 1000))
+	require.NoError(t, err)
+	require.NoError(t, gz.Close())
+
+	gzr, err := gzip.NewReader(&buf)
+	require.NoError(t, err)
+	_, err = NewLimiter(Settings{MaxRequestBytes: 100}).ReadBody(gzr)
+	require.ErrorAs(t, err, &tooLarge)
+
+	// read errors are passed through
+	readErr := errors.New("read error")
+	_, err = l.ReadBody(io.MultiReader(strings.NewReader("12"), &errorReader{err: readErr}))
+	assert.Equal(t, readErr, err)
+}
+
+type errorReader struct {
+	err error
+}
+
+func (r *errorReader) Read([]byte) (int, error) {
+	return 0, r.err
+}
+
+func TestHandler(t *testing.T) {
+	l := NewLimiter(Settings{MaxRequestBytes: 5})
+
+	var received string
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := l.ReadBody(r.Body)
+		if err != nil {
+			WriteError(w, err, http.StatusBadRequest)
+			return
+		}
+		received = string(body)
+		w.WriteHeader(http.StatusAccepted)
+	})
+	h := l.Handler(next)
+
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader("12345"))
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusAccepted, rec.Code)
+	assert.Equal(t, "12345", received)
+
+	// a body declared too large
+	req = httptest.NewRequest(http.MethodPost, "/", strings.NewReader("123456"))
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+
+	// bodies without a declared length are cut
+	req = httptest.NewRequest(http.MethodPost, "/", strings.NewReader("123456"))
+	req.ContentLength = -1
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+}
+
+func TestMaxBytesReader(t *testing.T) {
+	r := &maxBytesReader{ReadCloser: io.NopCloser(strings.NewReader("123456")), max: 5}
+	body, err := io.ReadAll(r)
+	var tooLarge *TooLargeError
+	require.ErrorAs(t, err, &tooLarge)
+	assert.Equal(t, "12345", string(body))
+
+	r = &maxBytesReader{ReadCloser: io.NopCloser(strings.NewReader("12345")), max: 5}
+	body, err = io.ReadAll(r)
+	require.NoError(t, err)
+	assert.Equal(t, "12345", string(body))
+}
+
+func TestWriteError(t *testing.T) {
+	rec := httptest.NewRecorder()
+	WriteError(rec, &TooManySpansError{Count: 2, Max: 1}, http.StatusBadRequest)
+	assert.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+
+	rec = httptest.NewRecorder()
+	WriteError(rec, fmt.Errorf("reading body: %w", &TooLargeError{Max: 1}), http.StatusBadRequest)
+	assert.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+
+	rec = httptest.NewRecorder()
+	WriteError(rec, limitedError{}, http.StatusBadRequest)
+	assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+	assert.Equal(t, "1", rec.Header().Get("Retry-After"))
+
+	rec = httptest.NewRecorder()
+	WriteError(rec, errors.New("other"), http.StatusBadRequest)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
@@ -0,0 +1,49 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deltacumulative // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/deltacumulative"
+
+import (
+	"encoding/hex"
+	"strings"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/pdatautil"
+)
+
+const keySeparator = "\x1e"
+
+// StreamKey returns the key identifying the stream of data points with the given attributes in the given metric.
+// The attribute hashes are hex encoded so that the key is valid UTF-8 and survives the JSON encoding of the state.
+func StreamKey(resource pcommon.Resource, scope pcommon.InstrumentationScope, metric pmetric.Metric, attributes pcommon.Map) string {
+	var b strings.Builder
+	resourceHash := pdatautil.MapHash(resource.Attributes())
+	b.WriteString(hex.EncodeToString(resourceHash[:]))
+	b.WriteString(keySeparator)
+	b.WriteString(scope.Name())
+	b.WriteString(keySeparator)
+	b.WriteString(scope.Version())
+	b.WriteString(keySeparator)
+	b.WriteString(metric.Type().String())
+	b.WriteString(keySeparator)
+	b.WriteString(metric.Name())
+	b.WriteString(keySeparator)
+	b.WriteString(metric.Unit())
+	b.WriteString(keySeparator)
+	attributesHash := pdatautil.MapHash(attributes)
+	b.WriteString(hex.EncodeToString(attributesHash[:]))
+	return b.String()
+}
@@ -0,0 +1,52 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deltacumulative
+
+import (
+	"testing"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+func TestStreamKey(t *testing.T) {
+	resource := pcommon.NewResource()
+	resource.Attributes().PutStr("host.name", "host")
+	scope := pcommon.NewInstrumentationScope()
+	scope.SetName("scope")
+	metric := pmetric.NewMetric()
+	metric.SetName("metric")
+	metric.SetEmptySum()
+	attributes := pcommon.NewMap()
+	attributes.PutStr("state", "idle")
+
+	key := StreamKey(resource, scope, metric, attributes)
+	assert.Equal(t, key, StreamKey(resource, scope, metric, attributes))
+	assert.True(t, utf8.ValidString(key))
+
+	otherAttributes := pcommon.NewMap()
+	otherAttributes.PutStr("state", "busy")
+	assert.NotEqual(t, key, StreamKey(resource, scope, metric, otherAttributes))
+
+	otherMetric := pmetric.NewMetric()
+	otherMetric.SetName("metric")
+	otherMetric.SetEmptyGauge()
+	assert.NotEqual(t, key, StreamKey(resource, scope, otherMetric, attributes))
+
+	otherResource := pcommon.NewResource()
+	assert.NotEqual(t, key, StreamKey(otherResource, scope, metric, attributes))
+}
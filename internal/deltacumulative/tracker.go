@@ -0,0 +1,243 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deltacumulative // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/deltacumulative"
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/extension/experimental/storage"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.uber.org/zap"
+)
+
+// storageKey is the key under which the tracker state is persisted.
+const storageKey = "deltacumulative_states"
+
+// Point is a number data point of a stream.
+type Point struct {
+	StartTimestamp pcommon.Timestamp `json:"start_timestamp"`
+	Timestamp      pcommon.Timestamp `json:"timestamp"`
+	IsFloat        bool              `json:"is_float"`
+	IntValue       int64             `json:"int_value"`
+	FloatValue     float64           `json:"float_value"`
+}
+
+type persistedStates struct {
+	Deltas      map[string]Point `json:"deltas"`
+	Cumulatives map[string]Point `json:"cumulatives"`
+}
+
+// Tracker keeps the last point of each stream to convert cumulative points to delta ones and the running total of
+// each stream to convert delta points to cumulative ones.
+type Tracker struct {
+	logger       *zap.Logger
+	maxStaleness time.Duration
+	client       storage.Client
+	cancel       context.CancelFunc
+
+	mu sync.Mutex
+	// deltas holds the last cumulative point of each stream converted to delta.
+	deltas map[string]Point
+	// cumulatives holds the last cumulative point of each stream converted to cumulative.
+	cumulatives map[string]Point
+}
+
+// NewTracker creates a Tracker. States of streams without points for longer than maxStaleness are removed;
+// a zero maxStaleness keeps them forever.
+func NewTracker(logger *zap.Logger, maxStaleness time.Duration) *Tracker {
+	return &Tracker{
+		logger:       logger,
+		maxStaleness: maxStaleness,
+		deltas:       map[string]Point{},
+		cumulatives:  map[string]Point{},
+	}
+}
+
+// Start loads the state persisted by a previous run from the storage client and starts the removal of stale states.
+// A nil client keeps the state in memory only.
+func (t *Tracker) Start(ctx context.Context, client storage.Client) error {
+	if client == nil {
+		client = storage.NewNopClient()
+	}
+	t.client = client
+
+	if err := t.load(ctx); err != nil {
+		return err
+	}
+
+	if t.maxStaleness > 0 {
+		sweepCtx, cancel := context.WithCancel(context.Background())
+		t.cancel = cancel
+		go t.sweeper(sweepCtx)
+	}
+	return nil
+}
+
+// Shutdown stops the removal of stale states and persists the state to the storage client.
+func (t *Tracker) Shutdown(ctx context.Context) error {
+	if t.cancel != nil {
+		t.cancel()
+	}
+	if t.client == nil {
+		return nil
+	}
+	return t.persist(ctx)
+}
+
+// ToDelta converts a cumulative point of the stream with the given key to a delta point. The returned bool is false
+// when no delta can be computed, e.g. for the first point of a stream or an out of order point.
+func (t *Tracker) ToDelta(key string, point Point, monotonic bool) (Point, bool) {
+	// NaN is used to signal stale points, these are ignored.
+	if point.IsFloat && math.IsNaN(point.FloatValue) {
+		return Point{}, false
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	prev, ok := t.deltas[key]
+	if ok && prev.IsFloat == point.IsFloat && point.Timestamp < prev.Timestamp {
+		return Point{}, false
+	}
+	t.deltas[key] = point
+	if !ok || prev.IsFloat != point.IsFloat {
+		return Point{}, false
+	}
+
+	out := Point{
+		StartTimestamp: prev.Timestamp,
+		Timestamp:      point.Timestamp,
+		IsFloat:        point.IsFloat,
+		IntValue:       point.IntValue - prev.IntValue,
+		FloatValue:     point.FloatValue - prev.FloatValue,
+	}
+
+	// A new start timestamp or a decreasing monotonic value means that the stream has been reset.
+	reset := point.StartTimestamp != 0 && point.StartTimestamp != prev.StartTimestamp
+	if monotonic && (out.IntValue < 0 || out.FloatValue < 0) {
+		reset = true
+	}
+	if reset {
+		if point.StartTimestamp != 0 {
+			out.StartTimestamp = point.StartTimestamp
+		}
+		out.IntValue = point.IntValue
+		out.FloatValue = point.FloatValue
+	}
+	return out, true
+}
+
+// ToCumulative converts a delta point of the stream with the given key to a cumulative point, adding it to the
+// running total of the stream.
+func (t *Tracker) ToCumulative(key string, point Point) Point {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	out := point
+	if out.StartTimestamp == 0 {
+		out.StartTimestamp = point.Timestamp
+	}
+
+	if prev, ok := t.cumulatives[key]; ok && prev.IsFloat == point.IsFloat {
+		out.StartTimestamp = prev.StartTimestamp
+		out.IntValue += prev.IntValue
+		out.FloatValue += prev.FloatValue
+		if out.Timestamp < prev.Timestamp {
+			out.Timestamp = prev.Timestamp
+		}
+	}
+
+	t.cumulatives[key] = out
+	return out
+}
+
+func (t *Tracker) load(ctx context.Context) error {
+	data, err := t.client.Get(ctx, storageKey)
+	if err != nil {
+		return fmt.Errorf("failed to load delta/cumulative states: %w", err)
+	}
+	if data == nil {
+		return nil
+	}
+
+	var states persistedStates
+	if err = json.Unmarshal(data, &states); err != nil {
+		// A corrupted state must not prevent the component from starting.
+		t.logger.Warn("Discarding persisted delta/cumulative states", zap.Error(err))
+		return nil
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if states.Deltas != nil {
+		t.deltas = states.Deltas
+	}
+	if states.Cumulatives != nil {
+		t.cumulatives = states.Cumulatives
+	}
+	return nil
+}
+
+func (t *Tracker) persist(ctx context.Context) error {
+	t.mu.Lock()
+	data, err := json.Marshal(persistedStates{Deltas: t.deltas, Cumulatives: t.cumulatives})
+	t.mu.Unlock()
+	if err != nil {
+		return fmt.Errorf("failed to marshal delta/cumulative states: %w", err)
+	}
+
+	if err = t.client.Set(ctx, storageKey, data); err != nil {
+		return fmt.Errorf("failed to persist delta/cumulative states: %w", err)
+	}
+	return nil
+}
+
+func (t *Tracker) removeStale(staleBefore pcommon.Timestamp) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for key, point := range t.deltas {
+		if point.Timestamp < staleBefore {
+			delete(t.deltas, key)
+		}
+	}
+	for key, point := range t.cumulatives {
+		if point.Timestamp < staleBefore {
+			delete(t.cumulatives, key)
+		}
+	}
+}
+
+// sweeper removes stale states and checkpoints the remaining ones every maxStaleness.
+func (t *Tracker) sweeper(ctx context.Context) {
+	ticker := time.NewTicker(t.maxStaleness)
+	defer ticker.Stop()
+	for {
+		select {
+		case currentTime := <-ticker.C:
+			t.removeStale(pcommon.NewTimestampFromTime(currentTime.Add(-t.maxStaleness)))
+			if err := t.persist(ctx); err != nil {
+				t.logger.Warn("Failed to checkpoint delta/cumulative states", zap.Error(err))
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
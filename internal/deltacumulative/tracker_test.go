@@ -0,0 +1,215 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deltacumulative
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/extension/experimental/storage"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/storagetest"
+)
+
+// memoryClient is an in memory storage.Client.
+type memoryClient struct {
+	data   map[string][]byte
+	getErr error
+}
+
+func newMemoryClient() *memoryClient {
+	return &memoryClient{data: map[string][]byte{}}
+}
+
+func (c *memoryClient) Get(_ context.Context, key string) ([]byte, error) {
+	if c.getErr != nil {
+		return nil, c.getErr
+	}
+	return c.data[key], nil
+}
+
+func (c *memoryClient) Set(_ context.Context, key string, value []byte) error {
+	c.data[key] = value
+	return nil
+}
+
+func (c *memoryClient) Delete(_ context.Context, key string) error {
+	delete(c.data, key)
+	return nil
+}
+
+func (c *memoryClient) Batch(context.Context, ...storage.Operation) error {
+	return errors.New("not implemented")
+}
+
+func (c *memoryClient) Close(context.Context) error {
+	return nil
+}
+
+func TestToDelta(t *testing.T) {
+	tracker := NewTracker(zap.NewNop(), 0)
+
+	// The first point of a stream only initializes its state
+	_, ok := tracker.ToDelta("key", Point{StartTimestamp: 1, Timestamp: 10, IntValue: 100}, true)
+	assert.False(t, ok)
+
+	out, ok := tracker.ToDelta("key", Point{StartTimestamp: 1, Timestamp: 20, IntValue: 150}, true)
+	assert.True(t, ok)
+	assert.Equal(t, Point{StartTimestamp: 10, Timestamp: 20, IntValue: 50}, out)
+
+	// Out of order points are dropped
+	_, ok = tracker.ToDelta("key", Point{StartTimestamp: 1, Timestamp: 15, IntValue: 120}, true)
+	assert.False(t, ok)
+
+	// A decreasing monotonic value resets the stream
+	out, ok = tracker.ToDelta("key", Point{StartTimestamp: 1, Timestamp: 30, IntValue: 20}, true)
+	assert.True(t, ok)
+	assert.Equal(t, Point{StartTimestamp: 20, Timestamp: 30, IntValue: 20}, out)
+
+	// A new start timestamp resets the stream
+	out, ok = tracker.ToDelta("key", Point{StartTimestamp: 35, Timestamp: 40, IntValue: 30}, true)
+	assert.True(t, ok)
+	assert.Equal(t, Point{StartTimestamp: 35, Timestamp: 40, IntValue: 30}, out)
+}
+
+func TestToDeltaNonMonotonicFloat(t *testing.T) {
+	tracker := NewTracker(zap.NewNop(), 0)
+
+	_, ok := tracker.ToDelta("key", Point{Timestamp: 10, IsFloat: true, FloatValue: 5.5}, false)
+	assert.False(t, ok)
+
+	out, ok := tracker.ToDelta("key", Point{Timestamp: 20, IsFloat: true, FloatValue: 2}, false)
+	assert.True(t, ok)
+	assert.Equal(t, Point{StartTimestamp: 10, Timestamp: 20, IsFloat: true, FloatValue: -3.5}, out)
+
+	// Value type changes re-initialize the stream
+	_, ok = tracker.ToDelta("key", Point{Timestamp: 30, IntValue: 2}, false)
+	assert.False(t, ok)
+}
+
+func TestToCumulative(t *testing.T) {
+	tracker := NewTracker(zap.NewNop(), 0)
+
+	out := tracker.ToCumulative("key", Point{StartTimestamp: 5, Timestamp: 10, IntValue: 3})
+	assert.Equal(t, Point{StartTimestamp: 5, Timestamp: 10, IntValue: 3}, out)
+
+	out = tracker.ToCumulative("key", Point{StartTimestamp: 10, Timestamp: 20, IntValue: 4})
+	assert.Equal(t, Point{StartTimestamp: 5, Timestamp: 20, IntValue: 7}, out)
+
+	out = tracker.ToCumulative("other", Point{Timestamp: 20, IsFloat: true, FloatValue: 1.5})
+	assert.Equal(t, Point{StartTimestamp: 20, Timestamp: 20, IsFloat: true, FloatValue: 1.5}, out)
+}
+
+func TestPersistence(t *testing.T) {
+	ctx := context.Background()
+	client := newMemoryClient()
+
+	tracker := NewTracker(zap.NewNop(), 0)
+	require.NoError(t, tracker.Start(ctx, client))
+	_, ok := tracker.ToDelta("key", Point{Timestamp: 10, IntValue: 100}, true)
+	assert.False(t, ok)
+	tracker.ToCumulative("key", Point{StartTimestamp: 5, Timestamp: 10, IntValue: 3})
+	require.NoError(t, tracker.Shutdown(ctx))
+
+	// A new tracker continues from the persisted state
+	restarted := NewTracker(zap.NewNop(), 0)
+	require.NoError(t, restarted.Start(ctx, client))
+	out, ok := restarted.ToDelta("key", Point{Timestamp: 20, IntValue: 110}, true)
+	assert.True(t, ok)
+	assert.Equal(t, Point{StartTimestamp: 10, Timestamp: 20, IntValue: 10}, out)
+	out = restarted.ToCumulative("key", Point{StartTimestamp: 10, Timestamp: 20, IntValue: 4})
+	assert.Equal(t, Point{StartTimestamp: 5, Timestamp: 20, IntValue: 7}, out)
+	require.NoError(t, restarted.Shutdown(ctx))
+}
+
+func TestPersistenceWithStorageClient(t *testing.T) {
+	ctx := context.Background()
+	storageDir := t.TempDir()
+	id := component.NewID("test")
+
+	resource := pcommon.NewResource()
+	resource.Attributes().PutStr("host.name", "host")
+	metric := pmetric.NewMetric()
+	metric.SetName("metric")
+	metric.SetEmptySum()
+	attributes := pcommon.NewMap()
+	attributes.PutStr("state", "idle")
+	key := StreamKey(resource, pcommon.NewInstrumentationScope(), metric, attributes)
+
+	client := storagetest.NewFileBackedClient(component.KindExporter, id, "", storageDir)
+	tracker := NewTracker(zap.NewNop(), 0)
+	require.NoError(t, tracker.Start(ctx, client))
+	_, ok := tracker.ToDelta(key, Point{Timestamp: 10, IntValue: 100}, true)
+	assert.False(t, ok)
+	tracker.ToCumulative(key, Point{StartTimestamp: 5, Timestamp: 10, IntValue: 3})
+	require.NoError(t, tracker.Shutdown(ctx))
+	require.NoError(t, client.Close(ctx))
+
+	// A new tracker reloads the state from the storage and continues the stream
+	client = storagetest.NewFileBackedClient(component.KindExporter, id, "", storageDir)
+	restarted := NewTracker(zap.NewNop(), 0)
+	require.NoError(t, restarted.Start(ctx, client))
+	out, ok := restarted.ToDelta(key, Point{Timestamp: 20, IntValue: 110}, true)
+	assert.True(t, ok)
+	assert.Equal(t, Point{StartTimestamp: 10, Timestamp: 20, IntValue: 10}, out)
+	out = restarted.ToCumulative(key, Point{StartTimestamp: 10, Timestamp: 20, IntValue: 4})
+	assert.Equal(t, Point{StartTimestamp: 5, Timestamp: 20, IntValue: 7}, out)
+	require.NoError(t, restarted.Shutdown(ctx))
+	require.NoError(t, client.Close(ctx))
+}
+
+func TestStartErrors(t *testing.T) {
+	ctx := context.Background()
+
+	client := newMemoryClient()
+	client.getErr = errors.New("storage unavailable")
+	assert.Error(t, NewTracker(zap.NewNop(), 0).Start(ctx, client))
+
+	// Corrupted states are discarded
+	client = newMemoryClient()
+	client.data[storageKey] = []byte("not json")
+	tracker := NewTracker(zap.NewNop(), 0)
+	require.NoError(t, tracker.Start(ctx, client))
+	_, ok := tracker.ToDelta("key", Point{Timestamp: 10, IntValue: 100}, true)
+	assert.False(t, ok)
+	require.NoError(t, tracker.Shutdown(ctx))
+}
+
+func TestStartWithoutClient(t *testing.T) {
+	tracker := NewTracker(zap.NewNop(), time.Minute)
+	require.NoError(t, tracker.Start(context.Background(), nil))
+	require.NoError(t, tracker.Shutdown(context.Background()))
+}
+
+func TestRemoveStale(t *testing.T) {
+	tracker := NewTracker(zap.NewNop(), 0)
+	tracker.ToDelta("stale", Point{Timestamp: 10, IntValue: 1}, true)
+	tracker.ToDelta("fresh", Point{Timestamp: 30, IntValue: 1}, true)
+	tracker.ToCumulative("stale", Point{Timestamp: 10, IntValue: 1})
+
+	tracker.removeStale(20)
+
+	assert.Len(t, tracker.deltas, 1)
+	assert.Contains(t, tracker.deltas, "fresh")
+	assert.Empty(t, tracker.cumulatives)
+}
@@ -20,10 +20,20 @@ import (
 	"time"
 )
 
+const (
+	// RuntimeDocker is the runtime of the containers managed by the Docker Engine.
+	RuntimeDocker = "docker"
+	// RuntimeCRI is the runtime of the containers managed by a CRI runtime, e.g. containerd.
+	RuntimeCRI = "cri"
+)
+
 type Config struct {
 	// The URL of the docker server. Default is "unix:///var/run/docker.sock"
 	Endpoint string `mapstructure:"endpoint"`
 
+	// The container runtime API served by the endpoint, either "docker" or "cri". Default is "docker".
+	Runtime string `mapstructure:"runtime"`
+
 	// The maximum amount of time to wait for docker API responses. Default is 5s
 	Timeout time.Duration `mapstructure:"timeout"`
 
@@ -36,9 +46,10 @@ type Config struct {
 
 // NewConfig creates a new config to be used when creating
 // a docker client
-func NewConfig(endpoint string, timeout time.Duration, excludedImages []string, apiVersion float64) (*Config, error) {
+func NewConfig(endpoint string, runtime string, timeout time.Duration, excludedImages []string, apiVersion float64) (*Config, error) {
 	cfg := &Config{
 		Endpoint:         endpoint,
+		Runtime:          runtime,
 		Timeout:          timeout,
 		ExcludedImages:   excludedImages,
 		DockerAPIVersion: apiVersion,
@@ -53,6 +64,7 @@ func NewConfig(endpoint string, timeout time.Duration, excludedImages []string,
 func NewDefaultConfig() *Config {
 	cfg := &Config{
 		Endpoint:         "unix:///var/run/docker.sock",
+		Runtime:          RuntimeDocker,
 		Timeout:          5 * time.Second,
 		DockerAPIVersion: minimalRequiredDockerAPIVersion,
 	}
@@ -66,8 +78,14 @@ func (config Config) validate() error {
 	if config.Endpoint == "" {
 		return errors.New("config.Endpoint must be specified")
 	}
-	if config.DockerAPIVersion < minimalRequiredDockerAPIVersion {
-		return fmt.Errorf("Docker API version must be at least %v", minimalRequiredDockerAPIVersion)
+	switch config.Runtime {
+	case "", RuntimeDocker:
+		if config.DockerAPIVersion < minimalRequiredDockerAPIVersion {
+			return fmt.Errorf("Docker API version must be at least %v", minimalRequiredDockerAPIVersion)
+		}
+	case RuntimeCRI:
+	default:
+		return fmt.Errorf("unsupported runtime %q, must be %q or %q", config.Runtime, RuntimeDocker, RuntimeCRI)
 	}
 	return nil
 }
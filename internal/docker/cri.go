@@ -0,0 +1,276 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package docker // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/docker"
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	dtypes "github.com/docker/docker/api/types"
+	dcontainer "github.com/docker/docker/api/types/container"
+	devents "github.com/docker/docker/api/types/events"
+	"github.com/docker/docker/errdefs"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	runtimeapi "k8s.io/cri-api/pkg/apis/runtime/v1"
+)
+
+// criPollInterval is the interval at which the containers are listed to generate
+// the container events, since the CRI has no equivalent of the Docker events API.
+const criPollInterval = 10 * time.Second
+
+// containerRuntime is the subset of the Docker client API used by the Client,
+// implemented by the Docker client itself and by criClient.
+type containerRuntime interface {
+	ContainerList(ctx context.Context, options dtypes.ContainerListOptions) ([]dtypes.Container, error)
+	ContainerInspect(ctx context.Context, containerID string) (dtypes.ContainerJSON, error)
+	ContainerStats(ctx context.Context, containerID string, stream bool) (dtypes.ContainerStats, error)
+	Events(ctx context.Context, options dtypes.EventsOptions) (<-chan devents.Message, <-chan error)
+	Close() error
+}
+
+// criClient translates the responses of a CRI runtime, e.g. containerd, to the Docker API types,
+// so that the containers are discovered and monitored the same way as with the Docker Engine.
+type criClient struct {
+	conn         io.Closer
+	runtime      runtimeapi.RuntimeServiceClient
+	pollInterval time.Duration
+}
+
+var _ containerRuntime = (*criClient)(nil)
+
+func newCRIClient(config *Config) (*criClient, error) {
+	conn, err := grpc.Dial(config.Endpoint, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, err
+	}
+	return &criClient{
+		conn:         conn,
+		runtime:      runtimeapi.NewRuntimeServiceClient(conn),
+		pollInterval: criPollInterval,
+	}, nil
+}
+
+// Close closes the connection to the CRI runtime.
+func (c *criClient) Close() error {
+	if c.conn == nil {
+		return nil
+	}
+	return c.conn.Close()
+}
+
+// ContainerList returns the running containers, the options are ignored.
+func (c *criClient) ContainerList(ctx context.Context, _ dtypes.ContainerListOptions) ([]dtypes.Container, error) {
+	resp, err := c.runtime.ListContainers(ctx, &runtimeapi.ListContainersRequest{
+		Filter: &runtimeapi.ContainerFilter{
+			State: &runtimeapi.ContainerStateValue{State: runtimeapi.ContainerState_CONTAINER_RUNNING},
+		},
+	})
+	if err != nil {
+		return nil, fromCRIError(err)
+	}
+	containers := make([]dtypes.Container, 0, len(resp.GetContainers()))
+	for _, container := range resp.GetContainers() {
+		containers = append(containers, dtypes.Container{
+			ID:      container.GetId(),
+			Names:   []string{"/" + container.GetMetadata().GetName()},
+			Image:   container.GetImage().GetImage(),
+			ImageID: container.GetImageRef(),
+			Created: time.Unix(0, container.GetCreatedAt()).Unix(),
+			Labels:  container.GetLabels(),
+			State:   criStateToDocker(container.GetState()),
+		})
+	}
+	return containers, nil
+}
+
+// criContainerInfo is the part of the verbose container status info of containerd
+// that completes the container config.
+type criContainerInfo struct {
+	Pid         int `json:"pid"`
+	RuntimeSpec struct {
+		Hostname string `json:"hostname"`
+		Process  struct {
+			Env []string `json:"env"`
+		} `json:"process"`
+	} `json:"runtimeSpec"`
+}
+
+// ContainerInspect returns the status of the container. The environment variables and the
+// hostname are only available when the runtime provides the verbose info, as containerd does.
+func (c *criClient) ContainerInspect(ctx context.Context, containerID string) (dtypes.ContainerJSON, error) {
+	resp, err := c.runtime.ContainerStatus(ctx, &runtimeapi.ContainerStatusRequest{ContainerId: containerID, Verbose: true})
+	if err != nil {
+		return dtypes.ContainerJSON{}, fromCRIError(err)
+	}
+	containerStatus := resp.GetStatus()
+	var info criContainerInfo
+	if raw, ok := resp.GetInfo()["info"]; ok {
+		// The info is best effort, the container is still monitored without it.
+		_ = json.Unmarshal([]byte(raw), &info)
+	}
+
+	state := &dtypes.ContainerState{
+		Status:   criStateToDocker(containerStatus.GetState()),
+		Running:  containerStatus.GetState() == runtimeapi.ContainerState_CONTAINER_RUNNING,
+		Pid:      info.Pid,
+		ExitCode: int(containerStatus.GetExitCode()),
+		Error:    containerStatus.GetMessage(),
+	}
+	if containerStatus.GetStartedAt() != 0 {
+		state.StartedAt = time.Unix(0, containerStatus.GetStartedAt()).UTC().Format(time.RFC3339Nano)
+	}
+	if containerStatus.GetFinishedAt() != 0 {
+		state.FinishedAt = time.Unix(0, containerStatus.GetFinishedAt()).UTC().Format(time.RFC3339Nano)
+	}
+
+	return dtypes.ContainerJSON{
+		ContainerJSONBase: &dtypes.ContainerJSONBase{
+			ID:      containerStatus.GetId(),
+			Created: time.Unix(0, containerStatus.GetCreatedAt()).UTC().Format(time.RFC3339Nano),
+			State:   state,
+			Image:   containerStatus.GetImageRef(),
+			Name:    "/" + containerStatus.GetMetadata().GetName(),
+		},
+		Config: &dcontainer.Config{
+			Hostname: info.RuntimeSpec.Hostname,
+			Env:      info.RuntimeSpec.Process.Env,
+			Image:    containerStatus.GetImage().GetImage(),
+			Labels:   containerStatus.GetLabels(),
+		},
+		NetworkSettings: &dtypes.NetworkSettings{},
+	}, nil
+}
+
+// ContainerStats returns the CPU and memory usage of the container encoded as a Docker
+// stats response, the other stats aren't provided by the CRI. Streaming isn't supported.
+func (c *criClient) ContainerStats(ctx context.Context, containerID string, _ bool) (dtypes.ContainerStats, error) {
+	resp, err := c.runtime.ContainerStats(ctx, &runtimeapi.ContainerStatsRequest{ContainerId: containerID})
+	if err != nil {
+		return dtypes.ContainerStats{}, fromCRIError(err)
+	}
+	stats := resp.GetStats()
+	statsJSON := dtypes.StatsJSON{
+		Name: "/" + stats.GetAttributes().GetMetadata().GetName(),
+		ID:   stats.GetAttributes().GetId(),
+	}
+	if cpu := stats.GetCpu(); cpu != nil {
+		statsJSON.Read = time.Unix(0, cpu.GetTimestamp())
+		statsJSON.CPUStats.CPUUsage.TotalUsage = cpu.GetUsageCoreNanoSeconds().GetValue()
+	}
+	if memory := stats.GetMemory(); memory != nil {
+		statsJSON.MemoryStats.Usage = memory.GetUsageBytes().GetValue()
+		statsJSON.MemoryStats.Stats = map[string]uint64{
+			"rss":         memory.GetRssBytes().GetValue(),
+			"pgfault":     memory.GetPageFaults().GetValue(),
+			"pgmajfault":  memory.GetMajorPageFaults().GetValue(),
+			"working_set": memory.GetWorkingSetBytes().GetValue(),
+		}
+	}
+
+	body, err := json.Marshal(statsJSON)
+	if err != nil {
+		return dtypes.ContainerStats{}, err
+	}
+	return dtypes.ContainerStats{
+		Body:   io.NopCloser(bytes.NewReader(body)),
+		OSType: "linux",
+	}, nil
+}
+
+// Events lists the running containers periodically and emits a start event for each
+// new container and a destroy event for each container that is no longer running.
+// The options are ignored.
+func (c *criClient) Events(ctx context.Context, _ dtypes.EventsOptions) (<-chan devents.Message, <-chan error) {
+	eventCh := make(chan devents.Message)
+	errCh := make(chan error, 1)
+	go func() {
+		ticker := time.NewTicker(c.pollInterval)
+		defer ticker.Stop()
+
+		known := map[string]struct{}{}
+		for {
+			containers, err := c.ContainerList(ctx, dtypes.ContainerListOptions{})
+			if err != nil {
+				errCh <- err
+				return
+			}
+			running := make(map[string]struct{}, len(containers))
+			for _, container := range containers {
+				running[container.ID] = struct{}{}
+				if _, ok := known[container.ID]; !ok && !sendEvent(ctx, eventCh, "start", container.ID) {
+					return
+				}
+			}
+			for id := range known {
+				if _, ok := running[id]; !ok && !sendEvent(ctx, eventCh, "destroy", id) {
+					return
+				}
+			}
+			known = running
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+	return eventCh, errCh
+}
+
+// sendEvent sends a container event, it returns false if the context is done.
+func sendEvent(ctx context.Context, eventCh chan<- devents.Message, action string, id string) bool {
+	now := time.Now()
+	select {
+	case eventCh <- devents.Message{
+		Type:     devents.ContainerEventType,
+		Action:   action,
+		ID:       id,
+		Time:     now.Unix(),
+		TimeNano: now.UnixNano(),
+	}:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+func criStateToDocker(state runtimeapi.ContainerState) string {
+	switch state {
+	case runtimeapi.ContainerState_CONTAINER_CREATED:
+		return "created"
+	case runtimeapi.ContainerState_CONTAINER_RUNNING:
+		return "running"
+	case runtimeapi.ContainerState_CONTAINER_EXITED:
+		return "exited"
+	default:
+		return "unknown"
+	}
+}
+
+// fromCRIError converts the gRPC not found errors so that they are recognized by docker.IsErrNotFound.
+func fromCRIError(err error) error {
+	if status.Code(err) == codes.NotFound {
+		return errdefs.NotFound(fmt.Errorf("container not found: %w", err))
+	}
+	return err
+}
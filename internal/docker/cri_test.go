@@ -0,0 +1,233 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package docker
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	dtypes "github.com/docker/docker/api/types"
+	docker "github.com/docker/docker/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	runtimeapi "k8s.io/cri-api/pkg/apis/runtime/v1"
+)
+
+// fakeRuntimeService serves the containers it holds, the other methods aren't implemented.
+type fakeRuntimeService struct {
+	runtimeapi.RuntimeServiceClient
+
+	mu         sync.Mutex
+	containers map[string]*runtimeapi.Container
+}
+
+func (f *fakeRuntimeService) setContainers(containers ...*runtimeapi.Container) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.containers = map[string]*runtimeapi.Container{}
+	for _, container := range containers {
+		f.containers[container.Id] = container
+	}
+}
+
+func (f *fakeRuntimeService) ListContainers(context.Context, *runtimeapi.ListContainersRequest, ...grpc.CallOption) (*runtimeapi.ListContainersResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	resp := &runtimeapi.ListContainersResponse{}
+	for _, container := range f.containers {
+		resp.Containers = append(resp.Containers, container)
+	}
+	return resp, nil
+}
+
+func (f *fakeRuntimeService) ContainerStatus(_ context.Context, req *runtimeapi.ContainerStatusRequest, _ ...grpc.CallOption) (*runtimeapi.ContainerStatusResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	container, ok := f.containers[req.ContainerId]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+	return &runtimeapi.ContainerStatusResponse{
+		Status: &runtimeapi.ContainerStatus{
+			Id:        container.Id,
+			Metadata:  container.Metadata,
+			State:     container.State,
+			CreatedAt: container.CreatedAt,
+			StartedAt: container.CreatedAt,
+			Image:     container.Image,
+			ImageRef:  container.ImageRef,
+			Labels:    container.Labels,
+		},
+		Info: map[string]string{
+			"info": `{"pid":42,"runtimeSpec":{"hostname":"my-host","process":{"env":["PATH=/bin","APP=my-app"]}}}`,
+		},
+	}, nil
+}
+
+func (f *fakeRuntimeService) ContainerStats(_ context.Context, req *runtimeapi.ContainerStatsRequest, _ ...grpc.CallOption) (*runtimeapi.ContainerStatsResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	container, ok := f.containers[req.ContainerId]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+	return &runtimeapi.ContainerStatsResponse{
+		Stats: &runtimeapi.ContainerStats{
+			Attributes: &runtimeapi.ContainerAttributes{Id: container.Id, Metadata: container.Metadata},
+			Cpu: &runtimeapi.CpuUsage{
+				Timestamp:            1000,
+				UsageCoreNanoSeconds: &runtimeapi.UInt64Value{Value: 123456},
+			},
+			Memory: &runtimeapi.MemoryUsage{
+				Timestamp:       1000,
+				UsageBytes:      &runtimeapi.UInt64Value{Value: 2048},
+				WorkingSetBytes: &runtimeapi.UInt64Value{Value: 1024},
+			},
+		},
+	}, nil
+}
+
+func newTestContainer(id string) *runtimeapi.Container {
+	return &runtimeapi.Container{
+		Id:        id,
+		Metadata:  &runtimeapi.ContainerMetadata{Name: "name-" + id},
+		Image:     &runtimeapi.ImageSpec{Image: "docker.io/library/nginx:latest"},
+		ImageRef:  "sha256:1234",
+		State:     runtimeapi.ContainerState_CONTAINER_RUNNING,
+		CreatedAt: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC).UnixNano(),
+		Labels:    map[string]string{"io.kubernetes.pod.name": "my-pod"},
+	}
+}
+
+func newTestCRIClient(t *testing.T, runtime *fakeRuntimeService, excludedImages ...string) *Client {
+	excludedImageMatcher, err := newStringMatcher(excludedImages)
+	require.NoError(t, err)
+	return &Client{
+		client:               &criClient{runtime: runtime, pollInterval: 10 * time.Millisecond},
+		config:               &Config{Runtime: RuntimeCRI, Timeout: time.Second},
+		containers:           map[string]Container{},
+		excludedImageMatcher: excludedImageMatcher,
+		logger:               zap.NewNop(),
+	}
+}
+
+func TestCRIClientLoadContainerList(t *testing.T) {
+	runtime := &fakeRuntimeService{}
+	runtime.setContainers(newTestContainer("one"), newTestContainer("two"))
+	cli := newTestCRIClient(t, runtime)
+
+	require.NoError(t, cli.LoadContainerList(context.Background()))
+	containers := cli.Containers()
+	require.Len(t, containers, 2)
+
+	container := cli.containers["one"]
+	assert.Equal(t, "one", container.ID)
+	assert.Equal(t, "/name-one", container.Name)
+	assert.Equal(t, "docker.io/library/nginx:latest", container.Config.Image)
+	assert.Equal(t, "my-host", container.Config.Hostname)
+	assert.Equal(t, map[string]string{"io.kubernetes.pod.name": "my-pod"}, container.Config.Labels)
+	assert.Equal(t, map[string]string{"PATH": "/bin", "APP": "my-app"}, container.EnvMap)
+	assert.True(t, container.State.Running)
+	assert.Equal(t, 42, container.State.Pid)
+	assert.Equal(t, "2023-01-01T00:00:00Z", container.State.StartedAt)
+}
+
+func TestCRIClientExcludedImages(t *testing.T) {
+	runtime := &fakeRuntimeService{}
+	runtime.setContainers(newTestContainer("one"))
+	cli := newTestCRIClient(t, runtime, "*nginx*")
+
+	require.NoError(t, cli.LoadContainerList(context.Background()))
+	assert.Empty(t, cli.Containers())
+}
+
+func TestCRIClientFetchContainerStats(t *testing.T) {
+	runtime := &fakeRuntimeService{}
+	runtime.setContainers(newTestContainer("one"))
+	cli := newTestCRIClient(t, runtime)
+	require.NoError(t, cli.LoadContainerList(context.Background()))
+
+	statsJSON, err := cli.FetchContainerStatsAsJSON(context.Background(), cli.Containers()[0])
+	require.NoError(t, err)
+	assert.Equal(t, "one", statsJSON.ID)
+	assert.Equal(t, "/name-one", statsJSON.Name)
+	assert.Equal(t, uint64(123456), statsJSON.CPUStats.CPUUsage.TotalUsage)
+	assert.Equal(t, uint64(2048), statsJSON.MemoryStats.Usage)
+	assert.Equal(t, uint64(1024), statsJSON.MemoryStats.Stats["working_set"])
+
+	// A container that no longer exists is no longer monitored.
+	container := cli.Containers()[0]
+	runtime.setContainers()
+	_, err = cli.FetchContainerStats(context.Background(), container)
+	require.Error(t, err)
+	assert.True(t, docker.IsErrNotFound(err))
+	assert.Empty(t, cli.Containers())
+}
+
+func TestCRIClientEventLoop(t *testing.T) {
+	runtime := &fakeRuntimeService{}
+	cli := newTestCRIClient(t, runtime)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go cli.ContainerEventLoop(ctx)
+
+	runtime.setContainers(newTestContainer("one"))
+	assert.Eventually(t, func() bool {
+		return len(cli.Containers()) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	runtime.setContainers()
+	assert.Eventually(t, func() bool {
+		return len(cli.Containers()) == 0
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestCRIClientEventsContextCanceled(t *testing.T) {
+	runtime := &fakeRuntimeService{}
+	runtime.setContainers(newTestContainer("one"))
+	client := &criClient{runtime: runtime, pollInterval: time.Hour}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	eventCh, _ := client.Events(ctx, dtypes.EventsOptions{})
+	event := <-eventCh
+	assert.Equal(t, "start", event.Action)
+	assert.Equal(t, "one", event.ID)
+	cancel()
+}
+
+func TestCRIClientClose(t *testing.T) {
+	client, err := NewDockerClient(&Config{Endpoint: "unix:///run/containerd/containerd.sock", Runtime: RuntimeCRI}, zap.NewNop())
+	require.NoError(t, err)
+	assert.NoError(t, client.Close())
+
+	assert.NoError(t, (&criClient{}).Close())
+}
+
+func TestConfigUnsupportedRuntime(t *testing.T) {
+	config := NewDefaultConfig()
+	config.Runtime = "rkt"
+	assert.EqualError(t, config.validate(), `unsupported runtime "rkt", must be "docker" or "cri"`)
+
+	config.Runtime = RuntimeCRI
+	config.DockerAPIVersion = 0
+	assert.NoError(t, config.validate())
+}
@@ -48,7 +48,7 @@ type Container struct {
 // from client.ContainerInspect() for container information (id, name, hostname, labels, and env)
 // and dtypes.StatsJSON from client.ContainerStats() for metric values.
 type Client struct {
-	client               *docker.Client
+	client               containerRuntime
 	config               *Config
 	containers           map[string]Container
 	containersLock       sync.Mutex
@@ -56,16 +56,28 @@ type Client struct {
 	logger               *zap.Logger
 }
 
+// NewDockerClient creates a client for the container runtime of the config, the Docker Engine
+// by default or a CRI runtime like containerd. The opts only apply to the Docker Engine.
 func NewDockerClient(config *Config, logger *zap.Logger, opts ...docker.Opt) (*Client, error) {
-	client, err := docker.NewClientWithOpts(
-		append([]docker.Opt{
-			docker.WithHost(config.Endpoint),
-			docker.WithVersion(fmt.Sprintf("v%v", config.DockerAPIVersion)),
-			docker.WithHTTPHeaders(map[string]string{"User-Agent": userAgent}),
-		}, opts...)...,
-	)
-	if err != nil {
-		return nil, fmt.Errorf("could not create docker client: %w", err)
+	var client containerRuntime
+	if config.Runtime == RuntimeCRI {
+		cri, err := newCRIClient(config)
+		if err != nil {
+			return nil, fmt.Errorf("could not create CRI client: %w", err)
+		}
+		client = cri
+	} else {
+		dockerClient, err := docker.NewClientWithOpts(
+			append([]docker.Opt{
+				docker.WithHost(config.Endpoint),
+				docker.WithVersion(fmt.Sprintf("v%v", config.DockerAPIVersion)),
+				docker.WithHTTPHeaders(map[string]string{"User-Agent": userAgent}),
+			}, opts...)...,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("could not create docker client: %w", err)
+		}
+		client = dockerClient
 	}
 
 	excludedImageMatcher, err := newStringMatcher(config.ExcludedImages)
@@ -85,6 +97,11 @@ func NewDockerClient(config *Config, logger *zap.Logger, opts ...docker.Opt) (*C
 	return dc, nil
 }
 
+// Close closes the connection to the container runtime.
+func (dc *Client) Close() error {
+	return dc.client.Close()
+}
+
 // Containers provides a slice of Container to use for individual FetchContainerStats calls.
 func (dc *Client) Containers() []Container {
 	dc.containersLock.Lock()
@@ -205,7 +222,8 @@ func (dc *Client) toStatsJSON(
 	return &statsJSON, nil
 }
 
-// Events exposes the underlying Docker clients Events channel.
+// Events exposes the underlying Docker clients Events channel, or the events generated by
+// listing the containers periodically for CRI runtimes.
 // Caller should close the events channel by canceling the context.
 // If an error occurs, processing stops and caller must reinvoke this method.
 func (dc *Client) Events(ctx context.Context, options dtypes.EventsOptions) (<-chan devents.Message, <-chan error) {
@@ -16,23 +16,81 @@ package batchperresourceattr // import "github.com/open-telemetry/opentelemetry-
 
 import (
 	"context"
+	"strings"
 
 	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.uber.org/multierr"
 )
 
+// keySeparator separates the attribute values of a composite key, it cannot be part of a valid UTF-8 string.
+const keySeparator = "\xff"
+
+// Option configures the batching done by the consumers of this package.
+type Option func(*batchKeyer)
+
+// WithFallbackValue sets the value used for the resources missing one of the attribute keys.
+// Resources missing a key are batched together with the resources having the fallback value
+// for that key, by default the empty string.
+func WithFallbackValue(value string) Option {
+	return func(k *batchKeyer) {
+		k.fallback = value
+	}
+}
+
+// batchKeyer computes the key identifying the batch of a resource.
+type batchKeyer struct {
+	attrKeys []string
+	fallback string
+}
+
+func newBatchKeyer(attrKeys []string, opts []Option) batchKeyer {
+	k := batchKeyer{attrKeys: attrKeys}
+	for _, opt := range opts {
+		opt(&k)
+	}
+	return k
+}
+
+func (k batchKeyer) key(attrs pcommon.Map) string {
+	if len(k.attrKeys) == 1 {
+		return k.value(attrs, k.attrKeys[0])
+	}
+	var sb strings.Builder
+	for i, attrKey := range k.attrKeys {
+		if i > 0 {
+			sb.WriteString(keySeparator)
+		}
+		sb.WriteString(k.value(attrs, attrKey))
+	}
+	return sb.String()
+}
+
+func (k batchKeyer) value(attrs pcommon.Map, attrKey string) string {
+	if attributeValue, ok := attrs.Get(attrKey); ok {
+		return attributeValue.Str()
+	}
+	return k.fallback
+}
+
 type batchTraces struct {
-	attrKey string
-	next    consumer.Traces
+	keyer batchKeyer
+	next  consumer.Traces
 }
 
 func NewBatchPerResourceTraces(attrKey string, next consumer.Traces) consumer.Traces {
+	return NewMultiBatchPerResourceTraces([]string{attrKey}, next)
+}
+
+// NewMultiBatchPerResourceTraces returns a consumer splitting the traces into batches of resources
+// having the same values for all the given attribute keys.
+func NewMultiBatchPerResourceTraces(attrKeys []string, next consumer.Traces, opts ...Option) consumer.Traces {
 	return &batchTraces{
-		attrKey: attrKey,
-		next:    next,
+		keyer: newBatchKeyer(attrKeys, opts),
+		next:  next,
 	}
 }
 
@@ -51,11 +109,7 @@ func (bt *batchTraces) ConsumeTraces(ctx context.Context, td ptrace.Traces) erro
 
 	indicesByAttr := make(map[string][]int)
 	for i := 0; i < lenRss; i++ {
-		rs := rss.At(i)
-		var attrVal string
-		if attributeValue, ok := rs.Resource().Attributes().Get(bt.attrKey); ok {
-			attrVal = attributeValue.Str()
-		}
+		attrVal := bt.keyer.key(rss.At(i).Resource().Attributes())
 		indicesByAttr[attrVal] = append(indicesByAttr[attrVal], i)
 	}
 	// If there is a single attribute value, then call next.
@@ -77,14 +131,20 @@ func (bt *batchTraces) ConsumeTraces(ctx context.Context, td ptrace.Traces) erro
 }
 
 type batchMetrics struct {
-	attrKey string
-	next    consumer.Metrics
+	keyer batchKeyer
+	next  consumer.Metrics
 }
 
 func NewBatchPerResourceMetrics(attrKey string, next consumer.Metrics) consumer.Metrics {
+	return NewMultiBatchPerResourceMetrics([]string{attrKey}, next)
+}
+
+// NewMultiBatchPerResourceMetrics returns a consumer splitting the metrics into batches of resources
+// having the same values for all the given attribute keys.
+func NewMultiBatchPerResourceMetrics(attrKeys []string, next consumer.Metrics, opts ...Option) consumer.Metrics {
 	return &batchMetrics{
-		attrKey: attrKey,
-		next:    next,
+		keyer: newBatchKeyer(attrKeys, opts),
+		next:  next,
 	}
 }
 
@@ -103,11 +163,7 @@ func (bt *batchMetrics) ConsumeMetrics(ctx context.Context, td pmetric.Metrics)
 
 	indicesByAttr := make(map[string][]int)
 	for i := 0; i < lenRms; i++ {
-		rm := rms.At(i)
-		var attrVal string
-		if attributeValue, ok := rm.Resource().Attributes().Get(bt.attrKey); ok {
-			attrVal = attributeValue.Str()
-		}
+		attrVal := bt.keyer.key(rms.At(i).Resource().Attributes())
 		indicesByAttr[attrVal] = append(indicesByAttr[attrVal], i)
 	}
 	// If there is a single attribute value, then call next.
@@ -129,14 +185,20 @@ func (bt *batchMetrics) ConsumeMetrics(ctx context.Context, td pmetric.Metrics)
 }
 
 type batchLogs struct {
-	attrKey string
-	next    consumer.Logs
+	keyer batchKeyer
+	next  consumer.Logs
 }
 
 func NewBatchPerResourceLogs(attrKey string, next consumer.Logs) consumer.Logs {
+	return NewMultiBatchPerResourceLogs([]string{attrKey}, next)
+}
+
+// NewMultiBatchPerResourceLogs returns a consumer splitting the logs into batches of resources
+// having the same values for all the given attribute keys.
+func NewMultiBatchPerResourceLogs(attrKeys []string, next consumer.Logs, opts ...Option) consumer.Logs {
 	return &batchLogs{
-		attrKey: attrKey,
-		next:    next,
+		keyer: newBatchKeyer(attrKeys, opts),
+		next:  next,
 	}
 }
 
@@ -155,11 +217,7 @@ func (bt *batchLogs) ConsumeLogs(ctx context.Context, td plog.Logs) error {
 
 	indicesByAttr := make(map[string][]int)
 	for i := 0; i < lenRls; i++ {
-		rl := rls.At(i)
-		var attrVal string
-		if attributeValue, ok := rl.Resource().Attributes().Get(bt.attrKey); ok {
-			attrVal = attributeValue.Str()
-		}
+		attrVal := bt.keyer.key(rls.At(i).Resource().Attributes())
 		indicesByAttr[attrVal] = append(indicesByAttr[attrVal], i)
 	}
 	// If there is a single attribute value, then call next.
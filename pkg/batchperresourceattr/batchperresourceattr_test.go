@@ -110,6 +110,59 @@ func TestSplitTracesIntoDifferentBatches(t *testing.T) {
 	assert.Equal(t, newTraces(expected.ResourceSpans().At(3), expected.ResourceSpans().At(7)), outBatches[4])
 }
 
+func TestSplitTracesMultipleKeys(t *testing.T) {
+	inBatch := ptrace.NewTraces()
+	fillResourceSpans(inBatch.ResourceSpans().AppendEmpty(), "tenant", "1")
+	fillResourceSpans(inBatch.ResourceSpans().AppendEmpty(), "tenant", "1")
+	fillResourceSpans(inBatch.ResourceSpans().AppendEmpty(), "tenant", "2")
+	inBatch.ResourceSpans().At(0).Resource().Attributes().PutStr("env", "prod")
+	inBatch.ResourceSpans().At(1).Resource().Attributes().PutStr("env", "dev")
+	inBatch.ResourceSpans().At(2).Resource().Attributes().PutStr("env", "prod")
+	expected := ptrace.NewTraces()
+	inBatch.CopyTo(expected)
+
+	sink := new(consumertest.TracesSink)
+	bpr := NewMultiBatchPerResourceTraces([]string{"tenant", "env"}, sink)
+	assert.NoError(t, bpr.ConsumeTraces(context.Background(), inBatch))
+	outBatches := sink.AllTraces()
+	require.Len(t, outBatches, 3)
+	sortTraces(outBatches, "tenant", "env")
+	assert.Equal(t, newTraces(expected.ResourceSpans().At(1)), outBatches[0])
+	assert.Equal(t, newTraces(expected.ResourceSpans().At(0)), outBatches[1])
+	assert.Equal(t, newTraces(expected.ResourceSpans().At(2)), outBatches[2])
+}
+
+func TestSplitTracesFallbackValue(t *testing.T) {
+	inBatch := ptrace.NewTraces()
+	fillResourceSpans(inBatch.ResourceSpans().AppendEmpty(), "tenant", "default")
+	fillResourceSpans(inBatch.ResourceSpans().AppendEmpty(), "tenant", "1")
+	fillResourceSpans(inBatch.ResourceSpans().AppendEmpty(), "other", "1")
+	expected := ptrace.NewTraces()
+	inBatch.CopyTo(expected)
+
+	sink := new(consumertest.TracesSink)
+	bpr := NewMultiBatchPerResourceTraces([]string{"tenant"}, sink, WithFallbackValue("default"))
+	assert.NoError(t, bpr.ConsumeTraces(context.Background(), inBatch))
+	outBatches := sink.AllTraces()
+	require.Len(t, outBatches, 2)
+	sortTraces(outBatches, "tenant")
+	assert.Equal(t, newTraces(expected.ResourceSpans().At(1)), outBatches[0])
+	assert.Equal(t, newTraces(expected.ResourceSpans().At(0), expected.ResourceSpans().At(2)), outBatches[1])
+}
+
+func TestSplitTracesMultipleKeysNoCollision(t *testing.T) {
+	inBatch := ptrace.NewTraces()
+	fillResourceSpans(inBatch.ResourceSpans().AppendEmpty(), "a", "x")
+	inBatch.ResourceSpans().At(0).Resource().Attributes().PutStr("b", "yz")
+	fillResourceSpans(inBatch.ResourceSpans().AppendEmpty(), "a", "xy")
+	inBatch.ResourceSpans().At(1).Resource().Attributes().PutStr("b", "z")
+
+	sink := new(consumertest.TracesSink)
+	bpr := NewMultiBatchPerResourceTraces([]string{"a", "b"}, sink)
+	assert.NoError(t, bpr.ConsumeTraces(context.Background(), inBatch))
+	assert.Len(t, sink.AllTraces(), 2)
+}
+
 func TestSplitMetricsOneResourceMetrics(t *testing.T) {
 	inBatch := pmetric.NewMetrics()
 	fillResourceMetrics(inBatch.ResourceMetrics().AppendEmpty(), "attr_key", "1")
@@ -191,6 +244,26 @@ func TestSplitMetricsIntoDifferentBatches(t *testing.T) {
 	assert.Equal(t, newMetrics(expected.ResourceMetrics().At(3), expected.ResourceMetrics().At(7)), outBatches[4])
 }
 
+func TestSplitMetricsMultipleKeysFallbackValue(t *testing.T) {
+	inBatch := pmetric.NewMetrics()
+	fillResourceMetrics(inBatch.ResourceMetrics().AppendEmpty(), "tenant", "1")
+	fillResourceMetrics(inBatch.ResourceMetrics().AppendEmpty(), "tenant", "1")
+	fillResourceMetrics(inBatch.ResourceMetrics().AppendEmpty(), "tenant", "1")
+	inBatch.ResourceMetrics().At(0).Resource().Attributes().PutStr("env", "prod")
+	inBatch.ResourceMetrics().At(1).Resource().Attributes().PutStr("env", "none")
+	expected := pmetric.NewMetrics()
+	inBatch.CopyTo(expected)
+
+	sink := new(consumertest.MetricsSink)
+	bpr := NewMultiBatchPerResourceMetrics([]string{"tenant", "env"}, sink, WithFallbackValue("none"))
+	assert.NoError(t, bpr.ConsumeMetrics(context.Background(), inBatch))
+	outBatches := sink.AllMetrics()
+	require.Len(t, outBatches, 2)
+	sortMetrics(outBatches, "env")
+	assert.Equal(t, newMetrics(expected.ResourceMetrics().At(1), expected.ResourceMetrics().At(2)), outBatches[0])
+	assert.Equal(t, newMetrics(expected.ResourceMetrics().At(0)), outBatches[1])
+}
+
 func TestSplitLogsOneResourceLogs(t *testing.T) {
 	inBatch := plog.NewLogs()
 	fillResourceLogs(inBatch.ResourceLogs().AppendEmpty(), "attr_key", "1")
@@ -280,16 +353,11 @@ func newTraces(rss ...ptrace.ResourceSpans) ptrace.Traces {
 	return td
 }
 
-func sortTraces(tds []ptrace.Traces, attrKey string) {
+func sortTraces(tds []ptrace.Traces, attrKeys ...string) {
+	keyer := newBatchKeyer(attrKeys, nil)
 	sort.Slice(tds, func(i, j int) bool {
-		valI := ""
-		if av, ok := tds[i].ResourceSpans().At(0).Resource().Attributes().Get(attrKey); ok {
-			valI = av.Str()
-		}
-		valJ := ""
-		if av, ok := tds[j].ResourceSpans().At(0).Resource().Attributes().Get(attrKey); ok {
-			valJ = av.Str()
-		}
+		valI := keyer.key(tds[i].ResourceSpans().At(0).Resource().Attributes())
+		valJ := keyer.key(tds[j].ResourceSpans().At(0).Resource().Attributes())
 		return valI < valJ
 	})
 }
@@ -0,0 +1,120 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottl // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/multierr"
+)
+
+// Condition holds a standalone boolean expression that can be evaluated against a TransformContext
+// without being attached to a Statement.
+type Condition[K any] struct {
+	condition BoolExpr[K]
+}
+
+// Eval evaluates the condition against the given TransformContext.
+func (c *Condition[K]) Eval(ctx context.Context, tCtx K) (bool, error) {
+	return c.condition.Eval(ctx, tCtx)
+}
+
+// ParseCondition parses a single boolean expression, such as `resource.attributes["service.name"] == "checkout"`,
+// into a Condition.
+func (p *Parser[K]) ParseCondition(condition string) (*Condition[K], error) {
+	parsed, err := parseCondition(condition)
+	if err != nil {
+		return nil, err
+	}
+	expression, err := p.newBoolExpr(parsed)
+	if err != nil {
+		return nil, err
+	}
+	return &Condition[K]{condition: expression}, nil
+}
+
+// ParseConditions parses each of the given boolean expressions into a Condition.
+func (p *Parser[K]) ParseConditions(conditions []string) ([]*Condition[K], error) {
+	var parsedConditions []*Condition[K]
+	var errors error
+
+	for _, condition := range conditions {
+		parsed, err := p.ParseCondition(condition)
+		if err != nil {
+			errors = multierr.Append(errors, err)
+			continue
+		}
+		parsedConditions = append(parsedConditions, parsed)
+	}
+
+	if errors != nil {
+		return nil, errors
+	}
+	return parsedConditions, nil
+}
+
+var conditionParser = newParser[booleanExpression]()
+
+func parseCondition(raw string) (*booleanExpression, error) {
+	parsed, err := conditionParser.ParseString("", raw)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse OTTL condition: %w", err)
+	}
+	err = parsed.checkForCustomError()
+	if err != nil {
+		return nil, err
+	}
+	return parsed, nil
+}
+
+// Route pairs a Condition evaluated against an enclosing context R, such as a resource or scope, with the
+// Statements that operate on a nested context K. A Route without a Condition always matches.
+type Route[R any, K any] struct {
+	Condition  *Condition[R]
+	Statements []*Statement[K]
+}
+
+// StatementRouter selects which Statements to execute for an enclosing context. Conditions are evaluated once
+// per enclosing context instead of once per item, which avoids running statements that cannot apply to any
+// of the nested telemetry.
+type StatementRouter[R any, K any] struct {
+	routes []Route[R, K]
+}
+
+// NewStatementRouter creates a StatementRouter for the given routes. Routes are evaluated in order.
+func NewStatementRouter[R any, K any](routes ...Route[R, K]) *StatementRouter[R, K] {
+	return &StatementRouter[R, K]{routes: routes}
+}
+
+// Select evaluates the Condition of every route against rCtx and returns the Statements of the matching
+// routes, in the order the routes were provided. An empty result means that no statement needs to run
+// for the telemetry under rCtx.
+func (r *StatementRouter[R, K]) Select(ctx context.Context, rCtx R) ([]*Statement[K], error) {
+	var selected []*Statement[K]
+	for _, route := range r.routes {
+		if route.Condition != nil {
+			matched, err := route.Condition.Eval(ctx, rCtx)
+			if err != nil {
+				return nil, err
+			}
+			if !matched {
+				continue
+			}
+		}
+		selected = append(selected, route.Statements...)
+	}
+	return selected, nil
+}
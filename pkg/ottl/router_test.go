@@ -0,0 +1,160 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottl
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+)
+
+func Test_ParseCondition(t *testing.T) {
+	p := NewParser(
+		defaultFunctionsForTests(),
+		testParsePath,
+		testParseEnum,
+		componenttest.NewNopTelemetrySettings(),
+	)
+
+	tests := []struct {
+		name      string
+		condition string
+		item      string
+		want      bool
+	}{
+		{name: "path matches", condition: `name == "bear"`, item: "bear", want: true},
+		{name: "path does not match", condition: `name == "bear"`, item: "cat"},
+		{name: "and", condition: `name != "cat" and 1 < 2`, item: "bear", want: true},
+		{name: "or", condition: `name == "cat" or name == "bear"`, item: "bear", want: true},
+		{name: "not", condition: `not (name == "bear")`, item: "bear"},
+		{name: "enum", condition: `TEST_ENUM == 0`, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			condition, err := p.ParseCondition(tt.condition)
+			require.NoError(t, err)
+			result, err := condition.Eval(context.Background(), tt.item)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, result)
+		})
+	}
+}
+
+func Test_ParseCondition_Error(t *testing.T) {
+	p := NewParser(
+		defaultFunctionsForTests(),
+		testParsePath,
+		testParseEnum,
+		componenttest.NewNopTelemetrySettings(),
+	)
+
+	tests := []struct {
+		name      string
+		condition string
+	}{
+		{name: "statement instead of condition", condition: `set(name, "bear")`},
+		{name: "invalid path", condition: `unknown == "bear"`},
+		{name: "unknown enum", condition: `NOT_AN_ENUM == 1`},
+		{name: "dangling operator", condition: `name ==`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := p.ParseCondition(tt.condition)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func Test_ParseConditions(t *testing.T) {
+	p := NewParser(
+		defaultFunctionsForTests(),
+		testParsePath,
+		testParseEnum,
+		componenttest.NewNopTelemetrySettings(),
+	)
+
+	conditions, err := p.ParseConditions([]string{`name == "bear"`, `name == "cat"`})
+	require.NoError(t, err)
+	assert.Len(t, conditions, 2)
+
+	_, err = p.ParseConditions([]string{`name == "bear"`, `unknown == "cat"`, `name ==`})
+	assert.Error(t, err)
+}
+
+func Test_StatementRouter_Select(t *testing.T) {
+	first := &Statement[any]{condition: BoolExpr[any]{alwaysTrue[any]}}
+	second := &Statement[any]{condition: BoolExpr[any]{alwaysTrue[any]}}
+	third := &Statement[any]{condition: BoolExpr[any]{alwaysTrue[any]}}
+
+	matches := &Condition[any]{condition: BoolExpr[any]{alwaysTrue[any]}}
+	doesNotMatch := &Condition[any]{condition: BoolExpr[any]{alwaysFalse[any]}}
+
+	tests := []struct {
+		name   string
+		routes []Route[any, any]
+		want   []*Statement[any]
+	}{
+		{
+			name: "no routes",
+		},
+		{
+			name: "route without condition",
+			routes: []Route[any, any]{
+				{Statements: []*Statement[any]{first, second}},
+			},
+			want: []*Statement[any]{first, second},
+		},
+		{
+			name: "only matching routes",
+			routes: []Route[any, any]{
+				{Condition: matches, Statements: []*Statement[any]{first}},
+				{Condition: doesNotMatch, Statements: []*Statement[any]{second}},
+				{Condition: matches, Statements: []*Statement[any]{third}},
+			},
+			want: []*Statement[any]{first, third},
+		},
+		{
+			name: "nothing matches",
+			routes: []Route[any, any]{
+				{Condition: doesNotMatch, Statements: []*Statement[any]{first}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := NewStatementRouter(tt.routes...)
+			selected, err := router.Select(context.Background(), nil)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, selected)
+		})
+	}
+}
+
+func Test_StatementRouter_Select_Error(t *testing.T) {
+	failing := &Condition[any]{condition: BoolExpr[any]{func(context.Context, any) (bool, error) {
+		return false, errors.New("failed")
+	}}}
+	router := NewStatementRouter(Route[any, any]{
+		Condition:  failing,
+		Statements: []*Statement[any]{{condition: BoolExpr[any]{alwaysTrue[any]}}},
+	})
+
+	_, err := router.Select(context.Background(), nil)
+	assert.EqualError(t, err, "failed")
+}
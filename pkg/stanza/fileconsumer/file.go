@@ -256,7 +256,7 @@ func (m *Manager) saveCurrent(readers []*Reader) {
 
 func (m *Manager) newReader(file *os.File, fp *Fingerprint) (*Reader, error) {
 	// Check if the new path has the same fingerprint as an old path
-	if oldReader, ok := m.findFingerprintMatch(fp); ok {
+	if oldReader, ok := m.findFingerprintMatch(file, fp); ok {
 		return m.readerFactory.copy(oldReader, file)
 	}
 
@@ -264,13 +264,18 @@ func (m *Manager) newReader(file *os.File, fp *Fingerprint) (*Reader, error) {
 	return m.readerFactory.newReader(file, fp)
 }
 
-func (m *Manager) findFingerprintMatch(fp *Fingerprint) (*Reader, bool) {
+func (m *Manager) findFingerprintMatch(file *os.File, fp *Fingerprint) (*Reader, bool) {
 	// Iterate backwards to match newest first
 	for i := len(m.knownFiles) - 1; i >= 0; i-- {
 		oldReader := m.knownFiles[i]
-		if fp.StartsWith(oldReader.Fingerprint) {
+		if !fp.StartsWith(oldReader.Fingerprint) {
+			continue
+		}
+		if oldReader.continuedIn(file) {
 			return oldReader, true
 		}
+		m.Debugw("File starts like a known file but its content differs, it was truncated or replaced",
+			"path", file.Name(), "offset", oldReader.Offset)
 	}
 	return nil, false
 }
@@ -16,8 +16,11 @@ package fileconsumer // import "github.com/open-telemetry/opentelemetry-collecto
 
 import (
 	"bufio"
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"os"
 
 	"go.uber.org/zap"
@@ -40,6 +43,7 @@ type Reader struct {
 
 	Fingerprint    *Fingerprint
 	Offset         int64
+	LastBytes      []byte
 	generation     int
 	file           *os.File
 	fileAttributes *FileAttributes
@@ -57,6 +61,8 @@ func (r *Reader) offsetToEnd() error {
 
 // ReadToEnd will read until the end of the file
 func (r *Reader) ReadToEnd(ctx context.Context) {
+	defer r.updateLastBytes()
+
 	if _, err := r.file.Seek(r.Offset, 0); err != nil {
 		r.Errorw("Failed to seek", zap.Error(err))
 		return
@@ -91,6 +97,48 @@ func (r *Reader) ReadToEnd(ctx context.Context) {
 	}
 }
 
+// updateLastBytes remembers the bytes preceding the offset, so that a file that
+// merely starts with the same bytes can later be told apart from this one.
+// Bytes that are part of the fingerprint are not stored again.
+func (r *Reader) updateLastBytes() {
+	r.LastBytes = nil
+	if r.Offset <= int64(len(r.Fingerprint.FirstBytes)) {
+		return
+	}
+
+	size := int64(r.fingerprintSize)
+	if size > r.Offset {
+		size = r.Offset
+	}
+	buf := make([]byte, size)
+	n, err := r.file.ReadAt(buf, r.Offset-size)
+	if err != nil && !errors.Is(err, io.EOF) {
+		r.Debugw("Failed to read last bytes", zap.Error(err))
+		return
+	}
+	if int64(n) == size {
+		r.LastBytes = buf
+	}
+}
+
+// continuedIn returns true if the file still holds the bytes last read by this
+// reader at the same position, i.e. it is the same file, or a copy or rename of it.
+// A file that only starts with the same bytes does not, e.g. when the file was
+// truncated and rewritten, or when a new file sharing a common header was created
+// after a rotation, possibly reusing the inode of the rotated file.
+func (r *Reader) continuedIn(file *os.File) bool {
+	if len(r.LastBytes) == 0 {
+		return true
+	}
+
+	buf := make([]byte, len(r.LastBytes))
+	n, err := file.ReadAt(buf, r.Offset-int64(len(r.LastBytes)))
+	if err != nil && !errors.Is(err, io.EOF) {
+		return false
+	}
+	return n == len(buf) && bytes.Equal(buf, r.LastBytes)
+}
+
 // Close will close the file
 func (r *Reader) Close() {
 	if r.file != nil {
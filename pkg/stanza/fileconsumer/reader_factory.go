@@ -44,6 +44,7 @@ func (f *readerFactory) copy(old *Reader, newFile *os.File) (*Reader, error) {
 		withFile(newFile).
 		withFingerprint(old.Fingerprint.Copy()).
 		withOffset(old.Offset).
+		withLastBytes(old.LastBytes).
 		withSplitterFunc(old.splitFunc).
 		build()
 }
@@ -61,6 +62,7 @@ type readerBuilder struct {
 	file      *os.File
 	fp        *Fingerprint
 	offset    int64
+	lastBytes []byte
 	splitFunc bufio.SplitFunc
 }
 
@@ -88,10 +90,16 @@ func (b *readerBuilder) withOffset(offset int64) *readerBuilder {
 	return b
 }
 
+func (b *readerBuilder) withLastBytes(lastBytes []byte) *readerBuilder {
+	b.lastBytes = lastBytes
+	return b
+}
+
 func (b *readerBuilder) build() (r *Reader, err error) {
 	r = &Reader{
 		readerConfig: b.readerConfig,
 		Offset:       b.offset,
+		LastBytes:    b.lastBytes,
 	}
 
 	if b.splitFunc != nil {
@@ -36,7 +36,7 @@ func (r *detectLostFiles) readLostFiles(ctx context.Context, readers []*Reader)
 OUTER:
 	for _, oldReader := range r.oldReaders {
 		for _, reader := range readers {
-			if reader.Fingerprint.StartsWith(oldReader.Fingerprint) {
+			if reader.Fingerprint.StartsWith(oldReader.Fingerprint) && oldReader.continuedIn(reader.file) {
 				continue OUTER
 			}
 		}
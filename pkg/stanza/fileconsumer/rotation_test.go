@@ -596,3 +596,158 @@ func TestFileMovedWhileOff_BigFiles(t *testing.T) {
 	require.NoError(t, operator.Start(persister))
 	waitForToken(t, emitCalls, log2)
 }
+
+// logHeader is written at the beginning of every log file in the tests below.
+// It is longer than the fingerprint, so all files share the same fingerprint.
+const logHeader = "# log format v1.0\n"
+
+// TruncateThenWriteSameHeader tests that, after a file has been truncated,
+// new writes are picked up from the beginning even if the file
+// starts with the same header and grows beyond the previous offset
+func TestTruncateThenWriteSameHeader(t *testing.T) {
+	if runtime.GOOS == windowsOS {
+		t.Skip("Rotation tests have been flaky on Windows. See https://github.com/open-telemetry/opentelemetry-collector-contrib/issues/16331")
+	}
+	t.Parallel()
+
+	tempDir := t.TempDir()
+	rotatedDir := t.TempDir()
+	cfg := NewConfig().includeDir(tempDir)
+	cfg.StartAt = "beginning"
+	cfg.FingerprintSize = MinFingerprintSize
+	operator, emitCalls := buildTestManager(t, cfg)
+	operator.persister = testutil.NewMockPersister("test")
+	defer func() {
+		require.NoError(t, operator.Stop())
+	}()
+
+	temp := openTemp(t, tempDir)
+	for i := 0; i < 10; i++ {
+		expected := [][]byte{[]byte(logHeader[:len(logHeader)-1])}
+		writeString(t, temp, logHeader)
+		for j := 0; j < i+2; j++ {
+			line := fmt.Sprintf("rotation %d log %d", i, j)
+			writeString(t, temp, line+"\n")
+			expected = append(expected, []byte(line))
+		}
+
+		operator.poll(context.Background())
+		require.ElementsMatch(t, expected, waitForNTokens(t, emitCalls, len(expected)))
+
+		// Copy the file away, then truncate the original
+		rotated, err := os.Create(filepath.Join(rotatedDir, fmt.Sprintf("rotated-%d.log", i)))
+		require.NoError(t, err)
+		_, err = temp.Seek(0, 0)
+		require.NoError(t, err)
+		_, err = io.Copy(rotated, temp)
+		require.NoError(t, err)
+		require.NoError(t, rotated.Close())
+
+		require.NoError(t, temp.Truncate(0))
+		_, err = temp.Seek(0, 0)
+		require.NoError(t, err)
+	}
+	expectNoTokens(t, emitCalls)
+}
+
+// RenameThenCreateSameHeader tests that, when a file is rotated by renaming it
+// and creating a new file with the same header, unread logs are read from the
+// rotated file and the new file is read from the beginning
+func TestRenameThenCreateSameHeader(t *testing.T) {
+	if runtime.GOOS == windowsOS {
+		t.Skip("Moving files while open is unsupported on Windows")
+	}
+	t.Parallel()
+
+	tempDir := t.TempDir()
+	rotatedDir := t.TempDir()
+	cfg := NewConfig().includeDir(tempDir)
+	cfg.StartAt = "beginning"
+	cfg.FingerprintSize = MinFingerprintSize
+	operator, emitCalls := buildTestManager(t, cfg)
+	operator.persister = testutil.NewMockPersister("test")
+	defer func() {
+		require.NoError(t, operator.Stop())
+	}()
+
+	path := filepath.Join(tempDir, "app.log")
+	var unread []byte
+	for i := 0; i < 10; i++ {
+		temp := openFile(t, path)
+		expected := [][]byte{[]byte(logHeader[:len(logHeader)-1])}
+		if unread != nil {
+			expected = append(expected, unread)
+		}
+		writeString(t, temp, logHeader)
+		for j := 0; j < i+2; j++ {
+			line := fmt.Sprintf("rotation %d log %d", i, j)
+			writeString(t, temp, line+"\n")
+			expected = append(expected, []byte(line))
+		}
+
+		operator.poll(context.Background())
+		require.ElementsMatch(t, expected, waitForNTokens(t, emitCalls, len(expected)))
+
+		// Write a log that won't be read before the rotation
+		line := fmt.Sprintf("rotation %d unread", i)
+		writeString(t, temp, line+"\n")
+		unread = []byte(line)
+
+		require.NoError(t, os.Rename(path, filepath.Join(rotatedDir, fmt.Sprintf("rotated-%d.log", i))))
+	}
+	expectNoTokens(t, emitCalls)
+}
+
+// SymlinkedDirectoryFlip tests that, when a symlink to the directory
+// holding the files is switched to another directory, the remaining logs
+// of the previous files are read, and the new files are read from the
+// beginning even if they start with the same header
+func TestSymlinkedDirectoryFlip(t *testing.T) {
+	if runtime.GOOS == windowsOS {
+		t.Skip("Symlinks are not supported on Windows")
+	}
+	t.Parallel()
+
+	tempDir := t.TempDir()
+	link := filepath.Join(tempDir, "current")
+	cfg := NewConfig()
+	cfg.Include = []string{filepath.Join(link, "*.log")}
+	cfg.StartAt = "beginning"
+	cfg.FingerprintSize = MinFingerprintSize
+	operator, emitCalls := buildTestManager(t, cfg)
+	operator.persister = testutil.NewMockPersister("test")
+	defer func() {
+		require.NoError(t, operator.Stop())
+	}()
+
+	dir1 := filepath.Join(tempDir, "1")
+	require.NoError(t, os.Mkdir(dir1, 0777))
+	require.NoError(t, os.Symlink(dir1, link))
+
+	temp1 := openFile(t, filepath.Join(dir1, "app.log"))
+	writeString(t, temp1, logHeader+"dir1 log1\n")
+
+	operator.poll(context.Background())
+	waitForToken(t, emitCalls, []byte(logHeader[:len(logHeader)-1]))
+	waitForToken(t, emitCalls, []byte("dir1 log1"))
+
+	writeString(t, temp1, "dir1 log2\n")
+
+	dir2 := filepath.Join(tempDir, "2")
+	require.NoError(t, os.Mkdir(dir2, 0777))
+	temp2 := openFile(t, filepath.Join(dir2, "app.log"))
+	writeString(t, temp2, logHeader+"dir2 log1\ndir2 log2\ndir2 log3\n")
+
+	// Atomically switch the symlink to the new directory
+	require.NoError(t, os.Symlink(dir2, link+".tmp"))
+	require.NoError(t, os.Rename(link+".tmp", link))
+
+	operator.poll(context.Background())
+	waitForTokens(t, emitCalls, [][]byte{
+		[]byte("dir1 log2"),
+		[]byte(logHeader[:len(logHeader)-1]),
+		[]byte("dir2 log1"),
+		[]byte("dir2 log2"),
+		[]byte("dir2 log3"),
+	})
+}
@@ -16,6 +16,7 @@ package syslog
 import (
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -60,6 +61,24 @@ func TestUnmarshal(t *testing.T) {
 					return cfg
 				}(),
 			},
+			{
+				Name: "year_inference_window",
+				Expect: func() *Config {
+					cfg := NewConfig()
+					cfg.Protocol = RFC3164
+					cfg.YearInferenceWindow = 30 * 24 * time.Hour
+					return cfg
+				}(),
+			},
+			{
+				Name: "keep_original_timestamp",
+				Expect: func() *Config {
+					cfg := NewConfig()
+					cfg.Protocol = RFC3164
+					cfg.KeepOriginalTimestamp = true
+					return cfg
+				}(),
+			},
 			{
 				Name: "on_error_drop",
 				Expect: func() *Config {
@@ -243,3 +262,44 @@ func TestParserInvalidLocation(t *testing.T) {
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "failed to load location "+config.Location)
 }
+
+func TestRFC3164TimestampConfigOptions(t *testing.T) {
+	testCases := []struct {
+		desc        string
+		cfg         BaseConfig
+		errContents string
+	}{
+		{
+			desc:        "Year inference window with RFC5424",
+			cfg:         BaseConfig{Protocol: RFC5424, YearInferenceWindow: time.Hour},
+			errContents: "year_inference_window and keep_original_timestamp are only compatible with protocol rfc3164",
+		},
+		{
+			desc:        "Keep original timestamp with RFC5424",
+			cfg:         BaseConfig{Protocol: RFC5424, KeepOriginalTimestamp: true},
+			errContents: "year_inference_window and keep_original_timestamp are only compatible with protocol rfc3164",
+		},
+		{
+			desc:        "Negative year inference window",
+			cfg:         BaseConfig{Protocol: RFC3164, YearInferenceWindow: -time.Hour},
+			errContents: "invalid year_inference_window '-1h0m0s'",
+		},
+		{
+			desc: "Valid RFC3164 timestamp options",
+			cfg:  BaseConfig{Protocol: RFC3164, YearInferenceWindow: time.Hour, KeepOriginalTimestamp: true},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			cfg := NewConfig()
+			cfg.BaseConfig = tc.cfg
+			_, err := cfg.Build(testutil.Logger(t))
+			if tc.errContents != "" {
+				require.ErrorContains(t, err, tc.errContents)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -41,8 +41,18 @@ const (
 
 	NULTrailer = "NUL"
 	LFTrailer  = "LF"
+
+	// defaultYearInferenceWindow is how far in the future an RFC3164 timestamp
+	// can be before it's assumed to be from the previous year.
+	defaultYearInferenceWindow = 7 * 24 * time.Hour
+
+	// rfc3164TimestampLayout is the layout of the timestamps of RFC3164 messages.
+	rfc3164TimestampLayout = "Jan _2 15:04:05"
 )
 
+// Allows tests to override with deterministic value
+var now = time.Now
+
 func init() {
 	operator.Register(operatorType, func() operator.Builder { return NewConfig() })
 }
@@ -67,10 +77,12 @@ type Config struct {
 
 // BaseConfig is the detailed configuration of a syslog parser.
 type BaseConfig struct {
-	Protocol                     string  `mapstructure:"protocol,omitempty"`
-	Location                     string  `mapstructure:"location,omitempty"`
-	EnableOctetCounting          bool    `mapstructure:"enable_octet_counting,omitempty"`
-	NonTransparentFramingTrailer *string `mapstructure:"non_transparent_framing_trailer,omitempty"`
+	Protocol                     string        `mapstructure:"protocol,omitempty"`
+	Location                     string        `mapstructure:"location,omitempty"`
+	EnableOctetCounting          bool          `mapstructure:"enable_octet_counting,omitempty"`
+	NonTransparentFramingTrailer *string       `mapstructure:"non_transparent_framing_trailer,omitempty"`
+	YearInferenceWindow          time.Duration `mapstructure:"year_inference_window,omitempty"`
+	KeepOriginalTimestamp        bool          `mapstructure:"keep_original_timestamp,omitempty"`
 }
 
 // Build will build a JSON parser operator.
@@ -99,6 +111,14 @@ func (c Config) Build(logger *zap.SugaredLogger) (operator.Operator, error) {
 		if *c.NonTransparentFramingTrailer != NULTrailer && *c.NonTransparentFramingTrailer != LFTrailer {
 			return nil, fmt.Errorf("invalid non_transparent_framing_trailer '%s'. Must be either 'LF' or 'NUL'", *c.NonTransparentFramingTrailer)
 		}
+	case c.Protocol != RFC3164 && (c.YearInferenceWindow != 0 || c.KeepOriginalTimestamp):
+		return nil, errors.New("year_inference_window and keep_original_timestamp are only compatible with protocol rfc3164")
+	case c.YearInferenceWindow < 0:
+		return nil, fmt.Errorf("invalid year_inference_window '%s'. Must not be negative", c.YearInferenceWindow)
+	}
+
+	if c.YearInferenceWindow == 0 {
+		c.YearInferenceWindow = defaultYearInferenceWindow
 	}
 
 	if c.Location == "" {
@@ -116,6 +136,8 @@ func (c Config) Build(logger *zap.SugaredLogger) (operator.Operator, error) {
 		location:                     location,
 		enableOctetCounting:          c.EnableOctetCounting,
 		nonTransparentFramingTrailer: c.NonTransparentFramingTrailer,
+		yearInferenceWindow:          c.YearInferenceWindow,
+		keepOriginalTimestamp:        c.KeepOriginalTimestamp,
 	}, nil
 }
 
@@ -157,6 +179,8 @@ type Parser struct {
 	location                     *time.Location
 	enableOctetCounting          bool
 	nonTransparentFramingTrailer *string
+	yearInferenceWindow          time.Duration
+	keepOriginalTimestamp        bool
 }
 
 // Process will parse an entry field as syslog.
@@ -183,7 +207,7 @@ func (s *Parser) parse(value interface{}) (interface{}, error) {
 
 	switch message := slog.(type) {
 	case *rfc3164.SyslogMessage:
-		return s.parseRFC3164(message)
+		return s.parseRFC3164(message, bytes)
 	case *rfc5424.SyslogMessage:
 		return s.parseRFC5424(message)
 	default:
@@ -192,9 +216,14 @@ func (s *Parser) parse(value interface{}) (interface{}, error) {
 }
 
 // parseRFC3164 will parse an RFC3164 syslog message.
-func (s *Parser) parseRFC3164(syslogMessage *rfc3164.SyslogMessage) (map[string]interface{}, error) {
+func (s *Parser) parseRFC3164(syslogMessage *rfc3164.SyslogMessage, input []byte) (map[string]interface{}, error) {
+	timestamp := syslogMessage.Timestamp
+	if timestamp != nil && timestamp.Year() == 0 {
+		inferred := inferYear(*timestamp, now(), s.yearInferenceWindow)
+		timestamp = &inferred
+	}
 	value := map[string]interface{}{
-		"timestamp": syslogMessage.Timestamp,
+		"timestamp": timestamp,
 		"priority":  syslogMessage.Priority,
 		"facility":  syslogMessage.Facility,
 		"severity":  syslogMessage.Severity,
@@ -204,9 +233,41 @@ func (s *Parser) parseRFC3164(syslogMessage *rfc3164.SyslogMessage) (map[string]
 		"msg_id":    syslogMessage.MsgID,
 		"message":   syslogMessage.Message,
 	}
+	if s.keepOriginalTimestamp && syslogMessage.Timestamp != nil {
+		if original, ok := rfc3164Timestamp(input); ok {
+			value["original_timestamp"] = &original
+		}
+	}
 	return s.toSafeMap(value)
 }
 
+// inferYear sets the year of an RFC3164 timestamp, which doesn't include it. The timestamp is
+// assumed to be within the year ending window after it was received, so that a message from
+// December 31st received on January 1st is given the previous year, and the other way around.
+func inferYear(t, received time.Time, window time.Duration) time.Time {
+	received = received.In(t.Location())
+	inferred := time.Date(received.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+	latest := received.Add(window)
+	switch {
+	case inferred.After(latest):
+		return inferred.AddDate(-1, 0, 0)
+	case !inferred.After(latest.AddDate(-1, 0, 0)):
+		return inferred.AddDate(1, 0, 0)
+	default:
+		return inferred
+	}
+}
+
+// rfc3164Timestamp returns the timestamp of an RFC3164 message as it was received,
+// it directly follows the priority.
+func rfc3164Timestamp(input []byte) (string, bool) {
+	start := bytes.IndexByte(input, '>') + 1
+	if start == 0 || len(input) < start+len(rfc3164TimestampLayout) {
+		return "", false
+	}
+	return string(input[start : start+len(rfc3164TimestampLayout)]), true
+}
+
 // parseRFC5424 will parse an RFC5424 syslog message.
 func (s *Parser) parseRFC5424(syslogMessage *rfc5424.SyslogMessage) (map[string]interface{}, error) {
 	value := map[string]interface{}{
@@ -88,3 +88,84 @@ func TestSyslogParseRFC5424_SDNameTooLong(t *testing.T) {
 		require.FailNow(t, "Timed out waiting for entry to be processed")
 	}
 }
+
+func TestInferYear(t *testing.T) {
+	received := time.Date(2023, time.January, 1, 0, 0, 10, 0, time.UTC)
+	testCases := []struct {
+		desc      string
+		timestamp time.Time
+		received  time.Time
+		window    time.Duration
+		expected  time.Time
+	}{
+		{
+			desc:      "same year",
+			timestamp: time.Date(0, time.January, 1, 0, 0, 5, 0, time.UTC),
+			received:  received,
+			window:    defaultYearInferenceWindow,
+			expected:  time.Date(2023, time.January, 1, 0, 0, 5, 0, time.UTC),
+		},
+		{
+			desc:      "previous year",
+			timestamp: time.Date(0, time.December, 31, 23, 59, 55, 0, time.UTC),
+			received:  received,
+			window:    defaultYearInferenceWindow,
+			expected:  time.Date(2022, time.December, 31, 23, 59, 55, 0, time.UTC),
+		},
+		{
+			desc:      "within window",
+			timestamp: time.Date(0, time.January, 5, 0, 0, 0, 0, time.UTC),
+			received:  received,
+			window:    defaultYearInferenceWindow,
+			expected:  time.Date(2023, time.January, 5, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			desc:      "beyond window",
+			timestamp: time.Date(0, time.January, 5, 0, 0, 0, 0, time.UTC),
+			received:  received,
+			window:    time.Hour,
+			expected:  time.Date(2022, time.January, 5, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			desc:      "next year",
+			timestamp: time.Date(0, time.January, 1, 0, 0, 5, 0, time.UTC),
+			received:  time.Date(2022, time.December, 31, 23, 59, 55, 0, time.UTC),
+			window:    defaultYearInferenceWindow,
+			expected:  time.Date(2023, time.January, 1, 0, 0, 5, 0, time.UTC),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			require.Equal(t, tc.expected, inferYear(tc.timestamp, tc.received, tc.window))
+		})
+	}
+}
+
+func TestSyslogParseRFC3164_TimestampOptions(t *testing.T) {
+	now = func() time.Time { return time.Date(2023, time.January, 1, 0, 0, 10, 0, time.UTC) }
+	t.Cleanup(func() { now = time.Now })
+
+	cfg := basicConfig()
+	cfg.Protocol = RFC3164
+	cfg.KeepOriginalTimestamp = true
+
+	op, err := cfg.Build(testutil.Logger(t))
+	require.NoError(t, err)
+
+	fake := testutil.NewFakeOutput(t)
+	require.NoError(t, op.SetOutputs([]operator.Operator{fake}))
+
+	body := "<34>Dec 31 23:59:55 1.2.3.4 apache_server: test message"
+	newEntry := entry.New()
+	newEntry.Body = body
+	require.NoError(t, op.Process(context.Background(), newEntry))
+
+	select {
+	case e := <-fake.Received:
+		require.Equal(t, time.Date(2022, time.December, 31, 23, 59, 55, 0, time.UTC), e.Timestamp)
+		require.Equal(t, "Dec 31 23:59:55", e.Attributes["original_timestamp"])
+	case <-time.After(time.Second):
+		require.FailNow(t, "Timed out waiting for entry to be processed")
+	}
+}
@@ -0,0 +1,579 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheusremotewrite // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/translator/prometheusremotewrite"
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/prometheus/common/model"
+	"github.com/prometheus/prometheus/model/value"
+	"github.com/prometheus/prometheus/prompb"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	conventions "go.opentelemetry.io/collector/semconv/v1.6.1"
+	"go.uber.org/multierr"
+)
+
+// FromWriteRequest converts a prometheus remote write request to pmetric.Metrics.
+// The metadata of the request is used to determine the type, unit and description of the metrics.
+func FromWriteRequest(req *prompb.WriteRequest) (pmetric.Metrics, error) {
+	return FromTimeSeries(req.Timeseries, req.Metadata)
+}
+
+// FromTimeSeries converts prometheus remote write time series to pmetric.Metrics.
+//
+// The series are grouped into resources by their job and instance labels, which are mapped to the
+// service.namespace, service.name and service.instance.id attributes; the labels of the target_info
+// series become the resource attributes. The series of metric families without metadata are converted
+// to gauges. Series that can't be converted are skipped and reported in the returned error.
+func FromTimeSeries(tss []prompb.TimeSeries, metadata []prompb.MetricMetadata) (pmetric.Metrics, error) {
+	c := newPRWConverter(metadata)
+
+	var errs error
+	for i := range tss {
+		errs = multierr.Append(errs, c.addTimeSeries(&tss[i]))
+	}
+	return c.metrics(), errs
+}
+
+type prwConverter struct {
+	metadata  map[string]prompb.MetricMetadata
+	resources []*prwResource
+	byKey     map[string]*prwResource
+}
+
+type prwResource struct {
+	job, instance string
+	info          []prompb.Label
+	metrics       []*prwMetric
+	byName        map[string]*prwMetric
+}
+
+type prwMetric struct {
+	name   string
+	kind   prompb.MetricMetadata_MetricType
+	native bool
+	unit   string
+	help   string
+	series []*prwSeries
+	bySig  map[string]*prwSeries
+}
+
+type prwSeries struct {
+	labels []prompb.Label
+	// start is the timestamp, in ms, of the _created series.
+	start  int64
+	points []*prwPoint
+	byTs   map[int64]*prwPoint
+}
+
+type prwPoint struct {
+	timestamp int64
+	value     float64
+	sum       float64
+	hasSum    bool
+	count     float64
+	hasCount  bool
+	buckets   map[float64]float64
+	quantiles map[float64]float64
+	histogram *prompb.Histogram
+}
+
+func newPRWConverter(metadata []prompb.MetricMetadata) *prwConverter {
+	c := &prwConverter{
+		metadata: make(map[string]prompb.MetricMetadata, len(metadata)),
+		byKey:    map[string]*prwResource{},
+	}
+	for _, md := range metadata {
+		c.metadata[md.MetricFamilyName] = md
+	}
+	return c
+}
+
+func (c *prwConverter) addTimeSeries(ts *prompb.TimeSeries) error {
+	var name, job, instance string
+	labels := make([]prompb.Label, 0, len(ts.Labels))
+	for _, l := range ts.Labels {
+		switch l.Name {
+		case nameStr:
+			name = l.Value
+		case model.JobLabel:
+			job = l.Value
+		case model.InstanceLabel:
+			instance = l.Value
+		default:
+			labels = append(labels, l)
+		}
+	}
+	if name == "" {
+		return errors.New("time series without metric name label is dropped")
+	}
+
+	resource := c.resource(job, instance)
+	if name == targetMetricName {
+		resource.info = labels
+		return nil
+	}
+
+	family, suffix, md := c.family(name)
+	if len(ts.Histograms) > 0 {
+		family, suffix = name, ""
+		md.Type = prompb.MetricMetadata_HISTOGRAM
+	}
+
+	metric, ok := resource.byName[family]
+	if !ok {
+		metric = &prwMetric{
+			name:   family,
+			kind:   md.Type,
+			native: len(ts.Histograms) > 0,
+			unit:   md.Unit,
+			help:   md.Help,
+			bySig:  map[string]*prwSeries{},
+		}
+		resource.byName[family] = metric
+		resource.metrics = append(resource.metrics, metric)
+	}
+
+	var extra prompb.Label
+	switch {
+	case suffix == bucketStr:
+		labels, extra = removeLabel(labels, leStr)
+		if extra.Name == "" {
+			return fmt.Errorf("bucket series of %s without %s label is dropped", family, leStr)
+		}
+	case suffix == "" && metric.kind == prompb.MetricMetadata_SUMMARY:
+		labels, extra = removeLabel(labels, quantileStr)
+		if extra.Name == "" {
+			return fmt.Errorf("series of summary %s without %s label is dropped", family, quantileStr)
+		}
+	}
+
+	series := metric.seriesFor(labels)
+	if suffix == createdSuffix {
+		if len(ts.Samples) > 0 {
+			series.start = int64(ts.Samples[len(ts.Samples)-1].Value)
+		}
+		return nil
+	}
+
+	if metric.native {
+		var errs error
+		for i := range ts.Histograms {
+			h := &ts.Histograms[i]
+			if err := validateNativeHistogram(h); err != nil {
+				errs = multierr.Append(errs, fmt.Errorf("native histogram point of %s at %d is dropped: %w", family, h.Timestamp, err))
+				continue
+			}
+			series.point(h.Timestamp).histogram = h
+		}
+		return errs
+	}
+
+	var bound float64
+	if extra.Name != "" {
+		var err error
+		if bound, err = strconv.ParseFloat(extra.Value, 64); err != nil {
+			return fmt.Errorf("invalid %s label value %q of %s: %w", extra.Name, extra.Value, family, err)
+		}
+	}
+	for _, sample := range ts.Samples {
+		pt := series.point(sample.Timestamp)
+		switch suffix {
+		case sumStr:
+			pt.sum, pt.hasSum = sample.Value, true
+		case countStr:
+			pt.count, pt.hasCount = sample.Value, true
+		case bucketStr:
+			if pt.buckets == nil {
+				pt.buckets = map[float64]float64{}
+			}
+			pt.buckets[bound] = sample.Value
+		default:
+			if metric.kind == prompb.MetricMetadata_SUMMARY {
+				if pt.quantiles == nil {
+					pt.quantiles = map[float64]float64{}
+				}
+				pt.quantiles[bound] = sample.Value
+			} else {
+				pt.value = sample.Value
+			}
+		}
+	}
+	return nil
+}
+
+// family returns the metric family of a series name, the suffix of the series in the family
+// and the metadata of the family.
+func (c *prwConverter) family(name string) (string, string, prompb.MetricMetadata) {
+	if md, ok := c.metadata[name]; ok {
+		return name, "", md
+	}
+	for _, suffix := range []string{bucketStr, sumStr, countStr, createdSuffix} {
+		base := strings.TrimSuffix(name, suffix)
+		if base == name {
+			continue
+		}
+		md, ok := c.metadata[base]
+		if !ok {
+			// Counters may be described by the name without the _total suffix.
+			md, ok = c.metadata[strings.TrimSuffix(base, "_total")]
+			if ok && suffix == createdSuffix && md.Type == prompb.MetricMetadata_COUNTER {
+				return base, suffix, md
+			}
+			continue
+		}
+		switch md.Type {
+		case prompb.MetricMetadata_HISTOGRAM, prompb.MetricMetadata_GAUGEHISTOGRAM, prompb.MetricMetadata_SUMMARY:
+			return base, suffix, md
+		case prompb.MetricMetadata_COUNTER:
+			if suffix == createdSuffix {
+				return base, suffix, md
+			}
+		}
+	}
+	if md, ok := c.metadata[strings.TrimSuffix(name, "_total")]; ok && md.Type == prompb.MetricMetadata_COUNTER {
+		return name, "", md
+	}
+	return name, "", prompb.MetricMetadata{Type: prompb.MetricMetadata_GAUGE}
+}
+
+func (c *prwConverter) resource(job, instance string) *prwResource {
+	key := job + "\xff" + instance
+	r, ok := c.byKey[key]
+	if !ok {
+		r = &prwResource{job: job, instance: instance, byName: map[string]*prwMetric{}}
+		c.byKey[key] = r
+		c.resources = append(c.resources, r)
+	}
+	return r
+}
+
+func (m *prwMetric) seriesFor(labels []prompb.Label) *prwSeries {
+	sort.Sort(ByLabelName(labels))
+	var b strings.Builder
+	for _, l := range labels {
+		b.WriteString(l.Name)
+		b.WriteByte('\xff')
+		b.WriteString(l.Value)
+		b.WriteByte('\xff')
+	}
+	sig := b.String()
+	s, ok := m.bySig[sig]
+	if !ok {
+		s = &prwSeries{labels: labels, byTs: map[int64]*prwPoint{}}
+		m.bySig[sig] = s
+		m.series = append(m.series, s)
+	}
+	return s
+}
+
+func (s *prwSeries) point(timestamp int64) *prwPoint {
+	pt, ok := s.byTs[timestamp]
+	if !ok {
+		pt = &prwPoint{timestamp: timestamp}
+		s.byTs[timestamp] = pt
+		s.points = append(s.points, pt)
+	}
+	return pt
+}
+
+func removeLabel(labels []prompb.Label, name string) ([]prompb.Label, prompb.Label) {
+	for i, l := range labels {
+		if l.Name == name {
+			return append(labels[:i:i], labels[i+1:]...), l
+		}
+	}
+	return labels, prompb.Label{}
+}
+
+func (c *prwConverter) metrics() pmetric.Metrics {
+	md := pmetric.NewMetrics()
+	for _, r := range c.resources {
+		rm := md.ResourceMetrics().AppendEmpty()
+		r.fillResource(rm.Resource())
+
+		ms := rm.ScopeMetrics().AppendEmpty().Metrics()
+		for _, m := range r.metrics {
+			m.fill(ms.AppendEmpty())
+		}
+	}
+	return md
+}
+
+func (r *prwResource) fillResource(resource pcommon.Resource) {
+	attrs := resource.Attributes()
+	for _, l := range r.info {
+		attrs.PutStr(l.Name, l.Value)
+	}
+	if r.job != "" {
+		if namespace, name, found := strings.Cut(r.job, "/"); found {
+			attrs.PutStr(conventions.AttributeServiceNamespace, namespace)
+			attrs.PutStr(conventions.AttributeServiceName, name)
+		} else {
+			attrs.PutStr(conventions.AttributeServiceName, r.job)
+		}
+	}
+	if r.instance != "" {
+		attrs.PutStr(conventions.AttributeServiceInstanceID, r.instance)
+	}
+}
+
+func (m *prwMetric) fill(metric pmetric.Metric) {
+	metric.SetName(m.name)
+	metric.SetUnit(m.unit)
+	metric.SetDescription(m.help)
+
+	switch {
+	case m.native:
+		h := metric.SetEmptyExponentialHistogram()
+		h.SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+		for _, s := range m.series {
+			for _, pt := range s.points {
+				dp := h.DataPoints().AppendEmpty()
+				s.fillCommon(dp.Attributes(), dp.SetStartTimestamp, dp.SetTimestamp, pt)
+				fillExponentialHistogramDataPoint(dp, pt.histogram)
+			}
+		}
+	case m.kind == prompb.MetricMetadata_HISTOGRAM || m.kind == prompb.MetricMetadata_GAUGEHISTOGRAM:
+		h := metric.SetEmptyHistogram()
+		h.SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+		for _, s := range m.series {
+			for _, pt := range s.points {
+				dp := h.DataPoints().AppendEmpty()
+				s.fillCommon(dp.Attributes(), dp.SetStartTimestamp, dp.SetTimestamp, pt)
+				fillHistogramDataPoint(dp, pt)
+			}
+		}
+	case m.kind == prompb.MetricMetadata_SUMMARY:
+		summary := metric.SetEmptySummary()
+		for _, s := range m.series {
+			for _, pt := range s.points {
+				dp := summary.DataPoints().AppendEmpty()
+				s.fillCommon(dp.Attributes(), dp.SetStartTimestamp, dp.SetTimestamp, pt)
+				fillSummaryDataPoint(dp, pt)
+			}
+		}
+	default:
+		var dps pmetric.NumberDataPointSlice
+		if m.kind == prompb.MetricMetadata_COUNTER {
+			sum := metric.SetEmptySum()
+			sum.SetIsMonotonic(true)
+			sum.SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+			dps = sum.DataPoints()
+		} else {
+			dps = metric.SetEmptyGauge().DataPoints()
+		}
+		for _, s := range m.series {
+			for _, pt := range s.points {
+				dp := dps.AppendEmpty()
+				s.fillCommon(dp.Attributes(), dp.SetStartTimestamp, dp.SetTimestamp, pt)
+				if value.IsStaleNaN(pt.value) {
+					dp.SetFlags(pmetric.DefaultDataPointFlags.WithNoRecordedValue(true))
+				} else {
+					dp.SetDoubleValue(pt.value)
+				}
+			}
+		}
+	}
+}
+
+func (s *prwSeries) fillCommon(attrs pcommon.Map, setStart, setTimestamp func(pcommon.Timestamp), pt *prwPoint) {
+	for _, l := range s.labels {
+		attrs.PutStr(l.Name, l.Value)
+	}
+	if s.start != 0 {
+		setStart(fromMilliseconds(s.start))
+	}
+	setTimestamp(fromMilliseconds(pt.timestamp))
+}
+
+func fillHistogramDataPoint(dp pmetric.HistogramDataPoint, pt *prwPoint) {
+	if value.IsStaleNaN(pt.sum) || value.IsStaleNaN(pt.count) {
+		dp.SetFlags(pmetric.DefaultDataPointFlags.WithNoRecordedValue(true))
+		return
+	}
+	if pt.hasSum {
+		dp.SetSum(pt.sum)
+	}
+
+	bounds := make([]float64, 0, len(pt.buckets))
+	for bound := range pt.buckets {
+		bounds = append(bounds, bound)
+	}
+	sort.Float64s(bounds)
+
+	count := pt.count
+	var prev float64
+	for _, bound := range bounds {
+		cumulative := pt.buckets[bound]
+		if math.IsInf(bound, 1) {
+			if !pt.hasCount {
+				count = cumulative
+			}
+			continue
+		}
+		dp.ExplicitBounds().Append(bound)
+		dp.BucketCounts().Append(uint64(cumulative - prev))
+		prev = cumulative
+	}
+	if len(bounds) > 0 {
+		dp.BucketCounts().Append(uint64(count - prev))
+	}
+	dp.SetCount(uint64(count))
+}
+
+func fillSummaryDataPoint(dp pmetric.SummaryDataPoint, pt *prwPoint) {
+	if value.IsStaleNaN(pt.sum) || value.IsStaleNaN(pt.count) {
+		dp.SetFlags(pmetric.DefaultDataPointFlags.WithNoRecordedValue(true))
+		return
+	}
+	dp.SetSum(pt.sum)
+	dp.SetCount(uint64(pt.count))
+
+	quantiles := make([]float64, 0, len(pt.quantiles))
+	for q := range pt.quantiles {
+		quantiles = append(quantiles, q)
+	}
+	sort.Float64s(quantiles)
+	for _, q := range quantiles {
+		qv := dp.QuantileValues().AppendEmpty()
+		qv.SetQuantile(q)
+		qv.SetValue(pt.quantiles[q])
+	}
+}
+
+// fillExponentialHistogramDataPoint converts a Prometheus Native Histogram to an OTel Exponential
+// Histogram data point, reverting exponentialToNativeHistogram.
+func fillExponentialHistogramDataPoint(dp pmetric.ExponentialHistogramDataPoint, h *prompb.Histogram) {
+	if value.IsStaleNaN(h.Sum) {
+		dp.SetFlags(pmetric.DefaultDataPointFlags.WithNoRecordedValue(true))
+		return
+	}
+	dp.SetScale(h.Schema)
+	dp.SetSum(h.Sum)
+	dp.SetCount(h.GetCountInt())
+	if h.GetCountInt() == 0 && h.GetCountFloat() > 0 {
+		dp.SetCount(uint64(h.GetCountFloat()))
+	}
+	dp.SetZeroCount(h.GetZeroCountInt())
+	if h.GetZeroCountInt() == 0 && h.GetZeroCountFloat() > 0 {
+		dp.SetZeroCount(uint64(h.GetZeroCountFloat()))
+	}
+
+	fillExponentialBuckets(dp.Positive(), h.PositiveSpans, h.PositiveDeltas, h.PositiveCounts)
+	fillExponentialBuckets(dp.Negative(), h.NegativeSpans, h.NegativeDeltas, h.NegativeCounts)
+}
+
+// maxDenseBuckets is the maximum number of dense buckets a Native Histogram can be converted to, so that
+// a single point with large gaps between its spans can't allocate an unbounded number of empty buckets.
+const maxDenseBuckets = 1 << 14
+
+// validateNativeHistogram checks that the positive and negative buckets of a Native Histogram can be
+// converted by fillExponentialBuckets.
+func validateNativeHistogram(h *prompb.Histogram) error {
+	if err := validateBuckets(h.PositiveSpans, h.PositiveDeltas, h.PositiveCounts); err != nil {
+		return fmt.Errorf("invalid positive buckets: %w", err)
+	}
+	if err := validateBuckets(h.NegativeSpans, h.NegativeDeltas, h.NegativeCounts); err != nil {
+		return fmt.Errorf("invalid negative buckets: %w", err)
+	}
+	return nil
+}
+
+func validateBuckets(spans []*prompb.BucketSpan, deltas []int64, counts []float64) error {
+	var length, dense int64
+	for i, span := range spans {
+		if span == nil {
+			return fmt.Errorf("span %d is missing", i)
+		}
+		if i == 0 {
+			if span.Offset < -maxDenseBuckets || span.Offset > maxDenseBuckets {
+				return fmt.Errorf("offset %d of the first span is out of range", span.Offset)
+			}
+		} else {
+			if span.Offset < 0 {
+				return fmt.Errorf("span %d has a negative offset %d", i, span.Offset)
+			}
+			dense += int64(span.Offset)
+		}
+		length += int64(span.Length)
+		dense += int64(span.Length)
+		if dense > maxDenseBuckets {
+			return fmt.Errorf("spans cover more than %d buckets", maxDenseBuckets)
+		}
+	}
+
+	if len(deltas) == 0 {
+		if length != int64(len(counts)) {
+			return fmt.Errorf("spans have a total length of %d but there are %d counts", length, len(counts))
+		}
+		return nil
+	}
+	if length != int64(len(deltas)) {
+		return fmt.Errorf("spans have a total length of %d but there are %d deltas", length, len(deltas))
+	}
+	var current int64
+	for _, delta := range deltas {
+		current += delta
+		if current < 0 {
+			return errors.New("deltas result in a negative bucket count")
+		}
+	}
+	return nil
+}
+
+// fillExponentialBuckets converts the sparse buckets of a Native Histogram to the dense buckets of an
+// Exponential Histogram. The Prometheus bucket index is adjusted by 1, see convertBucketsLayout.
+// The buckets must have been checked by validateBuckets.
+func fillExponentialBuckets(buckets pmetric.ExponentialHistogramDataPointBuckets, spans []*prompb.BucketSpan, deltas []int64, counts []float64) {
+	if len(spans) == 0 {
+		return
+	}
+
+	var (
+		current int64
+		pos     int
+	)
+	buckets.SetOffset(spans[0].Offset - 1)
+	for i, span := range spans {
+		if i > 0 {
+			for j := int32(0); j < span.Offset; j++ {
+				buckets.BucketCounts().Append(0)
+			}
+		}
+		for j := uint32(0); j < span.Length; j++ {
+			var count uint64
+			if len(deltas) > 0 {
+				current += deltas[pos]
+				count = uint64(current)
+			} else {
+				count = uint64(counts[pos])
+			}
+			buckets.BucketCounts().Append(count)
+			pos++
+		}
+	}
+}
+
+// fromMilliseconds converts a timestamp in ms to an OTLP timestamp in ns.
+func fromMilliseconds(ms int64) pcommon.Timestamp {
+	return pcommon.Timestamp(ms * 1e6)
+}
@@ -0,0 +1,341 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheusremotewrite
+
+import (
+	"math"
+	"sort"
+	"testing"
+
+	"github.com/prometheus/prometheus/model/value"
+	"github.com/prometheus/prometheus/prompb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	conventions "go.opentelemetry.io/collector/semconv/v1.6.1"
+)
+
+func TestFromTimeSeries(t *testing.T) {
+	tss := []prompb.TimeSeries{
+		*getTimeSeries(getPromLabels(nameStr, "test_gauge", "job", "ns/svc", "instance", "host:8080", "foo", "bar"), getSample(1.5, 1000)),
+		*getTimeSeries(getPromLabels(nameStr, "test_gauge", "job", "ns/svc", "instance", "host:8080", "foo", "baz"), getSample(2.5, 1000)),
+		*getTimeSeries(getPromLabels(nameStr, "test_other", "job", "other"), getSample(3, 2000), getSample(4, 3000)),
+		*getTimeSeries(getPromLabels(nameStr, targetMetricName, "job", "ns/svc", "instance", "host:8080", "host_name", "host"), getSample(1, 1000)),
+	}
+
+	md, err := FromTimeSeries(tss, nil)
+	require.NoError(t, err)
+	require.Equal(t, 2, md.ResourceMetrics().Len())
+
+	rm := md.ResourceMetrics().At(0)
+	assert.Equal(t, map[string]any{
+		conventions.AttributeServiceNamespace:  "ns",
+		conventions.AttributeServiceName:       "svc",
+		conventions.AttributeServiceInstanceID: "host:8080",
+		"host_name":                            "host",
+	}, rm.Resource().Attributes().AsRaw())
+	require.Equal(t, 1, rm.ScopeMetrics().At(0).Metrics().Len())
+	metric := rm.ScopeMetrics().At(0).Metrics().At(0)
+	assert.Equal(t, "test_gauge", metric.Name())
+	require.Equal(t, pmetric.MetricTypeGauge, metric.Type())
+	require.Equal(t, 2, metric.Gauge().DataPoints().Len())
+	dp := metric.Gauge().DataPoints().At(0)
+	assert.Equal(t, 1.5, dp.DoubleValue())
+	assert.Equal(t, pcommon.Timestamp(1000*1e6), dp.Timestamp())
+	assert.Equal(t, map[string]any{"foo": "bar"}, dp.Attributes().AsRaw())
+
+	rm = md.ResourceMetrics().At(1)
+	assert.Equal(t, map[string]any{conventions.AttributeServiceName: "other"}, rm.Resource().Attributes().AsRaw())
+	metric = rm.ScopeMetrics().At(0).Metrics().At(0)
+	assert.Equal(t, "test_other", metric.Name())
+	assert.Equal(t, 2, metric.Gauge().DataPoints().Len())
+}
+
+func TestFromTimeSeriesWithoutName(t *testing.T) {
+	tss := []prompb.TimeSeries{
+		*getTimeSeries(getPromLabels("foo", "bar"), getSample(1, 1000)),
+		*getTimeSeries(getPromLabels(nameStr, "test_gauge"), getSample(1, 1000)),
+	}
+
+	md, err := FromTimeSeries(tss, nil)
+	assert.Error(t, err)
+	assert.Equal(t, 1, md.MetricCount())
+}
+
+func TestFromWriteRequest(t *testing.T) {
+	req := &prompb.WriteRequest{
+		Timeseries: []prompb.TimeSeries{
+			*getTimeSeries(getPromLabels(nameStr, "test_counter_total", "foo", "bar"), getSample(10, 2000), getSample(math.Float64frombits(value.StaleNaN), 3000)),
+			*getTimeSeries(getPromLabels(nameStr, "test_counter_total_created", "foo", "bar"), getSample(1000, 0)),
+			*getTimeSeries(getPromLabels(nameStr, "test_hist_bucket", "le", "1"), getSample(1, 2000)),
+			*getTimeSeries(getPromLabels(nameStr, "test_hist_bucket", "le", "+Inf"), getSample(4, 2000)),
+			*getTimeSeries(getPromLabels(nameStr, "test_hist_bucket", "le", "0.5"), getSample(0, 2000)),
+			*getTimeSeries(getPromLabels(nameStr, "test_hist_sum"), getSample(12.5, 2000)),
+			*getTimeSeries(getPromLabels(nameStr, "test_hist_count"), getSample(4, 2000)),
+			*getTimeSeries(getPromLabels(nameStr, "test_summary", "quantile", "0.9"), getSample(9, 2000)),
+			*getTimeSeries(getPromLabels(nameStr, "test_summary", "quantile", "0.5"), getSample(5, 2000)),
+			*getTimeSeries(getPromLabels(nameStr, "test_summary_sum"), getSample(100, 2000)),
+			*getTimeSeries(getPromLabels(nameStr, "test_summary_count"), getSample(20, 2000)),
+			*getTimeSeries(getPromLabels(nameStr, "test_summary_bad", "quantile", "x"), getSample(20, 2000)),
+		},
+		Metadata: []prompb.MetricMetadata{
+			{MetricFamilyName: "test_counter", Type: prompb.MetricMetadata_COUNTER, Help: "A counter", Unit: "1"},
+			{MetricFamilyName: "test_hist", Type: prompb.MetricMetadata_HISTOGRAM},
+			{MetricFamilyName: "test_summary", Type: prompb.MetricMetadata_SUMMARY},
+			{MetricFamilyName: "test_summary_bad", Type: prompb.MetricMetadata_SUMMARY},
+		},
+	}
+
+	md, err := FromWriteRequest(req)
+	assert.ErrorContains(t, err, `invalid quantile label value "x" of test_summary_bad`)
+	require.Equal(t, 1, md.ResourceMetrics().Len())
+	metrics := metricsByName(md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics())
+
+	counter := metrics["test_counter_total"]
+	assert.Equal(t, "A counter", counter.Description())
+	assert.Equal(t, "1", counter.Unit())
+	require.Equal(t, pmetric.MetricTypeSum, counter.Type())
+	assert.True(t, counter.Sum().IsMonotonic())
+	assert.Equal(t, pmetric.AggregationTemporalityCumulative, counter.Sum().AggregationTemporality())
+	require.Equal(t, 2, counter.Sum().DataPoints().Len())
+	dp := counter.Sum().DataPoints().At(0)
+	assert.Equal(t, 10.0, dp.DoubleValue())
+	assert.Equal(t, pcommon.Timestamp(1000*1e6), dp.StartTimestamp())
+	assert.Equal(t, map[string]any{"foo": "bar"}, dp.Attributes().AsRaw())
+	assert.True(t, counter.Sum().DataPoints().At(1).Flags().NoRecordedValue())
+
+	hist := metrics["test_hist"]
+	require.Equal(t, pmetric.MetricTypeHistogram, hist.Type())
+	require.Equal(t, 1, hist.Histogram().DataPoints().Len())
+	hdp := hist.Histogram().DataPoints().At(0)
+	assert.Equal(t, 12.5, hdp.Sum())
+	assert.Equal(t, uint64(4), hdp.Count())
+	assert.Equal(t, []float64{0.5, 1}, hdp.ExplicitBounds().AsRaw())
+	assert.Equal(t, []uint64{0, 1, 3}, hdp.BucketCounts().AsRaw())
+	assert.Equal(t, 0, hdp.Attributes().Len())
+
+	summary := metrics["test_summary"]
+	require.Equal(t, pmetric.MetricTypeSummary, summary.Type())
+	require.Equal(t, 1, summary.Summary().DataPoints().Len())
+	sdp := summary.Summary().DataPoints().At(0)
+	assert.Equal(t, 100.0, sdp.Sum())
+	assert.Equal(t, uint64(20), sdp.Count())
+	require.Equal(t, 2, sdp.QuantileValues().Len())
+	assert.Equal(t, 0.5, sdp.QuantileValues().At(0).Quantile())
+	assert.Equal(t, 5.0, sdp.QuantileValues().At(0).Value())
+	assert.Equal(t, 0.9, sdp.QuantileValues().At(1).Quantile())
+	assert.Equal(t, 9.0, sdp.QuantileValues().At(1).Value())
+}
+
+func TestFromTimeSeriesNativeHistogram(t *testing.T) {
+	want := pmetric.NewExponentialHistogramDataPoint()
+	want.SetScale(1)
+	want.SetSum(10.1)
+	want.SetCount(15)
+	want.SetZeroCount(1)
+	want.Positive().SetOffset(1)
+	want.Positive().BucketCounts().FromRaw([]uint64{2, 0, 3, 0, 0, 0, 4})
+	want.Negative().SetOffset(-2)
+	want.Negative().BucketCounts().FromRaw([]uint64{5})
+	want.SetTimestamp(pcommon.Timestamp(2000 * 1e6))
+
+	h, err := exponentialToNativeHistogram(want)
+	require.NoError(t, err)
+
+	tss := []prompb.TimeSeries{{
+		Labels:     getPromLabels(nameStr, "test_native"),
+		Histograms: []prompb.Histogram{h},
+	}}
+	md, err := FromTimeSeries(tss, nil)
+	require.NoError(t, err)
+
+	metric := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0)
+	require.Equal(t, pmetric.MetricTypeExponentialHistogram, metric.Type())
+	require.Equal(t, 1, metric.ExponentialHistogram().DataPoints().Len())
+	assert.Equal(t, want, metric.ExponentialHistogram().DataPoints().At(0))
+}
+
+func TestFromTimeSeriesInvalidNativeHistogram(t *testing.T) {
+	valid := prompb.Histogram{
+		Count:          &prompb.Histogram_CountInt{CountInt: 3},
+		Schema:         1,
+		PositiveSpans:  []*prompb.BucketSpan{{Offset: 1, Length: 2}},
+		PositiveDeltas: []int64{1, 1},
+		Timestamp:      1000,
+	}
+	tests := []struct {
+		name   string
+		modify func(h *prompb.Histogram)
+	}{
+		{
+			name: "missing deltas",
+			modify: func(h *prompb.Histogram) {
+				h.PositiveDeltas = h.PositiveDeltas[:1]
+			},
+		},
+		{
+			name: "extra deltas",
+			modify: func(h *prompb.Histogram) {
+				h.PositiveDeltas = append(h.PositiveDeltas, 1)
+			},
+		},
+		{
+			name: "missing counts",
+			modify: func(h *prompb.Histogram) {
+				h.PositiveDeltas = nil
+				h.PositiveCounts = []float64{1}
+			},
+		},
+		{
+			name: "negative count",
+			modify: func(h *prompb.Histogram) {
+				h.PositiveDeltas = []int64{1, -2}
+			},
+		},
+		{
+			name: "negative span offset",
+			modify: func(h *prompb.Histogram) {
+				h.NegativeSpans = []*prompb.BucketSpan{{Offset: 0, Length: 1}, {Offset: -1, Length: 1}}
+				h.NegativeDeltas = []int64{1, 0}
+			},
+		},
+		{
+			name: "large span gap",
+			modify: func(h *prompb.Histogram) {
+				h.PositiveSpans = append(h.PositiveSpans, &prompb.BucketSpan{Offset: math.MaxInt32, Length: 1})
+				h.PositiveDeltas = append(h.PositiveDeltas, 0)
+			},
+		},
+		{
+			name: "large first offset",
+			modify: func(h *prompb.Histogram) {
+				h.PositiveSpans[0].Offset = math.MinInt32
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			invalid := valid
+			invalid.PositiveSpans = []*prompb.BucketSpan{{Offset: 1, Length: 2}}
+			invalid.PositiveDeltas = []int64{1, 1}
+			invalid.Timestamp = 2000
+			tt.modify(&invalid)
+
+			tss := []prompb.TimeSeries{{
+				Labels:     getPromLabels(nameStr, "test_native"),
+				Histograms: []prompb.Histogram{valid, invalid},
+			}}
+			md, err := FromTimeSeries(tss, nil)
+			assert.Error(t, err)
+
+			metric := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0)
+			require.Equal(t, 1, metric.ExponentialHistogram().DataPoints().Len())
+			assert.Equal(t, pcommon.Timestamp(1000*1e6), metric.ExponentialHistogram().DataPoints().At(0).Timestamp())
+		})
+	}
+}
+
+func TestFromMetricsRoundTrip(t *testing.T) {
+	md := pmetric.NewMetrics()
+	rm := md.ResourceMetrics().AppendEmpty()
+	rm.Resource().Attributes().PutStr(conventions.AttributeServiceNamespace, "ns")
+	rm.Resource().Attributes().PutStr(conventions.AttributeServiceName, "svc")
+	rm.Resource().Attributes().PutStr(conventions.AttributeServiceInstanceID, "host:8080")
+	rm.Resource().Attributes().PutStr("region", "eu")
+	ms := rm.ScopeMetrics().AppendEmpty().Metrics()
+
+	start := pcommon.Timestamp(1000 * 1e6)
+	ts := pcommon.Timestamp(2000 * 1e6)
+
+	gauge := ms.AppendEmpty()
+	gauge.SetName("test_gauge")
+	gdp := gauge.SetEmptyGauge().DataPoints().AppendEmpty()
+	gdp.SetDoubleValue(1.5)
+	gdp.SetTimestamp(ts)
+	gdp.Attributes().PutStr("foo", "bar")
+
+	counter := ms.AppendEmpty()
+	counter.SetName("test_counter_total")
+	sum := counter.SetEmptySum()
+	sum.SetIsMonotonic(true)
+	sum.SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	cdp := sum.DataPoints().AppendEmpty()
+	cdp.SetDoubleValue(10)
+	cdp.SetStartTimestamp(start)
+	cdp.SetTimestamp(ts)
+
+	hist := ms.AppendEmpty()
+	hist.SetName("test_hist")
+	hist.SetEmptyHistogram().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	hdp := hist.Histogram().DataPoints().AppendEmpty()
+	hdp.SetStartTimestamp(start)
+	hdp.SetTimestamp(ts)
+	hdp.SetSum(12.5)
+	hdp.SetCount(4)
+	hdp.ExplicitBounds().FromRaw([]float64{0.5, 1})
+	hdp.BucketCounts().FromRaw([]uint64{0, 1, 3})
+
+	summary := ms.AppendEmpty()
+	summary.SetName("test_summary")
+	sdp := summary.SetEmptySummary().DataPoints().AppendEmpty()
+	sdp.SetStartTimestamp(start)
+	sdp.SetTimestamp(ts)
+	sdp.SetSum(100)
+	sdp.SetCount(20)
+	q := sdp.QuantileValues().AppendEmpty()
+	q.SetQuantile(0.5)
+	q.SetValue(5)
+
+	tsMap, err := FromMetrics(md, Settings{ExportCreatedMetric: true})
+	require.NoError(t, err)
+
+	req := &prompb.WriteRequest{
+		Metadata: []prompb.MetricMetadata{
+			{MetricFamilyName: "test_gauge", Type: prompb.MetricMetadata_GAUGE},
+			{MetricFamilyName: "test_counter", Type: prompb.MetricMetadata_COUNTER},
+			{MetricFamilyName: "test_hist", Type: prompb.MetricMetadata_HISTOGRAM},
+			{MetricFamilyName: "test_summary", Type: prompb.MetricMetadata_SUMMARY},
+		},
+	}
+	keys := make([]string, 0, len(tsMap))
+	for k := range tsMap {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		req.Timeseries = append(req.Timeseries, *tsMap[k])
+	}
+
+	got, err := FromWriteRequest(req)
+	require.NoError(t, err)
+	require.Equal(t, 1, got.ResourceMetrics().Len())
+	assert.Equal(t, rm.Resource().Attributes().AsRaw(), got.ResourceMetrics().At(0).Resource().Attributes().AsRaw())
+
+	metrics := metricsByName(got.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics())
+	require.Len(t, metrics, 4)
+	assert.Equal(t, gdp, metrics["test_gauge"].Gauge().DataPoints().At(0))
+	assert.Equal(t, sum, metrics["test_counter_total"].Sum())
+	assert.Equal(t, hdp, metrics["test_hist"].Histogram().DataPoints().At(0))
+	assert.Equal(t, sdp, metrics["test_summary"].Summary().DataPoints().At(0))
+}
+
+func metricsByName(ms pmetric.MetricSlice) map[string]pmetric.Metric {
+	metrics := make(map[string]pmetric.Metric, ms.Len())
+	for i := 0; i < ms.Len(); i++ {
+		metrics[ms.At(i).Name()] = ms.At(i)
+	}
+	return metrics
+}
@@ -0,0 +1,296 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package signalfx // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/translator/signalfx"
+
+import (
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/signalfx/com_signalfx_metrics_protobuf/model"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+const (
+	bucketSuffix = "_bucket"
+	countSuffix  = "_count"
+	sumSuffix    = "_sum"
+	minSuffix    = "_min"
+	maxSuffix    = "_max"
+)
+
+// histogramBucket is a "<name>_bucket" data point, its count includes all the lower buckets.
+type histogramBucket struct {
+	bound float64
+	count uint64
+}
+
+// histogramFamily gathers the data points of a single histogram data point, they
+// share the base metric name, the timestamp and the dimensions other than "le".
+type histogramFamily struct {
+	name       string
+	metricType model.MetricType
+	timestamp  int64
+	dimensions []*model.Dimension
+	buckets    []histogramBucket
+	members    []*model.DataPoint
+	count      *model.DataPoint
+	sum        *model.DataPoint
+	min        *model.DataPoint
+	max        *model.DataPoint
+	invalid    bool
+}
+
+// reconstructHistograms appends to ms the histograms following the Prometheus
+// conventions, as emitted by FromTranslator, and returns the data points not
+// part of any of them.
+func reconstructHistograms(sfxDataPoints []*model.DataPoint, ms pmetric.MetricSlice) []*model.DataPoint {
+	families := make(map[string]*histogramFamily)
+	var order []*histogramFamily
+
+	for _, dp := range sfxDataPoints {
+		if dp == nil || !strings.HasSuffix(dp.Metric, bucketSuffix) || !isCounter(dp) {
+			continue
+		}
+		bound, dims, ok := bucketBound(dp.Dimensions)
+		if !ok {
+			continue
+		}
+		name := strings.TrimSuffix(dp.Metric, bucketSuffix)
+		key := familyKey(name, dp.Timestamp, dims)
+		f, ok := families[key]
+		if !ok {
+			f = &histogramFamily{
+				name:       name,
+				metricType: dp.GetMetricType(),
+				timestamp:  dp.Timestamp,
+				dimensions: dims,
+			}
+			families[key] = f
+			order = append(order, f)
+		}
+		count, ok := datumToCount(dp.Value)
+		if !ok || f.metricType != dp.GetMetricType() {
+			f.invalid = true
+			continue
+		}
+		f.buckets = append(f.buckets, histogramBucket{bound: bound, count: count})
+		f.members = append(f.members, dp)
+	}
+	if len(families) == 0 {
+		return sfxDataPoints
+	}
+
+	for _, dp := range sfxDataPoints {
+		if dp == nil {
+			continue
+		}
+		for _, suffix := range []string{countSuffix, sumSuffix, minSuffix, maxSuffix} {
+			if !strings.HasSuffix(dp.Metric, suffix) {
+				continue
+			}
+			f, ok := families[familyKey(strings.TrimSuffix(dp.Metric, suffix), dp.Timestamp, dp.Dimensions)]
+			if ok {
+				f.attach(suffix, dp)
+			}
+			break
+		}
+	}
+
+	consumed := make(map[*model.DataPoint]struct{})
+	// This is a map from [metric_name, metric_type] -> index + 1 in the Metrics slice.
+	histogramToMetric := make(map[string][numMetricTypes]int)
+	for _, f := range order {
+		if f.invalid || !f.sortBuckets() {
+			continue
+		}
+		idxs := histogramToMetric[f.name]
+		if idxs[f.metricType] == 0 {
+			m := ms.AppendEmpty()
+			m.SetName(f.name)
+			h := m.SetEmptyHistogram()
+			if f.metricType == model.MetricType_COUNTER {
+				h.SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+			} else {
+				h.SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+			}
+			idxs[f.metricType] = ms.Len()
+			histogramToMetric[f.name] = idxs
+		}
+		f.fillDataPoint(ms.At(idxs[f.metricType] - 1).Histogram().DataPoints().AppendEmpty())
+		for _, dp := range f.members {
+			consumed[dp] = struct{}{}
+		}
+	}
+	if len(consumed) == 0 {
+		return sfxDataPoints
+	}
+
+	remaining := make([]*model.DataPoint, 0, len(sfxDataPoints)-len(consumed))
+	for _, dp := range sfxDataPoints {
+		if _, ok := consumed[dp]; !ok {
+			remaining = append(remaining, dp)
+		}
+	}
+	return remaining
+}
+
+// attach adds to the family the "_count", "_sum", "_min" or "_max" data point.
+func (f *histogramFamily) attach(suffix string, dp *model.DataPoint) {
+	switch suffix {
+	case countSuffix:
+		if _, ok := datumToCount(dp.Value); !ok || f.count != nil || dp.GetMetricType() != f.metricType {
+			return
+		}
+		f.count = dp
+	case sumSuffix:
+		if !hasValue(dp) || f.sum != nil || dp.GetMetricType() != f.metricType {
+			return
+		}
+		f.sum = dp
+	case minSuffix:
+		if !hasValue(dp) || f.min != nil || dp.GetMetricType() != model.MetricType_GAUGE {
+			return
+		}
+		f.min = dp
+	case maxSuffix:
+		if !hasValue(dp) || f.max != nil || dp.GetMetricType() != model.MetricType_GAUGE {
+			return
+		}
+		f.max = dp
+	default:
+		return
+	}
+	f.members = append(f.members, dp)
+}
+
+// sortBuckets sorts the buckets by bound and reports whether they describe
+// a valid histogram: distinct bounds, an "+Inf" bucket and non-decreasing counts.
+func (f *histogramFamily) sortBuckets() bool {
+	sort.Slice(f.buckets, func(i, j int) bool { return f.buckets[i].bound < f.buckets[j].bound })
+	last := len(f.buckets) - 1
+	if last < 0 || !math.IsInf(f.buckets[last].bound, 1) {
+		return false
+	}
+	for i := 1; i < len(f.buckets); i++ {
+		if f.buckets[i].bound == f.buckets[i-1].bound || f.buckets[i].count < f.buckets[i-1].count {
+			return false
+		}
+	}
+	return true
+}
+
+func (f *histogramFamily) fillDataPoint(dp pmetric.HistogramDataPoint) {
+	dp.SetTimestamp(toTimestamp(f.timestamp))
+	fillInAttributes(f.dimensions, dp.Attributes())
+
+	total := f.buckets[len(f.buckets)-1].count
+	if f.count != nil {
+		total, _ = datumToCount(f.count.Value)
+	}
+	dp.SetCount(total)
+	if f.sum != nil {
+		dp.SetSum(datumToFloat64(f.sum.Value))
+	}
+	if f.min != nil {
+		dp.SetMin(datumToFloat64(f.min.Value))
+	}
+	if f.max != nil {
+		dp.SetMax(datumToFloat64(f.max.Value))
+	}
+
+	bounds := dp.ExplicitBounds()
+	counts := dp.BucketCounts()
+	bounds.EnsureCapacity(len(f.buckets) - 1)
+	counts.EnsureCapacity(len(f.buckets))
+	var previous uint64
+	for i, b := range f.buckets {
+		if i < len(f.buckets)-1 {
+			bounds.Append(b.bound)
+		}
+		counts.Append(b.count - previous)
+		previous = b.count
+	}
+}
+
+// bucketBound returns the bound of the "le" dimension and the other dimensions.
+func bucketBound(dimensions []*model.Dimension) (float64, []*model.Dimension, bool) {
+	var bound float64
+	found := false
+	dims := make([]*model.Dimension, 0, len(dimensions))
+	for _, dim := range dimensions {
+		if dim == nil {
+			continue
+		}
+		if dim.Key != bucketDimensionKey {
+			dims = append(dims, dim)
+			continue
+		}
+		b, err := strconv.ParseFloat(dim.Value, 64)
+		if err != nil || math.IsNaN(b) || found {
+			return 0, nil, false
+		}
+		bound = b
+		found = true
+	}
+	return bound, dims, found
+}
+
+// familyKey identifies the data points of a histogram data point regardless of the dimensions order.
+func familyKey(name string, timestamp int64, dimensions []*model.Dimension) string {
+	pairs := make([]string, 0, len(dimensions))
+	for _, dim := range dimensions {
+		if dim != nil {
+			pairs = append(pairs, dim.Key+"\x00"+dim.Value)
+		}
+	}
+	sort.Strings(pairs)
+	return name + "\x01" + strconv.FormatInt(timestamp, 10) + "\x01" + strings.Join(pairs, "\x01")
+}
+
+func isCounter(dp *model.DataPoint) bool {
+	mt := dp.GetMetricType()
+	return mt == model.MetricType_COUNTER || mt == model.MetricType_CUMULATIVE_COUNTER
+}
+
+func hasValue(dp *model.DataPoint) bool {
+	return dp.Value.IntValue != nil || dp.Value.DoubleValue != nil
+}
+
+// datumToCount returns the datum as a count, it must be a non-negative integer.
+func datumToCount(d model.Datum) (uint64, bool) {
+	switch {
+	case d.IntValue != nil:
+		if *d.IntValue < 0 {
+			return 0, false
+		}
+		return uint64(*d.IntValue), true
+	case d.DoubleValue != nil:
+		v := *d.DoubleValue
+		if v < 0 || v != math.Trunc(v) || math.IsInf(v, 0) {
+			return 0, false
+		}
+		return uint64(v), true
+	}
+	return 0, false
+}
+
+func datumToFloat64(d model.Datum) float64 {
+	if d.IntValue != nil {
+		return float64(*d.IntValue)
+	}
+	return *d.DoubleValue
+}
@@ -0,0 +1,163 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package signalfx
+
+import (
+	"testing"
+	"time"
+
+	sfxpb "github.com/signalfx/com_signalfx_metrics_protobuf/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/comparetest"
+)
+
+func TestToMetricsReconstructHistograms(t *testing.T) {
+	ts := pcommon.NewTimestampFromTime(time.Now().Truncate(time.Millisecond))
+
+	buildHistogram := func(temporality pmetric.AggregationTemporality) pmetric.Metrics {
+		md := pmetric.NewMetrics()
+		m := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+		m.SetName("latency")
+		m.SetEmptyHistogram().SetAggregationTemporality(temporality)
+		for _, k := range []string{"a", "b"} {
+			dp := m.Histogram().DataPoints().AppendEmpty()
+			dp.SetTimestamp(ts)
+			dp.Attributes().PutStr("k0", k)
+			dp.Attributes().PutStr("k1", "v1")
+			dp.SetCount(16)
+			dp.SetSum(100)
+			dp.SetMin(0.1)
+			dp.SetMax(11.11)
+			dp.ExplicitBounds().FromRaw([]float64{1, 2.5, 4})
+			dp.BucketCounts().FromRaw([]uint64{4, 2, 3, 7})
+		}
+		return md
+	}
+
+	gauge := &sfxpb.DataPoint{
+		Metric:     "single",
+		Timestamp:  int64(ts) / 1e6,
+		Value:      sfxpb.Datum{IntValue: int64Ptr(13)},
+		MetricType: sfxTypePtr(sfxpb.MetricType_GAUGE),
+		Dimensions: buildNDimensions(3),
+	}
+
+	tests := []struct {
+		name          string
+		sfxDataPoints func() []*sfxpb.DataPoint
+		wantMetrics   func() pmetric.Metrics
+	}{
+		{
+			name: "cumulative",
+			sfxDataPoints: func() []*sfxpb.DataPoint {
+				dps, err := (&FromTranslator{}).FromMetrics(buildHistogram(pmetric.AggregationTemporalityCumulative))
+				require.NoError(t, err)
+				// The order of the data points must not matter.
+				dps[0], dps[len(dps)-1] = dps[len(dps)-1], dps[0]
+				return dps
+			},
+			wantMetrics: func() pmetric.Metrics {
+				return buildHistogram(pmetric.AggregationTemporalityCumulative)
+			},
+		},
+		{
+			name: "delta_with_other_metric",
+			sfxDataPoints: func() []*sfxpb.DataPoint {
+				dps, err := (&FromTranslator{}).FromMetrics(buildHistogram(pmetric.AggregationTemporalityDelta))
+				require.NoError(t, err)
+				return append(dps, gauge)
+			},
+			wantMetrics: func() pmetric.Metrics {
+				md := buildHistogram(pmetric.AggregationTemporalityDelta)
+				m := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().AppendEmpty()
+				m.SetName("single")
+				dp := m.SetEmptyGauge().DataPoints().AppendEmpty()
+				dp.SetTimestamp(ts)
+				dp.SetIntValue(13)
+				dp.Attributes().PutStr("k0", "v0")
+				dp.Attributes().PutStr("k1", "v1")
+				dp.Attributes().PutStr("k2", "v2")
+				return md
+			},
+		},
+		{
+			name: "missing_infinity_bucket",
+			sfxDataPoints: func() []*sfxpb.DataPoint {
+				return []*sfxpb.DataPoint{{
+					Metric:     "latency_bucket",
+					Timestamp:  int64(ts) / 1e6,
+					Value:      sfxpb.Datum{IntValue: int64Ptr(4)},
+					MetricType: sfxTypePtr(sfxpb.MetricType_CUMULATIVE_COUNTER),
+					Dimensions: []*sfxpb.Dimension{{Key: "le", Value: "1"}},
+				}}
+			},
+			wantMetrics: func() pmetric.Metrics {
+				md := pmetric.NewMetrics()
+				m := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+				m.SetName("latency_bucket")
+				m.SetEmptySum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+				m.Sum().SetIsMonotonic(true)
+				dp := m.Sum().DataPoints().AppendEmpty()
+				dp.SetTimestamp(ts)
+				dp.SetIntValue(4)
+				dp.Attributes().PutStr("le", "1")
+				return md
+			},
+		},
+		{
+			name: "decreasing_bucket_counts",
+			sfxDataPoints: func() []*sfxpb.DataPoint {
+				var dps []*sfxpb.DataPoint
+				for le, v := range map[string]int64{"1": 5, "+Inf": 3} {
+					dps = append(dps, &sfxpb.DataPoint{
+						Metric:     "latency_bucket",
+						Timestamp:  int64(ts) / 1e6,
+						Value:      sfxpb.Datum{IntValue: int64Ptr(v)},
+						MetricType: sfxTypePtr(sfxpb.MetricType_CUMULATIVE_COUNTER),
+						Dimensions: []*sfxpb.Dimension{{Key: "le", Value: le}},
+					})
+				}
+				return dps
+			},
+			wantMetrics: func() pmetric.Metrics {
+				md := pmetric.NewMetrics()
+				m := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+				m.SetName("latency_bucket")
+				m.SetEmptySum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+				m.Sum().SetIsMonotonic(true)
+				for _, le := range []string{"1", "+Inf"} {
+					dp := m.Sum().DataPoints().AppendEmpty()
+					dp.SetTimestamp(ts)
+					dp.SetIntValue(map[string]int64{"1": 5, "+Inf": 3}[le])
+					dp.Attributes().PutStr("le", le)
+				}
+				return md
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			to := &ToTranslator{ReconstructHistograms: true}
+			md, err := to.ToMetrics(tt.sfxDataPoints())
+			assert.NoError(t, err)
+			assert.NoError(t, comparetest.CompareMetrics(tt.wantMetrics(), md, comparetest.IgnoreMetricDataPointsOrder()))
+		})
+	}
+}
@@ -26,7 +26,12 @@ import (
 const numMetricTypes = 4
 
 // ToTranslator converts from SignalFx proto data model to pdata.
-type ToTranslator struct{}
+type ToTranslator struct {
+	// ReconstructHistograms combines the data points following the Prometheus
+	// histogram conventions ("<name>_bucket" with an "le" dimension, "<name>_count",
+	// "<name>_sum", "<name>_min" and "<name>_max") into OTLP histograms.
+	ReconstructHistograms bool
+}
 
 // ToMetrics converts SignalFx proto data points to pmetric.Metrics.
 func (tt *ToTranslator) ToMetrics(sfxDataPoints []*model.DataPoint) (pmetric.Metrics, error) {
@@ -40,6 +45,10 @@ func (tt *ToTranslator) ToMetrics(sfxDataPoints []*model.DataPoint) (pmetric.Met
 	// This is a map from [metric_name, metric_type] -> index + 1 in the Metrics slice. Used to combine datapoints together.
 	datapointToMetric := make(map[string][numMetricTypes]int, len(sfxDataPoints))
 
+	if tt.ReconstructHistograms {
+		sfxDataPoints = reconstructHistograms(sfxDataPoints, ms)
+	}
+
 	var err error
 	for _, sfxDataPoint := range sfxDataPoints {
 		if sfxDataPoint == nil {
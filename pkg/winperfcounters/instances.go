@@ -0,0 +1,141 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build windows
+// +build windows
+
+package winperfcounters // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/winperfcounters"
+
+import (
+	"math"
+	"sort"
+	"time"
+)
+
+// InstanceMetadata describes the lifetime of a counter instance at the time of a scrape.
+type InstanceMetadata struct {
+	// StartTime is the time the instance was first observed, or last restarted. It can be used
+	// as the start timestamp of cumulative data points.
+	StartTime time.Time
+	// PreviousTime is the time of the previous scrape of the instance. It can be used as the start
+	// timestamp of delta data points. It is zero when Reset is true.
+	PreviousTime time.Time
+	// Reset is true when the value can't be compared with the value of the previous scrape,
+	// because the instance is new or was restarted.
+	Reset bool
+	// Wrapped is true when the counter wrapped around its maximum value since the previous scrape.
+	Wrapped bool
+	// Delta is the increase of the value since the previous scrape, accounting for a wrap-around.
+	// It is zero when Reset is true.
+	Delta uint64
+	// Total is the increase of the value since StartTime, accounting for wrap-arounds. When the
+	// instance is first observed, it is the raw value of the counter.
+	Total uint64
+	// Restarts is the number of times the instance was observed restarting.
+	Restarts int
+}
+
+type instanceState struct {
+	startTime time.Time
+	lastTime  time.Time
+	lastValue uint64
+	total     uint64
+	restarts  int
+}
+
+// InstanceTracker tracks the instances of a counter across scrapes, so that receivers can report
+// accurate rates when instances come and go, e.g. processes restarting, or when counters wrap around.
+// Instances are identified by their name. The values are the raw values of monotonic counters, see
+// PerfCounterWatcher.ScrapeRawValues.
+type InstanceTracker struct {
+	instances map[string]*instanceState
+}
+
+// NewInstanceTracker creates a new InstanceTracker.
+func NewInstanceTracker() *InstanceTracker {
+	return &InstanceTracker{instances: map[string]*instanceState{}}
+}
+
+// Track records the raw values of a scrape made at now. It returns the metadata of the values, in the
+// same order, and the names of the instances that disappeared since the previous scrape.
+func (t *InstanceTracker) Track(vals []RawCounterValue, now time.Time) ([]InstanceMetadata, []string) {
+	metadata := make([]InstanceMetadata, len(vals))
+	seen := make(map[string]struct{}, len(vals))
+
+	for i, val := range vals {
+		seen[val.InstanceName] = struct{}{}
+		// 64-bit counters are reported as signed integers, they are unsigned for the purpose of wrap-arounds.
+		value := uint64(val.RawValue)
+
+		state, ok := t.instances[val.InstanceName]
+		if !ok {
+			t.instances[val.InstanceName] = &instanceState{startTime: now, lastTime: now, lastValue: value, total: value}
+			metadata[i] = InstanceMetadata{StartTime: now, Reset: true, Total: value}
+			continue
+		}
+
+		md := InstanceMetadata{StartTime: state.startTime, PreviousTime: state.lastTime}
+		switch {
+		case value >= state.lastValue:
+			md.Delta = value - state.lastValue
+		case wrapsAround(state.lastValue, value):
+			md.Wrapped = true
+			md.Delta = increaseAcrossWrap(state.lastValue, value)
+		default:
+			// The value went backwards without wrapping around, the instance was restarted.
+			state.restarts++
+			state.startTime = now
+			md = InstanceMetadata{StartTime: now, Reset: true}
+		}
+		if md.Reset {
+			state.total = value
+		} else {
+			state.total += md.Delta
+		}
+		md.Total = state.total
+		md.Restarts = state.restarts
+		state.lastTime = now
+		state.lastValue = value
+		metadata[i] = md
+	}
+
+	var gone []string
+	for name := range t.instances {
+		if _, ok := seen[name]; !ok {
+			gone = append(gone, name)
+			delete(t.instances, name)
+		}
+	}
+	sort.Strings(gone)
+	return metadata, gone
+}
+
+// increaseAcrossWrap returns the increase from previous to current of a counter that wrapped around
+// the maximum value of its size.
+func increaseAcrossWrap(previous, current uint64) uint64 {
+	if previous <= math.MaxUint32 {
+		return math.MaxUint32 - previous + current + 1
+	}
+	// The subtraction wraps around like the 64-bit counter did.
+	return current - previous
+}
+
+// wrapsAround determines if a decreasing value is the result of a wrap-around rather than a restart:
+// the previous value was in the upper quarter of its counter size, and the current one in the lower quarter.
+func wrapsAround(previous, current uint64) bool {
+	if previous <= math.MaxUint32 {
+		return previous >= math.MaxUint32/4*3 && current < math.MaxUint32/4
+	}
+	return previous >= math.MaxUint64/4*3 && current < math.MaxUint64/4
+}
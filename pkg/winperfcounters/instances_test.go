@@ -0,0 +1,89 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build windows
+// +build windows
+
+package winperfcounters
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInstanceTracker(t *testing.T) {
+	t0 := time.Unix(1000, 0)
+	t1 := t0.Add(time.Minute)
+	t2 := t1.Add(time.Minute)
+	tracker := NewInstanceTracker()
+
+	md, gone := tracker.Track([]RawCounterValue{
+		{InstanceName: "a", RawValue: 10},
+		{InstanceName: "b", RawValue: math.MaxUint32 - 5},
+		{InstanceName: "c", RawValue: 100},
+	}, t0)
+	assert.Equal(t, []InstanceMetadata{
+		{StartTime: t0, Reset: true, Total: 10},
+		{StartTime: t0, Reset: true, Total: math.MaxUint32 - 5},
+		{StartTime: t0, Reset: true, Total: 100},
+	}, md)
+	assert.Empty(t, gone)
+
+	md, gone = tracker.Track([]RawCounterValue{
+		{InstanceName: "a", RawValue: 15},
+		{InstanceName: "b", RawValue: 10},
+		{InstanceName: "c", RawValue: 20},
+	}, t1)
+	assert.Equal(t, []InstanceMetadata{
+		{StartTime: t0, PreviousTime: t0, Delta: 5, Total: 15},
+		{StartTime: t0, PreviousTime: t0, Wrapped: true, Delta: 16, Total: math.MaxUint32 + 11},
+		{StartTime: t1, Reset: true, Total: 20, Restarts: 1},
+	}, md)
+	assert.Empty(t, gone)
+
+	md, gone = tracker.Track([]RawCounterValue{
+		{InstanceName: "c", RawValue: 30},
+		{InstanceName: "d", RawValue: 1},
+	}, t2)
+	assert.Equal(t, []InstanceMetadata{
+		{StartTime: t1, PreviousTime: t1, Delta: 10, Total: 30, Restarts: 1},
+		{StartTime: t2, Reset: true, Total: 1},
+	}, md)
+	assert.Equal(t, []string{"a", "b"}, gone)
+}
+
+func TestInstanceTrackerWraps64Bit(t *testing.T) {
+	t0 := time.Unix(1000, 0)
+	tracker := NewInstanceTracker()
+
+	// 64-bit counters in their upper half are reported as negative raw values.
+	tracker.Track([]RawCounterValue{{RawValue: -10}}, t0)
+	md, _ := tracker.Track([]RawCounterValue{{RawValue: 5}}, t0.Add(time.Minute))
+	require.Len(t, md, 1)
+	assert.True(t, md[0].Wrapped)
+	assert.False(t, md[0].Reset)
+	assert.Equal(t, uint64(15), md[0].Delta)
+}
+
+func TestWrapsAround(t *testing.T) {
+	assert.True(t, wrapsAround(math.MaxUint32-1, 1))
+	assert.False(t, wrapsAround(math.MaxUint32/2, 1))
+	assert.False(t, wrapsAround(math.MaxUint32-1, math.MaxUint32/2))
+	assert.True(t, wrapsAround(math.MaxUint64-1e15, 1))
+	assert.False(t, wrapsAround(1e12, 1))
+}
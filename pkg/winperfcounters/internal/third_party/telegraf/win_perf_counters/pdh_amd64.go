@@ -69,6 +69,22 @@ type PDH_FMT_COUNTERVALUE_ITEM_LONG struct {
 	FmtValue PDH_FMT_COUNTERVALUE_LONG
 }
 
+// PDH_RAW_COUNTER structure returns the data as it was collected from the counter provider. No translation, formatting,
+// or other interpretation is performed on the data.
+type PDH_RAW_COUNTER struct {
+	CStatus     uint32
+	TimeStamp   FILETIME
+	FirstValue  int64
+	SecondValue int64
+	MultiCount  uint32
+}
+
+// PDH_RAW_COUNTER_ITEM holds the instance name and raw value of a counter, used by PdhGetRawCounterArray()
+type PDH_RAW_COUNTER_ITEM struct {
+	SzName   *uint16 // pointer to a string
+	RawValue PDH_RAW_COUNTER
+}
+
 // PDH_COUNTER_INFO structure contains information describing the properties of a counter. This information also includes the counter path.
 type PDH_COUNTER_INFO struct {
 	//Size of the structure, including the appended strings, in bytes.
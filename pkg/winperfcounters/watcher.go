@@ -34,12 +34,17 @@ type PerfCounterWatcher interface {
 	Path() string
 	// ScrapeData collects a measurement and returns the value(s).
 	ScrapeData() ([]CounterValue, error)
+	// ScrapeRawValues collects a measurement and returns the raw value(s), as provided by the counter
+	// without being computed from the previous measurement.
+	ScrapeRawValues() ([]RawCounterValue, error)
 	// Close all counters/handles related to the query and free all associated memory.
 	Close() error
 }
 
 type CounterValue = win_perf_counters.CounterValue
 
+type RawCounterValue = win_perf_counters.RawCounterValue
+
 type perfCounter struct {
 	path   string
 	query  win_perf_counters.PerformanceQuery
@@ -129,6 +134,19 @@ func (pc *perfCounter) ScrapeData() ([]CounterValue, error) {
 	return vals, nil
 }
 
+func (pc *perfCounter) ScrapeRawValues() ([]RawCounterValue, error) {
+	if err := pc.query.CollectData(); err != nil {
+		return nil, fmt.Errorf("failed to collect data for performance counter '%s': %w", pc.path, err)
+	}
+
+	vals, err := pc.query.GetRawCounterArray(pc.handle)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get raw data for performance counter '%s': %w", pc.path, err)
+	}
+
+	return removeTotalIfMultipleRawValues(vals), nil
+}
+
 func removeTotalIfMultipleValues(vals []CounterValue) []CounterValue {
 	if len(vals) == 0 {
 		return vals
@@ -157,3 +175,21 @@ func removeItemAt(vals []CounterValue, idx int) []CounterValue {
 	vals[len(vals)-1] = CounterValue{}
 	return vals[:len(vals)-1]
 }
+
+func removeTotalIfMultipleRawValues(vals []RawCounterValue) []RawCounterValue {
+	if len(vals) == 1 {
+		if vals[0].InstanceName == totalInstanceName {
+			vals[0].InstanceName = ""
+		}
+		return vals
+	}
+
+	for i, val := range vals {
+		if val.InstanceName == totalInstanceName {
+			vals[i] = vals[len(vals)-1]
+			return vals[:len(vals)-1]
+		}
+	}
+
+	return vals
+}
@@ -0,0 +1,115 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package adaptivesamplerprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/adaptivesamplerprocessor"
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+)
+
+// Config has the configuration guiding the adaptive sampler processor.
+type Config struct {
+
+	// SamplingPercentage is the percentage rate at which traces are sampled while the pipeline is not under
+	// pressure. It is also the upper bound the effective rate is restored to once pressure subsides.
+	// Values greater or equal 100 are treated as "sample all traces".
+	SamplingPercentage float32 `mapstructure:"sampling_percentage"`
+
+	// MinSamplingPercentage is the lower bound of the effective sampling rate, no matter how high the
+	// pressure on the pipeline is.
+	MinSamplingPercentage float32 `mapstructure:"min_sampling_percentage"`
+
+	// HashSeed allows one to configure the hashing seed, see the probabilistic_sampler processor for details.
+	HashSeed uint32 `mapstructure:"hash_seed"`
+
+	// CheckInterval is the time between measurements of the pipeline pressure. Every measurement may
+	// result in an adjustment of the effective sampling rate.
+	CheckInterval time.Duration `mapstructure:"check_interval"`
+
+	// HighWatermark is the pressure, expressed as a ratio in the (0, 1] range, at or above which the
+	// effective sampling rate is lowered.
+	HighWatermark float64 `mapstructure:"high_watermark"`
+
+	// LowWatermark is the pressure, expressed as a ratio in the [0, 1) range, at or below which the
+	// effective sampling rate is raised back towards SamplingPercentage.
+	LowWatermark float64 `mapstructure:"low_watermark"`
+
+	// DecreaseFactor is multiplied with the effective sampling rate every time the pressure is at
+	// or above the high watermark.
+	DecreaseFactor float32 `mapstructure:"decrease_factor"`
+
+	// IncreaseStep is the number of percentage points added to the effective sampling rate every
+	// time the pressure is at or below the low watermark.
+	IncreaseStep float32 `mapstructure:"increase_step"`
+
+	// MemoryLimitMiB is the amount of heap memory, in MiB, considered as full memory pressure.
+	// Zero disables memory as a pressure source.
+	MemoryLimitMiB uint32 `mapstructure:"memory_limit_mib"`
+
+	// ExporterQueues lists the exporter sending queues whose utilization is used as a pressure source.
+	ExporterQueues []ExporterQueueConfig `mapstructure:"exporter_queues"`
+}
+
+// ExporterQueueConfig identifies the sending queue of an exporter.
+type ExporterQueueConfig struct {
+	// Exporter is the ID of the exporter as used in the service pipelines, e.g. "otlp/backend".
+	Exporter string `mapstructure:"exporter"`
+
+	// QueueSize is the capacity of the sending queue of the exporter, it must match the
+	// `sending_queue::queue_size` setting of the exporter.
+	QueueSize int `mapstructure:"queue_size"`
+}
+
+var _ component.Config = (*Config)(nil)
+
+// Validate checks if the processor configuration is valid
+func (cfg *Config) Validate() error {
+	if cfg.SamplingPercentage <= 0 {
+		return fmt.Errorf("sampling_percentage must be positive: %.2f", cfg.SamplingPercentage)
+	}
+	if cfg.MinSamplingPercentage < 0 || cfg.MinSamplingPercentage > cfg.SamplingPercentage {
+		return fmt.Errorf("min_sampling_percentage must be between 0 and sampling_percentage: %.2f", cfg.MinSamplingPercentage)
+	}
+	if cfg.CheckInterval <= 0 {
+		return errors.New("check_interval must be positive")
+	}
+	if cfg.HighWatermark <= 0 || cfg.HighWatermark > 1 {
+		return fmt.Errorf("high_watermark must be in the (0, 1] range: %.2f", cfg.HighWatermark)
+	}
+	if cfg.LowWatermark < 0 || cfg.LowWatermark >= cfg.HighWatermark {
+		return fmt.Errorf("low_watermark must be in the [0, high_watermark) range: %.2f", cfg.LowWatermark)
+	}
+	if cfg.DecreaseFactor <= 0 || cfg.DecreaseFactor >= 1 {
+		return fmt.Errorf("decrease_factor must be in the (0, 1) range: %.2f", cfg.DecreaseFactor)
+	}
+	if cfg.IncreaseStep <= 0 {
+		return fmt.Errorf("increase_step must be positive: %.2f", cfg.IncreaseStep)
+	}
+	if cfg.MemoryLimitMiB == 0 && len(cfg.ExporterQueues) == 0 {
+		return errors.New("at least one of memory_limit_mib or exporter_queues must be configured")
+	}
+	for _, q := range cfg.ExporterQueues {
+		if q.Exporter == "" {
+			return errors.New("exporter_queues: exporter must not be empty")
+		}
+		if q.QueueSize <= 0 {
+			return fmt.Errorf("exporter_queues: queue_size of %q must be positive", q.Exporter)
+		}
+	}
+	return nil
+}
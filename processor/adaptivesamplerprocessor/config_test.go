@@ -0,0 +1,120 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package adaptivesamplerprocessor
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+)
+
+func TestLoadConfig(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		id          component.ID
+		expected    component.Config
+		errorString string
+	}{
+		{
+			id: component.NewIDWithName(typeStr, ""),
+			expected: &Config{
+				SamplingPercentage:    50,
+				MinSamplingPercentage: 1,
+				HashSeed:              22,
+				CheckInterval:         5 * time.Second,
+				HighWatermark:         0.9,
+				LowWatermark:          0.6,
+				DecreaseFactor:        0.75,
+				IncreaseStep:          2,
+				MemoryLimitMiB:        512,
+				ExporterQueues: []ExporterQueueConfig{
+					{Exporter: "otlp/backend", QueueSize: 5000},
+				},
+			},
+		},
+		{
+			id:          component.NewIDWithName(typeStr, "invalid"),
+			errorString: "low_watermark must be in the [0, high_watermark) range: 0.90",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+			require.NoError(t, err)
+			processors, err := cm.Sub("processors")
+			require.NoError(t, err)
+
+			factory := NewFactory()
+			cfg := factory.CreateDefaultConfig()
+
+			sub, err := processors.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, component.UnmarshalConfig(sub, cfg))
+
+			if tt.errorString != "" {
+				assert.EqualError(t, component.ValidateConfig(cfg), tt.errorString)
+				return
+			}
+			assert.NoError(t, component.ValidateConfig(cfg))
+			assert.Equal(t, tt.expected, cfg)
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		modify      func(cfg *Config)
+		errorString string
+	}{
+		{
+			name:        "no pressure source",
+			modify:      func(cfg *Config) { cfg.MemoryLimitMiB = 0 },
+			errorString: "at least one of memory_limit_mib or exporter_queues must be configured",
+		},
+		{
+			name:        "min above max",
+			modify:      func(cfg *Config) { cfg.MinSamplingPercentage = 200 },
+			errorString: "min_sampling_percentage must be between 0 and sampling_percentage: 200.00",
+		},
+		{
+			name:        "decrease factor out of range",
+			modify:      func(cfg *Config) { cfg.DecreaseFactor = 1 },
+			errorString: "decrease_factor must be in the (0, 1) range: 1.00",
+		},
+		{
+			name: "queue without size",
+			modify: func(cfg *Config) {
+				cfg.ExporterQueues = []ExporterQueueConfig{{Exporter: "otlp"}}
+			},
+			errorString: `exporter_queues: queue_size of "otlp" must be positive`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			cfg.MemoryLimitMiB = 100
+			tt.modify(cfg)
+			assert.EqualError(t, cfg.Validate(), tt.errorString)
+		})
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package adaptivesamplerprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/adaptivesamplerprocessor"
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.opencensus.io/stats/view"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/configtelemetry"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/processor"
+)
+
+const (
+	// The value of "type" key in configuration.
+	typeStr = "adaptive_sampler"
+	// The stability level of the processor.
+	stability = component.StabilityLevelDevelopment
+
+	defaultSamplingPercentage = 100
+	defaultCheckInterval      = time.Second
+	defaultHighWatermark      = 0.8
+	defaultLowWatermark       = 0.5
+	defaultDecreaseFactor     = 0.5
+	defaultIncreaseStep       = 5
+)
+
+var onceMetrics sync.Once
+
+// NewFactory returns a new factory for the Adaptive sampler processor.
+func NewFactory() processor.Factory {
+	onceMetrics.Do(func() {
+		// TODO: Handle this err
+		_ = view.Register(SamplingProcessorMetricViews(configtelemetry.LevelNormal)...)
+	})
+
+	return processor.NewFactory(
+		typeStr,
+		createDefaultConfig,
+		processor.WithTraces(createTracesProcessor, stability))
+}
+
+func createDefaultConfig() component.Config {
+	return &Config{
+		SamplingPercentage: defaultSamplingPercentage,
+		CheckInterval:      defaultCheckInterval,
+		HighWatermark:      defaultHighWatermark,
+		LowWatermark:       defaultLowWatermark,
+		DecreaseFactor:     defaultDecreaseFactor,
+		IncreaseStep:       defaultIncreaseStep,
+	}
+}
+
+// createTracesProcessor creates a trace processor based on this config.
+func createTracesProcessor(
+	ctx context.Context,
+	set processor.CreateSettings,
+	cfg component.Config,
+	nextConsumer consumer.Traces,
+) (processor.Traces, error) {
+	return newTracesProcessor(ctx, set, cfg.(*Config), nextConsumer)
+}
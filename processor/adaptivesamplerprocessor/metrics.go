@@ -0,0 +1,68 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package adaptivesamplerprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/adaptivesamplerprocessor"
+
+import (
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+	"go.opentelemetry.io/collector/config/configtelemetry"
+	"go.opentelemetry.io/collector/obsreport"
+)
+
+// Variables related to metrics specific to adaptive sampling.
+var (
+	tagProcessorKey, _ = tag.NewKey("processor")
+	tagSampledKey, _   = tag.NewKey("sampled")
+
+	statCountTracesSampled          = stats.Int64("count_traces_sampled", "Count of traces that were sampled or not", stats.UnitDimensionless)
+	statEffectiveSamplingPercentage = stats.Float64("effective_sampling_percentage", "Sampling percentage currently applied to traces", stats.UnitDimensionless)
+	statPipelinePressure            = stats.Float64("pipeline_pressure", "Highest pressure ratio measured across the configured pressure sources", stats.UnitDimensionless)
+)
+
+// SamplingProcessorMetricViews return the metrics views according to given telemetry level.
+func SamplingProcessorMetricViews(level configtelemetry.Level) []*view.View {
+	if level == configtelemetry.LevelNone {
+		return nil
+	}
+
+	countTracesSampledView := &view.View{
+		Name:        obsreport.BuildProcessorCustomMetricName(typeStr, statCountTracesSampled.Name()),
+		Measure:     statCountTracesSampled,
+		Description: statCountTracesSampled.Description(),
+		TagKeys:     []tag.Key{tagProcessorKey, tagSampledKey},
+		Aggregation: view.Sum(),
+	}
+	effectiveSamplingPercentageView := &view.View{
+		Name:        obsreport.BuildProcessorCustomMetricName(typeStr, statEffectiveSamplingPercentage.Name()),
+		Measure:     statEffectiveSamplingPercentage,
+		Description: statEffectiveSamplingPercentage.Description(),
+		TagKeys:     []tag.Key{tagProcessorKey},
+		Aggregation: view.LastValue(),
+	}
+	pipelinePressureView := &view.View{
+		Name:        obsreport.BuildProcessorCustomMetricName(typeStr, statPipelinePressure.Name()),
+		Measure:     statPipelinePressure,
+		Description: statPipelinePressure.Description(),
+		TagKeys:     []tag.Key{tagProcessorKey},
+		Aggregation: view.LastValue(),
+	}
+
+	return []*view.View{
+		countTracesSampledView,
+		effectiveSamplingPercentageView,
+		pipelinePressureView,
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package adaptivesamplerprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/adaptivesamplerprocessor"
+
+import (
+	"runtime"
+
+	"go.opencensus.io/metric/metricdata"
+	"go.opencensus.io/metric/metricproducer"
+)
+
+const (
+	mibBytes = 1024 * 1024
+
+	// queueSizeMetricName is the name of the gauge reported by the exporterhelper for the
+	// current number of batches in the sending queue of an exporter.
+	queueSizeMetricName = "exporter/queue_size"
+	exporterLabelKey    = "exporter"
+)
+
+// pressureSource returns the current pressure on the pipeline as a ratio, where 0 means
+// no pressure and 1 means that a resource is exhausted.
+type pressureSource func() float64
+
+// memoryPressure returns a pressureSource measuring the heap usage against the given limit.
+func memoryPressure(limitMiB uint32, readMemStats func(*runtime.MemStats)) pressureSource {
+	limit := float64(uint64(limitMiB) * mibBytes)
+	return func() float64 {
+		var ms runtime.MemStats
+		readMemStats(&ms)
+		return float64(ms.Alloc) / limit
+	}
+}
+
+// queueSizeReader returns the current size of the sending queue of the exporter with the
+// given ID, and false if the exporter does not report any.
+type queueSizeReader func(exporter string) (int64, bool)
+
+// queuePressure returns a pressureSource measuring the utilization of an exporter sending queue.
+func queuePressure(q ExporterQueueConfig, read queueSizeReader) pressureSource {
+	return func() float64 {
+		size, ok := read(q.Exporter)
+		if !ok {
+			return 0
+		}
+		return float64(size) / float64(q.QueueSize)
+	}
+}
+
+// readQueueSize looks up the queue size gauge of the given exporter in the metrics
+// registered with the OpenCensus global producer manager.
+func readQueueSize(exporter string) (int64, bool) {
+	for _, producer := range metricproducer.GlobalManager().GetAll() {
+		for _, m := range producer.Read() {
+			if m.Descriptor.Name != queueSizeMetricName {
+				continue
+			}
+			if size, ok := findQueueSize(m, exporter); ok {
+				return size, true
+			}
+		}
+	}
+	return 0, false
+}
+
+func findQueueSize(m *metricdata.Metric, exporter string) (int64, bool) {
+	labelIdx := -1
+	for i, k := range m.Descriptor.LabelKeys {
+		if k.Key == exporterLabelKey {
+			labelIdx = i
+			break
+		}
+	}
+	if labelIdx < 0 {
+		return 0, false
+	}
+	for _, ts := range m.TimeSeries {
+		if labelIdx >= len(ts.LabelValues) || ts.LabelValues[labelIdx].Value != exporter || len(ts.Points) == 0 {
+			continue
+		}
+		if v, ok := ts.Points[len(ts.Points)-1].Value.(int64); ok {
+			return v, true
+		}
+	}
+	return 0, false
+}
+
+// maxPressure returns the highest pressure reported by the given sources.
+func maxPressure(sources []pressureSource) float64 {
+	var pressure float64
+	for _, source := range sources {
+		if p := source(); p > pressure {
+			pressure = p
+		}
+	}
+	return pressure
+}
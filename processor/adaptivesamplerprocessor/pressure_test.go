@@ -0,0 +1,82 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package adaptivesamplerprocessor
+
+import (
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opencensus.io/metric/metricdata"
+)
+
+func TestMemoryPressure(t *testing.T) {
+	source := memoryPressure(100, func(ms *runtime.MemStats) {
+		ms.Alloc = 75 * mibBytes
+	})
+	assert.Equal(t, 0.75, source())
+}
+
+func TestQueuePressure(t *testing.T) {
+	read := func(exporter string) (int64, bool) {
+		if exporter == "otlp" {
+			return 250, true
+		}
+		return 0, false
+	}
+
+	assert.Equal(t, 0.25, queuePressure(ExporterQueueConfig{Exporter: "otlp", QueueSize: 1000}, read)())
+	assert.Equal(t, 0.0, queuePressure(ExporterQueueConfig{Exporter: "missing", QueueSize: 1000}, read)())
+}
+
+func TestMaxPressure(t *testing.T) {
+	sources := []pressureSource{
+		func() float64 { return 0.2 },
+		func() float64 { return 0.9 },
+		func() float64 { return 0.4 },
+	}
+	assert.Equal(t, 0.9, maxPressure(sources))
+	assert.Equal(t, 0.0, maxPressure(nil))
+}
+
+func TestFindQueueSize(t *testing.T) {
+	m := &metricdata.Metric{
+		Descriptor: metricdata.Descriptor{
+			Name:      queueSizeMetricName,
+			LabelKeys: []metricdata.LabelKey{{Key: exporterLabelKey}},
+		},
+		TimeSeries: []*metricdata.TimeSeries{
+			{
+				LabelValues: []metricdata.LabelValue{metricdata.NewLabelValue("otlp")},
+				Points:      []metricdata.Point{{Value: int64(10)}, {Value: int64(42)}},
+			},
+			{
+				LabelValues: []metricdata.LabelValue{metricdata.NewLabelValue("otlp/backend")},
+				Points:      []metricdata.Point{{Value: int64(7)}},
+			},
+		},
+	}
+
+	size, ok := findQueueSize(m, "otlp")
+	assert.True(t, ok)
+	assert.Equal(t, int64(42), size)
+
+	size, ok = findQueueSize(m, "otlp/backend")
+	assert.True(t, ok)
+	assert.Equal(t, int64(7), size)
+
+	_, ok = findQueueSize(m, "kafka")
+	assert.False(t, ok)
+}
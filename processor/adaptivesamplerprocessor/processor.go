@@ -0,0 +1,190 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package adaptivesamplerprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/adaptivesamplerprocessor"
+
+import (
+	"context"
+	"runtime"
+	"sync"
+	"time"
+
+	"go.opencensus.io/stats"
+	"go.opencensus.io/tag"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/processor"
+	"go.opentelemetry.io/collector/processor/processorhelper"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/murmur3"
+)
+
+const (
+	// The constants help translate user friendly percentages to numbers direct used in sampling.
+	numHashBuckets        = 0x4000 // Using a power of 2 to avoid division.
+	bitMaskHashBuckets    = numHashBuckets - 1
+	percentageScaleFactor = numHashBuckets / 100.0
+)
+
+type adaptiveSamplerProcessor struct {
+	cfg      *Config
+	logger   *zap.Logger
+	hashSeed uint32
+	sources  []pressureSource
+
+	// processorTag tags the metrics with the ID of the processor, so that each instance has its own series.
+	processorTag tag.Mutator
+
+	// samplingPercentage is the current effective sampling rate, only modified by adjust.
+	samplingPercentage float32
+	// scaledSamplingRate is the effective sampling rate read by the data path.
+	scaledSamplingRate *atomic.Uint32
+
+	done     chan struct{}
+	stopOnce sync.Once
+}
+
+// newTracesProcessor returns a processor.Traces that performs head sampling at a rate
+// adjusted according to the pressure on the pipeline.
+func newTracesProcessor(ctx context.Context, set processor.CreateSettings, cfg *Config, nextConsumer consumer.Traces) (processor.Traces, error) {
+	asp := newAdaptiveSamplerProcessor(set, cfg, runtime.ReadMemStats, readQueueSize)
+
+	return processorhelper.NewTracesProcessor(
+		ctx,
+		set,
+		cfg,
+		nextConsumer,
+		asp.processTraces,
+		processorhelper.WithCapabilities(consumer.Capabilities{MutatesData: true}),
+		processorhelper.WithStart(asp.start),
+		processorhelper.WithShutdown(asp.shutdown))
+}
+
+func newAdaptiveSamplerProcessor(set processor.CreateSettings, cfg *Config, readMemStats func(*runtime.MemStats), readQueue queueSizeReader) *adaptiveSamplerProcessor {
+	var sources []pressureSource
+	if cfg.MemoryLimitMiB > 0 {
+		sources = append(sources, memoryPressure(cfg.MemoryLimitMiB, readMemStats))
+	}
+	for _, q := range cfg.ExporterQueues {
+		sources = append(sources, queuePressure(q, readQueue))
+	}
+
+	asp := &adaptiveSamplerProcessor{
+		cfg:                cfg,
+		logger:             set.Logger,
+		hashSeed:           cfg.HashSeed,
+		sources:            sources,
+		processorTag:       tag.Upsert(tagProcessorKey, set.ID.String()),
+		scaledSamplingRate: atomic.NewUint32(0),
+		done:               make(chan struct{}),
+	}
+	asp.setSamplingPercentage(cfg.SamplingPercentage)
+	return asp
+}
+
+func (asp *adaptiveSamplerProcessor) start(context.Context, component.Host) error {
+	go asp.checkPressure()
+	return nil
+}
+
+func (asp *adaptiveSamplerProcessor) shutdown(context.Context) error {
+	asp.stopOnce.Do(func() { close(asp.done) })
+	return nil
+}
+
+func (asp *adaptiveSamplerProcessor) checkPressure() {
+	ticker := time.NewTicker(asp.cfg.CheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			asp.adjust(maxPressure(asp.sources))
+		case <-asp.done:
+			return
+		}
+	}
+}
+
+// adjust lowers the effective sampling rate multiplicatively when the pressure is at or above
+// the high watermark, and raises it additively when the pressure is at or below the low watermark.
+func (asp *adaptiveSamplerProcessor) adjust(pressure float64) {
+	_ = stats.RecordWithTags(context.Background(), []tag.Mutator{asp.processorTag}, statPipelinePressure.M(pressure))
+
+	current := asp.samplingPercentage
+	next := current
+	switch {
+	case pressure >= asp.cfg.HighWatermark:
+		next = current * asp.cfg.DecreaseFactor
+		if next < asp.cfg.MinSamplingPercentage {
+			next = asp.cfg.MinSamplingPercentage
+		}
+	case pressure <= asp.cfg.LowWatermark:
+		next = current + asp.cfg.IncreaseStep
+		if next > asp.cfg.SamplingPercentage {
+			next = asp.cfg.SamplingPercentage
+		}
+	}
+
+	if next != current {
+		asp.logger.Debug("Adjusting effective sampling rate",
+			zap.Float64("pressure", pressure),
+			zap.Float32("previous_percentage", current),
+			zap.Float32("percentage", next))
+		asp.setSamplingPercentage(next)
+	}
+}
+
+func (asp *adaptiveSamplerProcessor) setSamplingPercentage(percentage float32) {
+	asp.samplingPercentage = percentage
+	asp.scaledSamplingRate.Store(uint32(percentage * percentageScaleFactor))
+	_ = stats.RecordWithTags(context.Background(), []tag.Mutator{asp.processorTag}, statEffectiveSamplingPercentage.M(float64(percentage)))
+}
+
+func (asp *adaptiveSamplerProcessor) processTraces(ctx context.Context, td ptrace.Traces) (ptrace.Traces, error) {
+	scaledSamplingRate := asp.scaledSamplingRate.Load()
+	td.ResourceSpans().RemoveIf(func(rs ptrace.ResourceSpans) bool {
+		rs.ScopeSpans().RemoveIf(func(ils ptrace.ScopeSpans) bool {
+			ils.Spans().RemoveIf(func(s ptrace.Span) bool {
+				tidBytes := s.TraceID()
+				sampled := murmur3.Hash(tidBytes[:], asp.hashSeed)&bitMaskHashBuckets < scaledSamplingRate
+
+				_ = stats.RecordWithTags(
+					ctx,
+					[]tag.Mutator{asp.processorTag, tag.Upsert(tagSampledKey, boolToString(sampled))},
+					statCountTracesSampled.M(int64(1)),
+				)
+				return !sampled
+			})
+			// Filter out empty ScopeSpans
+			return ils.Spans().Len() == 0
+		})
+		// Filter out empty ResourceSpans
+		return rs.ScopeSpans().Len() == 0
+	})
+	if td.ResourceSpans().Len() == 0 {
+		return td, processorhelper.ErrSkipProcessingData
+	}
+	return td, nil
+}
+
+func boolToString(b bool) string {
+	if b {
+		return "true"
+	}
+	return "false"
+}
@@ -0,0 +1,147 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package adaptivesamplerprocessor
+
+import (
+	"context"
+	"encoding/binary"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opencensus.io/stats/view"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config/configtelemetry"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/processor/processorhelper"
+	"go.opentelemetry.io/collector/processor/processortest"
+)
+
+func testConfig() *Config {
+	cfg := createDefaultConfig().(*Config)
+	cfg.SamplingPercentage = 40
+	cfg.MinSamplingPercentage = 4
+	cfg.MemoryLimitMiB = 100
+	return cfg
+}
+
+func noQueue(string) (int64, bool) { return 0, false }
+
+func TestAdjust(t *testing.T) {
+	asp := newAdaptiveSamplerProcessor(processortest.NewNopCreateSettings(), testConfig(), runtime.ReadMemStats, noQueue)
+	require.Equal(t, float32(40), asp.samplingPercentage)
+
+	// Pressure between the watermarks keeps the rate stable.
+	asp.adjust(0.7)
+	assert.Equal(t, float32(40), asp.samplingPercentage)
+
+	// High pressure halves the rate until the minimum is reached.
+	asp.adjust(0.8)
+	assert.Equal(t, float32(20), asp.samplingPercentage)
+	asp.adjust(1.5)
+	assert.Equal(t, float32(10), asp.samplingPercentage)
+	asp.adjust(0.9)
+	assert.Equal(t, float32(5), asp.samplingPercentage)
+	asp.adjust(0.9)
+	assert.Equal(t, float32(4), asp.samplingPercentage)
+	assert.Equal(t, uint32(4*percentageScaleFactor), asp.scaledSamplingRate.Load())
+
+	// Low pressure restores the rate step by step up to the configured percentage.
+	asp.adjust(0.5)
+	assert.Equal(t, float32(9), asp.samplingPercentage)
+	for i := 0; i < 10; i++ {
+		asp.adjust(0)
+	}
+	assert.Equal(t, float32(40), asp.samplingPercentage)
+	assert.Equal(t, uint32(40*percentageScaleFactor), asp.scaledSamplingRate.Load())
+}
+
+func TestProcessTraces(t *testing.T) {
+	asp := newAdaptiveSamplerProcessor(processortest.NewNopCreateSettings(), testConfig(), runtime.ReadMemStats, noQueue)
+
+	const numTraces = 10000
+	sampledAt := func() int {
+		td := genTraces(numTraces)
+		out, err := asp.processTraces(context.Background(), td)
+		if err == processorhelper.ErrSkipProcessingData {
+			return 0
+		}
+		require.NoError(t, err)
+		return out.SpanCount()
+	}
+
+	assert.InDelta(t, 0.4*numTraces, sampledAt(), 0.05*numTraces)
+
+	asp.adjust(1)
+	asp.adjust(1)
+	assert.InDelta(t, 0.1*numTraces, sampledAt(), 0.05*numTraces)
+
+	asp.setSamplingPercentage(0)
+	assert.Equal(t, 0, sampledAt())
+}
+
+func TestShutdownTwice(t *testing.T) {
+	asp := newAdaptiveSamplerProcessor(processortest.NewNopCreateSettings(), testConfig(), runtime.ReadMemStats, noQueue)
+	require.NoError(t, asp.start(context.Background(), componenttest.NewNopHost()))
+	require.NoError(t, asp.shutdown(context.Background()))
+	require.NoError(t, asp.shutdown(context.Background()))
+}
+
+func TestMetricsTaggedWithProcessorID(t *testing.T) {
+	views := SamplingProcessorMetricViews(configtelemetry.LevelNormal)
+	require.NoError(t, view.Register(views...))
+	defer view.Unregister(views...)
+
+	percentageFor := func(id component.ID) (float64, bool) {
+		rows, err := view.RetrieveData(views[1].Name)
+		require.NoError(t, err)
+		for _, row := range rows {
+			if len(row.Tags) == 1 && row.Tags[0].Key == tagProcessorKey && row.Tags[0].Value == id.String() {
+				return row.Data.(*view.LastValueData).Value, true
+			}
+		}
+		return 0, false
+	}
+
+	// Each instance reports its own effective sampling percentage.
+	set := processortest.NewNopCreateSettings()
+	set.ID = component.NewIDWithName(typeStr, "first")
+	first := newAdaptiveSamplerProcessor(set, testConfig(), runtime.ReadMemStats, noQueue)
+	set.ID = component.NewIDWithName(typeStr, "second")
+	newAdaptiveSamplerProcessor(set, testConfig(), runtime.ReadMemStats, noQueue)
+	first.adjust(1)
+
+	percentage, ok := percentageFor(component.NewIDWithName(typeStr, "first"))
+	require.True(t, ok)
+	assert.Equal(t, float64(20), percentage)
+	percentage, ok = percentageFor(component.NewIDWithName(typeStr, "second"))
+	require.True(t, ok)
+	assert.Equal(t, float64(40), percentage)
+}
+
+func genTraces(numTraces int) ptrace.Traces {
+	td := ptrace.NewTraces()
+	spans := td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans()
+	for i := 0; i < numTraces; i++ {
+		var tid [16]byte
+		binary.BigEndian.PutUint64(tid[:8], uint64(i)*0x9E3779B97F4A7C15)
+		binary.BigEndian.PutUint64(tid[8:], uint64(i))
+		spans.AppendEmpty().SetTraceID(pcommon.TraceID(tid))
+	}
+	return td
+}
@@ -0,0 +1,57 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cardinalityguardprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/cardinalityguardprocessor"
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+)
+
+// Config defines the configuration for the processor.
+type Config struct {
+	// Attributes is the list of data point attribute keys to guard. When empty, every
+	// data point attribute is guarded.
+	Attributes []string `mapstructure:"attributes"`
+
+	// MaxValues is the number of distinct values tracked per attribute key within a window.
+	// Further values of the key are replaced with OverflowValue until the window ends.
+	MaxValues int `mapstructure:"max_values"`
+
+	// Window is the duration after which the tracked values are forgotten.
+	Window time.Duration `mapstructure:"window"`
+
+	// OverflowValue replaces the attribute values above the MaxValues threshold.
+	OverflowValue string `mapstructure:"overflow_value"`
+}
+
+var _ component.Config = (*Config)(nil)
+
+// Validate checks whether the input configuration has all of the required fields for the processor.
+// An error is returned if there are any invalid inputs.
+func (cfg *Config) Validate() error {
+	if cfg.MaxValues <= 0 {
+		return fmt.Errorf("max_values must be positive: %d", cfg.MaxValues)
+	}
+	if cfg.Window <= 0 {
+		return errors.New("window must be positive")
+	}
+	if cfg.OverflowValue == "" {
+		return errors.New("overflow_value must not be empty")
+	}
+	return nil
+}
@@ -0,0 +1,265 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cardinalityguardprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/cardinalityguardprocessor"
+
+import (
+	"encoding/json"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+// dataPointKey identifies the series of a data point at a point in time. The replaced data points
+// with the same key are merged.
+func dataPointKey(attrs pcommon.Map, ts pcommon.Timestamp) string {
+	jsonStr, _ := json.Marshal([]interface{}{attrs.AsRaw(), ts.String()})
+	return string(jsonStr)
+}
+
+// mergeNumberDataPoints merges the replaced data points with the same attributes into the first of them.
+// The values of sums are added, and gauges keep the last value.
+func mergeNumberDataPoints(dps pmetric.NumberDataPointSlice, replaced []bool, isSum bool) {
+	first := map[string]pmetric.NumberDataPoint{}
+	i := -1
+	dps.RemoveIf(func(dp pmetric.NumberDataPoint) bool {
+		i++
+		if !replaced[i] {
+			return false
+		}
+		key := dataPointKey(dp.Attributes(), dp.Timestamp())
+		into, found := first[key]
+		if !found {
+			first[key] = dp
+			return false
+		}
+		switch {
+		case !isSum:
+			// The merged data points have the same timestamp, the last one reported wins.
+			switch dp.ValueType() {
+			case pmetric.NumberDataPointValueTypeInt:
+				into.SetIntValue(dp.IntValue())
+			case pmetric.NumberDataPointValueTypeDouble:
+				into.SetDoubleValue(dp.DoubleValue())
+			}
+		case into.ValueType() == pmetric.NumberDataPointValueTypeInt && dp.ValueType() == pmetric.NumberDataPointValueTypeInt:
+			into.SetIntValue(into.IntValue() + dp.IntValue())
+		default:
+			into.SetDoubleValue(doubleVal(into) + doubleVal(dp))
+		}
+		mergeStartTimestamp(into, dp)
+		dp.Exemplars().MoveAndAppendTo(into.Exemplars())
+		return true
+	})
+}
+
+func doubleVal(dp pmetric.NumberDataPoint) float64 {
+	switch dp.ValueType() {
+	case pmetric.NumberDataPointValueTypeDouble:
+		return dp.DoubleValue()
+	case pmetric.NumberDataPointValueTypeInt:
+		return float64(dp.IntValue())
+	}
+	return 0
+}
+
+// mergeHistogramDataPoints merges the replaced data points with the same attributes into the first of them.
+// The bucket counts are only kept when all the merged data points have the same explicit bounds.
+func mergeHistogramDataPoints(dps pmetric.HistogramDataPointSlice, replaced []bool) {
+	first := map[string]pmetric.HistogramDataPoint{}
+	i := -1
+	dps.RemoveIf(func(dp pmetric.HistogramDataPoint) bool {
+		i++
+		if !replaced[i] {
+			return false
+		}
+		key := dataPointKey(dp.Attributes(), dp.Timestamp())
+		into, found := first[key]
+		if !found {
+			first[key] = dp
+			return false
+		}
+		if sameBounds(into.ExplicitBounds(), dp.ExplicitBounds()) && into.BucketCounts().Len() == dp.BucketCounts().Len() {
+			counts := into.BucketCounts()
+			for b := 0; b < counts.Len(); b++ {
+				counts.SetAt(b, counts.At(b)+dp.BucketCounts().At(b))
+			}
+		} else {
+			into.ExplicitBounds().FromRaw(nil)
+			into.BucketCounts().FromRaw(nil)
+		}
+		into.SetCount(into.Count() + dp.Count())
+		if into.HasSum() && dp.HasSum() {
+			into.SetSum(into.Sum() + dp.Sum())
+		} else {
+			into.RemoveSum()
+		}
+		if into.HasMin() && dp.HasMin() && dp.Min() < into.Min() {
+			into.SetMin(dp.Min())
+		} else if !dp.HasMin() {
+			into.RemoveMin()
+		}
+		if into.HasMax() && dp.HasMax() && dp.Max() > into.Max() {
+			into.SetMax(dp.Max())
+		} else if !dp.HasMax() {
+			into.RemoveMax()
+		}
+		mergeStartTimestamp(into, dp)
+		dp.Exemplars().MoveAndAppendTo(into.Exemplars())
+		return true
+	})
+}
+
+func sameBounds(a, b pcommon.Float64Slice) bool {
+	if a.Len() != b.Len() {
+		return false
+	}
+	for i := 0; i < a.Len(); i++ {
+		if a.At(i) != b.At(i) {
+			return false
+		}
+	}
+	return true
+}
+
+// mergeExponentialHistogramDataPoints merges the replaced data points with the same attributes into the first
+// of them, at the lowest scale of the merged data points.
+func mergeExponentialHistogramDataPoints(dps pmetric.ExponentialHistogramDataPointSlice, replaced []bool) {
+	first := map[string]pmetric.ExponentialHistogramDataPoint{}
+	i := -1
+	dps.RemoveIf(func(dp pmetric.ExponentialHistogramDataPoint) bool {
+		i++
+		if !replaced[i] {
+			return false
+		}
+		key := dataPointKey(dp.Attributes(), dp.Timestamp())
+		into, found := first[key]
+		if !found {
+			first[key] = dp
+			return false
+		}
+		if dp.Scale() < into.Scale() {
+			downscaleBuckets(into.Positive(), into.Scale()-dp.Scale())
+			downscaleBuckets(into.Negative(), into.Scale()-dp.Scale())
+			into.SetScale(dp.Scale())
+		} else if dp.Scale() > into.Scale() {
+			downscaleBuckets(dp.Positive(), dp.Scale()-into.Scale())
+			downscaleBuckets(dp.Negative(), dp.Scale()-into.Scale())
+			dp.SetScale(into.Scale())
+		}
+		addBuckets(into.Positive(), dp.Positive())
+		addBuckets(into.Negative(), dp.Negative())
+		into.SetCount(into.Count() + dp.Count())
+		into.SetZeroCount(into.ZeroCount() + dp.ZeroCount())
+		if into.HasSum() && dp.HasSum() {
+			into.SetSum(into.Sum() + dp.Sum())
+		} else {
+			into.RemoveSum()
+		}
+		if into.HasMin() && dp.HasMin() && dp.Min() < into.Min() {
+			into.SetMin(dp.Min())
+		} else if !dp.HasMin() {
+			into.RemoveMin()
+		}
+		if into.HasMax() && dp.HasMax() && dp.Max() > into.Max() {
+			into.SetMax(dp.Max())
+		} else if !dp.HasMax() {
+			into.RemoveMax()
+		}
+		mergeStartTimestamp(into, dp)
+		dp.Exemplars().MoveAndAppendTo(into.Exemplars())
+		return true
+	})
+}
+
+// downscaleBuckets merges each 2^by adjacent buckets into one.
+func downscaleBuckets(buckets pmetric.ExponentialHistogramDataPointBuckets, by int32) {
+	offset := buckets.Offset()
+	counts := buckets.BucketCounts().AsRaw()
+	newOffset := offset >> by
+	buckets.SetOffset(newOffset)
+	if len(counts) == 0 {
+		return
+	}
+	newCounts := make([]uint64, (offset+int32(len(counts))-1)>>by-newOffset+1)
+	for i, count := range counts {
+		newCounts[(offset+int32(i))>>by-newOffset] += count
+	}
+	buckets.BucketCounts().FromRaw(newCounts)
+}
+
+// addBuckets adds the bucket counts of from to the bucket counts of into, which have the same scale.
+func addBuckets(into, from pmetric.ExponentialHistogramDataPointBuckets) {
+	if from.BucketCounts().Len() == 0 {
+		return
+	}
+	if into.BucketCounts().Len() == 0 {
+		into.SetOffset(from.Offset())
+		into.BucketCounts().FromRaw(from.BucketCounts().AsRaw())
+		return
+	}
+	offset := into.Offset()
+	if from.Offset() < offset {
+		offset = from.Offset()
+	}
+	end := into.Offset() + int32(into.BucketCounts().Len())
+	if fromEnd := from.Offset() + int32(from.BucketCounts().Len()); fromEnd > end {
+		end = fromEnd
+	}
+	counts := make([]uint64, end-offset)
+	for i := 0; i < into.BucketCounts().Len(); i++ {
+		counts[into.Offset()+int32(i)-offset] += into.BucketCounts().At(i)
+	}
+	for i := 0; i < from.BucketCounts().Len(); i++ {
+		counts[from.Offset()+int32(i)-offset] += from.BucketCounts().At(i)
+	}
+	into.SetOffset(offset)
+	into.BucketCounts().FromRaw(counts)
+}
+
+// mergeSummaryDataPoints merges the replaced data points with the same attributes into the first of them.
+// Quantiles can't be merged, so only the count and sum are kept.
+func mergeSummaryDataPoints(dps pmetric.SummaryDataPointSlice, replaced []bool) {
+	first := map[string]pmetric.SummaryDataPoint{}
+	i := -1
+	dps.RemoveIf(func(dp pmetric.SummaryDataPoint) bool {
+		i++
+		if !replaced[i] {
+			return false
+		}
+		key := dataPointKey(dp.Attributes(), dp.Timestamp())
+		into, found := first[key]
+		if !found {
+			first[key] = dp
+			return false
+		}
+		into.SetCount(into.Count() + dp.Count())
+		into.SetSum(into.Sum() + dp.Sum())
+		into.QuantileValues().RemoveIf(func(pmetric.SummaryDataPointValueAtQuantile) bool { return true })
+		mergeStartTimestamp(into, dp)
+		return true
+	})
+}
+
+type startTimestamped interface {
+	StartTimestamp() pcommon.Timestamp
+	SetStartTimestamp(pcommon.Timestamp)
+}
+
+// mergeStartTimestamp keeps the earliest start timestamp of the merged data points.
+func mergeStartTimestamp(into, from startTimestamped) {
+	if from.StartTimestamp() != 0 && (into.StartTimestamp() == 0 || from.StartTimestamp() < into.StartTimestamp()) {
+		into.SetStartTimestamp(from.StartTimestamp())
+	}
+}
@@ -0,0 +1,123 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cardinalityguardprocessor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+func TestMergeNumberDataPointsGauge(t *testing.T) {
+	dps := pmetric.NewNumberDataPointSlice()
+	for _, v := range []int64{1, 2, 3} {
+		dp := dps.AppendEmpty()
+		dp.Attributes().PutStr("user.id", "overflow")
+		dp.SetIntValue(v)
+	}
+
+	mergeNumberDataPoints(dps, []bool{true, true, true}, false)
+	require.Equal(t, 1, dps.Len())
+	assert.Equal(t, int64(3), dps.At(0).IntValue())
+}
+
+func TestMergeHistogramDataPoints(t *testing.T) {
+	dps := pmetric.NewHistogramDataPointSlice()
+	for i, bounds := range [][]float64{{1, 10}, {1, 10}, {1, 10}, {5}} {
+		dp := dps.AppendEmpty()
+		dp.Attributes().PutStr("user.id", "overflow")
+		if i == 0 {
+			// Not replaced, it is kept as is.
+			dp.Attributes().PutStr("user.id", "1")
+		}
+		dp.ExplicitBounds().FromRaw(bounds)
+		counts := make([]uint64, len(bounds)+1)
+		counts[0] = 1
+		dp.BucketCounts().FromRaw(counts)
+		dp.SetCount(1)
+		dp.SetSum(float64(i))
+		dp.SetMin(float64(i))
+		dp.SetMax(float64(i))
+	}
+
+	mergeHistogramDataPoints(dps, []bool{false, true, true, false})
+	require.Equal(t, 3, dps.Len())
+	assert.Equal(t, []uint64{1, 0, 0}, dps.At(0).BucketCounts().AsRaw())
+	assert.Equal(t, uint64(2), dps.At(1).Count())
+	assert.Equal(t, []float64{1, 10}, dps.At(1).ExplicitBounds().AsRaw())
+	assert.Equal(t, []uint64{2, 0, 0}, dps.At(1).BucketCounts().AsRaw())
+	assert.Equal(t, 3.0, dps.At(1).Sum())
+	assert.Equal(t, 1.0, dps.At(1).Min())
+	assert.Equal(t, 2.0, dps.At(1).Max())
+
+	// The buckets of data points with different bounds can't be merged.
+	dps = pmetric.NewHistogramDataPointSlice()
+	for _, bounds := range [][]float64{{1, 10}, {5}} {
+		dp := dps.AppendEmpty()
+		dp.ExplicitBounds().FromRaw(bounds)
+		dp.BucketCounts().FromRaw(make([]uint64, len(bounds)+1))
+		dp.SetCount(1)
+	}
+	mergeHistogramDataPoints(dps, []bool{true, true})
+	require.Equal(t, 1, dps.Len())
+	assert.Equal(t, uint64(2), dps.At(0).Count())
+	assert.Equal(t, 0, dps.At(0).ExplicitBounds().Len())
+	assert.Equal(t, 0, dps.At(0).BucketCounts().Len())
+}
+
+func TestMergeExponentialHistogramDataPoints(t *testing.T) {
+	dps := pmetric.NewExponentialHistogramDataPointSlice()
+	first := dps.AppendEmpty()
+	first.SetScale(1)
+	first.SetCount(10)
+	first.Positive().SetOffset(1)
+	first.Positive().BucketCounts().FromRaw([]uint64{1, 2, 3, 4})
+	second := dps.AppendEmpty()
+	second.SetScale(0)
+	second.SetCount(3)
+	second.SetZeroCount(1)
+	second.Positive().SetOffset(2)
+	second.Positive().BucketCounts().FromRaw([]uint64{2})
+
+	mergeExponentialHistogramDataPoints(dps, []bool{true, true})
+	require.Equal(t, 1, dps.Len())
+	dp := dps.At(0)
+	assert.Equal(t, int32(0), dp.Scale())
+	assert.Equal(t, uint64(13), dp.Count())
+	assert.Equal(t, uint64(1), dp.ZeroCount())
+	// At scale 0, the buckets 1 to 4 of scale 1 become the buckets 0 to 2.
+	assert.Equal(t, int32(0), dp.Positive().Offset())
+	assert.Equal(t, []uint64{1, 5, 6}, dp.Positive().BucketCounts().AsRaw())
+}
+
+func TestMergeSummaryDataPoints(t *testing.T) {
+	dps := pmetric.NewSummaryDataPointSlice()
+	for i := 0; i < 2; i++ {
+		dp := dps.AppendEmpty()
+		dp.SetCount(2)
+		dp.SetSum(4)
+		q := dp.QuantileValues().AppendEmpty()
+		q.SetQuantile(0.5)
+		q.SetValue(2)
+	}
+
+	mergeSummaryDataPoints(dps, []bool{true, true})
+	require.Equal(t, 1, dps.Len())
+	assert.Equal(t, uint64(4), dps.At(0).Count())
+	assert.Equal(t, 8.0, dps.At(0).Sum())
+	assert.Equal(t, 0, dps.At(0).QuantileValues().Len())
+}
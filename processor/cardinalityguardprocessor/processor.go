@@ -0,0 +1,178 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cardinalityguardprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/cardinalityguardprocessor"
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.uber.org/zap"
+)
+
+type cardinalityGuard struct {
+	attributes    map[string]struct{}
+	maxValues     int
+	window        time.Duration
+	overflowValue string
+	logger        *zap.Logger
+
+	// now is replaced in tests.
+	now func() time.Time
+
+	mu          sync.Mutex
+	windowStart time.Time
+	// values holds the distinct values seen per attribute key in the current window.
+	values map[string]map[string]struct{}
+	// overflowed holds the attribute keys that reached the threshold in the current window.
+	overflowed map[string]struct{}
+}
+
+func newCardinalityGuard(cfg *Config, logger *zap.Logger) *cardinalityGuard {
+	var attributes map[string]struct{}
+	if len(cfg.Attributes) > 0 {
+		attributes = make(map[string]struct{}, len(cfg.Attributes))
+		for _, k := range cfg.Attributes {
+			attributes[k] = struct{}{}
+		}
+	}
+	return &cardinalityGuard{
+		attributes:    attributes,
+		maxValues:     cfg.MaxValues,
+		window:        cfg.Window,
+		overflowValue: cfg.OverflowValue,
+		logger:        logger,
+		now:           time.Now,
+		values:        map[string]map[string]struct{}{},
+		overflowed:    map[string]struct{}{},
+	}
+}
+
+// processMetrics implements the ProcessMetricsFunc type.
+func (g *cardinalityGuard) processMetrics(_ context.Context, md pmetric.Metrics) (pmetric.Metrics, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if now := g.now(); now.Sub(g.windowStart) >= g.window {
+		g.windowStart = now
+		g.values = map[string]map[string]struct{}{}
+		g.overflowed = map[string]struct{}{}
+	}
+
+	rms := md.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		sms := rms.At(i).ScopeMetrics()
+		for j := 0; j < sms.Len(); j++ {
+			metrics := sms.At(j).Metrics()
+			for k := 0; k < metrics.Len(); k++ {
+				g.processMetric(metrics.At(k))
+			}
+		}
+	}
+	return md, nil
+}
+
+// processMetric guards the attributes of the data points of the metric. The data points whose
+// attributes were rewritten to the overflow value are merged when they end up with the same
+// attributes, so that the metric doesn't carry duplicate series.
+func (g *cardinalityGuard) processMetric(metric pmetric.Metric) {
+	switch metric.Type() {
+	case pmetric.MetricTypeGauge:
+		dps := metric.Gauge().DataPoints()
+		if replaced := g.guardDataPoints(dps.Len(), func(i int) pcommon.Map { return dps.At(i).Attributes() }); replaced != nil {
+			mergeNumberDataPoints(dps, replaced, false)
+		}
+	case pmetric.MetricTypeSum:
+		dps := metric.Sum().DataPoints()
+		if replaced := g.guardDataPoints(dps.Len(), func(i int) pcommon.Map { return dps.At(i).Attributes() }); replaced != nil {
+			mergeNumberDataPoints(dps, replaced, true)
+		}
+	case pmetric.MetricTypeHistogram:
+		dps := metric.Histogram().DataPoints()
+		if replaced := g.guardDataPoints(dps.Len(), func(i int) pcommon.Map { return dps.At(i).Attributes() }); replaced != nil {
+			mergeHistogramDataPoints(dps, replaced)
+		}
+	case pmetric.MetricTypeExponentialHistogram:
+		dps := metric.ExponentialHistogram().DataPoints()
+		if replaced := g.guardDataPoints(dps.Len(), func(i int) pcommon.Map { return dps.At(i).Attributes() }); replaced != nil {
+			mergeExponentialHistogramDataPoints(dps, replaced)
+		}
+	case pmetric.MetricTypeSummary:
+		dps := metric.Summary().DataPoints()
+		if replaced := g.guardDataPoints(dps.Len(), func(i int) pcommon.Map { return dps.At(i).Attributes() }); replaced != nil {
+			mergeSummaryDataPoints(dps, replaced)
+		}
+	}
+}
+
+// guardDataPoints guards the attributes of each data point and reports which data points had a value
+// replaced, or nil if none had.
+func (g *cardinalityGuard) guardDataPoints(numDataPoints int, attributes func(i int) pcommon.Map) []bool {
+	var replaced []bool
+	for i := 0; i < numDataPoints; i++ {
+		if g.guard(attributes(i)) {
+			if replaced == nil {
+				replaced = make([]bool, numDataPoints)
+			}
+			replaced[i] = true
+		}
+	}
+	return replaced
+}
+
+// guard replaces the values of the guarded attributes that exceed the threshold, and reports
+// whether any value was replaced.
+func (g *cardinalityGuard) guard(attrs pcommon.Map) bool {
+	replaced := false
+	attrs.Range(func(k string, v pcommon.Value) bool {
+		if g.attributes != nil {
+			if _, found := g.attributes[k]; !found {
+				return true
+			}
+		}
+		if !g.track(k, v.AsString()) {
+			v.SetStr(g.overflowValue)
+			replaced = true
+		}
+		return true
+	})
+	return replaced
+}
+
+// track records the value of the attribute key and reports whether it is within the threshold.
+func (g *cardinalityGuard) track(key, value string) bool {
+	seen, found := g.values[key]
+	if !found {
+		seen = map[string]struct{}{}
+		g.values[key] = seen
+	}
+	if _, found = seen[value]; found {
+		return true
+	}
+	if len(seen) < g.maxValues {
+		seen[value] = struct{}{}
+		return true
+	}
+	if _, found = g.overflowed[key]; !found {
+		g.overflowed[key] = struct{}{}
+		g.logger.Warn("Attribute cardinality threshold reached, replacing further values",
+			zap.String("attribute", key),
+			zap.Int("max_values", g.maxValues),
+			zap.String("overflow_value", g.overflowValue))
+	}
+	return false
+}
@@ -0,0 +1,121 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cardinalityguardprocessor
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.uber.org/zap"
+)
+
+func newTestMetrics(userIDs ...int) pmetric.Metrics {
+	md := pmetric.NewMetrics()
+	m := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+	m.SetName("http.requests")
+	dps := m.SetEmptySum().DataPoints()
+	for _, id := range userIDs {
+		dp := dps.AppendEmpty()
+		dp.Attributes().PutInt("user.id", int64(id))
+		dp.Attributes().PutStr("http.method", "GET")
+		dp.SetIntValue(1)
+	}
+	return md
+}
+
+func userIDs(t *testing.T, md pmetric.Metrics) []string {
+	var ids []string
+	dps := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Sum().DataPoints()
+	for i := 0; i < dps.Len(); i++ {
+		v, ok := dps.At(i).Attributes().Get("user.id")
+		require.True(t, ok)
+		ids = append(ids, v.AsString())
+	}
+	return ids
+}
+
+func TestProcessMetrics(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Attributes = []string{"user.id"}
+	cfg.MaxValues = 2
+	cfg.Window = time.Minute
+
+	now := time.Unix(1000, 0)
+	g := newCardinalityGuard(cfg, zap.NewNop())
+	g.now = func() time.Time { return now }
+
+	md, err := g.processMetrics(context.Background(), newTestMetrics(1, 2, 3, 1))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1", "2", defaultOverflowValue, "1"}, userIDs(t, md))
+
+	md, err = g.processMetrics(context.Background(), newTestMetrics(4, 2))
+	require.NoError(t, err)
+	assert.Equal(t, []string{defaultOverflowValue, "2"}, userIDs(t, md))
+
+	// The unguarded attribute is left untouched.
+	v, ok := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Sum().DataPoints().At(0).Attributes().Get("http.method")
+	require.True(t, ok)
+	assert.Equal(t, "GET", v.Str())
+
+	// The tracked values are forgotten once the window ends.
+	now = now.Add(time.Minute)
+	md, err = g.processMetrics(context.Background(), newTestMetrics(4, 5, 6))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"4", "5", defaultOverflowValue}, userIDs(t, md))
+}
+
+func TestProcessMetricsAllAttributes(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.MaxValues = 1
+
+	g := newCardinalityGuard(cfg, zap.NewNop())
+
+	var ids []int
+	for i := 0; i < 3; i++ {
+		ids = append(ids, i)
+	}
+	md, err := g.processMetrics(context.Background(), newTestMetrics(ids...))
+	require.NoError(t, err)
+	// The two overflowed data points are merged into a single series.
+	assert.Equal(t, []string{"0", defaultOverflowValue}, userIDs(t, md))
+	assert.Equal(t, int64(2), md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Sum().DataPoints().At(1).IntValue())
+	assert.Len(t, g.values["http.method"], 1)
+	assert.Len(t, g.values["user.id"], 1)
+	assert.NotContains(t, g.values["user.id"], strconv.Itoa(1))
+}
+
+func TestProcessMetricsMergesOverflow(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Attributes = []string{"user.id"}
+	cfg.MaxValues = 1
+
+	g := newCardinalityGuard(cfg, zap.NewNop())
+
+	md := newTestMetrics(1, 2, 3, 4)
+	dps := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Sum().DataPoints()
+	dps.At(2).SetDoubleValue(0.5)
+
+	md, err := g.processMetrics(context.Background(), md)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1", defaultOverflowValue}, userIDs(t, md))
+	dps = md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Sum().DataPoints()
+	assert.Equal(t, int64(1), dps.At(0).IntValue())
+	assert.Equal(t, 2.5, dps.At(1).DoubleValue())
+}
@@ -16,7 +16,9 @@ package filterprocessor // import "github.com/open-telemetry/opentelemetry-colle
 
 import (
 	"context"
+	"sync"
 
+	"go.opencensus.io/stats/view"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/processor"
@@ -32,8 +34,15 @@ const (
 
 var processorCapabilities = consumer.Capabilities{MutatesData: true}
 
+var once sync.Once
+
 // NewFactory returns a new factory for the Filter processor.
 func NewFactory() processor.Factory {
+	once.Do(func() {
+		// TODO: as with other -contrib factories registering metrics, this is causing the error being ignored
+		_ = view.Register(MetricViews()...)
+	})
+
 	return processor.NewFactory(
 		typeStr,
 		createDefaultConfig,
@@ -62,7 +71,7 @@ func createMetricsProcessor(
 		set,
 		cfg,
 		nextConsumer,
-		fp.processMetrics,
+		withFilterTag(set.ID, fp.processMetrics),
 		processorhelper.WithCapabilities(processorCapabilities))
 }
 
@@ -81,7 +90,7 @@ func createLogsProcessor(
 		set,
 		cfg,
 		nextConsumer,
-		fp.processLogs,
+		withFilterTag(set.ID, fp.processLogs),
 		processorhelper.WithCapabilities(processorCapabilities))
 }
 
@@ -100,6 +109,6 @@ func createTracesProcessor(
 		set,
 		cfg,
 		nextConsumer,
-		fp.processTraces,
+		withFilterTag(set.ID, fp.processTraces),
 		processorhelper.WithCapabilities(processorCapabilities))
 }
@@ -29,54 +29,54 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
 )
 
-func ParseSpan(conditions []string, set component.TelemetrySettings) (expr.BoolExpr[ottlspan.TransformContext], error) {
+func ParseSpan(conditions []string, set component.TelemetrySettings, onMatch MatchFunc) (expr.BoolExpr[ottlspan.TransformContext], error) {
 	statmentsStr := conditionsToStatements(conditions)
 	parser := ottlspan.NewParser(functions[ottlspan.TransformContext](), set)
 	statements, err := parser.ParseStatements(statmentsStr)
 	if err != nil {
 		return nil, err
 	}
-	return statementsToExpr(statements), nil
+	return statementsToExpr(statements, onMatch), nil
 }
 
-func ParseSpanEvent(conditions []string, set component.TelemetrySettings) (expr.BoolExpr[ottlspanevent.TransformContext], error) {
+func ParseSpanEvent(conditions []string, set component.TelemetrySettings, onMatch MatchFunc) (expr.BoolExpr[ottlspanevent.TransformContext], error) {
 	statmentsStr := conditionsToStatements(conditions)
 	parser := ottlspanevent.NewParser(functions[ottlspanevent.TransformContext](), set)
 	statements, err := parser.ParseStatements(statmentsStr)
 	if err != nil {
 		return nil, err
 	}
-	return statementsToExpr(statements), nil
+	return statementsToExpr(statements, onMatch), nil
 }
 
-func ParseLog(conditions []string, set component.TelemetrySettings) (expr.BoolExpr[ottllog.TransformContext], error) {
+func ParseLog(conditions []string, set component.TelemetrySettings, onMatch MatchFunc) (expr.BoolExpr[ottllog.TransformContext], error) {
 	statmentsStr := conditionsToStatements(conditions)
 	parser := ottllog.NewParser(functions[ottllog.TransformContext](), set)
 	statements, err := parser.ParseStatements(statmentsStr)
 	if err != nil {
 		return nil, err
 	}
-	return statementsToExpr(statements), nil
+	return statementsToExpr(statements, onMatch), nil
 }
 
-func ParseMetric(conditions []string, set component.TelemetrySettings) (expr.BoolExpr[ottlmetric.TransformContext], error) {
+func ParseMetric(conditions []string, set component.TelemetrySettings, onMatch MatchFunc) (expr.BoolExpr[ottlmetric.TransformContext], error) {
 	statmentsStr := conditionsToStatements(conditions)
 	parser := ottlmetric.NewParser(functions[ottlmetric.TransformContext](), set)
 	statements, err := parser.ParseStatements(statmentsStr)
 	if err != nil {
 		return nil, err
 	}
-	return statementsToExpr(statements), nil
+	return statementsToExpr(statements, onMatch), nil
 }
 
-func ParseDataPoint(conditions []string, set component.TelemetrySettings) (expr.BoolExpr[ottldatapoint.TransformContext], error) {
+func ParseDataPoint(conditions []string, set component.TelemetrySettings, onMatch MatchFunc) (expr.BoolExpr[ottldatapoint.TransformContext], error) {
 	statmentsStr := conditionsToStatements(conditions)
 	parser := ottldatapoint.NewParser(functions[ottldatapoint.TransformContext](), set)
 	statements, err := parser.ParseStatements(statmentsStr)
 	if err != nil {
 		return nil, err
 	}
-	return statementsToExpr(statements), nil
+	return statementsToExpr(statements, onMatch), nil
 }
 
 func conditionsToStatements(conditions []string) []string {
@@ -87,19 +87,28 @@ func conditionsToStatements(conditions []string) []string {
 	return statements
 }
 
+// MatchFunc is called with the index of the condition that matched, and
+// therefore caused the item to be dropped.
+type MatchFunc func(ctx context.Context, condition int)
+
 type statementExpr[K any] struct {
 	statement *ottl.Statement[K]
+	index     int
+	onMatch   MatchFunc
 }
 
 func (se statementExpr[K]) Eval(ctx context.Context, tCtx K) (bool, error) {
 	_, ret, err := se.statement.Execute(ctx, tCtx)
+	if ret && err == nil && se.onMatch != nil {
+		se.onMatch(ctx, se.index)
+	}
 	return ret, err
 }
 
-func statementsToExpr[K any](statements []*ottl.Statement[K]) expr.BoolExpr[K] {
+func statementsToExpr[K any](statements []*ottl.Statement[K], onMatch MatchFunc) expr.BoolExpr[K] {
 	var rets []expr.BoolExpr[K]
-	for _, statement := range statements {
-		rets = append(rets, statementExpr[K]{statement: statement})
+	for i, statement := range statements {
+		rets = append(rets, statementExpr[K]{statement: statement, index: i, onMatch: onMatch})
 	}
 	return expr.Or(rets...)
 }
@@ -36,7 +36,7 @@ type filterLogProcessor struct {
 
 func newFilterLogsProcessor(set component.TelemetrySettings, cfg *Config) (*filterLogProcessor, error) {
 	if cfg.Logs.LogConditions != nil {
-		skipExpr, err := common.ParseLog(cfg.Logs.LogConditions, set)
+		skipExpr, err := common.ParseLog(cfg.Logs.LogConditions, set, conditionDropRecorder(signalLogs))
 		if err != nil {
 			return nil, err
 		}
@@ -58,7 +58,7 @@ func newFilterLogsProcessor(set component.TelemetrySettings, cfg *Config) (*filt
 		return nil, fmt.Errorf("failed to build skip matcher: %w", err)
 	}
 
-	return &filterLogProcessor{skipExpr: skipExpr}, nil
+	return &filterLogProcessor{skipExpr: recordIncludeExcludeDrops(skipExpr, signalLogs)}, nil
 }
 
 func (flp *filterLogProcessor) processLogs(ctx context.Context, ld plog.Logs) (plog.Logs, error) {
@@ -46,14 +46,14 @@ func newFilterMetricProcessor(set component.TelemetrySettings, cfg *Config) (*fi
 	fsp := &filterMetricProcessor{}
 	if cfg.Metrics.MetricConditions != nil || cfg.Metrics.DataPointConditions != nil {
 		if cfg.Metrics.MetricConditions != nil {
-			fsp.skipMetricExpr, err = common.ParseMetric(cfg.Metrics.MetricConditions, set)
+			fsp.skipMetricExpr, err = common.ParseMetric(cfg.Metrics.MetricConditions, set, conditionDropRecorder(signalMetrics))
 			if err != nil {
 				return nil, err
 			}
 		}
 
 		if cfg.Metrics.DataPointConditions != nil {
-			fsp.skipDataPointExpr, err = common.ParseDataPoint(cfg.Metrics.DataPointConditions, set)
+			fsp.skipDataPointExpr, err = common.ParseDataPoint(cfg.Metrics.DataPointConditions, set, conditionDropRecorder(signalDataPoints))
 			if err != nil {
 				return nil, err
 			}
@@ -67,10 +67,11 @@ func newFilterMetricProcessor(set component.TelemetrySettings, cfg *Config) (*fi
 		return nil, err
 	}
 
-	fsp.skipMetricExpr, err = filtermetric.NewSkipExpr(cfg.Metrics.Include, cfg.Metrics.Exclude)
+	skipMetricExpr, err := filtermetric.NewSkipExpr(cfg.Metrics.Include, cfg.Metrics.Exclude)
 	if err != nil {
 		return nil, err
 	}
+	fsp.skipMetricExpr = recordIncludeExcludeDrops(skipMetricExpr, signalMetrics)
 
 	includeMatchType := ""
 	var includeExpressions []string
@@ -125,6 +126,7 @@ func (fmp *filterMetricProcessor) processMetrics(ctx context.Context, md pmetric
 				return false
 			}
 			if skip {
+				recordDropped(ctx, signalMetrics, includeExcludeCondition, int64(countMetrics(rmetrics)))
 				return true
 			}
 		}
@@ -177,6 +179,14 @@ func (fmp *filterMetricProcessor) processMetrics(ctx context.Context, md pmetric
 	return md, nil
 }
 
+func countMetrics(rmetrics pmetric.ResourceMetrics) int {
+	count := 0
+	for i := 0; i < rmetrics.ScopeMetrics().Len(); i++ {
+		count += rmetrics.ScopeMetrics().At(i).Metrics().Len()
+	}
+	return count
+}
+
 func newSkipResExpr(include *filtermetric.MatchProperties, exclude *filtermetric.MatchProperties) (expr.BoolExpr[ottlresource.TransformContext], error) {
 	var matchers []expr.BoolExpr[ottlresource.TransformContext]
 	inclExpr, err := newResExpr(include)
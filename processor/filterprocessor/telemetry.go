@@ -0,0 +1,108 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filterprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/filterprocessor"
+
+import (
+	"context"
+	"strconv"
+
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/obsreport"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/expr"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/filterprocessor/internal/common"
+)
+
+const (
+	signalSpans      = "spans"
+	signalSpanEvents = "span_events"
+	signalLogs       = "logs"
+	signalMetrics    = "metrics"
+	signalDataPoints = "datapoints"
+
+	// includeExcludeCondition is the condition value recorded for items dropped
+	// by the include/exclude configuration rather than by an OTTL condition.
+	includeExcludeCondition = "include_exclude"
+)
+
+var (
+	tagFilterKey, _    = tag.NewKey("filter")
+	tagSignalKey, _    = tag.NewKey("signal")
+	tagConditionKey, _ = tag.NewKey("condition")
+
+	statDroppedItems = stats.Int64("dropped_items", "Number of spans, span events, log records, metrics or data points dropped by the filter", stats.UnitDimensionless)
+)
+
+// MetricViews return the metrics views according to given telemetry level.
+func MetricViews() []*view.View {
+	return []*view.View{
+		{
+			Name:        obsreport.BuildProcessorCustomMetricName(typeStr, statDroppedItems.Name()),
+			Measure:     statDroppedItems,
+			Description: statDroppedItems.Description(),
+			TagKeys:     []tag.Key{tagFilterKey, tagSignalKey, tagConditionKey},
+			Aggregation: view.Sum(),
+		},
+	}
+}
+
+// withFilterTag tags the context of the process function with the ID of the processor, so that
+// the items dropped by every filter processor are recorded separately.
+func withFilterTag[T any](id component.ID, process func(context.Context, T) (T, error)) func(context.Context, T) (T, error) {
+	return func(ctx context.Context, data T) (T, error) {
+		ctx, _ = tag.New(ctx, tag.Upsert(tagFilterKey, id.String()))
+		return process(ctx, data)
+	}
+}
+
+func recordDropped(ctx context.Context, signal string, condition string, count int64) {
+	_ = stats.RecordWithTags(
+		ctx,
+		[]tag.Mutator{tag.Upsert(tagSignalKey, signal), tag.Upsert(tagConditionKey, condition)},
+		statDroppedItems.M(count),
+	)
+}
+
+// conditionDropRecorder records a dropped item against the index of the OTTL condition that matched it.
+func conditionDropRecorder(signal string) common.MatchFunc {
+	return func(ctx context.Context, condition int) {
+		recordDropped(ctx, signal, strconv.Itoa(condition), 1)
+	}
+}
+
+type includeExcludeExpr[K any] struct {
+	matcher expr.BoolExpr[K]
+	signal  string
+}
+
+func (ie includeExcludeExpr[K]) Eval(ctx context.Context, tCtx K) (bool, error) {
+	ret, err := ie.matcher.Eval(ctx, tCtx)
+	if ret && err == nil {
+		recordDropped(ctx, ie.signal, includeExcludeCondition, 1)
+	}
+	return ret, err
+}
+
+// recordIncludeExcludeDrops wraps a skip expression built from the include/exclude
+// configuration so that every item it drops is recorded.
+func recordIncludeExcludeDrops[K any](matcher expr.BoolExpr[K], signal string) expr.BoolExpr[K] {
+	if matcher == nil {
+		return nil
+	}
+	return includeExcludeExpr[K]{matcher: matcher, signal: signal}
+}
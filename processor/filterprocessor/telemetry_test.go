@@ -0,0 +1,102 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filterprocessor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/plog"
+)
+
+func TestProcessorMetricViews(t *testing.T) {
+	views := MetricViews()
+	require.Len(t, views, 1)
+	assert.Equal(t, "processor/filter/dropped_items", views[0].Name)
+}
+
+func TestDroppedItemsRecorded(t *testing.T) {
+	views := MetricViews()
+	view.Unregister(views...)
+	require.NoError(t, view.Register(views...))
+	defer view.Unregister(views...)
+
+	conditions, err := newFilterLogsProcessor(componenttest.NewNopTelemetrySettings(), &Config{
+		Logs: LogFilters{
+			LogConditions: []string{
+				`body == "first"`,
+				`body == "second"`,
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	includeExclude, err := newFilterLogsProcessor(componenttest.NewNopTelemetrySettings(), &Config{
+		Logs: LogFilters{
+			Exclude: &LogMatchProperties{
+				LogMatchType: Strict,
+				LogBodies:    []string{"first"},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	ld := plog.NewLogs()
+	lrs := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords()
+	for _, body := range []string{"first", "second", "second", "kept"} {
+		lrs.AppendEmpty().Body().SetStr(body)
+	}
+	_, err = withFilterTag(component.NewIDWithName(typeStr, "conditions"), conditions.processLogs)(context.Background(), ld)
+	require.NoError(t, err)
+	assert.Equal(t, 1, lrs.Len())
+
+	ld = plog.NewLogs()
+	lrs = ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords()
+	for _, body := range []string{"first", "kept"} {
+		lrs.AppendEmpty().Body().SetStr(body)
+	}
+	_, err = withFilterTag(component.NewIDWithName(typeStr, "include_exclude"), includeExclude.processLogs)(context.Background(), ld)
+	require.NoError(t, err)
+
+	rows, err := view.RetrieveData(views[0].Name)
+	require.NoError(t, err)
+
+	dropped := map[string]int64{}
+	for _, row := range rows {
+		signal, condition := tagValue(row.Tags, tagSignalKey), tagValue(row.Tags, tagConditionKey)
+		require.Equal(t, signalLogs, signal)
+		dropped[tagValue(row.Tags, tagFilterKey)+" "+condition] = int64(row.Data.(*view.SumData).Value)
+	}
+	assert.Equal(t, map[string]int64{
+		"filter/conditions 0":                               1,
+		"filter/conditions 1":                               2,
+		"filter/include_exclude " + includeExcludeCondition: 1,
+	}, dropped)
+}
+
+func tagValue(tags []tag.Tag, key tag.Key) string {
+	for _, t := range tags {
+		if t.Key == key {
+			return t.Value
+		}
+	}
+	return ""
+}
@@ -40,13 +40,13 @@ func newFilterSpansProcessor(set component.TelemetrySettings, cfg *Config) (*fil
 	fsp := &filterSpanProcessor{}
 	if cfg.Traces.SpanConditions != nil || cfg.Traces.SpanEventConditions != nil {
 		if cfg.Traces.SpanConditions != nil {
-			fsp.skipSpanExpr, err = common.ParseSpan(cfg.Traces.SpanConditions, set)
+			fsp.skipSpanExpr, err = common.ParseSpan(cfg.Traces.SpanConditions, set, conditionDropRecorder(signalSpans))
 			if err != nil {
 				return nil, err
 			}
 		}
 		if cfg.Traces.SpanEventConditions != nil {
-			fsp.skipSpanEventExpr, err = common.ParseSpanEvent(cfg.Traces.SpanEventConditions, set)
+			fsp.skipSpanEventExpr, err = common.ParseSpanEvent(cfg.Traces.SpanEventConditions, set, conditionDropRecorder(signalSpanEvents))
 			if err != nil {
 				return nil, err
 			}
@@ -54,10 +54,11 @@ func newFilterSpansProcessor(set component.TelemetrySettings, cfg *Config) (*fil
 		return fsp, nil
 	}
 
-	fsp.skipSpanExpr, err = filterspan.NewSkipExpr(&cfg.Spans)
+	skipSpanExpr, err := filterspan.NewSkipExpr(&cfg.Spans)
 	if err != nil {
 		return nil, err
 	}
+	fsp.skipSpanExpr = recordIncludeExcludeDrops(skipSpanExpr, signalSpans)
 
 	includeMatchType, excludeMatchType := "[None]", "[None]"
 	if cfg.Spans.Include != nil {
@@ -74,7 +74,8 @@ type ExtractConfig struct {
 	//   k8s.replicaset.name, k8s.replicaset.uid,
 	//   k8s.daemonset.name, k8s.daemonset.uid,
 	//   k8s.job.name, k8s.job.uid, k8s.cronjob.name,
-	//   k8s.statefulset.name, k8s.statefulset.uid
+	//   k8s.statefulset.name, k8s.statefulset.uid,
+	//   k8s.workload.kind, k8s.workload.name
 	//
 	// Specifying anything other than these values will result in an error.
 	// By default all of the fields are extracted and added to spans and metrics.
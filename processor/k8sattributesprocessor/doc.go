@@ -58,6 +58,10 @@
 //
 // Not all the attributes are guaranteed to be added.
 //
+// The `k8s.workload.kind` and `k8s.workload.name` attributes are not enabled by default. They resolve the pod through
+// its ReplicaSet or Job to the top-level workload, e.g. `Deployment` and the deployment name instead of the hashed
+// ReplicaSet name, or `CronJob` and the cron job name.
+//
 // Only attribute names from `metadata` should be used for pod_association's `resource_attribute`,
 // because empty or non-existing values will be ignored.
 //
@@ -24,6 +24,7 @@ import (
 	conventions "go.opentelemetry.io/collector/semconv/v1.6.1"
 	"go.uber.org/zap"
 	api_v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/selection"
@@ -356,6 +357,17 @@ func (c *WatchClient) extractPodAttributes(pod *api_v1.Pod) map[string]string {
 		}
 	}
 
+	if c.Rules.WorkloadKind || c.Rules.WorkloadName {
+		if kind, name := c.podWorkload(pod); kind != "" {
+			if c.Rules.WorkloadKind {
+				tags[tagWorkloadKind] = kind
+			}
+			if c.Rules.WorkloadName {
+				tags[tagWorkloadName] = name
+			}
+		}
+	}
+
 	if c.Rules.Node {
 		tags[tagNodeName] = pod.Spec.NodeName
 	}
@@ -370,6 +382,28 @@ func (c *WatchClient) extractPodAttributes(pod *api_v1.Pod) map[string]string {
 	return tags
 }
 
+// podWorkload resolves the owner chain of the pod to its top-level workload. ReplicaSets created by a
+// Deployment are resolved with the pod-template-hash label the Deployment controller sets on both the
+// ReplicaSet name and the pod, and Jobs created by a CronJob with the scheduled time suffix of their name.
+// Pods without a controller are not part of a workload and return an empty kind.
+func (c *WatchClient) podWorkload(pod *api_v1.Pod) (string, string) {
+	ref := metav1.GetControllerOf(pod)
+	if ref == nil {
+		return "", ""
+	}
+	switch ref.Kind {
+	case "ReplicaSet":
+		if hash, ok := pod.Labels[podTemplateHashLabel]; ok && strings.HasSuffix(ref.Name, "-"+hash) {
+			return "Deployment", strings.TrimSuffix(ref.Name, "-"+hash)
+		}
+	case "Job":
+		if parts := c.cronJobRegex.FindStringSubmatch(ref.Name); len(parts) == 2 {
+			return "CronJob", parts[1]
+		}
+	}
+	return ref.Kind, ref.Name
+}
+
 func (c *WatchClient) extractPodContainersAttributes(pod *api_v1.Pod) map[string]*Container {
 	containers := map[string]*Container{}
 
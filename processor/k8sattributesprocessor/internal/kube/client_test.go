@@ -788,6 +788,108 @@ func TestExtractionRules(t *testing.T) {
 	}
 }
 
+func TestWorkloadExtractionRules(t *testing.T) {
+	c, _ := newTestClientWithRulesAndFilters(t, ExtractionRules{WorkloadKind: true, WorkloadName: true}, Filters{})
+
+	controller := true
+	testCases := []struct {
+		name       string
+		labels     map[string]string
+		owners     []meta_v1.OwnerReference
+		attributes map[string]string
+	}{{
+		name:       "no-owner",
+		attributes: map[string]string{},
+	}, {
+		name: "no-controller",
+		owners: []meta_v1.OwnerReference{{
+			Kind: "ReplicaSet",
+			Name: "auth-service-66f5996c7c",
+		}},
+		attributes: map[string]string{},
+	}, {
+		name:   "deployment",
+		labels: map[string]string{"pod-template-hash": "66f5996c7c"},
+		owners: []meta_v1.OwnerReference{{
+			Kind:       "ReplicaSet",
+			Name:       "auth-service-66f5996c7c",
+			Controller: &controller,
+		}},
+		attributes: map[string]string{
+			"k8s.workload.kind": "Deployment",
+			"k8s.workload.name": "auth-service",
+		},
+	}, {
+		name: "replicaset",
+		owners: []meta_v1.OwnerReference{{
+			Kind:       "ReplicaSet",
+			Name:       "auth-service",
+			Controller: &controller,
+		}},
+		attributes: map[string]string{
+			"k8s.workload.kind": "ReplicaSet",
+			"k8s.workload.name": "auth-service",
+		},
+	}, {
+		name: "cronjob",
+		owners: []meta_v1.OwnerReference{{
+			Kind:       "Job",
+			Name:       "auth-cronjob-27667920",
+			Controller: &controller,
+		}},
+		attributes: map[string]string{
+			"k8s.workload.kind": "CronJob",
+			"k8s.workload.name": "auth-cronjob",
+		},
+	}, {
+		name: "job",
+		owners: []meta_v1.OwnerReference{{
+			Kind:       "Job",
+			Name:       "auth-job",
+			Controller: &controller,
+		}},
+		attributes: map[string]string{
+			"k8s.workload.kind": "Job",
+			"k8s.workload.name": "auth-job",
+		},
+	}, {
+		name: "statefulset",
+		owners: []meta_v1.OwnerReference{{
+			Kind: "ReplicaSet",
+			Name: "unrelated",
+		}, {
+			Kind:       "StatefulSet",
+			Name:       "pi-statefulset",
+			Controller: &controller,
+		}},
+		attributes: map[string]string{
+			"k8s.workload.kind": "StatefulSet",
+			"k8s.workload.name": "pi-statefulset",
+		},
+	}}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			pod := &api_v1.Pod{
+				ObjectMeta: meta_v1.ObjectMeta{
+					Name:            "auth-service-abc12-xyz3",
+					UID:             "aaaaaaaa-bbbb-cccc-dddd-eeeeeeeeeeee",
+					Namespace:       "ns1",
+					Labels:          tc.labels,
+					OwnerReferences: tc.owners,
+				},
+				Status: api_v1.PodStatus{
+					PodIP: "1.1.1.1",
+				},
+			}
+			c.handlePodAdd(pod)
+			p, ok := c.GetPod(newPodIdentifier("connection", "", pod.Status.PodIP))
+			require.True(t, ok)
+			assert.Equal(t, tc.attributes, p.Attributes)
+		})
+	}
+}
+
 func TestNamespaceExtractionRules(t *testing.T) {
 	c, _ := newTestClientWithRulesAndFilters(t, ExtractionRules{}, Filters{})
 
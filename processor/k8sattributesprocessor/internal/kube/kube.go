@@ -32,6 +32,10 @@ const (
 	ignoreAnnotation string = "opentelemetry.io/k8s-processor/ignore"
 	tagNodeName             = "k8s.node.name"
 	tagStartTime            = "k8s.pod.start_time"
+	tagWorkloadKind         = "k8s.workload.kind"
+	tagWorkloadName         = "k8s.workload.name"
+	// podTemplateHashLabel is set by the Deployment controller on the ReplicaSets and pods it creates.
+	podTemplateHashLabel = "pod-template-hash"
 	// MetadataFromPod is used to specify to extract metadata/labels/annotations from pod
 	MetadataFromPod = "pod"
 	// MetadataFromNamespace is used to specify to extract metadata/labels/annotations from namespace
@@ -201,6 +205,8 @@ type ExtractionRules struct {
 	ContainerID        bool
 	ContainerImageName bool
 	ContainerImageTag  bool
+	WorkloadKind       bool
+	WorkloadName       bool
 
 	Annotations []FieldExtractionRule
 	Labels      []FieldExtractionRule
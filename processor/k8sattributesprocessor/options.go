@@ -40,6 +40,9 @@ const (
 	metadataNode       = "node"
 	// Will be removed when new fields get merged to https://github.com/open-telemetry/opentelemetry-collector/blob/main/model/semconv/opentelemetry.go
 	metadataPodStartTime = "k8s.pod.start_time"
+	// The top-level workload of the pod, e.g. the Deployment of a pod created by a ReplicaSet
+	metadataWorkloadKind = "k8s.workload.kind"
+	metadataWorkloadName = "k8s.workload.name"
 	// This one was deprecated, see https://github.com/open-telemetry/opentelemetry-collector-contrib/issues/9886
 	deprecatedMetadataCluster = "cluster"
 )
@@ -116,6 +119,10 @@ func withExtractMetadata(fields ...string) option {
 				p.rules.JobUID = true
 			case conventions.AttributeK8SCronJobName:
 				p.rules.CronJobName = true
+			case metadataWorkloadKind:
+				p.rules.WorkloadKind = true
+			case metadataWorkloadName:
+				p.rules.WorkloadName = true
 			case metadataNode, conventions.AttributeK8SNodeName:
 				p.rules.Node = true
 			case conventions.AttributeContainerID:
@@ -328,6 +328,14 @@ func TestWithExtractMetadata(t *testing.T) {
 	assert.False(t, p.rules.StartTime)
 	assert.False(t, p.rules.Deployment)
 	assert.False(t, p.rules.Node)
+	assert.False(t, p.rules.WorkloadKind)
+	assert.False(t, p.rules.WorkloadName)
+
+	p = &kubernetesprocessor{}
+	assert.NoError(t, withExtractMetadata(metadataWorkloadKind, metadataWorkloadName)(p))
+	assert.True(t, p.rules.WorkloadKind)
+	assert.True(t, p.rules.WorkloadName)
+	assert.False(t, p.rules.Deployment)
 }
 
 func TestWithFilterLabels(t *testing.T) {
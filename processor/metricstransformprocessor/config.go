@@ -47,6 +47,9 @@ const (
 
 	// SubmatchCaseFieldName is the mapstructure field name for SubmatchCase field
 	SubmatchCaseFieldName = "submatch_case"
+
+	// AggregationTemporalityFieldName is the mapstructure field name for AggregationTemporality field
+	AggregationTemporalityFieldName = "aggregation_temporality"
 )
 
 // Config defines configuration for Resource processor.
@@ -139,6 +142,9 @@ type Operation struct {
 
 	// LabelValue identifies the exact label value to operate on
 	LabelValue string `mapstructure:"label_value"`
+
+	// AggregationTemporality is the temporality to convert sum metrics to.
+	AggregationTemporality AggregationTemporality `mapstructure:"aggregation_temporality"`
 }
 
 // ValueAction renames label values.
@@ -213,9 +219,14 @@ const (
 	// Metric has to match the FilterConfig with all its data points if used with Update ConfigAction,
 	// otherwise the operation will be ignored.
 	AggregateLabelValues OperationAction = "aggregate_label_values"
+
+	// ConvertAggregationTemporality converts sum metrics to the temporality indicated by Operation.AggregationTemporality.
+	// The conversion keeps the previous value of every time series, it is meant to be used with the Insert ConfigAction
+	// to export a metric with both temporalities.
+	ConvertAggregationTemporality OperationAction = "experimental_convert_aggregation_temporality"
 )
 
-var operationActions = []OperationAction{AddLabel, UpdateLabel, DeleteLabelValue, ToggleScalarDataType, ScaleValue, AggregateLabels, AggregateLabelValues}
+var operationActions = []OperationAction{AddLabel, UpdateLabel, DeleteLabelValue, ToggleScalarDataType, ScaleValue, AggregateLabels, AggregateLabelValues, ConvertAggregationTemporality}
 
 func (oa OperationAction) isValid() bool {
 	for _, operationAction := range operationActions {
@@ -256,6 +267,29 @@ func (at AggregationType) isValid() bool {
 	return false
 }
 
+// AggregationTemporality is the enum to capture the two aggregation temporalities of sum metrics.
+type AggregationTemporality string
+
+const (
+	// Delta indicates that each data point holds the change since the previous one.
+	Delta AggregationTemporality = "delta"
+
+	// Cumulative indicates that each data point holds the total since the start time.
+	Cumulative AggregationTemporality = "cumulative"
+)
+
+var aggregationTemporalities = []AggregationTemporality{Delta, Cumulative}
+
+func (at AggregationTemporality) isValid() bool {
+	for _, aggregationTemporality := range aggregationTemporalities {
+		if at == aggregationTemporality {
+			return true
+		}
+	}
+
+	return false
+}
+
 // MatchType is the enum to capture the two types of matching metric(s) that should have operations applied to them.
 type MatchType string
 
@@ -130,6 +130,10 @@ func validateConfiguration(config *Config) error {
 				return fmt.Errorf("operation %v: missing required field %q while %q is %v", i+1, ScaleFieldName, ActionFieldName, ScaleValue)
 			}
 
+			if op.Action == ConvertAggregationTemporality && !op.AggregationTemporality.isValid() {
+				return fmt.Errorf("operation %v: %q must be in %q while %q is %v", i+1, AggregationTemporalityFieldName, aggregationTemporalities, ActionFieldName, ConvertAggregationTemporality)
+			}
+
 			if op.AggregationType != "" && !op.AggregationType.isValid() {
 				return fmt.Errorf("operation %v: %q must be in %q", i+1, AggregationTypeFieldName, aggregationTypes)
 			}
@@ -174,6 +178,8 @@ func buildHelperConfig(config *Config, version string) ([]internalTransform, err
 				mtpOp.labelSetMap = sliceToSet(op.LabelSet)
 			} else if op.Action == AggregateLabelValues {
 				mtpOp.aggregatedValuesSet = sliceToSet(op.AggregatedValues)
+			} else if op.Action == ConvertAggregationTemporality {
+				mtpOp.temporalityConverter = newTemporalityConverter(op.AggregationTemporality)
 			}
 			helperT.Operations[j] = mtpOp
 		}
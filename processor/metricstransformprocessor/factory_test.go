@@ -110,6 +110,11 @@ func TestCreateProcessors(t *testing.T) {
 			succeed:      false,
 			errorMessage: fmt.Sprintf("operation %v: %q must be in %q", 1, AggregationTypeFieldName, aggregationTypes),
 		},
+		{
+			configName:   "config_invalid_aggregation_temporality.yaml",
+			succeed:      false,
+			errorMessage: fmt.Sprintf("operation %v: %q must be in %q while %q is %v", 1, AggregationTemporalityFieldName, aggregationTemporalities, ActionFieldName, ConvertAggregationTemporality),
+		},
 		{
 			configName:   "config_invalid_submatchcase.yaml",
 			succeed:      false,
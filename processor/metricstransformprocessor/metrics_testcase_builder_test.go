@@ -122,6 +122,12 @@ func (b builder) addHistogramDatapointWithMinMaxAndExemplars(start, ts pcommon.T
 	return b
 }
 
+// setAggregationTemporality sets the aggregation temporality of this sum metric
+func (b builder) setAggregationTemporality(temporality pmetric.AggregationTemporality) builder {
+	b.metric.Sum().SetAggregationTemporality(temporality)
+	return b
+}
+
 // setUnit sets the unit of this metric
 func (b builder) setUnit(unit string) builder {
 	b.metric.SetUnit(unit)
@@ -40,10 +40,11 @@ type internalTransform struct {
 }
 
 type internalOperation struct {
-	configOperation     Operation
-	valueActionsMapping map[string]string
-	labelSetMap         map[string]bool
-	aggregatedValuesSet map[string]bool
+	configOperation      Operation
+	valueActionsMapping  map[string]string
+	labelSetMap          map[string]bool
+	aggregatedValuesSet  map[string]bool
+	temporalityConverter *temporalityConverter
 }
 
 type internalFilter interface {
@@ -264,7 +264,7 @@ func (mtp *metricsTransformProcessor) processMetrics(_ context.Context, md pmetr
 					extractedMetrics := pmetric.NewMetricSlice()
 					extractAndRemoveMatchedMetrics(extractedMetrics, transform.MetricIncludeFilter, metrics)
 					combinedMetric := combine(transform, extractedMetrics)
-					if transformMetric(combinedMetric, transform) {
+					if transformMetric(combinedMetric, transform, rm.Resource()) {
 						combinedMetric.MoveTo(metrics.AppendEmpty())
 					}
 				case Insert:
@@ -280,7 +280,7 @@ func (mtp *metricsTransformProcessor) processMetrics(_ context.Context, md pmetr
 							newMetric = pmetric.NewMetric()
 							metric.CopyTo(newMetric)
 						}
-						if transformMetric(newMetric, transform) {
+						if transformMetric(newMetric, transform, rm.Resource()) {
 							newMetric.MoveTo(metrics.AppendEmpty())
 						}
 					}
@@ -291,7 +291,7 @@ func (mtp *metricsTransformProcessor) processMetrics(_ context.Context, md pmetr
 						}
 
 						// Drop the metric if all the data points were dropped after transformations.
-						return !transformMetric(metric, transform)
+						return !transformMetric(metric, transform, rm.Resource())
 					})
 				}
 			}
@@ -529,7 +529,7 @@ func countDataPoints(metric pmetric.Metric) int {
 // transformMetric updates the metric content based on operations indicated in transform and returns a flag
 // specifying whether the metric is valid after applying the translations,
 // e.g. false is returned if all the data points were removed after applying the translations.
-func transformMetric(metric pmetric.Metric, transform internalTransform) bool {
+func transformMetric(metric pmetric.Metric, transform internalTransform, resource pcommon.Resource) bool {
 	isMetricEmpty := countDataPoints(metric) == 0
 	canChangeMetric := transform.Action != Update || matchAllDps(metric, transform.MetricIncludeFilter)
 
@@ -565,6 +565,10 @@ func transformMetric(metric pmetric.Metric, transform internalTransform) bool {
 			if canChangeMetric {
 				deleteLabelValueOp(metric, op)
 			}
+		case ConvertAggregationTemporality:
+			if canChangeMetric {
+				op.temporalityConverter.convert(metric, resource)
+			}
 		}
 	}
 
@@ -75,6 +75,106 @@ func TestMetricsTransformProcessor(t *testing.T) {
 	}
 }
 
+func TestConvertAggregationTemporality(t *testing.T) {
+	tests := []struct {
+		name        string
+		from        pmetric.AggregationTemporality
+		temporality AggregationTemporality
+		in          []pmetric.Metric
+		out         []pmetric.MetricSlice
+	}{
+		{
+			name:        "cumulative_to_delta",
+			from:        pmetric.AggregationTemporalityCumulative,
+			temporality: Delta,
+			in: []pmetric.Metric{
+				metricBuilder(pmetric.MetricTypeSum, "requests").addIntDatapoint(0, 10, 100).build(),
+				metricBuilder(pmetric.MetricTypeSum, "requests").addIntDatapoint(0, 20, 150).build(),
+				// the counter was restarted
+				metricBuilder(pmetric.MetricTypeSum, "requests").addIntDatapoint(0, 30, 40).build(),
+				metricBuilder(pmetric.MetricTypeSum, "requests").addIntDatapoint(35, 40, 10).build(),
+			},
+			out: []pmetric.MetricSlice{
+				pmetric.NewMetricSlice(),
+				newMetricSlice(metricBuilder(pmetric.MetricTypeSum, "requests.delta").addIntDatapoint(10, 20, 50).build()),
+				newMetricSlice(metricBuilder(pmetric.MetricTypeSum, "requests.delta").addIntDatapoint(20, 30, 40).build()),
+				newMetricSlice(metricBuilder(pmetric.MetricTypeSum, "requests.delta").addIntDatapoint(35, 40, 10).build()),
+			},
+		},
+		{
+			name:        "delta_to_cumulative",
+			from:        pmetric.AggregationTemporalityDelta,
+			temporality: Cumulative,
+			in: []pmetric.Metric{
+				metricBuilder(pmetric.MetricTypeSum, "requests").addDoubleDatapoint(0, 10, 1.5).build(),
+				metricBuilder(pmetric.MetricTypeSum, "requests").addDoubleDatapoint(10, 20, 2).build(),
+				metricBuilder(pmetric.MetricTypeSum, "requests").addDoubleDatapoint(20, 30, 0.5).build(),
+			},
+			out: []pmetric.MetricSlice{
+				newMetricSlice(metricBuilder(pmetric.MetricTypeSum, "requests.cumulative").addDoubleDatapoint(0, 10, 1.5).build()),
+				newMetricSlice(metricBuilder(pmetric.MetricTypeSum, "requests.cumulative").addDoubleDatapoint(0, 20, 3.5).build()),
+				newMetricSlice(metricBuilder(pmetric.MetricTypeSum, "requests.cumulative").addDoubleDatapoint(0, 30, 4).build()),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := &Config{
+				Transforms: []Transform{
+					{
+						MetricIncludeFilter: FilterConfig{Include: "requests"},
+						Action:              Insert,
+						NewName:             "requests." + string(test.temporality),
+						Operations: []Operation{
+							{
+								Action:                 ConvertAggregationTemporality,
+								AggregationTemporality: test.temporality,
+							},
+						},
+					},
+				},
+			}
+			require.NoError(t, validateConfiguration(cfg))
+			transforms, err := buildHelperConfig(cfg, "v0.0.1")
+			require.NoError(t, err)
+			p := newMetricsTransformProcessor(zap.NewNop(), transforms)
+
+			for i, in := range test.in {
+				in.Sum().SetAggregationTemporality(test.from)
+				in.Sum().SetIsMonotonic(true)
+				md := pmetric.NewMetrics()
+				in.CopyTo(md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty())
+
+				got, err := p.processMetrics(context.Background(), md)
+				require.NoError(t, err)
+
+				// the original metric is kept as is
+				gotMetrics := got.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+				require.Equal(t, test.out[i].Len()+1, gotMetrics.Len())
+				assert.Equal(t, in, gotMetrics.At(0))
+				for j := 0; j < test.out[i].Len(); j++ {
+					expected := test.out[i].At(j)
+					expected.Sum().SetIsMonotonic(true)
+					expected.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+					if test.temporality == Delta {
+						expected.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+					}
+					assert.Equal(t, expected, gotMetrics.At(j+1))
+				}
+			}
+		})
+	}
+}
+
+func newMetricSlice(metrics ...pmetric.Metric) pmetric.MetricSlice {
+	ms := pmetric.NewMetricSlice()
+	for _, m := range metrics {
+		m.MoveTo(ms.AppendEmpty())
+	}
+	return ms
+}
+
 func sortDataPoints(m pmetric.Metric) pmetric.Metric {
 	switch m.Type() {
 	case pmetric.MetricTypeSum:
@@ -1353,6 +1353,60 @@ var (
 					addHistogramDatapoint(0, 2, 3, 6, []float64{1, 2, 3}, []uint64{0, 1, 1, 1}).build(),
 			},
 		},
+		// Convert Aggregation Temporality
+		{
+			name: "metric_experimental_convert_aggregation_temporality_insert",
+			transforms: []internalTransform{
+				{
+					MetricIncludeFilter: internalFilterStrict{include: "metric1"},
+					Action:              Insert,
+					NewName:             "metric1.cumulative",
+					Operations: []internalOperation{
+						{
+							configOperation: Operation{
+								Action:                 ConvertAggregationTemporality,
+								AggregationTemporality: Cumulative,
+							},
+							temporalityConverter: newTemporalityConverter(Cumulative),
+						},
+					},
+				},
+			},
+			in: []pmetric.Metric{
+				metricBuilder(pmetric.MetricTypeSum, "metric1", "label1").setAggregationTemporality(pmetric.AggregationTemporalityDelta).
+					addIntDatapoint(1, 2, 3, "value1").addDoubleDatapoint(1, 2, 0.5, "value2").build(),
+			},
+			out: []pmetric.Metric{
+				metricBuilder(pmetric.MetricTypeSum, "metric1", "label1").setAggregationTemporality(pmetric.AggregationTemporalityDelta).
+					addIntDatapoint(1, 2, 3, "value1").addDoubleDatapoint(1, 2, 0.5, "value2").build(),
+				metricBuilder(pmetric.MetricTypeSum, "metric1.cumulative", "label1").setAggregationTemporality(pmetric.AggregationTemporalityCumulative).
+					addIntDatapoint(1, 2, 3, "value1").addDoubleDatapoint(1, 2, 0.5, "value2").build(),
+			},
+		},
+		{
+			name: "metric_experimental_convert_aggregation_temporality_no_effect",
+			transforms: []internalTransform{
+				{
+					MetricIncludeFilter: internalFilterStrict{include: "metric1"},
+					Action:              Update,
+					Operations: []internalOperation{
+						{
+							configOperation: Operation{
+								Action:                 ConvertAggregationTemporality,
+								AggregationTemporality: Delta,
+							},
+							temporalityConverter: newTemporalityConverter(Delta),
+						},
+					},
+				},
+			},
+			in: []pmetric.Metric{
+				metricBuilder(pmetric.MetricTypeGauge, "metric1").addIntDatapoint(1, 2, 3).build(),
+			},
+			out: []pmetric.Metric{
+				metricBuilder(pmetric.MetricTypeGauge, "metric1").addIntDatapoint(1, 2, 3).build(),
+			},
+		},
 		// Scale Value
 		{
 			name: "metric_experimental_scale_value_int64",
@@ -0,0 +1,163 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metricstransformprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/metricstransformprocessor"
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+// staleSeriesTimeout is the duration after which the state of a time series that didn't receive
+// any data point is forgotten.
+const staleSeriesTimeout = time.Hour
+
+// seriesState is the state kept for each time series to convert its temporality.
+type seriesState struct {
+	start pcommon.Timestamp
+	// last is the timestamp of the last data point
+	last       pcommon.Timestamp
+	intValue   int64
+	floatValue float64
+	seen       time.Time
+}
+
+// temporalityConverter converts sum metrics to the configured aggregation temporality.
+// Cumulative data points are converted to delta by subtracting the previous value of the time series,
+// the first data point of a time series is dropped since there is no previous value.
+// Delta data points are converted to cumulative by adding them to the total of the time series.
+type temporalityConverter struct {
+	temporality pmetric.AggregationTemporality
+
+	lock        sync.Mutex
+	series      map[string]*seriesState
+	lastCleanup time.Time
+}
+
+func newTemporalityConverter(temporality AggregationTemporality) *temporalityConverter {
+	tc := &temporalityConverter{
+		temporality: pmetric.AggregationTemporalityCumulative,
+		series:      map[string]*seriesState{},
+	}
+	if temporality == Delta {
+		tc.temporality = pmetric.AggregationTemporalityDelta
+	}
+	return tc
+}
+
+// convert converts the data points of a sum metric, other metrics are left untouched.
+func (tc *temporalityConverter) convert(metric pmetric.Metric, resource pcommon.Resource) {
+	if metric.Type() != pmetric.MetricTypeSum {
+		return
+	}
+	sum := metric.Sum()
+	from := sum.AggregationTemporality()
+	if from == tc.temporality || from == pmetric.AggregationTemporalityUnspecified {
+		return
+	}
+
+	tc.lock.Lock()
+	defer tc.lock.Unlock()
+
+	now := time.Now()
+	tc.cleanup(now)
+	resourceKey := fmt.Sprint(resource.Attributes().AsRaw())
+	sum.DataPoints().RemoveIf(func(dp pmetric.NumberDataPoint) bool {
+		key := metric.Name() + "\x00" + resourceKey + "\x00" + fmt.Sprint(dp.Attributes().AsRaw())
+		state, ok := tc.series[key]
+		if !ok {
+			state = &seriesState{}
+			tc.series[key] = state
+		}
+		state.seen = now
+		if tc.temporality == pmetric.AggregationTemporalityDelta {
+			return !toDelta(dp, state, ok, sum.IsMonotonic())
+		}
+		toCumulative(dp, state, ok)
+		return false
+	})
+	sum.SetAggregationTemporality(tc.temporality)
+}
+
+// toDelta converts a cumulative data point to delta and returns false if the data point must be dropped.
+func toDelta(dp pmetric.NumberDataPoint, state *seriesState, hasPrevious, monotonic bool) bool {
+	// drop the data points received out of order
+	if hasPrevious && dp.Timestamp() <= state.last {
+		return false
+	}
+	prev := *state
+	state.start, state.last = dp.StartTimestamp(), dp.Timestamp()
+	state.intValue, state.floatValue = dp.IntValue(), dp.DoubleValue()
+	if !hasPrevious {
+		return false
+	}
+
+	// the time series was restarted, the value is the change since the new start time
+	reset := dp.StartTimestamp() != prev.start
+	switch dp.ValueType() {
+	case pmetric.NumberDataPointValueTypeInt:
+		if monotonic && dp.IntValue() < prev.intValue {
+			reset = true
+		}
+		if !reset {
+			dp.SetIntValue(dp.IntValue() - prev.intValue)
+		}
+	case pmetric.NumberDataPointValueTypeDouble:
+		if monotonic && dp.DoubleValue() < prev.floatValue {
+			reset = true
+		}
+		if !reset {
+			dp.SetDoubleValue(dp.DoubleValue() - prev.floatValue)
+		}
+	}
+	// the delta covers the interval since the previous data point, or since the new start time if it is more recent
+	if dp.StartTimestamp() <= prev.last {
+		dp.SetStartTimestamp(prev.last)
+	}
+	return true
+}
+
+// toCumulative converts a delta data point to cumulative.
+func toCumulative(dp pmetric.NumberDataPoint, state *seriesState, hasPrevious bool) {
+	if !hasPrevious {
+		state.start = dp.StartTimestamp()
+	}
+	state.last = dp.Timestamp()
+	switch dp.ValueType() {
+	case pmetric.NumberDataPointValueTypeInt:
+		state.intValue += dp.IntValue()
+		dp.SetIntValue(state.intValue)
+	case pmetric.NumberDataPointValueTypeDouble:
+		state.floatValue += dp.DoubleValue()
+		dp.SetDoubleValue(state.floatValue)
+	}
+	dp.SetStartTimestamp(state.start)
+}
+
+// cleanup forgets the time series that didn't receive any data point for staleSeriesTimeout.
+func (tc *temporalityConverter) cleanup(now time.Time) {
+	if now.Sub(tc.lastCleanup) < staleSeriesTimeout {
+		return
+	}
+	tc.lastCleanup = now
+	for key, state := range tc.series {
+		if now.Sub(state.seen) >= staleSeriesTimeout {
+			delete(tc.series, key)
+		}
+	}
+}
@@ -54,6 +54,11 @@ type Config struct {
 	// unique log record ID. The value of the attribute is only used if the trace ID is absent or if `attribute_source` is set to `record`.
 	FromAttribute string `mapstructure:"from_attribute"`
 
+	// PriorityAttribute (traces only) is the name of the span attribute used to force the sampling decision of a span
+	// regardless of its trace ID hash. A positive number or `true` always samples the span, zero or `false` always
+	// drops it. Defaults to `sampling.priority`.
+	PriorityAttribute string `mapstructure:"priority_attribute"`
+
 	// SamplingPriority (logs only) allows to use a log record attribute designed by the `sampling_priority` key
 	// to be used as the sampling priority of the log record.
 	SamplingPriority string `mapstructure:"sampling_priority"`
@@ -39,6 +39,14 @@ func TestLoadConfig(t *testing.T) {
 				AttributeSource:    "traceID",
 			},
 		},
+		{
+			id: component.NewIDWithName(typeStr, "priority"),
+			expected: &Config{
+				SamplingPercentage: 15.3,
+				AttributeSource:    "traceID",
+				PriorityAttribute:  "app.must_keep",
+			},
+		},
 		{
 			id: component.NewIDWithName(typeStr, "logs"),
 			expected: &Config{
@@ -25,6 +25,8 @@ import (
 	"go.opentelemetry.io/collector/processor"
 	"go.opentelemetry.io/collector/processor/processorhelper"
 	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/murmur3"
 )
 
 type logSamplerProcessor struct {
@@ -89,7 +91,7 @@ func (lsp *logSamplerProcessor) processLogs(ctx context.Context, ld plog.Logs) (
 					}
 				}
 
-				sampled := hash(lidBytes, lsp.hashSeed)&bitMaskHashBuckets < priority
+				sampled := murmur3.Hash(lidBytes, lsp.hashSeed)&bitMaskHashBuckets < priority
 				var err error
 				if sampled {
 					err = stats.RecordWithTags(
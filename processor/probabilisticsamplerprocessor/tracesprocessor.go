@@ -26,6 +26,8 @@ import (
 	"go.opentelemetry.io/collector/processor"
 	"go.opentelemetry.io/collector/processor/processorhelper"
 	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/murmur3"
 )
 
 // samplingPriority has the semantic result of parsing the "sampling.priority"
@@ -50,11 +52,16 @@ const (
 	numHashBuckets        = 0x4000 // Using a power of 2 to avoid division.
 	bitMaskHashBuckets    = numHashBuckets - 1
 	percentageScaleFactor = numHashBuckets / 100.0
+
+	// defaultPriorityAttribute is the span attribute used to override the
+	// hashing decision when no priority_attribute is configured.
+	defaultPriorityAttribute = "sampling.priority"
 )
 
 type traceSamplerProcessor struct {
 	scaledSamplingRate uint32
 	hashSeed           uint32
+	priorityAttribute  string
 	logger             *zap.Logger
 }
 
@@ -65,8 +72,12 @@ func newTracesProcessor(ctx context.Context, set processor.CreateSettings, cfg *
 		// Adjust sampling percentage on private so recalculations are avoided.
 		scaledSamplingRate: uint32(cfg.SamplingPercentage * percentageScaleFactor),
 		hashSeed:           cfg.HashSeed,
+		priorityAttribute:  cfg.PriorityAttribute,
 		logger:             set.Logger,
 	}
+	if tsp.priorityAttribute == "" {
+		tsp.priorityAttribute = defaultPriorityAttribute
+	}
 
 	return processorhelper.NewTracesProcessor(
 		ctx,
@@ -81,7 +92,7 @@ func (tsp *traceSamplerProcessor) processTraces(ctx context.Context, td ptrace.T
 	td.ResourceSpans().RemoveIf(func(rs ptrace.ResourceSpans) bool {
 		rs.ScopeSpans().RemoveIf(func(ils ptrace.ScopeSpans) bool {
 			ils.Spans().RemoveIf(func(s ptrace.Span) bool {
-				sp := parseSpanSamplingPriority(s)
+				sp := parseSpanSamplingPriority(s, tsp.priorityAttribute)
 				if sp == doNotSampleSpan {
 					// The OpenTelemetry mentions this as a "hint" we take a stronger
 					// approach and do not sample the span since some may use it to
@@ -105,7 +116,7 @@ func (tsp *traceSamplerProcessor) processTraces(ctx context.Context, td ptrace.T
 				// Hashing here prevents bias due to such systems.
 				tidBytes := s.TraceID()
 				sampled := sp == mustSampleSpan ||
-					hash(tidBytes[:], tsp.hashSeed)&bitMaskHashBuckets < tsp.scaledSamplingRate
+					murmur3.Hash(tidBytes[:], tsp.hashSeed)&bitMaskHashBuckets < tsp.scaledSamplingRate
 
 				if sampled {
 					_ = stats.RecordWithTags(
@@ -134,17 +145,18 @@ func (tsp *traceSamplerProcessor) processTraces(ctx context.Context, td ptrace.T
 	return td, nil
 }
 
-// parseSpanSamplingPriority checks if the span has the "sampling.priority" tag to
-// decide if the span should be sampled or not. The usage of the tag follows the
-// OpenTracing semantic tags:
+// parseSpanSamplingPriority checks if the span has the priority attribute, by default
+// "sampling.priority", to decide if the span should be sampled or not. The usage of the
+// tag follows the OpenTracing semantic tags:
 // https://github.com/opentracing/specification/blob/main/semantic_conventions.md#span-tags-table
-func parseSpanSamplingPriority(span ptrace.Span) samplingPriority {
+// Boolean values are also accepted so that application specific flags can be used.
+func parseSpanSamplingPriority(span ptrace.Span, priorityAttribute string) samplingPriority {
 	attribMap := span.Attributes()
 	if attribMap.Len() <= 0 {
 		return deferDecision
 	}
 
-	samplingPriorityAttrib, ok := attribMap.Get("sampling.priority")
+	samplingPriorityAttrib, ok := attribMap.Get(priorityAttribute)
 	if !ok {
 		return deferDecision
 	}
@@ -171,6 +183,8 @@ func parseSpanSamplingPriority(span ptrace.Span) samplingPriority {
 		} else if value > 0.0 {
 			decision = mustSampleSpan
 		}
+	case pcommon.ValueTypeBool:
+		decision = boolSamplingPriority(samplingPriorityAttrib.Bool())
 	case pcommon.ValueTypeStr:
 		attribVal := samplingPriorityAttrib.Str()
 		if value, err := strconv.ParseFloat(attribVal, 64); err == nil {
@@ -179,8 +193,17 @@ func parseSpanSamplingPriority(span ptrace.Span) samplingPriority {
 			} else if value > 0.0 {
 				decision = mustSampleSpan
 			}
+		} else if value, err := strconv.ParseBool(attribVal); err == nil {
+			decision = boolSamplingPriority(value)
 		}
 	}
 
 	return decision
 }
+
+func boolSamplingPriority(value bool) samplingPriority {
+	if value {
+		return mustSampleSpan
+	}
+	return doNotSampleSpan
+}
@@ -280,6 +280,38 @@ func Test_tracesamplerprocessor_SpanSamplingPriority(t *testing.T) {
 				"sampling.priority",
 				pcommon.NewValueStr("0")),
 		},
+		{
+			name: "must_sample_custom_attribute_bool",
+			cfg: &Config{
+				SamplingPercentage: 0.0,
+				PriorityAttribute:  "app.keep",
+			},
+			td: singleSpanWithAttrib(
+				"app.keep",
+				pcommon.NewValueBool(true)),
+			sampled: true,
+		},
+		{
+			name: "must_not_sample_custom_attribute_bool",
+			cfg: &Config{
+				SamplingPercentage: 100.0,
+				PriorityAttribute:  "app.keep",
+			},
+			td: singleSpanWithAttrib(
+				"app.keep",
+				pcommon.NewValueBool(false)),
+		},
+		{
+			name: "custom_attribute_ignores_sampling_priority",
+			cfg: &Config{
+				SamplingPercentage: 100.0,
+				PriorityAttribute:  "app.keep",
+			},
+			td: singleSpanWithAttrib(
+				"sampling.priority",
+				pcommon.NewValueInt(0)),
+			sampled: true,
+		},
 		{
 			name: "defer_sample_expect_not_sampled",
 			cfg: &Config{
@@ -389,6 +421,26 @@ func Test_parseSpanSamplingPriority(t *testing.T) {
 			span: getSpanWithAttributes("sampling.priority", pcommon.NewValueStr("-0.5")),
 			want: deferDecision,
 		},
+		{
+			name: "sampling_priority_bool_true",
+			span: getSpanWithAttributes("sampling.priority", pcommon.NewValueBool(true)),
+			want: mustSampleSpan,
+		},
+		{
+			name: "sampling_priority_bool_false",
+			span: getSpanWithAttributes("sampling.priority", pcommon.NewValueBool(false)),
+			want: doNotSampleSpan,
+		},
+		{
+			name: "sampling_priority_string_true",
+			span: getSpanWithAttributes("sampling.priority", pcommon.NewValueStr("true")),
+			want: mustSampleSpan,
+		},
+		{
+			name: "sampling_priority_string_false",
+			span: getSpanWithAttributes("sampling.priority", pcommon.NewValueStr("false")),
+			want: doNotSampleSpan,
+		},
 		{
 			name: "sampling_priority_string_NaN",
 			span: getSpanWithAttributes("sampling.priority", pcommon.NewValueStr("NaN")),
@@ -397,7 +449,7 @@ func Test_parseSpanSamplingPriority(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			assert.Equal(t, tt.want, parseSpanSamplingPriority(tt.span))
+			assert.Equal(t, tt.want, parseSpanSamplingPriority(tt.span, defaultPriorityAttribute))
 		})
 	}
 }
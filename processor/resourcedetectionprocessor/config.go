@@ -15,6 +15,8 @@
 package resourcedetectionprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor"
 
 import (
+	"fmt"
+
 	"go.opentelemetry.io/collector/config/confighttp"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal"
@@ -41,6 +43,19 @@ type Config struct {
 	// Attributes is an allowlist of attributes to add.
 	// If a supplied attribute is not a valid atrtibute of a supplied detector it will be ignored.
 	Attributes []string `mapstructure:"attributes"`
+	// MergeStrategies maps attribute names to the strategy used to merge their values when several
+	// detectors report them. Attributes not listed keep the value of the first detector.
+	MergeStrategies map[string]internal.MergeStrategy `mapstructure:"merge_strategies"`
+}
+
+func (cfg *Config) Validate() error {
+	for attribute, strategy := range cfg.MergeStrategies {
+		if !strategy.IsValid() {
+			return fmt.Errorf("invalid merge strategy %q for attribute %q, valid values are %q, %q and %q", strategy, attribute,
+				internal.MergeStrategyFirstWins, internal.MergeStrategyLastWins, internal.MergeStrategyErrorOnConflict)
+		}
+	}
+	return nil
 }
 
 // DetectorConfig contains user-specified configurations unique to all individual detectors
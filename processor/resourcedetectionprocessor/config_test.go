@@ -100,6 +100,22 @@ func TestLoadConfig(t *testing.T) {
 				Override:           false,
 			},
 		},
+		{
+			id: component.NewIDWithName(typeStr, "merge_strategies"),
+			expected: &Config{
+				Detectors:          []string{"env", "ec2", "system"},
+				HTTPClientSettings: cfg,
+				Override:           false,
+				MergeStrategies: map[string]internal.MergeStrategy{
+					"host.name":    internal.MergeStrategyLastWins,
+					"cloud.region": internal.MergeStrategyErrorOnConflict,
+				},
+			},
+		},
+		{
+			id:           component.NewIDWithName(typeStr, "invalid_merge_strategy"),
+			errorMessage: `invalid merge strategy "random" for attribute "host.name", valid values are "first_wins", "last_wins" and "error_on_conflict"`,
+		},
 		{
 			id:           component.NewIDWithName(typeStr, "invalid"),
 			errorMessage: "hostname_sources contains invalid value: \"invalid_source\"",
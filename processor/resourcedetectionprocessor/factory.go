@@ -182,7 +182,7 @@ func (f *factory) getResourceDetectionProcessor(
 ) (*resourceDetectionProcessor, error) {
 	oCfg := cfg.(*Config)
 
-	provider, err := f.getResourceProvider(params, oCfg.HTTPClientSettings.Timeout, oCfg.Detectors, oCfg.DetectorConfig, oCfg.Attributes)
+	provider, err := f.getResourceProvider(params, oCfg.HTTPClientSettings.Timeout, oCfg.Detectors, oCfg.DetectorConfig, oCfg.Attributes, oCfg.MergeStrategies)
 	if err != nil {
 		return nil, err
 	}
@@ -201,6 +201,7 @@ func (f *factory) getResourceProvider(
 	configuredDetectors []string,
 	detectorConfigs DetectorConfig,
 	attributes []string,
+	mergeStrategies map[string]internal.MergeStrategy,
 ) (*internal.ResourceProvider, error) {
 	f.lock.Lock()
 	defer f.lock.Unlock()
@@ -214,7 +215,7 @@ func (f *factory) getResourceProvider(
 		detectorTypes = append(detectorTypes, internal.DetectorType(strings.TrimSpace(key)))
 	}
 
-	provider, err := f.resourceProviderFactory.CreateResourceProvider(params, timeout, attributes, &detectorConfigs, detectorTypes...)
+	provider, err := f.resourceProviderFactory.CreateResourceProvider(params, timeout, attributes, mergeStrategies, &detectorConfigs, detectorTypes...)
 	if err != nil {
 		return nil, err
 	}
@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"sort"
 	"sync"
 	"time"
 
@@ -42,6 +43,28 @@ type ResourceDetectorConfig interface {
 
 type DetectorFactory func(processor.CreateSettings, DetectorConfig) (Detector, error)
 
+// MergeStrategy defines how an attribute detected by several detectors is merged.
+type MergeStrategy string
+
+const (
+	// MergeStrategyFirstWins keeps the value of the first detector in the configured order.
+	MergeStrategyFirstWins MergeStrategy = "first_wins"
+	// MergeStrategyLastWins keeps the value of the last detector in the configured order.
+	MergeStrategyLastWins MergeStrategy = "last_wins"
+	// MergeStrategyErrorOnConflict fails the resource detection when detectors report different values.
+	MergeStrategyErrorOnConflict MergeStrategy = "error_on_conflict"
+)
+
+// IsValid returns true if the merge strategy is one of the supported ones.
+func (s MergeStrategy) IsValid() bool {
+	switch s {
+	case MergeStrategyFirstWins, MergeStrategyLastWins, MergeStrategyErrorOnConflict:
+		return true
+	default:
+		return false
+	}
+}
+
 type ResourceProviderFactory struct {
 	// detectors holds all possible detector types.
 	detectors map[DetectorType]DetectorFactory
@@ -55,6 +78,7 @@ func (f *ResourceProviderFactory) CreateResourceProvider(
 	params processor.CreateSettings,
 	timeout time.Duration,
 	attributes []string,
+	mergeStrategies map[string]MergeStrategy,
 	detectorConfigs ResourceDetectorConfig,
 	detectorTypes ...DetectorType) (*ResourceProvider, error) {
 	detectors, err := f.getDetectors(params, detectorConfigs, detectorTypes)
@@ -70,6 +94,8 @@ func (f *ResourceProviderFactory) CreateResourceProvider(
 	}
 
 	provider := NewResourceProvider(params.Logger, timeout, attributesToKeep, detectors...)
+	provider.detectorTypes = detectorTypes
+	provider.mergeStrategies = mergeStrategies
 	return provider, nil
 }
 
@@ -99,6 +125,16 @@ type ResourceProvider struct {
 	detectedResource *resourceResult
 	once             sync.Once
 	attributesToKeep map[string]struct{}
+	// detectorTypes holds the type of each detector, used to report conflicts.
+	detectorTypes []DetectorType
+	// mergeStrategies holds the merge strategy of attributes not merged with MergeStrategyFirstWins.
+	mergeStrategies map[string]MergeStrategy
+}
+
+// detectedValue is a value of an attribute reported by a detector.
+type detectedValue struct {
+	detector string
+	value    string
 }
 
 type resourceResult struct {
@@ -133,19 +169,22 @@ func (p *ResourceProvider) detectResource(ctx context.Context) {
 	res := pcommon.NewResource()
 	mergedSchemaURL := ""
 
+	detectedValues := make(map[string][]detectedValue)
+
 	p.logger.Info("began detecting resource information")
 
-	for _, detector := range p.detectors {
+	for i, detector := range p.detectors {
 		r, schemaURL, err := detector.Detect(ctx)
 		if err != nil {
 			p.logger.Warn("failed to detect resource", zap.Error(err))
 		} else {
 			mergedSchemaURL = MergeSchemaURL(mergedSchemaURL, schemaURL)
-			MergeResource(res, r, false)
+			p.mergeDetectedResource(res, r, p.detectorName(i), detectedValues)
 		}
 	}
 
 	droppedAttributes := filterAttributes(res.Attributes(), p.attributesToKeep)
+	p.detectedResource.err = p.reportConflicts(res, detectedValues)
 
 	p.logger.Info("detected resource information", zap.Any("resource", res.Attributes().AsRaw()))
 	if len(droppedAttributes) > 0 {
@@ -156,6 +195,83 @@ func (p *ResourceProvider) detectResource(ctx context.Context) {
 	p.detectedResource.schemaURL = mergedSchemaURL
 }
 
+func (p *ResourceProvider) detectorName(index int) string {
+	if index < len(p.detectorTypes) {
+		return string(p.detectorTypes[index])
+	}
+	return fmt.Sprintf("detector %d", index)
+}
+
+func (p *ResourceProvider) mergeStrategy(attribute string) MergeStrategy {
+	if strategy, ok := p.mergeStrategies[attribute]; ok {
+		return strategy
+	}
+	return MergeStrategyFirstWins
+}
+
+// mergeDetectedResource merges the resource detected by a detector according to the attribute merge strategies,
+// recording the values reported for each attribute.
+func (p *ResourceProvider) mergeDetectedResource(to, from pcommon.Resource, detector string, detectedValues map[string][]detectedValue) {
+	toAttr := to.Attributes()
+	from.Attributes().Range(func(k string, v pcommon.Value) bool {
+		detectedValues[k] = append(detectedValues[k], detectedValue{detector: detector, value: v.AsString()})
+		if _, found := toAttr.Get(k); !found || p.mergeStrategy(k) == MergeStrategyLastWins {
+			v.CopyTo(toAttr.PutEmpty(k))
+		}
+		return true
+	})
+}
+
+// reportConflicts logs the attributes of the detected resource for which detectors reported different values,
+// and returns an error if any of them uses MergeStrategyErrorOnConflict.
+func (p *ResourceProvider) reportConflicts(res pcommon.Resource, detectedValues map[string][]detectedValue) error {
+	var conflicting []string
+	for attribute, values := range detectedValues {
+		if _, kept := res.Attributes().Get(attribute); !kept {
+			continue
+		}
+		for _, v := range values[1:] {
+			if v.value != values[0].value {
+				conflicting = append(conflicting, attribute)
+				break
+			}
+		}
+	}
+	sort.Strings(conflicting)
+
+	var errorOnConflict []string
+	for _, attribute := range conflicting {
+		values := detectedValues[attribute]
+		strategy := p.mergeStrategy(attribute)
+		detectors := make([]string, 0, len(values))
+		reported := make([]string, 0, len(values))
+		for _, v := range values {
+			detectors = append(detectors, v.detector)
+			reported = append(reported, v.value)
+		}
+		selected := values[0].detector
+		if strategy == MergeStrategyLastWins {
+			selected = values[len(values)-1].detector
+		}
+
+		p.logger.Warn("conflicting resource attribute values detected",
+			zap.String("attribute", attribute),
+			zap.String("merge_strategy", string(strategy)),
+			zap.Strings("detectors", detectors),
+			zap.Strings("values", reported),
+			zap.String("selected_detector", selected))
+
+		if strategy == MergeStrategyErrorOnConflict {
+			errorOnConflict = append(errorOnConflict, attribute)
+		}
+	}
+
+	if len(errorOnConflict) > 0 {
+		return fmt.Errorf("detectors reported conflicting values for resource attributes %v", errorOnConflict)
+	}
+	return nil
+}
+
 func MergeSchemaURL(currentSchemaURL string, newSchemaURL string) string {
 	if currentSchemaURL == "" {
 		return newSchemaURL
@@ -30,6 +30,7 @@ import (
 	"go.opentelemetry.io/collector/processor"
 	"go.opentelemetry.io/collector/processor/processortest"
 	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
 )
 
 type MockDetector struct {
@@ -112,7 +113,7 @@ func TestDetect(t *testing.T) {
 			}
 
 			f := NewProviderFactory(mockDetectors)
-			p, err := f.CreateResourceProvider(processortest.NewNopCreateSettings(), time.Second, tt.attributes, &mockDetectorConfig{}, mockDetectorTypes...)
+			p, err := f.CreateResourceProvider(processortest.NewNopCreateSettings(), time.Second, tt.attributes, nil, &mockDetectorConfig{}, mockDetectorTypes...)
 			require.NoError(t, err)
 
 			got, _, err := p.Get(context.Background(), http.DefaultClient)
@@ -126,7 +127,7 @@ func TestDetect(t *testing.T) {
 func TestDetectResource_InvalidDetectorType(t *testing.T) {
 	mockDetectorKey := DetectorType("mock")
 	p := NewProviderFactory(map[DetectorType]DetectorFactory{})
-	_, err := p.CreateResourceProvider(processortest.NewNopCreateSettings(), time.Second, nil, &mockDetectorConfig{}, mockDetectorKey)
+	_, err := p.CreateResourceProvider(processortest.NewNopCreateSettings(), time.Second, nil, nil, &mockDetectorConfig{}, mockDetectorKey)
 	require.EqualError(t, err, fmt.Sprintf("invalid detector key: %v", mockDetectorKey))
 }
 
@@ -137,7 +138,7 @@ func TestDetectResource_DetectoryFactoryError(t *testing.T) {
 			return nil, errors.New("creation failed")
 		},
 	})
-	_, err := p.CreateResourceProvider(processortest.NewNopCreateSettings(), time.Second, nil, &mockDetectorConfig{}, mockDetectorKey)
+	_, err := p.CreateResourceProvider(processortest.NewNopCreateSettings(), time.Second, nil, nil, &mockDetectorConfig{}, mockDetectorKey)
 	require.EqualError(t, err, fmt.Sprintf("failed creating detector type %q: %v", mockDetectorKey, "creation failed"))
 }
 
@@ -155,6 +156,95 @@ func TestDetectResource_Error(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestDetectResource_MergeStrategies(t *testing.T) {
+	for _, tt := range []struct {
+		name             string
+		mergeStrategies  map[string]MergeStrategy
+		expectedResource map[string]any
+		expectedSelected string
+		expectedErr      string
+	}{
+		{
+			name:             "first wins by default",
+			expectedResource: map[string]any{"a": "1", "b": "2", "c": "3"},
+			expectedSelected: "first",
+		},
+		{
+			name:             "last wins",
+			mergeStrategies:  map[string]MergeStrategy{"a": MergeStrategyLastWins},
+			expectedResource: map[string]any{"a": "11", "b": "2", "c": "3"},
+			expectedSelected: "second",
+		},
+		{
+			name:             "error on conflict",
+			mergeStrategies:  map[string]MergeStrategy{"a": MergeStrategyErrorOnConflict},
+			expectedResource: map[string]any{"a": "1", "b": "2", "c": "3"},
+			expectedSelected: "first",
+			expectedErr:      "detectors reported conflicting values for resource attributes [a]",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			md1 := &MockDetector{}
+			res1 := pcommon.NewResource()
+			require.NoError(t, res1.Attributes().FromRaw(map[string]any{"a": "1", "b": "2"}))
+			md1.On("Detect").Return(res1, nil)
+
+			md2 := &MockDetector{}
+			res2 := pcommon.NewResource()
+			require.NoError(t, res2.Attributes().FromRaw(map[string]any{"a": "11", "b": "2", "c": "3"}))
+			md2.On("Detect").Return(res2, nil)
+
+			core, logs := observer.New(zap.WarnLevel)
+			p := NewResourceProvider(zap.New(core), time.Second, nil, md1, md2)
+			p.detectorTypes = []DetectorType{"first", "second"}
+			p.mergeStrategies = tt.mergeStrategies
+
+			detected, _, err := p.Get(context.Background(), http.DefaultClient)
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedResource, detected.Attributes().AsRaw())
+
+			// Only the attribute with different values is reported
+			conflicts := logs.FilterMessage("conflicting resource attribute values detected").All()
+			require.Len(t, conflicts, 1)
+			fields := conflicts[0].ContextMap()
+			assert.Equal(t, "a", fields["attribute"])
+			assert.Equal(t, []interface{}{"first", "second"}, fields["detectors"])
+			assert.Equal(t, []interface{}{"1", "11"}, fields["values"])
+			assert.Equal(t, tt.expectedSelected, fields["selected_detector"])
+		})
+	}
+}
+
+func TestDetectResource_ConflictOnDroppedAttribute(t *testing.T) {
+	md1 := &MockDetector{}
+	res1 := pcommon.NewResource()
+	require.NoError(t, res1.Attributes().FromRaw(map[string]any{"a": "1", "b": "2"}))
+	md1.On("Detect").Return(res1, nil)
+
+	md2 := &MockDetector{}
+	res2 := pcommon.NewResource()
+	require.NoError(t, res2.Attributes().FromRaw(map[string]any{"a": "11"}))
+	md2.On("Detect").Return(res2, nil)
+
+	p := NewResourceProvider(zap.NewNop(), time.Second, map[string]struct{}{"b": {}}, md1, md2)
+	p.mergeStrategies = map[string]MergeStrategy{"a": MergeStrategyErrorOnConflict}
+
+	detected, _, err := p.Get(context.Background(), http.DefaultClient)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"b": "2"}, detected.Attributes().AsRaw())
+}
+
+func TestMergeStrategyIsValid(t *testing.T) {
+	assert.True(t, MergeStrategyFirstWins.IsValid())
+	assert.True(t, MergeStrategyLastWins.IsValid())
+	assert.True(t, MergeStrategyErrorOnConflict.IsValid())
+	assert.False(t, MergeStrategy("random").IsValid())
+}
+
 func TestMergeResource(t *testing.T) {
 	for _, tt := range []struct {
 		name       string
@@ -130,6 +130,12 @@ type RoutingTableItem struct {
 	// The routing processor will fail upon the first failure from these exporters.
 	// Optional.
 	Exporters []string `mapstructure:"exporters"`
+
+	// TransformStatements contains OTTL statements applied, in order, to a copy
+	// of the data matching this route before it is sent to the route's exporters.
+	// The data sent to the other routes is left unchanged.
+	// Optional.
+	TransformStatements []string `mapstructure:"transform_statements"`
 }
 
 // rewriteRoutingEntriesToOTTL translates the attributes-based routing into OTTL
@@ -162,8 +168,9 @@ func rewriteRoutingEntriesToOTTL(cfg *Config) *Config {
 			),
 		)
 		table = append(table, RoutingTableItem{
-			Statement: s.String(),
-			Exporters: e.Exporters,
+			Statement:           s.String(),
+			Exporters:           e.Exporters,
+			TransformStatements: e.TransformStatements,
 		})
 	}
 	return &Config{
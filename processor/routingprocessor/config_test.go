@@ -115,6 +115,9 @@ func TestLoadConfig(t *testing.T) {
 					{
 						Statement: "delete_key(resource.attributes, \"X-Tenant\") where IsMatch(resource.attributes[\"X-Tenant\"], \".*corp\") == true",
 						Exporters: []string{"jaeger/ecorp"},
+						TransformStatements: []string{
+							"delete_key(attributes, \"user.email\")",
+						},
 					},
 				},
 			},
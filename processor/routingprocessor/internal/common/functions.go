@@ -35,3 +35,29 @@ func Functions[K any]() map[string]interface{} {
 		},
 	}
 }
+
+// TransformFunctions returns the functions available to the statements
+// mutating the data of a route.
+func TransformFunctions[K any]() map[string]interface{} {
+	return map[string]interface{}{
+		"TraceID":              ottlfuncs.TraceID[K],
+		"SpanID":               ottlfuncs.SpanID[K],
+		"IsMatch":              ottlfuncs.IsMatch[K],
+		"Concat":               ottlfuncs.Concat[K],
+		"Split":                ottlfuncs.Split[K],
+		"Int":                  ottlfuncs.Int[K],
+		"ConvertCase":          ottlfuncs.ConvertCase[K],
+		"Substring":            ottlfuncs.Substring[K],
+		"keep_keys":            ottlfuncs.KeepKeys[K],
+		"set":                  ottlfuncs.Set[K],
+		"truncate_all":         ottlfuncs.TruncateAll[K],
+		"limit":                ottlfuncs.Limit[K],
+		"replace_match":        ottlfuncs.ReplaceMatch[K],
+		"replace_all_matches":  ottlfuncs.ReplaceAllMatches[K],
+		"replace_pattern":      ottlfuncs.ReplacePattern[K],
+		"replace_all_patterns": ottlfuncs.ReplaceAllPatterns[K],
+		"delete_key":           ottlfuncs.DeleteKey[K],
+		"delete_matching_keys": ottlfuncs.DeleteMatchingKeys[K],
+		"merge_maps":           ottlfuncs.MergeMaps[K],
+	}
+}
@@ -26,6 +26,7 @@ import (
 	"go.uber.org/multierr"
 	"go.uber.org/zap"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottllog"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/routingprocessor/internal/common"
 )
@@ -51,6 +52,7 @@ func newLogProcessor(settings component.TelemetrySettings, config component.Conf
 			cfg.DefaultExporters,
 			settings,
 			ottllog.NewParser(common.Functions[ottllog.TransformContext](), settings),
+			ottllog.NewParser(common.TransformFunctions[ottllog.TransformContext](), settings),
 		),
 		extractor: newExtractor(cfg.FromAttribute, settings.Logger),
 	}
@@ -118,7 +120,11 @@ func (p *logProcessor) route(ctx context.Context, l plog.Logs) error {
 			p.group("", groups, p.router.defaultExporters, rlogs)
 		}
 	}
-	for _, g := range groups {
+	for key, g := range groups {
+		if err := p.transform(ctx, p.router.getTransforms(key), g.logs); err != nil {
+			errs = multierr.Append(errs, err)
+			continue
+		}
 		for _, e := range g.exporters {
 			errs = multierr.Append(errs, e.ConsumeLogs(ctx, g.logs))
 		}
@@ -144,6 +150,15 @@ func (p *logProcessor) group(
 func (p *logProcessor) routeForContext(ctx context.Context, l plog.Logs) error {
 	value := p.extractor.extractFromContext(ctx)
 	exporters := p.router.getExporters(value)
+	if transforms := p.router.getTransforms(value); len(transforms) > 0 {
+		// the data is shared with the caller, only a copy can be mutated
+		transformed := plog.NewLogs()
+		l.CopyTo(transformed)
+		if err := p.transform(ctx, transforms, transformed); err != nil {
+			return err
+		}
+		l = transformed
+	}
 
 	var errs error
 	for _, e := range exporters {
@@ -152,6 +167,28 @@ func (p *logProcessor) routeForContext(ctx context.Context, l plog.Logs) error {
 	return errs
 }
 
+// transform executes the statements on every log record of the logs.
+func (p *logProcessor) transform(ctx context.Context, statements []*ottl.Statement[ottllog.TransformContext], l plog.Logs) error {
+	if len(statements) == 0 {
+		return nil
+	}
+	for i := 0; i < l.ResourceLogs().Len(); i++ {
+		rlogs := l.ResourceLogs().At(i)
+		for j := 0; j < rlogs.ScopeLogs().Len(); j++ {
+			slogs := rlogs.ScopeLogs().At(j)
+			for k := 0; k < slogs.LogRecords().Len(); k++ {
+				ltx := ottllog.NewTransformContext(slogs.LogRecords().At(k), slogs.Scope(), rlogs.Resource())
+				for _, statement := range statements {
+					if _, _, err := statement.Execute(ctx, ltx); err != nil {
+						return err
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
 func (p *logProcessor) Shutdown(context.Context) error {
 	return nil
 }
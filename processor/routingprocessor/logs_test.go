@@ -375,3 +375,53 @@ type mockLogsExporter struct {
 	mockComponent
 	consumertest.LogsSink
 }
+
+func TestLogs_RoutingWorks_TransformStatements(t *testing.T) {
+	internalExp := &mockLogsExporter{}
+	externalExp := &mockLogsExporter{}
+
+	host := newMockHost(map[component.DataType]map[component.ID]component.Component{
+		component.DataTypeLogs: {
+			component.NewID("otlp"):                     internalExp,
+			component.NewIDWithName("otlp", "external"): externalExp,
+		},
+	})
+
+	exp := newLogProcessor(component.TelemetrySettings{Logger: zap.NewNop()}, &Config{
+		DefaultExporters: []string{"otlp"},
+		Table: []RoutingTableItem{
+			{
+				Statement: `route() where resource.attributes["X-Tenant"] == "acme"`,
+				Exporters: []string{"otlp"},
+			},
+			{
+				Statement: `route() where resource.attributes["X-Tenant"] != "internal"`,
+				Exporters: []string{"otlp/external"},
+				TransformStatements: []string{
+					`delete_key(attributes, "email")`,
+					`set(attributes["redacted"], true)`,
+				},
+			},
+		},
+	})
+	require.NoError(t, exp.Start(context.Background(), host))
+
+	l := plog.NewLogs()
+	rl := l.ResourceLogs().AppendEmpty()
+	rl.Resource().Attributes().PutStr("X-Tenant", "acme")
+	lr := rl.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+	lr.Attributes().PutStr("email", "user@example.com")
+
+	require.NoError(t, exp.ConsumeLogs(context.Background(), l))
+
+	require.Len(t, internalExp.AllLogs(), 1)
+	internalAttrs := internalExp.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes()
+	assert.Equal(t, map[string]interface{}{"email": "user@example.com"}, internalAttrs.AsRaw())
+
+	require.Len(t, externalExp.AllLogs(), 1)
+	externalAttrs := externalExp.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes()
+	assert.Equal(t, map[string]interface{}{"redacted": true}, externalAttrs.AsRaw())
+
+	// the incoming data is left unchanged
+	assert.Equal(t, map[string]interface{}{"email": "user@example.com"}, lr.Attributes().AsRaw())
+}
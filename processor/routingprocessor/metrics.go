@@ -26,6 +26,7 @@ import (
 	"go.uber.org/multierr"
 	"go.uber.org/zap"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottldatapoint"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/routingprocessor/internal/common"
 )
@@ -51,6 +52,7 @@ func newMetricProcessor(settings component.TelemetrySettings, config component.C
 			cfg.DefaultExporters,
 			settings,
 			ottldatapoint.NewParser(common.Functions[ottldatapoint.TransformContext](), settings),
+			ottldatapoint.NewParser(common.TransformFunctions[ottldatapoint.TransformContext](), settings),
 		),
 		extractor: newExtractor(cfg.FromAttribute, settings.Logger),
 	}
@@ -121,7 +123,11 @@ func (p *metricsProcessor) route(ctx context.Context, tm pmetric.Metrics) error
 		}
 	}
 
-	for _, g := range groups {
+	for key, g := range groups {
+		if err := p.transform(ctx, p.router.getTransforms(key), g.metrics); err != nil {
+			errs = multierr.Append(errs, err)
+			continue
+		}
 		for _, e := range g.exporters {
 			errs = multierr.Append(errs, e.ConsumeMetrics(ctx, g.metrics))
 		}
@@ -147,6 +153,15 @@ func (p *metricsProcessor) group(
 func (p *metricsProcessor) routeForContext(ctx context.Context, m pmetric.Metrics) error {
 	value := p.extractor.extractFromContext(ctx)
 	exporters := p.router.getExporters(value)
+	if transforms := p.router.getTransforms(value); len(transforms) > 0 {
+		// the data is shared with the caller, only a copy can be mutated
+		transformed := pmetric.NewMetrics()
+		m.CopyTo(transformed)
+		if err := p.transform(ctx, transforms, transformed); err != nil {
+			return err
+		}
+		m = transformed
+	}
 
 	var errs error
 	for _, e := range exporters {
@@ -155,6 +170,60 @@ func (p *metricsProcessor) routeForContext(ctx context.Context, m pmetric.Metric
 	return errs
 }
 
+// transform executes the statements on every data point of the metrics.
+func (p *metricsProcessor) transform(ctx context.Context, statements []*ottl.Statement[ottldatapoint.TransformContext], m pmetric.Metrics) error {
+	if len(statements) == 0 {
+		return nil
+	}
+	for i := 0; i < m.ResourceMetrics().Len(); i++ {
+		rmetrics := m.ResourceMetrics().At(i)
+		for j := 0; j < rmetrics.ScopeMetrics().Len(); j++ {
+			smetrics := rmetrics.ScopeMetrics().At(j)
+			metrics := smetrics.Metrics()
+			for k := 0; k < metrics.Len(); k++ {
+				metric := metrics.At(k)
+				for _, dp := range dataPoints(metric) {
+					mtx := ottldatapoint.NewTransformContext(dp, metric, metrics, smetrics.Scope(), rmetrics.Resource())
+					for _, statement := range statements {
+						if _, _, err := statement.Execute(ctx, mtx); err != nil {
+							return err
+						}
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// dataPoints returns the data points of the metric, whatever its type.
+func dataPoints(metric pmetric.Metric) []interface{} {
+	var dps []interface{}
+	switch metric.Type() {
+	case pmetric.MetricTypeGauge:
+		for i := 0; i < metric.Gauge().DataPoints().Len(); i++ {
+			dps = append(dps, metric.Gauge().DataPoints().At(i))
+		}
+	case pmetric.MetricTypeSum:
+		for i := 0; i < metric.Sum().DataPoints().Len(); i++ {
+			dps = append(dps, metric.Sum().DataPoints().At(i))
+		}
+	case pmetric.MetricTypeHistogram:
+		for i := 0; i < metric.Histogram().DataPoints().Len(); i++ {
+			dps = append(dps, metric.Histogram().DataPoints().At(i))
+		}
+	case pmetric.MetricTypeExponentialHistogram:
+		for i := 0; i < metric.ExponentialHistogram().DataPoints().Len(); i++ {
+			dps = append(dps, metric.ExponentialHistogram().DataPoints().At(i))
+		}
+	case pmetric.MetricTypeSummary:
+		for i := 0; i < metric.Summary().DataPoints().Len(); i++ {
+			dps = append(dps, metric.Summary().DataPoints().At(i))
+		}
+	}
+	return dps
+}
+
 func (p *metricsProcessor) Capabilities() consumer.Capabilities {
 	return consumer.Capabilities{MutatesData: false}
 }
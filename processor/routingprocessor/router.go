@@ -30,8 +30,9 @@ var errExporterNotFound = errors.New("exporter not found")
 // be instantiated with exporter.Traces, exporter.Metrics, and
 // exporter.Logs type arguments.
 type router[E component.Component, K any] struct {
-	logger *zap.Logger
-	parser ottl.Parser[K]
+	logger          *zap.Logger
+	parser          ottl.Parser[K]
+	transformParser ottl.Parser[K]
 
 	defaultExporterIDs []string
 	table              []RoutingTableItem
@@ -47,10 +48,12 @@ func newRouter[E component.Component, K any](
 	defaultExporterIDs []string,
 	settings component.TelemetrySettings,
 	parser ottl.Parser[K],
+	transformParser ottl.Parser[K],
 ) router[E, K] {
 	return router[E, K]{
-		logger: settings.Logger,
-		parser: parser,
+		logger:          settings.Logger,
+		parser:          parser,
+		transformParser: transformParser,
 
 		table:              table,
 		defaultExporterIDs: defaultExporterIDs,
@@ -60,8 +63,9 @@ func newRouter[E component.Component, K any](
 }
 
 type routingItem[E component.Component, K any] struct {
-	exporters []E
-	statement *ottl.Statement[K]
+	exporters  []E
+	statement  *ottl.Statement[K]
+	transforms []*ottl.Statement[K]
 }
 
 func (r *router[E, K]) registerExporters(available map[component.ID]component.Component) error {
@@ -106,10 +110,16 @@ func (r *router[E, K]) registerRouteExporters(available map[component.ID]compone
 			return err
 		}
 
+		transforms, err := r.transformParser.ParseStatements(item.TransformStatements)
+		if err != nil {
+			return err
+		}
+
 		route, ok := r.routes[key(item)]
 		if !ok {
 			route.statement = statement
 		}
+		route.transforms = append(route.transforms, transforms...)
 
 		for _, name := range item.Exporters {
 			e, err := r.extractExporter(name, available)
@@ -190,3 +200,9 @@ func (r *router[E, K]) getExporters(key string) []E {
 	}
 	return e.exporters
 }
+
+// getTransforms returns the statements mutating the data sent to the
+// exporters of the given route. The default route has none.
+func (r *router[E, K]) getTransforms(key string) []*ottl.Statement[K] {
+	return r.routes[key].transforms
+}
@@ -26,6 +26,7 @@ import (
 	"go.uber.org/multierr"
 	"go.uber.org/zap"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspan"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/routingprocessor/internal/common"
 )
@@ -51,6 +52,7 @@ func newTracesProcessor(settings component.TelemetrySettings, config component.C
 			cfg.DefaultExporters,
 			settings,
 			ottlspan.NewParser(common.Functions[ottlspan.TransformContext](), settings),
+			ottlspan.NewParser(common.TransformFunctions[ottlspan.TransformContext](), settings),
 		),
 		extractor: newExtractor(cfg.FromAttribute, settings.Logger),
 	}
@@ -119,7 +121,11 @@ func (p *tracesProcessor) route(ctx context.Context, t ptrace.Traces) error {
 		}
 	}
 
-	for _, g := range groups {
+	for key, g := range groups {
+		if err := p.transform(ctx, p.router.getTransforms(key), g.traces); err != nil {
+			errs = multierr.Append(errs, err)
+			continue
+		}
 		for _, e := range g.exporters {
 			errs = multierr.Append(errs, e.ConsumeTraces(ctx, g.traces))
 		}
@@ -140,6 +146,15 @@ func (p *tracesProcessor) group(key string, groups map[string]spanGroup, exporte
 func (p *tracesProcessor) routeForContext(ctx context.Context, t ptrace.Traces) error {
 	value := p.extractor.extractFromContext(ctx)
 	exporters := p.router.getExporters(value)
+	if transforms := p.router.getTransforms(value); len(transforms) > 0 {
+		// the data is shared with the caller, only a copy can be mutated
+		transformed := ptrace.NewTraces()
+		t.CopyTo(transformed)
+		if err := p.transform(ctx, transforms, transformed); err != nil {
+			return err
+		}
+		t = transformed
+	}
 
 	var errs error
 	for _, e := range exporters {
@@ -148,6 +163,28 @@ func (p *tracesProcessor) routeForContext(ctx context.Context, t ptrace.Traces)
 	return errs
 }
 
+// transform executes the statements on every span of the traces.
+func (p *tracesProcessor) transform(ctx context.Context, statements []*ottl.Statement[ottlspan.TransformContext], t ptrace.Traces) error {
+	if len(statements) == 0 {
+		return nil
+	}
+	for i := 0; i < t.ResourceSpans().Len(); i++ {
+		rspans := t.ResourceSpans().At(i)
+		for j := 0; j < rspans.ScopeSpans().Len(); j++ {
+			sspans := rspans.ScopeSpans().At(j)
+			for k := 0; k < sspans.Spans().Len(); k++ {
+				stx := ottlspan.NewTransformContext(sspans.Spans().At(k), sspans.Scope(), rspans.Resource())
+				for _, statement := range statements {
+					if _, _, err := statement.Execute(ctx, stx); err != nil {
+						return err
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
 func (p *tracesProcessor) Capabilities() consumer.Capabilities {
 	return consumer.Capabilities{MutatesData: false}
 }
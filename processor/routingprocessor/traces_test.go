@@ -206,6 +206,56 @@ func TestTraces_RoutingWorks_Context(t *testing.T) {
 	})
 }
 
+func TestTraces_RoutingWorks_Context_TransformStatements(t *testing.T) {
+	defaultExp := &mockTracesExporter{}
+	tExp := &mockTracesExporter{}
+
+	host := newMockHost(map[component.DataType]map[component.ID]component.Component{
+		component.DataTypeTraces: {
+			component.NewID("otlp"):              defaultExp,
+			component.NewIDWithName("otlp", "2"): tExp,
+		},
+	})
+
+	exp := newTracesProcessor(component.TelemetrySettings{Logger: zap.NewNop()}, &Config{
+		FromAttribute:    "X-Tenant",
+		AttributeSource:  contextAttributeSource,
+		DefaultExporters: []string{"otlp"},
+		Table: []RoutingTableItem{
+			{
+				Value:               "acme",
+				Exporters:           []string{"otlp/2"},
+				TransformStatements: []string{`set(name, "redacted") where attributes["pii"] == true`},
+			},
+		},
+	})
+	require.NoError(t, exp.Start(context.Background(), host))
+
+	tr := ptrace.NewTraces()
+	span := tr.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans().AppendEmpty()
+	span.SetName("user.lookup")
+	span.Attributes().PutBool("pii", true)
+
+	require.NoError(t, exp.ConsumeTraces(
+		metadata.NewIncomingContext(context.Background(), metadata.New(map[string]string{
+			"X-Tenant": "acme",
+		})),
+		tr,
+	))
+	require.Len(t, tExp.AllTraces(), 1)
+	assert.Equal(t, "redacted", tExp.AllTraces()[0].ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0).Name())
+	assert.Equal(t, "user.lookup", span.Name(), "the incoming data should be left unchanged")
+
+	require.NoError(t, exp.ConsumeTraces(
+		metadata.NewIncomingContext(context.Background(), metadata.New(map[string]string{
+			"X-Tenant": "some-custom-value",
+		})),
+		tr,
+	))
+	require.Len(t, defaultExp.AllTraces(), 1)
+	assert.Equal(t, "user.lookup", defaultExp.AllTraces()[0].ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0).Name())
+}
+
 func TestTraces_RoutingWorks_ResourceAttribute(t *testing.T) {
 	defaultExp := &mockTracesExporter{}
 	tExp := &mockTracesExporter{}
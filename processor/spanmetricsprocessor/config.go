@@ -72,6 +72,16 @@ type Config struct {
 
 	// MetricsEmitInterval is the time period between when metrics are flushed or emitted to the configured MetricsExporter.
 	MetricsFlushInterval time.Duration `mapstructure:"metrics_flush_interval"`
+
+	// ResourceAttributes defines the list of resource attributes of the spans to propagate to the resource of the
+	// generated metrics. The metrics are generated separately for each distinct set of values of these attributes.
+	// Optional. By default, the generated metrics have an empty resource.
+	ResourceAttributes []string `mapstructure:"resource_attributes"`
+
+	// Namespace is prepended to the names of the generated metrics, separated with a dot, e.g. "tier1.calls_total",
+	// so that the metrics generated by multiple collector tiers don't collide.
+	// Optional. By default, the metric names are not prefixed.
+	Namespace string `mapstructure:"namespace"`
 }
 
 // GetAggregationTemporality converts the string value given in the config into a AggregationTemporality.
@@ -43,6 +43,8 @@ func TestLoadConfig(t *testing.T) {
 		wantDimensionsCacheSize     int
 		wantAggregationTemporality  string
 		wantMetricsFlushInterval    time.Duration
+		wantResourceAttributes      []string
+		wantNamespace               string
 	}{
 		{
 			configFile:                 "config-2-pipelines.yaml",
@@ -77,6 +79,8 @@ func TestLoadConfig(t *testing.T) {
 			wantDimensionsCacheSize:    1500,
 			wantAggregationTemporality: delta,
 			wantMetricsFlushInterval:   30 * time.Second,
+			wantResourceAttributes:     []string{"service.namespace", "deployment.environment"},
+			wantNamespace:              "tier1",
 		},
 	}
 	for _, tc := range testcases {
@@ -109,6 +113,8 @@ func TestLoadConfig(t *testing.T) {
 					DimensionsCacheSize:     tc.wantDimensionsCacheSize,
 					AggregationTemporality:  tc.wantAggregationTemporality,
 					MetricsFlushInterval:    tc.wantMetricsFlushInterval,
+					ResourceAttributes:      tc.wantResourceAttributes,
+					Namespace:               tc.wantNamespace,
 				},
 				cfg.Processors[component.NewID(typeStr)],
 			)
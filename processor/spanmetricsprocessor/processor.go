@@ -78,6 +78,9 @@ type processorImp struct {
 	histograms    map[metricKey]*histogramData
 	latencyBounds []float64
 
+	// The propagated resource attributes of the generated metrics, keyed by the concatenation of their values.
+	resources map[string]pcommon.Map
+
 	keyBuf *bytes.Buffer
 
 	// An LRU cache of dimension key-value maps keyed by a unique identifier formed by a concatenation of its values:
@@ -112,6 +115,7 @@ func newDimensions(cfgDims []Dimension) []dimension {
 }
 
 type histogramData struct {
+	resourceKey   string
 	count         uint64
 	sum           float64
 	bucketCounts  []uint64
@@ -148,6 +152,7 @@ func newProcessor(logger *zap.Logger, config component.Config, nextConsumer cons
 		startTimestamp:        pcommon.NewTimestampFromTime(time.Now()),
 		latencyBounds:         bounds,
 		histograms:            make(map[metricKey]*histogramData),
+		resources:             make(map[string]pcommon.Map),
 		nextConsumer:          nextConsumer,
 		dimensions:            newDimensions(pConfig.Dimensions),
 		keyBuf:                bytes.NewBuffer(make([]byte, 0, 1024)),
@@ -300,11 +305,17 @@ func (p *processorImp) exportMetrics(ctx context.Context) {
 // writes the raw metrics data into the metrics object.
 func (p *processorImp) buildMetrics() pmetric.Metrics {
 	m := pmetric.NewMetrics()
-	ilm := m.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty()
-	ilm.Scope().SetName("spanmetricsprocessor")
+	for _, resourceKey := range p.resourceKeys() {
+		rm := m.ResourceMetrics().AppendEmpty()
+		if attrs, ok := p.resources[resourceKey]; ok {
+			attrs.CopyTo(rm.Resource().Attributes())
+		}
+		ilm := rm.ScopeMetrics().AppendEmpty()
+		ilm.Scope().SetName("spanmetricsprocessor")
 
-	p.collectCallMetrics(ilm)
-	p.collectLatencyMetrics(ilm)
+		p.collectCallMetrics(ilm, resourceKey)
+		p.collectLatencyMetrics(ilm, resourceKey)
+	}
 
 	p.metricKeyToDimensions.RemoveEvictedItems()
 
@@ -317,17 +328,46 @@ func (p *processorImp) buildMetrics() pmetric.Metrics {
 	return m
 }
 
-// collectLatencyMetrics collects the raw latency metrics, writing the data
+// resourceKeys returns the sorted keys of the resources of the metrics to build. There is always
+// at least one resource, so that the metrics are built even when no span was received.
+func (p *processorImp) resourceKeys() []string {
+	seen := make(map[string]struct{})
+	var keys []string
+	for _, hist := range p.histograms {
+		if _, ok := seen[hist.resourceKey]; !ok {
+			seen[hist.resourceKey] = struct{}{}
+			keys = append(keys, hist.resourceKey)
+		}
+	}
+	if len(keys) == 0 {
+		return []string{""}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// buildMetricName prepends the configured namespace, if any, to the metric name.
+func (p *processorImp) buildMetricName(name string) string {
+	if p.config.Namespace == "" {
+		return name
+	}
+	return p.config.Namespace + "." + name
+}
+
+// collectLatencyMetrics collects the raw latency metrics of the given resource, writing the data
 // into the given instrumentation library metrics.
-func (p *processorImp) collectLatencyMetrics(ilm pmetric.ScopeMetrics) {
+func (p *processorImp) collectLatencyMetrics(ilm pmetric.ScopeMetrics, resourceKey string) {
 	mLatency := ilm.Metrics().AppendEmpty()
-	mLatency.SetName("latency")
+	mLatency.SetName(p.buildMetricName("latency"))
 	mLatency.SetUnit("ms")
 	mLatency.SetEmptyHistogram().SetAggregationTemporality(p.config.GetAggregationTemporality())
 	dps := mLatency.Histogram().DataPoints()
 	dps.EnsureCapacity(len(p.histograms))
 	timestamp := pcommon.NewTimestampFromTime(time.Now())
 	for key, hist := range p.histograms {
+		if hist.resourceKey != resourceKey {
+			continue
+		}
 		dimensions, ok := p.metricKeyToDimensions.Get(key)
 		if !ok {
 			p.logger.Warn("Metric key not found in cache; consider increasing the dimensions_cache_size", zap.String("key", string(key)))
@@ -345,17 +385,20 @@ func (p *processorImp) collectLatencyMetrics(ilm pmetric.ScopeMetrics) {
 	}
 }
 
-// collectCallMetrics collects the raw call count metrics, writing the data
+// collectCallMetrics collects the raw call count metrics of the given resource, writing the data
 // into the given instrumentation library metrics.
-func (p *processorImp) collectCallMetrics(ilm pmetric.ScopeMetrics) {
+func (p *processorImp) collectCallMetrics(ilm pmetric.ScopeMetrics, resourceKey string) {
 	mCalls := ilm.Metrics().AppendEmpty()
-	mCalls.SetName("calls_total")
+	mCalls.SetName(p.buildMetricName("calls_total"))
 	mCalls.SetEmptySum().SetIsMonotonic(true)
 	mCalls.Sum().SetAggregationTemporality(p.config.GetAggregationTemporality())
 	dps := mCalls.Sum().DataPoints()
 	dps.EnsureCapacity(len(p.histograms))
 	timestamp := pcommon.NewTimestampFromTime(time.Now())
 	for key, hist := range p.histograms {
+		if hist.resourceKey != resourceKey {
+			continue
+		}
 		dimensions, ok := p.metricKeyToDimensions.Get(key)
 		if !ok {
 			p.logger.Warn("Metric key not found in cache; consider increasing the dimensions_cache_size", zap.String("key", string(key)))
@@ -383,6 +426,7 @@ func (p *processorImp) aggregateMetrics(traces ptrace.Traces) {
 			continue
 		}
 		serviceName := serviceAttr.Str()
+		resourceKey := p.cacheResource(resourceAttr)
 		ilsSlice := rspans.ScopeSpans()
 		for j := 0; j < ilsSlice.Len(); j++ {
 			ils := ilsSlice.At(j)
@@ -399,9 +443,12 @@ func (p *processorImp) aggregateMetrics(traces ptrace.Traces) {
 				// Always reset the buffer before re-using.
 				p.keyBuf.Reset()
 				buildKey(p.keyBuf, serviceName, span, p.dimensions, resourceAttr)
+				if len(p.config.ResourceAttributes) > 0 {
+					concatDimensionValue(p.keyBuf, resourceKey, true)
+				}
 				key := metricKey(p.keyBuf.String())
 				p.cache(serviceName, span, key, resourceAttr)
-				p.updateHistogram(key, latencyInMilliseconds, span.TraceID(), span.SpanID())
+				p.updateHistogram(key, resourceKey, latencyInMilliseconds, span.TraceID(), span.SpanID())
 			}
 		}
 	}
@@ -411,14 +458,45 @@ func (p *processorImp) aggregateMetrics(traces ptrace.Traces) {
 // metricKeyToDimensions.
 func (p *processorImp) resetAccumulatedMetrics() {
 	p.histograms = make(map[metricKey]*histogramData)
+	p.resources = make(map[string]pcommon.Map)
 	p.metricKeyToDimensions.Purge()
 }
 
+// cacheResource returns the key identifying the values of the propagated resource attributes of the span's
+// resource, and caches these attributes if there is a cache miss. The key is empty if no resource attribute
+// is propagated.
+func (p *processorImp) cacheResource(resourceAttrs pcommon.Map) string {
+	if len(p.config.ResourceAttributes) == 0 {
+		return ""
+	}
+
+	var keyBuf bytes.Buffer
+	for _, name := range p.config.ResourceAttributes {
+		if v, ok := resourceAttrs.Get(name); ok {
+			// The name distinguishes a missing attribute from an empty value.
+			concatDimensionValue(&keyBuf, name+"="+v.AsString(), keyBuf.Len() > 0)
+		}
+	}
+	key := keyBuf.String()
+
+	if _, ok := p.resources[key]; !ok {
+		attrs := pcommon.NewMap()
+		for _, name := range p.config.ResourceAttributes {
+			if v, ok := resourceAttrs.Get(name); ok {
+				v.CopyTo(attrs.PutEmpty(name))
+			}
+		}
+		p.resources[key] = attrs
+	}
+	return key
+}
+
 // updateHistogram adds the histogram sample to the histogram defined by the metric key.
-func (p *processorImp) updateHistogram(key metricKey, latency float64, traceID pcommon.TraceID, spanID pcommon.SpanID) {
+func (p *processorImp) updateHistogram(key metricKey, resourceKey string, latency float64, traceID pcommon.TraceID, spanID pcommon.SpanID) {
 	histo, ok := p.histograms[key]
 	if !ok {
 		histo = &histogramData{
+			resourceKey:  resourceKey,
 			bucketCounts: make([]uint64, len(p.latencyBounds)+1),
 		}
 		p.histograms[key] = histo
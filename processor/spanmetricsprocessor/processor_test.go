@@ -932,6 +932,60 @@ func TestSetExemplars(t *testing.T) {
 	assert.Equal(t, exemplarSlice.At(0).DoubleValue(), value)
 }
 
+func TestProcessorResourceAttributesAndNamespace(t *testing.T) {
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig().(*Config)
+	cfg.ResourceAttributes = []string{"deployment.environment", regionResourceAttrName}
+	cfg.Namespace = "tier1"
+	p, err := newProcessor(zaptest.NewLogger(t), cfg, consumertest.NewNop(), nil)
+	require.NoError(t, err)
+
+	traces := buildSampleTrace()
+	traces.ResourceSpans().At(0).Resource().Attributes().PutStr("deployment.environment", "production")
+	p.aggregateMetrics(traces)
+
+	m := p.buildMetrics()
+
+	// service-a is in production, service-b has no environment, and the resource without service is ignored.
+	require.Equal(t, 2, m.ResourceMetrics().Len())
+	m.ResourceMetrics().Sort(func(a, b pmetric.ResourceMetrics) bool {
+		return a.Resource().Attributes().Len() < b.Resource().Attributes().Len()
+	})
+	for i, tc := range []struct {
+		resourceAttrs map[string]interface{}
+		service       string
+		dps           int
+	}{
+		{
+			resourceAttrs: map[string]interface{}{regionResourceAttrName: sampleRegion},
+			service:       "service-b",
+			dps:           1,
+		},
+		{
+			resourceAttrs: map[string]interface{}{"deployment.environment": "production", regionResourceAttrName: sampleRegion},
+			service:       "service-a",
+			dps:           2,
+		},
+	} {
+		rm := m.ResourceMetrics().At(i)
+		assert.Equal(t, tc.resourceAttrs, rm.Resource().Attributes().AsRaw())
+
+		metrics := rm.ScopeMetrics().At(0).Metrics()
+		require.Equal(t, 2, metrics.Len())
+		assert.Equal(t, "tier1.calls_total", metrics.At(0).Name())
+		assert.Equal(t, "tier1.latency", metrics.At(1).Name())
+
+		dps := metrics.At(0).Sum().DataPoints()
+		require.Equal(t, tc.dps, dps.Len())
+		for j := 0; j < dps.Len(); j++ {
+			service, ok := dps.At(j).Attributes().Get(serviceNameKey)
+			require.True(t, ok)
+			assert.Equal(t, tc.service, service.Str())
+		}
+		assert.Equal(t, tc.dps, metrics.At(1).Histogram().DataPoints().Len())
+	}
+}
+
 func TestProcessorUpdateExemplars(t *testing.T) {
 	// ----- conditions -------------------------------------------------------
 	factory := NewFactory()
@@ -945,7 +999,7 @@ func TestProcessorUpdateExemplars(t *testing.T) {
 	value := float64(42)
 
 	// ----- call -------------------------------------------------------------
-	p.updateHistogram(key, value, traceID, spanID)
+	p.updateHistogram(key, "", value, traceID, spanID)
 
 	// ----- verify -----------------------------------------------------------
 	assert.NoError(t, err)
@@ -15,6 +15,8 @@
 package tailsamplingprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/tailsamplingprocessor"
 
 import (
+	"fmt"
+
 	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/tailsamplingprocessor/internal/sampling"
@@ -22,7 +24,10 @@ import (
 
 func getNewCompositePolicy(logger *zap.Logger, config *CompositeCfg) (sampling.PolicyEvaluator, error) {
 	var subPolicyEvalParams []sampling.SubPolicyEvalParams
-	rateAllocationsMap := getRateAllocationMap(config)
+	rateAllocationsMap, err := getRateAllocationMap(config)
+	if err != nil {
+		return nil, err
+	}
 	for i := range config.SubPolicyCfg {
 		policyCfg := &config.SubPolicyCfg[i]
 		policy, err := getCompositeSubPolicyEvaluator(logger, policyCfg)
@@ -36,23 +41,61 @@ func getNewCompositePolicy(logger *zap.Logger, config *CompositeCfg) (sampling.P
 		}
 		subPolicyEvalParams = append(subPolicyEvalParams, evalParams)
 	}
-	return sampling.NewComposite(logger, config.MaxTotalSpansPerSecond, subPolicyEvalParams, sampling.MonotonicClock{}), nil
+	return sampling.NewComposite(logger, config.MaxTotalSpansPerSecond, subPolicyEvalParams, config.Spillover, sampling.MonotonicClock{}), nil
 }
 
-// Apply rate allocations to the sub-policies
-func getRateAllocationMap(config *CompositeCfg) map[string]float64 {
-	rateAllocationsMap := make(map[string]float64)
-	maxTotalSPS := float64(config.MaxTotalSpansPerSecond)
-	// Default SPS determined by equally diving number of sub policies
-	defaultSPS := maxTotalSPS / float64(len(config.SubPolicyCfg))
+// Apply rate allocations to the sub-policies. Sub-policies with a percentage get that share of
+// max_total_spans_per_second, the remaining share is divided between the other sub-policies,
+// including the ones not listed in rate_allocation, in proportion to their weight.
+func getRateAllocationMap(config *CompositeCfg) (map[string]float64, error) {
+	subPolicies := make(map[string]struct{}, len(config.SubPolicyCfg))
+	for _, subPolicy := range config.SubPolicyCfg {
+		subPolicies[subPolicy.Name] = struct{}{}
+	}
+
+	percents := make(map[string]int64)
+	weights := make(map[string]int64)
+	var totalPercent int64
 	for _, rAlloc := range config.RateAllocation {
+		if _, ok := subPolicies[rAlloc.Policy]; !ok {
+			return nil, fmt.Errorf("rate allocation refers to unknown sub-policy %q", rAlloc.Policy)
+		}
+		if rAlloc.Percent < 0 || rAlloc.Weight < 0 {
+			return nil, fmt.Errorf("rate allocation of sub-policy %q must not be negative", rAlloc.Policy)
+		}
 		if rAlloc.Percent > 0 {
-			rateAllocationsMap[rAlloc.Policy] = (float64(rAlloc.Percent) / 100) * maxTotalSPS
+			percents[rAlloc.Policy] = rAlloc.Percent
+			totalPercent += rAlloc.Percent
+		} else if rAlloc.Weight > 0 {
+			weights[rAlloc.Policy] = rAlloc.Weight
+		}
+	}
+	if totalPercent > 100 {
+		return nil, fmt.Errorf("rate allocation percentages add up to %d%%, must not exceed 100%%", totalPercent)
+	}
+
+	var totalWeight int64
+	for _, subPolicy := range config.SubPolicyCfg {
+		if _, ok := percents[subPolicy.Name]; ok {
+			continue
+		}
+		if _, ok := weights[subPolicy.Name]; !ok {
+			weights[subPolicy.Name] = 1
+		}
+		totalWeight += weights[subPolicy.Name]
+	}
+
+	rateAllocationsMap := make(map[string]float64)
+	maxTotalSPS := float64(config.MaxTotalSpansPerSecond)
+	remainingSPS := (float64(100-totalPercent) / 100) * maxTotalSPS
+	for _, subPolicy := range config.SubPolicyCfg {
+		if percent, ok := percents[subPolicy.Name]; ok {
+			rateAllocationsMap[subPolicy.Name] = (float64(percent) / 100) * maxTotalSPS
 		} else {
-			rateAllocationsMap[rAlloc.Policy] = defaultSPS
+			rateAllocationsMap[subPolicy.Name] = remainingSPS * float64(weights[subPolicy.Name]) / float64(totalWeight)
 		}
 	}
-	return rateAllocationsMap
+	return rateAllocationsMap, nil
 }
 
 // Return instance of composite sub-policy
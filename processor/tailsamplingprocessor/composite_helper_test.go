@@ -52,7 +52,7 @@ func TestCompositeHelper(t *testing.T) {
 				},
 				{
 					Policy:  "test-composite-policy-2",
-					Percent: 0, // will be populated with default
+					Percent: 0, // will be allocated the remaining share
 				},
 			},
 		})
@@ -65,12 +65,90 @@ func TestCompositeHelper(t *testing.T) {
 			},
 			{
 				Evaluator:         sampling.NewLatency(zap.NewNop(), 200),
-				MaxSpansPerSecond: 500,
+				MaxSpansPerSecond: 750,
 			},
-		}, sampling.MonotonicClock{})
+		}, false, sampling.MonotonicClock{})
 		assert.Equal(t, expected, actual)
 	})
 
+	t.Run("weighted remaining share", func(t *testing.T) {
+		actual, err := getRateAllocationMap(&CompositeCfg{
+			MaxTotalSpansPerSecond: 1000,
+			SubPolicyCfg: []CompositeSubPolicyCfg{
+				{sharedPolicyCfg: sharedPolicyCfg{Name: "policy-1"}},
+				{sharedPolicyCfg: sharedPolicyCfg{Name: "policy-2"}},
+				{sharedPolicyCfg: sharedPolicyCfg{Name: "policy-3"}},
+				{sharedPolicyCfg: sharedPolicyCfg{Name: "policy-4"}},
+			},
+			RateAllocation: []RateAllocationCfg{
+				{Policy: "policy-1", Percent: 40},
+				{Policy: "policy-2", Weight: 3},
+				{Policy: "policy-3", Weight: 2},
+				// policy-4 is not listed and gets the default weight
+			},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]float64{
+			"policy-1": 400,
+			"policy-2": 300,
+			"policy-3": 200,
+			"policy-4": 100,
+		}, actual)
+	})
+
+	t.Run("no rate allocation", func(t *testing.T) {
+		actual, err := getRateAllocationMap(&CompositeCfg{
+			MaxTotalSpansPerSecond: 100,
+			SubPolicyCfg: []CompositeSubPolicyCfg{
+				{sharedPolicyCfg: sharedPolicyCfg{Name: "policy-1"}},
+				{sharedPolicyCfg: sharedPolicyCfg{Name: "policy-2"}},
+			},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]float64{"policy-1": 50, "policy-2": 50}, actual)
+	})
+
+	t.Run("percentages exceeding 100", func(t *testing.T) {
+		_, err := getNewCompositePolicy(zap.NewNop(), &CompositeCfg{
+			MaxTotalSpansPerSecond: 100,
+			SubPolicyCfg: []CompositeSubPolicyCfg{
+				{sharedPolicyCfg: sharedPolicyCfg{Name: "policy-1", Type: AlwaysSample}},
+				{sharedPolicyCfg: sharedPolicyCfg{Name: "policy-2", Type: AlwaysSample}},
+			},
+			RateAllocation: []RateAllocationCfg{
+				{Policy: "policy-1", Percent: 60},
+				{Policy: "policy-2", Percent: 50},
+			},
+		})
+		require.EqualError(t, err, "rate allocation percentages add up to 110%, must not exceed 100%")
+	})
+
+	t.Run("unknown sub-policy", func(t *testing.T) {
+		_, err := getNewCompositePolicy(zap.NewNop(), &CompositeCfg{
+			MaxTotalSpansPerSecond: 100,
+			SubPolicyCfg: []CompositeSubPolicyCfg{
+				{sharedPolicyCfg: sharedPolicyCfg{Name: "policy-1", Type: AlwaysSample}},
+			},
+			RateAllocation: []RateAllocationCfg{
+				{Policy: "policy-2", Percent: 50},
+			},
+		})
+		require.EqualError(t, err, `rate allocation refers to unknown sub-policy "policy-2"`)
+	})
+
+	t.Run("negative weight", func(t *testing.T) {
+		_, err := getNewCompositePolicy(zap.NewNop(), &CompositeCfg{
+			MaxTotalSpansPerSecond: 100,
+			SubPolicyCfg: []CompositeSubPolicyCfg{
+				{sharedPolicyCfg: sharedPolicyCfg{Name: "policy-1", Type: AlwaysSample}},
+			},
+			RateAllocation: []RateAllocationCfg{
+				{Policy: "policy-1", Weight: -1},
+			},
+		})
+		require.EqualError(t, err, `rate allocation of sub-policy "policy-1" must not be negative`)
+	})
+
 	t.Run("unsupported sampling policy type", func(t *testing.T) {
 		_, err := getNewCompositePolicy(zap.NewNop(), &CompositeCfg{
 			SubPolicyCfg: []CompositeSubPolicyCfg{
@@ -104,12 +104,19 @@ type CompositeCfg struct {
 	PolicyOrder            []string                `mapstructure:"policy_order"`
 	SubPolicyCfg           []CompositeSubPolicyCfg `mapstructure:"composite_sub_policy"`
 	RateAllocation         []RateAllocationCfg     `mapstructure:"rate_allocation"`
+	// Spillover allows a sub-policy exceeding its allocated rate to use the part of
+	// max_total_spans_per_second left unused by the other sub-policies in the current second.
+	Spillover bool `mapstructure:"spillover"`
 }
 
 // RateAllocationCfg  used within composite policy
 type RateAllocationCfg struct {
-	Policy  string `mapstructure:"policy"`
-	Percent int64  `mapstructure:"percent"`
+	Policy string `mapstructure:"policy"`
+	// Percent is the share of max_total_spans_per_second allocated to the sub-policy.
+	Percent int64 `mapstructure:"percent"`
+	// Weight is used when Percent is not set: the share not allocated with a percentage
+	// is divided between such sub-policies in proportion to their weight. Defaults to 1.
+	Weight int64 `mapstructure:"weight"`
 }
 
 // PolicyCfg holds the common configuration to all policies.
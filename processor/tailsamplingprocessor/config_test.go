@@ -170,7 +170,12 @@ func TestLoadConfig(t *testing.T) {
 								Policy:  "test-composite-policy-2",
 								Percent: 25,
 							},
+							{
+								Policy: "test-composite-policy-3",
+								Weight: 2,
+							},
 						},
+						Spillover: true,
 					},
 				},
 			},
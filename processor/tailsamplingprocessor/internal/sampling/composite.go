@@ -38,6 +38,12 @@ type Composite struct {
 	// maximum total spans per second that must be sampled
 	maxTotalSPS int64
 
+	// spans per second that all the subpolicies sampled in this period
+	totalSampledSPS int64
+
+	// whether a subpolicy can exceed its allocation using the unused total rate
+	spillover bool
+
 	// current unix timestamp second
 	currentSecond int64
 
@@ -60,6 +66,7 @@ func NewComposite(
 	logger *zap.Logger,
 	maxTotalSpansPerSecond int64,
 	subPolicyParams []SubPolicyEvalParams,
+	spillover bool,
 	timeProvider TimeProvider,
 ) PolicyEvaluator {
 
@@ -79,6 +86,7 @@ func NewComposite(
 	return &Composite{
 		maxTotalSPS:  maxTotalSpansPerSecond,
 		subpolicies:  subpolicies,
+		spillover:    spillover,
 		timeProvider: timeProvider,
 		logger:       logger,
 	}
@@ -92,17 +100,29 @@ func (c *Composite) Evaluate(traceID pcommon.TraceID, trace *TraceData) (Decisio
 	// once the limit is exceeded the traces are no longer sampled. The counter
 	// restarts at the beginning of each second.
 	// Current counters and rate limits are kept separately for each subpolicy.
+	// When a subpolicy exceeds its allocated rate the next subpolicies are evaluated,
+	// and, with spillover enabled, the trace can still be sampled as long as the
+	// total rate is not exceeded.
 
 	currSecond := c.timeProvider.getCurSecond()
 	if c.currentSecond != currSecond {
 		// This is a new second
 		c.currentSecond = currSecond
 		// Reset counters
+		c.totalSampledSPS = 0
 		for i := range c.subpolicies {
 			c.subpolicies[i].sampledSPS = 0
 		}
 	}
 
+	spanCount := trace.SpanCount.Load()
+	totalInSecondIfSampled := c.totalSampledSPS + spanCount
+	if totalInSecondIfSampled > c.maxTotalSPS {
+		// No subpolicy can sample this trace without exceeding the total rate.
+		return NotSampled, nil
+	}
+
+	var overAllocation *subpolicy
 	for _, sub := range c.subpolicies {
 		decision, err := sub.evaluator.Evaluate(traceID, trace)
 		if err != nil {
@@ -113,24 +133,35 @@ func (c *Composite) Evaluate(traceID pcommon.TraceID, trace *TraceData) (Decisio
 			// The subpolicy made a decision to Sample. Now we need to make our decision.
 
 			// Calculate resulting SPS counter if we decide to sample this trace
-			spansInSecondIfSampled := sub.sampledSPS + trace.SpanCount.Load()
+			spansInSecondIfSampled := sub.sampledSPS + spanCount
 
 			// Check if the rate will be within the allocated bandwidth.
-			if spansInSecondIfSampled <= sub.allocatedSPS && spansInSecondIfSampled <= c.maxTotalSPS {
+			if spansInSecondIfSampled <= sub.allocatedSPS {
 				sub.sampledSPS = spansInSecondIfSampled
+				c.totalSampledSPS = totalInSecondIfSampled
 
 				// Let the sampling happen
 				return Sampled, nil
 			}
 
-			// We exceeded the rate limit. Don't sample this trace.
-			// Note that we will continue evaluating new incoming traces against
-			// allocated SPS, we do not update sub.sampledSPS here in order to give
+			// We exceeded the rate limit of this subpolicy, give the next ones a
+			// chance to sample the trace within their own allocation.
+			// Note that we do not update sub.sampledSPS here in order to give
 			// chance to another smaller trace to be accepted later.
-			return NotSampled, nil
+			if overAllocation == nil {
+				overAllocation = sub
+			}
 		}
 	}
 
+	if c.spillover && overAllocation != nil {
+		// The total rate was checked above, so the trace fits in the budget
+		// left unused by the other subpolicies.
+		overAllocation.sampledSPS += spanCount
+		c.totalSampledSPS = totalInSecondIfSampled
+		return Sampled, nil
+	}
+
 	return NotSampled, nil
 }
 
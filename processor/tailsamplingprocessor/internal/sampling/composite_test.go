@@ -66,7 +66,7 @@ func TestCompositeEvaluatorNotSampled(t *testing.T) {
 	// Create 2 policies which do not match any trace
 	n1 := NewNumericAttributeFilter(zap.NewNop(), "tag", 0, 100)
 	n2 := NewNumericAttributeFilter(zap.NewNop(), "tag", 200, 300)
-	c := NewComposite(zap.NewNop(), 1000, []SubPolicyEvalParams{{n1, 100}, {n2, 100}}, FakeTimeProvider{})
+	c := NewComposite(zap.NewNop(), 1000, []SubPolicyEvalParams{{n1, 100}, {n2, 100}}, false, FakeTimeProvider{})
 
 	trace := createTrace()
 
@@ -84,7 +84,7 @@ func TestCompositeEvaluatorSampled(t *testing.T) {
 	// Create 2 subpolicies. First results in 100% NotSampled, the second in 100% Sampled.
 	n1 := NewNumericAttributeFilter(zap.NewNop(), "tag", 0, 100)
 	n2 := NewAlwaysSample(zap.NewNop())
-	c := NewComposite(zap.NewNop(), 1000, []SubPolicyEvalParams{{n1, 100}, {n2, 100}}, FakeTimeProvider{})
+	c := NewComposite(zap.NewNop(), 1000, []SubPolicyEvalParams{{n1, 100}, {n2, 100}}, false, FakeTimeProvider{})
 
 	trace := createTrace()
 
@@ -103,7 +103,7 @@ func TestCompositeEvaluator_OverflowAlwaysSampled(t *testing.T) {
 	// Create 2 subpolicies. First results in 100% NotSampled, the second in 100% Sampled.
 	n1 := NewNumericAttributeFilter(zap.NewNop(), "tag", 0, 100)
 	n2 := NewAlwaysSample(zap.NewNop())
-	c := NewComposite(zap.NewNop(), 3, []SubPolicyEvalParams{{n1, 1}, {n2, 1}}, timeProvider)
+	c := NewComposite(zap.NewNop(), 3, []SubPolicyEvalParams{{n1, 1}, {n2, 1}}, false, timeProvider)
 
 	trace := newTraceWithKV(traceID, "tag", int64(10))
 
@@ -119,25 +119,89 @@ func TestCompositeEvaluator_OverflowAlwaysSampled(t *testing.T) {
 	decision, err = c.Evaluate(traceID, trace)
 	require.NoError(t, err, "Failed to evaluate composite policy: %v", err)
 
-	// The first policy is NewNumericAttributeFilter and trace tag matches criteria, so the decision should be Sampled.
-	expected = NotSampled
+	// The first policy matches but exceeded its allocation, the second policy is AlwaysSample and still has room, so the decision should be Sampled.
+	expected = Sampled
 	assert.Equal(t, decision, expected)
 
 	trace = newTraceWithKV(traceID, "tag", int64(1001))
 	decision, err = c.Evaluate(traceID, trace)
 	require.NoError(t, err, "Failed to evaluate composite policy: %v", err)
 
-	// The first policy fails as the tag value is higher than the range set where as the second policy is AlwaysSample, so the decision should be Sampled.
-	expected = Sampled
+	// The first policy fails as the tag value is higher than the range set and the second policy exceeded its allocation, so the decision should be NotSampled.
+	expected = NotSampled
 	assert.Equal(t, decision, expected)
 }
 
+func TestCompositeEvaluatorSpillover(t *testing.T) {
+
+	timeProvider := &FakeTimeProvider{second: 0}
+
+	// The first policy matches all the traces, the second one none of them.
+	n1 := NewAlwaysSample(zap.NewNop())
+	n2 := NewNumericAttributeFilter(zap.NewNop(), "tag", 0, 100)
+	const totalSPS = 10
+	withoutSpillover := NewComposite(zap.NewNop(), totalSPS, []SubPolicyEvalParams{{n1, 4}, {n2, 6}}, false, timeProvider)
+	withSpillover := NewComposite(zap.NewNop(), totalSPS, []SubPolicyEvalParams{{n1, 4}, {n2, 6}}, true, timeProvider)
+
+	trace := createTrace()
+
+	countSampled := func(c PolicyEvaluator) int {
+		sampled := 0
+		for i := 0; i < 2*totalSPS; i++ {
+			decision, err := c.Evaluate(traceID, trace)
+			require.NoError(t, err, "Failed to evaluate composite policy: %v", err)
+			if decision == Sampled {
+				sampled++
+			}
+		}
+		return sampled
+	}
+
+	// Without spillover the first policy is limited to its allocation.
+	assert.Equal(t, 4, countSampled(withoutSpillover))
+
+	// With spillover it uses the allocation left unused by the second policy, up to the total rate.
+	assert.Equal(t, totalSPS, countSampled(withSpillover))
+
+	// Let the time advance by one second, the budget is restored.
+	timeProvider.second++
+	assert.Equal(t, totalSPS, countSampled(withSpillover))
+}
+
+func TestCompositeEvaluatorSpilloverTotalLimit(t *testing.T) {
+
+	timeProvider := &FakeTimeProvider{second: 0}
+
+	n1 := NewNumericAttributeFilter(zap.NewNop(), "tag", 0, 100)
+	n2 := NewAlwaysSample(zap.NewNop())
+	const totalSPS = 10
+	c := NewComposite(zap.NewNop(), totalSPS, []SubPolicyEvalParams{{n1, 5}, {n2, 5}}, true, timeProvider)
+
+	// The second policy samples the traces without tag within its allocation, then spills over.
+	for i := 0; i < 8; i++ {
+		decision, err := c.Evaluate(traceID, createTrace())
+		require.NoError(t, err, "Failed to evaluate composite policy: %v", err)
+		assert.Equal(t, Sampled, decision)
+	}
+
+	// Only the unused budget is left for the first policy, even if it did not use its allocation.
+	for i := 0; i < 4; i++ {
+		decision, err := c.Evaluate(traceID, newTraceWithKV(traceID, "tag", int64(10)))
+		require.NoError(t, err, "Failed to evaluate composite policy: %v", err)
+		expected := Sampled
+		if i >= 2 {
+			expected = NotSampled
+		}
+		assert.Equal(t, expected, decision)
+	}
+}
+
 func TestCompositeEvaluatorSampled_AlwaysSampled(t *testing.T) {
 
 	// Create 2 subpolicies. First results in 100% NotSampled, the second in 100% Sampled.
 	n1 := NewNumericAttributeFilter(zap.NewNop(), "tag", 0, 100)
 	n2 := NewAlwaysSample(zap.NewNop())
-	c := NewComposite(zap.NewNop(), 10, []SubPolicyEvalParams{{n1, 20}, {n2, 20}}, FakeTimeProvider{})
+	c := NewComposite(zap.NewNop(), 10, []SubPolicyEvalParams{{n1, 20}, {n2, 20}}, false, FakeTimeProvider{})
 
 	for i := 1; i <= 10; i++ {
 		trace := createTrace()
@@ -156,7 +220,7 @@ func TestCompositeEvaluatorInverseSampled_AlwaysSampled(t *testing.T) {
 	// The first policy does not match, the second matches through invert
 	n1 := NewStringAttributeFilter(zap.NewNop(), "tag", []string{"foo"}, false, 0, false)
 	n2 := NewStringAttributeFilter(zap.NewNop(), "tag", []string{"foo"}, false, 0, true)
-	c := NewComposite(zap.NewNop(), 10, []SubPolicyEvalParams{{n1, 20}, {n2, 20}}, FakeTimeProvider{})
+	c := NewComposite(zap.NewNop(), 10, []SubPolicyEvalParams{{n1, 20}, {n2, 20}}, false, FakeTimeProvider{})
 
 	for i := 1; i <= 10; i++ {
 		trace := createTrace()
@@ -176,7 +240,7 @@ func TestCompositeEvaluatorThrottling(t *testing.T) {
 	n1 := NewAlwaysSample(zap.NewNop())
 	timeProvider := &FakeTimeProvider{second: 0}
 	const totalSPS = 10
-	c := NewComposite(zap.NewNop(), totalSPS, []SubPolicyEvalParams{{n1, totalSPS}}, timeProvider)
+	c := NewComposite(zap.NewNop(), totalSPS, []SubPolicyEvalParams{{n1, totalSPS}}, false, timeProvider)
 
 	trace := createTrace()
 
@@ -217,7 +281,7 @@ func TestCompositeEvaluator2SubpolicyThrottling(t *testing.T) {
 	n2 := NewAlwaysSample(zap.NewNop())
 	timeProvider := &FakeTimeProvider{second: 0}
 	const totalSPS = 10
-	c := NewComposite(zap.NewNop(), totalSPS, []SubPolicyEvalParams{{n1, totalSPS / 2}, {n2, totalSPS / 2}}, timeProvider)
+	c := NewComposite(zap.NewNop(), totalSPS, []SubPolicyEvalParams{{n1, totalSPS / 2}, {n2, totalSPS / 2}}, false, timeProvider)
 
 	trace := createTrace()
 
@@ -0,0 +1,60 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logs // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor/internal/logs"
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottllog"
+)
+
+func moveBodyFieldToAttribute(field []string, attribute string) (ottl.ExprFunc[ottllog.TransformContext], error) {
+	if len(field) == 0 {
+		return nil, fmt.Errorf("field must contain at least one key")
+	}
+	if attribute == "" {
+		return nil, fmt.Errorf("attribute must not be empty")
+	}
+
+	return func(_ context.Context, tCtx ottllog.TransformContext) (interface{}, error) {
+		body := tCtx.GetLogRecord().Body()
+		if body.Type() != pcommon.ValueTypeMap {
+			return nil, nil
+		}
+
+		// Walk down the nested maps to the map holding the last key of the field.
+		parent := body.Map()
+		for _, key := range field[:len(field)-1] {
+			next, ok := parent.Get(key)
+			if !ok || next.Type() != pcommon.ValueTypeMap {
+				return nil, nil
+			}
+			parent = next.Map()
+		}
+
+		key := field[len(field)-1]
+		value, ok := parent.Get(key)
+		if !ok {
+			return nil, nil
+		}
+		value.CopyTo(tCtx.GetLogRecord().Attributes().PutEmpty(attribute))
+		parent.Remove(key)
+		return nil, nil
+	}, nil
+}
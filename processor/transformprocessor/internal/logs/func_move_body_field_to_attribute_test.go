@@ -0,0 +1,136 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottllog"
+)
+
+func Test_moveBodyFieldToAttribute(t *testing.T) {
+	input := plog.NewLogRecord()
+	body := input.Body().SetEmptyMap()
+	body.PutStr("message", "request served")
+	request := body.PutEmptyMap("http").PutEmptyMap("request")
+	request.PutStr("method", "GET")
+	request.PutInt("size", 512)
+	input.Attributes().PutStr("service", "checkout")
+
+	tests := []struct {
+		name      string
+		field     []string
+		attribute string
+		want      func(plog.LogRecord)
+	}{
+		{
+			name:      "top level field",
+			field:     []string{"message"},
+			attribute: "log.message",
+			want: func(log plog.LogRecord) {
+				log.Body().Map().Remove("message")
+				log.Attributes().PutStr("log.message", "request served")
+			},
+		},
+		{
+			name:      "nested field",
+			field:     []string{"http", "request", "method"},
+			attribute: "http.method",
+			want: func(log plog.LogRecord) {
+				requestBody, _ := log.Body().Map().Get("http")
+				requestMap, _ := requestBody.Map().Get("request")
+				requestMap.Map().Remove("method")
+				log.Attributes().PutStr("http.method", "GET")
+			},
+		},
+		{
+			name:      "map field",
+			field:     []string{"http"},
+			attribute: "http",
+			want: func(log plog.LogRecord) {
+				httpField, _ := log.Body().Map().Get("http")
+				httpField.CopyTo(log.Attributes().PutEmpty("http"))
+				log.Body().Map().Remove("http")
+			},
+		},
+		{
+			name:      "overwrite existing attribute",
+			field:     []string{"message"},
+			attribute: "service",
+			want: func(log plog.LogRecord) {
+				log.Body().Map().Remove("message")
+				log.Attributes().PutStr("service", "request served")
+			},
+		},
+		{
+			name:      "missing field",
+			field:     []string{"http", "response", "status"},
+			attribute: "http.status_code",
+			want:      func(log plog.LogRecord) {},
+		},
+		{
+			name:      "intermediate field is not a map",
+			field:     []string{"message", "text"},
+			attribute: "text",
+			want:      func(log plog.LogRecord) {},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			log := plog.NewLogRecord()
+			input.CopyTo(log)
+
+			exprFunc, err := moveBodyFieldToAttribute(tt.field, tt.attribute)
+			require.NoError(t, err)
+
+			_, err = exprFunc(context.Background(), ottllog.NewTransformContext(log, pcommon.NewInstrumentationScope(), pcommon.NewResource()))
+			require.NoError(t, err)
+
+			expected := plog.NewLogRecord()
+			input.CopyTo(expected)
+			tt.want(expected)
+
+			assert.Equal(t, expected, log)
+		})
+	}
+}
+
+func Test_moveBodyFieldToAttribute_StringBody(t *testing.T) {
+	log := plog.NewLogRecord()
+	log.Body().SetStr("request served")
+
+	exprFunc, err := moveBodyFieldToAttribute([]string{"message"}, "message")
+	require.NoError(t, err)
+
+	_, err = exprFunc(context.Background(), ottllog.NewTransformContext(log, pcommon.NewInstrumentationScope(), pcommon.NewResource()))
+	require.NoError(t, err)
+
+	assert.Equal(t, "request served", log.Body().Str())
+	assert.Equal(t, 0, log.Attributes().Len())
+}
+
+func Test_moveBodyFieldToAttribute_validation(t *testing.T) {
+	_, err := moveBodyFieldToAttribute(nil, "attribute")
+	assert.Error(t, err)
+
+	_, err = moveBodyFieldToAttribute([]string{"field"}, "")
+	assert.Error(t, err)
+}
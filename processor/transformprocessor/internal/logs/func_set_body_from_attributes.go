@@ -0,0 +1,62 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logs // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor/internal/logs"
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottllog"
+)
+
+func setBodyFromAttributes(attributes []string) (ottl.ExprFunc[ottllog.TransformContext], error) {
+	if len(attributes) == 0 {
+		return nil, fmt.Errorf("attributes must contain at least one key")
+	}
+
+	return func(_ context.Context, tCtx ottllog.TransformContext) (interface{}, error) {
+		attrs := tCtx.GetLogRecord().Attributes()
+
+		found := false
+		for _, attribute := range attributes {
+			if _, ok := attrs.Get(attribute); ok {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, nil
+		}
+
+		// Merge into an existing map body so that its other fields are kept, any other body is replaced.
+		body := tCtx.GetLogRecord().Body()
+		var fields pcommon.Map
+		if body.Type() == pcommon.ValueTypeMap {
+			fields = body.Map()
+		} else {
+			fields = body.SetEmptyMap()
+		}
+
+		for _, attribute := range attributes {
+			if value, ok := attrs.Get(attribute); ok {
+				value.CopyTo(fields.PutEmpty(attribute))
+			}
+		}
+		return nil, nil
+	}, nil
+}
@@ -0,0 +1,110 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottllog"
+)
+
+func Test_setBodyFromAttributes(t *testing.T) {
+	stringBody := plog.NewLogRecord()
+	stringBody.Body().SetStr("request served")
+	stringBody.Attributes().PutStr("http.method", "GET")
+	stringBody.Attributes().PutInt("http.status_code", 200)
+	stringBody.Attributes().PutEmptyMap("http.request").PutStr("path", "/health")
+
+	mapBody := plog.NewLogRecord()
+	mapBody.Body().SetEmptyMap().PutStr("message", "request served")
+	mapBody.Attributes().PutStr("http.method", "GET")
+
+	tests := []struct {
+		name       string
+		input      plog.LogRecord
+		attributes []string
+		want       func(plog.LogRecord)
+	}{
+		{
+			name:       "replace string body",
+			input:      stringBody,
+			attributes: []string{"http.method", "http.status_code"},
+			want: func(log plog.LogRecord) {
+				body := log.Body().SetEmptyMap()
+				body.PutStr("http.method", "GET")
+				body.PutInt("http.status_code", 200)
+			},
+		},
+		{
+			name:       "nested map attribute",
+			input:      stringBody,
+			attributes: []string{"http.request"},
+			want: func(log plog.LogRecord) {
+				log.Body().SetEmptyMap().PutEmptyMap("http.request").PutStr("path", "/health")
+			},
+		},
+		{
+			name:       "merge into map body",
+			input:      mapBody,
+			attributes: []string{"http.method"},
+			want: func(log plog.LogRecord) {
+				log.Body().Map().PutStr("http.method", "GET")
+			},
+		},
+		{
+			name:       "missing attributes are skipped",
+			input:      mapBody,
+			attributes: []string{"http.method", "http.path"},
+			want: func(log plog.LogRecord) {
+				log.Body().Map().PutStr("http.method", "GET")
+			},
+		},
+		{
+			name:       "no matching attribute",
+			input:      stringBody,
+			attributes: []string{"http.path"},
+			want:       func(log plog.LogRecord) {},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			log := plog.NewLogRecord()
+			tt.input.CopyTo(log)
+
+			exprFunc, err := setBodyFromAttributes(tt.attributes)
+			require.NoError(t, err)
+
+			_, err = exprFunc(context.Background(), ottllog.NewTransformContext(log, pcommon.NewInstrumentationScope(), pcommon.NewResource()))
+			require.NoError(t, err)
+
+			expected := plog.NewLogRecord()
+			tt.input.CopyTo(expected)
+			tt.want(expected)
+
+			assert.Equal(t, expected, log)
+		})
+	}
+}
+
+func Test_setBodyFromAttributes_validation(t *testing.T) {
+	_, err := setBodyFromAttributes(nil)
+	assert.Error(t, err)
+}
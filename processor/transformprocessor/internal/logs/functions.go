@@ -19,7 +19,19 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor/internal/common"
 )
 
+// registry is a map of names to functions for logs pipelines
+var registry = map[string]interface{}{
+	"move_body_field_to_attribute": moveBodyFieldToAttribute,
+	"set_body_from_attributes":     setBodyFromAttributes,
+}
+
+func init() {
+	// Init logs registry with default functions common to all signals
+	for k, v := range common.Functions[ottllog.TransformContext]() {
+		registry[k] = v
+	}
+}
+
 func LogFunctions() map[string]interface{} {
-	// No logs-only functions yet.
-	return common.Functions[ottllog.TransformContext]()
+	return registry
 }
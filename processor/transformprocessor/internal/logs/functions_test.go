@@ -26,6 +26,8 @@ import (
 
 func Test_LogFunctions(t *testing.T) {
 	expected := common.Functions[ottllog.TransformContext]()
+	expected["move_body_field_to_attribute"] = moveBodyFieldToAttribute
+	expected["set_body_from_attributes"] = setBodyFromAttributes
 	actual := LogFunctions()
 	require.Equal(t, len(expected), len(actual))
 	for k := range actual {
@@ -172,6 +172,14 @@ func Test_ProcessLogs_LogContext(t *testing.T) {
 				td.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(1).Attributes().PutStr("total.string", "345678")
 			},
 		},
+		{
+			statement: `set_body_from_attributes(["http.method", "http.path"]) where body == "operationA"`,
+			want: func(td plog.Logs) {
+				body := td.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Body().SetEmptyMap()
+				body.PutStr("http.method", "get")
+				body.PutStr("http.path", "/health")
+			},
+		},
 		{
 			statement: `set(attributes["test"], "pass") where dropped_attributes_count == 1`,
 			want: func(td plog.Logs) {
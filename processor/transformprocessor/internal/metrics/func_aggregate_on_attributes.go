@@ -0,0 +1,140 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor/internal/metrics"
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlmetric"
+)
+
+type aggregationFunc func(acc, v float64) float64
+
+var aggregationFuncs = map[string]aggregationFunc{
+	"sum":  func(acc, v float64) float64 { return acc + v },
+	"mean": func(acc, v float64) float64 { return acc + v },
+	"min":  math.Min,
+	"max":  math.Max,
+}
+
+func aggregateOnAttributes(attributes []string, function string) (ottl.ExprFunc[ottlmetric.TransformContext], error) {
+	aggregate, ok := aggregationFuncs[function]
+	if !ok {
+		return nil, fmt.Errorf("unknown aggregation function: %s", function)
+	}
+	keep := make(map[string]struct{}, len(attributes))
+	for _, attr := range attributes {
+		keep[attr] = struct{}{}
+	}
+
+	return func(_ context.Context, tCtx ottlmetric.TransformContext) (interface{}, error) {
+		metric := tCtx.GetMetric()
+		switch metric.Type() {
+		case pmetric.MetricTypeGauge:
+			aggregateNumberDataPoints(metric.Gauge().DataPoints(), keep, aggregate, function == "mean")
+		case pmetric.MetricTypeSum:
+			aggregateNumberDataPoints(metric.Sum().DataPoints(), keep, aggregate, function == "mean")
+		}
+		return nil, nil
+	}, nil
+}
+
+type aggregatedDataPoint struct {
+	dp     pmetric.NumberDataPoint
+	value  float64
+	count  int
+	double bool
+}
+
+// aggregateNumberDataPoints merges the data points that have the same value for the kept attributes,
+// dropping all other attributes. The merged data point has the earliest start timestamp and the
+// latest timestamp of the data points it aggregates.
+func aggregateNumberDataPoints(dps pmetric.NumberDataPointSlice, keep map[string]struct{}, aggregate aggregationFunc, mean bool) {
+	var order []string
+	groups := map[string]*aggregatedDataPoint{}
+	for i := 0; i < dps.Len(); i++ {
+		dp := dps.At(i)
+		dp.Attributes().RemoveIf(func(k string, _ pcommon.Value) bool {
+			_, ok := keep[k]
+			return !ok
+		})
+
+		v, double := numberValue(dp)
+		key := attributesKey(dp.Attributes())
+		g, ok := groups[key]
+		if !ok {
+			groups[key] = &aggregatedDataPoint{dp: dp, value: v, count: 1, double: double}
+			order = append(order, key)
+			continue
+		}
+		g.value = aggregate(g.value, v)
+		g.count++
+		g.double = g.double || double
+		if dp.StartTimestamp() < g.dp.StartTimestamp() {
+			g.dp.SetStartTimestamp(dp.StartTimestamp())
+		}
+		if dp.Timestamp() > g.dp.Timestamp() {
+			g.dp.SetTimestamp(dp.Timestamp())
+		}
+	}
+	if len(order) == dps.Len() {
+		return
+	}
+
+	aggregated := pmetric.NewNumberDataPointSlice()
+	for _, key := range order {
+		g := groups[key]
+		dp := aggregated.AppendEmpty()
+		g.dp.CopyTo(dp)
+		switch {
+		case mean:
+			dp.SetDoubleValue(g.value / float64(g.count))
+		case g.double:
+			dp.SetDoubleValue(g.value)
+		default:
+			dp.SetIntValue(int64(g.value))
+		}
+	}
+	aggregated.CopyTo(dps)
+}
+
+func numberValue(dp pmetric.NumberDataPoint) (float64, bool) {
+	if dp.ValueType() == pmetric.NumberDataPointValueTypeInt {
+		return float64(dp.IntValue()), false
+	}
+	return dp.DoubleValue(), true
+}
+
+func attributesKey(attrs pcommon.Map) string {
+	attrs.Sort()
+	var b strings.Builder
+	attrs.Range(func(k string, v pcommon.Value) bool {
+		b.WriteString(k)
+		b.WriteByte(0)
+		b.WriteString(v.Type().String())
+		b.WriteByte(0)
+		b.WriteString(v.AsString())
+		b.WriteByte(0)
+		return true
+	})
+	return b.String()
+}
@@ -0,0 +1,206 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlmetric"
+)
+
+func getAggregateTestSumMetric() pmetric.Metric {
+	metric := pmetric.NewMetric()
+	metric.SetName("sum_metric")
+	dps := metric.SetEmptySum().DataPoints()
+	for i, host := range []string{"a", "b", "a", "b"} {
+		dp := dps.AppendEmpty()
+		dp.Attributes().PutStr("service", "svc")
+		dp.Attributes().PutStr("host", host)
+		dp.Attributes().PutInt("pid", int64(i))
+		dp.SetIntValue(int64(i + 1))
+		dp.SetStartTimestamp(pcommon.Timestamp(100 - i))
+		dp.SetTimestamp(pcommon.Timestamp(200 + i))
+	}
+	return metric
+}
+
+func Test_AggregateOnAttributes(t *testing.T) {
+	tests := []struct {
+		name       string
+		input      pmetric.Metric
+		attributes []string
+		function   string
+		want       func(pmetric.NumberDataPointSlice)
+	}{
+		{
+			name:       "sum",
+			input:      getAggregateTestSumMetric(),
+			attributes: []string{"host"},
+			function:   "sum",
+			want: func(dps pmetric.NumberDataPointSlice) {
+				dp := dps.AppendEmpty()
+				dp.Attributes().PutStr("host", "a")
+				dp.SetIntValue(4)
+				dp.SetStartTimestamp(98)
+				dp.SetTimestamp(202)
+				dp = dps.AppendEmpty()
+				dp.Attributes().PutStr("host", "b")
+				dp.SetIntValue(6)
+				dp.SetStartTimestamp(97)
+				dp.SetTimestamp(203)
+			},
+		},
+		{
+			name:       "min",
+			input:      getAggregateTestSumMetric(),
+			attributes: []string{"host"},
+			function:   "min",
+			want: func(dps pmetric.NumberDataPointSlice) {
+				dp := dps.AppendEmpty()
+				dp.Attributes().PutStr("host", "a")
+				dp.SetIntValue(1)
+				dp.SetStartTimestamp(98)
+				dp.SetTimestamp(202)
+				dp = dps.AppendEmpty()
+				dp.Attributes().PutStr("host", "b")
+				dp.SetIntValue(2)
+				dp.SetStartTimestamp(97)
+				dp.SetTimestamp(203)
+			},
+		},
+		{
+			name:       "max of all",
+			input:      getAggregateTestSumMetric(),
+			attributes: []string{},
+			function:   "max",
+			want: func(dps pmetric.NumberDataPointSlice) {
+				dp := dps.AppendEmpty()
+				dp.SetIntValue(4)
+				dp.SetStartTimestamp(97)
+				dp.SetTimestamp(203)
+			},
+		},
+		{
+			name:       "mean",
+			input:      getAggregateTestSumMetric(),
+			attributes: []string{"service"},
+			function:   "mean",
+			want: func(dps pmetric.NumberDataPointSlice) {
+				dp := dps.AppendEmpty()
+				dp.Attributes().PutStr("service", "svc")
+				dp.SetDoubleValue(2.5)
+				dp.SetStartTimestamp(97)
+				dp.SetTimestamp(203)
+			},
+		},
+		{
+			name: "mixed values",
+			input: func() pmetric.Metric {
+				metric := getAggregateTestSumMetric()
+				metric.Sum().DataPoints().At(0).SetDoubleValue(0.5)
+				return metric
+			}(),
+			attributes: []string{"service"},
+			function:   "sum",
+			want: func(dps pmetric.NumberDataPointSlice) {
+				dp := dps.AppendEmpty()
+				dp.Attributes().PutStr("service", "svc")
+				dp.SetDoubleValue(9.5)
+				dp.SetStartTimestamp(97)
+				dp.SetTimestamp(203)
+			},
+		},
+		{
+			name:       "no aggregation",
+			input:      getAggregateTestSumMetric(),
+			attributes: []string{"pid"},
+			function:   "sum",
+			want: func(dps pmetric.NumberDataPointSlice) {
+				getAggregateTestSumMetric().Sum().DataPoints().CopyTo(dps)
+				for i := 0; i < dps.Len(); i++ {
+					dps.At(i).Attributes().Remove("service")
+					dps.At(i).Attributes().Remove("host")
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := aggregateOnAttributes(tt.attributes, tt.function)
+			require.NoError(t, err)
+
+			ctx := ottlmetric.NewTransformContext(tt.input, pcommon.NewInstrumentationScope(), pcommon.NewResource())
+			_, err = exprFunc(context.Background(), ctx)
+			require.NoError(t, err)
+
+			expected := pmetric.NewNumberDataPointSlice()
+			tt.want(expected)
+			actual := tt.input.Sum().DataPoints()
+			require.Equal(t, expected.Len(), actual.Len())
+			for i := 0; i < expected.Len(); i++ {
+				assert.Equal(t, expected.At(i).Attributes().AsRaw(), actual.At(i).Attributes().AsRaw())
+				assert.Equal(t, expected.At(i).ValueType(), actual.At(i).ValueType())
+				assert.Equal(t, expected.At(i).IntValue(), actual.At(i).IntValue())
+				assert.Equal(t, expected.At(i).DoubleValue(), actual.At(i).DoubleValue())
+				assert.Equal(t, expected.At(i).StartTimestamp(), actual.At(i).StartTimestamp())
+				assert.Equal(t, expected.At(i).Timestamp(), actual.At(i).Timestamp())
+			}
+		})
+	}
+}
+
+func Test_AggregateOnAttributes_gauge(t *testing.T) {
+	metric := pmetric.NewMetric()
+	dps := metric.SetEmptyGauge().DataPoints()
+	for _, v := range []float64{1.5, 3.5} {
+		dp := dps.AppendEmpty()
+		dp.Attributes().PutDouble("value", v)
+		dp.SetDoubleValue(v)
+	}
+
+	exprFunc, err := aggregateOnAttributes(nil, "max")
+	require.NoError(t, err)
+	_, err = exprFunc(context.Background(), ottlmetric.NewTransformContext(metric, pcommon.NewInstrumentationScope(), pcommon.NewResource()))
+	require.NoError(t, err)
+
+	require.Equal(t, 1, metric.Gauge().DataPoints().Len())
+	assert.Equal(t, 3.5, metric.Gauge().DataPoints().At(0).DoubleValue())
+	assert.Equal(t, 0, metric.Gauge().DataPoints().At(0).Attributes().Len())
+}
+
+func Test_AggregateOnAttributes_noop(t *testing.T) {
+	histogram := pmetric.NewMetric()
+	dp := histogram.SetEmptyHistogram().DataPoints().AppendEmpty()
+	dp.Attributes().PutStr("host", "a")
+	expected := pmetric.NewMetric()
+	histogram.CopyTo(expected)
+
+	exprFunc, err := aggregateOnAttributes(nil, "sum")
+	require.NoError(t, err)
+	_, err = exprFunc(context.Background(), ottlmetric.NewTransformContext(histogram, pcommon.NewInstrumentationScope(), pcommon.NewResource()))
+	require.NoError(t, err)
+	assert.Equal(t, expected, histogram)
+}
+
+func Test_AggregateOnAttributes_invalid(t *testing.T) {
+	_, err := aggregateOnAttributes([]string{"host"}, "median")
+	assert.EqualError(t, err, "unknown aggregation function: median")
+}
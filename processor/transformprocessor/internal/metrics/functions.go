@@ -28,11 +28,19 @@ var datapointRegistry = map[string]interface{}{
 	"convert_summary_count_val_to_sum": convertSummaryCountValToSum,
 }
 
+var metricRegistry = map[string]interface{}{
+	"aggregate_on_attributes": aggregateOnAttributes,
+}
+
 func init() {
 	// Init metrics registry with default functions common to all signals
 	for k, v := range common.Functions[ottldatapoint.TransformContext]() {
 		datapointRegistry[k] = v
 	}
+	// Init metrics registry with default functions common to all signals
+	for k, v := range common.Functions[ottlmetric.TransformContext]() {
+		metricRegistry[k] = v
+	}
 }
 
 func DataPointFunctions() map[string]interface{} {
@@ -40,5 +48,5 @@ func DataPointFunctions() map[string]interface{} {
 }
 
 func MetricFunctions() map[string]interface{} {
-	return common.Functions[ottlmetric.TransformContext]()
+	return metricRegistry
 }
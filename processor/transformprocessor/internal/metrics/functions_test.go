@@ -42,6 +42,7 @@ func Test_DataPointFunctions(t *testing.T) {
 
 func Test_MetricFunctions(t *testing.T) {
 	expected := common.Functions[ottlmetric.TransformContext]()
+	expected["aggregate_on_attributes"] = aggregateOnAttributes
 	actual := MetricFunctions()
 	require.Equal(t, len(expected), len(actual))
 	for k := range actual {
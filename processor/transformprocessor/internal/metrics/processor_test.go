@@ -104,6 +104,47 @@ func Test_ProcessMetrics_ScopeContext(t *testing.T) {
 	}
 }
 
+func Test_ProcessMetrics_MetricContext(t *testing.T) {
+	tests := []struct {
+		statement string
+		want      func(td pmetric.Metrics)
+	}{
+		{
+			statement: `aggregate_on_attributes(["attr1"], "max") where name == "operationA"`,
+			want: func(td pmetric.Metrics) {
+				dps := td.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Sum().DataPoints()
+				dps.RemoveIf(func(dp pmetric.NumberDataPoint) bool {
+					return dp.DoubleValue() == 1.0
+				})
+				dps.At(0).Attributes().RemoveIf(func(k string, _ pcommon.Value) bool {
+					return k != "attr1"
+				})
+			},
+		},
+		{
+			statement: `aggregate_on_attributes(["attr1"], "max") where name == "operationB"`,
+			want: func(td pmetric.Metrics) {
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.statement, func(t *testing.T) {
+			td := constructMetrics()
+			processor, err := NewProcessor([]common.ContextStatements{{Context: "metric", Statements: []string{tt.statement}}}, componenttest.NewNopTelemetrySettings())
+			assert.NoError(t, err)
+
+			_, err = processor.ProcessMetrics(context.Background(), td)
+			assert.NoError(t, err)
+
+			exTd := constructMetrics()
+			tt.want(exTd)
+
+			assert.Equal(t, exTd, td)
+		})
+	}
+}
+
 func Test_ProcessMetrics_DataPointContext(t *testing.T) {
 	tests := []struct {
 		statements []string
@@ -169,6 +169,11 @@ func (w mockPerfCounterWatcher) ScrapeData() ([]winperfcounters.CounterValue, er
 	}, nil
 }
 
+// ScrapeRawValues panics; It should not be called
+func (mockPerfCounterWatcher) ScrapeRawValues() ([]winperfcounters.RawCounterValue, error) {
+	panic("mockPerfCounterWatcher::ScrapeRawValues is not implemented")
+}
+
 // Close all counters/handles related to the query and free all associated memory.
 func (w mockPerfCounterWatcher) Close() error {
 	if w.closed {
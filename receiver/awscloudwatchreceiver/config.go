@@ -20,6 +20,7 @@ import (
 	"net/url"
 	"time"
 
+	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/confmap"
 	"go.uber.org/multierr"
 )
@@ -36,6 +37,9 @@ type Config struct {
 	Profile      string      `mapstructure:"profile"`
 	IMDSEndpoint string      `mapstructure:"imds_endpoint"`
 	Logs         *LogsConfig `mapstructure:"logs"`
+	// StorageID is the ID of a storage extension used to persist the ingestion offset of each log group,
+	// so that the collection resumes where it stopped after a restart.
+	StorageID *component.ID `mapstructure:"storage"`
 }
 
 // LogsConfig is the configuration for the logs portion of this receiver
@@ -53,9 +57,13 @@ type GroupConfig struct {
 
 // AutodiscoverConfig is the configuration for the autodiscovery functionality of log groups
 type AutodiscoverConfig struct {
-	Prefix  string       `mapstructure:"prefix"`
-	Limit   int          `mapstructure:"limit"`
-	Streams StreamConfig `mapstructure:"streams"`
+	Prefix string `mapstructure:"prefix"`
+	// Prefixes allows discovering the log groups matching any of several prefixes.
+	Prefixes []string `mapstructure:"prefixes"`
+	// Tags restricts the discovery to the log groups having all of the tags. An empty value matches any value.
+	Tags    map[string]string `mapstructure:"tags"`
+	Limit   int               `mapstructure:"limit"`
+	Streams StreamConfig      `mapstructure:"streams"`
 }
 
 // StreamConfig represents the configuration for the log stream filtering
@@ -71,6 +79,8 @@ var (
 	errInvalidPollInterval            = errors.New("poll interval is incorrect, it must be a duration greater than one second")
 	errInvalidAutodiscoverLimit       = errors.New("the limit of autodiscovery of log groups is improperly configured, value must be greater than 0")
 	errAutodiscoverAndNamedConfigured = errors.New("both autodiscover and named configs are configured, Only one or the other is permitted")
+	errPrefixAndPrefixesConfigured    = errors.New("both prefix and prefixes are configured for autodiscovery, Only one or the other is permitted")
+	errEmptyAutodiscoverTagKey        = errors.New("the tags of autodiscovery of log groups must not have an empty key")
 )
 
 // Validate validates all portions of the relevant config
@@ -139,5 +149,11 @@ func validateAutodiscover(cfg AutodiscoverConfig) error {
 	if cfg.Limit <= 0 {
 		return errInvalidAutodiscoverLimit
 	}
+	if cfg.Prefix != "" && len(cfg.Prefixes) > 0 {
+		return errPrefixAndPrefixesConfigured
+	}
+	if _, ok := cfg.Tags[""]; ok {
+		return errEmptyAutodiscoverTagKey
+	}
 	return nil
 }
@@ -136,6 +136,41 @@ func TestValidate(t *testing.T) {
 			},
 			expectedErr: errAutodiscoverAndNamedConfigured,
 		},
+		{
+			name: "Both Autodiscover Prefix and Prefixes Set",
+			config: Config{
+				Region: "us-east-1",
+				Logs: &LogsConfig{
+					MaxEventsPerRequest: defaultEventLimit,
+					PollInterval:        defaultPollInterval,
+					Groups: GroupConfig{
+						AutodiscoverConfig: &AutodiscoverConfig{
+							Limit:    defaultLogGroupLimit,
+							Prefix:   "/aws/eks/",
+							Prefixes: []string{"/aws/lambda/"},
+						},
+					},
+				},
+			},
+			expectedErr: errPrefixAndPrefixesConfigured,
+		},
+		{
+			name: "Empty Autodiscover Tag Key",
+			config: Config{
+				Region: "us-east-1",
+				Logs: &LogsConfig{
+					MaxEventsPerRequest: defaultEventLimit,
+					PollInterval:        defaultPollInterval,
+					Groups: GroupConfig{
+						AutodiscoverConfig: &AutodiscoverConfig{
+							Limit: defaultLogGroupLimit,
+							Tags:  map[string]string{"": "payments"},
+						},
+					},
+				},
+			},
+			expectedErr: errEmptyAutodiscoverTagKey,
+		},
 	}
 
 	for _, tc := range cases {
@@ -154,6 +189,7 @@ func TestLoadConfig(t *testing.T) {
 	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
 	require.NoError(t, err)
 
+	storageID := component.NewID("file_storage")
 	cases := []struct {
 		name           string
 		expectedConfig component.Config
@@ -225,6 +261,24 @@ func TestLoadConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "autodiscover-tags",
+			expectedConfig: &Config{
+				Region:    "us-west-1",
+				StorageID: &storageID,
+				Logs: &LogsConfig{
+					PollInterval:        time.Minute,
+					MaxEventsPerRequest: defaultEventLimit,
+					Groups: GroupConfig{
+						AutodiscoverConfig: &AutodiscoverConfig{
+							Limit:    500,
+							Prefixes: []string{"/aws/eks/", "/aws/lambda/"},
+							Tags:     map[string]string{"team": "payments", "environment": ""},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "named-prefix",
 			expectedConfig: &Config{
@@ -43,7 +43,7 @@ func createLogsReceiver(
 	consumer consumer.Logs,
 ) (receiver.Logs, error) {
 	cfg := rConf.(*Config)
-	rcvr := newLogsReceiver(cfg, params.Logger, consumer)
+	rcvr := newLogsReceiver(cfg, params, consumer)
 	return rcvr, nil
 }
 
@@ -17,35 +17,55 @@ package awscloudwatchreceiver // import "github.com/open-telemetry/opentelemetry
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/extension/experimental/storage"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/receiver"
 	"go.uber.org/multierr"
 	"go.uber.org/zap"
 )
 
+const (
+	// maxLogGroupsPerPage is the maximum number of log groups returned by a DescribeLogGroups request.
+	maxLogGroupsPerPage  = 50
+	logGroupResourceType = "logs:log-group"
+	offsetStoragePrefix  = "offset/"
+)
+
 type logsReceiver struct {
+	id                  component.ID
 	region              string
 	profile             string
 	imdsEndpoint        string
 	pollInterval        time.Duration
 	maxEventsPerRequest int
-	nextStartTime       time.Time
 	groupRequests       []groupRequest
 	autodiscover        *AutodiscoverConfig
 	logger              *zap.Logger
 	client              client
+	taggingClient       taggingClient
 	consumer            consumer.Logs
 	wg                  *sync.WaitGroup
 	doneChan            chan bool
+
+	storageID     *component.ID
+	storageClient storage.Client
+	// offsets hold the time up to which the events of each log group have been collected.
+	offsets map[string]time.Time
 }
 
 type client interface {
@@ -53,6 +73,10 @@ type client interface {
 	FilterLogEventsWithContext(ctx context.Context, input *cloudwatchlogs.FilterLogEventsInput, opts ...request.Option) (*cloudwatchlogs.FilterLogEventsOutput, error)
 }
 
+type taggingClient interface {
+	GetResourcesWithContext(ctx context.Context, input *resourcegroupstaggingapi.GetResourcesInput, opts ...request.Option) (*resourcegroupstaggingapi.GetResourcesOutput, error)
+}
+
 type streamNames struct {
 	group string
 	names []*string
@@ -106,7 +130,7 @@ type groupRequest interface {
 	groupName() string
 }
 
-func newLogsReceiver(cfg *Config, logger *zap.Logger, consumer consumer.Logs) *logsReceiver {
+func newLogsReceiver(cfg *Config, params receiver.CreateSettings, consumer consumer.Logs) *logsReceiver {
 	groups := []groupRequest{}
 	for logGroupName, sc := range cfg.Logs.Groups.NamedConfigs {
 		for _, prefix := range sc.Prefixes {
@@ -122,6 +146,7 @@ func newLogsReceiver(cfg *Config, logger *zap.Logger, consumer consumer.Logs) *l
 	}
 
 	return &logsReceiver{
+		id:                  params.ID,
 		region:              cfg.Region,
 		profile:             cfg.Profile,
 		consumer:            consumer,
@@ -129,16 +154,23 @@ func newLogsReceiver(cfg *Config, logger *zap.Logger, consumer consumer.Logs) *l
 		imdsEndpoint:        cfg.IMDSEndpoint,
 		autodiscover:        autodiscover,
 		pollInterval:        cfg.Logs.PollInterval,
-		nextStartTime:       time.Now().Add(-cfg.Logs.PollInterval),
 		groupRequests:       groups,
-		logger:              logger,
+		logger:              params.Logger,
 		wg:                  &sync.WaitGroup{},
 		doneChan:            make(chan bool),
+		storageID:           cfg.StorageID,
+		offsets:             map[string]time.Time{},
 	}
 }
 
 func (l *logsReceiver) Start(ctx context.Context, host component.Host) error {
 	l.logger.Debug("starting to poll for Cloudwatch logs")
+	storageClient, err := getStorageClient(ctx, host, l.storageID, l.id)
+	if err != nil {
+		return fmt.Errorf("failed to set up storage: %w", err)
+	}
+	l.storageClient = storageClient
+
 	l.wg.Add(1)
 	go l.startPolling(ctx)
 	return nil
@@ -148,6 +180,9 @@ func (l *logsReceiver) Shutdown(ctx context.Context) error {
 	l.logger.Debug("shutting down logs receiver")
 	close(l.doneChan)
 	l.wg.Wait()
+	if l.storageClient != nil {
+		return l.storageClient.Close(ctx)
+	}
 	return nil
 }
 
@@ -179,19 +214,74 @@ func (l *logsReceiver) startPolling(ctx context.Context) {
 	}
 }
 
+// poll collects the events of each log group since its offset, and moves the offsets of the
+// log groups whose events were all collected.
 func (l *logsReceiver) poll(ctx context.Context) error {
 	var errs error
-	startTime := l.nextStartTime
 	endTime := time.Now()
+	failed := map[string]bool{}
 	for _, r := range l.groupRequests {
-		if err := l.pollForLogs(ctx, r, startTime, endTime); err != nil {
+		startTime, err := l.offset(ctx, r.groupName(), endTime)
+		if err == nil {
+			err = l.pollForLogs(ctx, r, startTime, endTime)
+		}
+		if err != nil {
 			errs = multierr.Append(errs, err)
+			failed[r.groupName()] = true
+		}
+	}
+
+	select {
+	case <-l.doneChan:
+		// the collection may have been interrupted, the offsets are kept as they are
+		return errs
+	default:
+	}
+
+	saved := map[string]bool{}
+	for _, r := range l.groupRequests {
+		group := r.groupName()
+		if failed[group] || saved[group] {
+			continue
 		}
+		saved[group] = true
+		errs = multierr.Append(errs, l.saveOffset(ctx, group, endTime))
 	}
-	l.nextStartTime = endTime
 	return errs
 }
 
+// offset returns the time from which the events of the log group are collected: the end of the
+// last poll of the log group, possibly persisted by a previous run, or one poll interval ago.
+func (l *logsReceiver) offset(ctx context.Context, group string, now time.Time) (time.Time, error) {
+	if offset, ok := l.offsets[group]; ok {
+		return offset, nil
+	}
+
+	offset := now.Add(-l.pollInterval)
+	data, err := l.storageClient.Get(ctx, offsetStoragePrefix+group)
+	if err != nil {
+		return offset, fmt.Errorf("unable to read the offset of log group %s: %w", group, err)
+	}
+	if data != nil {
+		millis, err := strconv.ParseInt(string(data), 10, 64)
+		if err != nil {
+			return offset, fmt.Errorf("invalid offset of log group %s: %w", group, err)
+		}
+		offset = time.UnixMilli(millis)
+	}
+	l.offsets[group] = offset
+	return offset, nil
+}
+
+func (l *logsReceiver) saveOffset(ctx context.Context, group string, offset time.Time) error {
+	l.offsets[group] = offset
+	err := l.storageClient.Set(ctx, offsetStoragePrefix+group, []byte(strconv.FormatInt(offset.UnixMilli(), 10)))
+	if err != nil {
+		return fmt.Errorf("unable to persist the offset of log group %s: %w", group, err)
+	}
+	return nil
+}
+
 func (l *logsReceiver) pollForLogs(ctx context.Context, pc groupRequest, startTime, endTime time.Time) error {
 	err := l.ensureSession()
 	if err != nil {
@@ -210,15 +300,13 @@ func (l *logsReceiver) pollForLogs(ctx context.Context, pc groupRequest, startTi
 			input := pc.request(l.maxEventsPerRequest, *nextToken, &startTime, &endTime)
 			resp, err := l.client.FilterLogEventsWithContext(ctx, input)
 			if err != nil {
-				l.logger.Error("unable to retrieve logs from cloudwatch", zap.String("log group", pc.groupName()), zap.Error(err))
-				break
+				return fmt.Errorf("unable to retrieve logs of log group %s from cloudwatch: %w", pc.groupName(), err)
 			}
 			observedTime := pcommon.NewTimestampFromTime(time.Now())
 			logs := l.processEvents(observedTime, pc.groupName(), resp)
 			if logs.LogRecordCount() > 0 {
 				if err = l.consumer.ConsumeLogs(ctx, logs); err != nil {
-					l.logger.Error("unable to consume logs", zap.Error(err))
-					break
+					return fmt.Errorf("unable to consume logs of log group %s: %w", pc.groupName(), err)
 				}
 			}
 			nextToken = resp.NextToken
@@ -271,52 +359,164 @@ func (l *logsReceiver) discoverGroups(ctx context.Context, auto *AutodiscoverCon
 		return groups, fmt.Errorf("unable to establish a session to auto discover log groups: %w", err)
 	}
 
-	numGroups := 0
-	var nextToken = aws.String("")
-	for nextToken != nil {
-		if numGroups > auto.Limit {
-			break
-		}
+	prefixes := auto.Prefixes
+	if auto.Prefix != "" {
+		prefixes = []string{auto.Prefix}
+	}
+
+	var names []string
+	if len(auto.Tags) > 0 {
+		names, err = l.discoverTaggedGroups(ctx, auto.Tags, prefixes, auto.Limit)
+	} else {
+		names, err = l.describeGroups(ctx, prefixes, auto.Limit)
+	}
+	if err != nil {
+		return groups, err
+	}
 
-		limit := int64(auto.Limit)
-		req := &cloudwatchlogs.DescribeLogGroupsInput{
-			Limit: aws.Int64(limit),
+	for _, name := range names {
+		l.logger.Debug("discovered log group", zap.String("log group", name))
+		// default behavior is to collect all if not stream filtered
+		if len(auto.Streams.Names) == 0 && len(auto.Streams.Prefixes) == 0 {
+			groups = append(groups, &streamNames{group: name})
+			continue
 		}
 
-		if auto.Prefix != "" {
-			req.LogGroupNamePrefix = &auto.Prefix
+		for _, prefix := range auto.Streams.Prefixes {
+			groups = append(groups, &streamPrefix{group: name, prefix: prefix})
 		}
 
-		dlgResults, err := l.client.DescribeLogGroupsWithContext(ctx, req)
-		if err != nil {
-			return groups, fmt.Errorf("unable to list log groups: %w", err)
+		if len(auto.Streams.Names) > 0 {
+			groups = append(groups, &streamNames{group: name, names: auto.Streams.Names})
 		}
+	}
+	return groups, nil
+}
 
-		for _, lg := range dlgResults.LogGroups {
-			numGroups++
-			l.logger.Debug("discovered log group", zap.String("log group", lg.GoString()))
-			// default behavior is to collect all if not stream filtered
-			if len(auto.Streams.Names) == 0 && len(auto.Streams.Prefixes) == 0 {
-				groups = append(groups, &streamNames{group: *lg.LogGroupName})
-				continue
+// describeGroups lists up to limit log groups whose name starts with any of the prefixes.
+func (l *logsReceiver) describeGroups(ctx context.Context, prefixes []string, limit int) ([]string, error) {
+	if len(prefixes) == 0 {
+		prefixes = []string{""}
+	}
+
+	names := []string{}
+	seen := map[string]bool{}
+	for _, prefix := range prefixes {
+		var nextToken = aws.String("")
+		for nextToken != nil && len(names) < limit {
+			req := &cloudwatchlogs.DescribeLogGroupsInput{
+				Limit: aws.Int64(int64(minInt(limit, maxLogGroupsPerPage))),
+			}
+			if prefix != "" {
+				req.LogGroupNamePrefix = aws.String(prefix)
+			}
+			if *nextToken != "" {
+				req.NextToken = nextToken
+			}
+
+			dlgResults, err := l.client.DescribeLogGroupsWithContext(ctx, req)
+			if err != nil {
+				return names, fmt.Errorf("unable to list log groups: %w", err)
 			}
 
-			for _, prefix := range auto.Streams.Prefixes {
-				groups = append(groups, &streamPrefix{group: *lg.LogGroupName, prefix: prefix})
+			for _, lg := range dlgResults.LogGroups {
+				if len(names) >= limit {
+					break
+				}
+				if lg.LogGroupName == nil || seen[*lg.LogGroupName] {
+					continue
+				}
+				seen[*lg.LogGroupName] = true
+				names = append(names, *lg.LogGroupName)
 			}
+			nextToken = dlgResults.NextToken
+		}
+	}
+	return names, nil
+}
+
+// discoverTaggedGroups lists up to limit log groups having all the tags, and whose name starts with
+// any of the prefixes if any. The log groups are retrieved with the Resource Groups Tagging API, so
+// that they don't need to be described and listed one by one.
+func (l *logsReceiver) discoverTaggedGroups(ctx context.Context, tags map[string]string, prefixes []string, limit int) ([]string, error) {
+	req := &resourcegroupstaggingapi.GetResourcesInput{
+		ResourceTypeFilters: []*string{aws.String(logGroupResourceType)},
+	}
+	for key, value := range tags {
+		filter := &resourcegroupstaggingapi.TagFilter{Key: aws.String(key)}
+		if value != "" {
+			filter.Values = []*string{aws.String(value)}
+		}
+		req.TagFilters = append(req.TagFilters, filter)
+	}
 
-			if len(auto.Streams.Names) > 0 {
-				groups = append(groups, &streamNames{group: *lg.LogGroupName, names: auto.Streams.Names})
+	names := []string{}
+	for {
+		resp, err := l.taggingClient.GetResourcesWithContext(ctx, req)
+		if err != nil {
+			return nil, fmt.Errorf("unable to list tagged log groups: %w", err)
+		}
+		for _, mapping := range resp.ResourceTagMappingList {
+			if mapping.ResourceARN == nil {
+				continue
+			}
+			name, err := logGroupName(*mapping.ResourceARN)
+			if err != nil {
+				l.logger.Warn("unable to parse the ARN of a tagged log group", zap.String("arn", *mapping.ResourceARN), zap.Error(err))
+				continue
 			}
+			if hasAnyPrefix(name, prefixes) {
+				names = append(names, name)
+			}
+		}
+		if resp.PaginationToken == nil || *resp.PaginationToken == "" {
+			break
 		}
-		nextToken = dlgResults.NextToken
+		req.PaginationToken = resp.PaginationToken
 	}
 
-	return groups, nil
+	// sort so that the same log groups are kept when there are more than the limit
+	sort.Strings(names)
+	if len(names) > limit {
+		names = names[:limit]
+	}
+	return names, nil
+}
+
+// logGroupName returns the name of the log group with the given ARN,
+// e.g. arn:aws:logs:us-west-1:123456789012:log-group:/aws/eks/dev-0/cluster:*
+func logGroupName(resourceARN string) (string, error) {
+	parsed, err := arn.Parse(resourceARN)
+	if err != nil {
+		return "", err
+	}
+	if !strings.HasPrefix(parsed.Resource, "log-group:") {
+		return "", fmt.Errorf("not a log group: %s", parsed.Resource)
+	}
+	return strings.TrimSuffix(strings.TrimPrefix(parsed.Resource, "log-group:"), ":*"), nil
+}
+
+func hasAnyPrefix(name string, prefixes []string) bool {
+	if len(prefixes) == 0 {
+		return true
+	}
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
 }
 
 func (l *logsReceiver) ensureSession() error {
-	if l.client != nil {
+	if l.client != nil && l.taggingClient != nil {
 		return nil
 	}
 	awsConfig := aws.NewConfig().WithRegion(l.region)
@@ -330,6 +530,32 @@ func (l *logsReceiver) ensureSession() error {
 		options.Profile = l.profile
 	}
 	s, err := session.NewSessionWithOptions(options)
-	l.client = cloudwatchlogs.New(s)
-	return err
+	if err != nil {
+		return err
+	}
+	if l.client == nil {
+		l.client = cloudwatchlogs.New(s)
+	}
+	if l.taggingClient == nil {
+		l.taggingClient = resourcegroupstaggingapi.New(s)
+	}
+	return nil
+}
+
+func getStorageClient(ctx context.Context, host component.Host, storageID *component.ID, componentID component.ID) (storage.Client, error) {
+	if storageID == nil {
+		return storage.NewNopClient(), nil
+	}
+
+	extension, ok := host.GetExtensions()[*storageID]
+	if !ok {
+		return nil, fmt.Errorf("storage extension '%s' not found", storageID)
+	}
+
+	storageExtension, ok := extension.(storage.Extension)
+	if !ok {
+		return nil, fmt.Errorf("non-storage extension '%s' found", storageID)
+	}
+
+	return storageExtension.GetClient(ctx, component.KindReceiver, componentID, "")
 }
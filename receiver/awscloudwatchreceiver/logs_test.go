@@ -16,21 +16,26 @@ package awscloudwatchreceiver // import "github.com/open-telemetry/opentelemetry
 
 import (
 	"context"
+	"errors"
 	"io"
 	"os"
 	"path/filepath"
+	"strconv"
 	"testing"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/extension/experimental/storage"
 	"go.opentelemetry.io/collector/pdata/plog"
-	"go.uber.org/zap"
+	"go.opentelemetry.io/collector/receiver/receivertest"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/comparetest"
 )
@@ -41,7 +46,7 @@ func TestStart(t *testing.T) {
 	cfg.Logs.Groups.AutodiscoverConfig = nil
 
 	sink := &consumertest.LogsSink{}
-	logsRcvr := newLogsReceiver(cfg, zap.NewNop(), sink)
+	logsRcvr := newLogsReceiver(cfg, receivertest.NewNopCreateSettings(), sink)
 
 	err := logsRcvr.Start(context.Background(), componenttest.NewNopHost())
 	require.NoError(t, err)
@@ -63,7 +68,7 @@ func TestPrefixedConfig(t *testing.T) {
 	}
 
 	sink := &consumertest.LogsSink{}
-	alertRcvr := newLogsReceiver(cfg, zap.NewNop(), sink)
+	alertRcvr := newLogsReceiver(cfg, receivertest.NewNopCreateSettings(), sink)
 	alertRcvr.client = defaultMockClient()
 
 	err := alertRcvr.Start(context.Background(), componenttest.NewNopHost())
@@ -95,7 +100,7 @@ func TestPrefixedNamedStreamsConfig(t *testing.T) {
 	}
 
 	sink := &consumertest.LogsSink{}
-	alertRcvr := newLogsReceiver(cfg, zap.NewNop(), sink)
+	alertRcvr := newLogsReceiver(cfg, receivertest.NewNopCreateSettings(), sink)
 	alertRcvr.client = defaultMockClient()
 
 	err := alertRcvr.Start(context.Background(), componenttest.NewNopHost())
@@ -129,7 +134,7 @@ func TestDiscovery(t *testing.T) {
 	}
 
 	sink := &consumertest.LogsSink{}
-	logsRcvr := newLogsReceiver(cfg, zap.NewNop(), sink)
+	logsRcvr := newLogsReceiver(cfg, receivertest.NewNopCreateSettings(), sink)
 	logsRcvr.client = defaultMockClient()
 
 	require.NoError(t, logsRcvr.Start(context.Background(), componenttest.NewNopHost()))
@@ -140,6 +145,138 @@ func TestDiscovery(t *testing.T) {
 	require.NoError(t, logsRcvr.Shutdown(context.Background()))
 }
 
+func TestDiscoveryPrefixes(t *testing.T) {
+	sink := &consumertest.LogsSink{}
+	logsRcvr := newLogsReceiver(createDefaultConfig().(*Config), receivertest.NewNopCreateSettings(), sink)
+	mc := &mockClient{}
+	mc.On("DescribeLogGroupsWithContext", mock.Anything, &cloudwatchlogs.DescribeLogGroupsInput{
+		Limit:              aws.Int64(3),
+		LogGroupNamePrefix: aws.String("/aws/eks/"),
+	}, mock.Anything).Return(&cloudwatchlogs.DescribeLogGroupsOutput{
+		LogGroups: []*cloudwatchlogs.LogGroup{
+			{LogGroupName: aws.String("/aws/eks/dev-0/cluster")},
+		},
+		NextToken: aws.String("next"),
+	}, nil)
+	mc.On("DescribeLogGroupsWithContext", mock.Anything, &cloudwatchlogs.DescribeLogGroupsInput{
+		Limit:              aws.Int64(3),
+		LogGroupNamePrefix: aws.String("/aws/eks/"),
+		NextToken:          aws.String("next"),
+	}, mock.Anything).Return(&cloudwatchlogs.DescribeLogGroupsOutput{
+		LogGroups: []*cloudwatchlogs.LogGroup{
+			{LogGroupName: aws.String("/aws/eks/prod-0/cluster")},
+		},
+	}, nil)
+	mc.On("DescribeLogGroupsWithContext", mock.Anything, &cloudwatchlogs.DescribeLogGroupsInput{
+		Limit:              aws.Int64(3),
+		LogGroupNamePrefix: aws.String("/aws/lambda/"),
+	}, mock.Anything).Return(&cloudwatchlogs.DescribeLogGroupsOutput{
+		LogGroups: []*cloudwatchlogs.LogGroup{
+			{LogGroupName: aws.String("/aws/lambda/one")},
+			{LogGroupName: aws.String("/aws/lambda/two")},
+		},
+	}, nil)
+	logsRcvr.client = mc
+	logsRcvr.taggingClient = &mockTaggingClient{}
+
+	groups, err := logsRcvr.discoverGroups(context.Background(), &AutodiscoverConfig{
+		Limit:    3,
+		Prefixes: []string{"/aws/eks/", "/aws/lambda/"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"/aws/eks/dev-0/cluster", "/aws/eks/prod-0/cluster", "/aws/lambda/one"}, groupNames(groups))
+}
+
+func TestDiscoveryTags(t *testing.T) {
+	sink := &consumertest.LogsSink{}
+	logsRcvr := newLogsReceiver(createDefaultConfig().(*Config), receivertest.NewNopCreateSettings(), sink)
+	tc := &mockTaggingClient{}
+	tc.On("GetResourcesWithContext", mock.Anything, &resourcegroupstaggingapi.GetResourcesInput{
+		ResourceTypeFilters: []*string{aws.String("logs:log-group")},
+		TagFilters: []*resourcegroupstaggingapi.TagFilter{
+			{Key: aws.String("team"), Values: []*string{aws.String("payments")}},
+		},
+	}, mock.Anything).Return(&resourcegroupstaggingapi.GetResourcesOutput{
+		ResourceTagMappingList: []*resourcegroupstaggingapi.ResourceTagMapping{
+			{ResourceARN: aws.String("arn:aws:logs:us-west-1:123456789012:log-group:/aws/lambda/refund:*")},
+			{ResourceARN: aws.String("arn:aws:logs:us-west-1:123456789012:log-group:/aws/eks/payments/cluster")},
+		},
+		PaginationToken: aws.String("next"),
+	}, nil)
+	tc.On("GetResourcesWithContext", mock.Anything, &resourcegroupstaggingapi.GetResourcesInput{
+		ResourceTypeFilters: []*string{aws.String("logs:log-group")},
+		TagFilters: []*resourcegroupstaggingapi.TagFilter{
+			{Key: aws.String("team"), Values: []*string{aws.String("payments")}},
+		},
+		PaginationToken: aws.String("next"),
+	}, mock.Anything).Return(&resourcegroupstaggingapi.GetResourcesOutput{
+		ResourceTagMappingList: []*resourcegroupstaggingapi.ResourceTagMapping{
+			{ResourceARN: aws.String("arn:aws:logs:us-west-1:123456789012:log-group:/aws/lambda/charge")},
+		},
+		PaginationToken: aws.String(""),
+	}, nil)
+	logsRcvr.client = &mockClient{}
+	logsRcvr.taggingClient = tc
+
+	groups, err := logsRcvr.discoverGroups(context.Background(), &AutodiscoverConfig{
+		Limit:    10,
+		Prefixes: []string{"/aws/lambda/"},
+		Tags:     map[string]string{"team": "payments"},
+		Streams: StreamConfig{
+			Prefixes: []*string{&testLogStreamPrefix},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"/aws/lambda/charge", "/aws/lambda/refund"}, groupNames(groups))
+}
+
+func TestOffsets(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Region = "us-west-1"
+	cfg.Logs.Groups = GroupConfig{
+		NamedConfigs: map[string]StreamConfig{
+			testLogGroupName: {},
+			"failing":        {},
+		},
+	}
+	storageID := component.NewID("storage")
+	cfg.StorageID = &storageID
+
+	storedOffset := time.UnixMilli(testTimeStamp - 60000)
+	storageClient := &mapStorageClient{data: map[string][]byte{
+		"offset/" + testLogGroupName: []byte(strconv.FormatInt(storedOffset.UnixMilli(), 10)),
+	}}
+	host := &storageHost{
+		Host:       componenttest.NewNopHost(),
+		extensions: map[component.ID]component.Component{storageID: &storageExtension{client: storageClient}},
+	}
+
+	sink := &consumertest.LogsSink{}
+	logsRcvr := newLogsReceiver(cfg, receivertest.NewNopCreateSettings(), sink)
+	mc := &mockClient{}
+	mc.On("FilterLogEventsWithContext", mock.Anything, mock.MatchedBy(func(input *cloudwatchlogs.FilterLogEventsInput) bool {
+		return *input.LogGroupName == testLogGroupName
+	}), mock.Anything).Return(&cloudwatchlogs.FilterLogEventsOutput{}, nil)
+	mc.On("FilterLogEventsWithContext", mock.Anything, mock.Anything, mock.Anything).Return(&cloudwatchlogs.FilterLogEventsOutput{}, errors.New("throttled"))
+	logsRcvr.client = mc
+
+	require.NoError(t, logsRcvr.Start(context.Background(), host))
+	require.NoError(t, logsRcvr.Shutdown(context.Background()))
+	logsRcvr.doneChan = make(chan bool)
+
+	require.Error(t, logsRcvr.poll(context.Background()))
+	input := mc.Calls[0].Arguments.Get(1).(*cloudwatchlogs.FilterLogEventsInput)
+	if *input.LogGroupName != testLogGroupName {
+		input = mc.Calls[1].Arguments.Get(1).(*cloudwatchlogs.FilterLogEventsInput)
+	}
+	require.Equal(t, storedOffset.UnixMilli(), *input.StartTime, "the collection should resume from the stored offset")
+
+	offset, err := strconv.ParseInt(string(storageClient.data["offset/"+testLogGroupName]), 10, 64)
+	require.NoError(t, err)
+	require.Equal(t, *input.EndTime, offset, "the offset should be moved to the end of the poll")
+	require.NotContains(t, storageClient.data, "offset/failing", "the offset of a failed log group should not be moved")
+}
+
 // Test to ensure that mid collection while streaming results we will
 // return early if Shutdown is called
 func TestShutdownWhileCollecting(t *testing.T) {
@@ -155,7 +292,7 @@ func TestShutdownWhileCollecting(t *testing.T) {
 	}
 
 	sink := &consumertest.LogsSink{}
-	alertRcvr := newLogsReceiver(cfg, zap.NewNop(), sink)
+	alertRcvr := newLogsReceiver(cfg, receivertest.NewNopCreateSettings(), sink)
 	doneChan := make(chan time.Time, 1)
 	mc := &mockClient{}
 	mc.On("FilterLogEventsWithContext", mock.Anything, mock.Anything, mock.Anything).Return(&cloudwatchlogs.FilterLogEventsOutput{
@@ -222,6 +359,79 @@ func (mc *mockClient) DescribeLogGroupsWithContext(ctx context.Context, input *c
 	return args.Get(0).(*cloudwatchlogs.DescribeLogGroupsOutput), args.Error(1)
 }
 
+type mockTaggingClient struct {
+	mock.Mock
+}
+
+func (mc *mockTaggingClient) GetResourcesWithContext(ctx context.Context, input *resourcegroupstaggingapi.GetResourcesInput, opts ...request.Option) (*resourcegroupstaggingapi.GetResourcesOutput, error) {
+	args := mc.Called(ctx, input, opts)
+	return args.Get(0).(*resourcegroupstaggingapi.GetResourcesOutput), args.Error(1)
+}
+
+func groupNames(groups []groupRequest) []string {
+	names := []string{}
+	for _, g := range groups {
+		names = append(names, g.groupName())
+	}
+	return names
+}
+
+// mapStorageClient is a storage.Client kept in memory for tests
+type mapStorageClient struct {
+	data map[string][]byte
+}
+
+func (c *mapStorageClient) Get(_ context.Context, key string) ([]byte, error) {
+	return c.data[key], nil
+}
+
+func (c *mapStorageClient) Set(_ context.Context, key string, value []byte) error {
+	c.data[key] = value
+	return nil
+}
+
+func (c *mapStorageClient) Delete(_ context.Context, key string) error {
+	delete(c.data, key)
+	return nil
+}
+
+func (c *mapStorageClient) Batch(_ context.Context, ops ...storage.Operation) error {
+	for _, op := range ops {
+		switch op.Type {
+		case storage.Get:
+			op.Value = c.data[op.Key]
+		case storage.Set:
+			c.data[op.Key] = op.Value
+		case storage.Delete:
+			delete(c.data, op.Key)
+		}
+	}
+	return nil
+}
+
+func (c *mapStorageClient) Close(_ context.Context) error {
+	return nil
+}
+
+type storageExtension struct {
+	component.StartFunc
+	component.ShutdownFunc
+	client storage.Client
+}
+
+func (e *storageExtension) GetClient(context.Context, component.Kind, component.ID, string) (storage.Client, error) {
+	return e.client, nil
+}
+
+type storageHost struct {
+	component.Host
+	extensions map[component.ID]component.Component
+}
+
+func (h *storageHost) GetExtensions() map[component.ID]component.Component {
+	return h.extensions
+}
+
 func (mc *mockClient) FilterLogEventsWithContext(ctx context.Context, input *cloudwatchlogs.FilterLogEventsInput, opts ...request.Option) (*cloudwatchlogs.FilterLogEventsOutput, error) {
 	args := mc.Called(ctx, input, opts)
 	return args.Get(0).(*cloudwatchlogs.FilterLogEventsOutput), args.Error(1)
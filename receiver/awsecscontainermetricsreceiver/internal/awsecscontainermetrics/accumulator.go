@@ -26,7 +26,8 @@ import (
 
 // metricDataAccumulator defines the accumulator
 type metricDataAccumulator struct {
-	mds []pmetric.Metrics
+	mds   []pmetric.Metrics
+	rates *RateCalculator
 }
 
 // getMetricsData generates OT Metrics data from task metadata and docker stats
@@ -35,6 +36,7 @@ func (acc *metricDataAccumulator) getMetricsData(containerStatsMap map[string]*C
 	taskMetrics := ECSMetrics{}
 	timestamp := pcommon.NewTimestampFromTime(time.Now())
 	taskResource := taskResource(metadata)
+	seen := make(map[string]bool, len(metadata.Containers))
 
 	for _, containerMetadata := range metadata.Containers {
 
@@ -49,6 +51,10 @@ func (acc *metricDataAccumulator) getMetricsData(containerStatsMap map[string]*C
 		if ok && !isEmptyStats(stats) {
 
 			containerMetrics := convertContainerMetrics(stats, logger, containerMetadata)
+			if acc.rates != nil {
+				acc.rates.update(containerMetadata.DockerID, stats.Read, stats.NetworkRate != nil, &containerMetrics)
+				seen[containerMetadata.DockerID] = true
+			}
 			acc.accumulate(convertToOTLPMetrics(containerPrefix, containerMetrics, containerResource, timestamp))
 			aggregateTaskMetrics(&taskMetrics, containerMetrics)
 
@@ -64,7 +70,11 @@ func (acc *metricDataAccumulator) getMetricsData(containerStatsMap map[string]*C
 
 		}
 	}
+	if acc.rates != nil {
+		acc.rates.retain(seen)
+	}
 	overrideWithTaskLevelLimit(&taskMetrics, metadata)
+	setEphemeralStorage(&taskMetrics, metadata)
 	acc.accumulate(convertToOTLPMetrics(taskPrefix, taskMetrics, taskResource, timestamp))
 }
 
@@ -112,6 +122,19 @@ func overrideWithTaskLevelLimit(taskMetrics *ECSMetrics, metadata ecsutil.TaskMe
 	}
 }
 
+func setEphemeralStorage(taskMetrics *ECSMetrics, metadata ecsutil.TaskMetadata) {
+	storage := metadata.EphemeralStorageMetrics
+	if storage == nil {
+		return
+	}
+	if storage.Utilized != nil {
+		taskMetrics.EphemeralStorageUtilized = *storage.Utilized
+	}
+	if storage.Reserved != nil {
+		taskMetrics.EphemeralStorageReserved = *storage.Reserved
+	}
+}
+
 func calculateDuration(startTime, endTime string) (float64, error) {
 	start, err := time.Parse(time.RFC3339Nano, startTime)
 	if err != nil {
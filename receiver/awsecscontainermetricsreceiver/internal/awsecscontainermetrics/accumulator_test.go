@@ -263,3 +263,19 @@ func TestCalculateDuration(t *testing.T) {
 	require.EqualValues(t, 0, result)
 
 }
+
+func TestSetEphemeralStorage(t *testing.T) {
+	utilized := uint64(221)
+	reserved := uint64(4096)
+	taskMetrics := ECSMetrics{}
+	setEphemeralStorage(&taskMetrics, ecsutil.TaskMetadata{
+		EphemeralStorageMetrics: &ecsutil.EphemeralStorageMetrics{Utilized: &utilized, Reserved: &reserved},
+	})
+	require.EqualValues(t, 221, taskMetrics.EphemeralStorageUtilized)
+	require.EqualValues(t, 4096, taskMetrics.EphemeralStorageReserved)
+
+	taskMetrics = ECSMetrics{}
+	setEphemeralStorage(&taskMetrics, ecsutil.TaskMetadata{})
+	require.EqualValues(t, 0, taskMetrics.EphemeralStorageUtilized)
+	require.EqualValues(t, 0, taskMetrics.EphemeralStorageReserved)
+}
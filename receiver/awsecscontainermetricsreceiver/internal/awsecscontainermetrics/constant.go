@@ -69,6 +69,12 @@ const (
 	attributeStorageRead  = "storage.read_bytes"
 	attributeStorageWrite = "storage.write_bytes"
 
+	attributeStorageRateRead  = "storage.rate.read"
+	attributeStorageRateWrite = "storage.rate.write"
+
+	attributeEphemeralStorageUtilized = "ephemeral_storage.utilized"
+	attributeEphemeralStorageReserved = "ephemeral_storage.reserved"
+
 	attributeDuration = "duration"
 
 	unitBytes       = "Bytes"
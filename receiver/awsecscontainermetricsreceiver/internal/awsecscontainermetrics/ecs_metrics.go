@@ -46,4 +46,10 @@ type ECSMetrics struct {
 
 	StorageReadBytes  uint64
 	StorageWriteBytes uint64
+
+	StorageReadBytesPerSecond  float64
+	StorageWriteBytesPerSecond float64
+
+	EphemeralStorageUtilized uint64
+	EphemeralStorageReserved uint64
 }
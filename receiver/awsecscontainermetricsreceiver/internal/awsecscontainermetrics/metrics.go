@@ -21,9 +21,10 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/aws/ecsutil"
 )
 
-// MetricsData generates OTLP metrics from endpoint raw data
-func MetricsData(containerStatsMap map[string]*ContainerStats, metadata ecsutil.TaskMetadata, logger *zap.Logger) []pmetric.Metrics {
-	acc := &metricDataAccumulator{}
+// MetricsData generates OTLP metrics from endpoint raw data. The RateCalculator
+// may be nil, in which case only the rates reported by the ECS agent are emitted.
+func MetricsData(containerStatsMap map[string]*ContainerStats, metadata ecsutil.TaskMetadata, rates *RateCalculator, logger *zap.Logger) []pmetric.Metrics {
+	acc := &metricDataAccumulator{rates: rates}
 	acc.getMetricsData(containerStatsMap, metadata, logger)
 
 	return acc.mds
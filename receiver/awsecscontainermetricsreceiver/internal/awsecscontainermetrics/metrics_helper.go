@@ -161,4 +161,7 @@ func aggregateTaskMetrics(taskMetrics *ECSMetrics, conMetrics ECSMetrics) {
 
 	taskMetrics.StorageReadBytes += conMetrics.StorageReadBytes
 	taskMetrics.StorageWriteBytes += conMetrics.StorageWriteBytes
+
+	taskMetrics.StorageReadBytesPerSecond += conMetrics.StorageReadBytesPerSecond
+	taskMetrics.StorageWriteBytesPerSecond += conMetrics.StorageWriteBytesPerSecond
 }
@@ -103,6 +103,6 @@ func TestMetricData(t *testing.T) {
 	cstats["001"] = &containerStats
 
 	logger := zap.NewNop()
-	md := MetricsData(cstats, tm, logger)
+	md := MetricsData(cstats, tm, nil, logger)
 	require.Less(t, 0, len(md))
 }
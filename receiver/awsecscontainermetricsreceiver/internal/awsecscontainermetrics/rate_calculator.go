@@ -0,0 +1,93 @@
+// Copyright 2020, OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package awsecscontainermetrics // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awsecscontainermetricsreceiver/internal/awsecscontainermetrics"
+
+import (
+	"sync"
+	"time"
+)
+
+// RateCalculator keeps the cumulative network and storage counters of every
+// container seen in the previous collection, so per-second rates can be
+// computed when the ECS agent does not provide them.
+type RateCalculator struct {
+	mu       sync.Mutex
+	previous map[string]rateSample
+}
+
+type rateSample struct {
+	read              time.Time
+	networkRxBytes    uint64
+	networkTxBytes    uint64
+	storageReadBytes  uint64
+	storageWriteBytes uint64
+}
+
+// NewRateCalculator creates an empty RateCalculator.
+func NewRateCalculator() *RateCalculator {
+	return &RateCalculator{previous: map[string]rateSample{}}
+}
+
+// update computes the rates of the given container metrics against the
+// previous sample of the same container and records the current sample.
+// Network rates reported by the ECS agent are left untouched.
+func (rc *RateCalculator) update(containerID string, read time.Time, hasNetworkRate bool, m *ECSMetrics) {
+	current := rateSample{
+		read:              read,
+		networkRxBytes:    m.NetworkRxBytes,
+		networkTxBytes:    m.NetworkTxBytes,
+		storageReadBytes:  m.StorageReadBytes,
+		storageWriteBytes: m.StorageWriteBytes,
+	}
+
+	rc.mu.Lock()
+	prev, ok := rc.previous[containerID]
+	rc.previous[containerID] = current
+	rc.mu.Unlock()
+
+	if !ok {
+		return
+	}
+	seconds := current.read.Sub(prev.read).Seconds()
+	if seconds <= 0 {
+		return
+	}
+
+	m.StorageReadBytesPerSecond = perSecond(prev.storageReadBytes, current.storageReadBytes, seconds)
+	m.StorageWriteBytesPerSecond = perSecond(prev.storageWriteBytes, current.storageWriteBytes, seconds)
+	if !hasNetworkRate {
+		m.NetworkRateRxBytesPerSecond = perSecond(prev.networkRxBytes, current.networkRxBytes, seconds)
+		m.NetworkRateTxBytesPerSecond = perSecond(prev.networkTxBytes, current.networkTxBytes, seconds)
+	}
+}
+
+// retain drops the samples of containers that are no longer part of the task.
+func (rc *RateCalculator) retain(containerIDs map[string]bool) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	for id := range rc.previous {
+		if !containerIDs[id] {
+			delete(rc.previous, id)
+		}
+	}
+}
+
+// perSecond returns zero when the counter went backwards, e.g. after a container restart.
+func perSecond(prev, current uint64, seconds float64) float64 {
+	if current < prev {
+		return 0
+	}
+	return float64(current-prev) / seconds
+}
@@ -0,0 +1,56 @@
+// Copyright 2020, OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package awsecscontainermetrics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateCalculatorUpdate(t *testing.T) {
+	rc := NewRateCalculator()
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	first := ECSMetrics{NetworkRxBytes: 100, NetworkTxBytes: 200, StorageReadBytes: 1000, StorageWriteBytes: 2000}
+	rc.update("001", start, false, &first)
+	require.Zero(t, first.NetworkRateRxBytesPerSecond)
+	require.Zero(t, first.StorageReadBytesPerSecond)
+
+	second := ECSMetrics{NetworkRxBytes: 300, NetworkTxBytes: 600, StorageReadBytes: 2000, StorageWriteBytes: 6000}
+	rc.update("001", start.Add(10*time.Second), false, &second)
+	require.EqualValues(t, 20, second.NetworkRateRxBytesPerSecond)
+	require.EqualValues(t, 40, second.NetworkRateTxBytesPerSecond)
+	require.EqualValues(t, 100, second.StorageReadBytesPerSecond)
+	require.EqualValues(t, 400, second.StorageWriteBytesPerSecond)
+
+	// Rates reported by the ECS agent take precedence and counter resets yield zero.
+	third := ECSMetrics{NetworkRateRxBytesPerSecond: 5, NetworkRxBytes: 400, StorageReadBytes: 10}
+	rc.update("001", start.Add(20*time.Second), true, &third)
+	require.EqualValues(t, 5, third.NetworkRateRxBytesPerSecond)
+	require.Zero(t, third.StorageReadBytesPerSecond)
+}
+
+func TestRateCalculatorRetain(t *testing.T) {
+	rc := NewRateCalculator()
+	now := time.Now()
+	rc.update("001", now, false, &ECSMetrics{})
+	rc.update("002", now, false, &ECSMetrics{})
+
+	rc.retain(map[string]bool{"002": true})
+	require.Len(t, rc.previous, 1)
+	require.Contains(t, rc.previous, "002")
+}
@@ -59,6 +59,15 @@ func convertToOTLPMetrics(prefix string, m ECSMetrics, r pcommon.Resource, times
 	appendIntSum(prefix+attributeStorageRead, unitBytes, int64(m.StorageReadBytes), timestamp, ilms.AppendEmpty())
 	appendIntSum(prefix+attributeStorageWrite, unitBytes, int64(m.StorageWriteBytes), timestamp, ilms.AppendEmpty())
 
+	appendDoubleGauge(prefix+attributeStorageRateRead, unitBytesPerSec, m.StorageReadBytesPerSecond, timestamp, ilms.AppendEmpty())
+	appendDoubleGauge(prefix+attributeStorageRateWrite, unitBytesPerSec, m.StorageWriteBytesPerSecond, timestamp, ilms.AppendEmpty())
+
+	// Ephemeral storage is only reported for the whole task by Fargate platform 1.4.0 and later.
+	if prefix == taskPrefix && (m.EphemeralStorageUtilized > 0 || m.EphemeralStorageReserved > 0) {
+		appendIntGauge(prefix+attributeEphemeralStorageUtilized, unitMegaBytes, int64(m.EphemeralStorageUtilized), timestamp, ilms.AppendEmpty())
+		appendIntGauge(prefix+attributeEphemeralStorageReserved, unitMegaBytes, int64(m.EphemeralStorageReserved), timestamp, ilms.AppendEmpty())
+	}
+
 	return md
 }
 
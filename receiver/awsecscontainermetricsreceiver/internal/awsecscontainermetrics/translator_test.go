@@ -36,10 +36,21 @@ func TestConvertToOTMetrics(t *testing.T) {
 
 	resource := pcommon.NewResource()
 	md := convertToOTLPMetrics("container.", m, resource, timestamp)
-	require.EqualValues(t, 26, md.ResourceMetrics().At(0).ScopeMetrics().Len())
+	require.EqualValues(t, 28, md.ResourceMetrics().At(0).ScopeMetrics().Len())
 	assert.EqualValues(t, conventions.SchemaURL, md.ResourceMetrics().At(0).SchemaUrl())
 }
 
+func TestConvertToOTMetricsEphemeralStorage(t *testing.T) {
+	timestamp := pcommon.NewTimestampFromTime(time.Now())
+	m := ECSMetrics{EphemeralStorageUtilized: 221, EphemeralStorageReserved: 4096}
+
+	md := convertToOTLPMetrics(taskPrefix, m, pcommon.NewResource(), timestamp)
+	require.EqualValues(t, 30, md.ResourceMetrics().At(0).ScopeMetrics().Len())
+
+	md = convertToOTLPMetrics(containerPrefix, m, pcommon.NewResource(), timestamp)
+	require.EqualValues(t, 28, md.ResourceMetrics().At(0).ScopeMetrics().Len())
+}
+
 func TestIntGauge(t *testing.T) {
 	intValue := int64(100)
 	timestamp := pcommon.NewTimestampFromTime(time.Now())
@@ -37,6 +37,7 @@ type awsEcsContainerMetricsReceiver struct {
 	cancel       context.CancelFunc
 	restClient   ecsutil.RestClient
 	provider     *awsecscontainermetrics.StatsProvider
+	rates        *awsecscontainermetrics.RateCalculator
 }
 
 // New creates the aws ecs container metrics receiver with the given parameters.
@@ -54,6 +55,7 @@ func newAWSECSContainermetrics(
 		nextConsumer: nextConsumer,
 		config:       config,
 		restClient:   rest,
+		rates:        awsecscontainermetrics.NewRateCalculator(),
 	}
 	return r, nil
 }
@@ -94,7 +96,7 @@ func (aecmr *awsEcsContainerMetricsReceiver) collectDataFromEndpoint(ctx context
 	}
 
 	// TODO: report self metrics using obsreport
-	mds := awsecscontainermetrics.MetricsData(stats, metadata, aecmr.logger)
+	mds := awsecscontainermetrics.MetricsData(stats, metadata, aecmr.rates, aecmr.logger)
 	for _, md := range mds {
 		err = aecmr.nextConsumer.ConsumeMetrics(ctx, md)
 		if err != nil {
@@ -47,6 +47,9 @@ type collectDRecord struct {
 	Message        *string                `json:"message"`
 	Meta           map[string]interface{} `json:"meta"`
 	Severity       *string                `json:"severity"`
+
+	// unit is set from the types.db definition of the record type.
+	unit string
 }
 
 func (r *collectDRecord) isEvent() bool {
@@ -62,6 +65,10 @@ func (r *collectDRecord) protoTime() *timestamppb.Timestamp {
 }
 
 func (r *collectDRecord) startTimestamp(mdType metricspb.MetricDescriptor_Type) *timestamppb.Timestamp {
+	// The time and interval are optional in the records, the start of the interval is then unknown.
+	if r.Time == nil || r.Interval == nil {
+		return nil
+	}
 	if mdType == metricspb.MetricDescriptor_CUMULATIVE_DISTRIBUTION || mdType == metricspb.MetricDescriptor_CUMULATIVE_DOUBLE || mdType == metricspb.MetricDescriptor_CUMULATIVE_INT64 {
 		return timestamppb.New(time.Unix(0, int64((*r.Time-*r.Interval)*float64(time.Second))))
 	}
@@ -115,6 +122,7 @@ func (r *collectDRecord) newMetric(name string, dsType *string, val *json.Number
 	metricType := r.metricType(dsType, isDouble)
 	metric.MetricDescriptor = &metricspb.MetricDescriptor{
 		Name:      name,
+		Unit:      r.unit,
 		Type:      metricType,
 		LabelKeys: lKeys,
 	}
@@ -139,9 +147,12 @@ func (r *collectDRecord) metricType(dsType *string, isDouble bool) metricspb.Met
 	case collectDMetricCounter, collectDMetricDerive:
 		return metricCumulative(isDouble)
 
-	// Prometheus collectd exporter just ignores it. We use gauge for it as it seems the
-	// closes type. https://github.com/prometheus/collectd_exporter/blob/master/main.go#L109-L129
-	case collectDMetricGauge, collectDMetricAbsolute:
+	// Absolute values are reset when read, the start timestamp of the interval
+	// makes them the sum of what happened during that interval.
+	case collectDMetricAbsolute:
+		return metricCumulative(isDouble)
+
+	case collectDMetricGauge:
 		return metricGauge(isDouble)
 	}
 	return metricGauge(isDouble)
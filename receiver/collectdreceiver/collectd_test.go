@@ -501,6 +501,22 @@ func TestStartTimestamp(t *testing.T) {
 			metricDescriptorType: metricspb.MetricDescriptor_GAUGE_DOUBLE,
 			wantStartTimestamp:   nil,
 		},
+		{
+			name: "metric type cumulative int64 without interval",
+			record: collectDRecord{
+				Time: createPtrFloat64(10),
+			},
+			metricDescriptorType: metricspb.MetricDescriptor_CUMULATIVE_INT64,
+			wantStartTimestamp:   nil,
+		},
+		{
+			name: "metric type cumulative double without time",
+			record: collectDRecord{
+				Interval: createPtrFloat64(5),
+			},
+			metricDescriptorType: metricspb.MetricDescriptor_CUMULATIVE_DOUBLE,
+			wantStartTimestamp:   nil,
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
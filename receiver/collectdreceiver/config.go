@@ -27,4 +27,6 @@ type Config struct {
 	Timeout          time.Duration `mapstructure:"timeout"`
 	AttributesPrefix string        `mapstructure:"attributes_prefix"`
 	Encoding         string        `mapstructure:"encoding"`
+	// TypesDB lists the collectd types.db files defining the data sources of the received types.
+	TypesDB []string `mapstructure:"types_db"`
 }
@@ -46,6 +46,7 @@ func TestLoadConfig(t *testing.T) {
 				Timeout:          time.Second * 50,
 				AttributesPrefix: "dap_",
 				Encoding:         "command",
+				TypesDB:          []string{"/usr/share/collectd/types.db"},
 			},
 		},
 	}
@@ -69,5 +69,9 @@ func createMetricsReceiver(
 			c.Encoding,
 		)
 	}
-	return newCollectdReceiver(params.Logger, c.Endpoint, c.Timeout, c.AttributesPrefix, nextConsumer)
+	db, err := loadTypesDB(c.TypesDB)
+	if err != nil {
+		return nil, err
+	}
+	return newCollectdReceiver(params.Logger, c.Endpoint, c.Timeout, c.AttributesPrefix, db, nextConsumer)
 }
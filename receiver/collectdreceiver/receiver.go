@@ -41,6 +41,7 @@ type collectdReceiver struct {
 	addr               string
 	server             *http.Server
 	defaultAttrsPrefix string
+	typesDB            typesDB
 	nextConsumer       consumer.Metrics
 }
 
@@ -50,6 +51,7 @@ func newCollectdReceiver(
 	addr string,
 	timeout time.Duration,
 	defaultAttrsPrefix string,
+	typesDB typesDB,
 	nextConsumer consumer.Metrics) (receiver.Metrics, error) {
 	if nextConsumer == nil {
 		return nil, component.ErrNilNextConsumer
@@ -60,6 +62,7 @@ func newCollectdReceiver(
 		addr:               addr,
 		nextConsumer:       nextConsumer,
 		defaultAttrsPrefix: defaultAttrsPrefix,
+		typesDB:            typesDB,
 	}
 	r.server = &http.Server{
 		Addr:         addr,
@@ -112,7 +115,9 @@ func (cdr *collectdReceiver) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 	var metrics []*metricspb.Metric
 	ctx := context.Background()
-	for _, record := range records {
+	for i := range records {
+		record := &records[i]
+		cdr.typesDB.apply(record)
 		metrics, err = record.appendToMetrics(metrics, defaultAttrs)
 		if err != nil {
 			cdr.handleHTTPErr(w, err, "unable to process metrics")
@@ -75,7 +75,7 @@ func TestNewReceiver(t *testing.T) {
 	logger := zap.NewNop()
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			_, err := newCollectdReceiver(logger, tt.args.addr, time.Second*10, "", tt.args.nextConsumer)
+			_, err := newCollectdReceiver(logger, tt.args.addr, time.Second*10, "", nil, tt.args.nextConsumer)
 			require.ErrorIs(t, err, tt.wantErr)
 		})
 	}
@@ -159,7 +159,7 @@ func TestCollectDServer(t *testing.T) {
 	sink := new(consumertest.MetricsSink)
 
 	logger := zap.NewNop()
-	cdr, err := newCollectdReceiver(logger, endpoint, defaultTimeout, defaultAttrsPrefix, sink)
+	cdr, err := newCollectdReceiver(logger, endpoint, defaultTimeout, defaultAttrsPrefix, nil, sink)
 	if err != nil {
 		t.Fatalf("Failed to create receiver: %v", err)
 	}
@@ -0,0 +1,148 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collectdreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/collectdreceiver"
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// typeUnits holds the units of the well-known collectd types.
+var typeUnits = map[string]string{
+	"bytes":         "By",
+	"cache_size":    "By",
+	"df_complex":    "By",
+	"disk_octets":   "By",
+	"if_octets":     "By",
+	"io_octets":     "By",
+	"memory":        "By",
+	"swap":          "By",
+	"swap_io":       "By",
+	"total_bytes":   "By",
+	"disk_io_time":  "ms",
+	"disk_time":     "ms",
+	"delay":         "s",
+	"duration":      "s",
+	"latency":       "s",
+	"response_time": "s",
+	"uptime":        "s",
+	"frequency":     "Hz",
+	"percent":       "%",
+	"temperature":   "Cel",
+	"voltage":       "V",
+	"current":       "A",
+	"power":         "W",
+	"disk_ops":      "{operations}",
+	"if_packets":    "{packets}",
+	"if_dropped":    "{packets}",
+	"if_errors":     "{errors}",
+}
+
+// collectDDataSource is a data source of a collectd type, e.g. "rx:DERIVE:0:U".
+type collectDDataSource struct {
+	name   string
+	dsType string
+}
+
+// collectDType is a collectd type as defined in a types.db file.
+type collectDType struct {
+	dataSources []collectDDataSource
+	unit        string
+}
+
+// typesDB maps the collectd type names to their definition.
+type typesDB map[string]collectDType
+
+// loadTypesDB parses the given types.db files, a type defined in several
+// files takes the definition of the last one, like collectd does.
+func loadTypesDB(paths []string) (typesDB, error) {
+	if len(paths) == 0 {
+		return nil, nil
+	}
+	db := make(typesDB)
+	for _, path := range paths {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open types.db file: %w", err)
+		}
+		err = db.parse(f)
+		_ = f.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse types.db file %q: %w", path, err)
+		}
+	}
+	return db, nil
+}
+
+// parse reads lines in the "name ds_name:TYPE:min:max[, ...]" format, empty
+// lines and lines starting with '#' are ignored.
+func (db typesDB) parse(r io.Reader) error {
+	scanner := bufio.NewScanner(r)
+	lineNum := 0
+	for scanner.Scan() {
+		lineNum++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			return fmt.Errorf("line %d: missing data sources for type %q", lineNum, fields[0])
+		}
+
+		var t collectDType
+		for _, spec := range strings.Split(strings.Join(fields[1:], ""), ",") {
+			parts := strings.Split(spec, ":")
+			if len(parts) != 4 || parts[0] == "" {
+				return fmt.Errorf("line %d: invalid data source %q", lineNum, spec)
+			}
+			dsType := strings.ToLower(parts[1])
+			switch dsType {
+			case collectDMetricGauge, collectDMetricDerive, collectDMetricCounter, collectDMetricAbsolute:
+			default:
+				return fmt.Errorf("line %d: unsupported data source type %q", lineNum, parts[1])
+			}
+			t.dataSources = append(t.dataSources, collectDDataSource{name: parts[0], dsType: dsType})
+		}
+		t.unit = typeUnits[fields[0]]
+		db[fields[0]] = t
+	}
+	return scanner.Err()
+}
+
+// apply sets the unit of the record and replaces its data sources by the ones
+// of its type, when this type is defined with as many data sources as values.
+func (db typesDB) apply(r *collectDRecord) {
+	if r.TypeS == nil {
+		return
+	}
+	t, ok := db[*r.TypeS]
+	if !ok {
+		return
+	}
+	r.unit = t.unit
+	if len(t.dataSources) != len(r.Values) {
+		return
+	}
+	r.Dsnames = make([]*string, len(t.dataSources))
+	r.Dstypes = make([]*string, len(t.dataSources))
+	for i := range t.dataSources {
+		r.Dsnames[i] = &t.dataSources[i].name
+		r.Dstypes[i] = &t.dataSources[i].dsType
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collectdreceiver
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	metricspb "github.com/census-instrumentation/opencensus-proto/gen-go/metrics/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadTypesDB(t *testing.T) {
+	db, err := loadTypesDB([]string{filepath.Join("testdata", "types.db")})
+	require.NoError(t, err)
+
+	assert.Equal(t, typesDB{
+		"if_octets": {
+			dataSources: []collectDDataSource{{name: "rx", dsType: "derive"}, {name: "tx", dsType: "derive"}},
+			unit:        "By",
+		},
+		"load": {
+			dataSources: []collectDDataSource{{name: "shortterm", dsType: "gauge"}, {name: "midterm", dsType: "gauge"}, {name: "longterm", dsType: "gauge"}},
+		},
+		"bytes": {
+			dataSources: []collectDDataSource{{name: "value", dsType: "gauge"}},
+			unit:        "By",
+		},
+		"requests": {
+			dataSources: []collectDDataSource{{name: "value", dsType: "absolute"}},
+		},
+	}, db)
+
+	_, err = loadTypesDB([]string{filepath.Join("testdata", "missing.db")})
+	assert.Error(t, err)
+}
+
+func TestParseTypesDBErrors(t *testing.T) {
+	for _, line := range []string{
+		"if_octets",
+		"if_octets rx:DERIVE:0",
+		"if_octets rx:HISTOGRAM:0:U",
+	} {
+		assert.Error(t, make(typesDB).parse(strings.NewReader(line)), line)
+	}
+}
+
+func TestTypesDBApply(t *testing.T) {
+	db, err := loadTypesDB([]string{filepath.Join("testdata", "types.db")})
+	require.NoError(t, err)
+
+	var records []collectDRecord
+	require.NoError(t, json.Unmarshal([]byte(`[
+		{"values": [10, 20], "host": "h", "interval": 10.0, "time": 1415062577.4949999, "plugin": "interface", "type": "if_octets"},
+		{"values": [5], "host": "h", "interval": 10.0, "time": 1415062577.4949999, "plugin": "web", "type": "requests"}
+	]`), &records))
+
+	var metrics []*metricspb.Metric
+	for i := range records {
+		db.apply(&records[i])
+		metrics, err = records[i].appendToMetrics(metrics, nil)
+		require.NoError(t, err)
+	}
+	require.Len(t, metrics, 3)
+
+	assert.Equal(t, "if_octets.rx", metrics[0].MetricDescriptor.Name)
+	assert.Equal(t, "if_octets.tx", metrics[1].MetricDescriptor.Name)
+	for _, m := range metrics[:2] {
+		assert.Equal(t, "By", m.MetricDescriptor.Unit)
+		assert.Equal(t, metricspb.MetricDescriptor_CUMULATIVE_INT64, m.MetricDescriptor.Type)
+	}
+
+	assert.Equal(t, "requests", metrics[2].MetricDescriptor.Name)
+	assert.Equal(t, metricspb.MetricDescriptor_CUMULATIVE_INT64, metrics[2].MetricDescriptor.Type)
+	assert.NotNil(t, metrics[2].Timeseries[0].StartTimestamp)
+}
@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strconv"
 
 	"go.opentelemetry.io/collector/component"
@@ -29,6 +30,9 @@ import (
 type client interface {
 	Get(path string) ([]byte, error)
 	GetStats(nodeName string) (map[string]interface{}, error)
+	GetDatabases() ([]string, error)
+	GetDatabaseInfo(database string) (map[string]interface{}, error)
+	GetReplicationDocs() (map[string]interface{}, error)
 }
 
 var _ client = (*couchDBClient)(nil)
@@ -103,6 +107,54 @@ func (c *couchDBClient) GetStats(nodeName string) (map[string]interface{}, error
 	return stats, nil
 }
 
+// GetDatabases gets the names of all the databases.
+func (c *couchDBClient) GetDatabases() ([]string, error) {
+	body, err := c.Get("/_all_dbs")
+	if err != nil {
+		return nil, err
+	}
+
+	var databases []string
+	err = json.Unmarshal(body, &databases)
+	if err != nil {
+		return nil, err
+	}
+
+	return databases, nil
+}
+
+// GetDatabaseInfo gets the document counts and sizes of a database.
+func (c *couchDBClient) GetDatabaseInfo(database string) (map[string]interface{}, error) {
+	body, err := c.Get("/" + url.PathEscape(database))
+	if err != nil {
+		return nil, err
+	}
+
+	var info map[string]interface{}
+	err = json.Unmarshal(body, &info)
+	if err != nil {
+		return nil, err
+	}
+
+	return info, nil
+}
+
+// GetReplicationDocs gets the state of the replication jobs from the replication scheduler.
+func (c *couchDBClient) GetReplicationDocs() (map[string]interface{}, error) {
+	body, err := c.Get("/_scheduler/docs")
+	if err != nil {
+		return nil, err
+	}
+
+	var docs map[string]interface{}
+	err = json.Unmarshal(body, &docs)
+	if err != nil {
+		return nil, err
+	}
+
+	return docs, nil
+}
+
 func (c *couchDBClient) buildReq(path string) (*http.Request, error) {
 	url := c.cfg.Endpoint + path
 	req, err := http.NewRequest(http.MethodGet, url, nil)
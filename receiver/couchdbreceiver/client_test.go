@@ -189,6 +189,65 @@ func TestGetNodeStats(t *testing.T) {
 	})
 }
 
+func TestGetDatabasesAndReplicationDocs(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.EscapedPath() {
+		case "/_all_dbs":
+			_, err := w.Write([]byte(`["_users","my/db"]`))
+			require.NoError(t, err)
+		case "/my%2Fdb":
+			_, err := w.Write([]byte(`{"db_name":"my/db","doc_count":1}`))
+			require.NoError(t, err)
+		case "/_scheduler/docs":
+			_, err := w.Write([]byte(`{"docs":[{"state":"running"}]}`))
+			require.NoError(t, err)
+		default:
+			w.WriteHeader(404)
+		}
+	}))
+	defer ts.Close()
+
+	t.Run("get databases", func(t *testing.T) {
+		couchdbClient := defaultClient(t, ts.URL)
+
+		databases, err := couchdbClient.GetDatabases()
+		require.NoError(t, err)
+		require.Equal(t, []string{"_users", "my/db"}, databases)
+	})
+	t.Run("get database info", func(t *testing.T) {
+		couchdbClient := defaultClient(t, ts.URL)
+
+		info, err := couchdbClient.GetDatabaseInfo("my/db")
+		require.NoError(t, err)
+		require.EqualValues(t, map[string]interface{}{"db_name": "my/db", "doc_count": float64(1)}, info)
+	})
+	t.Run("get unknown database info", func(t *testing.T) {
+		couchdbClient := defaultClient(t, ts.URL)
+
+		info, err := couchdbClient.GetDatabaseInfo("unknown")
+		require.Error(t, err)
+		require.Nil(t, info)
+	})
+	t.Run("get replication docs", func(t *testing.T) {
+		couchdbClient := defaultClient(t, ts.URL)
+
+		docs, err := couchdbClient.GetReplicationDocs()
+		require.NoError(t, err)
+		require.EqualValues(t, map[string]interface{}{"docs": []interface{}{map[string]interface{}{"state": "running"}}}, docs)
+	})
+	t.Run("invalid endpoint", func(t *testing.T) {
+		couchdbClient := defaultClient(t, "invalid")
+
+		databases, err := couchdbClient.GetDatabases()
+		require.Error(t, err)
+		require.Nil(t, databases)
+
+		docs, err := couchdbClient.GetReplicationDocs()
+		require.Error(t, err)
+		require.Nil(t, docs)
+	})
+}
+
 func TestBuildReq(t *testing.T) {
 	couchdbClient := couchDBClient{
 		client: &http.Client{},
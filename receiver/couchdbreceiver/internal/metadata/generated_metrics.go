@@ -33,14 +33,17 @@ func (ms *MetricSettings) Unmarshal(parser *confmap.Conf) error {
 
 // MetricsSettings provides settings for couchdbreceiver metrics.
 type MetricsSettings struct {
-	CouchdbAverageRequestTime MetricSettings `mapstructure:"couchdb.average_request_time"`
-	CouchdbDatabaseOpen       MetricSettings `mapstructure:"couchdb.database.open"`
-	CouchdbDatabaseOperations MetricSettings `mapstructure:"couchdb.database.operations"`
-	CouchdbFileDescriptorOpen MetricSettings `mapstructure:"couchdb.file_descriptor.open"`
-	CouchdbHttpdBulkRequests  MetricSettings `mapstructure:"couchdb.httpd.bulk_requests"`
-	CouchdbHttpdRequests      MetricSettings `mapstructure:"couchdb.httpd.requests"`
-	CouchdbHttpdResponses     MetricSettings `mapstructure:"couchdb.httpd.responses"`
-	CouchdbHttpdViews         MetricSettings `mapstructure:"couchdb.httpd.views"`
+	CouchdbAverageRequestTime    MetricSettings `mapstructure:"couchdb.average_request_time"`
+	CouchdbDatabaseDocumentCount MetricSettings `mapstructure:"couchdb.database.document.count"`
+	CouchdbDatabaseOpen          MetricSettings `mapstructure:"couchdb.database.open"`
+	CouchdbDatabaseOperations    MetricSettings `mapstructure:"couchdb.database.operations"`
+	CouchdbDatabaseSize          MetricSettings `mapstructure:"couchdb.database.size"`
+	CouchdbFileDescriptorOpen    MetricSettings `mapstructure:"couchdb.file_descriptor.open"`
+	CouchdbHttpdBulkRequests     MetricSettings `mapstructure:"couchdb.httpd.bulk_requests"`
+	CouchdbHttpdRequests         MetricSettings `mapstructure:"couchdb.httpd.requests"`
+	CouchdbHttpdResponses        MetricSettings `mapstructure:"couchdb.httpd.responses"`
+	CouchdbHttpdViews            MetricSettings `mapstructure:"couchdb.httpd.views"`
+	CouchdbReplicationJobs       MetricSettings `mapstructure:"couchdb.replication.jobs"`
 }
 
 func DefaultMetricsSettings() MetricsSettings {
@@ -48,12 +51,18 @@ func DefaultMetricsSettings() MetricsSettings {
 		CouchdbAverageRequestTime: MetricSettings{
 			Enabled: true,
 		},
+		CouchdbDatabaseDocumentCount: MetricSettings{
+			Enabled: false,
+		},
 		CouchdbDatabaseOpen: MetricSettings{
 			Enabled: true,
 		},
 		CouchdbDatabaseOperations: MetricSettings{
 			Enabled: true,
 		},
+		CouchdbDatabaseSize: MetricSettings{
+			Enabled: false,
+		},
 		CouchdbFileDescriptorOpen: MetricSettings{
 			Enabled: true,
 		},
@@ -69,6 +78,9 @@ func DefaultMetricsSettings() MetricsSettings {
 		CouchdbHttpdViews: MetricSettings{
 			Enabled: true,
 		},
+		CouchdbReplicationJobs: MetricSettings{
+			Enabled: false,
+		},
 	}
 }
 
@@ -104,6 +116,32 @@ func DefaultResourceAttributesSettings() ResourceAttributesSettings {
 	}
 }
 
+// AttributeDocumentState specifies the a value document_state attribute.
+type AttributeDocumentState int
+
+const (
+	_ AttributeDocumentState = iota
+	AttributeDocumentStateActive
+	AttributeDocumentStateDeleted
+)
+
+// String returns the string representation of the AttributeDocumentState.
+func (av AttributeDocumentState) String() string {
+	switch av {
+	case AttributeDocumentStateActive:
+		return "active"
+	case AttributeDocumentStateDeleted:
+		return "deleted"
+	}
+	return ""
+}
+
+// MapAttributeDocumentState is a helper map of string to AttributeDocumentState attribute value.
+var MapAttributeDocumentState = map[string]AttributeDocumentState{
+	"active":  AttributeDocumentStateActive,
+	"deleted": AttributeDocumentStateDeleted,
+}
+
 // AttributeHTTPMethod specifies the a value http.method attribute.
 type AttributeHTTPMethod int
 
@@ -176,6 +214,82 @@ var MapAttributeOperation = map[string]AttributeOperation{
 	"reads":  AttributeOperationReads,
 }
 
+// AttributeReplicationState specifies the a value replication_state attribute.
+type AttributeReplicationState int
+
+const (
+	_ AttributeReplicationState = iota
+	AttributeReplicationStateInitializing
+	AttributeReplicationStatePending
+	AttributeReplicationStateRunning
+	AttributeReplicationStateCrashing
+	AttributeReplicationStateError
+	AttributeReplicationStateCompleted
+	AttributeReplicationStateFailed
+)
+
+// String returns the string representation of the AttributeReplicationState.
+func (av AttributeReplicationState) String() string {
+	switch av {
+	case AttributeReplicationStateInitializing:
+		return "initializing"
+	case AttributeReplicationStatePending:
+		return "pending"
+	case AttributeReplicationStateRunning:
+		return "running"
+	case AttributeReplicationStateCrashing:
+		return "crashing"
+	case AttributeReplicationStateError:
+		return "error"
+	case AttributeReplicationStateCompleted:
+		return "completed"
+	case AttributeReplicationStateFailed:
+		return "failed"
+	}
+	return ""
+}
+
+// MapAttributeReplicationState is a helper map of string to AttributeReplicationState attribute value.
+var MapAttributeReplicationState = map[string]AttributeReplicationState{
+	"initializing": AttributeReplicationStateInitializing,
+	"pending":      AttributeReplicationStatePending,
+	"running":      AttributeReplicationStateRunning,
+	"crashing":     AttributeReplicationStateCrashing,
+	"error":        AttributeReplicationStateError,
+	"completed":    AttributeReplicationStateCompleted,
+	"failed":       AttributeReplicationStateFailed,
+}
+
+// AttributeSizeType specifies the a value size_type attribute.
+type AttributeSizeType int
+
+const (
+	_ AttributeSizeType = iota
+	AttributeSizeTypeActive
+	AttributeSizeTypeExternal
+	AttributeSizeTypeFile
+)
+
+// String returns the string representation of the AttributeSizeType.
+func (av AttributeSizeType) String() string {
+	switch av {
+	case AttributeSizeTypeActive:
+		return "active"
+	case AttributeSizeTypeExternal:
+		return "external"
+	case AttributeSizeTypeFile:
+		return "file"
+	}
+	return ""
+}
+
+// MapAttributeSizeType is a helper map of string to AttributeSizeType attribute value.
+var MapAttributeSizeType = map[string]AttributeSizeType{
+	"active":   AttributeSizeTypeActive,
+	"external": AttributeSizeTypeExternal,
+	"file":     AttributeSizeTypeFile,
+}
+
 // AttributeView specifies the a value view attribute.
 type AttributeView int
 
@@ -251,6 +365,60 @@ func newMetricCouchdbAverageRequestTime(settings MetricSettings) metricCouchdbAv
 	return m
 }
 
+type metricCouchdbDatabaseDocumentCount struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills couchdb.database.document.count metric with initial data.
+func (m *metricCouchdbDatabaseDocumentCount) init() {
+	m.data.SetName("couchdb.database.document.count")
+	m.data.SetDescription("The number of documents in a database.")
+	m.data.SetUnit("{documents}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricCouchdbDatabaseDocumentCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, databaseAttributeValue string, documentStateAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("database", databaseAttributeValue)
+	dp.Attributes().PutStr("state", documentStateAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricCouchdbDatabaseDocumentCount) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricCouchdbDatabaseDocumentCount) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricCouchdbDatabaseDocumentCount(settings MetricSettings) metricCouchdbDatabaseDocumentCount {
+	m := metricCouchdbDatabaseDocumentCount{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricCouchdbDatabaseOpen struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
@@ -355,6 +523,60 @@ func newMetricCouchdbDatabaseOperations(settings MetricSettings) metricCouchdbDa
 	return m
 }
 
+type metricCouchdbDatabaseSize struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills couchdb.database.size metric with initial data.
+func (m *metricCouchdbDatabaseSize) init() {
+	m.data.SetName("couchdb.database.size")
+	m.data.SetDescription("The size of a database.")
+	m.data.SetUnit("By")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricCouchdbDatabaseSize) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, databaseAttributeValue string, sizeTypeAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("database", databaseAttributeValue)
+	dp.Attributes().PutStr("type", sizeTypeAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricCouchdbDatabaseSize) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricCouchdbDatabaseSize) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricCouchdbDatabaseSize(settings MetricSettings) metricCouchdbDatabaseSize {
+	m := metricCouchdbDatabaseSize{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricCouchdbFileDescriptorOpen struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
@@ -616,23 +838,79 @@ func newMetricCouchdbHttpdViews(settings MetricSettings) metricCouchdbHttpdViews
 	return m
 }
 
+type metricCouchdbReplicationJobs struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills couchdb.replication.jobs metric with initial data.
+func (m *metricCouchdbReplicationJobs) init() {
+	m.data.SetName("couchdb.replication.jobs")
+	m.data.SetDescription("The number of replication jobs by state.")
+	m.data.SetUnit("{jobs}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricCouchdbReplicationJobs) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, replicationStateAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("state", replicationStateAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricCouchdbReplicationJobs) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricCouchdbReplicationJobs) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricCouchdbReplicationJobs(settings MetricSettings) metricCouchdbReplicationJobs {
+	m := metricCouchdbReplicationJobs{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 // MetricsBuilder provides an interface for scrapers to report metrics while taking care of all the transformations
 // required to produce metric representation defined in metadata and user settings.
 type MetricsBuilder struct {
-	startTime                       pcommon.Timestamp   // start time that will be applied to all recorded data points.
-	metricsCapacity                 int                 // maximum observed number of metrics per resource.
-	resourceCapacity                int                 // maximum observed number of resource attributes.
-	metricsBuffer                   pmetric.Metrics     // accumulates metrics data before emitting.
-	buildInfo                       component.BuildInfo // contains version information
-	resourceAttributesSettings      ResourceAttributesSettings
-	metricCouchdbAverageRequestTime metricCouchdbAverageRequestTime
-	metricCouchdbDatabaseOpen       metricCouchdbDatabaseOpen
-	metricCouchdbDatabaseOperations metricCouchdbDatabaseOperations
-	metricCouchdbFileDescriptorOpen metricCouchdbFileDescriptorOpen
-	metricCouchdbHttpdBulkRequests  metricCouchdbHttpdBulkRequests
-	metricCouchdbHttpdRequests      metricCouchdbHttpdRequests
-	metricCouchdbHttpdResponses     metricCouchdbHttpdResponses
-	metricCouchdbHttpdViews         metricCouchdbHttpdViews
+	startTime                          pcommon.Timestamp   // start time that will be applied to all recorded data points.
+	metricsCapacity                    int                 // maximum observed number of metrics per resource.
+	resourceCapacity                   int                 // maximum observed number of resource attributes.
+	metricsBuffer                      pmetric.Metrics     // accumulates metrics data before emitting.
+	buildInfo                          component.BuildInfo // contains version information
+	resourceAttributesSettings         ResourceAttributesSettings
+	metricCouchdbAverageRequestTime    metricCouchdbAverageRequestTime
+	metricCouchdbDatabaseDocumentCount metricCouchdbDatabaseDocumentCount
+	metricCouchdbDatabaseOpen          metricCouchdbDatabaseOpen
+	metricCouchdbDatabaseOperations    metricCouchdbDatabaseOperations
+	metricCouchdbDatabaseSize          metricCouchdbDatabaseSize
+	metricCouchdbFileDescriptorOpen    metricCouchdbFileDescriptorOpen
+	metricCouchdbHttpdBulkRequests     metricCouchdbHttpdBulkRequests
+	metricCouchdbHttpdRequests         metricCouchdbHttpdRequests
+	metricCouchdbHttpdResponses        metricCouchdbHttpdResponses
+	metricCouchdbHttpdViews            metricCouchdbHttpdViews
+	metricCouchdbReplicationJobs       metricCouchdbReplicationJobs
 }
 
 // metricBuilderOption applies changes to default metrics builder.
@@ -654,18 +932,21 @@ func WithResourceAttributesSettings(ras ResourceAttributesSettings) metricBuilde
 
 func NewMetricsBuilder(ms MetricsSettings, settings receiver.CreateSettings, options ...metricBuilderOption) *MetricsBuilder {
 	mb := &MetricsBuilder{
-		startTime:                       pcommon.NewTimestampFromTime(time.Now()),
-		metricsBuffer:                   pmetric.NewMetrics(),
-		buildInfo:                       settings.BuildInfo,
-		resourceAttributesSettings:      DefaultResourceAttributesSettings(),
-		metricCouchdbAverageRequestTime: newMetricCouchdbAverageRequestTime(ms.CouchdbAverageRequestTime),
-		metricCouchdbDatabaseOpen:       newMetricCouchdbDatabaseOpen(ms.CouchdbDatabaseOpen),
-		metricCouchdbDatabaseOperations: newMetricCouchdbDatabaseOperations(ms.CouchdbDatabaseOperations),
-		metricCouchdbFileDescriptorOpen: newMetricCouchdbFileDescriptorOpen(ms.CouchdbFileDescriptorOpen),
-		metricCouchdbHttpdBulkRequests:  newMetricCouchdbHttpdBulkRequests(ms.CouchdbHttpdBulkRequests),
-		metricCouchdbHttpdRequests:      newMetricCouchdbHttpdRequests(ms.CouchdbHttpdRequests),
-		metricCouchdbHttpdResponses:     newMetricCouchdbHttpdResponses(ms.CouchdbHttpdResponses),
-		metricCouchdbHttpdViews:         newMetricCouchdbHttpdViews(ms.CouchdbHttpdViews),
+		startTime:                          pcommon.NewTimestampFromTime(time.Now()),
+		metricsBuffer:                      pmetric.NewMetrics(),
+		buildInfo:                          settings.BuildInfo,
+		resourceAttributesSettings:         DefaultResourceAttributesSettings(),
+		metricCouchdbAverageRequestTime:    newMetricCouchdbAverageRequestTime(ms.CouchdbAverageRequestTime),
+		metricCouchdbDatabaseDocumentCount: newMetricCouchdbDatabaseDocumentCount(ms.CouchdbDatabaseDocumentCount),
+		metricCouchdbDatabaseOpen:          newMetricCouchdbDatabaseOpen(ms.CouchdbDatabaseOpen),
+		metricCouchdbDatabaseOperations:    newMetricCouchdbDatabaseOperations(ms.CouchdbDatabaseOperations),
+		metricCouchdbDatabaseSize:          newMetricCouchdbDatabaseSize(ms.CouchdbDatabaseSize),
+		metricCouchdbFileDescriptorOpen:    newMetricCouchdbFileDescriptorOpen(ms.CouchdbFileDescriptorOpen),
+		metricCouchdbHttpdBulkRequests:     newMetricCouchdbHttpdBulkRequests(ms.CouchdbHttpdBulkRequests),
+		metricCouchdbHttpdRequests:         newMetricCouchdbHttpdRequests(ms.CouchdbHttpdRequests),
+		metricCouchdbHttpdResponses:        newMetricCouchdbHttpdResponses(ms.CouchdbHttpdResponses),
+		metricCouchdbHttpdViews:            newMetricCouchdbHttpdViews(ms.CouchdbHttpdViews),
+		metricCouchdbReplicationJobs:       newMetricCouchdbReplicationJobs(ms.CouchdbReplicationJobs),
 	}
 	for _, op := range options {
 		op(mb)
@@ -728,13 +1009,16 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	ils.Scope().SetVersion(mb.buildInfo.Version)
 	ils.Metrics().EnsureCapacity(mb.metricsCapacity)
 	mb.metricCouchdbAverageRequestTime.emit(ils.Metrics())
+	mb.metricCouchdbDatabaseDocumentCount.emit(ils.Metrics())
 	mb.metricCouchdbDatabaseOpen.emit(ils.Metrics())
 	mb.metricCouchdbDatabaseOperations.emit(ils.Metrics())
+	mb.metricCouchdbDatabaseSize.emit(ils.Metrics())
 	mb.metricCouchdbFileDescriptorOpen.emit(ils.Metrics())
 	mb.metricCouchdbHttpdBulkRequests.emit(ils.Metrics())
 	mb.metricCouchdbHttpdRequests.emit(ils.Metrics())
 	mb.metricCouchdbHttpdResponses.emit(ils.Metrics())
 	mb.metricCouchdbHttpdViews.emit(ils.Metrics())
+	mb.metricCouchdbReplicationJobs.emit(ils.Metrics())
 
 	for _, op := range rmo {
 		op(mb.resourceAttributesSettings, rm)
@@ -760,6 +1044,11 @@ func (mb *MetricsBuilder) RecordCouchdbAverageRequestTimeDataPoint(ts pcommon.Ti
 	mb.metricCouchdbAverageRequestTime.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordCouchdbDatabaseDocumentCountDataPoint adds a data point to couchdb.database.document.count metric.
+func (mb *MetricsBuilder) RecordCouchdbDatabaseDocumentCountDataPoint(ts pcommon.Timestamp, val int64, databaseAttributeValue string, documentStateAttributeValue AttributeDocumentState) {
+	mb.metricCouchdbDatabaseDocumentCount.recordDataPoint(mb.startTime, ts, val, databaseAttributeValue, documentStateAttributeValue.String())
+}
+
 // RecordCouchdbDatabaseOpenDataPoint adds a data point to couchdb.database.open metric.
 func (mb *MetricsBuilder) RecordCouchdbDatabaseOpenDataPoint(ts pcommon.Timestamp, val int64) {
 	mb.metricCouchdbDatabaseOpen.recordDataPoint(mb.startTime, ts, val)
@@ -770,6 +1059,11 @@ func (mb *MetricsBuilder) RecordCouchdbDatabaseOperationsDataPoint(ts pcommon.Ti
 	mb.metricCouchdbDatabaseOperations.recordDataPoint(mb.startTime, ts, val, operationAttributeValue.String())
 }
 
+// RecordCouchdbDatabaseSizeDataPoint adds a data point to couchdb.database.size metric.
+func (mb *MetricsBuilder) RecordCouchdbDatabaseSizeDataPoint(ts pcommon.Timestamp, val int64, databaseAttributeValue string, sizeTypeAttributeValue AttributeSizeType) {
+	mb.metricCouchdbDatabaseSize.recordDataPoint(mb.startTime, ts, val, databaseAttributeValue, sizeTypeAttributeValue.String())
+}
+
 // RecordCouchdbFileDescriptorOpenDataPoint adds a data point to couchdb.file_descriptor.open metric.
 func (mb *MetricsBuilder) RecordCouchdbFileDescriptorOpenDataPoint(ts pcommon.Timestamp, val int64) {
 	mb.metricCouchdbFileDescriptorOpen.recordDataPoint(mb.startTime, ts, val)
@@ -795,6 +1089,11 @@ func (mb *MetricsBuilder) RecordCouchdbHttpdViewsDataPoint(ts pcommon.Timestamp,
 	mb.metricCouchdbHttpdViews.recordDataPoint(mb.startTime, ts, val, viewAttributeValue.String())
 }
 
+// RecordCouchdbReplicationJobsDataPoint adds a data point to couchdb.replication.jobs metric.
+func (mb *MetricsBuilder) RecordCouchdbReplicationJobsDataPoint(ts pcommon.Timestamp, val int64, replicationStateAttributeValue AttributeReplicationState) {
+	mb.metricCouchdbReplicationJobs.recordDataPoint(mb.startTime, ts, val, replicationStateAttributeValue.String())
+}
+
 // Reset resets metrics builder to its initial state. It should be used when external metrics source is restarted,
 // and metrics builder should update its startTime and reset it's internal state accordingly.
 func (mb *MetricsBuilder) Reset(options ...metricBuilderOption) {
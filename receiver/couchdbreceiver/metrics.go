@@ -162,6 +162,72 @@ func (c *couchdbScraper) recordCouchdbDatabaseOperationsDataPoint(now pcommon.Ti
 	}
 }
 
+func (c *couchdbScraper) recordCouchdbDatabaseDocumentCountDataPoint(now pcommon.Timestamp, database string, info map[string]interface{}, errs *scrapererror.ScrapeErrors) {
+	states := []metadata.AttributeDocumentState{metadata.AttributeDocumentStateActive, metadata.AttributeDocumentStateDeleted}
+	keyPaths := [][]string{{"doc_count"}, {"doc_del_count"}}
+	for i := 0; i < len(states); i++ {
+		value, err := getValueFromBody(keyPaths[i], info)
+		if err != nil {
+			errs.AddPartial(1, err)
+			continue
+		}
+
+		parsedValue, err := c.parseInt(value)
+		if err != nil {
+			errs.AddPartial(1, err)
+			continue
+		}
+		c.mb.RecordCouchdbDatabaseDocumentCountDataPoint(now, parsedValue, database, states[i])
+	}
+}
+
+func (c *couchdbScraper) recordCouchdbDatabaseSizeDataPoint(now pcommon.Timestamp, database string, info map[string]interface{}, errs *scrapererror.ScrapeErrors) {
+	for sizeVal, size := range metadata.MapAttributeSizeType {
+		sizeKey := []string{"sizes", sizeVal}
+		sizeValue, err := getValueFromBody(sizeKey, info)
+		if err != nil {
+			errs.AddPartial(1, err)
+			continue
+		}
+
+		parsedValue, err := c.parseInt(sizeValue)
+		if err != nil {
+			errs.AddPartial(1, err)
+			continue
+		}
+		c.mb.RecordCouchdbDatabaseSizeDataPoint(now, parsedValue, database, size)
+	}
+}
+
+func (c *couchdbScraper) recordCouchdbReplicationJobsDataPoint(now pcommon.Timestamp, docs map[string]interface{}, errs *scrapererror.ScrapeErrors) {
+	value, err := getValueFromBody([]string{"docs"}, docs)
+	if err != nil {
+		errs.AddPartial(1, err)
+		return
+	}
+	jobs, ok := value.([]interface{})
+	if !ok {
+		errs.AddPartial(1, fmt.Errorf("could not parse replication docs"))
+		return
+	}
+
+	counts := make(map[string]int64, len(metadata.MapAttributeReplicationState))
+	for _, job := range jobs {
+		jobBody, ok := job.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if state, ok := jobBody["state"].(string); ok {
+			counts[state]++
+		}
+	}
+
+	// Every state is recorded so that a state without jobs reports zero instead of disappearing.
+	for stateVal, state := range metadata.MapAttributeReplicationState {
+		c.mb.RecordCouchdbReplicationJobsDataPoint(now, counts[stateVal], state)
+	}
+}
+
 func getValueFromBody(keys []string, body map[string]interface{}) (interface{}, error) {
 	var currentValue interface{} = body
 	for _, key := range keys {
@@ -82,5 +82,47 @@ func (c *couchdbScraper) scrape(context.Context) (pmetric.Metrics, error) {
 	c.recordCouchdbFileDescriptorOpenDataPoint(now, stats, errs)
 	c.recordCouchdbDatabaseOperationsDataPoint(now, stats, errs)
 
+	if c.config.Metrics.CouchdbDatabaseDocumentCount.Enabled || c.config.Metrics.CouchdbDatabaseSize.Enabled {
+		c.scrapeDatabases(now, errs)
+	}
+	if c.config.Metrics.CouchdbReplicationJobs.Enabled {
+		c.scrapeReplicationJobs(now, errs)
+	}
+
 	return c.mb.Emit(metadata.WithCouchdbNodeName(c.config.Endpoint)), errs.Combine()
 }
+
+func (c *couchdbScraper) scrapeDatabases(now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	databases, err := c.client.GetDatabases()
+	if err != nil {
+		c.settings.Logger.Error("Failed to fetch couchdb databases",
+			zap.String("endpoint", c.config.Endpoint),
+			zap.Error(err),
+		)
+		errs.AddPartial(2, err)
+		return
+	}
+
+	for _, database := range databases {
+		info, err := c.client.GetDatabaseInfo(database)
+		if err != nil {
+			errs.AddPartial(2, err)
+			continue
+		}
+		c.recordCouchdbDatabaseDocumentCountDataPoint(now, database, info, errs)
+		c.recordCouchdbDatabaseSizeDataPoint(now, database, info, errs)
+	}
+}
+
+func (c *couchdbScraper) scrapeReplicationJobs(now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	docs, err := c.client.GetReplicationDocs()
+	if err != nil {
+		c.settings.Logger.Error("Failed to fetch couchdb replication jobs",
+			zap.String("endpoint", c.config.Endpoint),
+			zap.Error(err),
+		)
+		errs.AddPartial(1, err)
+		return
+	}
+	c.recordCouchdbReplicationJobsDataPoint(now, docs, errs)
+}
@@ -181,6 +181,68 @@ func TestMetricSettings(t *testing.T) {
 	require.Equal(t, metrics.MetricCount(), 1)
 }
 
+func TestScrapeDatabasesAndReplication(t *testing.T) {
+	cfg := &Config{
+		HTTPClientSettings: confighttp.HTTPClientSettings{},
+		Metrics: metadata.MetricsSettings{
+			CouchdbDatabaseDocumentCount: metadata.MetricSettings{Enabled: true},
+			CouchdbDatabaseSize:          metadata.MetricSettings{Enabled: true},
+			CouchdbReplicationJobs:       metadata.MetricSettings{Enabled: true},
+		},
+	}
+
+	t.Run("scrape databases and replication jobs", func(t *testing.T) {
+		mockClient := new(MockClient)
+		mockClient.On("GetStats", "_local").Return(getStats("response_3.12.json"))
+		mockClient.On("GetDatabases").Return([]string{"otel"}, nil)
+		mockClient.On("GetDatabaseInfo", "otel").Return(getStats("database_info.json"))
+		mockClient.On("GetReplicationDocs").Return(getStats("scheduler_docs.json"))
+		scraper := newCouchdbScraper(receivertest.NewNopCreateSettings(), cfg)
+		scraper.client = mockClient
+
+		actualMetrics, err := scraper.scrape(context.Background())
+		require.NoError(t, err)
+
+		expectedFile := filepath.Join("testdata", "scraper", "databases_and_replication.json")
+		expectedMetrics, err := golden.ReadMetrics(expectedFile)
+		require.NoError(t, err)
+
+		require.NoError(t, comparetest.CompareMetrics(expectedMetrics, actualMetrics, comparetest.IgnoreMetricDataPointsOrder()))
+	})
+
+	t.Run("scrape error: get databases and replication jobs endpoint error", func(t *testing.T) {
+		mockClient := new(MockClient)
+		mockClient.On("GetStats", "_local").Return(getStats("response_3.12.json"))
+		mockClient.On("GetDatabases").Return(nil, errors.New("bad response"))
+		mockClient.On("GetReplicationDocs").Return(getStats(""))
+		scraper := newCouchdbScraper(receivertest.NewNopCreateSettings(), cfg)
+		scraper.client = mockClient
+
+		metrics, err := scraper.scrape(context.Background())
+		require.Error(t, err)
+		require.Equal(t, 0, metrics.DataPointCount())
+
+		var partialScrapeErr scrapererror.PartialScrapeError
+		require.True(t, errors.As(err, &partialScrapeErr), "returned error was not PartialScrapeError")
+		require.Equal(t, 3, partialScrapeErr.Failed)
+	})
+
+	t.Run("database info error", func(t *testing.T) {
+		mockClient := new(MockClient)
+		mockClient.On("GetStats", "_local").Return(getStats("response_3.12.json"))
+		mockClient.On("GetDatabases").Return([]string{"otel", "missing"}, nil)
+		mockClient.On("GetDatabaseInfo", "otel").Return(getStats("database_info.json"))
+		mockClient.On("GetDatabaseInfo", "missing").Return(getStats(""))
+		mockClient.On("GetReplicationDocs").Return(getStats("scheduler_docs.json"))
+		scraper := newCouchdbScraper(receivertest.NewNopCreateSettings(), cfg)
+		scraper.client = mockClient
+
+		metrics, err := scraper.scrape(context.Background())
+		require.Error(t, err)
+		require.Equal(t, 12, metrics.DataPointCount())
+	})
+}
+
 func getStats(filename string) (map[string]interface{}, error) {
 	var stats map[string]interface{}
 
@@ -251,3 +313,66 @@ func (_m *MockClient) GetStats(nodeName string) (map[string]interface{}, error)
 
 	return r0, r1
 }
+
+// GetDatabases provides a mock function with given fields:
+func (_m *MockClient) GetDatabases() ([]string, error) {
+	ret := _m.Called()
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func() []string); ok {
+		r0 = rf()
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetDatabaseInfo provides a mock function with given fields: database
+func (_m *MockClient) GetDatabaseInfo(database string) (map[string]interface{}, error) {
+	ret := _m.Called(database)
+
+	var r0 map[string]interface{}
+	if rf, ok := ret.Get(0).(func(string) map[string]interface{}); ok {
+		r0 = rf(database)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(map[string]interface{})
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(database)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetReplicationDocs provides a mock function with given fields:
+func (_m *MockClient) GetReplicationDocs() (map[string]interface{}, error) {
+	ret := _m.Called()
+
+	var r0 map[string]interface{}
+	if rf, ok := ret.Get(0).(func() map[string]interface{}); ok {
+		r0 = rf()
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(map[string]interface{})
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
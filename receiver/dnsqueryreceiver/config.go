@@ -0,0 +1,117 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dnsqueryreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/dnsqueryreceiver"
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/miekg/dns"
+	"go.opentelemetry.io/collector/receiver/scraperhelper"
+	"go.uber.org/multierr"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/dnsqueryreceiver/internal/metadata"
+)
+
+const (
+	defaultPort     = "53"
+	defaultType     = "A"
+	defaultProtocol = "udp"
+)
+
+var (
+	errNoServers  = errors.New(`"servers" must not be empty`)
+	errNoQueries  = errors.New(`"queries" must not be empty`)
+	errNoName     = errors.New(`"name" must not be empty`)
+	errBadTimeout = errors.New(`"timeout" must be positive`)
+)
+
+type Config struct {
+	scraperhelper.ScraperControllerSettings `mapstructure:",squash"`
+	Metrics                                 metadata.MetricsSettings `mapstructure:"metrics"`
+	// Servers is the list of the DNS servers to query, in the form of <host>[:<port>].
+	Servers []string `mapstructure:"servers"`
+	// Queries is the list of the queries sent to each server.
+	Queries []QueryConfig `mapstructure:"queries"`
+	// Protocol is the transport used to query the servers, "udp" or "tcp".
+	Protocol string `mapstructure:"protocol"`
+	// Timeout is the maximum duration of each query.
+	Timeout time.Duration `mapstructure:"timeout"`
+}
+
+// QueryConfig defines a DNS query and the records it is expected to return.
+type QueryConfig struct {
+	// Name is the domain name to resolve.
+	Name string `mapstructure:"name"`
+	// Type is the type of the records to resolve, e.g. "A", "AAAA" or "CNAME".
+	Type string `mapstructure:"type"`
+	// Expected is the list of the values of the records the query must return, in any order.
+	// The response isn't checked if it's empty.
+	Expected []string `mapstructure:"expected"`
+}
+
+func (cfg *Config) Validate() error {
+	var err error
+
+	if len(cfg.Servers) == 0 {
+		err = multierr.Append(err, errNoServers)
+	}
+	for _, server := range cfg.Servers {
+		if _, _, splitErr := net.SplitHostPort(serverAddress(server)); splitErr != nil {
+			err = multierr.Append(err, fmt.Errorf("invalid server %q: %w", server, splitErr))
+		}
+	}
+
+	if len(cfg.Queries) == 0 {
+		err = multierr.Append(err, errNoQueries)
+	}
+	for _, query := range cfg.Queries {
+		if query.Name == "" {
+			err = multierr.Append(err, errNoName)
+		}
+		if _, ok := dns.StringToType[query.queryType()]; !ok {
+			err = multierr.Append(err, fmt.Errorf("unsupported query type %q for %q", query.Type, query.Name))
+		}
+	}
+
+	if cfg.Protocol != "udp" && cfg.Protocol != "tcp" {
+		err = multierr.Append(err, fmt.Errorf(`"protocol" must be "udp" or "tcp", got %q`, cfg.Protocol))
+	}
+	if cfg.Timeout <= 0 {
+		err = multierr.Append(err, errBadTimeout)
+	}
+
+	return err
+}
+
+// queryType returns the type of the query, A by default.
+func (q QueryConfig) queryType() string {
+	if q.Type == "" {
+		return defaultType
+	}
+	return strings.ToUpper(q.Type)
+}
+
+// serverAddress appends the default DNS port to the server if it has none.
+func serverAddress(server string) string {
+	if _, _, err := net.SplitHostPort(server); err == nil {
+		return server
+	}
+	// IPv6 addresses must be enclosed in brackets to be joined with the port.
+	return net.JoinHostPort(strings.Trim(server, "[]"), defaultPort)
+}
@@ -0,0 +1,119 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dnsqueryreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/dnsqueryreceiver"
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+	"go.uber.org/multierr"
+)
+
+func TestValidate(t *testing.T) {
+	testCases := []struct {
+		desc        string
+		cfg         *Config
+		expectedErr error
+	}{
+		{
+			desc:        "empty config",
+			cfg:         &Config{Protocol: "udp", Timeout: time.Second},
+			expectedErr: multierr.Combine(errNoServers, errNoQueries),
+		},
+		{
+			desc: "invalid query",
+			cfg: &Config{
+				Servers:  []string{"192.0.2.53"},
+				Queries:  []QueryConfig{{Type: "A"}, {Name: "example.com", Type: "BOGUS"}},
+				Protocol: "udp",
+				Timeout:  time.Second,
+			},
+			expectedErr: multierr.Combine(
+				errNoName,
+				errors.New(`unsupported query type "BOGUS" for "example.com"`),
+			),
+		},
+		{
+			desc: "invalid protocol and timeout",
+			cfg: &Config{
+				Servers:  []string{"192.0.2.53"},
+				Queries:  []QueryConfig{{Name: "example.com"}},
+				Protocol: "quic",
+			},
+			expectedErr: multierr.Combine(
+				fmt.Errorf(`"protocol" must be "udp" or "tcp", got %q`, "quic"),
+				errBadTimeout,
+			),
+		},
+		{
+			desc: "valid config",
+			cfg: &Config{
+				Servers:  []string{"192.0.2.53", "192.0.2.54:5353", "2001:db8::53", "[2001:db8::54]:53"},
+				Queries:  []QueryConfig{{Name: "example.com"}, {Name: "example.com", Type: "aaaa"}},
+				Protocol: "tcp",
+				Timeout:  time.Second,
+			},
+			expectedErr: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			actualErr := tc.cfg.Validate()
+			if tc.expectedErr != nil {
+				require.EqualError(t, actualErr, tc.expectedErr.Error())
+			} else {
+				require.NoError(t, actualErr)
+			}
+		})
+	}
+}
+
+func TestServerAddress(t *testing.T) {
+	assert.Equal(t, "192.0.2.53:53", serverAddress("192.0.2.53"))
+	assert.Equal(t, "192.0.2.53:5353", serverAddress("192.0.2.53:5353"))
+	assert.Equal(t, "[2001:db8::53]:53", serverAddress("2001:db8::53"))
+	assert.Equal(t, "[2001:db8::53]:53", serverAddress("[2001:db8::53]"))
+	assert.Equal(t, "[2001:db8::53]:5353", serverAddress("[2001:db8::53]:5353"))
+}
+
+func TestLoadConfig(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig()
+
+	sub, err := cm.Sub(component.NewIDWithName(typeStr, "").String())
+	require.NoError(t, err)
+	require.NoError(t, component.UnmarshalConfig(sub, cfg))
+	require.NoError(t, component.ValidateConfig(cfg))
+
+	expected := factory.CreateDefaultConfig().(*Config)
+	expected.CollectionInterval = 30 * time.Second
+	expected.Servers = []string{"8.8.8.8", "1.1.1.1:53"}
+	expected.Queries = []QueryConfig{
+		{Name: "example.com", Type: "A", Expected: []string{"93.184.216.34"}},
+		{Name: "example.com", Type: "AAAA"},
+	}
+	expected.Timeout = 2 * time.Second
+	assert.Equal(t, expected, cfg)
+}
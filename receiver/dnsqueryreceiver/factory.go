@@ -0,0 +1,69 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dnsqueryreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/dnsqueryreceiver"
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/receiver"
+	"go.opentelemetry.io/collector/receiver/scraperhelper"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/dnsqueryreceiver/internal/metadata"
+)
+
+const (
+	typeStr   = "dnsquery"
+	stability = component.StabilityLevelDevelopment
+)
+
+var errConfigNotDNSQuery = errors.New("config was not a DNS query receiver config")
+
+// NewFactory creates a new receiver factory
+func NewFactory() receiver.Factory {
+	return receiver.NewFactory(
+		typeStr,
+		createDefaultConfig,
+		receiver.WithMetrics(createMetricsReceiver, stability))
+}
+
+func createDefaultConfig() component.Config {
+	return &Config{
+		ScraperControllerSettings: scraperhelper.ScraperControllerSettings{
+			CollectionInterval: 60 * time.Second,
+		},
+		Metrics:  metadata.DefaultMetricsSettings(),
+		Protocol: defaultProtocol,
+		Timeout:  5 * time.Second,
+	}
+}
+
+func createMetricsReceiver(ctx context.Context, params receiver.CreateSettings, rConf component.Config, consumer consumer.Metrics) (receiver.Metrics, error) {
+	cfg, ok := rConf.(*Config)
+	if !ok {
+		return nil, errConfigNotDNSQuery
+	}
+
+	dnsqueryScraper := newScraper(cfg, params)
+	scraper, err := scraperhelper.NewScraper(typeStr, dnsqueryScraper.scrape)
+	if err != nil {
+		return nil, err
+	}
+
+	return scraperhelper.NewScraperControllerReceiver(&cfg.ScraperControllerSettings, params, consumer, scraperhelper.AddScraper(scraper))
+}
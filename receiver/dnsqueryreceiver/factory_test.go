@@ -0,0 +1,92 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dnsqueryreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/dnsqueryreceiver"
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	"go.opentelemetry.io/collector/receiver/scraperhelper"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/dnsqueryreceiver/internal/metadata"
+)
+
+func TestNewFactory(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		testFunc func(*testing.T)
+	}{
+		{
+			desc: "creates a new factory with correct type",
+			testFunc: func(t *testing.T) {
+				factory := NewFactory()
+				require.EqualValues(t, typeStr, factory.Type())
+			},
+		},
+		{
+			desc: "creates a new factory with valid default config",
+			testFunc: func(t *testing.T) {
+				factory := NewFactory()
+
+				var expectedCfg component.Config = &Config{
+					ScraperControllerSettings: scraperhelper.ScraperControllerSettings{
+						CollectionInterval: 60 * time.Second,
+					},
+					Metrics:  metadata.DefaultMetricsSettings(),
+					Protocol: "udp",
+					Timeout:  5 * time.Second,
+				}
+
+				require.Equal(t, expectedCfg, factory.CreateDefaultConfig())
+			},
+		},
+		{
+			desc: "creates a new factory and CreateMetricsReceiver returns no error",
+			testFunc: func(t *testing.T) {
+				factory := NewFactory()
+				cfg := factory.CreateDefaultConfig()
+				_, err := factory.CreateMetricsReceiver(
+					context.Background(),
+					receivertest.NewNopCreateSettings(),
+					cfg,
+					consumertest.NewNop(),
+				)
+				require.NoError(t, err)
+			},
+		},
+		{
+			desc: "creates a new factory and CreateMetricsReceiver returns error with incorrect config",
+			testFunc: func(t *testing.T) {
+				factory := NewFactory()
+				_, err := factory.CreateMetricsReceiver(
+					context.Background(),
+					receivertest.NewNopCreateSettings(),
+					nil,
+					consumertest.NewNop(),
+				)
+				require.ErrorIs(t, err, errConfigNotDNSQuery)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, tc.testFunc)
+	}
+}
@@ -0,0 +1,161 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dnsqueryreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/dnsqueryreceiver"
+
+import (
+	"context"
+	"errors"
+	"net"
+	"os"
+	"sort"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/miekg/dns"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/dnsqueryreceiver/internal/metadata"
+)
+
+type dnsqueryScraper struct {
+	client   *dns.Client
+	cfg      *Config
+	settings component.TelemetrySettings
+	mb       *metadata.MetricsBuilder
+}
+
+func (d *dnsqueryScraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
+	now := pcommon.NewTimestampFromTime(time.Now())
+
+	for _, server := range d.cfg.Servers {
+		address := serverAddress(server)
+		for _, query := range d.cfg.Queries {
+			d.query(ctx, now, address, query)
+		}
+	}
+
+	return d.mb.Emit(), nil
+}
+
+// query sends a query to a server and records its outcome. The failures are recorded
+// as metrics, so that the other queries are still sent.
+func (d *dnsqueryScraper) query(ctx context.Context, now pcommon.Timestamp, address string, query QueryConfig) {
+	queryType := query.queryType()
+	msg := new(dns.Msg)
+	msg.SetQuestion(dns.Fqdn(query.Name), dns.StringToType[queryType])
+
+	resp, rtt, err := d.client.ExchangeContext(ctx, msg, address)
+	if err != nil {
+		d.mb.RecordDnsqueryErrorDataPoint(now, int64(1), address, query.Name, queryType, errorType(err))
+		return
+	}
+
+	d.mb.RecordDnsqueryDurationDataPoint(now, rtt.Milliseconds(), address, query.Name, queryType)
+	d.mb.RecordDnsqueryResponseCodeDataPoint(now, int64(1), address, query.Name, queryType, dns.RcodeToString[resp.Rcode])
+
+	if len(query.Expected) > 0 {
+		mismatch := int64(0)
+		if !sameValues(answerValues(resp, dns.StringToType[queryType]), query.Expected) {
+			mismatch = 1
+		}
+		d.mb.RecordDnsqueryMismatchDataPoint(now, mismatch, address, query.Name, queryType)
+	}
+}
+
+// errorType maps the error of a query to a type, since the error messages hold values like the
+// ephemeral local port that would make each failure a new series.
+func errorType(err error) metadata.AttributeErrorType {
+	var netErr net.Error
+	switch {
+	case errors.Is(err, context.DeadlineExceeded), errors.Is(err, os.ErrDeadlineExceeded),
+		errors.As(err, &netErr) && netErr.Timeout():
+		return metadata.AttributeErrorTypeTimeout
+	case errors.Is(err, syscall.ECONNREFUSED):
+		return metadata.AttributeErrorTypeRefused
+	default:
+		return metadata.AttributeErrorTypeOther
+	}
+}
+
+// answerValues returns the values of the answer records of the queried type,
+// e.g. the addresses of the A records, without the records of the CNAME chain.
+func answerValues(resp *dns.Msg, queryType uint16) []string {
+	var values []string
+	for _, rr := range resp.Answer {
+		if rr.Header().Rrtype != queryType {
+			continue
+		}
+		var value string
+		switch record := rr.(type) {
+		case *dns.A:
+			value = record.A.String()
+		case *dns.AAAA:
+			value = record.AAAA.String()
+		case *dns.CNAME:
+			value = record.Target
+		case *dns.NS:
+			value = record.Ns
+		case *dns.PTR:
+			value = record.Ptr
+		case *dns.MX:
+			value = record.Mx
+		case *dns.TXT:
+			value = strings.Join(record.Txt, "")
+		default:
+			value = strings.TrimPrefix(rr.String(), rr.Header().String())
+		}
+		values = append(values, value)
+	}
+	return values
+}
+
+// sameValues returns true if both lists hold the same values in any order. The names are compared
+// case-insensitively and with or without the trailing dot.
+func sameValues(actual []string, expected []string) bool {
+	if len(actual) != len(expected) {
+		return false
+	}
+	normalize := func(values []string) []string {
+		normalized := make([]string, len(values))
+		for i, value := range values {
+			normalized[i] = strings.ToLower(strings.TrimSuffix(value, "."))
+		}
+		sort.Strings(normalized)
+		return normalized
+	}
+	a, e := normalize(actual), normalize(expected)
+	for i := range a {
+		if a[i] != e[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func newScraper(conf *Config, settings receiver.CreateSettings) *dnsqueryScraper {
+	return &dnsqueryScraper{
+		client: &dns.Client{
+			Net:     conf.Protocol,
+			Timeout: conf.Timeout,
+		},
+		cfg:      conf,
+		settings: settings.TelemetrySettings,
+		mb:       metadata.NewMetricsBuilder(conf.Metrics, settings),
+	}
+}
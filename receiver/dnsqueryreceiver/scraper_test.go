@@ -0,0 +1,175 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dnsqueryreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/dnsqueryreceiver"
+
+import (
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/comparetest"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/comparetest/golden"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/dnsqueryreceiver/internal/metadata"
+)
+
+// newMockServer starts a DNS server answering the A queries of example.com and other.example.com,
+// and NXDOMAIN for the other names. It returns the address of the server.
+func newMockServer(t *testing.T) string {
+	records := map[string]dns.RR{}
+	for _, record := range []string{
+		"example.com. 60 IN A 192.0.2.1",
+		"other.example.com. 60 IN A 192.0.2.2",
+	} {
+		rr, err := dns.NewRR(record)
+		require.NoError(t, err)
+		records[rr.Header().Name] = rr
+	}
+
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	started := make(chan struct{})
+	server := &dns.Server{
+		PacketConn:        pc,
+		NotifyStartedFunc: func() { close(started) },
+		Handler: dns.HandlerFunc(func(w dns.ResponseWriter, req *dns.Msg) {
+			resp := new(dns.Msg)
+			rr, ok := records[req.Question[0].Name]
+			if !ok {
+				resp.SetRcode(req, dns.RcodeNameError)
+				_ = w.WriteMsg(resp)
+				return
+			}
+			resp.SetReply(req)
+			resp.Answer = append(resp.Answer, rr)
+			_ = w.WriteMsg(resp)
+		}),
+	}
+	go func() {
+		_ = server.ActivateAndServe()
+	}()
+	<-started
+	t.Cleanup(func() {
+		_ = server.Shutdown()
+	})
+	return pc.LocalAddr().String()
+}
+
+// unreachableServer returns the address of a closed UDP port.
+func unreachableServer(t *testing.T) string {
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	address := pc.LocalAddr().String()
+	require.NoError(t, pc.Close())
+	return address
+}
+
+func TestScraperScrape(t *testing.T) {
+	testCases := []struct {
+		desc           string
+		server         func(t *testing.T) string
+		queries        []QueryConfig
+		expectedFile   string
+		compareOptions []comparetest.MetricsCompareOption
+	}{
+		{
+			desc:   "Successful queries",
+			server: newMockServer,
+			queries: []QueryConfig{
+				{Name: "example.com", Expected: []string{"192.0.2.1"}},
+				{Name: "other.example.com", Type: "a", Expected: []string{"192.0.2.9"}},
+				{Name: "missing.example.com"},
+			},
+			expectedFile: "metrics_golden.json",
+			compareOptions: []comparetest.MetricsCompareOption{
+				comparetest.IgnoreMetricAttributeValue("dns.server"),
+				comparetest.IgnoreMetricValues("dnsquery.duration"),
+				comparetest.IgnoreMetricDataPointsOrder(),
+			},
+		},
+		{
+			desc:   "Unreachable server",
+			server: unreachableServer,
+			queries: []QueryConfig{
+				{Name: "example.com", Expected: []string{"192.0.2.1"}},
+			},
+			expectedFile: "server_unreachable.json",
+			compareOptions: []comparetest.MetricsCompareOption{
+				comparetest.IgnoreMetricAttributeValue("dns.server"),
+				// Windows reports the closed port as a connection reset.
+				comparetest.IgnoreMetricAttributeValue("error.type"),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			cfg.Servers = []string{tc.server(t)}
+			cfg.Queries = tc.queries
+			cfg.Timeout = time.Second
+			require.NoError(t, cfg.Validate())
+
+			scraper := newScraper(cfg, receivertest.NewNopCreateSettings())
+			actualMetrics, err := scraper.scrape(context.Background())
+			require.NoError(t, err)
+
+			expectedMetrics, err := golden.ReadMetrics(filepath.Join("testdata", "expected_metrics", tc.expectedFile))
+			require.NoError(t, err)
+
+			require.NoError(t, comparetest.CompareMetrics(expectedMetrics, actualMetrics, tc.compareOptions...))
+		})
+	}
+}
+
+func TestErrorType(t *testing.T) {
+	assert.Equal(t, metadata.AttributeErrorTypeTimeout, errorType(&net.OpError{Op: "read", Net: "udp", Err: os.ErrDeadlineExceeded}))
+	assert.Equal(t, metadata.AttributeErrorTypeTimeout, errorType(context.DeadlineExceeded))
+	assert.Equal(t, metadata.AttributeErrorTypeRefused, errorType(&net.OpError{Op: "read", Net: "udp", Err: os.NewSyscallError("read", syscall.ECONNREFUSED)}))
+	assert.Equal(t, metadata.AttributeErrorTypeOther, errorType(dns.ErrId))
+}
+
+func TestSameValues(t *testing.T) {
+	assert.True(t, sameValues([]string{"192.0.2.1", "192.0.2.2"}, []string{"192.0.2.2", "192.0.2.1"}))
+	assert.True(t, sameValues([]string{"Target.Example.com."}, []string{"target.example.com"}))
+	assert.False(t, sameValues([]string{"192.0.2.1"}, []string{"192.0.2.1", "192.0.2.2"}))
+	assert.False(t, sameValues([]string{"192.0.2.1"}, []string{"192.0.2.2"}))
+}
+
+func TestAnswerValues(t *testing.T) {
+	resp := new(dns.Msg)
+	for _, record := range []string{
+		"www.example.com. 60 IN CNAME example.com.",
+		"example.com. 60 IN A 192.0.2.1",
+		"example.com. 60 IN TXT \"v=spf1\" \" -all\"",
+	} {
+		rr, err := dns.NewRR(record)
+		require.NoError(t, err)
+		resp.Answer = append(resp.Answer, rr)
+	}
+
+	assert.Equal(t, []string{"192.0.2.1"}, answerValues(resp, dns.TypeA))
+	assert.Equal(t, []string{"example.com."}, answerValues(resp, dns.TypeCNAME))
+	assert.Equal(t, []string{"v=spf1 -all"}, answerValues(resp, dns.TypeTXT))
+	assert.Empty(t, answerValues(resp, dns.TypeAAAA))
+}
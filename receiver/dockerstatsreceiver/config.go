@@ -22,6 +22,7 @@ import (
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/docker"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/dockerstatsreceiver/internal/metadata"
 )
 
@@ -32,6 +33,10 @@ type Config struct {
 	// The URL of the docker server.  Default is "unix:///var/run/docker.sock"
 	Endpoint string `mapstructure:"endpoint"`
 
+	// The container runtime API served by the endpoint, either "docker" or "cri" for a CRI
+	// runtime like containerd. Default is "docker"
+	Runtime string `mapstructure:"runtime"`
+
 	// The maximum amount of time to wait for docker API responses.  Default is 5s
 	Timeout time.Duration `mapstructure:"timeout"`
 
@@ -70,8 +75,14 @@ func (config Config) Validate() error {
 	if config.CollectionInterval == 0 {
 		return errors.New("collection_interval must be a positive duration")
 	}
-	if config.DockerAPIVersion < minimalRequiredDockerAPIVersion {
-		return fmt.Errorf("api_version must be at least %v", minimalRequiredDockerAPIVersion)
+	switch config.Runtime {
+	case "", docker.RuntimeDocker:
+		if config.DockerAPIVersion < minimalRequiredDockerAPIVersion {
+			return fmt.Errorf("api_version must be at least %v", minimalRequiredDockerAPIVersion)
+		}
+	case docker.RuntimeCRI:
+	default:
+		return fmt.Errorf("runtime must be either %q or %q, got %q", docker.RuntimeDocker, docker.RuntimeCRI, config.Runtime)
 	}
 	return nil
 }
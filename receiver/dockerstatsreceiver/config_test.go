@@ -49,6 +49,7 @@ func TestLoadConfig(t *testing.T) {
 				},
 
 				Endpoint:         "http://example.com/",
+				Runtime:          "docker",
 				Timeout:          20 * time.Second,
 				DockerAPIVersion: 1.24,
 
@@ -79,6 +80,15 @@ func TestLoadConfig(t *testing.T) {
 				}(),
 			},
 		},
+		{
+			id: component.NewIDWithName(typeStr, "cri"),
+			expected: func() component.Config {
+				cfg := createDefaultConfig().(*Config)
+				cfg.Endpoint = "unix:///run/containerd/containerd.sock"
+				cfg.Runtime = "cri"
+				return cfg
+			}(),
+		},
 	}
 
 	for _, tt := range tests {
@@ -110,4 +120,10 @@ func TestValidateErrors(t *testing.T) {
 
 	cfg = &Config{ScraperControllerSettings: scraperhelper.ScraperControllerSettings{CollectionInterval: 1 * time.Second}, Endpoint: "someEndpoint", DockerAPIVersion: 1.21}
 	assert.Equal(t, "api_version must be at least 1.22", component.ValidateConfig(cfg).Error())
+
+	cfg = &Config{ScraperControllerSettings: scraperhelper.ScraperControllerSettings{CollectionInterval: 1 * time.Second}, Endpoint: "someEndpoint", Runtime: "cri", DockerAPIVersion: 1.21}
+	assert.NoError(t, component.ValidateConfig(cfg))
+
+	cfg = &Config{ScraperControllerSettings: scraperhelper.ScraperControllerSettings{CollectionInterval: 1 * time.Second}, Endpoint: "someEndpoint", Runtime: "podman"}
+	assert.Equal(t, `runtime must be either "docker" or "cri", got "podman"`, component.ValidateConfig(cfg).Error())
 }
@@ -24,6 +24,7 @@ import (
 	rcvr "go.opentelemetry.io/collector/receiver"
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/docker"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/dockerstatsreceiver/internal/metadata"
 )
 
@@ -56,6 +57,7 @@ func createDefaultConfig() component.Config {
 	return &Config{
 		ScraperControllerSettings: scs,
 		Endpoint:                  "unix:///var/run/docker.sock",
+		Runtime:                   docker.RuntimeDocker,
 		Timeout:                   5 * time.Second,
 		DockerAPIVersion:          defaultDockerAPIVersion,
 		MetricsConfig:             metadata.DefaultMetricsSettings(),
@@ -81,7 +83,7 @@ func createMetricsReceiver(
 				"See the dockerstatsreceiver/README.md for more info.")
 	}
 
-	scrp, err := scraperhelper.NewScraper(typeStr, scrapeFunc, scraperhelper.WithStart(dsr.start))
+	scrp, err := scraperhelper.NewScraper(typeStr, scrapeFunc, scraperhelper.WithStart(dsr.start), scraperhelper.WithShutdown(dsr.shutdown))
 	if err != nil {
 		return nil, err
 	}
@@ -214,7 +214,7 @@ func TestRemovedContainerRemovesRecordsIntegration(t *testing.T) {
 	_, config := factory()
 	config.ExcludedImages = append(config.ExcludedImages, "!*nginx*")
 
-	dConfig, err := docker.NewConfig(config.Endpoint, config.Timeout, config.ExcludedImages, config.DockerAPIVersion)
+	dConfig, err := docker.NewConfig(config.Endpoint, config.Runtime, config.Timeout, config.ExcludedImages, config.DockerAPIVersion)
 	require.NoError(t, err)
 
 	client, err := docker.NewDockerClient(dConfig, zap.NewNop())
@@ -40,6 +40,7 @@ type receiver struct {
 	settings rcvr.CreateSettings
 	client   *docker.Client
 	mb       *metadata.MetricsBuilder
+	cancel   context.CancelFunc
 }
 
 func newReceiver(set rcvr.CreateSettings, config *Config) *receiver {
@@ -54,7 +55,7 @@ func newReceiver(set rcvr.CreateSettings, config *Config) *receiver {
 }
 
 func (r *receiver) start(ctx context.Context, _ component.Host) error {
-	dConfig, err := docker.NewConfig(r.config.Endpoint, r.config.Timeout, r.config.ExcludedImages, r.config.DockerAPIVersion)
+	dConfig, err := docker.NewConfig(r.config.Endpoint, r.config.Runtime, r.config.Timeout, r.config.ExcludedImages, r.config.DockerAPIVersion)
 	if err != nil {
 		return err
 	}
@@ -68,7 +69,19 @@ func (r *receiver) start(ctx context.Context, _ component.Host) error {
 		return err
 	}
 
-	go r.client.ContainerEventLoop(ctx)
+	var eventCtx context.Context
+	eventCtx, r.cancel = context.WithCancel(context.Background())
+	go r.client.ContainerEventLoop(eventCtx)
+	return nil
+}
+
+func (r *receiver) shutdown(context.Context) error {
+	if r.cancel != nil {
+		r.cancel()
+	}
+	if r.client != nil {
+		return r.client.Close()
+	}
 	return nil
 }
 
@@ -44,6 +44,7 @@ type elasticsearchClient interface {
 	IndexStats(ctx context.Context, indices []string) (*model.IndexStats, error)
 	ClusterMetadata(ctx context.Context) (*model.ClusterMetadataResponse, error)
 	ClusterStats(ctx context.Context, nodes []string) (*model.ClusterStats, error)
+	Tasks(ctx context.Context, actions []string) (*model.Tasks, error)
 }
 
 // defaultElasticsearchClient is the main implementation of elasticsearchClient.
@@ -203,6 +204,23 @@ func (c defaultElasticsearchClient) ClusterStats(ctx context.Context, nodes []st
 	return &clusterStats, err
 }
 
+func (c defaultElasticsearchClient) Tasks(ctx context.Context, actions []string) (*model.Tasks, error) {
+	query := url.Values{}
+	query.Set("detailed", "true")
+	if len(actions) > 0 {
+		query.Set("actions", strings.Join(actions, ","))
+	}
+
+	body, err := c.doRequest(ctx, "_tasks?"+query.Encode())
+	if err != nil {
+		return nil, err
+	}
+
+	tasks := model.Tasks{}
+	err = json.Unmarshal(body, &tasks)
+	return &tasks, err
+}
+
 func (c defaultElasticsearchClient) doRequest(ctx context.Context, path string) ([]byte, error) {
 	endpoint, err := c.endpoint.Parse(path)
 	if err != nil {
@@ -588,6 +588,30 @@ func TestClusterStatsBadAuthentication(t *testing.T) {
 
 // mockServer gives a mock elasticsearch server for testing; if username or password is included, they will be required for the client.
 // otherwise, authorization is ignored.
+func TestTasks(t *testing.T) {
+	tasksJSON, err := os.ReadFile("./testdata/sample_payloads/tasks.json")
+	require.NoError(t, err)
+
+	actualTasks := model.Tasks{}
+	require.NoError(t, json.Unmarshal(tasksJSON, &actualTasks))
+
+	elasticsearchMock := mockServer(t, "", "")
+	defer elasticsearchMock.Close()
+
+	client, err := newElasticsearchClient(componenttest.NewNopTelemetrySettings(), Config{
+		HTTPClientSettings: confighttp.HTTPClientSettings{
+			Endpoint: elasticsearchMock.URL,
+		},
+	}, componenttest.NewNopHost())
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	tasks, err := client.Tasks(ctx, []string{"*search*", "indices:data/write/*"})
+	require.NoError(t, err)
+
+	require.Equal(t, &actualTasks, tasks)
+}
+
 func mockServer(t *testing.T, username, password string) *httptest.Server {
 	nodes, err := os.ReadFile("./testdata/sample_payloads/nodes_stats_linux.json")
 	require.NoError(t, err)
@@ -599,6 +623,8 @@ func mockServer(t *testing.T, username, password string) *httptest.Server {
 	require.NoError(t, err)
 	cluster, err := os.ReadFile("./testdata/sample_payloads/cluster.json")
 	require.NoError(t, err)
+	tasks, err := os.ReadFile("./testdata/sample_payloads/tasks.json")
+	require.NoError(t, err)
 
 	elasticsearchMock := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
 		if username != "" || password != "" {
@@ -640,6 +666,13 @@ func mockServer(t *testing.T, username, password string) *httptest.Server {
 			return
 		}
 
+		if req.URL.Path == "/_tasks" && req.URL.Query().Get("detailed") == "true" {
+			rw.WriteHeader(200)
+			_, err = rw.Write(tasks)
+			require.NoError(t, err)
+			return
+		}
+
 		// metadata check
 		if req.URL.Path == "/" {
 			rw.WriteHeader(200)
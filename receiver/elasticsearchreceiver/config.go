@@ -18,7 +18,10 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
+	"path/filepath"
+	"time"
 
+	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
 	"go.uber.org/multierr"
@@ -35,6 +38,9 @@ var (
 	errUsernameNotSpecified = errors.New("password was specified, but not username")
 	errPasswordNotSpecified = errors.New("username was specified, but not password")
 	errEmptyEndpoint        = errors.New("endpoint must be specified")
+	errNoLogSources         = errors.New("logs are enabled, but no slowlog files, audit log files or slow task threshold are specified")
+	errNegativeThreshold    = errors.New("slow_task_threshold must not be negative")
+	errInvalidStartAt       = errors.New("start_at must be either 'beginning' or 'end'")
 )
 
 // Config is the configuration for the elasticsearch receiver
@@ -58,6 +64,28 @@ type Config struct {
 	Username string `mapstructure:"username"`
 	// Password is the password used when making REST calls to elasticsearch. Must be specified if Username is. Not required.
 	Password string `mapstructure:"password"`
+	// Logs defines the collection of slow logs and audit logs by the logs receiver.
+	Logs LogsConfig `mapstructure:"logs"`
+}
+
+// LogsConfig is the configuration of the logs collected by the receiver.
+type LogsConfig struct {
+	// Enabled indicates whether the logs receiver collects logs.
+	Enabled bool `mapstructure:"enabled"`
+	// SlowlogFiles are glob patterns matching the index and search slowlog files written in the JSON format.
+	SlowlogFiles []string `mapstructure:"slowlog_files"`
+	// AuditLogFiles are glob patterns matching the audit log files written in the JSON format.
+	AuditLogFiles []string `mapstructure:"audit_log_files"`
+	// StartAt is where the files that aren't known yet are read from, either "beginning" or "end".
+	StartAt string `mapstructure:"start_at"`
+	// StorageID is the ID of the storage extension keeping the read positions of the files, so that
+	// the files are read from where they were left when the collector restarts.
+	StorageID *component.ID `mapstructure:"storage"`
+	// SlowTaskThreshold is the running time above which the search and indexing tasks returned by
+	// the /_tasks endpoint are reported as log records. If zero, tasks are not collected.
+	SlowTaskThreshold time.Duration `mapstructure:"slow_task_threshold"`
+	// SlowTaskActions are the task actions that are checked against SlowTaskThreshold.
+	SlowTaskActions []string `mapstructure:"slow_task_actions"`
 }
 
 // Validate validates the given config, returning an error specifying any issues with the config.
@@ -85,6 +113,25 @@ func (cfg *Config) Validate() error {
 		return multierr.Append(combinedErr, errEndpointBadScheme)
 	}
 
+	return multierr.Append(combinedErr, cfg.Logs.validate())
+}
+
+func (cfg *LogsConfig) validate() error {
+	var combinedErr error
+	for _, pattern := range append(append([]string{}, cfg.SlowlogFiles...), cfg.AuditLogFiles...) {
+		if _, err := filepath.Match(pattern, ""); err != nil {
+			combinedErr = multierr.Append(combinedErr, fmt.Errorf("invalid log file pattern '%s': %w", pattern, err))
+		}
+	}
+	if cfg.StartAt != "beginning" && cfg.StartAt != "end" {
+		combinedErr = multierr.Append(combinedErr, errInvalidStartAt)
+	}
+	if cfg.SlowTaskThreshold < 0 {
+		combinedErr = multierr.Append(combinedErr, errNegativeThreshold)
+	}
+	if cfg.Enabled && len(cfg.SlowlogFiles) == 0 && len(cfg.AuditLogFiles) == 0 && cfg.SlowTaskThreshold == 0 {
+		combinedErr = multierr.Append(combinedErr, errNoLogSources)
+	}
 	return combinedErr
 }
 
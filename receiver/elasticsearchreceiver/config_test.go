@@ -149,6 +149,70 @@ func TestValidateEndpoint(t *testing.T) {
 	}
 }
 
+func TestValidateLogs(t *testing.T) {
+	testCases := []struct {
+		desc           string
+		logs           LogsConfig
+		expectedErr    error
+		expectedErrStr string
+	}{
+		{
+			desc: "Logs disabled",
+			logs: LogsConfig{StartAt: "end"},
+		},
+		{
+			desc: "Slowlog files",
+			logs: LogsConfig{Enabled: true, StartAt: "end", SlowlogFiles: []string{"/var/log/elasticsearch/*_slowlog.json"}},
+		},
+		{
+			desc: "Audit log files",
+			logs: LogsConfig{Enabled: true, StartAt: "end", AuditLogFiles: []string{"/var/log/elasticsearch/*_audit.json"}},
+		},
+		{
+			desc: "Slow tasks",
+			logs: LogsConfig{Enabled: true, StartAt: "end", SlowTaskThreshold: 5 * time.Second},
+		},
+		{
+			desc:        "No log source",
+			logs:        LogsConfig{Enabled: true, StartAt: "end"},
+			expectedErr: errNoLogSources,
+		},
+		{
+			desc:        "Negative threshold",
+			logs:        LogsConfig{StartAt: "end", SlowTaskThreshold: -time.Second},
+			expectedErr: errNegativeThreshold,
+		},
+		{
+			desc:        "Invalid start_at",
+			logs:        LogsConfig{Enabled: true, StartAt: "middle", AuditLogFiles: []string{"/var/log/elasticsearch/*_audit.json"}},
+			expectedErr: errInvalidStartAt,
+		},
+		{
+			desc:           "Invalid pattern",
+			logs:           LogsConfig{Enabled: true, StartAt: "end", AuditLogFiles: []string{"/var/log/["}},
+			expectedErrStr: "invalid log file pattern",
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.desc, func(t *testing.T) {
+			cfg := NewFactory().CreateDefaultConfig().(*Config)
+			cfg.Logs = testCase.logs
+
+			err := component.ValidateConfig(cfg)
+
+			switch {
+			case testCase.expectedErr != nil:
+				require.ErrorIs(t, err, testCase.expectedErr)
+			case testCase.expectedErrStr != "":
+				require.Error(t, err)
+				require.Contains(t, err.Error(), testCase.expectedErrStr)
+			default:
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestLoadConfig(t *testing.T) {
 	t.Parallel()
 
@@ -181,8 +245,29 @@ func TestLoadConfig(t *testing.T) {
 					Timeout:  10000000000,
 					Endpoint: "http://example.com:9200",
 				},
+				Logs: LogsConfig{
+					StartAt:         "end",
+					SlowTaskActions: []string{"*search*", "indices:data/write/*"},
+				},
 			},
 		},
+		{
+			id: component.NewIDWithName(typeStr, "logs"),
+			expected: func() component.Config {
+				cfg := createDefaultConfig().(*Config)
+				storageID := component.NewID("file_storage")
+				cfg.Logs = LogsConfig{
+					Enabled:           true,
+					SlowlogFiles:      []string{"/var/log/elasticsearch/*_index_search_slowlog.json", "/var/log/elasticsearch/*_index_indexing_slowlog.json"},
+					AuditLogFiles:     []string{"/var/log/elasticsearch/*_audit.json"},
+					StartAt:           "beginning",
+					StorageID:         &storageID,
+					SlowTaskThreshold: 5 * time.Second,
+					SlowTaskActions:   []string{"indices:data/read/search*"},
+				}
+				return cfg
+			}(),
+		},
 	}
 
 	for _, tt := range tests {
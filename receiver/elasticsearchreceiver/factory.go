@@ -31,6 +31,7 @@ import (
 const (
 	typeStr                   = "elasticsearch"
 	stability                 = component.StabilityLevelBeta
+	logsStability             = component.StabilityLevelDevelopment
 	defaultCollectionInterval = 10 * time.Second
 	defaultHTTPClientTimeout  = 10 * time.Second
 )
@@ -40,7 +41,8 @@ func NewFactory() receiver.Factory {
 	return receiver.NewFactory(
 		typeStr,
 		createDefaultConfig,
-		receiver.WithMetrics(createMetricsReceiver, stability))
+		receiver.WithMetrics(createMetricsReceiver, stability),
+		receiver.WithLogs(createLogsReceiver, logsStability))
 }
 
 // createDefaultConfig creates the default elasticsearchreceiver config.
@@ -56,10 +58,17 @@ func createDefaultConfig() component.Config {
 		Metrics: metadata.DefaultMetricsSettings(),
 		Nodes:   []string{"_all"},
 		Indices: []string{"_all"},
+		Logs: LogsConfig{
+			StartAt:         "end",
+			SlowTaskActions: []string{"*search*", "indices:data/write/*"},
+		},
 	}
 }
 
-var errConfigNotES = errors.New("config was not an elasticsearch receiver config")
+var (
+	errConfigNotES    = errors.New("config was not an elasticsearch receiver config")
+	errLogsNotEnabled = errors.New("logs must be enabled to create an elasticsearch logs receiver")
+)
 
 // createMetricsReceiver creates a metrics receiver for scraping elasticsearch metrics.
 func createMetricsReceiver(
@@ -86,3 +95,23 @@ func createMetricsReceiver(
 		scraperhelper.AddScraper(scraper),
 	)
 }
+
+// createLogsReceiver creates a logs receiver collecting elasticsearch slow logs and audit logs.
+func createLogsReceiver(
+	_ context.Context,
+	params receiver.CreateSettings,
+	rConf component.Config,
+	consumer consumer.Logs,
+) (receiver.Logs, error) {
+	c, ok := rConf.(*Config)
+	if !ok {
+		return nil, errConfigNotES
+	}
+	if consumer == nil {
+		return nil, component.ErrNilNextConsumer
+	}
+	if !c.Logs.Enabled {
+		return nil, errLogsNotEnabled
+	}
+	return newLogsReceiver(params, c, consumer)
+}
@@ -20,6 +20,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/consumer/consumertest"
 	"go.opentelemetry.io/collector/receiver/receivertest"
 )
@@ -77,3 +78,57 @@ func TestCreateMetricsReceiver(t *testing.T) {
 		t.Run(testCase.desc, testCase.run)
 	}
 }
+
+func TestCreateLogsReceiver(t *testing.T) {
+	enabledConfig := func() component.Config {
+		cfg := createDefaultConfig().(*Config)
+		cfg.Logs.Enabled = true
+		cfg.Logs.SlowlogFiles = []string{"/var/log/elasticsearch/*_slowlog.json"}
+		return cfg
+	}
+
+	testCases := []struct {
+		desc        string
+		cfg         component.Config
+		consumer    consumer.Logs
+		expectedErr error
+	}{
+		{
+			desc:     "Logs enabled",
+			cfg:      enabledConfig(),
+			consumer: consumertest.NewNop(),
+		},
+		{
+			desc:        "Default config",
+			cfg:         createDefaultConfig(),
+			consumer:    consumertest.NewNop(),
+			expectedErr: errLogsNotEnabled,
+		},
+		{
+			desc:        "Nil config",
+			consumer:    consumertest.NewNop(),
+			expectedErr: errConfigNotES,
+		},
+		{
+			desc:        "Nil consumer",
+			cfg:         enabledConfig(),
+			expectedErr: component.ErrNilNextConsumer,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.desc, func(t *testing.T) {
+			_, err := createLogsReceiver(
+				context.Background(),
+				receivertest.NewNopCreateSettings(),
+				testCase.cfg,
+				testCase.consumer,
+			)
+			if testCase.expectedErr != nil {
+				require.ErrorIs(t, err, testCase.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -153,6 +153,29 @@ func (_m *MockElasticsearchClient) NodeStats(ctx context.Context, nodes []string
 	return r0, r1
 }
 
+// Tasks provides a mock function with given fields: ctx, actions
+func (_m *MockElasticsearchClient) Tasks(ctx context.Context, actions []string) (*model.Tasks, error) {
+	ret := _m.Called(ctx, actions)
+
+	var r0 *model.Tasks
+	if rf, ok := ret.Get(0).(func(context.Context, []string) *model.Tasks); ok {
+		r0 = rf(ctx, actions)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Tasks)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, actions)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 type mockConstructorTestingTNewMockElasticsearchClient interface {
 	mock.TestingT
 	Cleanup(func())
@@ -0,0 +1,41 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/elasticsearchreceiver/internal/model"
+
+// Tasks represents a response from elasticsearch's /_tasks endpoint.
+// The struct is not exhaustive; It does not provide all values returned by elasticsearch,
+// only the ones relevant to the logs collected by the receiver.
+type Tasks struct {
+	Nodes map[string]TasksNode `json:"nodes"`
+}
+
+// TasksNode holds the tasks running on a node.
+type TasksNode struct {
+	Name  string          `json:"name"`
+	Tasks map[string]Task `json:"tasks"`
+}
+
+// Task is a task running on a node.
+type Task struct {
+	Node               string `json:"node"`
+	ID                 int64  `json:"id"`
+	Type               string `json:"type"`
+	Action             string `json:"action"`
+	Description        string `json:"description"`
+	StartTimeInMillis  int64  `json:"start_time_in_millis"`
+	RunningTimeInNanos int64  `json:"running_time_in_nanos"`
+	Cancellable        bool   `json:"cancellable"`
+	ParentTaskID       string `json:"parent_task_id"`
+}
@@ -0,0 +1,372 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package elasticsearchreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/elasticsearchreceiver"
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/extension/experimental/storage"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/receiver"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/adapter"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
+)
+
+const (
+	logTypeSlowlog  = "slowlog"
+	logTypeAudit    = "audit"
+	logTypeSlowTask = "slow_task"
+)
+
+// timestampLayouts are the layouts of the timestamps written by Elasticsearch in the JSON log files,
+// after the comma separating the fractional seconds is replaced with a dot.
+var timestampLayouts = []string{
+	"2006-01-02T15:04:05.999999999Z07:00",
+	"2006-01-02T15:04:05.999999999Z0700",
+}
+
+// slowlogIndexPattern extracts the index name from the message of a slowlog entry, e.g. "[my-index][0]".
+var slowlogIndexPattern = regexp.MustCompile(`^\[([^\]]+)\]`)
+
+var severityNumbers = map[string]plog.SeverityNumber{
+	"TRACE": plog.SeverityNumberTrace,
+	"DEBUG": plog.SeverityNumberDebug,
+	"INFO":  plog.SeverityNumberInfo,
+	"WARN":  plog.SeverityNumberWarn,
+	"ERROR": plog.SeverityNumberError,
+	"FATAL": plog.SeverityNumberFatal,
+}
+
+// logsReceiver collects the slowlog and audit log entries appended to the configured files, and the
+// search and indexing tasks running for longer than the configured threshold.
+type logsReceiver struct {
+	settings component.TelemetrySettings
+	id       component.ID
+	cfg      *Config
+	consumer consumer.Logs
+	client   elasticsearchClient
+
+	// inputs tail the slowlog and audit log files, keyed by log type.
+	inputs        map[string]*fileconsumer.Manager
+	storageClient storage.Client
+	// reportedTasks holds the IDs of the running tasks that have already been reported.
+	reportedTasks map[string]struct{}
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func newLogsReceiver(settings receiver.CreateSettings, cfg *Config, consumer consumer.Logs) (*logsReceiver, error) {
+	r := &logsReceiver{
+		settings:      settings.TelemetrySettings,
+		id:            settings.ID,
+		cfg:           cfg,
+		consumer:      consumer,
+		inputs:        map[string]*fileconsumer.Manager{},
+		reportedTasks: map[string]struct{}{},
+	}
+	for logType, patterns := range map[string][]string{
+		logTypeSlowlog: cfg.Logs.SlowlogFiles,
+		logTypeAudit:   cfg.Logs.AuditLogFiles,
+	} {
+		if len(patterns) == 0 {
+			continue
+		}
+		input, err := r.buildInput(logType, patterns)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build the %s input: %w", logType, err)
+		}
+		r.inputs[logType] = input
+	}
+	return r, nil
+}
+
+// buildInput returns a file consumer sending each line of the files matching the patterns as a log
+// record. The lines are only sent once they are terminated, since Elasticsearch writes an entry per line.
+func (r *logsReceiver) buildInput(logType string, patterns []string) (*fileconsumer.Manager, error) {
+	cfg := fileconsumer.NewConfig()
+	cfg.Include = patterns
+	cfg.IncludeFileName = false
+	cfg.StartAt = r.cfg.Logs.StartAt
+	cfg.Splitter.Flusher.Period = 0
+	return cfg.Build(r.settings.Logger.Sugar(), func(ctx context.Context, _ *fileconsumer.FileAttributes, token []byte) {
+		line := string(token)
+		if strings.TrimSpace(line) == "" {
+			return
+		}
+		logs, records := newLogs()
+		fillLogRecord(records.AppendEmpty(), line, logType, pcommon.NewTimestampFromTime(time.Now()))
+		if err := r.consumer.ConsumeLogs(ctx, logs); err != nil {
+			r.settings.Logger.Error("Failed to consume Elasticsearch logs", zap.Error(err))
+		}
+	})
+}
+
+func (r *logsReceiver) Start(ctx context.Context, host component.Host) error {
+	if r.cfg.Logs.SlowTaskThreshold > 0 {
+		client, err := newElasticsearchClient(r.settings, *r.cfg, host)
+		if err != nil {
+			return err
+		}
+		r.client = client
+	}
+
+	if len(r.inputs) > 0 {
+		storageClient, err := adapter.GetStorageClient(ctx, host, r.cfg.Logs.StorageID, r.id)
+		if err != nil {
+			return err
+		}
+		r.storageClient = storageClient
+		// The inputs share the storage client, their read positions are kept under the log type.
+		for logType, input := range r.inputs {
+			if err = input.Start(operator.NewScopedPersister(logType, storageClient)); err != nil {
+				return err
+			}
+		}
+	}
+
+	if r.client == nil {
+		return nil
+	}
+
+	tasksCtx, cancel := context.WithCancel(context.Background())
+	r.cancel = cancel
+
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		ticker := time.NewTicker(r.cfg.CollectionInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-tasksCtx.Done():
+				return
+			case <-ticker.C:
+				r.collect(tasksCtx)
+			}
+		}
+	}()
+	return nil
+}
+
+func (r *logsReceiver) Shutdown(ctx context.Context) error {
+	if r.cancel != nil {
+		r.cancel()
+	}
+	r.wg.Wait()
+
+	var errs error
+	for _, input := range r.inputs {
+		errs = multierr.Append(errs, input.Stop())
+	}
+	if r.storageClient != nil {
+		errs = multierr.Append(errs, r.storageClient.Close(ctx))
+	}
+	return errs
+}
+
+// collect gathers the slow tasks and sends them to the next consumer.
+func (r *logsReceiver) collect(ctx context.Context) {
+	logs, records := newLogs()
+	r.collectSlowTasks(ctx, records, pcommon.NewTimestampFromTime(time.Now()))
+
+	if records.Len() == 0 {
+		return
+	}
+	if err := r.consumer.ConsumeLogs(ctx, logs); err != nil {
+		r.settings.Logger.Error("Failed to consume Elasticsearch logs", zap.Error(err))
+	}
+}
+
+// newLogs returns logs holding a single scope, and the records of the scope.
+func newLogs() (plog.Logs, plog.LogRecordSlice) {
+	logs := plog.NewLogs()
+	scopeLogs := logs.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty()
+	scopeLogs.Scope().SetName("otelcol/elasticsearchreceiver")
+	return logs, scopeLogs.LogRecords()
+}
+
+// fillLogRecord converts a line of an Elasticsearch JSON log file into a log record. Both the
+// format of Elasticsearch 7.x and the ECS format of Elasticsearch 8.x are supported. The body
+// of the record is the line itself.
+func fillLogRecord(record plog.LogRecord, line string, logType string, now pcommon.Timestamp) {
+	record.SetObservedTimestamp(now)
+	record.Body().SetStr(line)
+
+	entry := map[string]interface{}{}
+	if err := json.Unmarshal([]byte(line), &entry); err != nil {
+		record.Attributes().PutStr("elasticsearch.log.type", logType)
+		return
+	}
+
+	if ts, ok := parseTimestamp(firstString(entry, "@timestamp", "timestamp")); ok {
+		record.SetTimestamp(ts)
+	}
+	if level := firstString(entry, "log.level", "level"); level != "" {
+		record.SetSeverityText(level)
+		record.SetSeverityNumber(severityNumbers[strings.ToUpper(level)])
+	}
+
+	attrs := record.Attributes()
+	if t := firstString(entry, "type"); t != "" {
+		logType = t
+	} else if dataset := firstString(entry, "event.dataset"); dataset != "" {
+		logType = strings.TrimPrefix(dataset, "elasticsearch.")
+	}
+	attrs.PutStr("elasticsearch.log.type", logType)
+	putString(attrs, "elasticsearch.cluster.name", firstString(entry, "cluster.name", "elasticsearch.cluster.name"))
+	putString(attrs, "elasticsearch.node.name", firstString(entry, "node.name", "elasticsearch.node.name"))
+
+	index := firstString(entry, "elasticsearch.index.name")
+	if index == "" {
+		if match := slowlogIndexPattern.FindStringSubmatch(firstString(entry, "message", "elasticsearch.slowlog.message")); match != nil {
+			index = match[1]
+		}
+	}
+	putString(attrs, "elasticsearch.index.name", index)
+
+	if indices, ok := entry["indices"].([]interface{}); ok && len(indices) > 0 {
+		names := attrs.PutEmptySlice("elasticsearch.index.names")
+		for _, name := range indices {
+			if s, ok := name.(string); ok {
+				names.AppendEmpty().SetStr(s)
+			}
+		}
+	}
+
+	if took, ok := tookMillis(entry); ok {
+		attrs.PutInt("elasticsearch.slowlog.took_millis", took)
+	}
+	putString(attrs, "elasticsearch.audit.action", firstString(entry, "event.action"))
+	putString(attrs, "elasticsearch.audit.user.name", firstString(entry, "user.name"))
+}
+
+func (r *logsReceiver) collectSlowTasks(ctx context.Context, records plog.LogRecordSlice, now pcommon.Timestamp) {
+	tasks, err := r.client.Tasks(ctx, r.cfg.Logs.SlowTaskActions)
+	if err != nil {
+		r.settings.Logger.Error("Failed to fetch Elasticsearch tasks", zap.Error(err))
+		return
+	}
+
+	nodeIDs := make([]string, 0, len(tasks.Nodes))
+	for nodeID := range tasks.Nodes {
+		nodeIDs = append(nodeIDs, nodeID)
+	}
+	sort.Strings(nodeIDs)
+
+	running := map[string]struct{}{}
+	for _, nodeID := range nodeIDs {
+		node := tasks.Nodes[nodeID]
+		taskIDs := make([]string, 0, len(node.Tasks))
+		for taskID := range node.Tasks {
+			taskIDs = append(taskIDs, taskID)
+		}
+		sort.Strings(taskIDs)
+
+		for _, taskID := range taskIDs {
+			running[taskID] = struct{}{}
+			task := node.Tasks[taskID]
+			if time.Duration(task.RunningTimeInNanos) < r.cfg.Logs.SlowTaskThreshold {
+				continue
+			}
+			// Tasks are reported once, when they first exceed the threshold.
+			if _, ok := r.reportedTasks[taskID]; ok {
+				continue
+			}
+			r.reportedTasks[taskID] = struct{}{}
+
+			record := records.AppendEmpty()
+			record.SetTimestamp(pcommon.NewTimestampFromTime(time.UnixMilli(task.StartTimeInMillis)))
+			record.SetObservedTimestamp(now)
+			record.SetSeverityText("WARN")
+			record.SetSeverityNumber(plog.SeverityNumberWarn)
+			record.Body().SetStr(task.Description)
+
+			attrs := record.Attributes()
+			attrs.PutStr("elasticsearch.log.type", logTypeSlowTask)
+			putString(attrs, "elasticsearch.node.name", node.Name)
+			attrs.PutStr("elasticsearch.task.id", taskID)
+			attrs.PutStr("elasticsearch.task.action", task.Action)
+			attrs.PutInt("elasticsearch.task.running_time_millis", time.Duration(task.RunningTimeInNanos).Milliseconds())
+		}
+	}
+
+	// Forget the tasks that completed, so that the set doesn't grow indefinitely.
+	for taskID := range r.reportedTasks {
+		if _, ok := running[taskID]; !ok {
+			delete(r.reportedTasks, taskID)
+		}
+	}
+}
+
+func parseTimestamp(value string) (pcommon.Timestamp, bool) {
+	if value == "" {
+		return 0, false
+	}
+	value = strings.Replace(value, ",", ".", 1)
+	for _, layout := range timestampLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return pcommon.NewTimestampFromTime(t), true
+		}
+	}
+	return 0, false
+}
+
+// tookMillis returns the duration of a slowlog entry, which is written as a string or as a number
+// depending on the version of Elasticsearch.
+func tookMillis(entry map[string]interface{}) (int64, bool) {
+	for _, field := range []string{"took_millis", "elasticsearch.slowlog.took_millis"} {
+		switch v := entry[field].(type) {
+		case float64:
+			return int64(v), true
+		case string:
+			if took, err := strconv.ParseInt(v, 10, 64); err == nil {
+				return took, true
+			}
+		}
+	}
+	return 0, false
+}
+
+// firstString returns the first of the given fields of the entry that is a non-empty string.
+func firstString(entry map[string]interface{}, fields ...string) string {
+	for _, field := range fields {
+		if s, ok := entry[field].(string); ok && s != "" {
+			return s
+		}
+	}
+	return ""
+}
+
+func putString(attrs pcommon.Map, key, value string) {
+	if value != "" {
+		attrs.PutStr(key, value)
+	}
+}
@@ -0,0 +1,261 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package elasticsearchreceiver
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/storagetest"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/elasticsearchreceiver/internal/mocks"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/elasticsearchreceiver/internal/model"
+)
+
+func TestLogsReceiverFiles(t *testing.T) {
+	dir := t.TempDir()
+	slowlogPath := filepath.Join(dir, "es-cluster_index_search_slowlog.json")
+	auditPath := filepath.Join(dir, "es-cluster_audit.json")
+
+	// The entries written before the receiver starts are not collected.
+	require.NoError(t, os.WriteFile(slowlogPath, []byte(`{"type": "index_search_slowlog", "message": "[old][0]"}`+"\n"), 0600))
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Logs.Enabled = true
+	cfg.Logs.SlowlogFiles = []string{filepath.Join(dir, "*_slowlog.json")}
+	cfg.Logs.AuditLogFiles = []string{auditPath}
+
+	sink := &consumertest.LogsSink{}
+	rcvr, err := newLogsReceiver(receivertest.NewNopCreateSettings(), cfg, sink)
+	require.NoError(t, err)
+	require.NoError(t, rcvr.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() { require.NoError(t, rcvr.Shutdown(context.Background())) }()
+
+	appendFile(t, slowlogPath, filepath.Join("testdata", "logs", "slowlog.json"))
+	appendFile(t, auditPath, filepath.Join("testdata", "logs", "audit.json"))
+	// An incomplete line is left until it is terminated.
+	appendString(t, auditPath, `{"type": "audit", "event.action": "authentication_success"`)
+
+	require.Eventually(t, func() bool { return sink.LogRecordCount() == 4 }, 5*time.Second, 10*time.Millisecond)
+
+	// The files are read concurrently, so the records are matched by timestamp.
+	records := map[uint64]plog.LogRecord{}
+	for _, record := range allRecords(sink) {
+		records[uint64(record.Timestamp())] = record
+	}
+
+	expected := []struct {
+		timestamp  uint64
+		severity   plog.SeverityNumber
+		attributes map[string]interface{}
+	}{
+		{
+			timestamp: 1673344800123000000,
+			severity:  plog.SeverityNumberWarn,
+			attributes: map[string]interface{}{
+				"elasticsearch.log.type":            "index_search_slowlog",
+				"elasticsearch.cluster.name":        "es-cluster",
+				"elasticsearch.node.name":           "node-1",
+				"elasticsearch.index.name":          "my-index",
+				"elasticsearch.slowlog.took_millis": int64(1200),
+			},
+		},
+		{
+			timestamp: 1673341201000000000,
+			severity:  plog.SeverityNumberInfo,
+			attributes: map[string]interface{}{
+				"elasticsearch.log.type":            "index_indexing_slowlog",
+				"elasticsearch.cluster.name":        "es-cluster",
+				"elasticsearch.node.name":           "node-2",
+				"elasticsearch.index.name":          "logs-2023",
+				"elasticsearch.slowlog.took_millis": int64(600),
+			},
+		},
+		{
+			timestamp: 1673341202500000000,
+			severity:  plog.SeverityNumberWarn,
+			attributes: map[string]interface{}{
+				"elasticsearch.log.type":            "index_search_slowlog",
+				"elasticsearch.cluster.name":        "es-cluster",
+				"elasticsearch.node.name":           "node-3",
+				"elasticsearch.index.name":          "metrics",
+				"elasticsearch.slowlog.took_millis": int64(2500),
+			},
+		},
+		{
+			timestamp: 1673344803000000000,
+			severity:  plog.SeverityNumberUnspecified,
+			attributes: map[string]interface{}{
+				"elasticsearch.log.type":        "audit",
+				"elasticsearch.node.name":       "node-1",
+				"elasticsearch.index.names":     []interface{}{"secret-1", "secret-2"},
+				"elasticsearch.audit.action":    "access_denied",
+				"elasticsearch.audit.user.name": "alice",
+			},
+		},
+	}
+	for _, want := range expected {
+		record, ok := records[want.timestamp]
+		require.True(t, ok, "record %d", want.timestamp)
+		require.NotZero(t, record.ObservedTimestamp(), "record %d", want.timestamp)
+		require.Equal(t, want.severity, record.SeverityNumber(), "record %d", want.timestamp)
+		require.Equal(t, want.attributes, record.Attributes().AsRaw(), "record %d", want.timestamp)
+		require.True(t, json.Valid([]byte(record.Body().Str())), "record %d", want.timestamp)
+	}
+
+	// The incomplete line is collected once it is terminated.
+	appendString(t, auditPath, "}\n")
+	require.Eventually(t, func() bool { return sink.LogRecordCount() == 5 }, 5*time.Second, 10*time.Millisecond)
+	require.Equal(t, `{"type": "audit", "event.action": "authentication_success"}`, lastRecord(sink).Body().Str())
+
+	// A truncated file is read from the beginning.
+	require.NoError(t, os.WriteFile(slowlogPath, []byte(`{"type": "index_search_slowlog", "message": "[new][0]"}`+"\n"), 0600))
+	require.Eventually(t, func() bool { return sink.LogRecordCount() == 6 }, 5*time.Second, 10*time.Millisecond)
+	index, ok := lastRecord(sink).Attributes().Get("elasticsearch.index.name")
+	require.True(t, ok)
+	require.Equal(t, "new", index.Str())
+}
+
+func TestLogsReceiverFilesRestart(t *testing.T) {
+	dir := t.TempDir()
+	auditPath := filepath.Join(dir, "es-cluster_audit.json")
+	require.NoError(t, os.WriteFile(auditPath, nil, 0600))
+
+	ext := storagetest.NewFileBackedStorageExtension("test", t.TempDir())
+	host := storagetest.NewStorageHost().WithExtension(ext.ID, ext)
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Logs.Enabled = true
+	cfg.Logs.AuditLogFiles = []string{auditPath}
+	cfg.Logs.StorageID = &ext.ID
+
+	sink := &consumertest.LogsSink{}
+	rcvr, err := newLogsReceiver(receivertest.NewNopCreateSettings(), cfg, sink)
+	require.NoError(t, err)
+	require.NoError(t, rcvr.Start(context.Background(), host))
+
+	appendString(t, auditPath, `{"type": "audit", "event.action": "access_denied"}`+"\n")
+	require.Eventually(t, func() bool { return sink.LogRecordCount() == 1 }, 5*time.Second, 10*time.Millisecond)
+	require.NoError(t, rcvr.Shutdown(context.Background()))
+
+	// The entries written while the receiver is stopped are collected once it restarts, and the
+	// entries collected before aren't collected again.
+	appendString(t, auditPath, `{"type": "audit", "event.action": "authentication_success"}`+"\n")
+
+	sink.Reset()
+	rcvr, err = newLogsReceiver(receivertest.NewNopCreateSettings(), cfg, sink)
+	require.NoError(t, err)
+	require.NoError(t, rcvr.Start(context.Background(), host))
+	defer func() { require.NoError(t, rcvr.Shutdown(context.Background())) }()
+
+	require.Eventually(t, func() bool { return sink.LogRecordCount() == 1 }, 5*time.Second, 10*time.Millisecond)
+	require.Equal(t, `{"type": "audit", "event.action": "authentication_success"}`, lastRecord(sink).Body().Str())
+	require.Never(t, func() bool { return sink.LogRecordCount() > 1 }, 500*time.Millisecond, 10*time.Millisecond)
+}
+
+func TestLogsReceiverInvalidLine(t *testing.T) {
+	record := plog.NewLogRecord()
+	fillLogRecord(record, "not json", logTypeAudit, 1)
+
+	require.Equal(t, "not json", record.Body().Str())
+	require.Equal(t, map[string]interface{}{"elasticsearch.log.type": "audit"}, record.Attributes().AsRaw())
+	require.Zero(t, record.Timestamp())
+}
+
+func TestLogsReceiverSlowTasks(t *testing.T) {
+	tasksJSON, err := os.ReadFile(filepath.Join("testdata", "sample_payloads", "tasks.json"))
+	require.NoError(t, err)
+	tasks := model.Tasks{}
+	require.NoError(t, json.Unmarshal(tasksJSON, &tasks))
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Logs.Enabled = true
+	cfg.Logs.SlowTaskThreshold = 10 * time.Second
+
+	client := mocks.MockElasticsearchClient{}
+	client.On("Tasks", mock.Anything, cfg.Logs.SlowTaskActions).Return(&tasks, nil).Twice()
+	client.On("Tasks", mock.Anything, cfg.Logs.SlowTaskActions).Return(&model.Tasks{}, nil)
+
+	sink := &consumertest.LogsSink{}
+	rcvr, err := newLogsReceiver(receivertest.NewNopCreateSettings(), cfg, sink)
+	require.NoError(t, err)
+	rcvr.client = &client
+
+	rcvr.collect(context.Background())
+	require.Equal(t, 1, len(sink.AllLogs()))
+	records := sink.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords()
+	require.Equal(t, 1, records.Len())
+
+	record := records.At(0)
+	require.Equal(t, uint64(1673344800000000000), uint64(record.Timestamp()))
+	require.Equal(t, plog.SeverityNumberWarn, record.SeverityNumber())
+	require.Equal(t, `indices[my-index], search_type[QUERY_THEN_FETCH], source[{"query":{"match_all":{}}}]`, record.Body().Str())
+	require.Equal(t, map[string]interface{}{
+		"elasticsearch.log.type":                 "slow_task",
+		"elasticsearch.node.name":                "node-1",
+		"elasticsearch.task.id":                  "EPtnKtfNRbyFg9Hh7AGfcQ:1024",
+		"elasticsearch.task.action":              "indices:data/read/search",
+		"elasticsearch.task.running_time_millis": int64(12000),
+	}, record.Attributes().AsRaw())
+
+	// A task that is still running is only reported once.
+	rcvr.collect(context.Background())
+	require.Equal(t, 1, len(sink.AllLogs()))
+
+	// Completed tasks are forgotten.
+	rcvr.collect(context.Background())
+	require.Empty(t, rcvr.reportedTasks)
+}
+
+// allRecords returns the records of all the logs received by the sink.
+func allRecords(sink *consumertest.LogsSink) []plog.LogRecord {
+	var records []plog.LogRecord
+	for _, logs := range sink.AllLogs() {
+		logRecords := logs.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords()
+		for i := 0; i < logRecords.Len(); i++ {
+			records = append(records, logRecords.At(i))
+		}
+	}
+	return records
+}
+
+func lastRecord(sink *consumertest.LogsSink) plog.LogRecord {
+	records := allRecords(sink)
+	return records[len(records)-1]
+}
+
+func appendFile(t *testing.T, path, source string) {
+	data, err := os.ReadFile(source)
+	require.NoError(t, err)
+	appendString(t, path, string(data))
+}
+
+func appendString(t *testing.T, path, data string) {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	require.NoError(t, err)
+	_, err = f.WriteString(data)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+}
@@ -15,8 +15,10 @@
 package expvarreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/expvarreceiver"
 
 import (
+	"errors"
 	"fmt"
 	"net/url"
+	"strings"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config/confighttp"
@@ -29,6 +31,38 @@ type Config struct {
 	scraperhelper.ScraperControllerSettings `mapstructure:",squash"`
 	confighttp.HTTPClientSettings           `mapstructure:",squash"`
 	MetricsConfig                           metadata.MetricsSettings `mapstructure:"metrics"`
+	// CustomMetrics maps expvar variables other than memstats to metrics.
+	CustomMetrics []CustomMetricConfig `mapstructure:"custom_metrics"`
+}
+
+const (
+	customMetricTypeGauge = "gauge"
+	customMetricTypeSum   = "sum"
+
+	// pathSeparator separates the keys of a custom metric path.
+	pathSeparator = "."
+	// pathWildcard matches every key of an object, or every index of an array.
+	pathWildcard = "*"
+)
+
+// CustomMetricConfig maps the values found at a path of the expvar JSON to a metric.
+type CustomMetricConfig struct {
+	// Name of the metric.
+	Name string `mapstructure:"name"`
+	// Description of the metric.
+	Description string `mapstructure:"description"`
+	// Unit of the metric.
+	Unit string `mapstructure:"unit"`
+	// Type of the metric, either `gauge` (default) or `sum`.
+	Type string `mapstructure:"type"`
+	// Monotonic marks a sum as monotonic.
+	Monotonic bool `mapstructure:"monotonic"`
+	// Path to the value, made of the keys leading to it separated by dots, e.g. `myapp.requests.total`.
+	// Array elements are selected by their index, and a `*` key selects every key of an object or
+	// every element of an array.
+	Path string `mapstructure:"path"`
+	// KeyAttribute is the name of the attribute recording the key matched by the `*` of the path.
+	KeyAttribute string `mapstructure:"key_attribute"`
 }
 
 var _ component.Config = (*Config)(nil)
@@ -44,5 +78,53 @@ func (c *Config) Validate() error {
 	if u.Host == "" {
 		return fmt.Errorf("host not found in HTTP endpoint")
 	}
+
+	names := make(map[string]struct{}, len(c.CustomMetrics))
+	for _, m := range c.CustomMetrics {
+		if err := m.validate(); err != nil {
+			return err
+		}
+		if _, ok := names[m.Name]; ok {
+			return fmt.Errorf("custom metric %q is defined more than once", m.Name)
+		}
+		names[m.Name] = struct{}{}
+	}
+	return nil
+}
+
+func (m *CustomMetricConfig) validate() error {
+	if m.Name == "" {
+		return errors.New("custom metric name must not be empty")
+	}
+	if m.Path == "" {
+		return fmt.Errorf("custom metric %q: path must not be empty", m.Name)
+	}
+	switch m.Type {
+	case "", customMetricTypeGauge:
+		if m.Monotonic {
+			return fmt.Errorf("custom metric %q: only sums can be monotonic", m.Name)
+		}
+	case customMetricTypeSum:
+	default:
+		return fmt.Errorf("custom metric %q: type must be '%s' or '%s', but was '%s'", m.Name, customMetricTypeGauge, customMetricTypeSum, m.Type)
+	}
+
+	wildcards := 0
+	for _, key := range strings.Split(m.Path, pathSeparator) {
+		if key == "" {
+			return fmt.Errorf("custom metric %q: path %q contains an empty key", m.Name, m.Path)
+		}
+		if key == pathWildcard {
+			wildcards++
+		}
+	}
+	switch {
+	case wildcards > 1:
+		return fmt.Errorf("custom metric %q: path %q contains more than one '%s'", m.Name, m.Path, pathWildcard)
+	case wildcards == 1 && m.KeyAttribute == "":
+		return fmt.Errorf("custom metric %q: key_attribute is required when the path contains '%s'", m.Name, pathWildcard)
+	case wildcards == 0 && m.KeyAttribute != "":
+		return fmt.Errorf("custom metric %q: key_attribute requires the path to contain '%s'", m.Name, pathWildcard)
+	}
 	return nil
 }
@@ -61,6 +61,46 @@ func TestLoadConfig(t *testing.T) {
 				MetricsConfig: metricCfg,
 			},
 		},
+		{
+			id: component.NewIDWithName(typeStr, "custom_metrics"),
+			expected: &Config{
+				ScraperControllerSettings: scraperhelper.NewDefaultScraperControllerSettings(typeStr),
+				HTTPClientSettings: confighttp.HTTPClientSettings{
+					Endpoint: defaultEndpoint,
+					Timeout:  defaultTimeout,
+				},
+				MetricsConfig: metadata.DefaultMetricsSettings(),
+				CustomMetrics: []CustomMetricConfig{
+					{
+						Name:        "myapp.requests",
+						Description: "The number of requests served.",
+						Unit:        "{requests}",
+						Type:        customMetricTypeSum,
+						Monotonic:   true,
+						Path:        "requests.total",
+					},
+					{
+						Name:         "myapp.handler.requests",
+						Type:         customMetricTypeSum,
+						Monotonic:    true,
+						Path:         "requests.by_handler.*",
+						KeyAttribute: "handler",
+					},
+				},
+			},
+		},
+		{
+			id:           component.NewIDWithName(typeStr, "bad_custom_metric_type"),
+			errorMessage: "custom metric \"myapp.requests\": type must be 'gauge' or 'sum', but was 'histogram'",
+		},
+		{
+			id:           component.NewIDWithName(typeStr, "bad_custom_metric_missing_key_attribute"),
+			errorMessage: "custom metric \"myapp.handler.requests\": key_attribute is required when the path contains '*'",
+		},
+		{
+			id:           component.NewIDWithName(typeStr, "bad_custom_metric_duplicate_name"),
+			errorMessage: "custom metric \"myapp.requests\" is defined more than once",
+		},
 		{
 			id:           component.NewIDWithName(typeStr, "bad_schemeless_endpoint"),
 			errorMessage: "scheme must be 'http' or 'https', but was 'localhost'",
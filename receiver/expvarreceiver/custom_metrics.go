@@ -0,0 +1,153 @@
+// Copyright  OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expvarreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/expvarreceiver"
+
+import (
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+const scopeName = "otelcol/expvarreceiver"
+
+// customMetric records the values found at the path of a CustomMetricConfig.
+type customMetric struct {
+	cfg  CustomMetricConfig
+	keys []string
+}
+
+func newCustomMetrics(cfgs []CustomMetricConfig) []customMetric {
+	metrics := make([]customMetric, 0, len(cfgs))
+	for _, cfg := range cfgs {
+		metrics = append(metrics, customMetric{
+			cfg:  cfg,
+			keys: strings.Split(cfg.Path, pathSeparator),
+		})
+	}
+	return metrics
+}
+
+// customValue is a value found at the path of a custom metric, with the key matched by the
+// wildcard of the path if any.
+type customValue struct {
+	key   string
+	value interface{}
+}
+
+// lookup appends to dest the values found by following keys from value.
+func lookup(value interface{}, keys []string, matched string, dest []customValue) []customValue {
+	if len(keys) == 0 {
+		return append(dest, customValue{key: matched, value: value})
+	}
+
+	key, rest := keys[0], keys[1:]
+	switch v := value.(type) {
+	case map[string]interface{}:
+		if key == pathWildcard {
+			children := make([]string, 0, len(v))
+			for child := range v {
+				children = append(children, child)
+			}
+			sort.Strings(children)
+			for _, child := range children {
+				dest = lookup(v[child], rest, child, dest)
+			}
+			return dest
+		}
+		if child, ok := v[key]; ok {
+			return lookup(child, rest, matched, dest)
+		}
+	case []interface{}:
+		if key == pathWildcard {
+			for i, child := range v {
+				dest = lookup(child, rest, strconv.Itoa(i), dest)
+			}
+			return dest
+		}
+		if i, err := strconv.Atoi(key); err == nil && i >= 0 && i < len(v) {
+			return lookup(v[i], rest, matched, dest)
+		}
+	}
+	return dest
+}
+
+// record appends the metric to dest, unless none of the values found at its path are numbers or booleans.
+func (m *customMetric) record(now pcommon.Timestamp, start pcommon.Timestamp, vars map[string]interface{}, dest pmetric.MetricSlice) {
+	values := lookup(vars, m.keys, "", nil)
+	if len(values) == 0 {
+		return
+	}
+
+	metric := pmetric.NewMetric()
+	metric.SetName(m.cfg.Name)
+	metric.SetDescription(m.cfg.Description)
+	metric.SetUnit(m.cfg.Unit)
+
+	var dps pmetric.NumberDataPointSlice
+	if m.cfg.Type == customMetricTypeSum {
+		sum := metric.SetEmptySum()
+		sum.SetIsMonotonic(m.cfg.Monotonic)
+		sum.SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+		dps = sum.DataPoints()
+	} else {
+		dps = metric.SetEmptyGauge().DataPoints()
+	}
+
+	for _, v := range values {
+		dp := pmetric.NewNumberDataPoint()
+		if !setDataPointValue(dp, v.value) {
+			continue
+		}
+		if m.cfg.Type == customMetricTypeSum {
+			dp.SetStartTimestamp(start)
+		}
+		dp.SetTimestamp(now)
+		if m.cfg.KeyAttribute != "" {
+			dp.Attributes().PutStr(m.cfg.KeyAttribute, v.key)
+		}
+		dp.MoveTo(dps.AppendEmpty())
+	}
+
+	if dps.Len() > 0 {
+		metric.MoveTo(dest.AppendEmpty())
+	}
+}
+
+// setDataPointValue sets the value of dp to a JSON number, or to 1 or 0 for a boolean.
+func setDataPointValue(dp pmetric.NumberDataPoint, value interface{}) bool {
+	switch v := value.(type) {
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			dp.SetIntValue(i)
+			return true
+		}
+		if f, err := v.Float64(); err == nil {
+			dp.SetDoubleValue(f)
+			return true
+		}
+	case bool:
+		if v {
+			dp.SetIntValue(1)
+		} else {
+			dp.SetIntValue(0)
+		}
+		return true
+	}
+	return false
+}
@@ -15,6 +15,7 @@
 package expvarreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/expvarreceiver"
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -37,17 +38,20 @@ type expVar struct {
 }
 
 type expVarScraper struct {
-	cfg    *Config
-	set    *receiver.CreateSettings
-	client *http.Client
-	mb     *metadata.MetricsBuilder
+	cfg           *Config
+	set           *receiver.CreateSettings
+	client        *http.Client
+	mb            *metadata.MetricsBuilder
+	customMetrics []customMetric
+	startTime     pcommon.Timestamp
 }
 
 func newExpVarScraper(cfg *Config, set receiver.CreateSettings) *expVarScraper {
 	return &expVarScraper{
-		cfg: cfg,
-		set: &set,
-		mb:  metadata.NewMetricsBuilder(cfg.MetricsConfig, set),
+		cfg:           cfg,
+		set:           &set,
+		mb:            metadata.NewMetricsBuilder(cfg.MetricsConfig, set),
+		customMetrics: newCustomMetrics(cfg.CustomMetrics),
 	}
 }
 
@@ -57,6 +61,7 @@ func (e *expVarScraper) start(_ context.Context, host component.Host) error {
 		return err
 	}
 	e.client = client
+	e.startTime = pcommon.NewTimestampFromTime(time.Now())
 	return nil
 }
 
@@ -75,7 +80,9 @@ func (e *expVarScraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
 		return emptyMetrics, fmt.Errorf("expected 200 but received %d status code", resp.StatusCode)
 	}
 
-	result, err := decodeResponseBody(resp.Body)
+	// The body is kept to decode the variables of the custom metrics.
+	var body bytes.Buffer
+	result, err := decodeResponseBody(io.TeeReader(resp.Body, &body))
 	if err != nil {
 		return emptyMetrics, fmt.Errorf("could not decode response body to JSON: %w", err)
 	}
@@ -115,10 +122,50 @@ func (e *expVarScraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
 	// The most recent pause is at PauseNs[(NumGC+255)%256].
 	e.mb.RecordProcessRuntimeMemstatsLastPauseDataPoint(now, int64(memStats.PauseNs[(memStats.NumGC+255)%256]))
 
-	return e.mb.Emit(), nil
+	md := e.mb.Emit()
+	if len(e.customMetrics) > 0 {
+		vars, err := decodeVars(&body)
+		if err != nil {
+			return emptyMetrics, fmt.Errorf("could not decode response body to JSON: %w", err)
+		}
+		e.recordCustomMetrics(now, vars, md)
+	}
+	return md, nil
+}
+
+// recordCustomMetrics appends the custom metrics to the scope of the memstats metrics.
+func (e *expVarScraper) recordCustomMetrics(now pcommon.Timestamp, vars map[string]interface{}, md pmetric.Metrics) {
+	metrics := pmetric.NewMetricSlice()
+	for i := range e.customMetrics {
+		e.customMetrics[i].record(now, e.startTime, vars, metrics)
+	}
+	if metrics.Len() == 0 {
+		return
+	}
+
+	var sm pmetric.ScopeMetrics
+	if md.ResourceMetrics().Len() == 0 {
+		sm = md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty()
+		sm.Scope().SetName(scopeName)
+		sm.Scope().SetVersion(e.set.BuildInfo.Version)
+	} else {
+		sm = md.ResourceMetrics().At(0).ScopeMetrics().At(0)
+	}
+	metrics.MoveAndAppendTo(sm.Metrics())
+}
+
+func decodeVars(body io.Reader) (map[string]interface{}, error) {
+	var vars map[string]interface{}
+	decoder := json.NewDecoder(body)
+	// Numbers are kept as is to record integers as integers.
+	decoder.UseNumber()
+	if err := decoder.Decode(&vars); err != nil {
+		return nil, err
+	}
+	return vars, nil
 }
 
-func decodeResponseBody(body io.ReadCloser) (*expVar, error) {
+func decodeResponseBody(body io.Reader) (*expVar, error) {
 	var result expVar
 	if err := json.NewDecoder(body).Decode(&result); err != nil {
 		return nil, err
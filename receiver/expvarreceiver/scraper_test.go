@@ -16,10 +16,12 @@ package expvarreceiver
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -194,3 +196,85 @@ func TestEmptyResponseBodyError(t *testing.T) {
 	require.EqualError(t, err, "could not decode response body to JSON: EOF")
 	require.NoError(t, comparetest.CompareMetrics(expectedMetrics, actualMetrics))
 }
+
+func TestCustomMetrics(t *testing.T) {
+	ms := newMockServer(t, filepath.Join("testdata", "response", "expvar_custom_response.json"))
+	defer ms.Close()
+	cfg := newDefaultConfig().(*Config)
+	cfg.Endpoint = ms.URL + defaultPath
+	cfg.MetricsConfig = allMetricsDisabled
+	cfg.CustomMetrics = []CustomMetricConfig{
+		{
+			Name:        "myapp.requests",
+			Description: "The number of requests served.",
+			Unit:        "{requests}",
+			Type:        customMetricTypeSum,
+			Monotonic:   true,
+			Path:        "requests.total",
+		},
+		{
+			Name:         "myapp.handler.requests",
+			Description:  "The number of requests served by handler.",
+			Unit:         "{requests}",
+			Type:         customMetricTypeSum,
+			Monotonic:    true,
+			Path:         "requests.by_handler.*",
+			KeyAttribute: "handler",
+		},
+		{
+			Name: "myapp.ready",
+			Path: "ready",
+		},
+		{
+			Name: "myapp.latency",
+			Unit: "ms",
+			Path: "latency_ms",
+		},
+		{
+			Name: "myapp.name",
+			Path: "name",
+		},
+		{
+			Name: "myapp.missing",
+			Path: "requests.missing",
+		},
+	}
+	require.NoError(t, cfg.Validate())
+
+	scraper := newExpVarScraper(cfg, receivertest.NewNopCreateSettings())
+	err := scraper.start(context.Background(), componenttest.NewNopHost())
+	require.NoError(t, err)
+
+	actualMetrics, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+
+	expectedFile := filepath.Join("testdata", "metrics", "expected_custom_metrics.json")
+	expectedMetrics, err := golden.ReadMetrics(expectedFile)
+	require.NoError(t, err)
+	require.NoError(t, comparetest.CompareMetrics(expectedMetrics, actualMetrics))
+}
+
+func TestLookup(t *testing.T) {
+	vars := map[string]interface{}{
+		"a": map[string]interface{}{
+			"b": []interface{}{json.Number("1"), json.Number("2")},
+		},
+	}
+
+	tests := []struct {
+		path     string
+		expected []customValue
+	}{
+		{path: "a.b.1", expected: []customValue{{value: json.Number("2")}}},
+		{path: "a.b.*", expected: []customValue{{key: "0", value: json.Number("1")}, {key: "1", value: json.Number("2")}}},
+		{path: "a.*.0", expected: []customValue{{key: "b", value: json.Number("1")}}},
+		{path: "a.b.2"},
+		{path: "a.b.c"},
+		{path: "a.c"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			require.Equal(t, tt.expected, lookup(vars, strings.Split(tt.path, pathSeparator), "", nil))
+		})
+	}
+}
@@ -63,7 +63,10 @@ type MetricsSettings struct {
 	FlinkMemoryManagedUsed            MetricSettings `mapstructure:"flink.memory.managed.used"`
 	FlinkOperatorRecordCount          MetricSettings `mapstructure:"flink.operator.record.count"`
 	FlinkOperatorWatermarkOutput      MetricSettings `mapstructure:"flink.operator.watermark.output"`
+	FlinkTaskBackpressureRatio        MetricSettings `mapstructure:"flink.task.backpressure.ratio"`
+	FlinkTaskCheckpointAlignmentTime  MetricSettings `mapstructure:"flink.task.checkpoint.alignment.time"`
 	FlinkTaskRecordCount              MetricSettings `mapstructure:"flink.task.record.count"`
+	FlinkTaskTime                     MetricSettings `mapstructure:"flink.task.time"`
 }
 
 func DefaultMetricsSettings() MetricsSettings {
@@ -152,9 +155,18 @@ func DefaultMetricsSettings() MetricsSettings {
 		FlinkOperatorWatermarkOutput: MetricSettings{
 			Enabled: true,
 		},
+		FlinkTaskBackpressureRatio: MetricSettings{
+			Enabled: true,
+		},
+		FlinkTaskCheckpointAlignmentTime: MetricSettings{
+			Enabled: true,
+		},
 		FlinkTaskRecordCount: MetricSettings{
 			Enabled: true,
 		},
+		FlinkTaskTime: MetricSettings{
+			Enabled: true,
+		},
 	}
 }
 
@@ -300,6 +312,32 @@ var MapAttributeRecord = map[string]AttributeRecord{
 	"dropped": AttributeRecordDropped,
 }
 
+// AttributeTaskTimeState specifies the a value task_time_state attribute.
+type AttributeTaskTimeState int
+
+const (
+	_ AttributeTaskTimeState = iota
+	AttributeTaskTimeStateBusy
+	AttributeTaskTimeStateIdle
+)
+
+// String returns the string representation of the AttributeTaskTimeState.
+func (av AttributeTaskTimeState) String() string {
+	switch av {
+	case AttributeTaskTimeStateBusy:
+		return "busy"
+	case AttributeTaskTimeStateIdle:
+		return "idle"
+	}
+	return ""
+}
+
+// MapAttributeTaskTimeState is a helper map of string to AttributeTaskTimeState attribute value.
+var MapAttributeTaskTimeState = map[string]AttributeTaskTimeState{
+	"busy": AttributeTaskTimeStateBusy,
+	"idle": AttributeTaskTimeStateIdle,
+}
+
 type metricFlinkJobCheckpointCount struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
@@ -1735,6 +1773,104 @@ func newMetricFlinkOperatorWatermarkOutput(settings MetricSettings) metricFlinkO
 	return m
 }
 
+type metricFlinkTaskBackpressureRatio struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills flink.task.backpressure.ratio metric with initial data.
+func (m *metricFlinkTaskBackpressureRatio) init() {
+	m.data.SetName("flink.task.backpressure.ratio")
+	m.data.SetDescription("The fraction of time a subtask is back pressured by its downstream subtasks.")
+	m.data.SetUnit("1")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricFlinkTaskBackpressureRatio) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricFlinkTaskBackpressureRatio) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricFlinkTaskBackpressureRatio) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricFlinkTaskBackpressureRatio(settings MetricSettings) metricFlinkTaskBackpressureRatio {
+	m := metricFlinkTaskBackpressureRatio{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricFlinkTaskCheckpointAlignmentTime struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills flink.task.checkpoint.alignment.time metric with initial data.
+func (m *metricFlinkTaskCheckpointAlignmentTime) init() {
+	m.data.SetName("flink.task.checkpoint.alignment.time")
+	m.data.SetDescription("The time the last checkpoint barrier alignment of a subtask took to complete.")
+	m.data.SetUnit("ns")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricFlinkTaskCheckpointAlignmentTime) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricFlinkTaskCheckpointAlignmentTime) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricFlinkTaskCheckpointAlignmentTime) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricFlinkTaskCheckpointAlignmentTime(settings MetricSettings) metricFlinkTaskCheckpointAlignmentTime {
+	m := metricFlinkTaskCheckpointAlignmentTime{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricFlinkTaskRecordCount struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
@@ -1788,6 +1924,57 @@ func newMetricFlinkTaskRecordCount(settings MetricSettings) metricFlinkTaskRecor
 	return m
 }
 
+type metricFlinkTaskTime struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills flink.task.time metric with initial data.
+func (m *metricFlinkTaskTime) init() {
+	m.data.SetName("flink.task.time")
+	m.data.SetDescription("The time per second a subtask spends busy or idle.")
+	m.data.SetUnit("ms/s")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricFlinkTaskTime) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, taskTimeStateAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("state", taskTimeStateAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricFlinkTaskTime) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricFlinkTaskTime) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricFlinkTaskTime(settings MetricSettings) metricFlinkTaskTime {
+	m := metricFlinkTaskTime{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 // MetricsBuilder provides an interface for scrapers to report metrics while taking care of all the transformations
 // required to produce metric representation defined in metadata and user settings.
 type MetricsBuilder struct {
@@ -1825,7 +2012,10 @@ type MetricsBuilder struct {
 	metricFlinkMemoryManagedUsed            metricFlinkMemoryManagedUsed
 	metricFlinkOperatorRecordCount          metricFlinkOperatorRecordCount
 	metricFlinkOperatorWatermarkOutput      metricFlinkOperatorWatermarkOutput
+	metricFlinkTaskBackpressureRatio        metricFlinkTaskBackpressureRatio
+	metricFlinkTaskCheckpointAlignmentTime  metricFlinkTaskCheckpointAlignmentTime
 	metricFlinkTaskRecordCount              metricFlinkTaskRecordCount
+	metricFlinkTaskTime                     metricFlinkTaskTime
 }
 
 // metricBuilderOption applies changes to default metrics builder.
@@ -1879,7 +2069,10 @@ func NewMetricsBuilder(ms MetricsSettings, settings receiver.CreateSettings, opt
 		metricFlinkMemoryManagedUsed:            newMetricFlinkMemoryManagedUsed(ms.FlinkMemoryManagedUsed),
 		metricFlinkOperatorRecordCount:          newMetricFlinkOperatorRecordCount(ms.FlinkOperatorRecordCount),
 		metricFlinkOperatorWatermarkOutput:      newMetricFlinkOperatorWatermarkOutput(ms.FlinkOperatorWatermarkOutput),
+		metricFlinkTaskBackpressureRatio:        newMetricFlinkTaskBackpressureRatio(ms.FlinkTaskBackpressureRatio),
+		metricFlinkTaskCheckpointAlignmentTime:  newMetricFlinkTaskCheckpointAlignmentTime(ms.FlinkTaskCheckpointAlignmentTime),
 		metricFlinkTaskRecordCount:              newMetricFlinkTaskRecordCount(ms.FlinkTaskRecordCount),
+		metricFlinkTaskTime:                     newMetricFlinkTaskTime(ms.FlinkTaskTime),
 	}
 	for _, op := range options {
 		op(mb)
@@ -2019,7 +2212,10 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	mb.metricFlinkMemoryManagedUsed.emit(ils.Metrics())
 	mb.metricFlinkOperatorRecordCount.emit(ils.Metrics())
 	mb.metricFlinkOperatorWatermarkOutput.emit(ils.Metrics())
+	mb.metricFlinkTaskBackpressureRatio.emit(ils.Metrics())
+	mb.metricFlinkTaskCheckpointAlignmentTime.emit(ils.Metrics())
 	mb.metricFlinkTaskRecordCount.emit(ils.Metrics())
+	mb.metricFlinkTaskTime.emit(ils.Metrics())
 
 	for _, op := range rmo {
 		op(mb.resourceAttributesSettings, rm)
@@ -2320,6 +2516,21 @@ func (mb *MetricsBuilder) RecordFlinkOperatorWatermarkOutputDataPoint(ts pcommon
 	return nil
 }
 
+// RecordFlinkTaskBackpressureRatioDataPoint adds a data point to flink.task.backpressure.ratio metric.
+func (mb *MetricsBuilder) RecordFlinkTaskBackpressureRatioDataPoint(ts pcommon.Timestamp, val float64) {
+	mb.metricFlinkTaskBackpressureRatio.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordFlinkTaskCheckpointAlignmentTimeDataPoint adds a data point to flink.task.checkpoint.alignment.time metric.
+func (mb *MetricsBuilder) RecordFlinkTaskCheckpointAlignmentTimeDataPoint(ts pcommon.Timestamp, inputVal string) error {
+	val, err := strconv.ParseInt(inputVal, 10, 64)
+	if err != nil {
+		return fmt.Errorf("failed to parse int64 for FlinkTaskCheckpointAlignmentTime, value was %s: %w", inputVal, err)
+	}
+	mb.metricFlinkTaskCheckpointAlignmentTime.recordDataPoint(mb.startTime, ts, val)
+	return nil
+}
+
 // RecordFlinkTaskRecordCountDataPoint adds a data point to flink.task.record.count metric.
 func (mb *MetricsBuilder) RecordFlinkTaskRecordCountDataPoint(ts pcommon.Timestamp, inputVal string, recordAttributeValue AttributeRecord) error {
 	val, err := strconv.ParseInt(inputVal, 10, 64)
@@ -2330,6 +2541,16 @@ func (mb *MetricsBuilder) RecordFlinkTaskRecordCountDataPoint(ts pcommon.Timesta
 	return nil
 }
 
+// RecordFlinkTaskTimeDataPoint adds a data point to flink.task.time metric.
+func (mb *MetricsBuilder) RecordFlinkTaskTimeDataPoint(ts pcommon.Timestamp, inputVal string, taskTimeStateAttributeValue AttributeTaskTimeState) error {
+	val, err := strconv.ParseFloat(inputVal, 64)
+	if err != nil {
+		return fmt.Errorf("failed to parse float64 for FlinkTaskTime, value was %s: %w", inputVal, err)
+	}
+	mb.metricFlinkTaskTime.recordDataPoint(mb.startTime, ts, val, taskTimeStateAttributeValue.String())
+	return nil
+}
+
 // Reset resets metrics builder to its initial state. It should be used when external metrics source is restarted,
 // and metrics builder should update its startTime and reset it's internal state accordingly.
 func (mb *MetricsBuilder) Reset(options ...metricBuilderOption) {
@@ -170,10 +170,22 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordFlinkOperatorWatermarkOutputDataPoint(ts, "1", "attr-val")
 
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordFlinkTaskBackpressureRatioDataPoint(ts, 1)
+
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordFlinkTaskCheckpointAlignmentTimeDataPoint(ts, "1")
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordFlinkTaskRecordCountDataPoint(ts, "1", AttributeRecord(1))
 
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordFlinkTaskTimeDataPoint(ts, "1", AttributeTaskTimeState(1))
+
 			metrics := mb.Emit(WithFlinkJobName("attr-val"), WithFlinkResourceTypeJobmanager, WithFlinkSubtaskIndex("attr-val"), WithFlinkTaskName("attr-val"), WithFlinkTaskmanagerID("attr-val"), WithHostName("attr-val"))
 
 			if test.metricsSet == testMetricsSetNo {
@@ -647,6 +659,30 @@ func TestMetricsBuilder(t *testing.T) {
 					attrVal, ok := dp.Attributes().Get("name")
 					assert.True(t, ok)
 					assert.EqualValues(t, "attr-val", attrVal.Str())
+				case "flink.task.backpressure.ratio":
+					assert.False(t, validatedMetrics["flink.task.backpressure.ratio"], "Found a duplicate in the metrics slice: flink.task.backpressure.ratio")
+					validatedMetrics["flink.task.backpressure.ratio"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The fraction of time a subtask is back pressured by its downstream subtasks.", ms.At(i).Description())
+					assert.Equal(t, "1", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.Equal(t, float64(1), dp.DoubleValue())
+				case "flink.task.checkpoint.alignment.time":
+					assert.False(t, validatedMetrics["flink.task.checkpoint.alignment.time"], "Found a duplicate in the metrics slice: flink.task.checkpoint.alignment.time")
+					validatedMetrics["flink.task.checkpoint.alignment.time"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The time the last checkpoint barrier alignment of a subtask took to complete.", ms.At(i).Description())
+					assert.Equal(t, "ns", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
 				case "flink.task.record.count":
 					assert.False(t, validatedMetrics["flink.task.record.count"], "Found a duplicate in the metrics slice: flink.task.record.count")
 					validatedMetrics["flink.task.record.count"] = true
@@ -664,6 +700,21 @@ func TestMetricsBuilder(t *testing.T) {
 					attrVal, ok := dp.Attributes().Get("record")
 					assert.True(t, ok)
 					assert.Equal(t, "in", attrVal.Str())
+				case "flink.task.time":
+					assert.False(t, validatedMetrics["flink.task.time"], "Found a duplicate in the metrics slice: flink.task.time")
+					validatedMetrics["flink.task.time"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The time per second a subtask spends busy or idle.", ms.At(i).Description())
+					assert.Equal(t, "ms/s", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.Equal(t, float64(1), dp.DoubleValue())
+					attrVal, ok := dp.Attributes().Get("state")
+					assert.True(t, ok)
+					assert.Equal(t, "busy", attrVal.Str())
 				}
 			}
 		})
@@ -15,6 +15,7 @@
 package flinkmetricsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/flinkmetricsreceiver"
 
 import (
+	"strconv"
 	"strings"
 
 	"go.opentelemetry.io/collector/pdata/pcommon"
@@ -176,6 +177,17 @@ func (s *flinkmetricsScraper) processSubtaskMetrics(now pcommon.Timestamp, subta
 				_ = s.mb.RecordFlinkTaskRecordCountDataPoint(now, metric.Value, metadata.AttributeRecordOut)
 			case metric.ID == "numLateRecordsDropped":
 				_ = s.mb.RecordFlinkTaskRecordCountDataPoint(now, metric.Value, metadata.AttributeRecordDropped)
+			case metric.ID == "busyTimeMsPerSecond":
+				_ = s.mb.RecordFlinkTaskTimeDataPoint(now, metric.Value, metadata.AttributeTaskTimeStateBusy)
+			case metric.ID == "idleTimeMsPerSecond":
+				_ = s.mb.RecordFlinkTaskTimeDataPoint(now, metric.Value, metadata.AttributeTaskTimeStateIdle)
+			case metric.ID == "backPressuredTimeMsPerSecond":
+				// Flink reports the back pressured time per second, the ratio is its fraction of a second.
+				if backPressuredTime, err := strconv.ParseFloat(metric.Value, 64); err == nil {
+					s.mb.RecordFlinkTaskBackpressureRatioDataPoint(now, backPressuredTime/1000)
+				}
+			case metric.ID == "checkpointAlignmentTime":
+				_ = s.mb.RecordFlinkTaskCheckpointAlignmentTimeDataPoint(now, metric.Value)
 				// record operator metrics
 			case strings.Contains(metric.ID, ".numRecordsIn"):
 				operatorName := strings.Split(metric.ID, ".numRecordsIn")
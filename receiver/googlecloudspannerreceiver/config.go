@@ -17,6 +17,7 @@ package googlecloudspannerreceiver // import "github.com/open-telemetry/opentele
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
 )
@@ -29,11 +30,14 @@ const (
 type Config struct {
 	scraperhelper.ScraperControllerSettings `mapstructure:",squash"`
 
-	TopMetricsQueryMaxRows            int       `mapstructure:"top_metrics_query_max_rows"`
-	BackfillEnabled                   bool      `mapstructure:"backfill_enabled"`
-	CardinalityTotalLimit             int       `mapstructure:"cardinality_total_limit"`
-	Projects                          []Project `mapstructure:"projects"`
-	HideTopnLockstatsRowrangestartkey bool      `mapstructure:"hide_topn_lockstats_rowrangestartkey"`
+	TopMetricsQueryMaxRows            int           `mapstructure:"top_metrics_query_max_rows"`
+	TopQueryStatsQueryMaxRows         int           `mapstructure:"top_query_stats_query_max_rows"`
+	TopLockStatsQueryMaxRows          int           `mapstructure:"top_lock_stats_query_max_rows"`
+	TopMetricsSamplingInterval        time.Duration `mapstructure:"top_metrics_sampling_interval"`
+	BackfillEnabled                   bool          `mapstructure:"backfill_enabled"`
+	CardinalityTotalLimit             int           `mapstructure:"cardinality_total_limit"`
+	Projects                          []Project     `mapstructure:"projects"`
+	HideTopnLockstatsRowrangestartkey bool          `mapstructure:"hide_topn_lockstats_rowrangestartkey"`
 }
 
 type Project struct {
@@ -60,6 +64,18 @@ func (config *Config) Validate() error {
 		return fmt.Errorf("\"top_metrics_query_max_rows\" must be not greater than %v, current value is %v", maxTopMetricsQueryMaxRows, config.TopMetricsQueryMaxRows)
 	}
 
+	if config.TopQueryStatsQueryMaxRows < 0 || config.TopQueryStatsQueryMaxRows > maxTopMetricsQueryMaxRows {
+		return fmt.Errorf("\"top_query_stats_query_max_rows\" must be between 0 and %v, current value is %v", maxTopMetricsQueryMaxRows, config.TopQueryStatsQueryMaxRows)
+	}
+
+	if config.TopLockStatsQueryMaxRows < 0 || config.TopLockStatsQueryMaxRows > maxTopMetricsQueryMaxRows {
+		return fmt.Errorf("\"top_lock_stats_query_max_rows\" must be between 0 and %v, current value is %v", maxTopMetricsQueryMaxRows, config.TopLockStatsQueryMaxRows)
+	}
+
+	if config.TopMetricsSamplingInterval < 0 {
+		return fmt.Errorf("\"top_metrics_sampling_interval\" must be not negative, current value is %v", config.TopMetricsSamplingInterval)
+	}
+
 	if config.CardinalityTotalLimit < 0 {
 		return fmt.Errorf("\"cardinality_total_limit\" must be not negative, current value is %v", config.CardinalityTotalLimit)
 	}
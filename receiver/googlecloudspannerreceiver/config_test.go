@@ -46,6 +46,9 @@ func TestLoadConfig(t *testing.T) {
 				CollectionInterval: 120 * time.Second,
 			},
 			TopMetricsQueryMaxRows:            10,
+			TopQueryStatsQueryMaxRows:         20,
+			TopLockStatsQueryMaxRows:          5,
+			TopMetricsSamplingInterval:        5 * time.Minute,
 			BackfillEnabled:                   true,
 			CardinalityTotalLimit:             200000,
 			HideTopnLockstatsRowrangestartkey: true,
@@ -202,3 +205,48 @@ func TestValidateConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateConfigTopMetricsOverrides(t *testing.T) {
+	project := Project{
+		ID:        "id",
+		Instances: []Instance{{ID: "id", Databases: []string{"name"}}},
+	}
+
+	testCases := map[string]struct {
+		topQueryStatsQueryMaxRows  int
+		topLockStatsQueryMaxRows   int
+		topMetricsSamplingInterval time.Duration
+		requireError               bool
+	}{
+		"Not specified": {0, 0, 0, false},
+		"All specified": {10, 20, 5 * time.Minute, false},
+		"Negative top query stats query max rows":         {-1, 0, 0, true},
+		"Top query stats query max rows greater than max": {maxTopMetricsQueryMaxRows + 1, 0, 0, true},
+		"Negative top lock stats query max rows":          {0, -1, 0, true},
+		"Top lock stats query max rows greater than max":  {0, maxTopMetricsQueryMaxRows + 1, 0, true},
+		"Negative top metrics sampling interval":          {0, 0, -time.Minute, true},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			cfg := &Config{
+				ScraperControllerSettings: scraperhelper.ScraperControllerSettings{
+					CollectionInterval: defaultCollectionInterval,
+				},
+				TopMetricsQueryMaxRows:     defaultTopMetricsQueryMaxRows,
+				TopQueryStatsQueryMaxRows:  testCase.topQueryStatsQueryMaxRows,
+				TopLockStatsQueryMaxRows:   testCase.topLockStatsQueryMaxRows,
+				TopMetricsSamplingInterval: testCase.topMetricsSamplingInterval,
+				Projects:                   []Project{project},
+			}
+
+			err := component.ValidateConfig(cfg)
+
+			if testCase.requireError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -86,6 +86,11 @@ type lockRequestSliceLabelValue struct {
 	value    string
 }
 
+type normalizedSQLLabelValue struct {
+	metadata LabelValueMetadata
+	value    string
+}
+
 func (m queryLabelValueMetadata) Name() string {
 	return m.name
 }
@@ -247,6 +252,25 @@ func newLockRequestSliceLabelValue(metadata LabelValueMetadata, valueHolder inte
 	}
 }
 
+func (v normalizedSQLLabelValue) Metadata() LabelValueMetadata {
+	return v.metadata
+}
+
+func (v normalizedSQLLabelValue) Value() interface{} {
+	return v.value
+}
+
+func (v normalizedSQLLabelValue) SetValueTo(attributes pcommon.Map) {
+	attributes.PutStr(v.metadata.Name(), v.value)
+}
+
+func newNormalizedSQLLabelValue(metadata LabelValueMetadata, valueHolder interface{}) LabelValue {
+	return normalizedSQLLabelValue{
+		metadata: metadata,
+		value:    normalizeSQL(*valueHolder.(*string)),
+	}
+}
+
 func NewLabelValueMetadata(name string, columnName string, valueType ValueType) (LabelValueMetadata, error) {
 	var newLabelValueFunc newLabelValueFunction
 	var valueHolderFunc valueHolderFunction
@@ -288,6 +312,12 @@ func NewLabelValueMetadata(name string, columnName string, valueType ValueType)
 			var valueHolder []*lockRequest
 			return &valueHolder
 		}
+	case NormalizedSQLValueType:
+		newLabelValueFunc = newNormalizedSQLLabelValue
+		valueHolderFunc = func() interface{} {
+			var valueHolder string
+			return &valueHolder
+		}
 	default:
 		return nil, fmt.Errorf("invalid value type received for label %q", name)
 	}
@@ -94,6 +94,18 @@ func TestLockRequestSliceLabelValueMetadata(t *testing.T) {
 	assert.IsType(t, expectedType, metadata.ValueHolder())
 }
 
+func TestNormalizedSQLLabelValueMetadata(t *testing.T) {
+	metadata, _ := NewLabelValueMetadata(labelName, labelColumnName, NormalizedSQLValueType)
+
+	assert.Equal(t, NormalizedSQLValueType, metadata.ValueType())
+	assert.Equal(t, labelName, metadata.Name())
+	assert.Equal(t, labelColumnName, metadata.ColumnName())
+
+	var expectedType *string
+
+	assert.IsType(t, expectedType, metadata.ValueHolder())
+}
+
 func TestUnknownLabelValueMetadata(t *testing.T) {
 	metadata, err := NewLabelValueMetadata(labelName, labelColumnName, UnknownValueType)
 
@@ -224,6 +236,26 @@ func TestLockRequestSliceLabelValue(t *testing.T) {
 	assert.Equal(t, stringValue, attributeValue.Str())
 }
 
+func TestNormalizedSQLLabelValue(t *testing.T) {
+	metadata, _ := NewLabelValueMetadata(labelName, labelColumnName, NormalizedSQLValueType)
+	labelValue := normalizedSQLLabelValue{
+		metadata: metadata,
+		value:    stringValue,
+	}
+
+	assert.Equal(t, NormalizedSQLValueType, labelValue.Metadata().ValueType())
+	assert.Equal(t, stringValue, labelValue.Value())
+
+	attributes := pcommon.NewMap()
+
+	labelValue.SetValueTo(attributes)
+
+	attributeValue, exists := attributes.Get(labelName)
+
+	assert.True(t, exists)
+	assert.Equal(t, stringValue, attributeValue.Str())
+}
+
 func TestNewStringLabelValue(t *testing.T) {
 	metadata, _ := NewLabelValueMetadata(labelName, labelColumnName, StringValueType)
 	value := stringValue
@@ -297,3 +329,15 @@ func TestNewLockRequestSliceLabelValue(t *testing.T) {
 	assert.Equal(t, LockRequestSliceValueType, labelValue.Metadata().ValueType())
 	assert.Equal(t, expectedValue, labelValue.Value())
 }
+
+func TestNewNormalizedSQLLabelValue(t *testing.T) {
+	metadata, _ := NewLabelValueMetadata(labelName, labelColumnName, NormalizedSQLValueType)
+	value := "SELECT * FROM Singers WHERE SingerId = 1"
+	expectedValue := "SELECT * FROM Singers WHERE SingerId = ?"
+	valueHolder := &value
+
+	labelValue := newNormalizedSQLLabelValue(metadata, valueHolder)
+
+	assert.Equal(t, NormalizedSQLValueType, labelValue.Metadata().ValueType())
+	assert.Equal(t, expectedValue, labelValue.Value())
+}
@@ -82,6 +82,7 @@ func TestToLabelValue(t *testing.T) {
 		"String slice label value metadata":       {StringSliceValueType, stringSliceLabelValue{}, []string{stringValue, stringValue}, stringValue + "," + stringValue},
 		"Byte slice label value metadata":         {ByteSliceValueType, byteSliceLabelValue{}, []byte(stringValue), stringValue},
 		"Lock request slice label value metadata": {LockRequestSliceValueType, lockRequestSliceLabelValue{}, []*lockRequest{{"lockMode", "column", "transactionTag"}}, "{lockMode,column,transactionTag}"},
+		"Normalized SQL label value metadata":     {NormalizedSQLValueType, normalizedSQLLabelValue{}, "SELECT 1", "SELECT ?"},
 	}
 
 	for name, testCase := range testCases {
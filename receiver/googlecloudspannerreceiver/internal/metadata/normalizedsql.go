@@ -0,0 +1,42 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/googlecloudspannerreceiver/internal/metadata"
+
+import (
+	"regexp"
+	"strings"
+)
+
+const sqlPlaceholder = "?"
+
+var (
+	sqlStringLiteralRegexp   = regexp.MustCompile(`'(?:[^'\\]|\\.)*'|"(?:[^"\\]|\\.)*"`)
+	sqlHexLiteralRegexp      = regexp.MustCompile(`\b0[xX][0-9a-fA-F]+\b`)
+	sqlNumericLiteralRegexp  = regexp.MustCompile(`\b\d+(?:\.\d+)?(?:[eE][+-]?\d+)?\b`)
+	sqlWhitespaceRegexp      = regexp.MustCompile(`\s+`)
+	sqlPlaceholderListRegexp = regexp.MustCompile(`\(\s*\?(?:\s*,\s*\?)+\s*\)`)
+)
+
+// normalizeSQL replaces literals in the SQL text with placeholders, collapses lists of placeholders and whitespace,
+// so that queries which differ only in their literal values share the same normalized text.
+func normalizeSQL(text string) string {
+	normalized := sqlStringLiteralRegexp.ReplaceAllString(text, sqlPlaceholder)
+	normalized = sqlHexLiteralRegexp.ReplaceAllString(normalized, sqlPlaceholder)
+	normalized = sqlNumericLiteralRegexp.ReplaceAllString(normalized, sqlPlaceholder)
+	normalized = sqlWhitespaceRegexp.ReplaceAllString(normalized, " ")
+	normalized = sqlPlaceholderListRegexp.ReplaceAllString(normalized, "("+sqlPlaceholder+")")
+
+	return strings.TrimSpace(normalized)
+}
@@ -0,0 +1,44 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeSQL(t *testing.T) {
+	testCases := map[string]struct {
+		text     string
+		expected string
+	}{
+		"No literals":                            {"SELECT * FROM Singers", "SELECT * FROM Singers"},
+		"String literal":                         {"SELECT * FROM Singers WHERE FirstName = 'Marc'", "SELECT * FROM Singers WHERE FirstName = ?"},
+		"Escaped quote":                          {`SELECT * FROM Singers WHERE FirstName = 'Marc\'s'`, "SELECT * FROM Singers WHERE FirstName = ?"},
+		"Double quoted string":                   {`SELECT * FROM Singers WHERE FirstName = "Marc"`, "SELECT * FROM Singers WHERE FirstName = ?"},
+		"Numeric literals":                       {"SELECT * FROM Singers WHERE SingerId = 12 AND Rating > 4.5e2", "SELECT * FROM Singers WHERE SingerId = ? AND Rating > ?"},
+		"Hex literal":                            {"SELECT * FROM Albums WHERE Flags = 0x1F", "SELECT * FROM Albums WHERE Flags = ?"},
+		"Identifiers with digits and parameters": {"SELECT c1 FROM t2 WHERE c3 = @p4", "SELECT c1 FROM t2 WHERE c3 = @p4"},
+		"List of literals":                       {"SELECT * FROM Singers WHERE SingerId IN (1, 2,3)", "SELECT * FROM Singers WHERE SingerId IN (?)"},
+		"Whitespace":                             {"  SELECT *\n\tFROM   Singers  ", "SELECT * FROM Singers"},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, testCase.expected, normalizeSQL(testCase.text))
+		})
+	}
+}
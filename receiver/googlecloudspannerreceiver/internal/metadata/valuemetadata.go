@@ -26,6 +26,7 @@ const (
 	StringSliceValueType      ValueType = "string_slice"
 	ByteSliceValueType        ValueType = "byte_slice"
 	LockRequestSliceValueType ValueType = "lock_request_slice"
+	NormalizedSQLValueType    ValueType = "normalized_sql"
 )
 
 type ValueMetadata interface {
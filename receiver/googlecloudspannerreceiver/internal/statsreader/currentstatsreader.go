@@ -58,7 +58,7 @@ func newCurrentStatsReader(
 		database:               database,
 		metricsMetadata:        metricsMetadata,
 		statement:              currentStatsStatement,
-		topMetricsQueryMaxRows: config.TopMetricsQueryMaxRows,
+		topMetricsQueryMaxRows: config.topMetricsQueryMaxRows(metricsMetadata),
 	}
 }
 
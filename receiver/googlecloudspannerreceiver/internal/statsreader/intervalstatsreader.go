@@ -31,6 +31,7 @@ const (
 	// this constant was set to 1 hour - max allowed interval by Prometheus.
 	backfillIntervalDuration = time.Hour
 	topLockStatsMetricName   = "top minute lock stats"
+	topQueryStatsMetricName  = "top minute query stats"
 )
 
 type intervalStatsReader struct {
@@ -38,6 +39,7 @@ type intervalStatsReader struct {
 	timestampsGenerator               *timestampsGenerator
 	lastPullTimestamp                 time.Time
 	hideTopnLockstatsRowrangestartkey bool
+	topMetricsSamplingInterval        time.Duration
 }
 
 func newIntervalStatsReader(
@@ -51,7 +53,7 @@ func newIntervalStatsReader(
 		database:               database,
 		metricsMetadata:        metricsMetadata,
 		statement:              intervalStatsStatement,
-		topMetricsQueryMaxRows: config.TopMetricsQueryMaxRows,
+		topMetricsQueryMaxRows: config.topMetricsQueryMaxRows(metricsMetadata),
 	}
 	tsGenerator := &timestampsGenerator{
 		backfillEnabled: config.BackfillEnabled,
@@ -62,14 +64,25 @@ func newIntervalStatsReader(
 		currentStatsReader:                reader,
 		timestampsGenerator:               tsGenerator,
 		hideTopnLockstatsRowrangestartkey: config.HideTopnLockstatsRowrangestartkey,
+		topMetricsSamplingInterval:        config.TopMetricsSamplingInterval,
 	}
 }
 
 func (reader *intervalStatsReader) Read(ctx context.Context) ([]*metadata.MetricsDataPoint, error) {
 	reader.logger.Debug("Executing read method", zap.String("reader", reader.Name()))
 
+	now := time.Now().UTC()
+	if reader.isSamplingSkipped(now) {
+		reader.logger.Debug("Skipping read until next sampling interval", zap.String("reader", reader.Name()))
+		return nil, nil
+	}
+
 	// Generating pull timestamps
-	pullTimestamps := reader.timestampsGenerator.pullTimestamps(reader.lastPullTimestamp, time.Now().UTC())
+	pullTimestamps := reader.timestampsGenerator.pullTimestamps(reader.lastPullTimestamp, now)
+	// Sampled readers pull only the latest interval instead of catching up on the skipped ones
+	if reader.isSampled() && !reader.lastPullTimestamp.IsZero() {
+		pullTimestamps = pullTimestamps[len(pullTimestamps)-1:]
+	}
 
 	var collectedDataPoints []*metadata.MetricsDataPoint
 
@@ -111,6 +124,19 @@ func (reader *intervalStatsReader) newPullStatement(pullTimestamp time.Time) sta
 	return reader.statement(args)
 }
 
+// isSampled returns true when the reader pulls high cardinality(Top N) metrics only once per sampling interval.
+func (reader *intervalStatsReader) isSampled() bool {
+	return reader.topMetricsSamplingInterval > 0 && reader.metricsMetadata != nil && reader.metricsMetadata.HighCardinality
+}
+
+func (reader *intervalStatsReader) isSamplingSkipped(now time.Time) bool {
+	if !reader.isSampled() || reader.lastPullTimestamp.IsZero() {
+		return false
+	}
+
+	return shiftToStartOfMinute(now).Sub(reader.lastPullTimestamp) < reader.topMetricsSamplingInterval
+}
+
 func (reader *intervalStatsReader) isBackfillExecution() bool {
 	return reader.timestampsGenerator.isBackfillExecution(reader.lastPullTimestamp)
 }
@@ -92,3 +92,38 @@ func TestIntervalStatsReader_NewPullStatement(t *testing.T) {
 
 	assert.NotZero(t, reader.newPullStatement(timestamp))
 }
+
+func TestIntervalStatsReader_Sampling(t *testing.T) {
+	databaseID := datasource.NewDatabaseID(projectID, instanceID, databaseName)
+	ctx := context.Background()
+	client, _ := spanner.NewClient(ctx, "")
+	database := datasource.NewDatabaseFromClient(client, databaseID)
+	logger := zaptest.NewLogger(t)
+	config := ReaderConfig{
+		TopMetricsSamplingInterval: 10 * time.Minute,
+	}
+	now := time.Now().UTC()
+
+	highCardinalityReader := newIntervalStatsReader(logger, database,
+		&metadata.MetricsMetadata{Name: name, HighCardinality: true}, config)
+	lowCardinalityReader := newIntervalStatsReader(logger, database,
+		&metadata.MetricsMetadata{Name: name, HighCardinality: false}, config)
+
+	assert.True(t, highCardinalityReader.isSampled())
+	assert.False(t, lowCardinalityReader.isSampled())
+
+	// First read is never skipped
+	assert.False(t, highCardinalityReader.isSamplingSkipped(now))
+
+	highCardinalityReader.lastPullTimestamp = shiftToStartOfMinute(now).Add(-5 * time.Minute)
+	lowCardinalityReader.lastPullTimestamp = highCardinalityReader.lastPullTimestamp
+	assert.True(t, highCardinalityReader.isSamplingSkipped(now))
+	assert.False(t, lowCardinalityReader.isSamplingSkipped(now))
+
+	dataPoints, err := highCardinalityReader.Read(ctx)
+	assert.NoError(t, err)
+	assert.Nil(t, dataPoints)
+
+	highCardinalityReader.lastPullTimestamp = shiftToStartOfMinute(now).Add(-10 * time.Minute)
+	assert.False(t, highCardinalityReader.isSamplingSkipped(now))
+}
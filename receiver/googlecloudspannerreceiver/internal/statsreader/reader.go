@@ -16,16 +16,35 @@ package statsreader // import "github.com/open-telemetry/opentelemetry-collector
 
 import (
 	"context"
+	"time"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/googlecloudspannerreceiver/internal/metadata"
 )
 
 type ReaderConfig struct {
 	TopMetricsQueryMaxRows            int
+	TopQueryStatsQueryMaxRows         int
+	TopLockStatsQueryMaxRows          int
+	TopMetricsSamplingInterval        time.Duration
 	BackfillEnabled                   bool
 	HideTopnLockstatsRowrangestartkey bool
 }
 
+// topMetricsQueryMaxRows returns the rows limit for the metrics metadata query. Limits specific to the top query stats
+// and top lock stats tables take precedence over the common limit when set.
+func (config ReaderConfig) topMetricsQueryMaxRows(metricsMetadata *metadata.MetricsMetadata) int {
+	if metricsMetadata != nil {
+		switch {
+		case metricsMetadata.Name == topQueryStatsMetricName && config.TopQueryStatsQueryMaxRows > 0:
+			return config.TopQueryStatsQueryMaxRows
+		case metricsMetadata.Name == topLockStatsMetricName && config.TopLockStatsQueryMaxRows > 0:
+			return config.TopLockStatsQueryMaxRows
+		}
+	}
+
+	return config.TopMetricsQueryMaxRows
+}
+
 type Reader interface {
 	Name() string
 	Read(ctx context.Context) ([]*metadata.MetricsDataPoint, error)
@@ -0,0 +1,49 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package statsreader
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/googlecloudspannerreceiver/internal/metadata"
+)
+
+func TestReaderConfig_TopMetricsQueryMaxRows(t *testing.T) {
+	config := ReaderConfig{
+		TopMetricsQueryMaxRows:    topMetricsQueryMaxRows,
+		TopQueryStatsQueryMaxRows: 20,
+		TopLockStatsQueryMaxRows:  30,
+	}
+
+	testCases := map[string]struct {
+		config          ReaderConfig
+		metricsMetadata *metadata.MetricsMetadata
+		expected        int
+	}{
+		"No metadata":                            {config, nil, topMetricsQueryMaxRows},
+		"Other metadata":                         {config, &metadata.MetricsMetadata{Name: name}, topMetricsQueryMaxRows},
+		"Top query stats":                        {config, &metadata.MetricsMetadata{Name: topQueryStatsMetricName}, 20},
+		"Top lock stats":                         {config, &metadata.MetricsMetadata{Name: topLockStatsMetricName}, 30},
+		"Top query stats without specific limit": {ReaderConfig{TopMetricsQueryMaxRows: topMetricsQueryMaxRows}, &metadata.MetricsMetadata{Name: topQueryStatsMetricName}, topMetricsQueryMaxRows},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, testCase.expected, testCase.config.topMetricsQueryMaxRows(testCase.metricsMetadata))
+		})
+	}
+}
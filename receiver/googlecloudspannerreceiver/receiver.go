@@ -114,6 +114,9 @@ func (r *googleCloudSpannerReceiver) initializeProjectReaders(ctx context.Contex
 	readerConfig := statsreader.ReaderConfig{
 		BackfillEnabled:                   r.config.BackfillEnabled,
 		TopMetricsQueryMaxRows:            r.config.TopMetricsQueryMaxRows,
+		TopQueryStatsQueryMaxRows:         r.config.TopQueryStatsQueryMaxRows,
+		TopLockStatsQueryMaxRows:          r.config.TopLockStatsQueryMaxRows,
+		TopMetricsSamplingInterval:        r.config.TopMetricsSamplingInterval,
 		HideTopnLockstatsRowrangestartkey: r.config.HideTopnLockstatsRowrangestartkey,
 	}
 
@@ -57,8 +57,8 @@ func newReceiver(
 	haProxyCfg := cfg.(*Config)
 	metricsBuilder := metadata.NewMetricsBuilder(haProxyCfg.MetricsSettings, settings)
 
-	mp := newScraper(metricsBuilder, haProxyCfg, settings.TelemetrySettings.Logger)
-	s, err := scraperhelper.NewScraper(settings.ID.Name(), mp.scrape)
+	mp := newScraper(metricsBuilder, haProxyCfg, settings.TelemetrySettings)
+	s, err := scraperhelper.NewScraper(settings.ID.Name(), mp.scrape, scraperhelper.WithStart(mp.start))
 	if err != nil {
 		return nil, err
 	}
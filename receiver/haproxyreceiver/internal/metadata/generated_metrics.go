@@ -38,6 +38,8 @@ type MetricsSettings struct {
 	HaproxyConnectionRate MetricSettings `mapstructure:"haproxy.connection_rate"`
 	HaproxyIdlePercent    MetricSettings `mapstructure:"haproxy.idle_percent"`
 	HaproxyRequests       MetricSettings `mapstructure:"haproxy.requests"`
+	HaproxyServerUp       MetricSettings `mapstructure:"haproxy.server.up"`
+	HaproxyServerWeight   MetricSettings `mapstructure:"haproxy.server.weight"`
 	HaproxySessionsCount  MetricSettings `mapstructure:"haproxy.sessions.count"`
 }
 
@@ -52,6 +54,12 @@ func DefaultMetricsSettings() MetricsSettings {
 		HaproxyRequests: MetricSettings{
 			Enabled: true,
 		},
+		HaproxyServerUp: MetricSettings{
+			Enabled: true,
+		},
+		HaproxyServerWeight: MetricSettings{
+			Enabled: true,
+		},
 		HaproxySessionsCount: MetricSettings{
 			Enabled: true,
 		},
@@ -266,6 +274,110 @@ func newMetricHaproxyRequests(settings MetricSettings) metricHaproxyRequests {
 	return m
 }
 
+type metricHaproxyServerUp struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills haproxy.server.up metric with initial data.
+func (m *metricHaproxyServerUp) init() {
+	m.data.SetName("haproxy.server.up")
+	m.data.SetDescription("Whether the server is up (1) or not (0), derived from HAProxy's `status` field. Servers without health checks are considered up.")
+	m.data.SetUnit("1")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricHaproxyServerUp) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, proxyNameAttributeValue string, serviceNameAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("proxy_name", proxyNameAttributeValue)
+	dp.Attributes().PutStr("service_name", serviceNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricHaproxyServerUp) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricHaproxyServerUp) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricHaproxyServerUp(settings MetricSettings) metricHaproxyServerUp {
+	m := metricHaproxyServerUp{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricHaproxyServerWeight struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills haproxy.server.weight metric with initial data.
+func (m *metricHaproxyServerWeight) init() {
+	m.data.SetName("haproxy.server.weight")
+	m.data.SetDescription("Effective weight of the server. Corresponds to HAProxy's `weight` metric.")
+	m.data.SetUnit("1")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricHaproxyServerWeight) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, proxyNameAttributeValue string, serviceNameAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("proxy_name", proxyNameAttributeValue)
+	dp.Attributes().PutStr("service_name", serviceNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricHaproxyServerWeight) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricHaproxyServerWeight) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricHaproxyServerWeight(settings MetricSettings) metricHaproxyServerWeight {
+	m := metricHaproxyServerWeight{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricHaproxySessionsCount struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
@@ -330,6 +442,8 @@ type MetricsBuilder struct {
 	metricHaproxyConnectionRate metricHaproxyConnectionRate
 	metricHaproxyIdlePercent    metricHaproxyIdlePercent
 	metricHaproxyRequests       metricHaproxyRequests
+	metricHaproxyServerUp       metricHaproxyServerUp
+	metricHaproxyServerWeight   metricHaproxyServerWeight
 	metricHaproxySessionsCount  metricHaproxySessionsCount
 }
 
@@ -359,6 +473,8 @@ func NewMetricsBuilder(ms MetricsSettings, settings receiver.CreateSettings, opt
 		metricHaproxyConnectionRate: newMetricHaproxyConnectionRate(ms.HaproxyConnectionRate),
 		metricHaproxyIdlePercent:    newMetricHaproxyIdlePercent(ms.HaproxyIdlePercent),
 		metricHaproxyRequests:       newMetricHaproxyRequests(ms.HaproxyRequests),
+		metricHaproxyServerUp:       newMetricHaproxyServerUp(ms.HaproxyServerUp),
+		metricHaproxyServerWeight:   newMetricHaproxyServerWeight(ms.HaproxyServerWeight),
 		metricHaproxySessionsCount:  newMetricHaproxySessionsCount(ms.HaproxySessionsCount),
 	}
 	for _, op := range options {
@@ -478,6 +594,8 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	mb.metricHaproxyConnectionRate.emit(ils.Metrics())
 	mb.metricHaproxyIdlePercent.emit(ils.Metrics())
 	mb.metricHaproxyRequests.emit(ils.Metrics())
+	mb.metricHaproxyServerUp.emit(ils.Metrics())
+	mb.metricHaproxyServerWeight.emit(ils.Metrics())
 	mb.metricHaproxySessionsCount.emit(ils.Metrics())
 
 	for _, op := range rmo {
@@ -529,6 +647,21 @@ func (mb *MetricsBuilder) RecordHaproxyRequestsDataPoint(ts pcommon.Timestamp, i
 	return nil
 }
 
+// RecordHaproxyServerUpDataPoint adds a data point to haproxy.server.up metric.
+func (mb *MetricsBuilder) RecordHaproxyServerUpDataPoint(ts pcommon.Timestamp, val int64, proxyNameAttributeValue string, serviceNameAttributeValue string) {
+	mb.metricHaproxyServerUp.recordDataPoint(mb.startTime, ts, val, proxyNameAttributeValue, serviceNameAttributeValue)
+}
+
+// RecordHaproxyServerWeightDataPoint adds a data point to haproxy.server.weight metric.
+func (mb *MetricsBuilder) RecordHaproxyServerWeightDataPoint(ts pcommon.Timestamp, inputVal string, proxyNameAttributeValue string, serviceNameAttributeValue string) error {
+	val, err := strconv.ParseInt(inputVal, 10, 64)
+	if err != nil {
+		return fmt.Errorf("failed to parse int64 for HaproxyServerWeight, value was %s: %w", inputVal, err)
+	}
+	mb.metricHaproxyServerWeight.recordDataPoint(mb.startTime, ts, val, proxyNameAttributeValue, serviceNameAttributeValue)
+	return nil
+}
+
 // RecordHaproxySessionsCountDataPoint adds a data point to haproxy.sessions.count metric.
 func (mb *MetricsBuilder) RecordHaproxySessionsCountDataPoint(ts pcommon.Timestamp, inputVal string, proxyNameAttributeValue string, serviceNameAttributeValue string) error {
 	val, err := strconv.ParseInt(inputVal, 10, 64)
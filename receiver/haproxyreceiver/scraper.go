@@ -15,13 +15,18 @@
 package haproxyreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/haproxyreceiver"
 
 import (
+	"bufio"
 	"bytes"
 	"context"
 	"encoding/csv"
+	"fmt"
+	"io"
 	"net"
+	"net/http"
 	"strings"
 	"time"
 
+	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.uber.org/zap"
@@ -30,23 +35,45 @@ import (
 )
 
 var (
-	showStatsCommand = []byte("show stats\n")
+	showStatsCommand = []byte("show stat typed\n")
+)
+
+const (
+	// csvSuffix makes the HAProxy stats page return its CSV representation.
+	csvSuffix = ";csv"
+	// serverType is the value of the `type` field for the servers of a backend.
+	serverType = "2"
 )
 
 type scraper struct {
 	endpoint       string
 	logger         *zap.Logger
 	metricsBuilder *metadata.MetricsBuilder
+	cfg            *Config
+	telemetry      component.TelemetrySettings
+	httpClient     *http.Client
 }
 
-func (s *scraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
-	var d net.Dialer
-	c, err := d.DialContext(ctx, "unix", s.endpoint)
+func (s *scraper) start(_ context.Context, host component.Host) error {
+	if !isHTTPEndpoint(s.endpoint) {
+		return nil
+	}
+	httpClient, err := s.cfg.HTTPClientSettings.ToClient(host, s.telemetry)
 	if err != nil {
-		return pmetric.NewMetrics(), err
+		return err
+	}
+	s.httpClient = httpClient
+	return nil
+}
+
+func (s *scraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
+	var records []map[string]string
+	var err error
+	if s.httpClient != nil {
+		records, err = s.readHTTPStats(ctx)
+	} else {
+		records, err = s.readSocketStats(ctx)
 	}
-	defer c.Close()
-	records, err := s.readStats(c)
 	if err != nil {
 		return pmetric.NewMetrics(), err
 	}
@@ -57,22 +84,75 @@ func (s *scraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
 		if err != nil {
 			return pmetric.NewMetrics(), err
 		}
+		if record["type"] == serverType {
+			s.recordServerMetrics(now, record)
+		}
 	}
 
 	return s.metricsBuilder.Emit(metadata.WithHaproxyAddr(s.endpoint)), nil
 }
 
-func (s *scraper) readStats(c net.Conn) ([]map[string]string, error) {
-	_, err := c.Write(showStatsCommand)
+func (s *scraper) recordServerMetrics(now pcommon.Timestamp, record map[string]string) {
+	var up int64
+	if isServerUp(record["status"]) {
+		up = 1
+	}
+	s.metricsBuilder.RecordHaproxyServerUpDataPoint(now, up, record["pxname"], record["svname"])
+
+	if err := s.metricsBuilder.RecordHaproxyServerWeightDataPoint(now, record["weight"], record["pxname"], record["svname"]); err != nil {
+		s.logger.Debug("failed to record server weight", zap.String("server", record["svname"]), zap.Error(err))
+	}
+}
+
+// isServerUp returns whether a server is up from its status, such as `UP`, `UP 1/3` when it is
+// going down, `DOWN`, `MAINT` or `no check` when health checks are disabled.
+func isServerUp(status string) bool {
+	return strings.HasPrefix(status, "UP") || status == "no check"
+}
+
+func (s *scraper) readSocketStats(ctx context.Context) ([]map[string]string, error) {
+	var d net.Dialer
+	c, err := d.DialContext(ctx, "unix", strings.TrimPrefix(s.endpoint, "file://"))
+	if err != nil {
+		return nil, err
+	}
+	defer c.Close()
+
+	_, err = c.Write(showStatsCommand)
+	if err != nil {
+		return nil, err
+	}
+	return parseTypedStats(c)
+}
+
+func (s *scraper) readHTTPStats(ctx context.Context) ([]map[string]string, error) {
+	url := s.endpoint
+	if !strings.HasSuffix(url, csvSuffix) {
+		url += csvSuffix
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.httpClient.Do(req)
 	if err != nil {
 		return nil, err
 	}
-	buf := make([]byte, 4096)
-	_, err = c.Read(buf)
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("expected 200 but received %d status code", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(resp.Body)
 	if err != nil {
 		return nil, err
 	}
-	reader := csv.NewReader(bytes.NewReader(buf))
+	return parseCSVStats(body)
+}
+
+// parseCSVStats parses the CSV representation of the stats, as returned by the stats page.
+func parseCSVStats(data []byte) ([]map[string]string, error) {
+	reader := csv.NewReader(bytes.NewReader(data))
 	headers, err := reader.Read()
 	if err != nil {
 		return nil, err
@@ -95,10 +175,50 @@ func (s *scraper) readStats(c net.Conn) ([]map[string]string, error) {
 	return results, err
 }
 
-func newScraper(metricsBuilder *metadata.MetricsBuilder, cfg *Config, logger *zap.Logger) *scraper {
+// parseTypedStats parses the typed representation of the stats, as returned by `show stat typed`.
+// Every line holds a single field of an object, in the form
+// `<object type>.<proxy id>.<object id>.<field position>.<field name>.<process>:<tags>:<type>:<value>`,
+// and the lines of every object are grouped into a record.
+func parseTypedStats(r io.Reader) ([]map[string]string, error) {
+	var results []map[string]string
+	byObject := make(map[string]map[string]string)
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" {
+			continue
+		}
+		parts := strings.SplitN(line, ":", 4)
+		if len(parts) != 4 {
+			return nil, fmt.Errorf("invalid typed stats line %q", line)
+		}
+		ids := strings.Split(parts[0], ".")
+		if len(ids) != 6 {
+			return nil, fmt.Errorf("invalid typed stats field %q", parts[0])
+		}
+
+		object := strings.Join([]string{ids[0], ids[1], ids[2], ids[5]}, ".")
+		result, ok := byObject[object]
+		if !ok {
+			result = make(map[string]string)
+			byObject[object] = result
+			results = append(results, result)
+		}
+		result[ids[4]] = parts[3]
+	}
+	return results, scanner.Err()
+}
+
+func isHTTPEndpoint(endpoint string) bool {
+	return strings.HasPrefix(endpoint, "http://") || strings.HasPrefix(endpoint, "https://")
+}
+
+func newScraper(metricsBuilder *metadata.MetricsBuilder, cfg *Config, telemetry component.TelemetrySettings) *scraper {
 	return &scraper{
 		endpoint:       cfg.Endpoint,
-		logger:         logger,
+		logger:         telemetry.Logger,
 		metricsBuilder: metricsBuilder,
+		cfg:            cfg,
+		telemetry:      telemetry,
 	}
 }
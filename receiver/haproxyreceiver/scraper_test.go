@@ -18,14 +18,18 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver/receivertest"
-	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/haproxyreceiver/internal/metadata"
 )
@@ -41,6 +45,7 @@ func Test_scraper_readStats(t *testing.T) {
 	go func() {
 		c, err2 := l.Accept()
 		require.NoError(t, err2)
+		defer c.Close()
 
 		buf := make([]byte, 512)
 		nr, err2 := c.Read(buf)
@@ -48,8 +53,8 @@ func Test_scraper_readStats(t *testing.T) {
 
 		data := string(buf[0:nr])
 		switch data {
-		case "show stats\n":
-			stats, err2 := os.ReadFile(filepath.Join("testdata", "stats.txt"))
+		case "show stat typed\n":
+			stats, err2 := os.ReadFile(filepath.Join("testdata", "stats_typed.txt"))
 			require.NoError(t, err2)
 			_, err2 = c.Write(stats)
 			require.NoError(t, err2)
@@ -63,14 +68,118 @@ func Test_scraper_readStats(t *testing.T) {
 	settings := receivertest.NewNopCreateSettings()
 	metricsBuilder := metadata.NewMetricsBuilder(haProxyCfg.MetricsSettings, settings)
 
-	s := newScraper(metricsBuilder, haProxyCfg, zap.NewNop())
+	s := newScraper(metricsBuilder, haProxyCfg, settings.TelemetrySettings)
+	require.NoError(t, s.start(context.Background(), componenttest.NewNopHost()))
 	m, err := s.scrape(context.Background())
 	require.NoError(t, err)
 	require.NotNil(t, m)
 	require.Equal(t, m.ResourceMetrics().Len(), 1)
 	require.Equal(t, m.ResourceMetrics().At(0).ScopeMetrics().Len(), 1)
-	metric := m.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0)
-	assert.Equal(t, "haproxy.sessions.count", metric.Name())
-	assert.Equal(t, int64(0), metric.Gauge().DataPoints().At(0).IntValue())
+	metrics := m.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
 
+	sessions := findMetric(t, metrics, "haproxy.sessions.count")
+	assert.Equal(t, 6, sessions.Gauge().DataPoints().Len())
+	assert.Equal(t, int64(0), sessions.Gauge().DataPoints().At(0).IntValue())
+
+	up := findMetric(t, metrics, "haproxy.server.up")
+	require.Equal(t, 3, up.Gauge().DataPoints().Len())
+	assert.Equal(t, int64(1), up.Gauge().DataPoints().At(0).IntValue())
+	assert.Equal(t, int64(1), up.Gauge().DataPoints().At(1).IntValue())
+	assert.Equal(t, int64(0), up.Gauge().DataPoints().At(2).IntValue())
+	svname, ok := up.Gauge().DataPoints().At(2).Attributes().Get("service_name")
+	require.True(t, ok)
+	assert.Equal(t, "s3", svname.Str())
+
+	weight := findMetric(t, metrics, "haproxy.server.weight")
+	require.Equal(t, 3, weight.Gauge().DataPoints().Len())
+	assert.Equal(t, int64(1), weight.Gauge().DataPoints().At(0).IntValue())
+}
+
+func Test_scraper_readHTTPStats(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/stats;csv" {
+			rw.WriteHeader(http.StatusNotFound)
+			return
+		}
+		stats, err := os.ReadFile(filepath.Join("testdata", "stats.txt"))
+		require.NoError(t, err)
+		_, err = rw.Write(stats)
+		require.NoError(t, err)
+	}))
+	defer server.Close()
+
+	haProxyCfg := newDefaultConfig().(*Config)
+	haProxyCfg.Endpoint = server.URL + "/stats"
+	settings := receivertest.NewNopCreateSettings()
+	metricsBuilder := metadata.NewMetricsBuilder(haProxyCfg.MetricsSettings, settings)
+
+	s := newScraper(metricsBuilder, haProxyCfg, settings.TelemetrySettings)
+	require.NoError(t, s.start(context.Background(), componenttest.NewNopHost()))
+	m, err := s.scrape(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, m.ResourceMetrics().Len(), 1)
+	endpoint, ok := m.ResourceMetrics().At(0).Resource().Attributes().Get("haproxy.addr")
+	require.True(t, ok)
+	assert.Equal(t, haProxyCfg.Endpoint, endpoint.Str())
+	metrics := m.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+
+	sessions := findMetric(t, metrics, "haproxy.sessions.count")
+	assert.Equal(t, 6, sessions.Gauge().DataPoints().Len())
+
+	up := findMetric(t, metrics, "haproxy.server.up")
+	require.Equal(t, 3, up.Gauge().DataPoints().Len())
+	for i := 0; i < up.Gauge().DataPoints().Len(); i++ {
+		assert.Equal(t, int64(1), up.Gauge().DataPoints().At(i).IntValue())
+	}
+}
+
+func Test_scraper_readHTTPStatsError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	haProxyCfg := newDefaultConfig().(*Config)
+	haProxyCfg.Endpoint = server.URL + "/stats;csv"
+	settings := receivertest.NewNopCreateSettings()
+	metricsBuilder := metadata.NewMetricsBuilder(haProxyCfg.MetricsSettings, settings)
+
+	s := newScraper(metricsBuilder, haProxyCfg, settings.TelemetrySettings)
+	require.NoError(t, s.start(context.Background(), componenttest.NewNopHost()))
+	_, err := s.scrape(context.Background())
+	require.EqualError(t, err, "expected 200 but received 401 status code")
+}
+
+func Test_parseTypedStats(t *testing.T) {
+	_, err := parseTypedStats(strings.NewReader("F.2.0.0.pxname.1:KNSV:str:stats\ninvalid\n"))
+	require.EqualError(t, err, `invalid typed stats line "invalid"`)
+
+	_, err = parseTypedStats(strings.NewReader("F.2.0.pxname:KNSV:str:stats\n"))
+	require.EqualError(t, err, `invalid typed stats field "F.2.0.pxname"`)
+
+	records, err := parseTypedStats(strings.NewReader("S.4.1.0.pxname.1:KNSV:str:webservers\nS.4.1.1.svname.1:KNSV:str:s1\nS.4.2.1.svname.1:KNSV:str:s2\n"))
+	require.NoError(t, err)
+	assert.Equal(t, []map[string]string{
+		{"pxname": "webservers", "svname": "s1"},
+		{"svname": "s2"},
+	}, records)
+}
+
+func Test_isServerUp(t *testing.T) {
+	assert.True(t, isServerUp("UP"))
+	assert.True(t, isServerUp("UP 1/3"))
+	assert.True(t, isServerUp("no check"))
+	assert.False(t, isServerUp("DOWN"))
+	assert.False(t, isServerUp("DOWN 1/2"))
+	assert.False(t, isServerUp("MAINT"))
+}
+
+func findMetric(t *testing.T, metrics pmetric.MetricSlice, name string) pmetric.Metric {
+	for i := 0; i < metrics.Len(); i++ {
+		if metrics.At(i).Name() == name {
+			return metrics.At(i)
+		}
+	}
+	require.Failf(t, "metric not found", "%s", name)
+	return pmetric.Metric{}
 }
@@ -34,13 +34,14 @@ func (ms *MetricSettings) Unmarshal(parser *confmap.Conf) error {
 
 // MetricsSettings provides settings for hostmetricsreceiver/network metrics.
 type MetricsSettings struct {
-	SystemNetworkConnections    MetricSettings `mapstructure:"system.network.connections"`
-	SystemNetworkConntrackCount MetricSettings `mapstructure:"system.network.conntrack.count"`
-	SystemNetworkConntrackMax   MetricSettings `mapstructure:"system.network.conntrack.max"`
-	SystemNetworkDropped        MetricSettings `mapstructure:"system.network.dropped"`
-	SystemNetworkErrors         MetricSettings `mapstructure:"system.network.errors"`
-	SystemNetworkIo             MetricSettings `mapstructure:"system.network.io"`
-	SystemNetworkPackets        MetricSettings `mapstructure:"system.network.packets"`
+	SystemNetworkConnections        MetricSettings `mapstructure:"system.network.connections"`
+	SystemNetworkConntrackCount     MetricSettings `mapstructure:"system.network.conntrack.count"`
+	SystemNetworkConntrackMax       MetricSettings `mapstructure:"system.network.conntrack.max"`
+	SystemNetworkDropped            MetricSettings `mapstructure:"system.network.dropped"`
+	SystemNetworkErrors             MetricSettings `mapstructure:"system.network.errors"`
+	SystemNetworkIo                 MetricSettings `mapstructure:"system.network.io"`
+	SystemNetworkPackets            MetricSettings `mapstructure:"system.network.packets"`
+	SystemNetworkProcessConnections MetricSettings `mapstructure:"system.network.process.connections"`
 }
 
 func DefaultMetricsSettings() MetricsSettings {
@@ -66,6 +67,9 @@ func DefaultMetricsSettings() MetricsSettings {
 		SystemNetworkPackets: MetricSettings{
 			Enabled: true,
 		},
+		SystemNetworkProcessConnections: MetricSettings{
+			Enabled: false,
+		},
 	}
 }
 
@@ -516,22 +520,79 @@ func newMetricSystemNetworkPackets(settings MetricSettings) metricSystemNetworkP
 	return m
 }
 
+type metricSystemNetworkProcessConnections struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.network.process.connections metric with initial data.
+func (m *metricSystemNetworkProcessConnections) init() {
+	m.data.SetName("system.network.process.connections")
+	m.data.SetDescription("The number of connections per owning process. Connections whose owning process cannot be determined, e.g. due to missing permissions, are not reported.")
+	m.data.SetUnit("{connections}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSystemNetworkProcessConnections) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, protocolAttributeValue string, stateAttributeValue string, processPidAttributeValue int64, processExecutableNameAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("protocol", protocolAttributeValue)
+	dp.Attributes().PutStr("state", stateAttributeValue)
+	dp.Attributes().PutInt("process.pid", processPidAttributeValue)
+	dp.Attributes().PutStr("process.executable.name", processExecutableNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemNetworkProcessConnections) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemNetworkProcessConnections) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemNetworkProcessConnections(settings MetricSettings) metricSystemNetworkProcessConnections {
+	m := metricSystemNetworkProcessConnections{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 // MetricsBuilder provides an interface for scrapers to report metrics while taking care of all the transformations
 // required to produce metric representation defined in metadata and user settings.
 type MetricsBuilder struct {
-	startTime                         pcommon.Timestamp   // start time that will be applied to all recorded data points.
-	metricsCapacity                   int                 // maximum observed number of metrics per resource.
-	resourceCapacity                  int                 // maximum observed number of resource attributes.
-	metricsBuffer                     pmetric.Metrics     // accumulates metrics data before emitting.
-	buildInfo                         component.BuildInfo // contains version information
-	resourceAttributesSettings        ResourceAttributesSettings
-	metricSystemNetworkConnections    metricSystemNetworkConnections
-	metricSystemNetworkConntrackCount metricSystemNetworkConntrackCount
-	metricSystemNetworkConntrackMax   metricSystemNetworkConntrackMax
-	metricSystemNetworkDropped        metricSystemNetworkDropped
-	metricSystemNetworkErrors         metricSystemNetworkErrors
-	metricSystemNetworkIo             metricSystemNetworkIo
-	metricSystemNetworkPackets        metricSystemNetworkPackets
+	startTime                             pcommon.Timestamp   // start time that will be applied to all recorded data points.
+	metricsCapacity                       int                 // maximum observed number of metrics per resource.
+	resourceCapacity                      int                 // maximum observed number of resource attributes.
+	metricsBuffer                         pmetric.Metrics     // accumulates metrics data before emitting.
+	buildInfo                             component.BuildInfo // contains version information
+	resourceAttributesSettings            ResourceAttributesSettings
+	metricSystemNetworkConnections        metricSystemNetworkConnections
+	metricSystemNetworkConntrackCount     metricSystemNetworkConntrackCount
+	metricSystemNetworkConntrackMax       metricSystemNetworkConntrackMax
+	metricSystemNetworkDropped            metricSystemNetworkDropped
+	metricSystemNetworkErrors             metricSystemNetworkErrors
+	metricSystemNetworkIo                 metricSystemNetworkIo
+	metricSystemNetworkPackets            metricSystemNetworkPackets
+	metricSystemNetworkProcessConnections metricSystemNetworkProcessConnections
 }
 
 // metricBuilderOption applies changes to default metrics builder.
@@ -553,17 +614,18 @@ func WithResourceAttributesSettings(ras ResourceAttributesSettings) metricBuilde
 
 func NewMetricsBuilder(ms MetricsSettings, settings receiver.CreateSettings, options ...metricBuilderOption) *MetricsBuilder {
 	mb := &MetricsBuilder{
-		startTime:                         pcommon.NewTimestampFromTime(time.Now()),
-		metricsBuffer:                     pmetric.NewMetrics(),
-		buildInfo:                         settings.BuildInfo,
-		resourceAttributesSettings:        DefaultResourceAttributesSettings(),
-		metricSystemNetworkConnections:    newMetricSystemNetworkConnections(ms.SystemNetworkConnections),
-		metricSystemNetworkConntrackCount: newMetricSystemNetworkConntrackCount(ms.SystemNetworkConntrackCount),
-		metricSystemNetworkConntrackMax:   newMetricSystemNetworkConntrackMax(ms.SystemNetworkConntrackMax),
-		metricSystemNetworkDropped:        newMetricSystemNetworkDropped(ms.SystemNetworkDropped),
-		metricSystemNetworkErrors:         newMetricSystemNetworkErrors(ms.SystemNetworkErrors),
-		metricSystemNetworkIo:             newMetricSystemNetworkIo(ms.SystemNetworkIo),
-		metricSystemNetworkPackets:        newMetricSystemNetworkPackets(ms.SystemNetworkPackets),
+		startTime:                             pcommon.NewTimestampFromTime(time.Now()),
+		metricsBuffer:                         pmetric.NewMetrics(),
+		buildInfo:                             settings.BuildInfo,
+		resourceAttributesSettings:            DefaultResourceAttributesSettings(),
+		metricSystemNetworkConnections:        newMetricSystemNetworkConnections(ms.SystemNetworkConnections),
+		metricSystemNetworkConntrackCount:     newMetricSystemNetworkConntrackCount(ms.SystemNetworkConntrackCount),
+		metricSystemNetworkConntrackMax:       newMetricSystemNetworkConntrackMax(ms.SystemNetworkConntrackMax),
+		metricSystemNetworkDropped:            newMetricSystemNetworkDropped(ms.SystemNetworkDropped),
+		metricSystemNetworkErrors:             newMetricSystemNetworkErrors(ms.SystemNetworkErrors),
+		metricSystemNetworkIo:                 newMetricSystemNetworkIo(ms.SystemNetworkIo),
+		metricSystemNetworkPackets:            newMetricSystemNetworkPackets(ms.SystemNetworkPackets),
+		metricSystemNetworkProcessConnections: newMetricSystemNetworkProcessConnections(ms.SystemNetworkProcessConnections),
 	}
 	for _, op := range options {
 		op(mb)
@@ -624,6 +686,7 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	mb.metricSystemNetworkErrors.emit(ils.Metrics())
 	mb.metricSystemNetworkIo.emit(ils.Metrics())
 	mb.metricSystemNetworkPackets.emit(ils.Metrics())
+	mb.metricSystemNetworkProcessConnections.emit(ils.Metrics())
 
 	for _, op := range rmo {
 		op(mb.resourceAttributesSettings, rm)
@@ -679,6 +742,11 @@ func (mb *MetricsBuilder) RecordSystemNetworkPacketsDataPoint(ts pcommon.Timesta
 	mb.metricSystemNetworkPackets.recordDataPoint(mb.startTime, ts, val, deviceAttributeValue, directionAttributeValue.String())
 }
 
+// RecordSystemNetworkProcessConnectionsDataPoint adds a data point to system.network.process.connections metric.
+func (mb *MetricsBuilder) RecordSystemNetworkProcessConnectionsDataPoint(ts pcommon.Timestamp, val int64, protocolAttributeValue AttributeProtocol, stateAttributeValue string, processPidAttributeValue int64, processExecutableNameAttributeValue string) {
+	mb.metricSystemNetworkProcessConnections.recordDataPoint(mb.startTime, ts, val, protocolAttributeValue.String(), stateAttributeValue, processPidAttributeValue, processExecutableNameAttributeValue)
+}
+
 // Reset resets metrics builder to its initial state. It should be used when external metrics source is restarted,
 // and metrics builder should update its startTime and reset it's internal state accordingly.
 func (mb *MetricsBuilder) Reset(options ...metricBuilderOption) {
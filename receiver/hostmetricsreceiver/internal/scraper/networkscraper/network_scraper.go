@@ -21,11 +21,13 @@ import (
 
 	"github.com/shirou/gopsutil/v3/host"
 	"github.com/shirou/gopsutil/v3/net"
+	"github.com/shirou/gopsutil/v3/process"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver"
 	"go.opentelemetry.io/collector/receiver/scrapererror"
+	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterset"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/networkscraper/internal/metadata"
@@ -50,6 +52,7 @@ type scraper struct {
 	ioCounters  func(bool) ([]net.IOCountersStat, error)
 	connections func(string) ([]net.ConnectionStat, error)
 	conntrack   func() ([]net.FilterStat, error)
+	processName func(int32) (string, error)
 }
 
 // newNetworkScraper creates a set of Network related metrics
@@ -61,6 +64,7 @@ func newNetworkScraper(_ context.Context, settings receiver.CreateSettings, cfg
 		ioCounters:  net.IOCounters,
 		connections: net.Connections,
 		conntrack:   net.FilterCounters,
+		processName: getProcessName,
 	}
 
 	var err error
@@ -175,6 +179,10 @@ func (s *scraper) recordNetworkConnectionsMetrics() error {
 	tcpConnectionStatusCounts := getTCPConnectionStatusCounts(connections)
 
 	s.recordNetworkConnectionsMetric(now, tcpConnectionStatusCounts)
+
+	if s.config.Metrics.SystemNetworkProcessConnections.Enabled {
+		s.recordNetworkProcessConnectionsMetric(now, connections)
+	}
 	return nil
 }
 
@@ -196,6 +204,43 @@ func (s *scraper) recordNetworkConnectionsMetric(now pcommon.Timestamp, connecti
 	}
 }
 
+type processConnectionsKey struct {
+	state string
+	pid   int32
+}
+
+func (s *scraper) recordNetworkProcessConnectionsMetric(now pcommon.Timestamp, connections []net.ConnectionStat) {
+	counts := make(map[processConnectionsKey]int64)
+	for _, connection := range connections {
+		// the owning process is only known when the collector is allowed to inspect it
+		if connection.Pid <= 0 {
+			continue
+		}
+		counts[processConnectionsKey{state: connection.Status, pid: connection.Pid}]++
+	}
+
+	names := make(map[int32]string)
+	for key, count := range counts {
+		name, ok := names[key.pid]
+		if !ok {
+			var err error
+			if name, err = s.processName(key.pid); err != nil {
+				s.settings.Logger.Debug("failed to read name of process owning connections", zap.Int32("pid", key.pid), zap.Error(err))
+			}
+			names[key.pid] = name
+		}
+		s.mb.RecordSystemNetworkProcessConnectionsDataPoint(now, count, metadata.AttributeProtocolTcp, key.state, int64(key.pid), name)
+	}
+}
+
+func getProcessName(pid int32) (string, error) {
+	proc, err := process.NewProcess(pid)
+	if err != nil {
+		return "", err
+	}
+	return proc.Name()
+}
+
 func (s *scraper) filterByInterface(ioCounters []net.IOCountersStat) []net.IOCountersStat {
 	if s.includeFS == nil && s.excludeFS == nil {
 		return ioCounters
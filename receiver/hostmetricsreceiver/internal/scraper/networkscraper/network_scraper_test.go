@@ -216,3 +216,59 @@ func assertNetworkConnectionsMetricValid(t *testing.T, metric pmetric.Metric) {
 	internal.AssertSumMetricHasAttribute(t, metric, 0, "state")
 	assert.Equal(t, 12, metric.Sum().DataPoints().Len())
 }
+
+func TestScrapeProcessConnections(t *testing.T) {
+	config := &Config{Metrics: metadata.DefaultMetricsSettings()}
+	config.Metrics.SystemNetworkProcessConnections.Enabled = true
+	scraper, err := newNetworkScraper(context.Background(), receivertest.NewNopCreateSettings(), config)
+	require.NoError(t, err)
+
+	scraper.connections = func(string) ([]net.ConnectionStat, error) {
+		return []net.ConnectionStat{
+			{Status: "ESTABLISHED", Pid: 10},
+			{Status: "ESTABLISHED", Pid: 10},
+			{Status: "CLOSE_WAIT", Pid: 10},
+			{Status: "ESTABLISHED", Pid: 20},
+			{Status: "LISTEN", Pid: 0},
+		}, nil
+	}
+	scraper.processName = func(pid int32) (string, error) {
+		if pid == 10 {
+			return "nginx", nil
+		}
+		return "", errors.New("permission denied")
+	}
+
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+	md, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+
+	metrics := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	var processConnections pmetric.Metric
+	for i := 0; i < metrics.Len(); i++ {
+		if metrics.At(i).Name() == "system.network.process.connections" {
+			processConnections = metrics.At(i)
+		}
+	}
+	require.Equal(t, "system.network.process.connections", processConnections.Name())
+
+	type dataPoint struct {
+		state string
+		pid   int64
+		name  string
+	}
+	counts := map[dataPoint]int64{}
+	dps := processConnections.Sum().DataPoints()
+	for i := 0; i < dps.Len(); i++ {
+		attrs := dps.At(i).Attributes()
+		state, _ := attrs.Get("state")
+		pid, _ := attrs.Get("process.pid")
+		name, _ := attrs.Get("process.executable.name")
+		counts[dataPoint{state: state.Str(), pid: pid.Int(), name: name.Str()}] = dps.At(i).IntValue()
+	}
+	assert.Equal(t, map[dataPoint]int64{
+		{state: "ESTABLISHED", pid: 10, name: "nginx"}: 2,
+		{state: "CLOSE_WAIT", pid: 10, name: "nginx"}:  1,
+		{state: "ESTABLISHED", pid: 20, name: ""}:      1,
+	}, counts)
+}
@@ -119,6 +119,11 @@ func (mpc *mockPerfCounter) ScrapeData() ([]winperfcounters.CounterValue, error)
 	return []winperfcounters.CounterValue{{InstanceName: "Instance", Value: 1}}, mpc.watchErr
 }
 
+// ScrapeRawValues
+func (mpc *mockPerfCounter) ScrapeRawValues() ([]winperfcounters.RawCounterValue, error) {
+	return []winperfcounters.RawCounterValue{{InstanceName: "Instance", RawValue: 1}}, mpc.watchErr
+}
+
 // Close
 func (mpc *mockPerfCounter) Close() error {
 	return nil
@@ -24,6 +24,8 @@ import (
 	"go.opentelemetry.io/collector/config/configgrpc"
 	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/confmap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/requestlimit"
 )
 
 const (
@@ -81,6 +83,11 @@ func DefaultServerConfigUDP() ServerConfigUDP {
 type Config struct {
 	Protocols      `mapstructure:"protocols"`
 	RemoteSampling *RemoteSamplingConfig `mapstructure:"remote_sampling"`
+	// Limits guards the Thrift HTTP endpoint against oversized requests and clients sending
+	// too many spans. All limits are disabled by default.
+	Limits requestlimit.Settings `mapstructure:"limits"`
+	// GRPCReflection serves the gRPC server reflection service on the gRPC endpoint, disabled by default.
+	GRPCReflection bool `mapstructure:"grpc_reflection"`
 }
 
 var _ component.Config = (*Config)(nil)
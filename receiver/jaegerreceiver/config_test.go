@@ -27,11 +27,15 @@ import (
 	"go.opentelemetry.io/collector/config/confignet"
 	"go.opentelemetry.io/collector/config/configtls"
 	"go.opentelemetry.io/collector/confmap/confmaptest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/requestlimit"
 )
 
 func TestLoadConfig(t *testing.T) {
 	t.Parallel()
 
+	rateLimiterID := component.NewIDWithName("rate_limiter", "jaeger")
+
 	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
 	require.NoError(t, err)
 
@@ -144,6 +148,35 @@ func TestLoadConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			id: component.NewIDWithName(typeStr, "limits"),
+			expected: &Config{
+				Protocols: Protocols{
+					ThriftHTTP: &confighttp.HTTPServerSettings{
+						Endpoint: defaultHTTPBindEndpoint,
+					},
+				},
+				Limits: requestlimit.Settings{
+					MaxRequestBytes:    5242880,
+					MaxSpansPerRequest: 1000,
+					RateLimiter:        &rateLimiterID,
+				},
+			},
+		},
+		{
+			id: component.NewIDWithName(typeStr, "grpc_reflection"),
+			expected: &Config{
+				Protocols: Protocols{
+					GRPC: &configgrpc.GRPCServerSettings{
+						NetAddr: confignet.NetAddr{
+							Endpoint:  defaultGRPCBindEndpoint,
+							Transport: "tcp",
+						},
+					},
+				},
+				GRPCReflection: true,
+			},
+		},
 	}
 
 	for _, tt := range tests {
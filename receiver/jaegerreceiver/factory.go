@@ -95,10 +95,12 @@ func createTracesReceiver(
 	// Set ports
 	if rCfg.Protocols.GRPC != nil {
 		config.CollectorGRPCServerSettings = *rCfg.Protocols.GRPC
+		config.CollectorGRPCReflection = rCfg.GRPCReflection
 	}
 
 	if rCfg.Protocols.ThriftHTTP != nil {
 		config.CollectorHTTPSettings = *rCfg.ThriftHTTP
+		config.CollectorHTTPLimits = rCfg.Limits
 	}
 
 	if rCfg.Protocols.ThriftBinary != nil {
@@ -0,0 +1,60 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jaegerreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/jaegerreceiver"
+
+import (
+	"context"
+	"sync"
+
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+	"go.opentelemetry.io/collector/component"
+)
+
+var (
+	registerViewsOnce sync.Once
+	errRegisterViews  error
+)
+
+// registerViews registers the views of the receiver metrics, only once for all the receivers.
+func registerViews() error {
+	registerViewsOnce.Do(func() {
+		errRegisterViews = view.Register(viewRequestsReceived)
+	})
+	return errRegisterViews
+}
+
+var (
+	receiverTagKey = tag.MustNewKey("receiver")
+	protocolTagKey = tag.MustNewKey("protocol")
+
+	mRequestsReceived = stats.Int64("otelcol/jaeger/requests_received", "Number of batches received per Jaeger protocol", "1")
+)
+
+var viewRequestsReceived = &view.View{
+	Name:        mRequestsReceived.Name(),
+	Description: mRequestsReceived.Description(),
+	Measure:     mRequestsReceived,
+	TagKeys:     []tag.Key{receiverTagKey, protocolTagKey},
+	Aggregation: view.Sum(),
+}
+
+func recordRequestReceived(id component.ID, protocol string) {
+	_ = stats.RecordWithTags(
+		context.Background(),
+		[]tag.Mutator{tag.Upsert(receiverTagKey, id.String()), tag.Upsert(protocolTagKey, protocol)},
+		mRequestsReceived.M(int64(1)))
+}
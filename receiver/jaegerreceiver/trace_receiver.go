@@ -19,7 +19,6 @@ import (
 	"errors"
 	"fmt"
 	"html"
-	"io"
 	"mime"
 	"net/http"
 	"sync"
@@ -47,7 +46,11 @@ import (
 	"go.opentelemetry.io/collector/receiver"
 	"go.uber.org/multierr"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/requestlimit"
 	jaegertranslator "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/translator/jaeger"
 )
 
@@ -55,7 +58,9 @@ import (
 // the Jaeger receiver will use.
 type configuration struct {
 	CollectorHTTPSettings       confighttp.HTTPServerSettings
+	CollectorHTTPLimits         requestlimit.Settings
 	CollectorGRPCServerSettings configgrpc.GRPCServerSettings
+	CollectorGRPCReflection     bool
 
 	AgentCompactThrift ProtocolUDP
 	AgentBinaryThrift  ProtocolUDP
@@ -71,7 +76,9 @@ type jReceiver struct {
 	config *configuration
 
 	grpc            *grpc.Server
+	grpcHealth      *health.Server
 	collectorServer *http.Server
+	httpLimiter     *requestlimit.Limiter
 
 	agentProcessors []processors.Processor
 	agentServer     *http.Server
@@ -109,6 +116,10 @@ func newJaegerReceiver(
 	nextConsumer consumer.Traces,
 	set receiver.CreateSettings,
 ) (*jReceiver, error) {
+	if err := registerViews(); err != nil {
+		return nil, fmt.Errorf("failed to register metric views: %w", err)
+	}
+
 	grpcObsrecv, err := obsreport.NewReceiver(obsreport.ReceiverSettings{
 		ReceiverID:             id,
 		Transport:              grpcTransport,
@@ -133,6 +144,7 @@ func newJaegerReceiver(
 		settings:     set,
 		grpcObsrecv:  grpcObsrecv,
 		httpObsrecv:  httpObsrecv,
+		httpLimiter:  requestlimit.NewLimiter(config.CollectorHTTPLimits),
 	}, nil
 }
 
@@ -161,6 +173,9 @@ func (jr *jReceiver) Shutdown(ctx context.Context) error {
 			errs = multierr.Append(errs, cerr)
 		}
 	}
+	if jr.grpcHealth != nil {
+		jr.grpcHealth.Shutdown()
+	}
 	if jr.grpc != nil {
 		jr.grpc.GracefulStop()
 	}
@@ -199,6 +214,8 @@ func (notImplementedConfigManager) GetBaggageRestrictions(ctx context.Context, s
 type agentHandler struct {
 	nextConsumer consumer.Traces
 	obsrecv      *obsreport.Receiver
+	id           component.ID
+	protocol     string
 }
 
 // EmitZipkinBatch is unsupported agent's
@@ -209,6 +226,7 @@ func (h *agentHandler) EmitZipkinBatch(context.Context, []*zipkincore.Span) (err
 // EmitBatch implements thrift-gen/agent/Agent and it forwards
 // Jaeger spans received by the Jaeger agent processor.
 func (h *agentHandler) EmitBatch(ctx context.Context, batch *jaeger.Batch) error {
+	recordRequestReceived(h.id, h.protocol)
 	ctx = h.obsrecv.StartTracesOp(ctx)
 	numSpans, err := consumeTraces(ctx, batch, h.nextConsumer)
 	h.obsrecv.EndTracesOp(ctx, thriftFormat, numSpans, err)
@@ -216,6 +234,7 @@ func (h *agentHandler) EmitBatch(ctx context.Context, batch *jaeger.Batch) error
 }
 
 func (jr *jReceiver) PostSpans(ctx context.Context, r *api_v2.PostSpansRequest) (*api_v2.PostSpansResponse, error) {
+	recordRequestReceived(jr.id, protoGRPC)
 	ctx = jr.grpcObsrecv.StartTracesOp(ctx)
 
 	batch := r.GetBatch()
@@ -252,6 +271,8 @@ func (jr *jReceiver) startAgent(host component.Host) error {
 		h := &agentHandler{
 			nextConsumer: jr.nextConsumer,
 			obsrecv:      obsrecv,
+			id:           jr.id,
+			protocol:     protoThriftBinary,
 		}
 		processor, err := jr.buildProcessor(jr.config.AgentBinaryThrift.Endpoint, jr.config.AgentBinaryThrift.ServerConfigUDP, apacheThrift.NewTBinaryProtocolFactoryConf(nil), h)
 		if err != nil {
@@ -272,6 +293,8 @@ func (jr *jReceiver) startAgent(host component.Host) error {
 		h := &agentHandler{
 			nextConsumer: jr.nextConsumer,
 			obsrecv:      obsrecv,
+			id:           jr.id,
+			protocol:     protoThriftCompact,
 		}
 		processor, err := jr.buildProcessor(jr.config.AgentCompactThrift.Endpoint, jr.config.AgentCompactThrift.ServerConfigUDP, apacheThrift.NewTCompactProtocolFactoryConf(nil), h)
 		if err != nil {
@@ -326,8 +349,15 @@ func (jr *jReceiver) buildProcessor(address string, cfg ServerConfigUDP, factory
 }
 
 func (jr *jReceiver) decodeThriftHTTPBody(r *http.Request) (*jaeger.Batch, *httpError) {
-	bodyBytes, err := io.ReadAll(r.Body)
+	bodyBytes, err := jr.httpLimiter.ReadBody(r.Body)
 	r.Body.Close()
+	var tooLarge *requestlimit.TooLargeError
+	if errors.As(err, &tooLarge) {
+		return nil, &httpError{
+			err.Error(),
+			http.StatusRequestEntityTooLarge,
+		}
+	}
 	if err != nil {
 		return nil, &httpError{
 			fmt.Sprintf("Unable to process request body: %v", err),
@@ -362,6 +392,7 @@ func (jr *jReceiver) decodeThriftHTTPBody(r *http.Request) (*jaeger.Batch, *http
 
 // HandleThriftHTTPBatch implements Jaeger HTTP Thrift handler.
 func (jr *jReceiver) HandleThriftHTTPBatch(w http.ResponseWriter, r *http.Request) {
+	recordRequestReceived(jr.id, protoThriftHTTP)
 	ctx := jr.httpObsrecv.StartTracesOp(r.Context())
 
 	batch, hErr := jr.decodeThriftHTTPBody(r)
@@ -371,7 +402,16 @@ func (jr *jReceiver) HandleThriftHTTPBatch(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
-	numSpans, err := consumeTraces(ctx, batch, jr.nextConsumer)
+	td, err := jaegertranslator.ThriftToTraces(batch)
+	if err == nil {
+		if err = jr.httpLimiter.Check(ctx, td); err != nil {
+			requestlimit.WriteError(w, err, http.StatusInternalServerError)
+			jr.httpObsrecv.EndTracesOp(ctx, thriftFormat, len(batch.Spans), err)
+			return
+		}
+		err = jr.nextConsumer.ConsumeTraces(ctx, td)
+	}
+	numSpans := len(batch.Spans)
 	if err != nil {
 		http.Error(w, fmt.Sprintf("Cannot submit Jaeger batch: %v", err), http.StatusInternalServerError)
 	} else {
@@ -394,7 +434,10 @@ func (jr *jReceiver) startCollector(host component.Host) error {
 
 		nr := mux.NewRouter()
 		nr.HandleFunc("/api/traces", jr.HandleThriftHTTPBatch).Methods(http.MethodPost)
-		jr.collectorServer, err = jr.config.CollectorHTTPSettings.ToServer(host, jr.settings.TelemetrySettings, nr)
+		if err = jr.httpLimiter.Start(host); err != nil {
+			return err
+		}
+		jr.collectorServer, err = jr.config.CollectorHTTPSettings.ToServer(host, jr.settings.TelemetrySettings, jr.httpLimiter.Handler(nr))
 		if err != nil {
 			return err
 		}
@@ -421,6 +464,13 @@ func (jr *jReceiver) startCollector(host component.Host) error {
 		}
 
 		api_v2.RegisterCollectorServiceServer(jr.grpc, jr)
+		if jr.config.CollectorGRPCReflection {
+			reflection.Register(jr.grpc)
+		}
+
+		jr.grpcHealth = health.NewServer()
+		jr.grpcHealth.SetServingStatus("jaeger.api_v2.CollectorService", grpc_health_v1.HealthCheckResponse_SERVING)
+		grpc_health_v1.RegisterHealthServer(jr.grpc, jr.grpcHealth)
 
 		jr.goroutines.Add(1)
 		go func() {
@@ -24,6 +24,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
@@ -33,6 +34,8 @@ import (
 	jaegerthrift "github.com/jaegertracing/jaeger/thrift-gen/jaeger"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/config/configgrpc"
@@ -47,8 +50,10 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health/grpc_health_v1"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/common/testutil"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/requestlimit"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/translator/jaeger"
 )
 
@@ -120,6 +125,91 @@ func TestReception(t *testing.T) {
 	assert.EqualValues(t, td, gotTraces[0])
 }
 
+type testRateLimiter struct {
+	component.StartFunc
+	component.ShutdownFunc
+}
+
+type rateLimitedError struct{}
+
+func (rateLimitedError) Error() string {
+	return "rate limited"
+}
+
+func (rateLimitedError) WriteHTTP(w http.ResponseWriter) {
+	http.Error(w, "rate limited", http.StatusTooManyRequests)
+}
+
+func (testRateLimiter) Limit(context.Context, pcommon.Map, int) error {
+	return rateLimitedError{}
+}
+
+type testHost struct {
+	component.Host
+	extensions map[component.ID]component.Component
+}
+
+func (h *testHost) GetExtensions() map[component.ID]component.Component {
+	return h.extensions
+}
+
+func TestReceptionLimits(t *testing.T) {
+	addr := testutil.GetAvailableLocalAddress(t)
+	rateLimiterID := component.NewID("rate_limiter")
+	config := &configuration{
+		CollectorHTTPSettings: confighttp.HTTPServerSettings{
+			Endpoint: addr,
+		},
+		CollectorHTTPLimits: requestlimit.Settings{
+			MaxSpansPerRequest: 1,
+			RateLimiter:        &rateLimiterID,
+		},
+	}
+	sink := new(consumertest.TracesSink)
+
+	set := receivertest.NewNopCreateSettings()
+	jr, err := newJaegerReceiver(jaegerReceiver, config, sink, set)
+	require.NoError(t, err)
+
+	host := &testHost{
+		Host:       componenttest.NewNopHost(),
+		extensions: map[component.ID]component.Component{rateLimiterID: testRateLimiter{}},
+	}
+	require.NoError(t, jr.Start(context.Background(), host))
+	t.Cleanup(func() { require.NoError(t, jr.Shutdown(context.Background())) })
+
+	_, port, _ := net.SplitHostPort(addr)
+	collectorAddr := fmt.Sprintf("http://localhost:%s/api/traces", port)
+	batch := &jaegerthrift.Batch{
+		Process: jaegerthrift.NewProcess(),
+		Spans:   []*jaegerthrift.Span{jaegerthrift.NewSpan(), jaegerthrift.NewSpan()},
+	}
+
+	err = sendToCollector(collectorAddr, batch)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), fmt.Sprint(http.StatusRequestEntityTooLarge))
+
+	batch.Spans = batch.Spans[:1]
+	err = sendToCollector(collectorAddr, batch)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), fmt.Sprint(http.StatusTooManyRequests))
+
+	assert.Empty(t, sink.AllTraces())
+}
+
+func TestDecodeThriftHTTPBodyTooLarge(t *testing.T) {
+	jr, err := newJaegerReceiver(jaegerReceiver, &configuration{
+		CollectorHTTPLimits: requestlimit.Settings{MaxRequestBytes: 5},
+	}, consumertest.NewNop(), receivertest.NewNopCreateSettings())
+	require.NoError(t, err)
+
+	r := httptest.NewRequest(http.MethodPost, "/api/traces", strings.NewReader("123456"))
+	r.Header.Set("Content-Type", "application/x-thrift")
+	_, hErr := jr.decodeThriftHTTPBody(r)
+	require.NotNil(t, hErr)
+	assert.Equal(t, http.StatusRequestEntityTooLarge, hErr.statusCode)
+}
+
 func TestPortsNotOpen(t *testing.T) {
 	// an empty config should result in no open ports
 	config := &configuration{}
@@ -197,6 +287,61 @@ func TestGRPCReception(t *testing.T) {
 	assert.EqualValues(t, want, gotTraces[0])
 }
 
+func TestGRPCHealthCheck(t *testing.T) {
+	config := &configuration{
+		CollectorGRPCServerSettings: configgrpc.GRPCServerSettings{
+			NetAddr: confignet.NetAddr{
+				Endpoint:  testutil.GetAvailableLocalAddress(t),
+				Transport: "tcp",
+			},
+		},
+	}
+	sink := new(consumertest.TracesSink)
+
+	set := receivertest.NewNopCreateSettings()
+	jr, err := newJaegerReceiver(jaegerReceiver, config, sink, set)
+	require.NoError(t, err)
+
+	require.NoError(t, jr.Start(context.Background(), componenttest.NewNopHost()))
+	t.Cleanup(func() { require.NoError(t, jr.Shutdown(context.Background())) })
+
+	conn, err := grpc.Dial(config.CollectorGRPCServerSettings.NetAddr.Endpoint, grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithBlock())
+	require.NoError(t, err)
+	defer conn.Close()
+
+	cl := grpc_health_v1.NewHealthClient(conn)
+	for _, service := range []string{"", "jaeger.api_v2.CollectorService"} {
+		resp, err := cl.Check(context.Background(), &grpc_health_v1.HealthCheckRequest{Service: service})
+		require.NoError(t, err)
+		assert.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING, resp.Status)
+	}
+}
+
+func TestRequestsReceivedPerProtocol(t *testing.T) {
+	id := component.NewIDWithName(typeStr, "requests")
+	countFor := func(protocol string) int64 {
+		rows, err := view.RetrieveData(viewRequestsReceived.Name)
+		require.NoError(t, err)
+		for _, row := range rows {
+			tags := map[tag.Key]string{}
+			for _, tg := range row.Tags {
+				tags[tg.Key] = tg.Value
+			}
+			if tags[receiverTagKey] == id.String() && tags[protocolTagKey] == protocol {
+				return int64(row.Data.(*view.SumData).Value)
+			}
+		}
+		return 0
+	}
+
+	require.NoError(t, registerViews())
+	recordRequestReceived(id, protoThriftCompact)
+	recordRequestReceived(id, protoThriftCompact)
+	recordRequestReceived(id, protoGRPC)
+	assert.Equal(t, int64(2), countFor(protoThriftCompact))
+	assert.Equal(t, int64(1), countFor(protoGRPC))
+}
+
 func TestGRPCReceptionWithTLS(t *testing.T) {
 	// prepare
 	tlsCreds := &configtls.TLSServerSetting{
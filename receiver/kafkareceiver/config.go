@@ -15,6 +15,7 @@
 package kafkareceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/kafkareceiver"
 
 import (
+	"errors"
 	"time"
 
 	"go.opentelemetry.io/collector/component"
@@ -42,6 +43,15 @@ type MessageMarking struct {
 	OnError bool `mapstructure:"on_error"`
 }
 
+// DeadLetter defines the topic the messages that fail to be unmarshaled are forwarded to.
+type DeadLetter struct {
+	// If true, the messages that fail to be unmarshaled are published to the dead letter
+	// topic and consumption continues with the next message (default disabled).
+	Enabled bool `mapstructure:"enabled"`
+	// The name of the kafka topic to publish the messages to.
+	Topic string `mapstructure:"topic"`
+}
+
 // Config defines configuration for Kafka receiver.
 type Config struct {
 	// The list of kafka brokers (default localhost:9092)
@@ -68,11 +78,22 @@ type Config struct {
 
 	// Controls the way the messages are marked as consumed
 	MessageMarking MessageMarking `mapstructure:"message_marking"`
+
+	// Controls the forwarding of the messages that fail to be unmarshaled
+	DeadLetter DeadLetter `mapstructure:"dead_letter"`
 }
 
 var _ component.Config = (*Config)(nil)
 
 // Validate checks the receiver configuration is valid
 func (cfg *Config) Validate() error {
+	if cfg.DeadLetter.Enabled {
+		if cfg.DeadLetter.Topic == "" {
+			return errors.New("dead_letter topic must be specified")
+		}
+		if cfg.DeadLetter.Topic == cfg.Topic {
+			return errors.New("dead_letter topic must differ from the consumed topic")
+		}
+	}
 	return nil
 }
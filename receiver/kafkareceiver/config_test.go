@@ -100,6 +100,31 @@ func TestLoadConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			id: component.NewIDWithName(typeStr, "dead_letter"),
+			expected: &Config{
+				Topic:    "spans",
+				Encoding: "otlp_proto",
+				Brokers:  []string{"foo:123"},
+				ClientID: "otel-collector",
+				GroupID:  "otel-collector",
+				Metadata: kafkaexporter.Metadata{
+					Full: true,
+					Retry: kafkaexporter.MetadataRetry{
+						Max:     3,
+						Backoff: time.Millisecond * 250,
+					},
+				},
+				AutoCommit: AutoCommit{
+					Enable:   true,
+					Interval: 1 * time.Second,
+				},
+				DeadLetter: DeadLetter{
+					Enabled: true,
+					Topic:   "spans_dead_letter",
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -116,3 +141,44 @@ func TestLoadConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateConfig(t *testing.T) {
+	tests := []struct {
+		name        string
+		deadLetter  DeadLetter
+		expectedErr string
+	}{
+		{
+			name:       "disabled",
+			deadLetter: DeadLetter{Topic: ""},
+		},
+		{
+			name:       "enabled",
+			deadLetter: DeadLetter{Enabled: true, Topic: "spans_dead_letter"},
+		},
+		{
+			name:        "missing topic",
+			deadLetter:  DeadLetter{Enabled: true},
+			expectedErr: "dead_letter topic must be specified",
+		},
+		{
+			name:        "same topic",
+			deadLetter:  DeadLetter{Enabled: true, Topic: "spans"},
+			expectedErr: "dead_letter topic must differ from the consumed topic",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			cfg.Topic = "spans"
+			cfg.DeadLetter = tt.deadLetter
+			err := cfg.Validate()
+			if tt.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.expectedErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kafkareceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/kafkareceiver"
+
+import (
+	"strconv"
+
+	"github.com/Shopify/sarama"
+)
+
+// Headers added to the messages published to the dead letter topic.
+const (
+	deadLetterHeaderError     = "otel-dead-letter-error"
+	deadLetterHeaderTopic     = "otel-dead-letter-topic"
+	deadLetterHeaderPartition = "otel-dead-letter-partition"
+	deadLetterHeaderOffset    = "otel-dead-letter-offset"
+)
+
+// deadLetterPublisher publishes the messages that fail to be unmarshaled to the dead letter topic.
+type deadLetterPublisher struct {
+	producer sarama.SyncProducer
+	topic    string
+}
+
+func newDeadLetterPublisher(config Config, c *sarama.Config) (*deadLetterPublisher, error) {
+	if !config.DeadLetter.Enabled {
+		return nil, nil
+	}
+	// The sync producer requires the successes to be returned.
+	c.Producer.Return.Successes = true
+	producer, err := sarama.NewSyncProducer(config.Brokers, c)
+	if err != nil {
+		return nil, err
+	}
+	return &deadLetterPublisher{producer: producer, topic: config.DeadLetter.Topic}, nil
+}
+
+// publish forwards the message to the dead letter topic, keeping its key, value and headers.
+// The unmarshal error and the origin of the message are added as headers.
+func (p *deadLetterPublisher) publish(message *sarama.ConsumerMessage, cause error) error {
+	headers := make([]sarama.RecordHeader, 0, len(message.Headers)+4)
+	for _, h := range message.Headers {
+		if h != nil {
+			headers = append(headers, *h)
+		}
+	}
+	headers = append(headers,
+		sarama.RecordHeader{Key: []byte(deadLetterHeaderError), Value: []byte(cause.Error())},
+		sarama.RecordHeader{Key: []byte(deadLetterHeaderTopic), Value: []byte(message.Topic)},
+		sarama.RecordHeader{Key: []byte(deadLetterHeaderPartition), Value: []byte(strconv.FormatInt(int64(message.Partition), 10))},
+		sarama.RecordHeader{Key: []byte(deadLetterHeaderOffset), Value: []byte(strconv.FormatInt(message.Offset, 10))},
+	)
+
+	msg := &sarama.ProducerMessage{
+		Topic:   p.topic,
+		Value:   sarama.ByteEncoder(message.Value),
+		Headers: headers,
+	}
+	if message.Key != nil {
+		msg.Key = sarama.ByteEncoder(message.Key)
+	}
+	_, _, err := p.producer.SendMessage(msg)
+	return err
+}
+
+func (p *deadLetterPublisher) close() error {
+	if p == nil {
+		return nil
+	}
+	return p.producer.Close()
+}
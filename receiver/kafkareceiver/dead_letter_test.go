@@ -0,0 +1,150 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kafkareceiver
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/Shopify/sarama"
+	"github.com/Shopify/sarama/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/obsreport"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	"go.uber.org/zap"
+)
+
+func TestNewDeadLetterPublisher_disabled(t *testing.T) {
+	p, err := newDeadLetterPublisher(Config{}, sarama.NewConfig())
+	require.NoError(t, err)
+	assert.Nil(t, p)
+	assert.NoError(t, p.close())
+}
+
+func TestDeadLetterPublisher_publish(t *testing.T) {
+	c := sarama.NewConfig()
+	c.Producer.Return.Successes = true
+	producer := mocks.NewSyncProducer(t, c)
+	producer.ExpectSendMessageWithMessageCheckerFunctionAndSucceed(func(msg *sarama.ProducerMessage) error {
+		assert.Equal(t, "spans_dead_letter", msg.Topic)
+		key, err := msg.Key.Encode()
+		require.NoError(t, err)
+		assert.Equal(t, []byte("key"), key)
+		value, err := msg.Value.Encode()
+		require.NoError(t, err)
+		assert.Equal(t, []byte("!@#"), value)
+
+		headers := map[string]string{}
+		for _, h := range msg.Headers {
+			headers[string(h.Key)] = string(h.Value)
+		}
+		assert.Equal(t, map[string]string{
+			"tenant":                  "a",
+			deadLetterHeaderError:     "invalid message",
+			deadLetterHeaderTopic:     "spans",
+			deadLetterHeaderPartition: "2",
+			deadLetterHeaderOffset:    "42",
+		}, headers)
+		return nil
+	})
+
+	p := &deadLetterPublisher{producer: producer, topic: "spans_dead_letter"}
+	t.Cleanup(func() {
+		require.NoError(t, p.close())
+	})
+	err := p.publish(&sarama.ConsumerMessage{
+		Topic:     "spans",
+		Partition: 2,
+		Offset:    42,
+		Key:       []byte("key"),
+		Value:     []byte("!@#"),
+		Headers:   []*sarama.RecordHeader{{Key: []byte("tenant"), Value: []byte("a")}},
+	}, errors.New("invalid message"))
+	require.NoError(t, err)
+}
+
+func TestTracesConsumerGroupHandler_dead_letter(t *testing.T) {
+	tests := []struct {
+		name       string
+		publishErr error
+	}{
+		{
+			name: "published",
+		},
+		{
+			name:       "publish failed",
+			publishErr: errors.New("broker unavailable"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := sarama.NewConfig()
+			c.Producer.Return.Successes = true
+			producer := mocks.NewSyncProducer(t, c)
+			if tt.publishErr != nil {
+				producer.ExpectSendMessageAndFail(tt.publishErr)
+			} else {
+				producer.ExpectSendMessageAndSucceed()
+			}
+
+			obsrecv, err := obsreport.NewReceiver(obsreport.ReceiverSettings{ReceiverCreateSettings: receivertest.NewNopCreateSettings()})
+			require.NoError(t, err)
+			sink := &consumertest.TracesSink{}
+			handler := tracesConsumerGroupHandler{
+				unmarshaler:       newPdataTracesUnmarshaler(&ptrace.ProtoUnmarshaler{}, defaultEncoding),
+				logger:            zap.NewNop(),
+				ready:             make(chan bool),
+				nextConsumer:      sink,
+				obsrecv:           obsrecv,
+				autocommitEnabled: true,
+				deadLetter:        &deadLetterPublisher{producer: producer, topic: "spans_dead_letter"},
+			}
+			t.Cleanup(func() {
+				require.NoError(t, handler.deadLetter.close())
+			})
+
+			groupClaim := &testConsumerGroupClaim{
+				messageChan: make(chan *sarama.ConsumerMessage, 2),
+			}
+			groupClaim.messageChan <- &sarama.ConsumerMessage{Value: []byte("!@#")}
+			groupClaim.messageChan <- &sarama.ConsumerMessage{Value: []byte{}}
+			close(groupClaim.messageChan)
+
+			wg := sync.WaitGroup{}
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				err := handler.ConsumeClaim(testConsumerGroupSession{}, groupClaim)
+				if tt.publishErr != nil {
+					assert.Error(t, err)
+				} else {
+					assert.NoError(t, err)
+				}
+			}()
+			wg.Wait()
+
+			if tt.publishErr != nil {
+				assert.Empty(t, sink.AllTraces())
+			} else {
+				assert.Len(t, sink.AllTraces(), 1)
+			}
+		})
+	}
+}
@@ -26,6 +26,7 @@ import (
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/obsreport"
 	"go.opentelemetry.io/collector/receiver"
+	"go.uber.org/multierr"
 	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/kafkaexporter"
@@ -49,6 +50,7 @@ type kafkaTracesConsumer struct {
 
 	autocommitEnabled bool
 	messageMarking    MessageMarking
+	deadLetter        *deadLetterPublisher
 }
 
 // kafkaMetricsConsumer uses sarama to consume and handle messages from kafka.
@@ -63,6 +65,7 @@ type kafkaMetricsConsumer struct {
 
 	autocommitEnabled bool
 	messageMarking    MessageMarking
+	deadLetter        *deadLetterPublisher
 }
 
 // kafkaLogsConsumer uses sarama to consume and handle messages from kafka.
@@ -77,6 +80,7 @@ type kafkaLogsConsumer struct {
 
 	autocommitEnabled bool
 	messageMarking    MessageMarking
+	deadLetter        *deadLetterPublisher
 }
 
 var _ receiver.Traces = (*kafkaTracesConsumer)(nil)
@@ -110,6 +114,11 @@ func newTracesReceiver(config Config, set receiver.CreateSettings, unmarshalers
 	if err != nil {
 		return nil, err
 	}
+	deadLetter, err := newDeadLetterPublisher(config, c)
+	if err != nil {
+		_ = client.Close()
+		return nil, err
+	}
 	return &kafkaTracesConsumer{
 		consumerGroup:     client,
 		topics:            []string{config.Topic},
@@ -118,6 +127,7 @@ func newTracesReceiver(config Config, set receiver.CreateSettings, unmarshalers
 		settings:          set,
 		autocommitEnabled: config.AutoCommit.Enable,
 		messageMarking:    config.MessageMarking,
+		deadLetter:        deadLetter,
 	}, nil
 }
 
@@ -140,6 +150,7 @@ func (c *kafkaTracesConsumer) Start(_ context.Context, host component.Host) erro
 		obsrecv:           obsrecv,
 		autocommitEnabled: c.autocommitEnabled,
 		messageMarking:    c.messageMarking,
+		deadLetter:        c.deadLetter,
 	}
 	go func() {
 		if err := c.consumeLoop(ctx, consumerGroup); err != nil {
@@ -168,7 +179,7 @@ func (c *kafkaTracesConsumer) consumeLoop(ctx context.Context, handler sarama.Co
 
 func (c *kafkaTracesConsumer) Shutdown(context.Context) error {
 	c.cancelConsumeLoop()
-	return c.consumerGroup.Close()
+	return multierr.Append(c.consumerGroup.Close(), c.deadLetter.close())
 }
 
 func newMetricsReceiver(config Config, set receiver.CreateSettings, unmarshalers map[string]MetricsUnmarshaler, nextConsumer consumer.Metrics) (*kafkaMetricsConsumer, error) {
@@ -199,6 +210,11 @@ func newMetricsReceiver(config Config, set receiver.CreateSettings, unmarshalers
 	if err != nil {
 		return nil, err
 	}
+	deadLetter, err := newDeadLetterPublisher(config, c)
+	if err != nil {
+		_ = client.Close()
+		return nil, err
+	}
 	return &kafkaMetricsConsumer{
 		consumerGroup:     client,
 		topics:            []string{config.Topic},
@@ -207,6 +223,7 @@ func newMetricsReceiver(config Config, set receiver.CreateSettings, unmarshalers
 		settings:          set,
 		autocommitEnabled: config.AutoCommit.Enable,
 		messageMarking:    config.MessageMarking,
+		deadLetter:        deadLetter,
 	}, nil
 }
 
@@ -229,6 +246,7 @@ func (c *kafkaMetricsConsumer) Start(_ context.Context, host component.Host) err
 		obsrecv:           obsrecv,
 		autocommitEnabled: c.autocommitEnabled,
 		messageMarking:    c.messageMarking,
+		deadLetter:        c.deadLetter,
 	}
 	go func() {
 		if err := c.consumeLoop(ctx, metricsConsumerGroup); err != nil {
@@ -257,7 +275,7 @@ func (c *kafkaMetricsConsumer) consumeLoop(ctx context.Context, handler sarama.C
 
 func (c *kafkaMetricsConsumer) Shutdown(context.Context) error {
 	c.cancelConsumeLoop()
-	return c.consumerGroup.Close()
+	return multierr.Append(c.consumerGroup.Close(), c.deadLetter.close())
 }
 
 func newLogsReceiver(config Config, set receiver.CreateSettings, unmarshalers map[string]LogsUnmarshaler, nextConsumer consumer.Logs) (*kafkaLogsConsumer, error) {
@@ -287,6 +305,11 @@ func newLogsReceiver(config Config, set receiver.CreateSettings, unmarshalers ma
 	if err != nil {
 		return nil, err
 	}
+	deadLetter, err := newDeadLetterPublisher(config, c)
+	if err != nil {
+		_ = client.Close()
+		return nil, err
+	}
 	return &kafkaLogsConsumer{
 		consumerGroup:     client,
 		topics:            []string{config.Topic},
@@ -295,6 +318,7 @@ func newLogsReceiver(config Config, set receiver.CreateSettings, unmarshalers ma
 		settings:          set,
 		autocommitEnabled: config.AutoCommit.Enable,
 		messageMarking:    config.MessageMarking,
+		deadLetter:        deadLetter,
 	}, nil
 }
 
@@ -318,6 +342,7 @@ func (c *kafkaLogsConsumer) Start(_ context.Context, host component.Host) error
 		obsrecv:           obsrecv,
 		autocommitEnabled: c.autocommitEnabled,
 		messageMarking:    c.messageMarking,
+		deadLetter:        c.deadLetter,
 	}
 	go func() {
 		if err := c.consumeLoop(ctx, logsConsumerGroup); err != nil {
@@ -346,7 +371,7 @@ func (c *kafkaLogsConsumer) consumeLoop(ctx context.Context, handler sarama.Cons
 
 func (c *kafkaLogsConsumer) Shutdown(context.Context) error {
 	c.cancelConsumeLoop()
-	return c.consumerGroup.Close()
+	return multierr.Append(c.consumerGroup.Close(), c.deadLetter.close())
 }
 
 type tracesConsumerGroupHandler struct {
@@ -362,6 +387,7 @@ type tracesConsumerGroupHandler struct {
 
 	autocommitEnabled bool
 	messageMarking    MessageMarking
+	deadLetter        *deadLetterPublisher
 }
 
 type metricsConsumerGroupHandler struct {
@@ -377,6 +403,7 @@ type metricsConsumerGroupHandler struct {
 
 	autocommitEnabled bool
 	messageMarking    MessageMarking
+	deadLetter        *deadLetterPublisher
 }
 
 type logsConsumerGroupHandler struct {
@@ -392,6 +419,7 @@ type logsConsumerGroupHandler struct {
 
 	autocommitEnabled bool
 	messageMarking    MessageMarking
+	deadLetter        *deadLetterPublisher
 }
 
 var _ sarama.ConsumerGroupHandler = (*tracesConsumerGroupHandler)(nil)
@@ -436,7 +464,13 @@ func (c *tracesConsumerGroupHandler) ConsumeClaim(session sarama.ConsumerGroupSe
 
 		traces, err := c.unmarshaler.Unmarshal(message.Value)
 		if err != nil {
-			c.logger.Error("failed to unmarshal message", zap.Error(err))
+			c.obsrecv.EndTracesOp(ctx, c.unmarshaler.Encoding(), 0, err)
+			if err = handleUnmarshalError(ctx, c.id, c.logger, c.deadLetter, session, message, err); err == nil {
+				if !c.autocommitEnabled {
+					session.Commit()
+				}
+				continue
+			}
 			if c.messageMarking.After && c.messageMarking.OnError {
 				session.MarkMessage(message, "")
 			}
@@ -501,7 +535,13 @@ func (c *metricsConsumerGroupHandler) ConsumeClaim(session sarama.ConsumerGroupS
 
 		metrics, err := c.unmarshaler.Unmarshal(message.Value)
 		if err != nil {
-			c.logger.Error("failed to unmarshal message", zap.Error(err))
+			c.obsrecv.EndMetricsOp(ctx, c.unmarshaler.Encoding(), 0, err)
+			if err = handleUnmarshalError(ctx, c.id, c.logger, c.deadLetter, session, message, err); err == nil {
+				if !c.autocommitEnabled {
+					session.Commit()
+				}
+				continue
+			}
 			if c.messageMarking.After && c.messageMarking.OnError {
 				session.MarkMessage(message, "")
 			}
@@ -570,7 +610,13 @@ func (c *logsConsumerGroupHandler) ConsumeClaim(session sarama.ConsumerGroupSess
 
 		logs, err := c.unmarshaler.Unmarshal(message.Value)
 		if err != nil {
-			c.logger.Error("failed to unmarshal message", zap.Error(err))
+			c.obsrecv.EndLogsOp(ctx, c.unmarshaler.Encoding(), 0, err)
+			if err = handleUnmarshalError(ctx, c.id, c.logger, c.deadLetter, session, message, err); err == nil {
+				if !c.autocommitEnabled {
+					session.Commit()
+				}
+				continue
+			}
 			if c.messageMarking.After && c.messageMarking.OnError {
 				session.MarkMessage(message, "")
 			}
@@ -595,3 +641,30 @@ func (c *logsConsumerGroupHandler) ConsumeClaim(session sarama.ConsumerGroupSess
 	}
 	return nil
 }
+
+// handleUnmarshalError records the failure to unmarshal the message and publishes the message to
+// the dead letter topic, if enabled. It returns nil if the message was published, in which case the
+// message is marked and the consumption continues with the next message.
+func handleUnmarshalError(
+	ctx context.Context,
+	id component.ID,
+	logger *zap.Logger,
+	deadLetter *deadLetterPublisher,
+	session sarama.ConsumerGroupSession,
+	message *sarama.ConsumerMessage,
+	err error,
+) error {
+	logger.Error("failed to unmarshal message", zap.Error(err))
+	statsTags := []tag.Mutator{tag.Upsert(tagInstanceName, id.String())}
+	_ = stats.RecordWithTags(ctx, statsTags, statUnmarshalFailedMessages.M(1))
+	if deadLetter == nil {
+		return err
+	}
+	if dlErr := deadLetter.publish(message, err); dlErr != nil {
+		logger.Error("failed to publish message to dead letter topic", zap.Error(dlErr))
+		return err
+	}
+	_ = stats.RecordWithTags(ctx, statsTags, statDeadLetterMessages.M(1))
+	session.MarkMessage(message, "")
+	return nil
+}
@@ -27,6 +27,9 @@ var (
 	statMessageOffset    = stats.Int64("kafka_receiver_current_offset", "Current message offset", stats.UnitDimensionless)
 	statMessageOffsetLag = stats.Int64("kafka_receiver_offset_lag", "Current offset lag", stats.UnitDimensionless)
 
+	statUnmarshalFailedMessages = stats.Int64("kafka_receiver_unmarshal_failed_messages", "Number of messages failed to be unmarshaled", stats.UnitDimensionless)
+	statDeadLetterMessages      = stats.Int64("kafka_receiver_dead_letter_messages", "Number of messages published to the dead letter topic", stats.UnitDimensionless)
+
 	statPartitionStart = stats.Int64("kafka_receiver_partition_start", "Number of started partitions", stats.UnitDimensionless)
 	statPartitionClose = stats.Int64("kafka_receiver_partition_close", "Number of finished partitions", stats.UnitDimensionless)
 )
@@ -75,11 +78,29 @@ func MetricViews() []*view.View {
 		Aggregation: view.Sum(),
 	}
 
+	countUnmarshalFailedMessages := &view.View{
+		Name:        statUnmarshalFailedMessages.Name(),
+		Measure:     statUnmarshalFailedMessages,
+		Description: statUnmarshalFailedMessages.Description(),
+		TagKeys:     tagKeys,
+		Aggregation: view.Sum(),
+	}
+
+	countDeadLetterMessages := &view.View{
+		Name:        statDeadLetterMessages.Name(),
+		Measure:     statDeadLetterMessages,
+		Description: statDeadLetterMessages.Description(),
+		TagKeys:     tagKeys,
+		Aggregation: view.Sum(),
+	}
+
 	return []*view.View{
 		countMessages,
 		lastValueOffset,
 		lastValueOffsetLag,
 		countPartitionStart,
 		countPartitionClose,
+		countUnmarshalFailedMessages,
+		countDeadLetterMessages,
 	}
 }
@@ -52,7 +52,7 @@ type metricDataAccumulator struct {
 	mbs                   *metadata.MetricsBuilders
 }
 
-func (a *metricDataAccumulator) nodeStats(s stats.NodeStats) {
+func (a *metricDataAccumulator) nodeStats(s stats.NodeStats, pressure *NodePressure) {
 	if !a.metricGroupsToCollect[NodeMetricGroup] {
 		return
 	}
@@ -62,6 +62,7 @@ func (a *metricDataAccumulator) nodeStats(s stats.NodeStats) {
 	addMemoryMetrics(a.mbs.NodeMetricsBuilder, metadata.NodeMemoryMetrics, s.Memory, currentTime)
 	addFilesystemMetrics(a.mbs.NodeMetricsBuilder, metadata.NodeFilesystemMetrics, s.Fs, currentTime)
 	addNetworkMetrics(a.mbs.NodeMetricsBuilder, metadata.NodeNetworkMetrics, s.Network, currentTime)
+	addPressureMetrics(a.mbs.NodeMetricsBuilder, metadata.NodePressureMetrics, pressure, currentTime)
 	// todo s.Runtime.ImageFs
 
 	a.m = append(a.m, a.mbs.NodeMetricsBuilder.Emit(
@@ -80,6 +81,7 @@ func (a *metricDataAccumulator) podStats(s stats.PodStats) {
 	addMemoryMetrics(a.mbs.PodMetricsBuilder, metadata.PodMemoryMetrics, s.Memory, currentTime)
 	addFilesystemMetrics(a.mbs.PodMetricsBuilder, metadata.PodFilesystemMetrics, s.EphemeralStorage, currentTime)
 	addNetworkMetrics(a.mbs.PodMetricsBuilder, metadata.PodNetworkMetrics, s.Network, currentTime)
+	addPodLifecycleMetrics(a.mbs.PodMetricsBuilder, a.metadata.getPod(s.PodRef.UID), a.metadata.PodStartupDurations, currentTime)
 
 	a.m = append(a.m, a.mbs.PodMetricsBuilder.Emit(
 		metadata.WithStartTimeOverride(pcommon.NewTimestampFromTime(s.StartTime.Time)),
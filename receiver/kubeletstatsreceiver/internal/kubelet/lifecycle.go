@@ -0,0 +1,66 @@
+// Copyright 2020, OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubelet // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/kubeletstatsreceiver/internal/kubelet"
+
+import (
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/kubeletstatsreceiver/internal/metadata"
+)
+
+// PodStartupDurations holds the startup duration of the pods, computed the first time each pod
+// is seen ready. The transition time of the Ready condition moves every time the readiness of
+// the pod changes, so it is only used until the duration is known.
+type PodStartupDurations map[types.UID]float64
+
+// Prune removes the durations of the pods that are no longer listed.
+func (d PodStartupDurations) Prune(pods *v1.PodList) {
+	listed := make(map[types.UID]struct{}, len(pods.Items))
+	for i := range pods.Items {
+		listed[pods.Items[i].UID] = struct{}{}
+	}
+	for uid := range d {
+		if _, ok := listed[uid]; !ok {
+			delete(d, uid)
+		}
+	}
+}
+
+// addPodLifecycleMetrics records the time it took for the pod to be scheduled and to become
+// ready since its creation, from the transition times of the pod conditions.
+func addPodLifecycleMetrics(mb *metadata.MetricsBuilder, pod *v1.Pod, startupDurations PodStartupDurations, currentTime pcommon.Timestamp) {
+	if pod == nil || pod.CreationTimestamp.IsZero() {
+		return
+	}
+	for _, condition := range pod.Status.Conditions {
+		if condition.Status != v1.ConditionTrue || condition.LastTransitionTime.IsZero() {
+			continue
+		}
+		duration := condition.LastTransitionTime.Sub(pod.CreationTimestamp.Time).Seconds()
+		switch condition.Type {
+		case v1.PodScheduled:
+			mb.RecordK8sPodSchedulingDurationDataPoint(currentTime, duration)
+		case v1.PodReady:
+			if startup, ok := startupDurations[pod.UID]; ok {
+				duration = startup
+			} else if startupDurations != nil {
+				startupDurations[pod.UID] = duration
+			}
+			mb.RecordK8sPodStartupDurationDataPoint(currentTime, duration)
+		}
+	}
+}
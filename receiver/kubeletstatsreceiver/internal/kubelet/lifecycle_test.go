@@ -0,0 +1,61 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubelet
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/kubeletstatsreceiver/internal/metadata"
+)
+
+func TestPodStartupDurationKeptAcrossReadinessChanges(t *testing.T) {
+	settings := metadata.DefaultMetricsSettings()
+	settings.K8sPodStartupDuration.Enabled = true
+	mb := metadata.NewMetricsBuilder(settings, receivertest.NewNopCreateSettings())
+
+	created := time.Unix(1000, 0)
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{UID: "pod-uid", CreationTimestamp: metav1.NewTime(created)},
+		Status: v1.PodStatus{Conditions: []v1.PodCondition{{
+			Type:               v1.PodReady,
+			Status:             v1.ConditionTrue,
+			LastTransitionTime: metav1.NewTime(created.Add(5 * time.Second)),
+		}}},
+	}
+	startupDuration := func(durations PodStartupDurations) float64 {
+		addPodLifecycleMetrics(mb, pod, durations, pcommon.NewTimestampFromTime(time.Now()))
+		metrics := mb.Emit().ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+		require.Equal(t, 1, metrics.Len())
+		return metrics.At(0).Gauge().DataPoints().At(0).DoubleValue()
+	}
+
+	durations := PodStartupDurations{}
+	assert.Equal(t, float64(5), startupDuration(durations))
+
+	// The pod becomes ready again, the startup duration is unchanged.
+	pod.Status.Conditions[0].LastTransitionTime = metav1.NewTime(created.Add(time.Hour))
+	assert.Equal(t, float64(5), startupDuration(durations))
+
+	durations.Prune(&v1.PodList{})
+	assert.Empty(t, durations)
+}
@@ -61,6 +61,8 @@ type Metadata struct {
 	Labels                    map[MetadataLabel]bool
 	PodsMetadata              *v1.PodList
 	DetailedPVCResourceGetter func(volCacheID, volumeClaim, namespace string) ([]metadata.ResourceMetricsOption, error)
+	// PodStartupDurations keeps the startup duration of the pods across scrapes.
+	PodStartupDurations PodStartupDurations
 }
 
 func NewMetadata(
@@ -123,6 +125,21 @@ func (m *Metadata) getExtraResources(podRef stats.PodReference, extraMetadataLab
 	return nil, nil
 }
 
+// getPod returns the metadata of the pod with the given UID, or nil if the pods metadata
+// were not fetched or don't include the pod.
+func (m *Metadata) getPod(podUID string) *v1.Pod {
+	if m.PodsMetadata == nil {
+		return nil
+	}
+	uid := types.UID(podUID)
+	for i := range m.PodsMetadata.Items {
+		if m.PodsMetadata.Items[i].UID == uid {
+			return &m.PodsMetadata.Items[i]
+		}
+	}
+	return nil
+}
+
 // getContainerID retrieves container id from metadata for given pod UID and container name,
 // returns an error if no container found in the metadata that matches the requirements
 // or if the apiServer returned a newly created container with empty containerID.
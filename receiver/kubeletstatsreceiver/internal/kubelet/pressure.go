@@ -0,0 +1,104 @@
+// Copyright 2020, OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubelet // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/kubeletstatsreceiver/internal/kubelet"
+
+import (
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/kubeletstatsreceiver/internal/metadata"
+)
+
+// NodePressure holds the pressure stall information (PSI) of the node. It is reported
+// by the summary API of the kubelets running with the KubeletPSI feature gate, but is
+// not part of the stats.Summary version the receiver is built with, so it is decoded separately.
+type NodePressure struct {
+	CPU    *PSIStats
+	Memory *PSIStats
+	IO     *PSIStats
+}
+
+// PSIStats holds the pressure stall information of a resource.
+type PSIStats struct {
+	// Full is the time all the non-idle tasks were stalled on the resource.
+	Full *PSIData `json:"full,omitempty"`
+	// Some is the time at least one task was stalled on the resource.
+	Some *PSIData `json:"some,omitempty"`
+}
+
+// PSIData holds the stall time of the tasks.
+type PSIData struct {
+	// Total is the cumulative stall time, in microseconds.
+	Total uint64 `json:"total"`
+	// Avg10 is the percentage of time the tasks were stalled over the last 10 seconds.
+	Avg10 float64 `json:"avg10"`
+	// Avg60 is the percentage of time the tasks were stalled over the last 60 seconds.
+	Avg60 float64 `json:"avg60"`
+	// Avg300 is the percentage of time the tasks were stalled over the last 300 seconds.
+	Avg300 float64 `json:"avg300"`
+}
+
+type psiContainer struct {
+	PSI *PSIStats `json:"psi,omitempty"`
+}
+
+// pressureSummary is the part of the summary API response holding the node PSI.
+type pressureSummary struct {
+	Node struct {
+		CPU    *psiContainer `json:"cpu,omitempty"`
+		Memory *psiContainer `json:"memory,omitempty"`
+		IO     *psiContainer `json:"io,omitempty"`
+	} `json:"node"`
+}
+
+// nodePressure returns the node PSI of the summary, or nil if the kubelet didn't report it.
+func (s pressureSummary) nodePressure() *NodePressure {
+	out := &NodePressure{
+		CPU:    s.Node.CPU.psi(),
+		Memory: s.Node.Memory.psi(),
+		IO:     s.Node.IO.psi(),
+	}
+	if out.CPU == nil && out.Memory == nil && out.IO == nil {
+		return nil
+	}
+	return out
+}
+
+func (c *psiContainer) psi() *PSIStats {
+	if c == nil {
+		return nil
+	}
+	return c.PSI
+}
+
+func addPressureMetrics(mb *metadata.MetricsBuilder, pressureMetrics metadata.PressureMetrics, p *NodePressure, currentTime pcommon.Timestamp) {
+	if p == nil {
+		return
+	}
+	addPSIStalledTimeMetric(mb, pressureMetrics.CPU, p.CPU, currentTime)
+	addPSIStalledTimeMetric(mb, pressureMetrics.Memory, p.Memory, currentTime)
+	addPSIStalledTimeMetric(mb, pressureMetrics.IO, p.IO, currentTime)
+}
+
+func addPSIStalledTimeMetric(mb *metadata.MetricsBuilder, recordDataPoint metadata.RecordDoubleDataPointWithStallFunc, s *PSIStats, currentTime pcommon.Timestamp) {
+	if s == nil {
+		return
+	}
+	if s.Some != nil {
+		recordDataPoint(mb, currentTime, float64(s.Some.Total)/1_000_000, metadata.AttributeStallSome)
+	}
+	if s.Full != nil {
+		recordDataPoint(mb, currentTime, float64(s.Full.Total)/1_000_000, metadata.AttributeStallFull)
+	}
+}
@@ -0,0 +1,52 @@
+// Copyright 2020, OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubelet
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type summaryRestClient struct {
+	fakeRestClient
+	summary string
+}
+
+func (c summaryRestClient) StatsSummary() ([]byte, error) {
+	return []byte(c.summary), nil
+}
+
+func TestStatsSummaryNodePressure(t *testing.T) {
+	summary, err := NewStatsProvider(&fakeRestClient{}).StatsSummary()
+	require.NoError(t, err)
+	require.NotNil(t, summary.NodePressure)
+	assert.Equal(t, &PSIStats{
+		Full: &PSIData{Total: 0, Avg10: 0, Avg60: 0, Avg300: 0},
+		Some: &PSIData{Total: 12500000, Avg10: 1.5, Avg60: 1.2, Avg300: 0.8},
+	}, summary.NodePressure.CPU)
+	assert.Equal(t, uint64(1500000), summary.NodePressure.Memory.Full.Total)
+	assert.Equal(t, uint64(5000000), summary.NodePressure.IO.Some.Total)
+	assert.Equal(t, "minikube", summary.Node.NodeName)
+}
+
+func TestStatsSummaryWithoutNodePressure(t *testing.T) {
+	rc := summaryRestClient{summary: `{"node": {"nodeName": "node", "cpu": {"usageNanoCores": 1}, "memory": {"usageBytes": 1}}}`}
+	summary, err := NewStatsProvider(rc).StatsSummary()
+	require.NoError(t, err)
+	assert.Nil(t, summary.NodePressure)
+	assert.Equal(t, "node", summary.Node.NodeName)
+}
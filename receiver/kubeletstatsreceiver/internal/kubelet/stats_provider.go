@@ -30,17 +30,30 @@ func NewStatsProvider(rc RestClient) *StatsProvider {
 	return &StatsProvider{rc: rc}
 }
 
+// Summary is the response of the /stats/summary kubelet endpoint.
+type Summary struct {
+	stats.Summary
+	// NodePressure is the pressure stall information of the node, nil if the kubelet doesn't report it.
+	NodePressure *NodePressure
+}
+
 // StatsSummary calls the /stats/summary kubelet endpoint and unmarshals the
-// results into a stats.Summary struct.
-func (p *StatsProvider) StatsSummary() (*stats.Summary, error) {
+// results into a Summary struct.
+func (p *StatsProvider) StatsSummary() (*Summary, error) {
 	summary, err := p.rc.StatsSummary()
 	if err != nil {
 		return nil, err
 	}
-	var out stats.Summary
-	err = json.Unmarshal(summary, &out)
+	var out Summary
+	err = json.Unmarshal(summary, &out.Summary)
+	if err != nil {
+		return nil, err
+	}
+	var pressure pressureSummary
+	err = json.Unmarshal(summary, &pressure)
 	if err != nil {
 		return nil, err
 	}
+	out.NodePressure = pressure.nodePressure()
 	return &out, nil
 }
@@ -33,48 +33,53 @@ func (ms *MetricSettings) Unmarshal(parser *confmap.Conf) error {
 
 // MetricsSettings provides settings for kubeletstatsreceiver metrics.
 type MetricsSettings struct {
-	ContainerCPUTime               MetricSettings `mapstructure:"container.cpu.time"`
-	ContainerCPUUtilization        MetricSettings `mapstructure:"container.cpu.utilization"`
-	ContainerFilesystemAvailable   MetricSettings `mapstructure:"container.filesystem.available"`
-	ContainerFilesystemCapacity    MetricSettings `mapstructure:"container.filesystem.capacity"`
-	ContainerFilesystemUsage       MetricSettings `mapstructure:"container.filesystem.usage"`
-	ContainerMemoryAvailable       MetricSettings `mapstructure:"container.memory.available"`
-	ContainerMemoryMajorPageFaults MetricSettings `mapstructure:"container.memory.major_page_faults"`
-	ContainerMemoryPageFaults      MetricSettings `mapstructure:"container.memory.page_faults"`
-	ContainerMemoryRss             MetricSettings `mapstructure:"container.memory.rss"`
-	ContainerMemoryUsage           MetricSettings `mapstructure:"container.memory.usage"`
-	ContainerMemoryWorkingSet      MetricSettings `mapstructure:"container.memory.working_set"`
-	K8sNodeCPUTime                 MetricSettings `mapstructure:"k8s.node.cpu.time"`
-	K8sNodeCPUUtilization          MetricSettings `mapstructure:"k8s.node.cpu.utilization"`
-	K8sNodeFilesystemAvailable     MetricSettings `mapstructure:"k8s.node.filesystem.available"`
-	K8sNodeFilesystemCapacity      MetricSettings `mapstructure:"k8s.node.filesystem.capacity"`
-	K8sNodeFilesystemUsage         MetricSettings `mapstructure:"k8s.node.filesystem.usage"`
-	K8sNodeMemoryAvailable         MetricSettings `mapstructure:"k8s.node.memory.available"`
-	K8sNodeMemoryMajorPageFaults   MetricSettings `mapstructure:"k8s.node.memory.major_page_faults"`
-	K8sNodeMemoryPageFaults        MetricSettings `mapstructure:"k8s.node.memory.page_faults"`
-	K8sNodeMemoryRss               MetricSettings `mapstructure:"k8s.node.memory.rss"`
-	K8sNodeMemoryUsage             MetricSettings `mapstructure:"k8s.node.memory.usage"`
-	K8sNodeMemoryWorkingSet        MetricSettings `mapstructure:"k8s.node.memory.working_set"`
-	K8sNodeNetworkErrors           MetricSettings `mapstructure:"k8s.node.network.errors"`
-	K8sNodeNetworkIo               MetricSettings `mapstructure:"k8s.node.network.io"`
-	K8sPodCPUTime                  MetricSettings `mapstructure:"k8s.pod.cpu.time"`
-	K8sPodCPUUtilization           MetricSettings `mapstructure:"k8s.pod.cpu.utilization"`
-	K8sPodFilesystemAvailable      MetricSettings `mapstructure:"k8s.pod.filesystem.available"`
-	K8sPodFilesystemCapacity       MetricSettings `mapstructure:"k8s.pod.filesystem.capacity"`
-	K8sPodFilesystemUsage          MetricSettings `mapstructure:"k8s.pod.filesystem.usage"`
-	K8sPodMemoryAvailable          MetricSettings `mapstructure:"k8s.pod.memory.available"`
-	K8sPodMemoryMajorPageFaults    MetricSettings `mapstructure:"k8s.pod.memory.major_page_faults"`
-	K8sPodMemoryPageFaults         MetricSettings `mapstructure:"k8s.pod.memory.page_faults"`
-	K8sPodMemoryRss                MetricSettings `mapstructure:"k8s.pod.memory.rss"`
-	K8sPodMemoryUsage              MetricSettings `mapstructure:"k8s.pod.memory.usage"`
-	K8sPodMemoryWorkingSet         MetricSettings `mapstructure:"k8s.pod.memory.working_set"`
-	K8sPodNetworkErrors            MetricSettings `mapstructure:"k8s.pod.network.errors"`
-	K8sPodNetworkIo                MetricSettings `mapstructure:"k8s.pod.network.io"`
-	K8sVolumeAvailable             MetricSettings `mapstructure:"k8s.volume.available"`
-	K8sVolumeCapacity              MetricSettings `mapstructure:"k8s.volume.capacity"`
-	K8sVolumeInodes                MetricSettings `mapstructure:"k8s.volume.inodes"`
-	K8sVolumeInodesFree            MetricSettings `mapstructure:"k8s.volume.inodes.free"`
-	K8sVolumeInodesUsed            MetricSettings `mapstructure:"k8s.volume.inodes.used"`
+	ContainerCPUTime                 MetricSettings `mapstructure:"container.cpu.time"`
+	ContainerCPUUtilization          MetricSettings `mapstructure:"container.cpu.utilization"`
+	ContainerFilesystemAvailable     MetricSettings `mapstructure:"container.filesystem.available"`
+	ContainerFilesystemCapacity      MetricSettings `mapstructure:"container.filesystem.capacity"`
+	ContainerFilesystemUsage         MetricSettings `mapstructure:"container.filesystem.usage"`
+	ContainerMemoryAvailable         MetricSettings `mapstructure:"container.memory.available"`
+	ContainerMemoryMajorPageFaults   MetricSettings `mapstructure:"container.memory.major_page_faults"`
+	ContainerMemoryPageFaults        MetricSettings `mapstructure:"container.memory.page_faults"`
+	ContainerMemoryRss               MetricSettings `mapstructure:"container.memory.rss"`
+	ContainerMemoryUsage             MetricSettings `mapstructure:"container.memory.usage"`
+	ContainerMemoryWorkingSet        MetricSettings `mapstructure:"container.memory.working_set"`
+	K8sNodeCPUPressureStalledTime    MetricSettings `mapstructure:"k8s.node.cpu.pressure.stalled_time"`
+	K8sNodeCPUTime                   MetricSettings `mapstructure:"k8s.node.cpu.time"`
+	K8sNodeCPUUtilization            MetricSettings `mapstructure:"k8s.node.cpu.utilization"`
+	K8sNodeFilesystemAvailable       MetricSettings `mapstructure:"k8s.node.filesystem.available"`
+	K8sNodeFilesystemCapacity        MetricSettings `mapstructure:"k8s.node.filesystem.capacity"`
+	K8sNodeFilesystemUsage           MetricSettings `mapstructure:"k8s.node.filesystem.usage"`
+	K8sNodeIoPressureStalledTime     MetricSettings `mapstructure:"k8s.node.io.pressure.stalled_time"`
+	K8sNodeMemoryAvailable           MetricSettings `mapstructure:"k8s.node.memory.available"`
+	K8sNodeMemoryMajorPageFaults     MetricSettings `mapstructure:"k8s.node.memory.major_page_faults"`
+	K8sNodeMemoryPageFaults          MetricSettings `mapstructure:"k8s.node.memory.page_faults"`
+	K8sNodeMemoryPressureStalledTime MetricSettings `mapstructure:"k8s.node.memory.pressure.stalled_time"`
+	K8sNodeMemoryRss                 MetricSettings `mapstructure:"k8s.node.memory.rss"`
+	K8sNodeMemoryUsage               MetricSettings `mapstructure:"k8s.node.memory.usage"`
+	K8sNodeMemoryWorkingSet          MetricSettings `mapstructure:"k8s.node.memory.working_set"`
+	K8sNodeNetworkErrors             MetricSettings `mapstructure:"k8s.node.network.errors"`
+	K8sNodeNetworkIo                 MetricSettings `mapstructure:"k8s.node.network.io"`
+	K8sPodCPUTime                    MetricSettings `mapstructure:"k8s.pod.cpu.time"`
+	K8sPodCPUUtilization             MetricSettings `mapstructure:"k8s.pod.cpu.utilization"`
+	K8sPodFilesystemAvailable        MetricSettings `mapstructure:"k8s.pod.filesystem.available"`
+	K8sPodFilesystemCapacity         MetricSettings `mapstructure:"k8s.pod.filesystem.capacity"`
+	K8sPodFilesystemUsage            MetricSettings `mapstructure:"k8s.pod.filesystem.usage"`
+	K8sPodMemoryAvailable            MetricSettings `mapstructure:"k8s.pod.memory.available"`
+	K8sPodMemoryMajorPageFaults      MetricSettings `mapstructure:"k8s.pod.memory.major_page_faults"`
+	K8sPodMemoryPageFaults           MetricSettings `mapstructure:"k8s.pod.memory.page_faults"`
+	K8sPodMemoryRss                  MetricSettings `mapstructure:"k8s.pod.memory.rss"`
+	K8sPodMemoryUsage                MetricSettings `mapstructure:"k8s.pod.memory.usage"`
+	K8sPodMemoryWorkingSet           MetricSettings `mapstructure:"k8s.pod.memory.working_set"`
+	K8sPodNetworkErrors              MetricSettings `mapstructure:"k8s.pod.network.errors"`
+	K8sPodNetworkIo                  MetricSettings `mapstructure:"k8s.pod.network.io"`
+	K8sPodSchedulingDuration         MetricSettings `mapstructure:"k8s.pod.scheduling.duration"`
+	K8sPodStartupDuration            MetricSettings `mapstructure:"k8s.pod.startup.duration"`
+	K8sVolumeAvailable               MetricSettings `mapstructure:"k8s.volume.available"`
+	K8sVolumeCapacity                MetricSettings `mapstructure:"k8s.volume.capacity"`
+	K8sVolumeInodes                  MetricSettings `mapstructure:"k8s.volume.inodes"`
+	K8sVolumeInodesFree              MetricSettings `mapstructure:"k8s.volume.inodes.free"`
+	K8sVolumeInodesUsed              MetricSettings `mapstructure:"k8s.volume.inodes.used"`
 }
 
 func DefaultMetricsSettings() MetricsSettings {
@@ -112,6 +117,9 @@ func DefaultMetricsSettings() MetricsSettings {
 		ContainerMemoryWorkingSet: MetricSettings{
 			Enabled: true,
 		},
+		K8sNodeCPUPressureStalledTime: MetricSettings{
+			Enabled: false,
+		},
 		K8sNodeCPUTime: MetricSettings{
 			Enabled: true,
 		},
@@ -127,6 +135,9 @@ func DefaultMetricsSettings() MetricsSettings {
 		K8sNodeFilesystemUsage: MetricSettings{
 			Enabled: true,
 		},
+		K8sNodeIoPressureStalledTime: MetricSettings{
+			Enabled: false,
+		},
 		K8sNodeMemoryAvailable: MetricSettings{
 			Enabled: true,
 		},
@@ -136,6 +147,9 @@ func DefaultMetricsSettings() MetricsSettings {
 		K8sNodeMemoryPageFaults: MetricSettings{
 			Enabled: true,
 		},
+		K8sNodeMemoryPressureStalledTime: MetricSettings{
+			Enabled: false,
+		},
 		K8sNodeMemoryRss: MetricSettings{
 			Enabled: true,
 		},
@@ -190,6 +204,12 @@ func DefaultMetricsSettings() MetricsSettings {
 		K8sPodNetworkIo: MetricSettings{
 			Enabled: true,
 		},
+		K8sPodSchedulingDuration: MetricSettings{
+			Enabled: false,
+		},
+		K8sPodStartupDuration: MetricSettings{
+			Enabled: false,
+		},
 		K8sVolumeAvailable: MetricSettings{
 			Enabled: true,
 		},
@@ -322,6 +342,32 @@ var MapAttributeDirection = map[string]AttributeDirection{
 	"transmit": AttributeDirectionTransmit,
 }
 
+// AttributeStall specifies the a value stall attribute.
+type AttributeStall int
+
+const (
+	_ AttributeStall = iota
+	AttributeStallSome
+	AttributeStallFull
+)
+
+// String returns the string representation of the AttributeStall.
+func (av AttributeStall) String() string {
+	switch av {
+	case AttributeStallSome:
+		return "some"
+	case AttributeStallFull:
+		return "full"
+	}
+	return ""
+}
+
+// MapAttributeStall is a helper map of string to AttributeStall attribute value.
+var MapAttributeStall = map[string]AttributeStall{
+	"some": AttributeStallSome,
+	"full": AttributeStallFull,
+}
+
 type metricContainerCPUTime struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
@@ -863,6 +909,59 @@ func newMetricContainerMemoryWorkingSet(settings MetricSettings) metricContainer
 	return m
 }
 
+type metricK8sNodeCPUPressureStalledTime struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.node.cpu.pressure.stalled_time metric with initial data.
+func (m *metricK8sNodeCPUPressureStalledTime) init() {
+	m.data.SetName("k8s.node.cpu.pressure.stalled_time")
+	m.data.SetDescription("Total time tasks were stalled waiting for CPU on the node, from the pressure stall information (PSI)")
+	m.data.SetUnit("s")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricK8sNodeCPUPressureStalledTime) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, stallAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("stall", stallAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sNodeCPUPressureStalledTime) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sNodeCPUPressureStalledTime) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sNodeCPUPressureStalledTime(settings MetricSettings) metricK8sNodeCPUPressureStalledTime {
+	m := metricK8sNodeCPUPressureStalledTime{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricK8sNodeCPUTime struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
@@ -1110,6 +1209,59 @@ func newMetricK8sNodeFilesystemUsage(settings MetricSettings) metricK8sNodeFiles
 	return m
 }
 
+type metricK8sNodeIoPressureStalledTime struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.node.io.pressure.stalled_time metric with initial data.
+func (m *metricK8sNodeIoPressureStalledTime) init() {
+	m.data.SetName("k8s.node.io.pressure.stalled_time")
+	m.data.SetDescription("Total time tasks were stalled waiting for IO on the node, from the pressure stall information (PSI)")
+	m.data.SetUnit("s")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricK8sNodeIoPressureStalledTime) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, stallAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("stall", stallAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sNodeIoPressureStalledTime) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sNodeIoPressureStalledTime) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sNodeIoPressureStalledTime(settings MetricSettings) metricK8sNodeIoPressureStalledTime {
+	m := metricK8sNodeIoPressureStalledTime{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricK8sNodeMemoryAvailable struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
@@ -1257,6 +1409,59 @@ func newMetricK8sNodeMemoryPageFaults(settings MetricSettings) metricK8sNodeMemo
 	return m
 }
 
+type metricK8sNodeMemoryPressureStalledTime struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.node.memory.pressure.stalled_time metric with initial data.
+func (m *metricK8sNodeMemoryPressureStalledTime) init() {
+	m.data.SetName("k8s.node.memory.pressure.stalled_time")
+	m.data.SetDescription("Total time tasks were stalled waiting for memory on the node, from the pressure stall information (PSI)")
+	m.data.SetUnit("s")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricK8sNodeMemoryPressureStalledTime) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, stallAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("stall", stallAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sNodeMemoryPressureStalledTime) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sNodeMemoryPressureStalledTime) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sNodeMemoryPressureStalledTime(settings MetricSettings) metricK8sNodeMemoryPressureStalledTime {
+	m := metricK8sNodeMemoryPressureStalledTime{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricK8sNodeMemoryRss struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
@@ -2161,6 +2366,104 @@ func newMetricK8sPodNetworkIo(settings MetricSettings) metricK8sPodNetworkIo {
 	return m
 }
 
+type metricK8sPodSchedulingDuration struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.pod.scheduling.duration metric with initial data.
+func (m *metricK8sPodSchedulingDuration) init() {
+	m.data.SetName("k8s.pod.scheduling.duration")
+	m.data.SetDescription("Time between the creation of the pod and its scheduling on the node")
+	m.data.SetUnit("s")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricK8sPodSchedulingDuration) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sPodSchedulingDuration) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sPodSchedulingDuration) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sPodSchedulingDuration(settings MetricSettings) metricK8sPodSchedulingDuration {
+	m := metricK8sPodSchedulingDuration{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricK8sPodStartupDuration struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.pod.startup.duration metric with initial data.
+func (m *metricK8sPodStartupDuration) init() {
+	m.data.SetName("k8s.pod.startup.duration")
+	m.data.SetDescription("Time between the creation of the pod and the pod first becoming ready")
+	m.data.SetUnit("s")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricK8sPodStartupDuration) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sPodStartupDuration) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sPodStartupDuration) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sPodStartupDuration(settings MetricSettings) metricK8sPodStartupDuration {
+	m := metricK8sPodStartupDuration{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricK8sVolumeAvailable struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
@@ -2409,54 +2712,59 @@ func newMetricK8sVolumeInodesUsed(settings MetricSettings) metricK8sVolumeInodes
 // MetricsBuilder provides an interface for scrapers to report metrics while taking care of all the transformations
 // required to produce metric representation defined in metadata and user settings.
 type MetricsBuilder struct {
-	startTime                            pcommon.Timestamp   // start time that will be applied to all recorded data points.
-	metricsCapacity                      int                 // maximum observed number of metrics per resource.
-	resourceCapacity                     int                 // maximum observed number of resource attributes.
-	metricsBuffer                        pmetric.Metrics     // accumulates metrics data before emitting.
-	buildInfo                            component.BuildInfo // contains version information
-	resourceAttributesSettings           ResourceAttributesSettings
-	metricContainerCPUTime               metricContainerCPUTime
-	metricContainerCPUUtilization        metricContainerCPUUtilization
-	metricContainerFilesystemAvailable   metricContainerFilesystemAvailable
-	metricContainerFilesystemCapacity    metricContainerFilesystemCapacity
-	metricContainerFilesystemUsage       metricContainerFilesystemUsage
-	metricContainerMemoryAvailable       metricContainerMemoryAvailable
-	metricContainerMemoryMajorPageFaults metricContainerMemoryMajorPageFaults
-	metricContainerMemoryPageFaults      metricContainerMemoryPageFaults
-	metricContainerMemoryRss             metricContainerMemoryRss
-	metricContainerMemoryUsage           metricContainerMemoryUsage
-	metricContainerMemoryWorkingSet      metricContainerMemoryWorkingSet
-	metricK8sNodeCPUTime                 metricK8sNodeCPUTime
-	metricK8sNodeCPUUtilization          metricK8sNodeCPUUtilization
-	metricK8sNodeFilesystemAvailable     metricK8sNodeFilesystemAvailable
-	metricK8sNodeFilesystemCapacity      metricK8sNodeFilesystemCapacity
-	metricK8sNodeFilesystemUsage         metricK8sNodeFilesystemUsage
-	metricK8sNodeMemoryAvailable         metricK8sNodeMemoryAvailable
-	metricK8sNodeMemoryMajorPageFaults   metricK8sNodeMemoryMajorPageFaults
-	metricK8sNodeMemoryPageFaults        metricK8sNodeMemoryPageFaults
-	metricK8sNodeMemoryRss               metricK8sNodeMemoryRss
-	metricK8sNodeMemoryUsage             metricK8sNodeMemoryUsage
-	metricK8sNodeMemoryWorkingSet        metricK8sNodeMemoryWorkingSet
-	metricK8sNodeNetworkErrors           metricK8sNodeNetworkErrors
-	metricK8sNodeNetworkIo               metricK8sNodeNetworkIo
-	metricK8sPodCPUTime                  metricK8sPodCPUTime
-	metricK8sPodCPUUtilization           metricK8sPodCPUUtilization
-	metricK8sPodFilesystemAvailable      metricK8sPodFilesystemAvailable
-	metricK8sPodFilesystemCapacity       metricK8sPodFilesystemCapacity
-	metricK8sPodFilesystemUsage          metricK8sPodFilesystemUsage
-	metricK8sPodMemoryAvailable          metricK8sPodMemoryAvailable
-	metricK8sPodMemoryMajorPageFaults    metricK8sPodMemoryMajorPageFaults
-	metricK8sPodMemoryPageFaults         metricK8sPodMemoryPageFaults
-	metricK8sPodMemoryRss                metricK8sPodMemoryRss
-	metricK8sPodMemoryUsage              metricK8sPodMemoryUsage
-	metricK8sPodMemoryWorkingSet         metricK8sPodMemoryWorkingSet
-	metricK8sPodNetworkErrors            metricK8sPodNetworkErrors
-	metricK8sPodNetworkIo                metricK8sPodNetworkIo
-	metricK8sVolumeAvailable             metricK8sVolumeAvailable
-	metricK8sVolumeCapacity              metricK8sVolumeCapacity
-	metricK8sVolumeInodes                metricK8sVolumeInodes
-	metricK8sVolumeInodesFree            metricK8sVolumeInodesFree
-	metricK8sVolumeInodesUsed            metricK8sVolumeInodesUsed
+	startTime                              pcommon.Timestamp   // start time that will be applied to all recorded data points.
+	metricsCapacity                        int                 // maximum observed number of metrics per resource.
+	resourceCapacity                       int                 // maximum observed number of resource attributes.
+	metricsBuffer                          pmetric.Metrics     // accumulates metrics data before emitting.
+	buildInfo                              component.BuildInfo // contains version information
+	resourceAttributesSettings             ResourceAttributesSettings
+	metricContainerCPUTime                 metricContainerCPUTime
+	metricContainerCPUUtilization          metricContainerCPUUtilization
+	metricContainerFilesystemAvailable     metricContainerFilesystemAvailable
+	metricContainerFilesystemCapacity      metricContainerFilesystemCapacity
+	metricContainerFilesystemUsage         metricContainerFilesystemUsage
+	metricContainerMemoryAvailable         metricContainerMemoryAvailable
+	metricContainerMemoryMajorPageFaults   metricContainerMemoryMajorPageFaults
+	metricContainerMemoryPageFaults        metricContainerMemoryPageFaults
+	metricContainerMemoryRss               metricContainerMemoryRss
+	metricContainerMemoryUsage             metricContainerMemoryUsage
+	metricContainerMemoryWorkingSet        metricContainerMemoryWorkingSet
+	metricK8sNodeCPUPressureStalledTime    metricK8sNodeCPUPressureStalledTime
+	metricK8sNodeCPUTime                   metricK8sNodeCPUTime
+	metricK8sNodeCPUUtilization            metricK8sNodeCPUUtilization
+	metricK8sNodeFilesystemAvailable       metricK8sNodeFilesystemAvailable
+	metricK8sNodeFilesystemCapacity        metricK8sNodeFilesystemCapacity
+	metricK8sNodeFilesystemUsage           metricK8sNodeFilesystemUsage
+	metricK8sNodeIoPressureStalledTime     metricK8sNodeIoPressureStalledTime
+	metricK8sNodeMemoryAvailable           metricK8sNodeMemoryAvailable
+	metricK8sNodeMemoryMajorPageFaults     metricK8sNodeMemoryMajorPageFaults
+	metricK8sNodeMemoryPageFaults          metricK8sNodeMemoryPageFaults
+	metricK8sNodeMemoryPressureStalledTime metricK8sNodeMemoryPressureStalledTime
+	metricK8sNodeMemoryRss                 metricK8sNodeMemoryRss
+	metricK8sNodeMemoryUsage               metricK8sNodeMemoryUsage
+	metricK8sNodeMemoryWorkingSet          metricK8sNodeMemoryWorkingSet
+	metricK8sNodeNetworkErrors             metricK8sNodeNetworkErrors
+	metricK8sNodeNetworkIo                 metricK8sNodeNetworkIo
+	metricK8sPodCPUTime                    metricK8sPodCPUTime
+	metricK8sPodCPUUtilization             metricK8sPodCPUUtilization
+	metricK8sPodFilesystemAvailable        metricK8sPodFilesystemAvailable
+	metricK8sPodFilesystemCapacity         metricK8sPodFilesystemCapacity
+	metricK8sPodFilesystemUsage            metricK8sPodFilesystemUsage
+	metricK8sPodMemoryAvailable            metricK8sPodMemoryAvailable
+	metricK8sPodMemoryMajorPageFaults      metricK8sPodMemoryMajorPageFaults
+	metricK8sPodMemoryPageFaults           metricK8sPodMemoryPageFaults
+	metricK8sPodMemoryRss                  metricK8sPodMemoryRss
+	metricK8sPodMemoryUsage                metricK8sPodMemoryUsage
+	metricK8sPodMemoryWorkingSet           metricK8sPodMemoryWorkingSet
+	metricK8sPodNetworkErrors              metricK8sPodNetworkErrors
+	metricK8sPodNetworkIo                  metricK8sPodNetworkIo
+	metricK8sPodSchedulingDuration         metricK8sPodSchedulingDuration
+	metricK8sPodStartupDuration            metricK8sPodStartupDuration
+	metricK8sVolumeAvailable               metricK8sVolumeAvailable
+	metricK8sVolumeCapacity                metricK8sVolumeCapacity
+	metricK8sVolumeInodes                  metricK8sVolumeInodes
+	metricK8sVolumeInodesFree              metricK8sVolumeInodesFree
+	metricK8sVolumeInodesUsed              metricK8sVolumeInodesUsed
 }
 
 // metricBuilderOption applies changes to default metrics builder.
@@ -2478,52 +2786,57 @@ func WithResourceAttributesSettings(ras ResourceAttributesSettings) metricBuilde
 
 func NewMetricsBuilder(ms MetricsSettings, settings receiver.CreateSettings, options ...metricBuilderOption) *MetricsBuilder {
 	mb := &MetricsBuilder{
-		startTime:                            pcommon.NewTimestampFromTime(time.Now()),
-		metricsBuffer:                        pmetric.NewMetrics(),
-		buildInfo:                            settings.BuildInfo,
-		resourceAttributesSettings:           DefaultResourceAttributesSettings(),
-		metricContainerCPUTime:               newMetricContainerCPUTime(ms.ContainerCPUTime),
-		metricContainerCPUUtilization:        newMetricContainerCPUUtilization(ms.ContainerCPUUtilization),
-		metricContainerFilesystemAvailable:   newMetricContainerFilesystemAvailable(ms.ContainerFilesystemAvailable),
-		metricContainerFilesystemCapacity:    newMetricContainerFilesystemCapacity(ms.ContainerFilesystemCapacity),
-		metricContainerFilesystemUsage:       newMetricContainerFilesystemUsage(ms.ContainerFilesystemUsage),
-		metricContainerMemoryAvailable:       newMetricContainerMemoryAvailable(ms.ContainerMemoryAvailable),
-		metricContainerMemoryMajorPageFaults: newMetricContainerMemoryMajorPageFaults(ms.ContainerMemoryMajorPageFaults),
-		metricContainerMemoryPageFaults:      newMetricContainerMemoryPageFaults(ms.ContainerMemoryPageFaults),
-		metricContainerMemoryRss:             newMetricContainerMemoryRss(ms.ContainerMemoryRss),
-		metricContainerMemoryUsage:           newMetricContainerMemoryUsage(ms.ContainerMemoryUsage),
-		metricContainerMemoryWorkingSet:      newMetricContainerMemoryWorkingSet(ms.ContainerMemoryWorkingSet),
-		metricK8sNodeCPUTime:                 newMetricK8sNodeCPUTime(ms.K8sNodeCPUTime),
-		metricK8sNodeCPUUtilization:          newMetricK8sNodeCPUUtilization(ms.K8sNodeCPUUtilization),
-		metricK8sNodeFilesystemAvailable:     newMetricK8sNodeFilesystemAvailable(ms.K8sNodeFilesystemAvailable),
-		metricK8sNodeFilesystemCapacity:      newMetricK8sNodeFilesystemCapacity(ms.K8sNodeFilesystemCapacity),
-		metricK8sNodeFilesystemUsage:         newMetricK8sNodeFilesystemUsage(ms.K8sNodeFilesystemUsage),
-		metricK8sNodeMemoryAvailable:         newMetricK8sNodeMemoryAvailable(ms.K8sNodeMemoryAvailable),
-		metricK8sNodeMemoryMajorPageFaults:   newMetricK8sNodeMemoryMajorPageFaults(ms.K8sNodeMemoryMajorPageFaults),
-		metricK8sNodeMemoryPageFaults:        newMetricK8sNodeMemoryPageFaults(ms.K8sNodeMemoryPageFaults),
-		metricK8sNodeMemoryRss:               newMetricK8sNodeMemoryRss(ms.K8sNodeMemoryRss),
-		metricK8sNodeMemoryUsage:             newMetricK8sNodeMemoryUsage(ms.K8sNodeMemoryUsage),
-		metricK8sNodeMemoryWorkingSet:        newMetricK8sNodeMemoryWorkingSet(ms.K8sNodeMemoryWorkingSet),
-		metricK8sNodeNetworkErrors:           newMetricK8sNodeNetworkErrors(ms.K8sNodeNetworkErrors),
-		metricK8sNodeNetworkIo:               newMetricK8sNodeNetworkIo(ms.K8sNodeNetworkIo),
-		metricK8sPodCPUTime:                  newMetricK8sPodCPUTime(ms.K8sPodCPUTime),
-		metricK8sPodCPUUtilization:           newMetricK8sPodCPUUtilization(ms.K8sPodCPUUtilization),
-		metricK8sPodFilesystemAvailable:      newMetricK8sPodFilesystemAvailable(ms.K8sPodFilesystemAvailable),
-		metricK8sPodFilesystemCapacity:       newMetricK8sPodFilesystemCapacity(ms.K8sPodFilesystemCapacity),
-		metricK8sPodFilesystemUsage:          newMetricK8sPodFilesystemUsage(ms.K8sPodFilesystemUsage),
-		metricK8sPodMemoryAvailable:          newMetricK8sPodMemoryAvailable(ms.K8sPodMemoryAvailable),
-		metricK8sPodMemoryMajorPageFaults:    newMetricK8sPodMemoryMajorPageFaults(ms.K8sPodMemoryMajorPageFaults),
-		metricK8sPodMemoryPageFaults:         newMetricK8sPodMemoryPageFaults(ms.K8sPodMemoryPageFaults),
-		metricK8sPodMemoryRss:                newMetricK8sPodMemoryRss(ms.K8sPodMemoryRss),
-		metricK8sPodMemoryUsage:              newMetricK8sPodMemoryUsage(ms.K8sPodMemoryUsage),
-		metricK8sPodMemoryWorkingSet:         newMetricK8sPodMemoryWorkingSet(ms.K8sPodMemoryWorkingSet),
-		metricK8sPodNetworkErrors:            newMetricK8sPodNetworkErrors(ms.K8sPodNetworkErrors),
-		metricK8sPodNetworkIo:                newMetricK8sPodNetworkIo(ms.K8sPodNetworkIo),
-		metricK8sVolumeAvailable:             newMetricK8sVolumeAvailable(ms.K8sVolumeAvailable),
-		metricK8sVolumeCapacity:              newMetricK8sVolumeCapacity(ms.K8sVolumeCapacity),
-		metricK8sVolumeInodes:                newMetricK8sVolumeInodes(ms.K8sVolumeInodes),
-		metricK8sVolumeInodesFree:            newMetricK8sVolumeInodesFree(ms.K8sVolumeInodesFree),
-		metricK8sVolumeInodesUsed:            newMetricK8sVolumeInodesUsed(ms.K8sVolumeInodesUsed),
+		startTime:                              pcommon.NewTimestampFromTime(time.Now()),
+		metricsBuffer:                          pmetric.NewMetrics(),
+		buildInfo:                              settings.BuildInfo,
+		resourceAttributesSettings:             DefaultResourceAttributesSettings(),
+		metricContainerCPUTime:                 newMetricContainerCPUTime(ms.ContainerCPUTime),
+		metricContainerCPUUtilization:          newMetricContainerCPUUtilization(ms.ContainerCPUUtilization),
+		metricContainerFilesystemAvailable:     newMetricContainerFilesystemAvailable(ms.ContainerFilesystemAvailable),
+		metricContainerFilesystemCapacity:      newMetricContainerFilesystemCapacity(ms.ContainerFilesystemCapacity),
+		metricContainerFilesystemUsage:         newMetricContainerFilesystemUsage(ms.ContainerFilesystemUsage),
+		metricContainerMemoryAvailable:         newMetricContainerMemoryAvailable(ms.ContainerMemoryAvailable),
+		metricContainerMemoryMajorPageFaults:   newMetricContainerMemoryMajorPageFaults(ms.ContainerMemoryMajorPageFaults),
+		metricContainerMemoryPageFaults:        newMetricContainerMemoryPageFaults(ms.ContainerMemoryPageFaults),
+		metricContainerMemoryRss:               newMetricContainerMemoryRss(ms.ContainerMemoryRss),
+		metricContainerMemoryUsage:             newMetricContainerMemoryUsage(ms.ContainerMemoryUsage),
+		metricContainerMemoryWorkingSet:        newMetricContainerMemoryWorkingSet(ms.ContainerMemoryWorkingSet),
+		metricK8sNodeCPUPressureStalledTime:    newMetricK8sNodeCPUPressureStalledTime(ms.K8sNodeCPUPressureStalledTime),
+		metricK8sNodeCPUTime:                   newMetricK8sNodeCPUTime(ms.K8sNodeCPUTime),
+		metricK8sNodeCPUUtilization:            newMetricK8sNodeCPUUtilization(ms.K8sNodeCPUUtilization),
+		metricK8sNodeFilesystemAvailable:       newMetricK8sNodeFilesystemAvailable(ms.K8sNodeFilesystemAvailable),
+		metricK8sNodeFilesystemCapacity:        newMetricK8sNodeFilesystemCapacity(ms.K8sNodeFilesystemCapacity),
+		metricK8sNodeFilesystemUsage:           newMetricK8sNodeFilesystemUsage(ms.K8sNodeFilesystemUsage),
+		metricK8sNodeIoPressureStalledTime:     newMetricK8sNodeIoPressureStalledTime(ms.K8sNodeIoPressureStalledTime),
+		metricK8sNodeMemoryAvailable:           newMetricK8sNodeMemoryAvailable(ms.K8sNodeMemoryAvailable),
+		metricK8sNodeMemoryMajorPageFaults:     newMetricK8sNodeMemoryMajorPageFaults(ms.K8sNodeMemoryMajorPageFaults),
+		metricK8sNodeMemoryPageFaults:          newMetricK8sNodeMemoryPageFaults(ms.K8sNodeMemoryPageFaults),
+		metricK8sNodeMemoryPressureStalledTime: newMetricK8sNodeMemoryPressureStalledTime(ms.K8sNodeMemoryPressureStalledTime),
+		metricK8sNodeMemoryRss:                 newMetricK8sNodeMemoryRss(ms.K8sNodeMemoryRss),
+		metricK8sNodeMemoryUsage:               newMetricK8sNodeMemoryUsage(ms.K8sNodeMemoryUsage),
+		metricK8sNodeMemoryWorkingSet:          newMetricK8sNodeMemoryWorkingSet(ms.K8sNodeMemoryWorkingSet),
+		metricK8sNodeNetworkErrors:             newMetricK8sNodeNetworkErrors(ms.K8sNodeNetworkErrors),
+		metricK8sNodeNetworkIo:                 newMetricK8sNodeNetworkIo(ms.K8sNodeNetworkIo),
+		metricK8sPodCPUTime:                    newMetricK8sPodCPUTime(ms.K8sPodCPUTime),
+		metricK8sPodCPUUtilization:             newMetricK8sPodCPUUtilization(ms.K8sPodCPUUtilization),
+		metricK8sPodFilesystemAvailable:        newMetricK8sPodFilesystemAvailable(ms.K8sPodFilesystemAvailable),
+		metricK8sPodFilesystemCapacity:         newMetricK8sPodFilesystemCapacity(ms.K8sPodFilesystemCapacity),
+		metricK8sPodFilesystemUsage:            newMetricK8sPodFilesystemUsage(ms.K8sPodFilesystemUsage),
+		metricK8sPodMemoryAvailable:            newMetricK8sPodMemoryAvailable(ms.K8sPodMemoryAvailable),
+		metricK8sPodMemoryMajorPageFaults:      newMetricK8sPodMemoryMajorPageFaults(ms.K8sPodMemoryMajorPageFaults),
+		metricK8sPodMemoryPageFaults:           newMetricK8sPodMemoryPageFaults(ms.K8sPodMemoryPageFaults),
+		metricK8sPodMemoryRss:                  newMetricK8sPodMemoryRss(ms.K8sPodMemoryRss),
+		metricK8sPodMemoryUsage:                newMetricK8sPodMemoryUsage(ms.K8sPodMemoryUsage),
+		metricK8sPodMemoryWorkingSet:           newMetricK8sPodMemoryWorkingSet(ms.K8sPodMemoryWorkingSet),
+		metricK8sPodNetworkErrors:              newMetricK8sPodNetworkErrors(ms.K8sPodNetworkErrors),
+		metricK8sPodNetworkIo:                  newMetricK8sPodNetworkIo(ms.K8sPodNetworkIo),
+		metricK8sPodSchedulingDuration:         newMetricK8sPodSchedulingDuration(ms.K8sPodSchedulingDuration),
+		metricK8sPodStartupDuration:            newMetricK8sPodStartupDuration(ms.K8sPodStartupDuration),
+		metricK8sVolumeAvailable:               newMetricK8sVolumeAvailable(ms.K8sVolumeAvailable),
+		metricK8sVolumeCapacity:                newMetricK8sVolumeCapacity(ms.K8sVolumeCapacity),
+		metricK8sVolumeInodes:                  newMetricK8sVolumeInodes(ms.K8sVolumeInodes),
+		metricK8sVolumeInodesFree:              newMetricK8sVolumeInodesFree(ms.K8sVolumeInodesFree),
+		metricK8sVolumeInodesUsed:              newMetricK8sVolumeInodesUsed(ms.K8sVolumeInodesUsed),
 	}
 	for _, op := range options {
 		op(mb)
@@ -2722,14 +3035,17 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	mb.metricContainerMemoryRss.emit(ils.Metrics())
 	mb.metricContainerMemoryUsage.emit(ils.Metrics())
 	mb.metricContainerMemoryWorkingSet.emit(ils.Metrics())
+	mb.metricK8sNodeCPUPressureStalledTime.emit(ils.Metrics())
 	mb.metricK8sNodeCPUTime.emit(ils.Metrics())
 	mb.metricK8sNodeCPUUtilization.emit(ils.Metrics())
 	mb.metricK8sNodeFilesystemAvailable.emit(ils.Metrics())
 	mb.metricK8sNodeFilesystemCapacity.emit(ils.Metrics())
 	mb.metricK8sNodeFilesystemUsage.emit(ils.Metrics())
+	mb.metricK8sNodeIoPressureStalledTime.emit(ils.Metrics())
 	mb.metricK8sNodeMemoryAvailable.emit(ils.Metrics())
 	mb.metricK8sNodeMemoryMajorPageFaults.emit(ils.Metrics())
 	mb.metricK8sNodeMemoryPageFaults.emit(ils.Metrics())
+	mb.metricK8sNodeMemoryPressureStalledTime.emit(ils.Metrics())
 	mb.metricK8sNodeMemoryRss.emit(ils.Metrics())
 	mb.metricK8sNodeMemoryUsage.emit(ils.Metrics())
 	mb.metricK8sNodeMemoryWorkingSet.emit(ils.Metrics())
@@ -2748,6 +3064,8 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	mb.metricK8sPodMemoryWorkingSet.emit(ils.Metrics())
 	mb.metricK8sPodNetworkErrors.emit(ils.Metrics())
 	mb.metricK8sPodNetworkIo.emit(ils.Metrics())
+	mb.metricK8sPodSchedulingDuration.emit(ils.Metrics())
+	mb.metricK8sPodStartupDuration.emit(ils.Metrics())
 	mb.metricK8sVolumeAvailable.emit(ils.Metrics())
 	mb.metricK8sVolumeCapacity.emit(ils.Metrics())
 	mb.metricK8sVolumeInodes.emit(ils.Metrics())
@@ -2828,6 +3146,11 @@ func (mb *MetricsBuilder) RecordContainerMemoryWorkingSetDataPoint(ts pcommon.Ti
 	mb.metricContainerMemoryWorkingSet.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordK8sNodeCPUPressureStalledTimeDataPoint adds a data point to k8s.node.cpu.pressure.stalled_time metric.
+func (mb *MetricsBuilder) RecordK8sNodeCPUPressureStalledTimeDataPoint(ts pcommon.Timestamp, val float64, stallAttributeValue AttributeStall) {
+	mb.metricK8sNodeCPUPressureStalledTime.recordDataPoint(mb.startTime, ts, val, stallAttributeValue.String())
+}
+
 // RecordK8sNodeCPUTimeDataPoint adds a data point to k8s.node.cpu.time metric.
 func (mb *MetricsBuilder) RecordK8sNodeCPUTimeDataPoint(ts pcommon.Timestamp, val float64) {
 	mb.metricK8sNodeCPUTime.recordDataPoint(mb.startTime, ts, val)
@@ -2853,6 +3176,11 @@ func (mb *MetricsBuilder) RecordK8sNodeFilesystemUsageDataPoint(ts pcommon.Times
 	mb.metricK8sNodeFilesystemUsage.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordK8sNodeIoPressureStalledTimeDataPoint adds a data point to k8s.node.io.pressure.stalled_time metric.
+func (mb *MetricsBuilder) RecordK8sNodeIoPressureStalledTimeDataPoint(ts pcommon.Timestamp, val float64, stallAttributeValue AttributeStall) {
+	mb.metricK8sNodeIoPressureStalledTime.recordDataPoint(mb.startTime, ts, val, stallAttributeValue.String())
+}
+
 // RecordK8sNodeMemoryAvailableDataPoint adds a data point to k8s.node.memory.available metric.
 func (mb *MetricsBuilder) RecordK8sNodeMemoryAvailableDataPoint(ts pcommon.Timestamp, val int64) {
 	mb.metricK8sNodeMemoryAvailable.recordDataPoint(mb.startTime, ts, val)
@@ -2868,6 +3196,11 @@ func (mb *MetricsBuilder) RecordK8sNodeMemoryPageFaultsDataPoint(ts pcommon.Time
 	mb.metricK8sNodeMemoryPageFaults.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordK8sNodeMemoryPressureStalledTimeDataPoint adds a data point to k8s.node.memory.pressure.stalled_time metric.
+func (mb *MetricsBuilder) RecordK8sNodeMemoryPressureStalledTimeDataPoint(ts pcommon.Timestamp, val float64, stallAttributeValue AttributeStall) {
+	mb.metricK8sNodeMemoryPressureStalledTime.recordDataPoint(mb.startTime, ts, val, stallAttributeValue.String())
+}
+
 // RecordK8sNodeMemoryRssDataPoint adds a data point to k8s.node.memory.rss metric.
 func (mb *MetricsBuilder) RecordK8sNodeMemoryRssDataPoint(ts pcommon.Timestamp, val int64) {
 	mb.metricK8sNodeMemoryRss.recordDataPoint(mb.startTime, ts, val)
@@ -2958,6 +3291,16 @@ func (mb *MetricsBuilder) RecordK8sPodNetworkIoDataPoint(ts pcommon.Timestamp, v
 	mb.metricK8sPodNetworkIo.recordDataPoint(mb.startTime, ts, val, interfaceAttributeValue, directionAttributeValue.String())
 }
 
+// RecordK8sPodSchedulingDurationDataPoint adds a data point to k8s.pod.scheduling.duration metric.
+func (mb *MetricsBuilder) RecordK8sPodSchedulingDurationDataPoint(ts pcommon.Timestamp, val float64) {
+	mb.metricK8sPodSchedulingDuration.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordK8sPodStartupDurationDataPoint adds a data point to k8s.pod.startup.duration metric.
+func (mb *MetricsBuilder) RecordK8sPodStartupDurationDataPoint(ts pcommon.Timestamp, val float64) {
+	mb.metricK8sPodStartupDuration.recordDataPoint(mb.startTime, ts, val)
+}
+
 // RecordK8sVolumeAvailableDataPoint adds a data point to k8s.volume.available metric.
 func (mb *MetricsBuilder) RecordK8sVolumeAvailableDataPoint(ts pcommon.Timestamp, val int64) {
 	mb.metricK8sVolumeAvailable.recordDataPoint(mb.startTime, ts, val)
@@ -22,6 +22,8 @@ type RecordIntDataPointFunc func(*MetricsBuilder, pcommon.Timestamp, int64)
 
 type RecordIntDataPointWithDirectionFunc func(*MetricsBuilder, pcommon.Timestamp, int64, string, AttributeDirection)
 
+type RecordDoubleDataPointWithStallFunc func(*MetricsBuilder, pcommon.Timestamp, float64, AttributeStall)
+
 type MetricsBuilders struct {
 	NodeMetricsBuilder      *MetricsBuilder
 	PodMetricsBuilder       *MetricsBuilder
@@ -124,6 +126,18 @@ var PodNetworkMetrics = NetworkMetrics{
 	Errors: (*MetricsBuilder).RecordK8sPodNetworkErrorsDataPoint,
 }
 
+type PressureMetrics struct {
+	CPU    RecordDoubleDataPointWithStallFunc
+	Memory RecordDoubleDataPointWithStallFunc
+	IO     RecordDoubleDataPointWithStallFunc
+}
+
+var NodePressureMetrics = PressureMetrics{
+	CPU:    (*MetricsBuilder).RecordK8sNodeCPUPressureStalledTimeDataPoint,
+	Memory: (*MetricsBuilder).RecordK8sNodeMemoryPressureStalledTimeDataPoint,
+	IO:     (*MetricsBuilder).RecordK8sNodeIoPressureStalledTimeDataPoint,
+}
+
 type VolumeMetrics struct {
 	Available  RecordIntDataPointFunc
 	Capacity   RecordIntDataPointFunc
@@ -47,6 +47,8 @@ type kubletScraper struct {
 	k8sAPIClient          kubernetes.Interface
 	cachedVolumeLabels    map[string][]metadata.ResourceMetricsOption
 	mbs                   *metadata.MetricsBuilders
+	needsPodsMetadata     bool
+	podStartupDurations   kubelet.PodStartupDurations
 }
 
 func newKubletScraper(
@@ -69,6 +71,10 @@ func newKubletScraper(
 			ContainerMetricsBuilder: metadata.NewMetricsBuilder(metricsConfig, set),
 			OtherMetricsBuilder:     metadata.NewMetricsBuilder(metricsConfig, set),
 		},
+		needsPodsMetadata: len(rOptions.extraMetadataLabels) > 0 ||
+			metricsConfig.K8sPodSchedulingDuration.Enabled ||
+			metricsConfig.K8sPodStartupDuration.Enabled,
+		podStartupDurations: kubelet.PodStartupDurations{},
 	}
 	return scraperhelper.NewScraper(typeStr, ks.scrape)
 }
@@ -81,16 +87,18 @@ func (r *kubletScraper) scrape(context.Context) (pmetric.Metrics, error) {
 	}
 
 	var podsMetadata *v1.PodList
-	// fetch metadata only when extra metadata labels are needed
-	if len(r.extraMetadataLabels) > 0 {
+	// fetch metadata only when extra metadata labels or pod lifecycle metrics are needed
+	if r.needsPodsMetadata {
 		podsMetadata, err = r.metadataProvider.Pods()
 		if err != nil {
 			r.logger.Error("call to /pods endpoint failed", zap.Error(err))
 			return pmetric.Metrics{}, err
 		}
+		r.podStartupDurations.Prune(podsMetadata)
 	}
 
 	metadata := kubelet.NewMetadata(r.extraMetadataLabels, podsMetadata, r.detailedPVCLabelsSetter())
+	metadata.PodStartupDurations = r.podStartupDurations
 	mds := kubelet.MetricsData(r.logger, summary, metadata, r.metricGroupsToCollect, r.mbs)
 	md := pmetric.NewMetrics()
 	for i := range mds {
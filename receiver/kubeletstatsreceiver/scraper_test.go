@@ -141,6 +141,68 @@ func TestScraperWithMetadata(t *testing.T) {
 	}
 }
 
+func TestScraperWithPressureAndLifecycleMetrics(t *testing.T) {
+	metricsConfig := metadata.DefaultMetricsSettings()
+	metricsConfig.K8sNodeCPUPressureStalledTime.Enabled = true
+	metricsConfig.K8sNodeMemoryPressureStalledTime.Enabled = true
+	metricsConfig.K8sNodeIoPressureStalledTime.Enabled = true
+	metricsConfig.K8sPodSchedulingDuration.Enabled = true
+	metricsConfig.K8sPodStartupDuration.Enabled = true
+
+	options := &scraperOptions{
+		metricGroupsToCollect: map[kubelet.MetricGroup]bool{
+			kubelet.NodeMetricGroup: true,
+			kubelet.PodMetricGroup:  true,
+		},
+	}
+	r, err := newKubletScraper(
+		&fakeRestClient{},
+		receivertest.NewNopCreateSettings(),
+		options,
+		metricsConfig,
+	)
+	require.NoError(t, err)
+
+	md, err := r.Scrape(context.Background())
+	require.NoError(t, err)
+
+	// The pods metadata are fetched for the lifecycle metrics, which are only
+	// recorded for the pod with conditions in testdata/pods.json.
+	values := map[string]float64{}
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		rm := md.ResourceMetrics().At(i)
+		for j := 0; j < rm.ScopeMetrics().Len(); j++ {
+			ms := rm.ScopeMetrics().At(j).Metrics()
+			for k := 0; k < ms.Len(); k++ {
+				m := ms.At(k)
+				switch m.Name() {
+				case "k8s.node.cpu.pressure.stalled_time", "k8s.node.memory.pressure.stalled_time", "k8s.node.io.pressure.stalled_time":
+					for l := 0; l < m.Sum().DataPoints().Len(); l++ {
+						dp := m.Sum().DataPoints().At(l)
+						stall, ok := dp.Attributes().Get("stall")
+						require.True(t, ok)
+						values[m.Name()+"/"+stall.Str()] = dp.DoubleValue()
+					}
+				case "k8s.pod.scheduling.duration", "k8s.pod.startup.duration":
+					require.Equal(t, 1, m.Gauge().DataPoints().Len())
+					podName, _ := rm.Resource().Attributes().Get("k8s.pod.name")
+					values[m.Name()+"/"+podName.Str()] = m.Gauge().DataPoints().At(0).DoubleValue()
+				}
+			}
+		}
+	}
+	require.Equal(t, map[string]float64{
+		"k8s.node.cpu.pressure.stalled_time/some":             12.5,
+		"k8s.node.cpu.pressure.stalled_time/full":             0,
+		"k8s.node.memory.pressure.stalled_time/some":          3,
+		"k8s.node.memory.pressure.stalled_time/full":          1.5,
+		"k8s.node.io.pressure.stalled_time/some":              5,
+		"k8s.node.io.pressure.stalled_time/full":              4,
+		"k8s.pod.scheduling.duration/kube-scheduler-minikube": 2,
+		"k8s.pod.startup.duration/kube-scheduler-minikube":    15,
+	}, values)
+}
+
 func TestScraperWithMetricGroups(t *testing.T) {
 	tests := []struct {
 		name         string
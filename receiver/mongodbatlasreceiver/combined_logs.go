@@ -21,10 +21,11 @@ import (
 	"go.uber.org/multierr"
 )
 
-// combinedLogsReceiver wraps alerts and log receivers in a single log receiver to be consumed by the factory
+// combinedLogsReceiver wraps alerts, events and log receivers in a single log receiver to be consumed by the factory
 type combinedLogsReceiver struct {
 	alerts *alertsReceiver
 	logs   *logsReceiver
+	events *eventsReceiver
 }
 
 // Starts up the combined MongoDB Atlas Logs and Alert Receiver
@@ -43,6 +44,12 @@ func (c *combinedLogsReceiver) Start(ctx context.Context, host component.Host) e
 		}
 	}
 
+	if c.events != nil {
+		if err := c.events.Start(ctx, host); err != nil {
+			errs = multierr.Append(errs, err)
+		}
+	}
+
 	return errs
 }
 
@@ -62,5 +69,11 @@ func (c *combinedLogsReceiver) Shutdown(ctx context.Context) error {
 		}
 	}
 
+	if c.events != nil {
+		if err := c.events.Shutdown(ctx); err != nil {
+			errs = multierr.Append(errs, err)
+		}
+	}
+
 	return errs
 }
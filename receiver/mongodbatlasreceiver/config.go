@@ -40,6 +40,7 @@ type Config struct {
 	Metrics                                 metadata.MetricsSettings     `mapstructure:"metrics"`
 	Alerts                                  AlertConfig                  `mapstructure:"alerts"`
 	Logs                                    LogConfig                    `mapstructure:"logs"`
+	Events                                  EventsConfig                 `mapstructure:"events"`
 	RetrySettings                           exporterhelper.RetrySettings `mapstructure:"retry_on_failure"`
 	StorageID                               *component.ID                `mapstructure:"storage"`
 }
@@ -63,6 +64,14 @@ type LogConfig struct {
 	Projects []*ProjectConfig `mapstructure:"projects"`
 }
 
+type EventsConfig struct {
+	Enabled      bool             `mapstructure:"enabled"`
+	Projects     []*ProjectConfig `mapstructure:"projects"`
+	PollInterval time.Duration    `mapstructure:"poll_interval"`
+	PageSize     int64            `mapstructure:"page_size"`
+	MaxPages     int64            `mapstructure:"max_pages"`
+}
+
 type ProjectConfig struct {
 	Name            string   `mapstructure:"name"`
 	ExcludeClusters []string `mapstructure:"exclude_clusters"`
@@ -109,6 +118,7 @@ func (c *Config) Validate() error {
 
 	errs = multierr.Append(errs, c.Alerts.validate())
 	errs = multierr.Append(errs, c.Logs.validate())
+	errs = multierr.Append(errs, c.Events.validate())
 
 	return errs
 }
@@ -132,6 +142,30 @@ func (l *LogConfig) validate() error {
 	return errs
 }
 
+func (e *EventsConfig) validate() error {
+	if !e.Enabled {
+		return nil
+	}
+
+	if len(e.Projects) == 0 {
+		return errNoProjects
+	}
+
+	// based off API limits https://www.mongodb.com/docs/atlas/reference/api/events-projects-get-all/
+	if 0 >= e.PageSize || e.PageSize > 500 {
+		return errPageSizeIncorrect
+	}
+
+	var errs error
+	for _, project := range e.Projects {
+		if len(project.ExcludeClusters) != 0 && len(project.IncludeClusters) != 0 {
+			errs = multierr.Append(errs, errClusterConfig)
+		}
+	}
+
+	return errs
+}
+
 func (a *AlertConfig) validate() error {
 	if !a.Enabled {
 		// No need to further validate, receiving alerts is disabled.
@@ -223,6 +223,62 @@ func TestValidate(t *testing.T) {
 			},
 			expectedErr: errPageSizeIncorrect.Error(),
 		},
+		{
+			name: "Valid Events Config",
+			input: Config{
+				Events: EventsConfig{
+					Enabled: true,
+					Projects: []*ProjectConfig{
+						{
+							Name: "Project1",
+						},
+					},
+					PageSize: defaultEventsPageSize,
+				},
+			},
+		},
+		{
+			name: "Invalid Events No Projects",
+			input: Config{
+				Events: EventsConfig{
+					Enabled:  true,
+					PageSize: defaultEventsPageSize,
+				},
+			},
+			expectedErr: errNoProjects.Error(),
+		},
+		{
+			name: "Invalid Events Page Size",
+			input: Config{
+				Events: EventsConfig{
+					Enabled: true,
+					Projects: []*ProjectConfig{
+						{
+							Name: "Project1",
+						},
+					},
+					PageSize: 501,
+				},
+			},
+			expectedErr: errPageSizeIncorrect.Error(),
+		},
+		{
+			name: "Invalid Events ProjectConfig",
+			input: Config{
+				Events: EventsConfig{
+					Enabled: true,
+					Projects: []*ProjectConfig{
+						{
+							Name:            "Project1",
+							ExcludeClusters: []string{"cluster1"},
+							IncludeClusters: []string{"cluster2"},
+						},
+					},
+					PageSize: defaultEventsPageSize,
+				},
+			},
+			expectedErr: errClusterConfig.Error(),
+		},
 	}
 
 	for _, tc := range testCases {
@@ -0,0 +1,428 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mongodbatlasreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/mongodbatlasreceiver"
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"go.mongodb.org/atlas/mongodbatlas"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/extension/experimental/storage"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	rcvr "go.opentelemetry.io/collector/receiver"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/mongodbatlasreceiver/internal"
+)
+
+const (
+	eventStorageName = "events"
+	eventCacheKey    = "last_recorded_event"
+
+	defaultEventsPollInterval = 5 * time.Minute
+	// defaults were based off API docs https://www.mongodb.com/docs/atlas/reference/api/events-projects-get-all/
+	defaultEventsPageSize = 100
+	defaultEventsMaxPages = 10
+)
+
+type eventsClient interface {
+	GetProject(ctx context.Context, groupID string) (*mongodbatlas.Project, error)
+	GetClusters(ctx context.Context, groupID string) ([]mongodbatlas.Cluster, error)
+	GetProjectEvents(ctx context.Context, groupID string, opts *internal.EventsPollOptions) ([]*mongodbatlas.Event, int, error)
+}
+
+type eventsReceiver struct {
+	client        eventsClient
+	logger        *zap.Logger
+	consumer      consumer.Logs
+	projects      []*ProjectConfig
+	pollInterval  time.Duration
+	pageSize      int64
+	maxPages      int64
+	record        *eventRecord
+	wg            *sync.WaitGroup
+	doneChan      chan bool
+	id            component.ID  // ID of the receiver component
+	storageID     *component.ID // ID of the storage extension component
+	storageClient storage.Client
+	startTime     time.Time // events created before are not polled unless a watermark was persisted
+}
+
+func newEventsReceiver(params rcvr.CreateSettings, baseConfig *Config, consumer consumer.Logs) *eventsReceiver {
+	cfg := baseConfig.Events
+	for _, p := range cfg.Projects {
+		p.populateIncludesAndExcludes()
+	}
+
+	return &eventsReceiver{
+		client:       internal.NewMongoDBAtlasClient(baseConfig.PublicKey, baseConfig.PrivateKey, baseConfig.RetrySettings, params.Logger),
+		logger:       params.Logger,
+		consumer:     consumer,
+		projects:     cfg.Projects,
+		pollInterval: cfg.PollInterval,
+		pageSize:     cfg.PageSize,
+		maxPages:     cfg.MaxPages,
+		record:       &eventRecord{},
+		wg:           &sync.WaitGroup{},
+		doneChan:     make(chan bool, 1),
+		id:           params.ID,
+		storageID:    baseConfig.StorageID,
+	}
+}
+
+func (e *eventsReceiver) Start(ctx context.Context, host component.Host) error {
+	e.logger.Debug("starting events receiver")
+	storageClient, err := getEventsStorageClient(ctx, host, e.storageID, e.id)
+	if err != nil {
+		return fmt.Errorf("failed to set up storage: %w", err)
+	}
+	e.storageClient = storageClient
+	e.startTime = time.Now().Truncate(time.Second)
+	if err = e.syncPersistence(ctx); err != nil {
+		e.logger.Error("there was an error syncing the receiver with checkpoint", zap.Error(err))
+	}
+
+	t := time.NewTicker(e.pollInterval)
+	e.wg.Add(1)
+	go func() {
+		defer e.wg.Done()
+		defer t.Stop()
+		for {
+			select {
+			case <-t.C:
+				if err := e.retrieveAndProcessEvents(ctx); err != nil {
+					e.logger.Error("unable to retrieve events", zap.Error(err))
+				}
+			case <-e.doneChan:
+				return
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return nil
+}
+
+func (e *eventsReceiver) Shutdown(ctx context.Context) error {
+	e.logger.Debug("Shutting down events receiver")
+	close(e.doneChan)
+	e.wg.Wait()
+	if e.storageClient == nil {
+		return nil
+	}
+	return e.writeCheckpoint(ctx)
+}
+
+func (e *eventsReceiver) retrieveAndProcessEvents(ctx context.Context) error {
+	for _, p := range e.projects {
+		project, err := e.client.GetProject(ctx, p.Name)
+		if err != nil {
+			e.logger.Error("error retrieving project "+p.Name+":", zap.Error(err))
+			continue
+		}
+		clusters, err := e.client.GetClusters(ctx, project.ID)
+		if err != nil {
+			e.logger.Warn("unable to retrieve clusters, events will not have cluster attributes", zap.String("project", p.Name), zap.Error(err))
+		}
+		e.pollAndProcess(ctx, p, project, clusterNamesByHostname(clusters))
+	}
+	return e.writeCheckpoint(ctx)
+}
+
+func (e *eventsReceiver) pollAndProcess(ctx context.Context, pc *ProjectConfig, project *mongodbatlas.Project, clusterNames map[string]string) {
+	watermark := e.record.watermark(project.ID)
+	// Without a watermark the project was never polled, its history is not replayed.
+	since := watermark.Created
+	if since.IsZero() {
+		since = e.startTime
+	}
+	// Only complete seconds are polled, so that events created while polling do not shift the pages being read.
+	until := time.Now().Truncate(time.Second).Add(-time.Second)
+	pollOptions := func(pageNum, pageSize int) *internal.EventsPollOptions {
+		return &internal.EventsPollOptions{
+			PageNum:  pageNum,
+			PageSize: pageSize,
+			MinDate:  since,
+			MaxDate:  until,
+		}
+	}
+
+	_, totalCount, err := e.client.GetProjectEvents(ctx, project.ID, pollOptions(1, 1))
+	if err != nil {
+		e.logger.Error("unable to get events for project", zap.String("project", pc.Name), zap.Error(err))
+		return
+	}
+
+	// The most recent events are returned first, so the pages are read from the last one. This way, when max_pages
+	// is reached, the events that are left for the next poll are all more recent than the ones consumed.
+	lastPage := (totalCount + int(e.pageSize) - 1) / int(e.pageSize)
+	for pageNum := lastPage; pageNum > 0 && pageNum > lastPage-int(e.maxPages); pageNum-- {
+		events, _, err := e.client.GetProjectEvents(ctx, project.ID, pollOptions(pageNum, int(e.pageSize)))
+		if err != nil {
+			e.logger.Error("unable to get events for project", zap.String("project", pc.Name), zap.Error(err))
+			break
+		}
+
+		pageWatermark := watermark.clone()
+		now := pcommon.NewTimestampFromTime(time.Now())
+		logs := plog.NewLogs()
+		for _, ce := range e.sortByCreation(events) {
+			if pageWatermark.covers(ce.created, ce.event.ID) {
+				// already processed in a previous poll
+				continue
+			}
+			pageWatermark.advance(ce.created, ce.event.ID)
+			clusterName := clusterNames[ce.event.Hostname]
+			if !pc.includesCluster(clusterName) {
+				continue
+			}
+			e.convertEvent(logs, now, ce.created, ce.event, project, clusterName)
+		}
+
+		if logs.LogRecordCount() > 0 {
+			if err = e.consumer.ConsumeLogs(ctx, logs); err != nil {
+				e.logger.Error("error consuming events", zap.Error(err))
+				break
+			}
+		}
+		watermark = pageWatermark
+	}
+
+	e.record.setWatermark(project.ID, watermark)
+}
+
+type createdEvent struct {
+	created time.Time
+	event   *mongodbatlas.Event
+}
+
+// sortByCreation returns the events in the order they were created, dropping the ones without a valid creation time.
+func (e *eventsReceiver) sortByCreation(events []*mongodbatlas.Event) []createdEvent {
+	sorted := make([]createdEvent, 0, len(events))
+	for _, event := range events {
+		created, err := time.Parse(time.RFC3339, event.Created)
+		if err != nil {
+			e.logger.Warn("unable to interpret created time for event, expecting a RFC3339 timestamp", zap.String("timestamp", event.Created))
+			continue
+		}
+		sorted = append(sorted, createdEvent{created: created, event: event})
+	}
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].created.Before(sorted[j].created)
+	})
+	return sorted
+}
+
+func (e *eventsReceiver) convertEvent(logs plog.Logs, now pcommon.Timestamp, created time.Time, event *mongodbatlas.Event,
+	project *mongodbatlas.Project, clusterName string) {
+	resourceLogs := logs.ResourceLogs().AppendEmpty()
+	resourceAttrs := resourceLogs.Resource().Attributes()
+	resourceAttrs.PutStr("mongodbatlas.group.id", project.ID)
+	resourceAttrs.PutStr("mongodbatlas.org.id", project.OrgID)
+	resourceAttrs.PutStr("mongodbatlas.project.name", project.Name)
+	putStringToMapNotEmpty(resourceAttrs, "mongodbatlas.cluster.name", clusterName)
+	putStringToMapNotEmpty(resourceAttrs, "mongodbatlas.replica_set.name", event.ReplicaSetName)
+
+	logRecord := resourceLogs.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+	logRecord.SetObservedTimestamp(now)
+	logRecord.SetTimestamp(pcommon.NewTimestampFromTime(created))
+	logRecord.SetSeverityNumber(plog.SeverityNumberInfo)
+
+	bodyBytes, err := json.Marshal(event)
+	if err != nil {
+		e.logger.Warn("unable to marshal event into a body string")
+	} else {
+		logRecord.Body().SetStr(string(bodyBytes))
+	}
+
+	attrs := logRecord.Attributes()
+	// These attributes are always present
+	attrs.PutStr("event.domain", "mongodbatlas")
+	attrs.PutStr("event.name", event.EventTypeName)
+	attrs.PutStr("id", event.ID)
+	attrs.PutStr("created", event.Created)
+
+	// These attributes are optional and may not be present, depending on the event type.
+	putStringToMapNotEmpty(attrs, "alert.id", event.AlertID)
+	putStringToMapNotEmpty(attrs, "alert.config.id", event.AlertConfigID)
+	putStringToMapNotEmpty(attrs, "shard.name", event.ShardName)
+	putStringToMapNotEmpty(attrs, "database", event.Database)
+	putStringToMapNotEmpty(attrs, "collection", event.Collection)
+	putStringToMapNotEmpty(attrs, "username", event.Username)
+	putStringToMapNotEmpty(attrs, "remote_address", event.RemoteAddress)
+	putStringToMapNotEmpty(attrs, "net.peer.name", event.Hostname)
+	if event.Port != 0 {
+		attrs.PutInt("net.peer.port", int64(event.Port))
+	}
+}
+
+// clusterNamesByHostname maps the hostnames of the cluster members to the cluster names,
+// since events only reference the hostname of the process they relate to.
+func clusterNamesByHostname(clusters []mongodbatlas.Cluster) map[string]string {
+	names := map[string]string{}
+	for _, cluster := range clusters {
+		hosts := strings.TrimPrefix(cluster.MongoURI, "mongodb://")
+		for _, host := range strings.Split(hosts, ",") {
+			hostname, _, err := net.SplitHostPort(host)
+			if err != nil {
+				hostname = host
+			}
+			if hostname != "" {
+				names[hostname] = cluster.Name
+			}
+		}
+	}
+	return names
+}
+
+// includesCluster returns whether events of the given cluster are collected for the project.
+// Events without a known cluster are only dropped when clusters are explicitly included.
+func (pc *ProjectConfig) includesCluster(clusterName string) bool {
+	if len(pc.excludesByClusterName) > 0 {
+		if _, ok := pc.excludesByClusterName[clusterName]; ok {
+			return false
+		}
+	}
+	if len(pc.IncludeClusters) > 0 {
+		if _, ok := pc.includesByClusterName[clusterName]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// eventWatermark marks the events of a project that were processed. Creation times only have a one second
+// resolution, so the IDs of the processed events created in the same second as the latest one are kept as well.
+type eventWatermark struct {
+	Created time.Time `json:"created"`
+	IDs     []string  `json:"ids,omitempty"`
+}
+
+// covers returns whether the event created at the given time with the given ID was processed.
+func (w eventWatermark) covers(created time.Time, id string) bool {
+	if created.Before(w.Created) {
+		return true
+	}
+	if created.After(w.Created) {
+		return false
+	}
+	for _, processed := range w.IDs {
+		if processed == id {
+			return true
+		}
+	}
+	return false
+}
+
+// advance marks the event created at the given time with the given ID as processed, events must be processed
+// in the order they were created.
+func (w *eventWatermark) advance(created time.Time, id string) {
+	if created.After(w.Created) {
+		w.Created = created
+		w.IDs = nil
+	}
+	w.IDs = append(w.IDs, id)
+}
+
+func (w eventWatermark) clone() eventWatermark {
+	return eventWatermark{Created: w.Created, IDs: append([]string(nil), w.IDs...)}
+}
+
+// eventRecord holds the watermark of the processed events of each project,
+// it is goroutine safe and persisted across restarts
+type eventRecord struct {
+	sync.Mutex
+	Watermarks map[string]eventWatermark `json:"watermarks"`
+}
+
+func (r *eventRecord) watermark(projectID string) eventWatermark {
+	r.Lock()
+	defer r.Unlock()
+	return r.Watermarks[projectID]
+}
+
+func (r *eventRecord) setWatermark(projectID string, watermark eventWatermark) {
+	r.Lock()
+	defer r.Unlock()
+	if r.Watermarks == nil {
+		r.Watermarks = map[string]eventWatermark{}
+	}
+	r.Watermarks[projectID] = watermark
+}
+
+func (e *eventsReceiver) syncPersistence(ctx context.Context) error {
+	cBytes, err := e.storageClient.Get(ctx, eventCacheKey)
+	if err != nil || cBytes == nil {
+		return nil
+	}
+
+	var cache eventRecord
+	if err = json.Unmarshal(cBytes, &cache); err != nil {
+		return fmt.Errorf("unable to decode stored cache: %w", err)
+	}
+	e.record = &cache
+	return nil
+}
+
+func (e *eventsReceiver) writeCheckpoint(ctx context.Context) error {
+	if e.storageClient == nil {
+		e.logger.Error("unable to write checkpoint since no storage client was found")
+		return errors.New("missing non-nil storage client")
+	}
+	e.record.Lock()
+	marshalBytes, err := json.Marshal(e.record)
+	e.record.Unlock()
+	if err != nil {
+		return fmt.Errorf("unable to write checkpoint: %w", err)
+	}
+	return e.storageClient.Set(ctx, eventCacheKey, marshalBytes)
+}
+
+// getEventsStorageClient returns a storage client dedicated to events, so that it does not share the
+// storage of the alerts receiver.
+func getEventsStorageClient(ctx context.Context, host component.Host, storageID *component.ID, componentID component.ID) (storage.Client, error) {
+	if storageID == nil {
+		return storage.NewNopClient(), nil
+	}
+
+	extension, ok := host.GetExtensions()[*storageID]
+	if !ok {
+		return nil, fmt.Errorf("storage extension '%s' not found", storageID)
+	}
+
+	storageExtension, ok := extension.(storage.Extension)
+	if !ok {
+		return nil, fmt.Errorf("non-storage extension '%s' found", storageID)
+	}
+
+	return storageExtension.GetClient(ctx, component.KindReceiver, componentID, eventStorageName)
+}
+
+func putStringToMapNotEmpty(m pcommon.Map, k string, v string) {
+	if v != "" {
+		m.PutStr(k, v)
+	}
+}
@@ -0,0 +1,384 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mongodbatlasreceiver
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas/mongodbatlas"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/mongodbatlasreceiver/internal"
+)
+
+const (
+	testEventID       = "6334a6bee9a7f43c4a7b9e1c"
+	testEventTypeName = "USER_ROLES_CHANGED_AUDIT"
+	testEventHostname = "cluster1-shard-00-00.a1b2c.mongodb.net"
+)
+
+func TestEventsRetrieval(t *testing.T) {
+	cases := []struct {
+		name            string
+		projects        []*ProjectConfig
+		validateEntries func(*testing.T, plog.Logs)
+	}{
+		{
+			name: "default",
+			projects: []*ProjectConfig{
+				{
+					Name: testProjectName,
+				},
+			},
+			validateEntries: func(t *testing.T, logs plog.Logs) {
+				require.Equal(t, 1, logs.LogRecordCount())
+				expectedStringAttributes := map[string]string{
+					"id":            testEventID,
+					"event.domain":  "mongodbatlas",
+					"event.name":    testEventTypeName,
+					"username":      "user@example.com",
+					"net.peer.name": testEventHostname,
+				}
+				validateAttributes(t, expectedStringAttributes, logs)
+				expectedResourceAttributes := map[string]string{
+					"mongodbatlas.group.id":     testProjectID,
+					"mongodbatlas.org.id":       testOrgID,
+					"mongodbatlas.project.name": testProjectName,
+					"mongodbatlas.cluster.name": testClusterName,
+				}
+				ra := logs.ResourceLogs().At(0).Resource().Attributes()
+				for k, v := range expectedResourceAttributes {
+					value, ok := ra.Get(k)
+					require.True(t, ok)
+					require.Equal(t, v, value.AsString())
+				}
+			},
+		},
+		{
+			name: "project cluster inclusions",
+			projects: []*ProjectConfig{
+				{
+					Name:            testProjectName,
+					IncludeClusters: []string{testClusterName},
+				},
+			},
+			validateEntries: func(t *testing.T, logs plog.Logs) {
+				require.Equal(t, 1, logs.LogRecordCount())
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			logSink := &consumertest.LogsSink{}
+			eventsRcvr := newEventsReceiver(receivertest.NewNopCreateSettings(), &Config{
+				Events: EventsConfig{
+					Enabled:      true,
+					Projects:     tc.projects,
+					PageSize:     defaultEventsPageSize,
+					MaxPages:     defaultEventsMaxPages,
+					PollInterval: 1 * time.Second,
+				},
+			}, logSink)
+			eventsRcvr.client = testEventsClient()
+
+			err := eventsRcvr.Start(context.Background(), componenttest.NewNopHost())
+			require.NoError(t, err)
+
+			require.Eventually(t, func() bool {
+				return logSink.LogRecordCount() > 0
+			}, 10*time.Second, 10*time.Millisecond)
+
+			require.NoError(t, eventsRcvr.Shutdown(context.Background()))
+			logs := logSink.AllLogs()[0]
+
+			tc.validateEntries(t, logs)
+		})
+	}
+}
+
+func TestEventPollingExclusions(t *testing.T) {
+	logSink := &consumertest.LogsSink{}
+	eventsRcvr := newEventsReceiver(receivertest.NewNopCreateSettings(), &Config{
+		Events: EventsConfig{
+			Enabled: true,
+			Projects: []*ProjectConfig{
+				{
+					Name:            testProjectName,
+					ExcludeClusters: []string{testClusterName},
+				},
+			},
+			PageSize:     defaultEventsPageSize,
+			MaxPages:     defaultEventsMaxPages,
+			PollInterval: 1 * time.Second,
+		},
+	}, logSink)
+	eventsRcvr.client = testEventsClient()
+
+	err := eventsRcvr.Start(context.Background(), componenttest.NewNopHost())
+	require.NoError(t, err)
+
+	require.Never(t, func() bool {
+		return logSink.LogRecordCount() > 0
+	}, 3*time.Second, 10*time.Millisecond)
+
+	require.NoError(t, eventsRcvr.Shutdown(context.Background()))
+}
+
+func TestEventPollingCheckpoint(t *testing.T) {
+	logSink := &consumertest.LogsSink{}
+	eventsRcvr := newEventsReceiver(receivertest.NewNopCreateSettings(), &Config{
+		Events: EventsConfig{
+			Enabled: true,
+			Projects: []*ProjectConfig{
+				{
+					Name: testProjectName,
+				},
+			},
+			PageSize:     defaultEventsPageSize,
+			MaxPages:     defaultEventsMaxPages,
+			PollInterval: 1 * time.Second,
+		},
+	}, logSink)
+	eventsRcvr.client = testEventsClient()
+
+	err := eventsRcvr.Start(context.Background(), componenttest.NewNopHost())
+	require.NoError(t, err)
+
+	// the same event is returned on every poll, it must only be emitted once
+	require.Eventually(t, func() bool {
+		return logSink.LogRecordCount() > 0
+	}, 10*time.Second, 10*time.Millisecond)
+	require.Never(t, func() bool {
+		return logSink.LogRecordCount() > 1
+	}, 3*time.Second, 10*time.Millisecond)
+
+	require.NoError(t, eventsRcvr.Shutdown(context.Background()))
+}
+
+func TestEventPollingMaxPages(t *testing.T) {
+	created := time.Now().Add(-time.Hour).Truncate(time.Second)
+	// 6 events over 3 pages, most recent first
+	var events []*mongodbatlas.Event
+	for i := 5; i >= 0; i-- {
+		events = append(events, &mongodbatlas.Event{
+			ID:      fmt.Sprintf("event%d", i),
+			Created: created.Add(time.Duration(i) * time.Minute).Format(time.RFC3339),
+		})
+	}
+
+	ec := &mockEventsClient{}
+	ec.On("GetProjectEvents", mock.Anything, testProjectID, mock.Anything).Return(
+		func(_ context.Context, _ string, opts *internal.EventsPollOptions) []*mongodbatlas.Event {
+			var matching []*mongodbatlas.Event
+			for _, event := range events {
+				eventCreated, _ := time.Parse(time.RFC3339, event.Created)
+				if !eventCreated.Before(opts.MinDate) {
+					matching = append(matching, event)
+				}
+			}
+			start := (opts.PageNum - 1) * opts.PageSize
+			if start >= len(matching) {
+				return []*mongodbatlas.Event{}
+			}
+			end := start + opts.PageSize
+			if end > len(matching) {
+				end = len(matching)
+			}
+			return matching[start:end]
+		},
+		func(_ context.Context, _ string, opts *internal.EventsPollOptions) int {
+			count := 0
+			for _, event := range events {
+				eventCreated, _ := time.Parse(time.RFC3339, event.Created)
+				if !eventCreated.Before(opts.MinDate) {
+					count++
+				}
+			}
+			return count
+		},
+		nil)
+
+	logSink := &consumertest.LogsSink{}
+	eventsRcvr := newEventsReceiver(receivertest.NewNopCreateSettings(), &Config{
+		Events: EventsConfig{
+			Projects: []*ProjectConfig{{Name: testProjectName}},
+			PageSize: 2,
+			MaxPages: 2,
+		},
+	}, logSink)
+	eventsRcvr.client = ec
+	project := &mongodbatlas.Project{ID: testProjectID, Name: testProjectName}
+
+	// the oldest pages are read first
+	eventsRcvr.pollAndProcess(context.Background(), eventsRcvr.projects[0], project, nil)
+	require.Equal(t, []string{"event0", "event1", "event2", "event3"}, eventIDs(logSink.AllLogs()))
+	require.Equal(t, created.Add(3*time.Minute), eventsRcvr.record.watermark(testProjectID).Created)
+
+	// the remaining events are read on the next poll
+	logSink.Reset()
+	eventsRcvr.pollAndProcess(context.Background(), eventsRcvr.projects[0], project, nil)
+	require.Equal(t, []string{"event4", "event5"}, eventIDs(logSink.AllLogs()))
+}
+
+func TestEventPollingSameSecond(t *testing.T) {
+	created := time.Now().Add(-time.Hour).Truncate(time.Second).Format(time.RFC3339)
+	first := &mongodbatlas.Event{ID: "event0", Created: created}
+	second := &mongodbatlas.Event{ID: "event1", Created: created}
+
+	ec := &mockEventsClient{}
+	ec.On("GetProjectEvents", mock.Anything, testProjectID, mock.Anything).Return([]*mongodbatlas.Event{first}, 1, nil).Twice()
+	ec.On("GetProjectEvents", mock.Anything, testProjectID, mock.Anything).Return([]*mongodbatlas.Event{second, first}, 2, nil)
+
+	logSink := &consumertest.LogsSink{}
+	eventsRcvr := newEventsReceiver(receivertest.NewNopCreateSettings(), &Config{
+		Events: EventsConfig{
+			Projects: []*ProjectConfig{{Name: testProjectName}},
+			PageSize: defaultEventsPageSize,
+			MaxPages: defaultEventsMaxPages,
+		},
+	}, logSink)
+	eventsRcvr.client = ec
+	project := &mongodbatlas.Project{ID: testProjectID, Name: testProjectName}
+
+	eventsRcvr.pollAndProcess(context.Background(), eventsRcvr.projects[0], project, nil)
+	require.Equal(t, []string{"event0"}, eventIDs(logSink.AllLogs()))
+
+	// an event created in the same second as the last processed one is not lost
+	logSink.Reset()
+	eventsRcvr.pollAndProcess(context.Background(), eventsRcvr.projects[0], project, nil)
+	require.Equal(t, []string{"event1"}, eventIDs(logSink.AllLogs()))
+}
+
+func TestEventPollingFirstPoll(t *testing.T) {
+	ec := &mockEventsClient{}
+	ec.On("GetProjectEvents", mock.Anything, testProjectID, mock.Anything).Return([]*mongodbatlas.Event{}, 0, nil)
+
+	eventsRcvr := newEventsReceiver(receivertest.NewNopCreateSettings(), &Config{
+		Events: EventsConfig{
+			Projects: []*ProjectConfig{{Name: testProjectName}},
+			PageSize: defaultEventsPageSize,
+			MaxPages: defaultEventsMaxPages,
+		},
+	}, &consumertest.LogsSink{})
+	eventsRcvr.client = ec
+	eventsRcvr.startTime = time.Now().Add(-time.Minute).Truncate(time.Second)
+	project := &mongodbatlas.Project{ID: testProjectID, Name: testProjectName}
+
+	// the history of the project is not replayed on the first poll
+	eventsRcvr.pollAndProcess(context.Background(), eventsRcvr.projects[0], project, nil)
+	opts := ec.Calls[0].Arguments.Get(2).(*internal.EventsPollOptions)
+	require.Equal(t, eventsRcvr.startTime, opts.MinDate)
+
+	// a persisted watermark takes precedence
+	watermark := eventWatermark{Created: eventsRcvr.startTime.Add(-time.Hour)}
+	eventsRcvr.record.setWatermark(testProjectID, watermark)
+	eventsRcvr.pollAndProcess(context.Background(), eventsRcvr.projects[0], project, nil)
+	opts = ec.Calls[1].Arguments.Get(2).(*internal.EventsPollOptions)
+	require.Equal(t, watermark.Created, opts.MinDate)
+}
+
+func eventIDs(allLogs []plog.Logs) []string {
+	var ids []string
+	for _, logs := range allLogs {
+		for i := 0; i < logs.ResourceLogs().Len(); i++ {
+			records := logs.ResourceLogs().At(i).ScopeLogs().At(0).LogRecords()
+			for j := 0; j < records.Len(); j++ {
+				id, _ := records.At(j).Attributes().Get("id")
+				ids = append(ids, id.Str())
+			}
+		}
+	}
+	return ids
+}
+
+func TestClusterNamesByHostname(t *testing.T) {
+	names := clusterNamesByHostname([]mongodbatlas.Cluster{
+		{
+			Name:     testClusterName,
+			MongoURI: "mongodb://" + testEventHostname + ":27017,cluster1-shard-00-01.a1b2c.mongodb.net:27017",
+		},
+		{
+			Name:     "Cluster2",
+			MongoURI: "mongodb://cluster2-shard-00-00.d3e4f.mongodb.net:27017",
+		},
+	})
+	require.Equal(t, map[string]string{
+		testEventHostname:                        testClusterName,
+		"cluster1-shard-00-01.a1b2c.mongodb.net": testClusterName,
+		"cluster2-shard-00-00.d3e4f.mongodb.net": "Cluster2",
+	}, names)
+}
+
+func testEventsClient() *mockEventsClient {
+	ec := &mockEventsClient{}
+	ec.On("GetProject", mock.Anything, mock.Anything).Return(&mongodbatlas.Project{
+		ID:    testProjectID,
+		OrgID: testOrgID,
+		Name:  testProjectName,
+		Links: []*mongodbatlas.Link{},
+	}, nil)
+	ec.On("GetClusters", mock.Anything, testProjectID).Return([]mongodbatlas.Cluster{
+		{
+			Name:     testClusterName,
+			MongoURI: "mongodb://" + testEventHostname + ":27017",
+		},
+	}, nil)
+	ec.On("GetProjectEvents", mock.Anything, testProjectID, mock.Anything).Return(
+		[]*mongodbatlas.Event{
+			{
+				ID:            testEventID,
+				GroupID:       testProjectID,
+				EventTypeName: testEventTypeName,
+				Created:       time.Now().Format(time.RFC3339),
+				Hostname:      testEventHostname,
+				Port:          27017,
+				Username:      "user@example.com",
+			},
+		}, 1, nil)
+	return ec
+}
+
+type mockEventsClient struct {
+	mock.Mock
+}
+
+func (mec *mockEventsClient) GetProject(ctx context.Context, pID string) (*mongodbatlas.Project, error) {
+	args := mec.Called(ctx, pID)
+	return args.Get(0).(*mongodbatlas.Project), args.Error(1)
+}
+
+func (mec *mockEventsClient) GetClusters(ctx context.Context, groupID string) ([]mongodbatlas.Cluster, error) {
+	args := mec.Called(ctx, groupID)
+	return args.Get(0).([]mongodbatlas.Cluster), args.Error(1)
+}
+
+func (mec *mockEventsClient) GetProjectEvents(ctx context.Context, groupID string, opts *internal.EventsPollOptions) ([]*mongodbatlas.Event, int, error) {
+	args := mec.Called(ctx, groupID, opts)
+	if eventsFunc, ok := args.Get(0).(func(context.Context, string, *internal.EventsPollOptions) []*mongodbatlas.Event); ok {
+		countFunc := args.Get(1).(func(context.Context, string, *internal.EventsPollOptions) int)
+		return eventsFunc(ctx, groupID, opts), countFunc(ctx, groupID, opts), args.Error(2)
+	}
+	return args.Get(0).([]*mongodbatlas.Event), args.Int(1), args.Error(2)
+}
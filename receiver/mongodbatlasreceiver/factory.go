@@ -34,6 +34,7 @@ const (
 	defaultGranularity   = "PT1M" // 1-minute, as per https://docs.atlas.mongodb.com/reference/api/process-measurements/
 	defaultAlertsEnabled = false
 	defaultLogsEnabled   = false
+	defaultEventsEnabled = false
 )
 
 // NewFactory creates a factory for MongoDB Atlas receiver
@@ -70,8 +71,8 @@ func createCombinedLogReceiver(
 ) (rcvr.Logs, error) {
 	cfg := rConf.(*Config)
 
-	if !cfg.Alerts.Enabled && !cfg.Logs.Enabled {
-		return nil, errors.New("one of 'alerts' or 'logs' must be enabled")
+	if !cfg.Alerts.Enabled && !cfg.Logs.Enabled && !cfg.Events.Enabled {
+		return nil, errors.New("one of 'alerts', 'logs' or 'events' must be enabled")
 	}
 
 	var err error
@@ -88,6 +89,10 @@ func createCombinedLogReceiver(
 		recv.logs = newMongoDBAtlasLogsReceiver(params, cfg, consumer)
 	}
 
+	if cfg.Events.Enabled {
+		recv.events = newEventsReceiver(params, cfg, consumer)
+	}
+
 	return recv, nil
 }
 
@@ -108,5 +113,12 @@ func createDefaultConfig() component.Config {
 			Enabled:  defaultLogsEnabled,
 			Projects: []*ProjectConfig{},
 		},
+		Events: EventsConfig{
+			Enabled:      defaultEventsEnabled,
+			Projects:     []*ProjectConfig{},
+			PollInterval: defaultEventsPollInterval,
+			PageSize:     defaultEventsPageSize,
+			MaxPages:     defaultEventsMaxPages,
+		},
 	}
 }
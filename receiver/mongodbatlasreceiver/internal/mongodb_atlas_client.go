@@ -648,6 +648,40 @@ func (s *MongoDBAtlasClient) GetAlerts(ctx context.Context, groupID string, opts
 	return alerts.Results, hasNext(response.Links), nil
 }
 
+// EventsPollOptions are the options used to poll the events of a project
+type EventsPollOptions struct {
+	PageNum  int
+	PageSize int
+	// MinDate is the earliest creation time of the returned events, ignored if zero
+	MinDate time.Time
+	// MaxDate is the latest creation time of the returned events, ignored if zero
+	MaxDate time.Time
+}
+
+// GetProjectEvents returns a page of the events of the given project, most recent first, along with the total
+// number of events matching the options
+func (s *MongoDBAtlasClient) GetProjectEvents(ctx context.Context, groupID string, opts *EventsPollOptions) (ret []*mongodbatlas.Event, totalCount int, err error) {
+	options := mongodbatlas.EventListOptions{
+		ListOptions: mongodbatlas.ListOptions{
+			PageNum:      opts.PageNum,
+			ItemsPerPage: opts.PageSize,
+		},
+	}
+	if !opts.MinDate.IsZero() {
+		options.MinDate = opts.MinDate.UTC().Format(time.RFC3339)
+	}
+	if !opts.MaxDate.IsZero() {
+		options.MaxDate = opts.MaxDate.UTC().Format(time.RFC3339)
+	}
+
+	events, response, err := s.client.Events.ListProjectEvents(ctx, groupID, &options)
+	err = checkMongoDBClientErr(err, response)
+	if err != nil {
+		return nil, 0, err
+	}
+	return events.Results, events.TotalCount, nil
+}
+
 func toUnixString(t time.Time) string {
 	return strconv.Itoa(int(t.Unix()))
 }
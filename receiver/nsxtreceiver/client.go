@@ -38,6 +38,10 @@ type Client interface {
 	NodeStatus(ctx context.Context, nodeID string, class nodeClass) (*dm.NodeStatus, error)
 	Interfaces(ctx context.Context, nodeID string, class nodeClass) ([]dm.NetworkInterface, error)
 	InterfaceStatus(ctx context.Context, nodeID, interfaceID string, class nodeClass) (*dm.NetworkInterfaceStats, error)
+	FirewallSections(ctx context.Context) ([]dm.FirewallSection, error)
+	FirewallRuleStats(ctx context.Context, sectionID string) ([]dm.FirewallStats, error)
+	LoadBalancerServices(ctx context.Context) ([]dm.LoadBalancerService, error)
+	LoadBalancerPoolStatus(ctx context.Context, serviceID string) ([]dm.LoadBalancerPoolStatus, error)
 }
 
 type nsxClient struct {
@@ -155,6 +159,58 @@ func (c *nsxClient) InterfaceStatus(
 	return &interfaceStats, err
 }
 
+func (c *nsxClient) FirewallSections(ctx context.Context) ([]dm.FirewallSection, error) {
+	body, err := c.doRequest(
+		ctx,
+		"/api/v1/firewall/sections",
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get firewall sections: %w", err)
+	}
+	var sections dm.FirewallSectionList
+	err = json.Unmarshal(body, &sections)
+	return sections.Results, err
+}
+
+func (c *nsxClient) FirewallRuleStats(ctx context.Context, sectionID string) ([]dm.FirewallStats, error) {
+	body, err := c.doRequest(
+		ctx,
+		fmt.Sprintf("/api/v1/firewall/sections/%s/rules/stats", sectionID),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get firewall rule stats: %w", err)
+	}
+	var stats dm.FirewallStatsList
+	err = json.Unmarshal(body, &stats)
+	return stats.Results, err
+}
+
+func (c *nsxClient) LoadBalancerServices(ctx context.Context) ([]dm.LoadBalancerService, error) {
+	body, err := c.doRequest(
+		ctx,
+		"/api/v1/loadbalancer/services",
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get load balancer services: %w", err)
+	}
+	var services dm.LoadBalancerServiceList
+	err = json.Unmarshal(body, &services)
+	return services.Results, err
+}
+
+func (c *nsxClient) LoadBalancerPoolStatus(ctx context.Context, serviceID string) ([]dm.LoadBalancerPoolStatus, error) {
+	body, err := c.doRequest(
+		ctx,
+		fmt.Sprintf("/api/v1/loadbalancer/services/%s/pools/status", serviceID),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get load balancer pool status: %w", err)
+	}
+	var pools dm.LoadBalancerPoolStatusList
+	err = json.Unmarshal(body, &pools)
+	return pools.Results, err
+}
+
 func (c *nsxClient) doRequest(ctx context.Context, path string) ([]byte, error) {
 	endpoint, err := c.endpoint.Parse(path)
 	if err != nil {
@@ -31,6 +31,8 @@ const (
 	managerNode1      = "b7a79908-9808-4c9e-bb49-b70008993fcb"
 	managerNodeNic1   = "eth0"
 	managerNodeNic2   = "lo"
+	firewallSection1  = "0c2e6f5f-8a2e-4b3c-9d0e-3a6b1f4c7d21"
+	lbService1        = "4b1d9e2a-6c3f-4e8a-b7d5-1f2a3c4d5e6f"
 )
 
 // MockClient is an autogenerated mock type for the MockClient type
@@ -59,6 +61,48 @@ func (m *MockClient) ClusterNodes(ctx context.Context) ([]model.ClusterNode, err
 	return r0, r1
 }
 
+// FirewallRuleStats provides a mock function with given fields: ctx, sectionID
+func (m *MockClient) FirewallRuleStats(ctx context.Context, sectionID string) ([]model.FirewallStats, error) {
+	ret := m.Called(ctx, sectionID)
+
+	var r0 []model.FirewallStats
+	if rf, ok := ret.Get(0).(func(context.Context, string) []model.FirewallStats); ok {
+		r0 = rf(ctx, sectionID)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]model.FirewallStats)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, sectionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FirewallSections provides a mock function with given fields: ctx
+func (m *MockClient) FirewallSections(ctx context.Context) ([]model.FirewallSection, error) {
+	ret := m.Called(ctx)
+
+	var r0 []model.FirewallSection
+	if rf, ok := ret.Get(0).(func(context.Context) []model.FirewallSection); ok {
+		r0 = rf(ctx)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]model.FirewallSection)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // InterfaceStatus provides a mock function with given fields: ctx, nodeID, interfaceID, class
 func (m *MockClient) InterfaceStatus(ctx context.Context, nodeID string, interfaceID string, class nodeClass) (*model.NetworkInterfaceStats, error) {
 	ret := m.Called(ctx, nodeID, interfaceID, class)
@@ -101,6 +145,48 @@ func (m *MockClient) Interfaces(ctx context.Context, nodeID string, class nodeCl
 	return r0, r1
 }
 
+// LoadBalancerPoolStatus provides a mock function with given fields: ctx, serviceID
+func (m *MockClient) LoadBalancerPoolStatus(ctx context.Context, serviceID string) ([]model.LoadBalancerPoolStatus, error) {
+	ret := m.Called(ctx, serviceID)
+
+	var r0 []model.LoadBalancerPoolStatus
+	if rf, ok := ret.Get(0).(func(context.Context, string) []model.LoadBalancerPoolStatus); ok {
+		r0 = rf(ctx, serviceID)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]model.LoadBalancerPoolStatus)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, serviceID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LoadBalancerServices provides a mock function with given fields: ctx
+func (m *MockClient) LoadBalancerServices(ctx context.Context) ([]model.LoadBalancerService, error) {
+	ret := m.Called(ctx)
+
+	var r0 []model.LoadBalancerService
+	if rf, ok := ret.Get(0).(func(context.Context) []model.LoadBalancerService); ok {
+		r0 = rf(ctx)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]model.LoadBalancerService)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NodeStatus provides a mock function with given fields: ctx, nodeID, class
 func (m *MockClient) NodeStatus(ctx context.Context, nodeID string, class nodeClass) (*model.NodeStatus, error) {
 	ret := m.Called(ctx, nodeID, class)
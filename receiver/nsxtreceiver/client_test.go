@@ -149,6 +149,62 @@ func TestManagerNodeInterfaceStatus(t *testing.T) {
 	require.NotZero(t, iStats.RxBytes)
 }
 
+func TestFirewallSections(t *testing.T) {
+	nsxMock := mockServer(t)
+	client, err := newClient(&Config{
+		HTTPClientSettings: confighttp.HTTPClientSettings{
+			Endpoint: nsxMock.URL,
+		},
+	}, componenttest.NewNopTelemetrySettings(), componenttest.NewNopHost(), zap.NewNop())
+	require.NoError(t, err)
+	sections, err := client.FirewallSections(context.Background())
+	require.NoError(t, err)
+	require.Len(t, sections, 1)
+	require.Equal(t, firewallSection1, sections[0].ID)
+}
+
+func TestFirewallRuleStats(t *testing.T) {
+	nsxMock := mockServer(t)
+	client, err := newClient(&Config{
+		HTTPClientSettings: confighttp.HTTPClientSettings{
+			Endpoint: nsxMock.URL,
+		},
+	}, componenttest.NewNopTelemetrySettings(), componenttest.NewNopHost(), zap.NewNop())
+	require.NoError(t, err)
+	stats, err := client.FirewallRuleStats(context.Background(), firewallSection1)
+	require.NoError(t, err)
+	require.Len(t, stats, 2)
+	require.EqualValues(t, 867, stats[0].HitCount)
+}
+
+func TestLoadBalancerServices(t *testing.T) {
+	nsxMock := mockServer(t)
+	client, err := newClient(&Config{
+		HTTPClientSettings: confighttp.HTTPClientSettings{
+			Endpoint: nsxMock.URL,
+		},
+	}, componenttest.NewNopTelemetrySettings(), componenttest.NewNopHost(), zap.NewNop())
+	require.NoError(t, err)
+	services, err := client.LoadBalancerServices(context.Background())
+	require.NoError(t, err)
+	require.Len(t, services, 1)
+	require.Equal(t, lbService1, services[0].ID)
+}
+
+func TestLoadBalancerPoolStatus(t *testing.T) {
+	nsxMock := mockServer(t)
+	client, err := newClient(&Config{
+		HTTPClientSettings: confighttp.HTTPClientSettings{
+			Endpoint: nsxMock.URL,
+		},
+	}, componenttest.NewNopTelemetrySettings(), componenttest.NewNopHost(), zap.NewNop())
+	require.NoError(t, err)
+	pools, err := client.LoadBalancerPoolStatus(context.Background(), lbService1)
+	require.NoError(t, err)
+	require.Len(t, pools, 1)
+	require.Len(t, pools[0].Members, 4)
+}
+
 func TestDoRequestBadUrl(t *testing.T) {
 	nsxMock := mockServer(t)
 	client, err := newClient(&Config{
@@ -273,6 +329,18 @@ func mockServer(t *testing.T) *httptest.Server {
 	mNodeInterfaceStats, err := os.ReadFile(filepath.Join("testdata", "metrics", "nodes", "cluster", managerNode1, "interfaces", managerNodeNic1, "stats.json"))
 	require.NoError(t, err)
 
+	firewallSections, err := os.ReadFile(filepath.Join("testdata", "metrics", "firewall", "sections.json"))
+	require.NoError(t, err)
+
+	firewallRuleStats, err := os.ReadFile(filepath.Join("testdata", "metrics", "firewall", "sections", firewallSection1, "stats.json"))
+	require.NoError(t, err)
+
+	lbServices, err := os.ReadFile(filepath.Join("testdata", "metrics", "loadbalancer", "services.json"))
+	require.NoError(t, err)
+
+	lbPoolStatus, err := os.ReadFile(filepath.Join("testdata", "metrics", "loadbalancer", "services", lbService1, "pools.json"))
+	require.NoError(t, err)
+
 	nsxMock := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
 		authUser, authPass, ok := req.BasicAuth()
 		switch {
@@ -343,6 +411,34 @@ func mockServer(t *testing.T) *httptest.Server {
 			return
 		}
 
+		if req.URL.Path == "/api/v1/firewall/sections" {
+			rw.WriteHeader(200)
+			_, err = rw.Write(firewallSections)
+			require.NoError(t, err)
+			return
+		}
+
+		if req.URL.Path == fmt.Sprintf("/api/v1/firewall/sections/%s/rules/stats", firewallSection1) {
+			rw.WriteHeader(200)
+			_, err = rw.Write(firewallRuleStats)
+			require.NoError(t, err)
+			return
+		}
+
+		if req.URL.Path == "/api/v1/loadbalancer/services" {
+			rw.WriteHeader(200)
+			_, err = rw.Write(lbServices)
+			require.NoError(t, err)
+			return
+		}
+
+		if req.URL.Path == fmt.Sprintf("/api/v1/loadbalancer/services/%s/pools/status", lbService1) {
+			rw.WriteHeader(200)
+			_, err = rw.Write(lbPoolStatus)
+			require.NoError(t, err)
+			return
+		}
+
 		rw.WriteHeader(404)
 	}))
 
@@ -33,17 +33,25 @@ func (ms *MetricSettings) Unmarshal(parser *confmap.Conf) error {
 
 // MetricsSettings provides settings for nsxtreceiver metrics.
 type MetricsSettings struct {
-	NsxtNodeCPUUtilization        MetricSettings `mapstructure:"nsxt.node.cpu.utilization"`
-	NsxtNodeFilesystemUsage       MetricSettings `mapstructure:"nsxt.node.filesystem.usage"`
-	NsxtNodeFilesystemUtilization MetricSettings `mapstructure:"nsxt.node.filesystem.utilization"`
-	NsxtNodeMemoryCacheUsage      MetricSettings `mapstructure:"nsxt.node.memory.cache.usage"`
-	NsxtNodeMemoryUsage           MetricSettings `mapstructure:"nsxt.node.memory.usage"`
-	NsxtNodeNetworkIo             MetricSettings `mapstructure:"nsxt.node.network.io"`
-	NsxtNodeNetworkPacketCount    MetricSettings `mapstructure:"nsxt.node.network.packet.count"`
+	NsxtFirewallRuleHitCount        MetricSettings `mapstructure:"nsxt.firewall.rule.hit.count"`
+	NsxtLoadbalancerPoolMemberCount MetricSettings `mapstructure:"nsxt.loadbalancer.pool.member.count"`
+	NsxtNodeCPUUtilization          MetricSettings `mapstructure:"nsxt.node.cpu.utilization"`
+	NsxtNodeFilesystemUsage         MetricSettings `mapstructure:"nsxt.node.filesystem.usage"`
+	NsxtNodeFilesystemUtilization   MetricSettings `mapstructure:"nsxt.node.filesystem.utilization"`
+	NsxtNodeMemoryCacheUsage        MetricSettings `mapstructure:"nsxt.node.memory.cache.usage"`
+	NsxtNodeMemoryUsage             MetricSettings `mapstructure:"nsxt.node.memory.usage"`
+	NsxtNodeNetworkIo               MetricSettings `mapstructure:"nsxt.node.network.io"`
+	NsxtNodeNetworkPacketCount      MetricSettings `mapstructure:"nsxt.node.network.packet.count"`
 }
 
 func DefaultMetricsSettings() MetricsSettings {
 	return MetricsSettings{
+		NsxtFirewallRuleHitCount: MetricSettings{
+			Enabled: false,
+		},
+		NsxtLoadbalancerPoolMemberCount: MetricSettings{
+			Enabled: false,
+		},
 		NsxtNodeCPUUtilization: MetricSettings{
 			Enabled: true,
 		},
@@ -89,10 +97,14 @@ func (ras *ResourceAttributeSettings) Unmarshal(parser *confmap.Conf) error {
 
 // ResourceAttributesSettings provides settings for nsxtreceiver metrics.
 type ResourceAttributesSettings struct {
-	DeviceID     ResourceAttributeSettings `mapstructure:"device.id"`
-	NsxtNodeID   ResourceAttributeSettings `mapstructure:"nsxt.node.id"`
-	NsxtNodeName ResourceAttributeSettings `mapstructure:"nsxt.node.name"`
-	NsxtNodeType ResourceAttributeSettings `mapstructure:"nsxt.node.type"`
+	DeviceID                    ResourceAttributeSettings `mapstructure:"device.id"`
+	NsxtFirewallSectionID       ResourceAttributeSettings `mapstructure:"nsxt.firewall.section.id"`
+	NsxtFirewallSectionName     ResourceAttributeSettings `mapstructure:"nsxt.firewall.section.name"`
+	NsxtLoadbalancerServiceID   ResourceAttributeSettings `mapstructure:"nsxt.loadbalancer.service.id"`
+	NsxtLoadbalancerServiceName ResourceAttributeSettings `mapstructure:"nsxt.loadbalancer.service.name"`
+	NsxtNodeID                  ResourceAttributeSettings `mapstructure:"nsxt.node.id"`
+	NsxtNodeName                ResourceAttributeSettings `mapstructure:"nsxt.node.name"`
+	NsxtNodeType                ResourceAttributeSettings `mapstructure:"nsxt.node.type"`
 }
 
 func DefaultResourceAttributesSettings() ResourceAttributesSettings {
@@ -100,6 +112,18 @@ func DefaultResourceAttributesSettings() ResourceAttributesSettings {
 		DeviceID: ResourceAttributeSettings{
 			Enabled: true,
 		},
+		NsxtFirewallSectionID: ResourceAttributeSettings{
+			Enabled: true,
+		},
+		NsxtFirewallSectionName: ResourceAttributeSettings{
+			Enabled: true,
+		},
+		NsxtLoadbalancerServiceID: ResourceAttributeSettings{
+			Enabled: true,
+		},
+		NsxtLoadbalancerServiceName: ResourceAttributeSettings{
+			Enabled: true,
+		},
 		NsxtNodeID: ResourceAttributeSettings{
 			Enabled: true,
 		},
@@ -190,6 +214,44 @@ var MapAttributeDiskState = map[string]AttributeDiskState{
 	"available": AttributeDiskStateAvailable,
 }
 
+// AttributeMemberStatus specifies the a value member.status attribute.
+type AttributeMemberStatus int
+
+const (
+	_ AttributeMemberStatus = iota
+	AttributeMemberStatusUp
+	AttributeMemberStatusDown
+	AttributeMemberStatusDisabled
+	AttributeMemberStatusGracefulDisabled
+	AttributeMemberStatusUnused
+)
+
+// String returns the string representation of the AttributeMemberStatus.
+func (av AttributeMemberStatus) String() string {
+	switch av {
+	case AttributeMemberStatusUp:
+		return "up"
+	case AttributeMemberStatusDown:
+		return "down"
+	case AttributeMemberStatusDisabled:
+		return "disabled"
+	case AttributeMemberStatusGracefulDisabled:
+		return "graceful_disabled"
+	case AttributeMemberStatusUnused:
+		return "unused"
+	}
+	return ""
+}
+
+// MapAttributeMemberStatus is a helper map of string to AttributeMemberStatus attribute value.
+var MapAttributeMemberStatus = map[string]AttributeMemberStatus{
+	"up":                AttributeMemberStatusUp,
+	"down":              AttributeMemberStatusDown,
+	"disabled":          AttributeMemberStatusDisabled,
+	"graceful_disabled": AttributeMemberStatusGracefulDisabled,
+	"unused":            AttributeMemberStatusUnused,
+}
+
 // AttributePacketType specifies the a value packet.type attribute.
 type AttributePacketType int
 
@@ -220,6 +282,113 @@ var MapAttributePacketType = map[string]AttributePacketType{
 	"success": AttributePacketTypeSuccess,
 }
 
+type metricNsxtFirewallRuleHitCount struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills nsxt.firewall.rule.hit.count metric with initial data.
+func (m *metricNsxtFirewallRuleHitCount) init() {
+	m.data.SetName("nsxt.firewall.rule.hit.count")
+	m.data.SetDescription("The number of times the distributed firewall rule has been hit.")
+	m.data.SetUnit("{hits}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricNsxtFirewallRuleHitCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, ruleIDAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("rule.id", ruleIDAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricNsxtFirewallRuleHitCount) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricNsxtFirewallRuleHitCount) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricNsxtFirewallRuleHitCount(settings MetricSettings) metricNsxtFirewallRuleHitCount {
+	m := metricNsxtFirewallRuleHitCount{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricNsxtLoadbalancerPoolMemberCount struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills nsxt.loadbalancer.pool.member.count metric with initial data.
+func (m *metricNsxtLoadbalancerPoolMemberCount) init() {
+	m.data.SetName("nsxt.loadbalancer.pool.member.count")
+	m.data.SetDescription("The number of load balancer pool members by health status.")
+	m.data.SetUnit("{members}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricNsxtLoadbalancerPoolMemberCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, poolIDAttributeValue string, memberStatusAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("pool.id", poolIDAttributeValue)
+	dp.Attributes().PutStr("status", memberStatusAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricNsxtLoadbalancerPoolMemberCount) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricNsxtLoadbalancerPoolMemberCount) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricNsxtLoadbalancerPoolMemberCount(settings MetricSettings) metricNsxtLoadbalancerPoolMemberCount {
+	m := metricNsxtLoadbalancerPoolMemberCount{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricNsxtNodeCPUUtilization struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
@@ -585,19 +754,21 @@ func newMetricNsxtNodeNetworkPacketCount(settings MetricSettings) metricNsxtNode
 // MetricsBuilder provides an interface for scrapers to report metrics while taking care of all the transformations
 // required to produce metric representation defined in metadata and user settings.
 type MetricsBuilder struct {
-	startTime                           pcommon.Timestamp   // start time that will be applied to all recorded data points.
-	metricsCapacity                     int                 // maximum observed number of metrics per resource.
-	resourceCapacity                    int                 // maximum observed number of resource attributes.
-	metricsBuffer                       pmetric.Metrics     // accumulates metrics data before emitting.
-	buildInfo                           component.BuildInfo // contains version information
-	resourceAttributesSettings          ResourceAttributesSettings
-	metricNsxtNodeCPUUtilization        metricNsxtNodeCPUUtilization
-	metricNsxtNodeFilesystemUsage       metricNsxtNodeFilesystemUsage
-	metricNsxtNodeFilesystemUtilization metricNsxtNodeFilesystemUtilization
-	metricNsxtNodeMemoryCacheUsage      metricNsxtNodeMemoryCacheUsage
-	metricNsxtNodeMemoryUsage           metricNsxtNodeMemoryUsage
-	metricNsxtNodeNetworkIo             metricNsxtNodeNetworkIo
-	metricNsxtNodeNetworkPacketCount    metricNsxtNodeNetworkPacketCount
+	startTime                             pcommon.Timestamp   // start time that will be applied to all recorded data points.
+	metricsCapacity                       int                 // maximum observed number of metrics per resource.
+	resourceCapacity                      int                 // maximum observed number of resource attributes.
+	metricsBuffer                         pmetric.Metrics     // accumulates metrics data before emitting.
+	buildInfo                             component.BuildInfo // contains version information
+	resourceAttributesSettings            ResourceAttributesSettings
+	metricNsxtFirewallRuleHitCount        metricNsxtFirewallRuleHitCount
+	metricNsxtLoadbalancerPoolMemberCount metricNsxtLoadbalancerPoolMemberCount
+	metricNsxtNodeCPUUtilization          metricNsxtNodeCPUUtilization
+	metricNsxtNodeFilesystemUsage         metricNsxtNodeFilesystemUsage
+	metricNsxtNodeFilesystemUtilization   metricNsxtNodeFilesystemUtilization
+	metricNsxtNodeMemoryCacheUsage        metricNsxtNodeMemoryCacheUsage
+	metricNsxtNodeMemoryUsage             metricNsxtNodeMemoryUsage
+	metricNsxtNodeNetworkIo               metricNsxtNodeNetworkIo
+	metricNsxtNodeNetworkPacketCount      metricNsxtNodeNetworkPacketCount
 }
 
 // metricBuilderOption applies changes to default metrics builder.
@@ -619,17 +790,19 @@ func WithResourceAttributesSettings(ras ResourceAttributesSettings) metricBuilde
 
 func NewMetricsBuilder(ms MetricsSettings, settings receiver.CreateSettings, options ...metricBuilderOption) *MetricsBuilder {
 	mb := &MetricsBuilder{
-		startTime:                           pcommon.NewTimestampFromTime(time.Now()),
-		metricsBuffer:                       pmetric.NewMetrics(),
-		buildInfo:                           settings.BuildInfo,
-		resourceAttributesSettings:          DefaultResourceAttributesSettings(),
-		metricNsxtNodeCPUUtilization:        newMetricNsxtNodeCPUUtilization(ms.NsxtNodeCPUUtilization),
-		metricNsxtNodeFilesystemUsage:       newMetricNsxtNodeFilesystemUsage(ms.NsxtNodeFilesystemUsage),
-		metricNsxtNodeFilesystemUtilization: newMetricNsxtNodeFilesystemUtilization(ms.NsxtNodeFilesystemUtilization),
-		metricNsxtNodeMemoryCacheUsage:      newMetricNsxtNodeMemoryCacheUsage(ms.NsxtNodeMemoryCacheUsage),
-		metricNsxtNodeMemoryUsage:           newMetricNsxtNodeMemoryUsage(ms.NsxtNodeMemoryUsage),
-		metricNsxtNodeNetworkIo:             newMetricNsxtNodeNetworkIo(ms.NsxtNodeNetworkIo),
-		metricNsxtNodeNetworkPacketCount:    newMetricNsxtNodeNetworkPacketCount(ms.NsxtNodeNetworkPacketCount),
+		startTime:                             pcommon.NewTimestampFromTime(time.Now()),
+		metricsBuffer:                         pmetric.NewMetrics(),
+		buildInfo:                             settings.BuildInfo,
+		resourceAttributesSettings:            DefaultResourceAttributesSettings(),
+		metricNsxtFirewallRuleHitCount:        newMetricNsxtFirewallRuleHitCount(ms.NsxtFirewallRuleHitCount),
+		metricNsxtLoadbalancerPoolMemberCount: newMetricNsxtLoadbalancerPoolMemberCount(ms.NsxtLoadbalancerPoolMemberCount),
+		metricNsxtNodeCPUUtilization:          newMetricNsxtNodeCPUUtilization(ms.NsxtNodeCPUUtilization),
+		metricNsxtNodeFilesystemUsage:         newMetricNsxtNodeFilesystemUsage(ms.NsxtNodeFilesystemUsage),
+		metricNsxtNodeFilesystemUtilization:   newMetricNsxtNodeFilesystemUtilization(ms.NsxtNodeFilesystemUtilization),
+		metricNsxtNodeMemoryCacheUsage:        newMetricNsxtNodeMemoryCacheUsage(ms.NsxtNodeMemoryCacheUsage),
+		metricNsxtNodeMemoryUsage:             newMetricNsxtNodeMemoryUsage(ms.NsxtNodeMemoryUsage),
+		metricNsxtNodeNetworkIo:               newMetricNsxtNodeNetworkIo(ms.NsxtNodeNetworkIo),
+		metricNsxtNodeNetworkPacketCount:      newMetricNsxtNodeNetworkPacketCount(ms.NsxtNodeNetworkPacketCount),
 	}
 	for _, op := range options {
 		op(mb)
@@ -659,6 +832,42 @@ func WithDeviceID(val string) ResourceMetricsOption {
 	}
 }
 
+// WithNsxtFirewallSectionID sets provided value as "nsxt.firewall.section.id" attribute for current resource.
+func WithNsxtFirewallSectionID(val string) ResourceMetricsOption {
+	return func(ras ResourceAttributesSettings, rm pmetric.ResourceMetrics) {
+		if ras.NsxtFirewallSectionID.Enabled {
+			rm.Resource().Attributes().PutStr("nsxt.firewall.section.id", val)
+		}
+	}
+}
+
+// WithNsxtFirewallSectionName sets provided value as "nsxt.firewall.section.name" attribute for current resource.
+func WithNsxtFirewallSectionName(val string) ResourceMetricsOption {
+	return func(ras ResourceAttributesSettings, rm pmetric.ResourceMetrics) {
+		if ras.NsxtFirewallSectionName.Enabled {
+			rm.Resource().Attributes().PutStr("nsxt.firewall.section.name", val)
+		}
+	}
+}
+
+// WithNsxtLoadbalancerServiceID sets provided value as "nsxt.loadbalancer.service.id" attribute for current resource.
+func WithNsxtLoadbalancerServiceID(val string) ResourceMetricsOption {
+	return func(ras ResourceAttributesSettings, rm pmetric.ResourceMetrics) {
+		if ras.NsxtLoadbalancerServiceID.Enabled {
+			rm.Resource().Attributes().PutStr("nsxt.loadbalancer.service.id", val)
+		}
+	}
+}
+
+// WithNsxtLoadbalancerServiceName sets provided value as "nsxt.loadbalancer.service.name" attribute for current resource.
+func WithNsxtLoadbalancerServiceName(val string) ResourceMetricsOption {
+	return func(ras ResourceAttributesSettings, rm pmetric.ResourceMetrics) {
+		if ras.NsxtLoadbalancerServiceName.Enabled {
+			rm.Resource().Attributes().PutStr("nsxt.loadbalancer.service.name", val)
+		}
+	}
+}
+
 // WithNsxtNodeID sets provided value as "nsxt.node.id" attribute for current resource.
 func WithNsxtNodeID(val string) ResourceMetricsOption {
 	return func(ras ResourceAttributesSettings, rm pmetric.ResourceMetrics) {
@@ -718,6 +927,8 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	ils.Scope().SetName("otelcol/nsxtreceiver")
 	ils.Scope().SetVersion(mb.buildInfo.Version)
 	ils.Metrics().EnsureCapacity(mb.metricsCapacity)
+	mb.metricNsxtFirewallRuleHitCount.emit(ils.Metrics())
+	mb.metricNsxtLoadbalancerPoolMemberCount.emit(ils.Metrics())
 	mb.metricNsxtNodeCPUUtilization.emit(ils.Metrics())
 	mb.metricNsxtNodeFilesystemUsage.emit(ils.Metrics())
 	mb.metricNsxtNodeFilesystemUtilization.emit(ils.Metrics())
@@ -745,6 +956,16 @@ func (mb *MetricsBuilder) Emit(rmo ...ResourceMetricsOption) pmetric.Metrics {
 	return metrics
 }
 
+// RecordNsxtFirewallRuleHitCountDataPoint adds a data point to nsxt.firewall.rule.hit.count metric.
+func (mb *MetricsBuilder) RecordNsxtFirewallRuleHitCountDataPoint(ts pcommon.Timestamp, val int64, ruleIDAttributeValue string) {
+	mb.metricNsxtFirewallRuleHitCount.recordDataPoint(mb.startTime, ts, val, ruleIDAttributeValue)
+}
+
+// RecordNsxtLoadbalancerPoolMemberCountDataPoint adds a data point to nsxt.loadbalancer.pool.member.count metric.
+func (mb *MetricsBuilder) RecordNsxtLoadbalancerPoolMemberCountDataPoint(ts pcommon.Timestamp, val int64, poolIDAttributeValue string, memberStatusAttributeValue AttributeMemberStatus) {
+	mb.metricNsxtLoadbalancerPoolMemberCount.recordDataPoint(mb.startTime, ts, val, poolIDAttributeValue, memberStatusAttributeValue.String())
+}
+
 // RecordNsxtNodeCPUUtilizationDataPoint adds a data point to nsxt.node.cpu.utilization metric.
 func (mb *MetricsBuilder) RecordNsxtNodeCPUUtilizationDataPoint(ts pcommon.Timestamp, val float64, classAttributeValue AttributeClass) {
 	mb.metricNsxtNodeCPUUtilization.recordDataPoint(mb.startTime, ts, val, classAttributeValue.String())
@@ -58,6 +58,12 @@ func TestMetricsBuilder(t *testing.T) {
 			defaultMetricsCount := 0
 			allMetricsCount := 0
 
+			allMetricsCount++
+			mb.RecordNsxtFirewallRuleHitCountDataPoint(ts, 1, "attr-val")
+
+			allMetricsCount++
+			mb.RecordNsxtLoadbalancerPoolMemberCountDataPoint(ts, 1, "attr-val", AttributeMemberStatus(1))
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordNsxtNodeCPUUtilizationDataPoint(ts, 1, AttributeClass(1))
@@ -86,7 +92,7 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordNsxtNodeNetworkPacketCountDataPoint(ts, 1, AttributeDirection(1), AttributePacketType(1))
 
-			metrics := mb.Emit(WithDeviceID("attr-val"), WithNsxtNodeID("attr-val"), WithNsxtNodeName("attr-val"), WithNsxtNodeType("attr-val"))
+			metrics := mb.Emit(WithDeviceID("attr-val"), WithNsxtFirewallSectionID("attr-val"), WithNsxtFirewallSectionName("attr-val"), WithNsxtLoadbalancerServiceID("attr-val"), WithNsxtLoadbalancerServiceName("attr-val"), WithNsxtNodeID("attr-val"), WithNsxtNodeName("attr-val"), WithNsxtNodeType("attr-val"))
 
 			if test.metricsSet == testMetricsSetNo {
 				assert.Equal(t, 0, metrics.ResourceMetrics().Len())
@@ -104,6 +110,34 @@ func TestMetricsBuilder(t *testing.T) {
 				enabledAttrCount++
 				assert.EqualValues(t, "attr-val", attrVal.Str())
 			}
+			attrVal, ok = rm.Resource().Attributes().Get("nsxt.firewall.section.id")
+			attrCount++
+			assert.Equal(t, mb.resourceAttributesSettings.NsxtFirewallSectionID.Enabled, ok)
+			if mb.resourceAttributesSettings.NsxtFirewallSectionID.Enabled {
+				enabledAttrCount++
+				assert.EqualValues(t, "attr-val", attrVal.Str())
+			}
+			attrVal, ok = rm.Resource().Attributes().Get("nsxt.firewall.section.name")
+			attrCount++
+			assert.Equal(t, mb.resourceAttributesSettings.NsxtFirewallSectionName.Enabled, ok)
+			if mb.resourceAttributesSettings.NsxtFirewallSectionName.Enabled {
+				enabledAttrCount++
+				assert.EqualValues(t, "attr-val", attrVal.Str())
+			}
+			attrVal, ok = rm.Resource().Attributes().Get("nsxt.loadbalancer.service.id")
+			attrCount++
+			assert.Equal(t, mb.resourceAttributesSettings.NsxtLoadbalancerServiceID.Enabled, ok)
+			if mb.resourceAttributesSettings.NsxtLoadbalancerServiceID.Enabled {
+				enabledAttrCount++
+				assert.EqualValues(t, "attr-val", attrVal.Str())
+			}
+			attrVal, ok = rm.Resource().Attributes().Get("nsxt.loadbalancer.service.name")
+			attrCount++
+			assert.Equal(t, mb.resourceAttributesSettings.NsxtLoadbalancerServiceName.Enabled, ok)
+			if mb.resourceAttributesSettings.NsxtLoadbalancerServiceName.Enabled {
+				enabledAttrCount++
+				assert.EqualValues(t, "attr-val", attrVal.Str())
+			}
 			attrVal, ok = rm.Resource().Attributes().Get("nsxt.node.id")
 			attrCount++
 			assert.Equal(t, mb.resourceAttributesSettings.NsxtNodeID.Enabled, ok)
@@ -126,7 +160,7 @@ func TestMetricsBuilder(t *testing.T) {
 				assert.EqualValues(t, "attr-val", attrVal.Str())
 			}
 			assert.Equal(t, enabledAttrCount, rm.Resource().Attributes().Len())
-			assert.Equal(t, attrCount, 4)
+			assert.Equal(t, attrCount, 8)
 
 			assert.Equal(t, 1, rm.ScopeMetrics().Len())
 			ms := rm.ScopeMetrics().At(0).Metrics()
@@ -139,6 +173,43 @@ func TestMetricsBuilder(t *testing.T) {
 			validatedMetrics := make(map[string]bool)
 			for i := 0; i < ms.Len(); i++ {
 				switch ms.At(i).Name() {
+				case "nsxt.firewall.rule.hit.count":
+					assert.False(t, validatedMetrics["nsxt.firewall.rule.hit.count"], "Found a duplicate in the metrics slice: nsxt.firewall.rule.hit.count")
+					validatedMetrics["nsxt.firewall.rule.hit.count"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "The number of times the distributed firewall rule has been hit.", ms.At(i).Description())
+					assert.Equal(t, "{hits}", ms.At(i).Unit())
+					assert.Equal(t, true, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("rule.id")
+					assert.True(t, ok)
+					assert.EqualValues(t, "attr-val", attrVal.Str())
+				case "nsxt.loadbalancer.pool.member.count":
+					assert.False(t, validatedMetrics["nsxt.loadbalancer.pool.member.count"], "Found a duplicate in the metrics slice: nsxt.loadbalancer.pool.member.count")
+					validatedMetrics["nsxt.loadbalancer.pool.member.count"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "The number of load balancer pool members by health status.", ms.At(i).Description())
+					assert.Equal(t, "{members}", ms.At(i).Unit())
+					assert.Equal(t, false, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("pool.id")
+					assert.True(t, ok)
+					assert.EqualValues(t, "attr-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("status")
+					assert.True(t, ok)
+					assert.Equal(t, "up", attrVal.Str())
 				case "nsxt.node.cpu.utilization":
 					assert.False(t, validatedMetrics["nsxt.node.cpu.utilization"], "Found a duplicate in the metrics slice: nsxt.node.cpu.utilization")
 					validatedMetrics["nsxt.node.cpu.utilization"] = true
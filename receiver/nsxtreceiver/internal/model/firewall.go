@@ -0,0 +1,43 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/nsxtreceiver/internal/model"
+
+// FirewallSectionList contains the results of the distributed firewall sections
+type FirewallSectionList struct {
+	Results []FirewallSection `json:"results"`
+}
+
+// FirewallSection is a section of distributed firewall rules
+type FirewallSection struct {
+	ID          string `json:"id"`
+	DisplayName string `json:"display_name"`
+	SectionType string `json:"section_type"`
+	RuleCount   int64  `json:"rule_count"`
+}
+
+// FirewallStatsList contains the statistics of the rules within a firewall section
+type FirewallStatsList struct {
+	SectionID string          `json:"section_id"`
+	Results   []FirewallStats `json:"results"`
+}
+
+// FirewallStats are the statistics of a single distributed firewall rule
+type FirewallStats struct {
+	RuleID       string `json:"rule_id"`
+	HitCount     int64  `json:"hit_count"`
+	PacketCount  int64  `json:"packet_count"`
+	ByteCount    int64  `json:"byte_count"`
+	SessionCount int64  `json:"session_count"`
+}
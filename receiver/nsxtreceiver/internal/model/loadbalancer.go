@@ -0,0 +1,46 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/nsxtreceiver/internal/model"
+
+// LoadBalancerServiceList contains the results of the load balancer services
+type LoadBalancerServiceList struct {
+	Results []LoadBalancerService `json:"results"`
+}
+
+// LoadBalancerService is a load balancer service configured in NSX
+type LoadBalancerService struct {
+	ID          string `json:"id"`
+	DisplayName string `json:"display_name"`
+	Enabled     bool   `json:"enabled"`
+}
+
+// LoadBalancerPoolStatusList contains the status of the pools of a load balancer service
+type LoadBalancerPoolStatusList struct {
+	Results []LoadBalancerPoolStatus `json:"results"`
+}
+
+// LoadBalancerPoolStatus is the health status of a load balancer pool and its members
+type LoadBalancerPoolStatus struct {
+	PoolID  string                         `json:"pool_id"`
+	Status  string                         `json:"status"`
+	Members []LoadBalancerPoolMemberStatus `json:"members"`
+}
+
+// LoadBalancerPoolMemberStatus is the health status of a single load balancer pool member
+type LoadBalancerPoolMemberStatus struct {
+	IPAddress string `json:"ip_address"`
+	Port      string `json:"port"`
+	Status    string `json:"status"`
+}
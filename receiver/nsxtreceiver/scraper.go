@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"strings"
 	"sync"
 	"time"
 
@@ -26,6 +27,7 @@ import (
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver"
 	"go.opentelemetry.io/collector/receiver/scrapererror"
+	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/nsxtreceiver/internal/metadata"
 	dm "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/nsxtreceiver/internal/model"
@@ -70,9 +72,19 @@ func (s *scraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
 		return pmetric.NewMetrics(), err
 	}
 
+	errs := &scrapererror.ScrapeErrors{}
+	sections := s.retrieveFirewallSections(ctx, errs)
+	services := s.retrieveLoadBalancers(ctx, errs)
+
 	colTime := pcommon.NewTimestampFromTime(time.Now())
 	s.process(r, colTime)
-	return s.mb.Emit(), nil
+	for _, section := range sections {
+		s.recordFirewallSection(colTime, section)
+	}
+	for _, service := range services {
+		s.recordLoadBalancer(colTime, service)
+	}
+	return s.mb.Emit(), errs.Combine()
 }
 
 type nodeInfo struct {
@@ -87,6 +99,25 @@ type interfaceInformation struct {
 	stats *dm.NetworkInterfaceStats
 }
 
+type firewallSectionInfo struct {
+	section dm.FirewallSection
+	stats   []dm.FirewallStats
+}
+
+type loadBalancerInfo struct {
+	service dm.LoadBalancerService
+	pools   []dm.LoadBalancerPoolStatus
+}
+
+// memberStatuses is the order in which pool member counts are recorded
+var memberStatuses = []metadata.AttributeMemberStatus{
+	metadata.AttributeMemberStatusUp,
+	metadata.AttributeMemberStatusDown,
+	metadata.AttributeMemberStatusDisabled,
+	metadata.AttributeMemberStatusGracefulDisabled,
+	metadata.AttributeMemberStatusUnused,
+}
+
 func (s *scraper) retrieve(ctx context.Context) ([]*nodeInfo, error) {
 	var r []*nodeInfo
 	errs := &scrapererror.ScrapeErrors{}
@@ -182,6 +213,61 @@ func (s *scraper) retrieveNodeStats(
 	nodeInfo.stats = ns
 }
 
+// retrieveFirewallSections only queries the firewall API when rule metrics are enabled,
+// so that deployments without distributed firewall permissions are unaffected by default
+func (s *scraper) retrieveFirewallSections(ctx context.Context, errs *scrapererror.ScrapeErrors) []*firewallSectionInfo {
+	if !s.config.Metrics.NsxtFirewallRuleHitCount.Enabled {
+		return nil
+	}
+
+	sections, err := s.client.FirewallSections(ctx)
+	if err != nil {
+		errs.AddPartial(1, err)
+		return nil
+	}
+
+	var r []*firewallSectionInfo
+	for _, section := range sections {
+		stats, err := s.client.FirewallRuleStats(ctx, section.ID)
+		if err != nil {
+			errs.AddPartial(1, err)
+			continue
+		}
+		r = append(r, &firewallSectionInfo{
+			section: section,
+			stats:   stats,
+		})
+	}
+	return r
+}
+
+// retrieveLoadBalancers only queries the load balancer API when pool member metrics are enabled
+func (s *scraper) retrieveLoadBalancers(ctx context.Context, errs *scrapererror.ScrapeErrors) []*loadBalancerInfo {
+	if !s.config.Metrics.NsxtLoadbalancerPoolMemberCount.Enabled {
+		return nil
+	}
+
+	services, err := s.client.LoadBalancerServices(ctx)
+	if err != nil {
+		errs.AddPartial(1, err)
+		return nil
+	}
+
+	var r []*loadBalancerInfo
+	for _, service := range services {
+		pools, err := s.client.LoadBalancerPoolStatus(ctx, service.ID)
+		if err != nil {
+			errs.AddPartial(1, err)
+			continue
+		}
+		r = append(r, &loadBalancerInfo{
+			service: service,
+			pools:   pools,
+		})
+	}
+	return r
+}
+
 func (s *scraper) process(
 	nodes []*nodeInfo,
 	colTime pcommon.Timestamp,
@@ -243,6 +329,39 @@ func (s *scraper) recordNode(
 	)
 }
 
+func (s *scraper) recordFirewallSection(colTime pcommon.Timestamp, info *firewallSectionInfo) {
+	for _, rule := range info.stats {
+		s.mb.RecordNsxtFirewallRuleHitCountDataPoint(colTime, rule.HitCount, rule.RuleID)
+	}
+
+	s.mb.EmitForResource(
+		metadata.WithNsxtFirewallSectionID(info.section.ID),
+		metadata.WithNsxtFirewallSectionName(info.section.DisplayName),
+	)
+}
+
+func (s *scraper) recordLoadBalancer(colTime pcommon.Timestamp, info *loadBalancerInfo) {
+	for _, pool := range info.pools {
+		counts := map[metadata.AttributeMemberStatus]int64{}
+		for _, member := range pool.Members {
+			status, ok := metadata.MapAttributeMemberStatus[strings.ToLower(member.Status)]
+			if !ok {
+				s.settings.Logger.Debug("unknown load balancer pool member status", zap.String("status", member.Status))
+				continue
+			}
+			counts[status]++
+		}
+		for _, status := range memberStatuses {
+			s.mb.RecordNsxtLoadbalancerPoolMemberCountDataPoint(colTime, counts[status], pool.PoolID, status)
+		}
+	}
+
+	s.mb.EmitForResource(
+		metadata.WithNsxtLoadbalancerServiceID(info.service.ID),
+		metadata.WithNsxtLoadbalancerServiceName(info.service.DisplayName),
+	)
+}
+
 func clusterNodeType(node dm.ClusterNode) string {
 	if node.ControllerRole != nil {
 		return "controller"
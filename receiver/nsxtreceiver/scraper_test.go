@@ -28,6 +28,7 @@ import (
 	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/receiver/receivertest"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/comparetest"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/comparetest/golden"
@@ -75,6 +76,85 @@ func TestScrape(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestScrapeFirewallAndLoadBalancer(t *testing.T) {
+	mockClient := NewMockClient(t)
+
+	mockClient.On("ClusterNodes", mock.Anything).Return([]dm.ClusterNode{}, nil)
+	mockClient.On("TransportNodes", mock.Anything).Return([]dm.TransportNode{}, nil)
+	mockClient.On("FirewallSections", mock.Anything).Return(loadTestFirewallSections(t))
+	mockClient.On("FirewallRuleStats", mock.Anything, firewallSection1).Return(loadTestFirewallRuleStats(t, firewallSection1))
+	mockClient.On("LoadBalancerServices", mock.Anything).Return(loadTestLoadBalancerServices(t))
+	mockClient.On("LoadBalancerPoolStatus", mock.Anything, lbService1).Return(loadTestLoadBalancerPoolStatus(t, lbService1))
+
+	ms := metadata.DefaultMetricsSettings()
+	ms.NsxtFirewallRuleHitCount.Enabled = true
+	ms.NsxtLoadbalancerPoolMemberCount.Enabled = true
+	scraper := newScraper(
+		&Config{
+			Metrics: ms,
+		},
+		receivertest.NewNopCreateSettings(),
+	)
+	scraper.client = mockClient
+
+	metrics, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 2, metrics.ResourceMetrics().Len())
+
+	firewall := metrics.ResourceMetrics().At(0)
+	sectionID, ok := firewall.Resource().Attributes().Get("nsxt.firewall.section.id")
+	require.True(t, ok)
+	require.Equal(t, firewallSection1, sectionID.Str())
+	hits := firewall.ScopeMetrics().At(0).Metrics().At(0)
+	require.Equal(t, "nsxt.firewall.rule.hit.count", hits.Name())
+	require.Equal(t, 2, hits.Sum().DataPoints().Len())
+	require.EqualValues(t, 867, hits.Sum().DataPoints().At(0).IntValue())
+	ruleID, ok := hits.Sum().DataPoints().At(0).Attributes().Get("rule.id")
+	require.True(t, ok)
+	require.Equal(t, "1001", ruleID.Str())
+
+	lb := metrics.ResourceMetrics().At(1)
+	serviceName, ok := lb.Resource().Attributes().Get("nsxt.loadbalancer.service.name")
+	require.True(t, ok)
+	require.Equal(t, "web-lb", serviceName.Str())
+	members := lb.ScopeMetrics().At(0).Metrics().At(0)
+	require.Equal(t, "nsxt.loadbalancer.pool.member.count", members.Name())
+	expected := map[string]int64{"up": 2, "down": 1, "disabled": 0, "graceful_disabled": 1, "unused": 0}
+	dps := members.Sum().DataPoints()
+	require.Equal(t, len(expected), dps.Len())
+	for i := 0; i < dps.Len(); i++ {
+		status, ok := dps.At(i).Attributes().Get("status")
+		require.True(t, ok)
+		require.Equal(t, expected[status.Str()], dps.At(i).IntValue(), status.Str())
+	}
+}
+
+func TestScrapeFirewallSectionErrors(t *testing.T) {
+	mockClient := NewMockClient(t)
+
+	mockClient.On("ClusterNodes", mock.Anything).Return([]dm.ClusterNode{}, nil)
+	mockClient.On("TransportNodes", mock.Anything).Return([]dm.TransportNode{}, nil)
+	mockClient.On("FirewallSections", mock.Anything).Return(nil, errUnauthorized)
+	mockClient.On("LoadBalancerServices", mock.Anything).Return(loadTestLoadBalancerServices(t))
+	mockClient.On("LoadBalancerPoolStatus", mock.Anything, lbService1).Return(loadTestLoadBalancerPoolStatus(t, lbService1))
+
+	ms := metadata.DefaultMetricsSettings()
+	ms.NsxtFirewallRuleHitCount.Enabled = true
+	ms.NsxtLoadbalancerPoolMemberCount.Enabled = true
+	scraper := newScraper(
+		&Config{
+			Metrics: ms,
+		},
+		receivertest.NewNopCreateSettings(),
+	)
+	scraper.client = mockClient
+
+	metrics, err := scraper.scrape(context.Background())
+	require.ErrorContains(t, err, errUnauthorized.Error())
+	require.True(t, scrapererror.IsPartialScrapeError(err))
+	require.Equal(t, 1, metrics.ResourceMetrics().Len())
+}
+
 func TestScrapeTransportNodeErrors(t *testing.T) {
 	mockClient := NewMockClient(t)
 	mockClient.On("TransportNodes", mock.Anything).Return(nil, errUnauthorized)
@@ -209,6 +289,42 @@ func loadInterfaceStats(t *testing.T, nodeID, interfaceID string, class nodeClas
 	return &stats, err
 }
 
+func loadTestFirewallSections(t *testing.T) ([]dm.FirewallSection, error) {
+	testFile, err := os.ReadFile(filepath.Join("testdata", "metrics", "firewall", "sections.json"))
+	require.NoError(t, err)
+	var sections dm.FirewallSectionList
+	err = json.Unmarshal(testFile, &sections)
+	require.NoError(t, err)
+	return sections.Results, err
+}
+
+func loadTestFirewallRuleStats(t *testing.T, sectionID string) ([]dm.FirewallStats, error) {
+	testFile, err := os.ReadFile(filepath.Join("testdata", "metrics", "firewall", "sections", sectionID, "stats.json"))
+	require.NoError(t, err)
+	var stats dm.FirewallStatsList
+	err = json.Unmarshal(testFile, &stats)
+	require.NoError(t, err)
+	return stats.Results, err
+}
+
+func loadTestLoadBalancerServices(t *testing.T) ([]dm.LoadBalancerService, error) {
+	testFile, err := os.ReadFile(filepath.Join("testdata", "metrics", "loadbalancer", "services.json"))
+	require.NoError(t, err)
+	var services dm.LoadBalancerServiceList
+	err = json.Unmarshal(testFile, &services)
+	require.NoError(t, err)
+	return services.Results, err
+}
+
+func loadTestLoadBalancerPoolStatus(t *testing.T, serviceID string) ([]dm.LoadBalancerPoolStatus, error) {
+	testFile, err := os.ReadFile(filepath.Join("testdata", "metrics", "loadbalancer", "services", serviceID, "pools.json"))
+	require.NoError(t, err)
+	var pools dm.LoadBalancerPoolStatusList
+	err = json.Unmarshal(testFile, &pools)
+	require.NoError(t, err)
+	return pools.Results, err
+}
+
 func loadTestClusterNodes() ([]dm.ClusterNode, error) {
 	testFile, err := os.ReadFile(filepath.Join("testdata", "metrics", "cluster_nodes.json"))
 	if err != nil {
@@ -35,31 +35,35 @@ func (ms *MetricSettings) Unmarshal(parser *confmap.Conf) error {
 
 // MetricsSettings provides settings for oracledbreceiver metrics.
 type MetricsSettings struct {
-	OracledbCPUTime               MetricSettings `mapstructure:"oracledb.cpu_time"`
-	OracledbDmlLocksLimit         MetricSettings `mapstructure:"oracledb.dml_locks.limit"`
-	OracledbDmlLocksUsage         MetricSettings `mapstructure:"oracledb.dml_locks.usage"`
-	OracledbEnqueueDeadlocks      MetricSettings `mapstructure:"oracledb.enqueue_deadlocks"`
-	OracledbEnqueueLocksLimit     MetricSettings `mapstructure:"oracledb.enqueue_locks.limit"`
-	OracledbEnqueueLocksUsage     MetricSettings `mapstructure:"oracledb.enqueue_locks.usage"`
-	OracledbEnqueueResourcesLimit MetricSettings `mapstructure:"oracledb.enqueue_resources.limit"`
-	OracledbEnqueueResourcesUsage MetricSettings `mapstructure:"oracledb.enqueue_resources.usage"`
-	OracledbExchangeDeadlocks     MetricSettings `mapstructure:"oracledb.exchange_deadlocks"`
-	OracledbExecutions            MetricSettings `mapstructure:"oracledb.executions"`
-	OracledbHardParses            MetricSettings `mapstructure:"oracledb.hard_parses"`
-	OracledbLogicalReads          MetricSettings `mapstructure:"oracledb.logical_reads"`
-	OracledbParseCalls            MetricSettings `mapstructure:"oracledb.parse_calls"`
-	OracledbPgaMemory             MetricSettings `mapstructure:"oracledb.pga_memory"`
-	OracledbPhysicalReads         MetricSettings `mapstructure:"oracledb.physical_reads"`
-	OracledbProcessesLimit        MetricSettings `mapstructure:"oracledb.processes.limit"`
-	OracledbProcessesUsage        MetricSettings `mapstructure:"oracledb.processes.usage"`
-	OracledbSessionsLimit         MetricSettings `mapstructure:"oracledb.sessions.limit"`
-	OracledbSessionsUsage         MetricSettings `mapstructure:"oracledb.sessions.usage"`
-	OracledbTablespaceSizeLimit   MetricSettings `mapstructure:"oracledb.tablespace_size.limit"`
-	OracledbTablespaceSizeUsage   MetricSettings `mapstructure:"oracledb.tablespace_size.usage"`
-	OracledbTransactionsLimit     MetricSettings `mapstructure:"oracledb.transactions.limit"`
-	OracledbTransactionsUsage     MetricSettings `mapstructure:"oracledb.transactions.usage"`
-	OracledbUserCommits           MetricSettings `mapstructure:"oracledb.user_commits"`
-	OracledbUserRollbacks         MetricSettings `mapstructure:"oracledb.user_rollbacks"`
+	OracledbCPUTime                 MetricSettings `mapstructure:"oracledb.cpu_time"`
+	OracledbDmlLocksLimit           MetricSettings `mapstructure:"oracledb.dml_locks.limit"`
+	OracledbDmlLocksUsage           MetricSettings `mapstructure:"oracledb.dml_locks.usage"`
+	OracledbEnqueueDeadlocks        MetricSettings `mapstructure:"oracledb.enqueue_deadlocks"`
+	OracledbEnqueueLocksLimit       MetricSettings `mapstructure:"oracledb.enqueue_locks.limit"`
+	OracledbEnqueueLocksUsage       MetricSettings `mapstructure:"oracledb.enqueue_locks.usage"`
+	OracledbEnqueueResourcesLimit   MetricSettings `mapstructure:"oracledb.enqueue_resources.limit"`
+	OracledbEnqueueResourcesUsage   MetricSettings `mapstructure:"oracledb.enqueue_resources.usage"`
+	OracledbExchangeDeadlocks       MetricSettings `mapstructure:"oracledb.exchange_deadlocks"`
+	OracledbExecutions              MetricSettings `mapstructure:"oracledb.executions"`
+	OracledbHardParses              MetricSettings `mapstructure:"oracledb.hard_parses"`
+	OracledbLogicalReads            MetricSettings `mapstructure:"oracledb.logical_reads"`
+	OracledbParseCalls              MetricSettings `mapstructure:"oracledb.parse_calls"`
+	OracledbPgaMemory               MetricSettings `mapstructure:"oracledb.pga_memory"`
+	OracledbPhysicalReads           MetricSettings `mapstructure:"oracledb.physical_reads"`
+	OracledbProcessesLimit          MetricSettings `mapstructure:"oracledb.processes.limit"`
+	OracledbProcessesUsage          MetricSettings `mapstructure:"oracledb.processes.usage"`
+	OracledbSessionsActive          MetricSettings `mapstructure:"oracledb.sessions.active"`
+	OracledbSessionsLimit           MetricSettings `mapstructure:"oracledb.sessions.limit"`
+	OracledbSessionsUsage           MetricSettings `mapstructure:"oracledb.sessions.usage"`
+	OracledbTablespaceSizeAllocated MetricSettings `mapstructure:"oracledb.tablespace_size.allocated"`
+	OracledbTablespaceSizeLimit     MetricSettings `mapstructure:"oracledb.tablespace_size.limit"`
+	OracledbTablespaceSizeMax       MetricSettings `mapstructure:"oracledb.tablespace_size.max"`
+	OracledbTablespaceSizeUsage     MetricSettings `mapstructure:"oracledb.tablespace_size.usage"`
+	OracledbTablespaceSizeUsed      MetricSettings `mapstructure:"oracledb.tablespace_size.used"`
+	OracledbTransactionsLimit       MetricSettings `mapstructure:"oracledb.transactions.limit"`
+	OracledbTransactionsUsage       MetricSettings `mapstructure:"oracledb.transactions.usage"`
+	OracledbUserCommits             MetricSettings `mapstructure:"oracledb.user_commits"`
+	OracledbUserRollbacks           MetricSettings `mapstructure:"oracledb.user_rollbacks"`
 }
 
 func DefaultMetricsSettings() MetricsSettings {
@@ -115,18 +119,30 @@ func DefaultMetricsSettings() MetricsSettings {
 		OracledbProcessesUsage: MetricSettings{
 			Enabled: true,
 		},
+		OracledbSessionsActive: MetricSettings{
+			Enabled: false,
+		},
 		OracledbSessionsLimit: MetricSettings{
 			Enabled: true,
 		},
 		OracledbSessionsUsage: MetricSettings{
 			Enabled: true,
 		},
+		OracledbTablespaceSizeAllocated: MetricSettings{
+			Enabled: false,
+		},
 		OracledbTablespaceSizeLimit: MetricSettings{
 			Enabled: true,
 		},
+		OracledbTablespaceSizeMax: MetricSettings{
+			Enabled: false,
+		},
 		OracledbTablespaceSizeUsage: MetricSettings{
 			Enabled: true,
 		},
+		OracledbTablespaceSizeUsed: MetricSettings{
+			Enabled: false,
+		},
 		OracledbTransactionsLimit: MetricSettings{
 			Enabled: true,
 		},
@@ -1025,6 +1041,57 @@ func newMetricOracledbProcessesUsage(settings MetricSettings) metricOracledbProc
 	return m
 }
 
+type metricOracledbSessionsActive struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills oracledb.sessions.active metric with initial data.
+func (m *metricOracledbSessionsActive) init() {
+	m.data.SetName("oracledb.sessions.active")
+	m.data.SetDescription("Average count of active sessions over the collection interval, sampled from the Active Session History. Requires the Oracle Diagnostics Pack.")
+	m.data.SetUnit("{sessions}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricOracledbSessionsActive) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, waitClassAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("wait_class", waitClassAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricOracledbSessionsActive) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricOracledbSessionsActive) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricOracledbSessionsActive(settings MetricSettings) metricOracledbSessionsActive {
+	m := metricOracledbSessionsActive{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricOracledbSessionsLimit struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
@@ -1126,6 +1193,57 @@ func newMetricOracledbSessionsUsage(settings MetricSettings) metricOracledbSessi
 	return m
 }
 
+type metricOracledbTablespaceSizeAllocated struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills oracledb.tablespace_size.allocated metric with initial data.
+func (m *metricOracledbTablespaceSizeAllocated) init() {
+	m.data.SetName("oracledb.tablespace_size.allocated")
+	m.data.SetDescription("Current size of the data files of the tablespace in bytes.")
+	m.data.SetUnit("By")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricOracledbTablespaceSizeAllocated) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, tablespaceNameAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("tablespace_name", tablespaceNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricOracledbTablespaceSizeAllocated) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricOracledbTablespaceSizeAllocated) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricOracledbTablespaceSizeAllocated(settings MetricSettings) metricOracledbTablespaceSizeAllocated {
+	m := metricOracledbTablespaceSizeAllocated{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricOracledbTablespaceSizeLimit struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
@@ -1177,6 +1295,57 @@ func newMetricOracledbTablespaceSizeLimit(settings MetricSettings) metricOracled
 	return m
 }
 
+type metricOracledbTablespaceSizeMax struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills oracledb.tablespace_size.max metric with initial data.
+func (m *metricOracledbTablespaceSizeMax) init() {
+	m.data.SetName("oracledb.tablespace_size.max")
+	m.data.SetDescription("Size the data files of the tablespace can grow to in bytes, taking autoextend into account.")
+	m.data.SetUnit("By")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricOracledbTablespaceSizeMax) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, tablespaceNameAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("tablespace_name", tablespaceNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricOracledbTablespaceSizeMax) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricOracledbTablespaceSizeMax) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricOracledbTablespaceSizeMax(settings MetricSettings) metricOracledbTablespaceSizeMax {
+	m := metricOracledbTablespaceSizeMax{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricOracledbTablespaceSizeUsage struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
@@ -1228,6 +1397,57 @@ func newMetricOracledbTablespaceSizeUsage(settings MetricSettings) metricOracled
 	return m
 }
 
+type metricOracledbTablespaceSizeUsed struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills oracledb.tablespace_size.used metric with initial data.
+func (m *metricOracledbTablespaceSizeUsed) init() {
+	m.data.SetName("oracledb.tablespace_size.used")
+	m.data.SetDescription("Space used by the segments of the tablespace in bytes.")
+	m.data.SetUnit("By")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricOracledbTablespaceSizeUsed) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, tablespaceNameAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("tablespace_name", tablespaceNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricOracledbTablespaceSizeUsed) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricOracledbTablespaceSizeUsed) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricOracledbTablespaceSizeUsed(settings MetricSettings) metricOracledbTablespaceSizeUsed {
+	m := metricOracledbTablespaceSizeUsed{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricOracledbTransactionsLimit struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
@@ -1431,37 +1651,41 @@ func newMetricOracledbUserRollbacks(settings MetricSettings) metricOracledbUserR
 // MetricsBuilder provides an interface for scrapers to report metrics while taking care of all the transformations
 // required to produce metric representation defined in metadata and user settings.
 type MetricsBuilder struct {
-	startTime                           pcommon.Timestamp   // start time that will be applied to all recorded data points.
-	metricsCapacity                     int                 // maximum observed number of metrics per resource.
-	resourceCapacity                    int                 // maximum observed number of resource attributes.
-	metricsBuffer                       pmetric.Metrics     // accumulates metrics data before emitting.
-	buildInfo                           component.BuildInfo // contains version information
-	resourceAttributesSettings          ResourceAttributesSettings
-	metricOracledbCPUTime               metricOracledbCPUTime
-	metricOracledbDmlLocksLimit         metricOracledbDmlLocksLimit
-	metricOracledbDmlLocksUsage         metricOracledbDmlLocksUsage
-	metricOracledbEnqueueDeadlocks      metricOracledbEnqueueDeadlocks
-	metricOracledbEnqueueLocksLimit     metricOracledbEnqueueLocksLimit
-	metricOracledbEnqueueLocksUsage     metricOracledbEnqueueLocksUsage
-	metricOracledbEnqueueResourcesLimit metricOracledbEnqueueResourcesLimit
-	metricOracledbEnqueueResourcesUsage metricOracledbEnqueueResourcesUsage
-	metricOracledbExchangeDeadlocks     metricOracledbExchangeDeadlocks
-	metricOracledbExecutions            metricOracledbExecutions
-	metricOracledbHardParses            metricOracledbHardParses
-	metricOracledbLogicalReads          metricOracledbLogicalReads
-	metricOracledbParseCalls            metricOracledbParseCalls
-	metricOracledbPgaMemory             metricOracledbPgaMemory
-	metricOracledbPhysicalReads         metricOracledbPhysicalReads
-	metricOracledbProcessesLimit        metricOracledbProcessesLimit
-	metricOracledbProcessesUsage        metricOracledbProcessesUsage
-	metricOracledbSessionsLimit         metricOracledbSessionsLimit
-	metricOracledbSessionsUsage         metricOracledbSessionsUsage
-	metricOracledbTablespaceSizeLimit   metricOracledbTablespaceSizeLimit
-	metricOracledbTablespaceSizeUsage   metricOracledbTablespaceSizeUsage
-	metricOracledbTransactionsLimit     metricOracledbTransactionsLimit
-	metricOracledbTransactionsUsage     metricOracledbTransactionsUsage
-	metricOracledbUserCommits           metricOracledbUserCommits
-	metricOracledbUserRollbacks         metricOracledbUserRollbacks
+	startTime                             pcommon.Timestamp   // start time that will be applied to all recorded data points.
+	metricsCapacity                       int                 // maximum observed number of metrics per resource.
+	resourceCapacity                      int                 // maximum observed number of resource attributes.
+	metricsBuffer                         pmetric.Metrics     // accumulates metrics data before emitting.
+	buildInfo                             component.BuildInfo // contains version information
+	resourceAttributesSettings            ResourceAttributesSettings
+	metricOracledbCPUTime                 metricOracledbCPUTime
+	metricOracledbDmlLocksLimit           metricOracledbDmlLocksLimit
+	metricOracledbDmlLocksUsage           metricOracledbDmlLocksUsage
+	metricOracledbEnqueueDeadlocks        metricOracledbEnqueueDeadlocks
+	metricOracledbEnqueueLocksLimit       metricOracledbEnqueueLocksLimit
+	metricOracledbEnqueueLocksUsage       metricOracledbEnqueueLocksUsage
+	metricOracledbEnqueueResourcesLimit   metricOracledbEnqueueResourcesLimit
+	metricOracledbEnqueueResourcesUsage   metricOracledbEnqueueResourcesUsage
+	metricOracledbExchangeDeadlocks       metricOracledbExchangeDeadlocks
+	metricOracledbExecutions              metricOracledbExecutions
+	metricOracledbHardParses              metricOracledbHardParses
+	metricOracledbLogicalReads            metricOracledbLogicalReads
+	metricOracledbParseCalls              metricOracledbParseCalls
+	metricOracledbPgaMemory               metricOracledbPgaMemory
+	metricOracledbPhysicalReads           metricOracledbPhysicalReads
+	metricOracledbProcessesLimit          metricOracledbProcessesLimit
+	metricOracledbProcessesUsage          metricOracledbProcessesUsage
+	metricOracledbSessionsActive          metricOracledbSessionsActive
+	metricOracledbSessionsLimit           metricOracledbSessionsLimit
+	metricOracledbSessionsUsage           metricOracledbSessionsUsage
+	metricOracledbTablespaceSizeAllocated metricOracledbTablespaceSizeAllocated
+	metricOracledbTablespaceSizeLimit     metricOracledbTablespaceSizeLimit
+	metricOracledbTablespaceSizeMax       metricOracledbTablespaceSizeMax
+	metricOracledbTablespaceSizeUsage     metricOracledbTablespaceSizeUsage
+	metricOracledbTablespaceSizeUsed      metricOracledbTablespaceSizeUsed
+	metricOracledbTransactionsLimit       metricOracledbTransactionsLimit
+	metricOracledbTransactionsUsage       metricOracledbTransactionsUsage
+	metricOracledbUserCommits             metricOracledbUserCommits
+	metricOracledbUserRollbacks           metricOracledbUserRollbacks
 }
 
 // metricBuilderOption applies changes to default metrics builder.
@@ -1483,35 +1707,39 @@ func WithResourceAttributesSettings(ras ResourceAttributesSettings) metricBuilde
 
 func NewMetricsBuilder(ms MetricsSettings, settings receiver.CreateSettings, options ...metricBuilderOption) *MetricsBuilder {
 	mb := &MetricsBuilder{
-		startTime:                           pcommon.NewTimestampFromTime(time.Now()),
-		metricsBuffer:                       pmetric.NewMetrics(),
-		buildInfo:                           settings.BuildInfo,
-		resourceAttributesSettings:          DefaultResourceAttributesSettings(),
-		metricOracledbCPUTime:               newMetricOracledbCPUTime(ms.OracledbCPUTime),
-		metricOracledbDmlLocksLimit:         newMetricOracledbDmlLocksLimit(ms.OracledbDmlLocksLimit),
-		metricOracledbDmlLocksUsage:         newMetricOracledbDmlLocksUsage(ms.OracledbDmlLocksUsage),
-		metricOracledbEnqueueDeadlocks:      newMetricOracledbEnqueueDeadlocks(ms.OracledbEnqueueDeadlocks),
-		metricOracledbEnqueueLocksLimit:     newMetricOracledbEnqueueLocksLimit(ms.OracledbEnqueueLocksLimit),
-		metricOracledbEnqueueLocksUsage:     newMetricOracledbEnqueueLocksUsage(ms.OracledbEnqueueLocksUsage),
-		metricOracledbEnqueueResourcesLimit: newMetricOracledbEnqueueResourcesLimit(ms.OracledbEnqueueResourcesLimit),
-		metricOracledbEnqueueResourcesUsage: newMetricOracledbEnqueueResourcesUsage(ms.OracledbEnqueueResourcesUsage),
-		metricOracledbExchangeDeadlocks:     newMetricOracledbExchangeDeadlocks(ms.OracledbExchangeDeadlocks),
-		metricOracledbExecutions:            newMetricOracledbExecutions(ms.OracledbExecutions),
-		metricOracledbHardParses:            newMetricOracledbHardParses(ms.OracledbHardParses),
-		metricOracledbLogicalReads:          newMetricOracledbLogicalReads(ms.OracledbLogicalReads),
-		metricOracledbParseCalls:            newMetricOracledbParseCalls(ms.OracledbParseCalls),
-		metricOracledbPgaMemory:             newMetricOracledbPgaMemory(ms.OracledbPgaMemory),
-		metricOracledbPhysicalReads:         newMetricOracledbPhysicalReads(ms.OracledbPhysicalReads),
-		metricOracledbProcessesLimit:        newMetricOracledbProcessesLimit(ms.OracledbProcessesLimit),
-		metricOracledbProcessesUsage:        newMetricOracledbProcessesUsage(ms.OracledbProcessesUsage),
-		metricOracledbSessionsLimit:         newMetricOracledbSessionsLimit(ms.OracledbSessionsLimit),
-		metricOracledbSessionsUsage:         newMetricOracledbSessionsUsage(ms.OracledbSessionsUsage),
-		metricOracledbTablespaceSizeLimit:   newMetricOracledbTablespaceSizeLimit(ms.OracledbTablespaceSizeLimit),
-		metricOracledbTablespaceSizeUsage:   newMetricOracledbTablespaceSizeUsage(ms.OracledbTablespaceSizeUsage),
-		metricOracledbTransactionsLimit:     newMetricOracledbTransactionsLimit(ms.OracledbTransactionsLimit),
-		metricOracledbTransactionsUsage:     newMetricOracledbTransactionsUsage(ms.OracledbTransactionsUsage),
-		metricOracledbUserCommits:           newMetricOracledbUserCommits(ms.OracledbUserCommits),
-		metricOracledbUserRollbacks:         newMetricOracledbUserRollbacks(ms.OracledbUserRollbacks),
+		startTime:                             pcommon.NewTimestampFromTime(time.Now()),
+		metricsBuffer:                         pmetric.NewMetrics(),
+		buildInfo:                             settings.BuildInfo,
+		resourceAttributesSettings:            DefaultResourceAttributesSettings(),
+		metricOracledbCPUTime:                 newMetricOracledbCPUTime(ms.OracledbCPUTime),
+		metricOracledbDmlLocksLimit:           newMetricOracledbDmlLocksLimit(ms.OracledbDmlLocksLimit),
+		metricOracledbDmlLocksUsage:           newMetricOracledbDmlLocksUsage(ms.OracledbDmlLocksUsage),
+		metricOracledbEnqueueDeadlocks:        newMetricOracledbEnqueueDeadlocks(ms.OracledbEnqueueDeadlocks),
+		metricOracledbEnqueueLocksLimit:       newMetricOracledbEnqueueLocksLimit(ms.OracledbEnqueueLocksLimit),
+		metricOracledbEnqueueLocksUsage:       newMetricOracledbEnqueueLocksUsage(ms.OracledbEnqueueLocksUsage),
+		metricOracledbEnqueueResourcesLimit:   newMetricOracledbEnqueueResourcesLimit(ms.OracledbEnqueueResourcesLimit),
+		metricOracledbEnqueueResourcesUsage:   newMetricOracledbEnqueueResourcesUsage(ms.OracledbEnqueueResourcesUsage),
+		metricOracledbExchangeDeadlocks:       newMetricOracledbExchangeDeadlocks(ms.OracledbExchangeDeadlocks),
+		metricOracledbExecutions:              newMetricOracledbExecutions(ms.OracledbExecutions),
+		metricOracledbHardParses:              newMetricOracledbHardParses(ms.OracledbHardParses),
+		metricOracledbLogicalReads:            newMetricOracledbLogicalReads(ms.OracledbLogicalReads),
+		metricOracledbParseCalls:              newMetricOracledbParseCalls(ms.OracledbParseCalls),
+		metricOracledbPgaMemory:               newMetricOracledbPgaMemory(ms.OracledbPgaMemory),
+		metricOracledbPhysicalReads:           newMetricOracledbPhysicalReads(ms.OracledbPhysicalReads),
+		metricOracledbProcessesLimit:          newMetricOracledbProcessesLimit(ms.OracledbProcessesLimit),
+		metricOracledbProcessesUsage:          newMetricOracledbProcessesUsage(ms.OracledbProcessesUsage),
+		metricOracledbSessionsActive:          newMetricOracledbSessionsActive(ms.OracledbSessionsActive),
+		metricOracledbSessionsLimit:           newMetricOracledbSessionsLimit(ms.OracledbSessionsLimit),
+		metricOracledbSessionsUsage:           newMetricOracledbSessionsUsage(ms.OracledbSessionsUsage),
+		metricOracledbTablespaceSizeAllocated: newMetricOracledbTablespaceSizeAllocated(ms.OracledbTablespaceSizeAllocated),
+		metricOracledbTablespaceSizeLimit:     newMetricOracledbTablespaceSizeLimit(ms.OracledbTablespaceSizeLimit),
+		metricOracledbTablespaceSizeMax:       newMetricOracledbTablespaceSizeMax(ms.OracledbTablespaceSizeMax),
+		metricOracledbTablespaceSizeUsage:     newMetricOracledbTablespaceSizeUsage(ms.OracledbTablespaceSizeUsage),
+		metricOracledbTablespaceSizeUsed:      newMetricOracledbTablespaceSizeUsed(ms.OracledbTablespaceSizeUsed),
+		metricOracledbTransactionsLimit:       newMetricOracledbTransactionsLimit(ms.OracledbTransactionsLimit),
+		metricOracledbTransactionsUsage:       newMetricOracledbTransactionsUsage(ms.OracledbTransactionsUsage),
+		metricOracledbUserCommits:             newMetricOracledbUserCommits(ms.OracledbUserCommits),
+		metricOracledbUserRollbacks:           newMetricOracledbUserRollbacks(ms.OracledbUserRollbacks),
 	}
 	for _, op := range options {
 		op(mb)
@@ -1590,10 +1818,14 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	mb.metricOracledbPhysicalReads.emit(ils.Metrics())
 	mb.metricOracledbProcessesLimit.emit(ils.Metrics())
 	mb.metricOracledbProcessesUsage.emit(ils.Metrics())
+	mb.metricOracledbSessionsActive.emit(ils.Metrics())
 	mb.metricOracledbSessionsLimit.emit(ils.Metrics())
 	mb.metricOracledbSessionsUsage.emit(ils.Metrics())
+	mb.metricOracledbTablespaceSizeAllocated.emit(ils.Metrics())
 	mb.metricOracledbTablespaceSizeLimit.emit(ils.Metrics())
+	mb.metricOracledbTablespaceSizeMax.emit(ils.Metrics())
 	mb.metricOracledbTablespaceSizeUsage.emit(ils.Metrics())
+	mb.metricOracledbTablespaceSizeUsed.emit(ils.Metrics())
 	mb.metricOracledbTransactionsLimit.emit(ils.Metrics())
 	mb.metricOracledbTransactionsUsage.emit(ils.Metrics())
 	mb.metricOracledbUserCommits.emit(ils.Metrics())
@@ -1783,6 +2015,16 @@ func (mb *MetricsBuilder) RecordOracledbProcessesUsageDataPoint(ts pcommon.Times
 	return nil
 }
 
+// RecordOracledbSessionsActiveDataPoint adds a data point to oracledb.sessions.active metric.
+func (mb *MetricsBuilder) RecordOracledbSessionsActiveDataPoint(ts pcommon.Timestamp, inputVal string, waitClassAttributeValue string) error {
+	val, err := strconv.ParseFloat(inputVal, 64)
+	if err != nil {
+		return fmt.Errorf("failed to parse float64 for OracledbSessionsActive, value was %s: %w", inputVal, err)
+	}
+	mb.metricOracledbSessionsActive.recordDataPoint(mb.startTime, ts, val, waitClassAttributeValue)
+	return nil
+}
+
 // RecordOracledbSessionsLimitDataPoint adds a data point to oracledb.sessions.limit metric.
 func (mb *MetricsBuilder) RecordOracledbSessionsLimitDataPoint(ts pcommon.Timestamp, inputVal string) error {
 	val, err := strconv.ParseInt(inputVal, 10, 64)
@@ -1803,6 +2045,16 @@ func (mb *MetricsBuilder) RecordOracledbSessionsUsageDataPoint(ts pcommon.Timest
 	return nil
 }
 
+// RecordOracledbTablespaceSizeAllocatedDataPoint adds a data point to oracledb.tablespace_size.allocated metric.
+func (mb *MetricsBuilder) RecordOracledbTablespaceSizeAllocatedDataPoint(ts pcommon.Timestamp, inputVal string, tablespaceNameAttributeValue string) error {
+	val, err := strconv.ParseInt(inputVal, 10, 64)
+	if err != nil {
+		return fmt.Errorf("failed to parse int64 for OracledbTablespaceSizeAllocated, value was %s: %w", inputVal, err)
+	}
+	mb.metricOracledbTablespaceSizeAllocated.recordDataPoint(mb.startTime, ts, val, tablespaceNameAttributeValue)
+	return nil
+}
+
 // RecordOracledbTablespaceSizeLimitDataPoint adds a data point to oracledb.tablespace_size.limit metric.
 func (mb *MetricsBuilder) RecordOracledbTablespaceSizeLimitDataPoint(ts pcommon.Timestamp, inputVal string, tablespaceNameAttributeValue string) error {
 	val, err := strconv.ParseInt(inputVal, 10, 64)
@@ -1813,6 +2065,16 @@ func (mb *MetricsBuilder) RecordOracledbTablespaceSizeLimitDataPoint(ts pcommon.
 	return nil
 }
 
+// RecordOracledbTablespaceSizeMaxDataPoint adds a data point to oracledb.tablespace_size.max metric.
+func (mb *MetricsBuilder) RecordOracledbTablespaceSizeMaxDataPoint(ts pcommon.Timestamp, inputVal string, tablespaceNameAttributeValue string) error {
+	val, err := strconv.ParseInt(inputVal, 10, 64)
+	if err != nil {
+		return fmt.Errorf("failed to parse int64 for OracledbTablespaceSizeMax, value was %s: %w", inputVal, err)
+	}
+	mb.metricOracledbTablespaceSizeMax.recordDataPoint(mb.startTime, ts, val, tablespaceNameAttributeValue)
+	return nil
+}
+
 // RecordOracledbTablespaceSizeUsageDataPoint adds a data point to oracledb.tablespace_size.usage metric.
 func (mb *MetricsBuilder) RecordOracledbTablespaceSizeUsageDataPoint(ts pcommon.Timestamp, inputVal string, tablespaceNameAttributeValue string) error {
 	val, err := strconv.ParseInt(inputVal, 10, 64)
@@ -1823,6 +2085,16 @@ func (mb *MetricsBuilder) RecordOracledbTablespaceSizeUsageDataPoint(ts pcommon.
 	return nil
 }
 
+// RecordOracledbTablespaceSizeUsedDataPoint adds a data point to oracledb.tablespace_size.used metric.
+func (mb *MetricsBuilder) RecordOracledbTablespaceSizeUsedDataPoint(ts pcommon.Timestamp, inputVal string, tablespaceNameAttributeValue string) error {
+	val, err := strconv.ParseInt(inputVal, 10, 64)
+	if err != nil {
+		return fmt.Errorf("failed to parse int64 for OracledbTablespaceSizeUsed, value was %s: %w", inputVal, err)
+	}
+	mb.metricOracledbTablespaceSizeUsed.recordDataPoint(mb.startTime, ts, val, tablespaceNameAttributeValue)
+	return nil
+}
+
 // RecordOracledbTransactionsLimitDataPoint adds a data point to oracledb.transactions.limit metric.
 func (mb *MetricsBuilder) RecordOracledbTransactionsLimitDataPoint(ts pcommon.Timestamp, inputVal string) error {
 	val, err := strconv.ParseInt(inputVal, 10, 64)
@@ -49,8 +49,25 @@ const (
 	systemResourceLimitsSQL = "select RESOURCE_NAME, CURRENT_UTILIZATION, LIMIT_VALUE, CASE WHEN TRIM(INITIAL_ALLOCATION) LIKE 'UNLIMITED' THEN '-1' ELSE TRIM(INITIAL_ALLOCATION) END as INITIAL_ALLOCATION, CASE WHEN TRIM(LIMIT_VALUE) LIKE 'UNLIMITED' THEN '-1' ELSE TRIM(LIMIT_VALUE) END as LIMIT_VALUE from v$resource_limit"
 	tablespaceUsageSQL      = "select TABLESPACE_NAME, BYTES from DBA_DATA_FILES"
 	tablespaceMaxSpaceSQL   = "select TABLESPACE_NAME, (BLOCK_SIZE*MAX_EXTENTS) AS VALUE FROM DBA_TABLESPACES"
+	// tablespaceSizeSQL sums the size of the data files of every tablespace, where the max size of autoextend files is MAXBYTES.
+	tablespaceSizeSQL = "select ts.TABLESPACE_NAME, df.ALLOCATED_BYTES, df.MAX_BYTES, NVL(um.USED_SPACE * ts.BLOCK_SIZE, 0) as USED_BYTES FROM DBA_TABLESPACES ts " +
+		"JOIN (select TABLESPACE_NAME, SUM(BYTES) as ALLOCATED_BYTES, SUM(CASE WHEN AUTOEXTENSIBLE = 'YES' THEN GREATEST(MAXBYTES, BYTES) ELSE BYTES END) as MAX_BYTES FROM DBA_DATA_FILES GROUP BY TABLESPACE_NAME) df ON ts.TABLESPACE_NAME = df.TABLESPACE_NAME " +
+		"LEFT JOIN DBA_TABLESPACE_USAGE_METRICS um ON ts.TABLESPACE_NAME = um.TABLESPACE_NAME"
+	// activeSessionHistorySQLTemplate averages the samples of the active sessions taken every second in the
+	// Active Session History over the collection interval, expressed in seconds.
+	activeSessionHistorySQLTemplate = "select CASE WHEN SESSION_STATE = 'ON CPU' THEN 'CPU' ELSE WAIT_CLASS END as WAIT_CLASS, ROUND(COUNT(*) / %[1]d, 3) as VALUE " +
+		"FROM V$ACTIVE_SESSION_HISTORY WHERE SAMPLE_TIME > SYSTIMESTAMP - NUMTODSINTERVAL(%[1]d, 'SECOND') " +
+		"GROUP BY CASE WHEN SESSION_STATE = 'ON CPU' THEN 'CPU' ELSE WAIT_CLASS END"
 )
 
+func activeSessionHistorySQL(collectionInterval time.Duration) string {
+	seconds := int64(collectionInterval.Seconds())
+	if seconds < 1 {
+		seconds = 1
+	}
+	return fmt.Sprintf(activeSessionHistorySQLTemplate, seconds)
+}
+
 type dbProviderFunc func() (*sql.DB, error)
 
 type clientProviderFunc func(*sql.DB, string, *zap.Logger) dbClient
@@ -59,6 +76,8 @@ type scraper struct {
 	statsClient                dbClient
 	tablespaceMaxSpaceClient   dbClient
 	tablespaceUsageClient      dbClient
+	tablespaceSizeClient       dbClient
+	activeSessionHistoryClient dbClient
 	systemResourceLimitsClient dbClient
 	sessionCountClient         dbClient
 	db                         *sql.DB
@@ -98,6 +117,8 @@ func (s *scraper) start(context.Context, component.Host) error {
 	s.systemResourceLimitsClient = s.clientProviderFunc(s.db, systemResourceLimitsSQL, s.logger)
 	s.tablespaceUsageClient = s.clientProviderFunc(s.db, tablespaceUsageSQL, s.logger)
 	s.tablespaceMaxSpaceClient = s.clientProviderFunc(s.db, tablespaceMaxSpaceSQL, s.logger)
+	s.tablespaceSizeClient = s.clientProviderFunc(s.db, tablespaceSizeSQL, s.logger)
+	s.activeSessionHistoryClient = s.clientProviderFunc(s.db, activeSessionHistorySQL(s.scrapeCfg.CollectionInterval), s.logger)
 	return nil
 }
 
@@ -277,6 +298,40 @@ func (s *scraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
 		}
 	}
 
+	if s.metricsSettings.OracledbTablespaceSizeUsed.Enabled || s.metricsSettings.OracledbTablespaceSizeAllocated.Enabled || s.metricsSettings.OracledbTablespaceSizeMax.Enabled {
+		rows, err := s.tablespaceSizeClient.metricRows(ctx)
+		if err != nil {
+			scrapeErrors = append(scrapeErrors, fmt.Errorf("error executing %s: %w", tablespaceSizeSQL, err))
+		} else {
+			now := pcommon.NewTimestampFromTime(time.Now())
+			for _, row := range rows {
+				tablespaceName := row["TABLESPACE_NAME"]
+				if err := s.metricsBuilder.RecordOracledbTablespaceSizeUsedDataPoint(now, row["USED_BYTES"], tablespaceName); err != nil {
+					scrapeErrors = append(scrapeErrors, err)
+				}
+				if err := s.metricsBuilder.RecordOracledbTablespaceSizeAllocatedDataPoint(now, row["ALLOCATED_BYTES"], tablespaceName); err != nil {
+					scrapeErrors = append(scrapeErrors, err)
+				}
+				if err := s.metricsBuilder.RecordOracledbTablespaceSizeMaxDataPoint(now, row["MAX_BYTES"], tablespaceName); err != nil {
+					scrapeErrors = append(scrapeErrors, err)
+				}
+			}
+		}
+	}
+	if s.metricsSettings.OracledbSessionsActive.Enabled {
+		rows, err := s.activeSessionHistoryClient.metricRows(ctx)
+		if err != nil {
+			scrapeErrors = append(scrapeErrors, fmt.Errorf("error executing %s: %w", activeSessionHistorySQL(s.scrapeCfg.CollectionInterval), err))
+		} else {
+			now := pcommon.NewTimestampFromTime(time.Now())
+			for _, row := range rows {
+				if err := s.metricsBuilder.RecordOracledbSessionsActiveDataPoint(now, row["VALUE"], row["WAIT_CLASS"]); err != nil {
+					scrapeErrors = append(scrapeErrors, err)
+				}
+			}
+		}
+	}
+
 	out := s.metricsBuilder.Emit(metadata.WithOracledbInstanceName(s.instanceName))
 	s.logger.Debug("Done scraping")
 	if len(scrapeErrors) > 0 {
@@ -19,6 +19,7 @@ import (
 	"database/sql"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -26,6 +27,7 @@ import (
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/receiver/receivertest"
 	"go.opentelemetry.io/collector/receiver/scrapererror"
+	"go.opentelemetry.io/collector/receiver/scraperhelper"
 	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/oracledbreceiver/internal/metadata"
@@ -46,8 +48,10 @@ var queryResponses = map[string][]metricRow{
 	sessionCountSQL: {{"VALUE": "1"}},
 	systemResourceLimitsSQL: {{"RESOURCE_NAME": "processes", "CURRENT_UTILIZATION": "3", "MAX_UTILIZATION": "10", "INITIAL_ALLOCATION": "100", "LIMIT_VALUE": "100"},
 		{"RESOURCE_NAME": "locks", "CURRENT_UTILIZATION": "3", "MAX_UTILIZATION": "10", "INITIAL_ALLOCATION": "-1", "LIMIT_VALUE": "-1"}},
-	tablespaceUsageSQL:    {{"TABLESPACE_NAME": "SYS", "BYTES": "1024"}},
-	tablespaceMaxSpaceSQL: {{"TABLESPACE_NAME": "SYS", "VALUE": "1024"}},
+	tablespaceUsageSQL:                        {{"TABLESPACE_NAME": "SYS", "BYTES": "1024"}},
+	tablespaceMaxSpaceSQL:                     {{"TABLESPACE_NAME": "SYS", "VALUE": "1024"}},
+	tablespaceSizeSQL:                         {{"TABLESPACE_NAME": "SYS", "USED_BYTES": "512", "ALLOCATED_BYTES": "1024", "MAX_BYTES": "4096"}},
+	activeSessionHistorySQL(10 * time.Second): {{"WAIT_CLASS": "CPU", "VALUE": "1.5"}, {"WAIT_CLASS": "User I/O", "VALUE": ".3"}},
 }
 
 func TestScraper_Scrape(t *testing.T) {
@@ -82,6 +86,68 @@ func TestScraper_Scrape(t *testing.T) {
 	assert.Equal(t, "", name.Str())
 }
 
+func TestScraper_ScrapeTablespaceSizeAndActiveSessions(t *testing.T) {
+	metricsSettings := metadata.DefaultMetricsSettings()
+	metricsSettings.OracledbTablespaceSizeUsed.Enabled = true
+	metricsSettings.OracledbTablespaceSizeAllocated.Enabled = true
+	metricsSettings.OracledbTablespaceSizeMax.Enabled = true
+	metricsSettings.OracledbSessionsActive.Enabled = true
+	metricsBuilder := metadata.NewMetricsBuilder(metricsSettings, receivertest.NewNopCreateSettings())
+
+	scrpr := scraper{
+		logger:         zap.NewNop(),
+		metricsBuilder: metricsBuilder,
+		dbProviderFunc: func() (*sql.DB, error) {
+			return nil, nil
+		},
+		clientProviderFunc: func(db *sql.DB, s string, logger *zap.Logger) dbClient {
+			return &fakeDbClient{
+				Responses: [][]metricRow{
+					queryResponses[s],
+				},
+			}
+		},
+		id:              component.ID{},
+		scrapeCfg:       scraperhelper.ScraperControllerSettings{CollectionInterval: 10 * time.Second},
+		metricsSettings: metricsSettings,
+	}
+	err := scrpr.start(context.Background(), componenttest.NewNopHost())
+	defer func() {
+		assert.NoError(t, scrpr.shutdown(context.Background()))
+	}()
+	require.NoError(t, err)
+	m, err := scrpr.scrape(context.Background())
+	require.NoError(t, err)
+
+	metrics := m.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	assert.Equal(t, 20, metrics.Len())
+	for i := 0; i < metrics.Len(); i++ {
+		metric := metrics.At(i)
+		switch metric.Name() {
+		case "oracledb.tablespace_size.used":
+			assert.Equal(t, int64(512), metric.Gauge().DataPoints().At(0).IntValue())
+		case "oracledb.tablespace_size.allocated":
+			assert.Equal(t, int64(1024), metric.Gauge().DataPoints().At(0).IntValue())
+		case "oracledb.tablespace_size.max":
+			assert.Equal(t, int64(4096), metric.Gauge().DataPoints().At(0).IntValue())
+		case "oracledb.sessions.active":
+			dps := metric.Gauge().DataPoints()
+			require.Equal(t, 2, dps.Len())
+			assert.Equal(t, 1.5, dps.At(0).DoubleValue())
+			waitClass, ok := dps.At(1).Attributes().Get("wait_class")
+			assert.True(t, ok)
+			assert.Equal(t, "User I/O", waitClass.Str())
+			assert.Equal(t, 0.3, dps.At(1).DoubleValue())
+		}
+	}
+}
+
+func TestActiveSessionHistorySQL(t *testing.T) {
+	assert.Contains(t, activeSessionHistorySQL(time.Minute), "COUNT(*) / 60")
+	assert.Contains(t, activeSessionHistorySQL(time.Minute), "NUMTODSINTERVAL(60, 'SECOND')")
+	assert.Contains(t, activeSessionHistorySQL(100*time.Millisecond), "COUNT(*) / 1")
+}
+
 func TestPartial_InvalidScrape(t *testing.T) {
 	metricsBuilder := metadata.NewMetricsBuilder(metadata.DefaultMetricsSettings(), receivertest.NewNopCreateSettings())
 
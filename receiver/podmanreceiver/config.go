@@ -36,6 +36,13 @@ type Config struct {
 	APIVersion    string `mapstructure:"api_version"`
 	SSHKey        string `mapstructure:"ssh_key"`
 	SSHPassphrase string `mapstructure:"ssh_passphrase"`
+
+	// PodMetrics enables the emission of metrics aggregated from the containers of every pod.
+	PodMetrics bool `mapstructure:"pod_metrics"`
+
+	// EventFilters are the filters of the events consumed by the logs receiver, e.g. `type: [container, pod]`.
+	// All the events are consumed if no filters are set.
+	EventFilters map[string][]string `mapstructure:"event_filters"`
 }
 
 func (config Config) Validate() error {
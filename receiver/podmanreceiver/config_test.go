@@ -57,6 +57,10 @@ func TestLoadConfig(t *testing.T) {
 				APIVersion: defaultAPIVersion,
 				Endpoint:   "http://example.com/",
 				Timeout:    20 * time.Second,
+				PodMetrics: true,
+				EventFilters: map[string][]string{
+					"type": {"container", "pod"},
+				},
 			},
 		},
 	}
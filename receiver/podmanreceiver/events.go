@@ -0,0 +1,172 @@
+// Copyright 2020 OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !windows
+// +build !windows
+
+package podmanreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/podmanreceiver"
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	rcvr "go.opentelemetry.io/collector/receiver"
+	conventions "go.opentelemetry.io/collector/semconv/v1.6.1"
+	"go.uber.org/zap"
+)
+
+const (
+	eventTypeAttribute       = "podman.event.type"
+	eventActionAttribute     = "podman.event.action"
+	eventActorIDAttribute    = "podman.event.actor.id"
+	eventAttributesAttribute = "podman.event.attributes"
+)
+
+// eventsReceiver consumes the events of the Podman service as logs.
+type eventsReceiver struct {
+	config        *Config
+	set           rcvr.CreateSettings
+	clientFactory clientFactory
+	nextConsumer  consumer.Logs
+	cancel        context.CancelFunc
+	done          chan struct{}
+}
+
+func newLogsReceiver(
+	_ context.Context,
+	set rcvr.CreateSettings,
+	config *Config,
+	nextConsumer consumer.Logs,
+	clientFactory clientFactory,
+) (rcvr.Logs, error) {
+	err := config.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	if clientFactory == nil {
+		clientFactory = newLibpodClient
+	}
+
+	return &eventsReceiver{
+		config:        config,
+		set:           set,
+		clientFactory: clientFactory,
+		nextConsumer:  nextConsumer,
+	}, nil
+}
+
+func (r *eventsReceiver) Start(_ context.Context, _ component.Host) error {
+	podmanClient, err := r.clientFactory(r.set.Logger, r.config)
+	if err != nil {
+		return err
+	}
+
+	filters := url.Values{}
+	if len(r.config.EventFilters) > 0 {
+		jsonFilter, err := json.Marshal(r.config.EventFilters)
+		if err != nil {
+			return err
+		}
+		filters.Add("filters", string(jsonFilter))
+	}
+
+	var ctx context.Context
+	ctx, r.cancel = context.WithCancel(context.Background())
+	r.done = make(chan struct{})
+	go r.eventLoop(ctx, podmanClient, filters)
+	return nil
+}
+
+func (r *eventsReceiver) Shutdown(context.Context) error {
+	if r.cancel == nil {
+		return nil
+	}
+	r.cancel()
+	<-r.done
+	return nil
+}
+
+func (r *eventsReceiver) eventLoop(ctx context.Context, client PodmanClient, filters url.Values) {
+	defer close(r.done)
+EVENT_LOOP:
+	for {
+		eventCh, errCh := client.events(ctx, filters)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case podmanEvent := <-eventCh:
+				if err := r.nextConsumer.ConsumeLogs(ctx, eventToLogs(podmanEvent)); err != nil {
+					r.set.Logger.Error("Error consuming podman event", zap.Error(err))
+				}
+			case err := <-errCh:
+				// We are only interested when the context hasn't been canceled since requests made
+				// with a closed context are guaranteed to fail.
+				if ctx.Err() == nil {
+					r.set.Logger.Error("Error watching podman events", zap.Error(err))
+					// Resume the event loop after waiting a moment, see ContainerScraper.containerEventLoop.
+					select {
+					case <-time.After(3 * time.Second):
+						continue EVENT_LOOP
+					case <-ctx.Done():
+						return
+					}
+				}
+			}
+		}
+	}
+}
+
+func eventToLogs(e event) plog.Logs {
+	ld := plog.NewLogs()
+	rl := ld.ResourceLogs().AppendEmpty()
+	rl.Resource().Attributes().PutStr(conventions.AttributeContainerRuntime, "podman")
+
+	lr := rl.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+	lr.SetObservedTimestamp(pcommon.NewTimestampFromTime(time.Now()))
+	if e.TimeNano != 0 {
+		lr.SetTimestamp(pcommon.Timestamp(e.TimeNano))
+	} else if e.Time != 0 {
+		lr.SetTimestamp(pcommon.NewTimestampFromTime(time.Unix(e.Time, 0)))
+	}
+
+	action := e.Action
+	if action == "" {
+		action = e.Status
+	}
+	lr.Body().SetStr(action)
+
+	attrs := lr.Attributes()
+	attrs.PutStr(eventTypeAttribute, e.Type)
+	attrs.PutStr(eventActionAttribute, action)
+	actorID := e.Actor.ID
+	if actorID == "" {
+		actorID = e.ID
+	}
+	attrs.PutStr(eventActorIDAttribute, actorID)
+	if len(e.Actor.Attributes) > 0 {
+		actorAttrs := attrs.PutEmptyMap(eventAttributesAttribute)
+		for k, v := range e.Actor.Attributes {
+			actorAttrs.PutStr(k, v)
+		}
+	}
+	return ld
+}
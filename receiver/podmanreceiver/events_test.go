@@ -0,0 +1,137 @@
+// Copyright 2020 OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !windows
+// +build !windows
+
+package podmanreceiver
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	"go.uber.org/zap"
+)
+
+func TestEventsReceiver(t *testing.T) {
+	cfg := createDefaultConfig()
+	cfg.EventFilters = map[string][]string{"type": {"container"}}
+
+	client := &mockEventsClient{eventCh: make(chan event)}
+	sink := new(consumertest.LogsSink)
+	r, err := newLogsReceiver(context.Background(), receivertest.NewNopCreateSettings(), cfg, sink, client.factory)
+	require.NoError(t, err)
+	require.NoError(t, r.Start(context.Background(), componenttest.NewNopHost()))
+
+	client.eventCh <- event{
+		Status:   "start",
+		ID:       "c1",
+		Type:     "container",
+		Action:   "start",
+		Actor:    eventActor{ID: "c1", Attributes: map[string]string{"name": "cntrA"}},
+		TimeNano: 1609459200000000000,
+	}
+	require.Eventually(t, func() bool { return sink.LogRecordCount() == 1 }, 5*time.Second, 10*time.Millisecond)
+	require.NoError(t, r.Shutdown(context.Background()))
+
+	assert.Equal(t, `{"type":["container"]}`, client.filters.Get("filters"))
+	lr := sink.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+	assert.Equal(t, "start", lr.Body().Str())
+	assert.Equal(t, pcommon.Timestamp(1609459200000000000), lr.Timestamp())
+}
+
+func TestEventsReceiverErrors(t *testing.T) {
+	r, err := newLogsReceiver(context.Background(), receivertest.NewNopCreateSettings(), &Config{}, consumertest.NewNop(), nil)
+	assert.Nil(t, r)
+	require.Error(t, err)
+	assert.Equal(t, "config.Endpoint must be specified", err.Error())
+
+	r, err = newLogsReceiver(context.Background(), receivertest.NewNopCreateSettings(), createDefaultConfig(), consumertest.NewNop(),
+		func(*zap.Logger, *Config) (PodmanClient, error) {
+			return nil, errors.New("no connection")
+		})
+	require.NoError(t, err)
+	assert.EqualError(t, r.Start(context.Background(), componenttest.NewNopHost()), "no connection")
+	assert.NoError(t, r.Shutdown(context.Background()))
+}
+
+func TestEventToLogs(t *testing.T) {
+	ld := eventToLogs(event{
+		Status: "died",
+		ID:     "c1",
+		Time:   1609459200,
+	})
+	require.Equal(t, 1, ld.LogRecordCount())
+
+	rl := ld.ResourceLogs().At(0)
+	runtime, ok := rl.Resource().Attributes().Get("container.runtime")
+	require.True(t, ok)
+	assert.Equal(t, "podman", runtime.Str())
+
+	lr := rl.ScopeLogs().At(0).LogRecords().At(0)
+	assert.Equal(t, "died", lr.Body().Str())
+	assert.Equal(t, pcommon.NewTimestampFromTime(time.Unix(1609459200, 0)), lr.Timestamp())
+	assert.Equal(t, map[string]interface{}{
+		"podman.event.type":     "",
+		"podman.event.action":   "died",
+		"podman.event.actor.id": "c1",
+	}, lr.Attributes().AsRaw())
+
+	lr = eventToLogs(event{
+		Type:   "pod",
+		Action: "create",
+		Actor:  eventActor{ID: "p1", Attributes: map[string]string{"name": "podA"}},
+	}).ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+	assert.Equal(t, map[string]interface{}{
+		"podman.event.type":       "pod",
+		"podman.event.action":     "create",
+		"podman.event.actor.id":   "p1",
+		"podman.event.attributes": map[string]interface{}{"name": "podA"},
+	}, lr.Attributes().AsRaw())
+}
+
+type mockEventsClient struct {
+	eventCh chan event
+	filters url.Values
+}
+
+func (c *mockEventsClient) factory(*zap.Logger, *Config) (PodmanClient, error) {
+	return c, nil
+}
+
+func (c *mockEventsClient) stats(context.Context, url.Values) ([]containerStats, error) {
+	return nil, nil
+}
+
+func (c *mockEventsClient) ping(context.Context) error {
+	return nil
+}
+
+func (c *mockEventsClient) list(context.Context, url.Values) ([]container, error) {
+	return nil, nil
+}
+
+func (c *mockEventsClient) events(_ context.Context, filters url.Values) (<-chan event, <-chan error) {
+	c.filters = filters
+	return c.eventCh, nil
+}
@@ -34,7 +34,8 @@ func NewFactory() rcvr.Factory {
 	return rcvr.NewFactory(
 		typeStr,
 		createDefaultReceiverConfig,
-		rcvr.WithMetrics(createMetricsReceiver, stability))
+		rcvr.WithMetrics(createMetricsReceiver, stability),
+		rcvr.WithLogs(createLogsReceiver, stability))
 }
 
 func createDefaultConfig() *Config {
@@ -66,3 +67,13 @@ func createMetricsReceiver(
 
 	return dsr, nil
 }
+
+func createLogsReceiver(
+	ctx context.Context,
+	params rcvr.CreateSettings,
+	config component.Config,
+	consumer consumer.Logs,
+) (rcvr.Logs, error) {
+	podmanConfig := config.(*Config)
+	return newLogsReceiver(ctx, params, podmanConfig, consumer, nil)
+}
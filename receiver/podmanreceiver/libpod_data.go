@@ -47,8 +47,24 @@ type container struct {
 }
 
 type event struct {
-	ID     string
-	Status string
+	ID       string
+	Status   string
+	Type     string
+	Action   string
+	Actor    eventActor
+	Time     int64
+	TimeNano int64
+}
+
+type eventActor struct {
+	ID         string
+	Attributes map[string]string
+}
+
+// pod identifies the pod that a container belongs to.
+type pod struct {
+	ID   string
+	Name string
 }
 
 type containerStats struct {
@@ -26,6 +26,14 @@ import (
 	conventions "go.opentelemetry.io/collector/semconv/v1.6.1"
 )
 
+const (
+	containerMetricPrefix = "container."
+	podMetricPrefix       = "pod."
+
+	podIDAttribute   = "podman.pod.id"
+	podNameAttribute = "podman.pod.name"
+)
+
 type point struct {
 	intVal     uint64
 	doubleVal  float64
@@ -45,34 +53,82 @@ func containerStatsToMetrics(ts time.Time, container container, stats *container
 	resourceAttr.PutStr(conventions.AttributeContainerImageName, container.Image)
 
 	ms := rs.ScopeMetrics().AppendEmpty().Metrics()
-	appendIOMetrics(ms, stats, pbts)
-	appendCPUMetrics(ms, stats, pbts)
-	appendNetworkMetrics(ms, stats, pbts)
-	appendMemoryMetrics(ms, stats, pbts)
+	appendIOMetrics(ms, containerMetricPrefix, stats, pbts)
+	appendCPUMetrics(ms, containerMetricPrefix, stats, pbts)
+	appendNetworkMetrics(ms, containerMetricPrefix, stats, pbts)
+	appendMemoryMetrics(ms, containerMetricPrefix, stats, pbts)
+
+	return md
+}
+
+// podStatsToMetrics translates the stats of the containers of a pod, summed in stats, into metrics.
+// The memory limit is left out since the sum of the limits of the containers isn't the limit of the pod.
+func podStatsToMetrics(ts time.Time, pod pod, stats *containerStats) pmetric.Metrics {
+	pbts := pcommon.NewTimestampFromTime(ts)
+
+	md := pmetric.NewMetrics()
+	rs := md.ResourceMetrics().AppendEmpty()
+
+	resourceAttr := rs.Resource().Attributes()
+	resourceAttr.PutStr(conventions.AttributeContainerRuntime, "podman")
+	resourceAttr.PutStr(podIDAttribute, pod.ID)
+	resourceAttr.PutStr(podNameAttribute, pod.Name)
+
+	ms := rs.ScopeMetrics().AppendEmpty().Metrics()
+	appendIOMetrics(ms, podMetricPrefix, stats, pbts)
+	appendCPUMetrics(ms, podMetricPrefix, stats, pbts)
+	appendNetworkMetrics(ms, podMetricPrefix, stats, pbts)
+	gaugeI(ms, podMetricPrefix+"memory.usage.total", "By", []point{{intVal: stats.MemUsage}}, pbts)
+	gaugeF(ms, podMetricPrefix+"memory.percent", "1", []point{{doubleVal: stats.MemPerc}}, pbts)
 
 	return md
 }
 
-func appendMemoryMetrics(ms pmetric.MetricSlice, stats *containerStats, ts pcommon.Timestamp) {
-	gaugeI(ms, "memory.usage.limit", "By", []point{{intVal: stats.MemLimit}}, ts)
-	gaugeI(ms, "memory.usage.total", "By", []point{{intVal: stats.MemUsage}}, ts)
-	gaugeF(ms, "memory.percent", "1", []point{{doubleVal: stats.MemPerc}}, ts)
+// addContainerStats adds the stats of a container to the stats of its pod. The containers of a pod
+// with an infra container share its network namespace, so the network stats are only added for the
+// container that owns the namespace, otherwise they would be counted once per container.
+func addContainerStats(total *containerStats, stats *containerStats, ownsNetwork bool) {
+	for i, cpu := range stats.PerCPU {
+		if i < len(total.PerCPU) {
+			total.PerCPU[i] += cpu
+		} else {
+			total.PerCPU = append(total.PerCPU, cpu)
+		}
+	}
+	total.CPU += stats.CPU
+	total.CPUNano += stats.CPUNano
+	total.CPUSystemNano += stats.CPUSystemNano
+	total.MemUsage += stats.MemUsage
+	total.MemPerc += stats.MemPerc
+	if ownsNetwork {
+		total.NetInput += stats.NetInput
+		total.NetOutput += stats.NetOutput
+	}
+	total.BlockInput += stats.BlockInput
+	total.BlockOutput += stats.BlockOutput
+	total.PIDs += stats.PIDs
+}
+
+func appendMemoryMetrics(ms pmetric.MetricSlice, prefix string, stats *containerStats, ts pcommon.Timestamp) {
+	gaugeI(ms, prefix+"memory.usage.limit", "By", []point{{intVal: stats.MemLimit}}, ts)
+	gaugeI(ms, prefix+"memory.usage.total", "By", []point{{intVal: stats.MemUsage}}, ts)
+	gaugeF(ms, prefix+"memory.percent", "1", []point{{doubleVal: stats.MemPerc}}, ts)
 }
 
-func appendNetworkMetrics(ms pmetric.MetricSlice, stats *containerStats, ts pcommon.Timestamp) {
-	sum(ms, "network.io.usage.tx_bytes", "By", []point{{intVal: stats.NetInput}}, ts)
-	sum(ms, "network.io.usage.rx_bytes", "By", []point{{intVal: stats.NetOutput}}, ts)
+func appendNetworkMetrics(ms pmetric.MetricSlice, prefix string, stats *containerStats, ts pcommon.Timestamp) {
+	sum(ms, prefix+"network.io.usage.tx_bytes", "By", []point{{intVal: stats.NetInput}}, ts)
+	sum(ms, prefix+"network.io.usage.rx_bytes", "By", []point{{intVal: stats.NetOutput}}, ts)
 }
 
-func appendIOMetrics(ms pmetric.MetricSlice, stats *containerStats, ts pcommon.Timestamp) {
-	sum(ms, "blockio.io_service_bytes_recursive.write", "By", []point{{intVal: stats.BlockOutput}}, ts)
-	sum(ms, "blockio.io_service_bytes_recursive.read", "By", []point{{intVal: stats.BlockInput}}, ts)
+func appendIOMetrics(ms pmetric.MetricSlice, prefix string, stats *containerStats, ts pcommon.Timestamp) {
+	sum(ms, prefix+"blockio.io_service_bytes_recursive.write", "By", []point{{intVal: stats.BlockOutput}}, ts)
+	sum(ms, prefix+"blockio.io_service_bytes_recursive.read", "By", []point{{intVal: stats.BlockInput}}, ts)
 }
 
-func appendCPUMetrics(ms pmetric.MetricSlice, stats *containerStats, ts pcommon.Timestamp) {
-	sum(ms, "cpu.usage.system", "ns", []point{{intVal: stats.CPUSystemNano}}, ts)
-	sum(ms, "cpu.usage.total", "ns", []point{{intVal: stats.CPUNano}}, ts)
-	gaugeF(ms, "cpu.percent", "1", []point{{doubleVal: stats.CPU}}, ts)
+func appendCPUMetrics(ms pmetric.MetricSlice, prefix string, stats *containerStats, ts pcommon.Timestamp) {
+	sum(ms, prefix+"cpu.usage.system", "ns", []point{{intVal: stats.CPUSystemNano}}, ts)
+	sum(ms, prefix+"cpu.usage.total", "ns", []point{{intVal: stats.CPUNano}}, ts)
+	gaugeF(ms, prefix+"cpu.percent", "1", []point{{doubleVal: stats.CPU}}, ts)
 
 	points := make([]point, len(stats.PerCPU))
 	for i, cpu := range stats.PerCPU {
@@ -83,12 +139,12 @@ func appendCPUMetrics(ms pmetric.MetricSlice, stats *containerStats, ts pcommon.
 			},
 		}
 	}
-	sum(ms, "cpu.usage.percpu", "ns", points, ts)
+	sum(ms, prefix+"cpu.usage.percpu", "ns", points, ts)
 }
 
 func initMetric(ms pmetric.MetricSlice, name, unit string) pmetric.Metric {
 	m := ms.AppendEmpty()
-	m.SetName(fmt.Sprintf("container.%s", name))
+	m.SetName(name)
 	m.SetUnit(unit)
 	return m
 }
@@ -33,6 +33,60 @@ func TestTranslateStatsToMetrics(t *testing.T) {
 	assertStatsEqualToMetrics(t, stats, md)
 }
 
+func TestTranslatePodStatsToMetrics(t *testing.T) {
+	stats := &containerStats{}
+	// The first container is the infra container, the second one shares its network namespace.
+	addContainerStats(stats, genContainerStats(), true)
+	addContainerStats(stats, &containerStats{PerCPU: []uint64{1, 2, 3, 4, 5}, CPUNano: 10, MemUsage: 13, MemPerc: 6.5, NetInput: 7}, false)
+
+	md := podStatsToMetrics(time.Now(), pod{ID: "p1", Name: "podA"}, stats)
+	assert.Equal(t, md.ResourceMetrics().Len(), 1)
+	rsm := md.ResourceMetrics().At(0)
+
+	resourceAttrs := map[string]string{
+		"container.runtime": "podman",
+		"podman.pod.id":     "p1",
+		"podman.pod.name":   "podA",
+	}
+	for k, v := range resourceAttrs {
+		attr, exists := rsm.Resource().Attributes().Get(k)
+		assert.True(t, exists)
+		assert.Equal(t, attr.Str(), v)
+	}
+
+	metrics := rsm.ScopeMetrics().At(0).Metrics()
+	assert.Equal(t, metrics.Len(), 10)
+
+	for i := 0; i < metrics.Len(); i++ {
+		m := metrics.At(i)
+		switch m.Name() {
+		case "pod.memory.usage.total":
+			assertMetricEqual(t, m, pmetric.MetricTypeGauge, []point{{intVal: 100}})
+		case "pod.memory.percent":
+			assertMetricEqual(t, m, pmetric.MetricTypeGauge, []point{{doubleVal: 50}})
+		case "pod.network.io.usage.tx_bytes":
+			assertMetricEqual(t, m, pmetric.MetricTypeSum, []point{{intVal: 349323}})
+		case "pod.cpu.usage.total":
+			assertMetricEqual(t, m, pmetric.MetricTypeSum, []point{{intVal: 3452000}})
+		case "pod.cpu.usage.percpu":
+			assertMetricEqual(t, m, pmetric.MetricTypeSum, []point{
+				{intVal: 41, attributes: map[string]string{"core": "cpu0"}},
+				{intVal: 52, attributes: map[string]string{"core": "cpu1"}},
+				{intVal: 23, attributes: map[string]string{"core": "cpu2"}},
+				{intVal: 19, attributes: map[string]string{"core": "cpu3"}},
+				{intVal: 5, attributes: map[string]string{"core": "cpu4"}},
+			})
+		case "pod.network.io.usage.rx_bytes",
+			"pod.blockio.io_service_bytes_recursive.write",
+			"pod.blockio.io_service_bytes_recursive.read",
+			"pod.cpu.usage.system",
+			"pod.cpu.percent":
+		default:
+			t.Errorf(fmt.Sprintf("unexpected metric: %s", m.Name()))
+		}
+	}
+}
+
 func assertStatsEqualToMetrics(t *testing.T, podmanStats *containerStats, md pmetric.Metrics) {
 	assert.Equal(t, md.ResourceMetrics().Len(), 1)
 	rsm := md.ResourceMetrics().At(0)
@@ -84,8 +84,9 @@ func (r *receiver) start(ctx context.Context, _ component.Host) error {
 }
 
 type result struct {
-	md  pmetric.Metrics
-	err error
+	container container
+	stats     containerStats
+	err       error
 }
 
 func (r *receiver) scrape(ctx context.Context) (pmetric.Metrics, error) {
@@ -98,11 +99,7 @@ func (r *receiver) scrape(ctx context.Context) (pmetric.Metrics, error) {
 		go func(c container) {
 			defer wg.Done()
 			stats, err := r.scraper.fetchContainerStats(ctx, c)
-			if err != nil {
-				results <- result{md: pmetric.Metrics{}, err: err}
-				return
-			}
-			results <- result{md: containerStatsToMetrics(time.Now(), c, &stats), err: nil}
+			results <- result{container: c, stats: stats, err: err}
 		}(c)
 	}
 
@@ -110,7 +107,16 @@ func (r *receiver) scrape(ctx context.Context) (pmetric.Metrics, error) {
 	close(results)
 
 	var errs error
+	now := time.Now()
 	md := pmetric.NewMetrics()
+	var pods []pod
+	podStats := make(map[pod]*containerStats)
+	podsWithInfra := make(map[string]bool)
+	for _, c := range containers {
+		if c.IsInfra {
+			podsWithInfra[c.Pod] = true
+		}
+	}
 	for res := range results {
 		if res.err != nil {
 			// Don't know the number of failed metrics, but one container fetch is a partial error.
@@ -118,7 +124,21 @@ func (r *receiver) scrape(ctx context.Context) (pmetric.Metrics, error) {
 			fmt.Println("No stats found!")
 			continue
 		}
-		res.md.ResourceMetrics().CopyTo(md.ResourceMetrics())
+		containerStatsToMetrics(now, res.container, &res.stats).ResourceMetrics().MoveAndAppendTo(md.ResourceMetrics())
+
+		if !r.config.PodMetrics || res.container.Pod == "" {
+			continue
+		}
+		p := pod{ID: res.container.Pod, Name: res.container.PodName}
+		if _, ok := podStats[p]; !ok {
+			pods = append(pods, p)
+			podStats[p] = &containerStats{}
+		}
+		addContainerStats(podStats[p], &res.stats, res.container.IsInfra || !podsWithInfra[res.container.Pod])
+	}
+
+	for _, p := range pods {
+		podStatsToMetrics(now, p, podStats[p]).ResourceMetrics().MoveAndAppendTo(md.ResourceMetrics())
 	}
 	return md, nil
 }
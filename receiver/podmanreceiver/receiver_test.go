@@ -89,6 +89,48 @@ func TestScraperLoop(t *testing.T) {
 	assert.NoError(t, r.Shutdown(context.Background()))
 }
 
+func TestScrapePodMetrics(t *testing.T) {
+	cfg := createDefaultConfig()
+	cfg.PodMetrics = true
+
+	client := mockStatsClient{
+		"c1": {ContainerID: "c1", CPUNano: 10, MemUsage: 100, NetInput: 50},
+		"c2": {ContainerID: "c2", CPUNano: 20, MemUsage: 200, NetInput: 50},
+		"c3": {ContainerID: "c3", CPUNano: 40, MemUsage: 400},
+	}
+	scraper := newContainerScraper(client, zap.NewNop(), cfg)
+	scraper.persistContainer(container{ID: "c1", Pod: "p1", PodName: "podA", IsInfra: true})
+	scraper.persistContainer(container{ID: "c2", Pod: "p1", PodName: "podA"})
+	scraper.persistContainer(container{ID: "c3"})
+	r := &receiver{config: cfg, scraper: scraper}
+
+	md, err := r.scrape(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 4, md.ResourceMetrics().Len())
+
+	rm := md.ResourceMetrics().At(3)
+	podID, ok := rm.Resource().Attributes().Get("podman.pod.id")
+	require.True(t, ok)
+	assert.Equal(t, "p1", podID.Str())
+	metrics := rm.ScopeMetrics().At(0).Metrics()
+	for i := 0; i < metrics.Len(); i++ {
+		switch metrics.At(i).Name() {
+		case "pod.cpu.usage.total":
+			assert.Equal(t, int64(30), metrics.At(i).Sum().DataPoints().At(0).IntValue())
+		case "pod.memory.usage.total":
+			assert.Equal(t, int64(300), metrics.At(i).Gauge().DataPoints().At(0).IntValue())
+		case "pod.network.io.usage.tx_bytes":
+			// The containers share the network namespace of the infra container.
+			assert.Equal(t, int64(50), metrics.At(i).Sum().DataPoints().At(0).IntValue())
+		}
+	}
+
+	cfg.PodMetrics = false
+	md, err = r.scrape(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 3, md.ResourceMetrics().Len())
+}
+
 type mockClient chan containerStatsReport
 
 func (c mockClient) factory(logger *zap.Logger, cfg *Config) (PodmanClient, error) {
@@ -117,6 +159,24 @@ func (c mockClient) events(context.Context, url.Values) (<-chan event, <-chan er
 	return nil, nil
 }
 
+type mockStatsClient map[string]containerStats
+
+func (c mockStatsClient) stats(_ context.Context, options url.Values) ([]containerStats, error) {
+	return []containerStats{c[options.Get("containers")]}, nil
+}
+
+func (c mockStatsClient) ping(context.Context) error {
+	return nil
+}
+
+func (c mockStatsClient) list(context.Context, url.Values) ([]container, error) {
+	return nil, nil
+}
+
+func (c mockStatsClient) events(context.Context, url.Values) (<-chan event, <-chan error) {
+	return nil, nil
+}
+
 func (m mockConsumer) Capabilities() consumer.Capabilities {
 	return consumer.Capabilities{}
 }
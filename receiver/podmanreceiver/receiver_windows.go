@@ -31,3 +31,13 @@ func newReceiver(
 ) (receiver.Metrics, error) {
 	return nil, fmt.Errorf("podman receiver is not supported on windows")
 }
+
+func newLogsReceiver(
+	_ context.Context,
+	settings receiver.CreateSettings,
+	config *Config,
+	nextConsumer consumer.Logs,
+	clientFactory interface{},
+) (receiver.Logs, error) {
+	return nil, fmt.Errorf("podman receiver is not supported on windows")
+}
@@ -29,3 +29,10 @@ func TestNewReceiver(t *testing.T) {
 	assert.Error(t, err)
 	assert.Equal(t, "podman receiver is not supported on windows", err.Error())
 }
+
+func TestNewLogsReceiver(t *testing.T) {
+	lr, err := newLogsReceiver(context.Background(), receivertest.NewNopCreateSettings(), &Config{}, consumertest.NewNop(), nil)
+	assert.Nil(t, lr)
+	assert.Error(t, err)
+	assert.Equal(t, "podman receiver is not supported on windows", err.Error())
+}
@@ -59,6 +59,15 @@ type Config struct {
 	UseStartTimeMetric   bool   `mapstructure:"use_start_time_metric"`
 	StartTimeMetricRegex string `mapstructure:"start_time_metric_regex"`
 
+	// MetadataCacheSize is the maximum number of metric metadata kept per target. The cached metadata
+	// are used when a scrape lacks the metadata of a metric, e.g. in the first scrapes after the target
+	// is redeployed. The cache is disabled when 0.
+	MetadataCacheSize int `mapstructure:"metadata_cache_size"`
+	// OutOfOrderTolerance is the maximum regression of the timestamps of a series that is considered
+	// as a restart of the target, which resets the start time of the series. The points older than the
+	// tolerance are kept and counted as out of order. It is ignored when UseStartTimeMetric is set.
+	OutOfOrderTolerance time.Duration `mapstructure:"out_of_order_tolerance"`
+
 	TargetAllocator *targetAllocator `mapstructure:"target_allocator"`
 
 	// ConfigPlaceholder is just an entry to make the configuration pass a check
@@ -139,6 +148,13 @@ func checkSDFile(filename string) error {
 
 // Validate checks the receiver configuration is valid.
 func (cfg *Config) Validate() error {
+	if cfg.MetadataCacheSize < 0 {
+		return fmt.Errorf("metadata_cache_size must not be negative: %d", cfg.MetadataCacheSize)
+	}
+	if cfg.OutOfOrderTolerance < 0 {
+		return fmt.Errorf("out_of_order_tolerance must not be negative: %v", cfg.OutOfOrderTolerance)
+	}
+
 	promConfig := cfg.PrometheusConfig
 	if promConfig != nil {
 		err := cfg.validatePromConfig(promConfig)
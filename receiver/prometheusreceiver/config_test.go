@@ -50,6 +50,8 @@ func TestLoadConfig(t *testing.T) {
 	assert.Equal(t, time.Duration(r1.PrometheusConfig.ScrapeConfigs[0].ScrapeInterval), 5*time.Second)
 	assert.Equal(t, r1.UseStartTimeMetric, true)
 	assert.Equal(t, r1.StartTimeMetricRegex, "^(.+_)*process_start_time_seconds$")
+	assert.Equal(t, 500, r1.MetadataCacheSize)
+	assert.Equal(t, 30*time.Second, r1.OutOfOrderTolerance)
 
 	assert.Equal(t, "http://my-targetallocator-service", r1.TargetAllocator.Endpoint)
 	assert.Equal(t, 30*time.Second, r1.TargetAllocator.Interval)
@@ -140,6 +142,24 @@ func TestLoadConfigFailsOnRenameDisallowed(t *testing.T) {
 
 }
 
+func TestLoadConfigFailsOnNegativeLimits(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "invalid-config-negative-limits.yaml"))
+	require.NoError(t, err)
+	factory := NewFactory()
+
+	sub, err := cm.Sub(component.NewIDWithName(typeStr, "metadata").String())
+	require.NoError(t, err)
+	cfg := factory.CreateDefaultConfig()
+	require.NoError(t, component.UnmarshalConfig(sub, cfg))
+	assert.EqualError(t, component.ValidateConfig(cfg), "metadata_cache_size must not be negative: -1")
+
+	sub, err = cm.Sub(component.NewIDWithName(typeStr, "tolerance").String())
+	require.NoError(t, err)
+	cfg = factory.CreateDefaultConfig()
+	require.NoError(t, component.UnmarshalConfig(sub, cfg))
+	assert.EqualError(t, component.ValidateConfig(cfg), "out_of_order_tolerance must not be negative: -5s")
+}
+
 func TestRejectUnsupportedPrometheusFeatures(t *testing.T) {
 	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "invalid-config-prometheus-unsupported-features.yaml"))
 	require.NoError(t, err)
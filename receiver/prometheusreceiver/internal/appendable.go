@@ -16,6 +16,7 @@ package internal // import "github.com/open-telemetry/opentelemetry-collector-co
 
 import (
 	"context"
+	"fmt"
 	"regexp"
 	"time"
 
@@ -34,6 +35,7 @@ type appendable struct {
 	useStartTimeMetric   bool
 	startTimeMetricRegex *regexp.Regexp
 	externalLabels       labels.Labels
+	metadataCache        *metadataCache
 
 	settings receiver.CreateSettings
 	obsrecv  *obsreport.Receiver
@@ -49,10 +51,16 @@ func NewAppendable(
 	startTimeMetricRegex *regexp.Regexp,
 	useCreatedMetric bool,
 	externalLabels labels.Labels,
-	registry *featuregate.Registry) (storage.Appendable, error) {
+	registry *featuregate.Registry,
+	metadataCacheSize int,
+	outOfOrderTolerance time.Duration) (storage.Appendable, error) {
+	if err := registerViews(); err != nil {
+		return nil, fmt.Errorf("failed to register metric views: %w", err)
+	}
+
 	var metricAdjuster MetricsAdjuster
 	if !useStartTimeMetric {
-		metricAdjuster = NewInitialPointAdjuster(set.Logger, gcInterval, useCreatedMetric)
+		metricAdjuster = NewInitialPointAdjuster(set, gcInterval, useCreatedMetric, outOfOrderTolerance)
 	} else {
 		metricAdjuster = NewStartTimeMetricAdjuster(set.Logger, startTimeMetricRegex)
 	}
@@ -69,11 +77,12 @@ func NewAppendable(
 		useStartTimeMetric:   useStartTimeMetric,
 		startTimeMetricRegex: startTimeMetricRegex,
 		externalLabels:       externalLabels,
+		metadataCache:        newMetadataCache(metadataCacheSize, gcInterval),
 		obsrecv:              obsrecv,
 		registry:             registry,
 	}, nil
 }
 
 func (o *appendable) Appender(ctx context.Context) storage.Appender {
-	return newTransaction(ctx, o.metricAdjuster, o.sink, o.externalLabels, o.settings, o.obsrecv, o.registry)
+	return newTransaction(ctx, o.metricAdjuster, o.sink, o.externalLabels, o.settings, o.obsrecv, o.registry, o.metadataCache)
 }
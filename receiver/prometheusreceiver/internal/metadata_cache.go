@@ -0,0 +1,112 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/prometheusreceiver/internal"
+
+import (
+	"sync"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/prometheus/prometheus/scrape"
+)
+
+// metadataCache keeps the latest metadata of the metrics of each target, so that the type of the
+// metrics is still known when a scrape lacks their metadata, e.g. in the first scrapes after the
+// target is redeployed.
+//
+// The caches of the targets are gc'd with the same mark-and-sweep approach as the JobsMap: each
+// time the cache of a target is wrapped it's marked, and once per gcInterval the caches that
+// haven't been marked since the last gc are removed, so that the caches of the targets that
+// disappeared from the service discovery don't pile up.
+type metadataCache struct {
+	size       int
+	gcInterval time.Duration
+
+	sync.Mutex
+	lastGC  time.Time
+	targets map[string]*targetMetadata
+}
+
+type targetMetadata struct {
+	cache *lru.Cache
+	mark  bool
+}
+
+// newMetadataCache returns a metadataCache holding up to size entries per target,
+// or nil if size isn't positive, which disables the cache.
+func newMetadataCache(size int, gcInterval time.Duration) *metadataCache {
+	if size <= 0 {
+		return nil
+	}
+	return &metadataCache{
+		size:       size,
+		gcInterval: gcInterval,
+		lastGC:     time.Now(),
+		targets:    make(map[string]*targetMetadata),
+	}
+}
+
+// wrap returns a scrape.MetricMetadataStore that looks up the metadata in the store first, and in the
+// cache of the target otherwise. The metadata found in the store are added to the cache.
+func (c *metadataCache) wrap(target string, store scrape.MetricMetadataStore) scrape.MetricMetadataStore {
+	if c == nil {
+		return store
+	}
+
+	c.Lock()
+	defer c.Unlock()
+	c.maybeGC()
+	tm, ok := c.targets[target]
+	if !ok {
+		// lru.New only fails for a non positive size.
+		cache, _ := lru.New(c.size)
+		tm = &targetMetadata{cache: cache}
+		c.targets[target] = tm
+	}
+	tm.mark = true
+	return &cachedMetadataStore{MetricMetadataStore: store, cache: tm.cache}
+}
+
+// maybeGC removes the caches of the targets that haven't been scraped since the last gc, if the
+// last gc is older than gcInterval. It must be called with the lock held.
+func (c *metadataCache) maybeGC() {
+	if time.Since(c.lastGC) <= c.gcInterval {
+		return
+	}
+	for target, tm := range c.targets {
+		if !tm.mark {
+			delete(c.targets, target)
+		} else {
+			tm.mark = false
+		}
+	}
+	c.lastGC = time.Now()
+}
+
+type cachedMetadataStore struct {
+	scrape.MetricMetadataStore
+	cache *lru.Cache
+}
+
+func (s *cachedMetadataStore) GetMetadata(metric string) (scrape.MetricMetadata, bool) {
+	if metadata, ok := s.MetricMetadataStore.GetMetadata(metric); ok {
+		s.cache.Add(metric, metadata)
+		return metadata, true
+	}
+	if cached, ok := s.cache.Get(metric); ok {
+		return cached.(scrape.MetricMetadata), true
+	}
+	return scrape.MetricMetadata{}, false
+}
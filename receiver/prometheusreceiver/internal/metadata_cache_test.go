@@ -0,0 +1,88 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/prometheus/model/textparse"
+	"github.com/prometheus/prometheus/scrape"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMetadataCacheDisabled(t *testing.T) {
+	cache := newMetadataCache(0, time.Minute)
+	assert.Nil(t, cache)
+
+	store := testMetadataStore{}
+	assert.Equal(t, store, cache.wrap("job:instance", store))
+}
+
+func TestMetadataCache(t *testing.T) {
+	counter := scrape.MetricMetadata{Metric: "counter", Type: textparse.MetricTypeCounter}
+	gauge := scrape.MetricMetadata{Metric: "gauge", Type: textparse.MetricTypeGauge}
+	cache := newMetadataCache(1, time.Minute)
+
+	store := cache.wrap("job:instance", testMetadataStore{"counter": counter})
+	metadata, ok := store.GetMetadata("counter")
+	assert.True(t, ok)
+	assert.Equal(t, counter, metadata)
+
+	// The metadata missing from the next scrape of the target are found in the cache.
+	store = cache.wrap("job:instance", testMetadataStore{})
+	metadata, ok = store.GetMetadata("counter")
+	assert.True(t, ok)
+	assert.Equal(t, counter, metadata)
+
+	// Each target has its own cache.
+	store = cache.wrap("job:other", testMetadataStore{})
+	_, ok = store.GetMetadata("counter")
+	assert.False(t, ok)
+
+	// The least recently used metadata are evicted when the cache is full.
+	store = cache.wrap("job:instance", testMetadataStore{"gauge": gauge})
+	metadata, ok = store.GetMetadata("gauge")
+	assert.True(t, ok)
+	assert.Equal(t, gauge, metadata)
+
+	store = cache.wrap("job:instance", testMetadataStore{})
+	_, ok = store.GetMetadata("counter")
+	assert.False(t, ok)
+}
+
+func TestMetadataCacheGC(t *testing.T) {
+	counter := scrape.MetricMetadata{Metric: "counter", Type: textparse.MetricTypeCounter}
+	cache := newMetadataCache(1, time.Minute)
+
+	cache.wrap("job:instance", testMetadataStore{"counter": counter}).GetMetadata("counter")
+	cache.wrap("job:gone", testMetadataStore{"counter": counter}).GetMetadata("counter")
+
+	// The first gc only unmarks the targets scraped since the creation of the cache.
+	cache.lastGC = time.Now().Add(-2 * time.Minute)
+	cache.wrap("job:instance", testMetadataStore{})
+	assert.Len(t, cache.targets, 2)
+
+	// The targets that weren't scraped since the previous gc are removed by the next one.
+	cache.lastGC = time.Now().Add(-2 * time.Minute)
+	store := cache.wrap("job:instance", testMetadataStore{})
+	assert.Len(t, cache.targets, 1)
+	metadata, ok := store.GetMetadata("counter")
+	assert.True(t, ok)
+	assert.Equal(t, counter, metadata)
+
+	_, ok = cache.wrap("job:gone", testMetadataStore{}).GetMetadata("counter")
+	assert.False(t, ok)
+}
@@ -20,8 +20,10 @@ import (
 	"sync"
 	"time"
 
+	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver"
 	semconv "go.opentelemetry.io/collector/semconv/v1.6.1"
 	"go.uber.org/zap"
 )
@@ -64,6 +66,9 @@ import (
 type timeseriesInfo struct {
 	mark bool
 
+	// previousTime is the timestamp of the latest point of the timeseries.
+	previousTime pcommon.Timestamp
+
 	number    numberInfo
 	histogram histogramInfo
 	summary   summaryInfo
@@ -244,17 +249,23 @@ type MetricsAdjuster interface {
 // and provides AdjustMetricSlice, which takes a sequence of metrics and adjust their start times based on
 // the initial points.
 type initialPointAdjuster struct {
-	jobsMap          *JobsMap
-	logger           *zap.Logger
-	useCreatedMetric bool
+	jobsMap             *JobsMap
+	logger              *zap.Logger
+	receiverID          component.ID
+	useCreatedMetric    bool
+	outOfOrderTolerance time.Duration
 }
 
 // NewInitialPointAdjuster returns a new MetricsAdjuster that adjust metrics' start times based on the initial received points.
-func NewInitialPointAdjuster(logger *zap.Logger, gcInterval time.Duration, useCreatedMetric bool) MetricsAdjuster {
+// When outOfOrderTolerance is set, the timeseries whose timestamp regresses within the tolerance are reset as if the target
+// restarted, and the points whose timestamp regresses further are counted as out of order.
+func NewInitialPointAdjuster(set receiver.CreateSettings, gcInterval time.Duration, useCreatedMetric bool, outOfOrderTolerance time.Duration) MetricsAdjuster {
 	return &initialPointAdjuster{
-		jobsMap:          NewJobsMap(gcInterval),
-		logger:           logger,
-		useCreatedMetric: useCreatedMetric,
+		jobsMap:             NewJobsMap(gcInterval),
+		logger:              set.Logger,
+		receiverID:          set.ID,
+		useCreatedMetric:    useCreatedMetric,
+		outOfOrderTolerance: outOfOrderTolerance,
 	}
 }
 
@@ -331,16 +342,19 @@ func (a *initialPointAdjuster) adjustMetricHistogram(tsm *timeseriesMap, current
 			tsi.histogram.startTime = currentDist.StartTimestamp()
 			tsi.histogram.previousCount = currentDist.Count()
 			tsi.histogram.previousSum = currentDist.Sum()
+			tsi.previousTime = currentDist.Timestamp()
 			continue
 		}
 
+		restarted := a.checkTimestamp(tsi, current.Name(), currentDist.Timestamp())
+
 		if currentDist.Flags().NoRecordedValue() {
 			// TODO: Investigate why this does not reset.
 			currentDist.SetStartTimestamp(tsi.histogram.startTime)
 			continue
 		}
 
-		if currentDist.Count() < tsi.histogram.previousCount || currentDist.Sum() < tsi.histogram.previousSum {
+		if restarted || currentDist.Count() < tsi.histogram.previousCount || currentDist.Sum() < tsi.histogram.previousSum {
 			// reset re-initialize everything.
 			tsi.histogram.startTime = currentDist.StartTimestamp()
 			tsi.histogram.previousCount = currentDist.Count()
@@ -372,16 +386,19 @@ func (a *initialPointAdjuster) adjustMetricSum(tsm *timeseriesMap, current pmetr
 			// initialize everything.
 			tsi.number.startTime = currentSum.StartTimestamp()
 			tsi.number.previousValue = currentSum.DoubleValue()
+			tsi.previousTime = currentSum.Timestamp()
 			continue
 		}
 
+		restarted := a.checkTimestamp(tsi, current.Name(), currentSum.Timestamp())
+
 		if currentSum.Flags().NoRecordedValue() {
 			// TODO: Investigate why this does not reset.
 			currentSum.SetStartTimestamp(tsi.number.startTime)
 			continue
 		}
 
-		if currentSum.DoubleValue() < tsi.number.previousValue {
+		if restarted || currentSum.DoubleValue() < tsi.number.previousValue {
 			// reset re-initialize everything.
 			tsi.number.startTime = currentSum.StartTimestamp()
 			tsi.number.previousValue = currentSum.DoubleValue()
@@ -413,18 +430,22 @@ func (a *initialPointAdjuster) adjustMetricSummary(tsm *timeseriesMap, current p
 			tsi.summary.startTime = currentSummary.StartTimestamp()
 			tsi.summary.previousCount = currentSummary.Count()
 			tsi.summary.previousSum = currentSummary.Sum()
+			tsi.previousTime = currentSummary.Timestamp()
 			continue
 		}
 
+		restarted := a.checkTimestamp(tsi, current.Name(), currentSummary.Timestamp())
+
 		if currentSummary.Flags().NoRecordedValue() {
 			// TODO: Investigate why this does not reset.
 			currentSummary.SetStartTimestamp(tsi.summary.startTime)
 			continue
 		}
 
-		if (currentSummary.Count() != 0 &&
-			tsi.summary.previousCount != 0 &&
-			currentSummary.Count() < tsi.summary.previousCount) ||
+		if restarted ||
+			(currentSummary.Count() != 0 &&
+				tsi.summary.previousCount != 0 &&
+				currentSummary.Count() < tsi.summary.previousCount) ||
 			(currentSummary.Sum() != 0 &&
 				tsi.summary.previousSum != 0 &&
 				currentSummary.Sum() < tsi.summary.previousSum) {
@@ -441,3 +462,23 @@ func (a *initialPointAdjuster) adjustMetricSummary(tsm *timeseriesMap, current p
 		currentSummary.SetStartTimestamp(tsi.summary.startTime)
 	}
 }
+
+// checkTimestamp compares the timestamp of a point with the one of the previous point of the timeseries,
+// and returns whether the target restarted. When the out of order tolerance is set, a point older than the
+// previous one within the tolerance is the first point after a restart of the target. An older point is
+// counted as out of order, and kept to be adjusted as if there was no tolerance.
+func (a *initialPointAdjuster) checkTimestamp(tsi *timeseriesInfo, metricName string, timestamp pcommon.Timestamp) bool {
+	if a.outOfOrderTolerance <= 0 || timestamp >= tsi.previousTime {
+		if timestamp > tsi.previousTime {
+			tsi.previousTime = timestamp
+		}
+		return false
+	}
+	if tsi.previousTime.AsTime().Sub(timestamp.AsTime()) > a.outOfOrderTolerance {
+		a.logger.Debug("Out of order point beyond the tolerance", zap.String("metric", metricName))
+		recordOutOfOrderPoint(a.receiverID)
+		return false
+	}
+	tsi.previousTime = timestamp
+	return true
+}
@@ -19,9 +19,12 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opencensus.io/stats/view"
+	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/receivertest"
 	semconv "go.opentelemetry.io/collector/semconv/v1.8.0"
-	"go.uber.org/zap"
 )
 
 var (
@@ -78,7 +81,7 @@ func TestGauge(t *testing.T) {
 			adjusted:    metrics(gaugeMetric(gauge1, doublePoint(k1v1k2v2, t3, t3, 55))),
 		},
 	}
-	runScript(t, NewInitialPointAdjuster(zap.NewNop(), time.Minute, true), "job", "0", script)
+	runScript(t, NewInitialPointAdjuster(receivertest.NewNopCreateSettings(), time.Minute, true, 0), "job", "0", script)
 }
 
 func TestSum(t *testing.T) {
@@ -109,7 +112,51 @@ func TestSum(t *testing.T) {
 			adjusted:    metrics(sumMetric(sum1, doublePoint(k1v1k2v2, t3, t5, 72))),
 		},
 	}
-	runScript(t, NewInitialPointAdjuster(zap.NewNop(), time.Minute, true), "job", "0", script)
+	runScript(t, NewInitialPointAdjuster(receivertest.NewNopCreateSettings(), time.Minute, true, 0), "job", "0", script)
+}
+
+func TestSumOutOfOrderTolerance(t *testing.T) {
+	script := []*metricsAdjusterTest{
+		{
+			description: "Sum Out Of Order: round 1 - initial instance, start time is established",
+			metrics:     metrics(sumMetric(sum1, doublePoint(k1v1k2v2, t3, t3, 10))),
+			adjusted:    metrics(sumMetric(sum1, doublePoint(k1v1k2v2, t3, t3, 10))),
+		},
+		{
+			description: "Sum Out Of Order: round 2 - instance adjusted based on round 1",
+			metrics:     metrics(sumMetric(sum1, doublePoint(k1v1k2v2, t4, t4, 20))),
+			adjusted:    metrics(sumMetric(sum1, doublePoint(k1v1k2v2, t3, t4, 20))),
+		},
+		{
+			description: "Sum Out Of Order: round 3 - timestamp regression within the tolerance, start time is reset",
+			metrics:     metrics(sumMetric(sum1, doublePoint(k1v1k2v2, t3, t3, 25))),
+			adjusted:    metrics(sumMetric(sum1, doublePoint(k1v1k2v2, t3, t3, 25))),
+		},
+		{
+			description: "Sum Out Of Order: round 4 - timestamp regression beyond the tolerance, point is kept and adjusted based on round 3",
+			metrics:     metrics(sumMetric(sum1, doublePoint(k1v1k2v2, t1, t1, 30))),
+			adjusted:    metrics(sumMetric(sum1, doublePoint(k1v1k2v2, t3, t1, 30))),
+		},
+		{
+			description: "Sum Out Of Order: round 5 - instance adjusted based on round 3",
+			metrics:     metrics(sumMetric(sum1, doublePoint(k1v1k2v2, t4, t4, 35))),
+			adjusted:    metrics(sumMetric(sum1, doublePoint(k1v1k2v2, t3, t4, 35))),
+		},
+	}
+	require.NoError(t, registerViews())
+	set := receivertest.NewNopCreateSettings()
+	set.ID = component.NewIDWithName("prometheus", "out_of_order")
+	runScript(t, NewInitialPointAdjuster(set, time.Minute, true, time.Millisecond), "job", "0", script)
+
+	rows, err := view.RetrieveData(viewOutOfOrderPoints.Name)
+	require.NoError(t, err)
+	var outOfOrder int64
+	for _, row := range rows {
+		if len(row.Tags) == 1 && row.Tags[0].Value == set.ID.String() {
+			outOfOrder = int64(row.Data.(*view.SumData).Value)
+		}
+	}
+	assert.Equal(t, int64(1), outOfOrder)
 }
 
 func TestSummaryNoCount(t *testing.T) {
@@ -136,7 +183,7 @@ func TestSummaryNoCount(t *testing.T) {
 		},
 	}
 
-	runScript(t, NewInitialPointAdjuster(zap.NewNop(), time.Minute, true), "job", "0", script)
+	runScript(t, NewInitialPointAdjuster(receivertest.NewNopCreateSettings(), time.Minute, true, 0), "job", "0", script)
 }
 
 func TestSummaryFlagNoRecordedValue(t *testing.T) {
@@ -153,7 +200,7 @@ func TestSummaryFlagNoRecordedValue(t *testing.T) {
 		},
 	}
 
-	runScript(t, NewInitialPointAdjuster(zap.NewNop(), time.Minute, true), "job", "0", script)
+	runScript(t, NewInitialPointAdjuster(receivertest.NewNopCreateSettings(), time.Minute, true, 0), "job", "0", script)
 }
 
 func TestSummary(t *testing.T) {
@@ -196,7 +243,7 @@ func TestSummary(t *testing.T) {
 		},
 	}
 
-	runScript(t, NewInitialPointAdjuster(zap.NewNop(), time.Minute, true), "job", "0", script)
+	runScript(t, NewInitialPointAdjuster(receivertest.NewNopCreateSettings(), time.Minute, true, 0), "job", "0", script)
 }
 
 func TestHistogram(t *testing.T) {
@@ -219,7 +266,7 @@ func TestHistogram(t *testing.T) {
 			adjusted:    metrics(histogramMetric(histogram1, histogramPoint(k1v1k2v2, t3, t4, bounds0, []uint64{7, 4, 2, 12}))),
 		},
 	}
-	runScript(t, NewInitialPointAdjuster(zap.NewNop(), time.Minute, true), "job", "0", script)
+	runScript(t, NewInitialPointAdjuster(receivertest.NewNopCreateSettings(), time.Minute, true, 0), "job", "0", script)
 }
 
 func TestHistogramFlagNoRecordedValue(t *testing.T) {
@@ -236,7 +283,7 @@ func TestHistogramFlagNoRecordedValue(t *testing.T) {
 		},
 	}
 
-	runScript(t, NewInitialPointAdjuster(zap.NewNop(), time.Minute, true), "job", "0", script)
+	runScript(t, NewInitialPointAdjuster(receivertest.NewNopCreateSettings(), time.Minute, true, 0), "job", "0", script)
 }
 
 func TestHistogramFlagNoRecordedValueFirstObservation(t *testing.T) {
@@ -253,7 +300,7 @@ func TestHistogramFlagNoRecordedValueFirstObservation(t *testing.T) {
 		},
 	}
 
-	runScript(t, NewInitialPointAdjuster(zap.NewNop(), time.Minute, true), "job", "0", script)
+	runScript(t, NewInitialPointAdjuster(receivertest.NewNopCreateSettings(), time.Minute, true, 0), "job", "0", script)
 }
 
 func TestSummaryFlagNoRecordedValueFirstObservation(t *testing.T) {
@@ -270,7 +317,7 @@ func TestSummaryFlagNoRecordedValueFirstObservation(t *testing.T) {
 		},
 	}
 
-	runScript(t, NewInitialPointAdjuster(zap.NewNop(), time.Minute, true), "job", "0", script)
+	runScript(t, NewInitialPointAdjuster(receivertest.NewNopCreateSettings(), time.Minute, true, 0), "job", "0", script)
 }
 
 func TestGaugeFlagNoRecordedValueFirstObservation(t *testing.T) {
@@ -287,7 +334,7 @@ func TestGaugeFlagNoRecordedValueFirstObservation(t *testing.T) {
 		},
 	}
 
-	runScript(t, NewInitialPointAdjuster(zap.NewNop(), time.Minute, true), "job", "0", script)
+	runScript(t, NewInitialPointAdjuster(receivertest.NewNopCreateSettings(), time.Minute, true, 0), "job", "0", script)
 }
 
 func TestSumFlagNoRecordedValueFirstObservation(t *testing.T) {
@@ -304,7 +351,7 @@ func TestSumFlagNoRecordedValueFirstObservation(t *testing.T) {
 		},
 	}
 
-	runScript(t, NewInitialPointAdjuster(zap.NewNop(), time.Minute, true), "job", "0", script)
+	runScript(t, NewInitialPointAdjuster(receivertest.NewNopCreateSettings(), time.Minute, true, 0), "job", "0", script)
 }
 
 func TestMultiMetrics(t *testing.T) {
@@ -368,7 +415,7 @@ func TestMultiMetrics(t *testing.T) {
 			),
 		},
 	}
-	runScript(t, NewInitialPointAdjuster(zap.NewNop(), time.Minute, true), "job", "0", script)
+	runScript(t, NewInitialPointAdjuster(receivertest.NewNopCreateSettings(), time.Minute, true, 0), "job", "0", script)
 }
 
 func TestNewDataPointsAdded(t *testing.T) {
@@ -430,7 +477,7 @@ func TestNewDataPointsAdded(t *testing.T) {
 			),
 		},
 	}
-	runScript(t, NewInitialPointAdjuster(zap.NewNop(), time.Minute, true), "job", "0", script)
+	runScript(t, NewInitialPointAdjuster(receivertest.NewNopCreateSettings(), time.Minute, true, 0), "job", "0", script)
 }
 
 func TestMultiTimeseries(t *testing.T) {
@@ -489,7 +536,7 @@ func TestMultiTimeseries(t *testing.T) {
 			),
 		},
 	}
-	runScript(t, NewInitialPointAdjuster(zap.NewNop(), time.Minute, true), "job", "0", script)
+	runScript(t, NewInitialPointAdjuster(receivertest.NewNopCreateSettings(), time.Minute, true, 0), "job", "0", script)
 }
 
 func TestEmptyLabels(t *testing.T) {
@@ -515,7 +562,7 @@ func TestEmptyLabels(t *testing.T) {
 			adjusted:    metrics(sumMetric(sum1, doublePoint(k1vEmptyk2vEmptyk3vEmpty, t1, t3, 88))),
 		},
 	}
-	runScript(t, NewInitialPointAdjuster(zap.NewNop(), time.Minute, true), "job", "0", script)
+	runScript(t, NewInitialPointAdjuster(receivertest.NewNopCreateSettings(), time.Minute, true, 0), "job", "0", script)
 }
 
 func TestTsGC(t *testing.T) {
@@ -569,7 +616,7 @@ func TestTsGC(t *testing.T) {
 		},
 	}
 
-	ma := NewInitialPointAdjuster(zap.NewNop(), time.Minute, true)
+	ma := NewInitialPointAdjuster(receivertest.NewNopCreateSettings(), time.Minute, true, 0)
 
 	// run round 1
 	runScript(t, ma, "job", "0", script1)
@@ -629,7 +676,7 @@ func TestJobGC(t *testing.T) {
 	}
 
 	gcInterval := 10 * time.Millisecond
-	ma := NewInitialPointAdjuster(zap.NewNop(), gcInterval, true)
+	ma := NewInitialPointAdjuster(receivertest.NewNopCreateSettings(), gcInterval, true, 0)
 
 	// run job 1, round 1 - all entries marked
 	runScript(t, ma, "job1", "0", job1Script1)
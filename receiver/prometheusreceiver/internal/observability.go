@@ -0,0 +1,62 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/prometheusreceiver/internal"
+
+import (
+	"context"
+	"sync"
+
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+	"go.opentelemetry.io/collector/component"
+)
+
+var (
+	registerViewsOnce sync.Once
+	errRegisterViews  error
+)
+
+// registerViews registers the views of the receiver metrics, only once for all the receivers.
+func registerViews() error {
+	registerViewsOnce.Do(func() {
+		errRegisterViews = view.Register(viewOutOfOrderPoints)
+	})
+	return errRegisterViews
+}
+
+var (
+	receiverTagKey = tag.MustNewKey("receiver")
+
+	mOutOfOrderPoints = stats.Int64(
+		"otelcol/prometheus_receiver/out_of_order_points",
+		"Number of points whose timestamp went back further than the out of order tolerance",
+		stats.UnitDimensionless)
+)
+
+var viewOutOfOrderPoints = &view.View{
+	Name:        mOutOfOrderPoints.Name(),
+	Description: mOutOfOrderPoints.Description(),
+	Measure:     mOutOfOrderPoints,
+	TagKeys:     []tag.Key{receiverTagKey},
+	Aggregation: view.Sum(),
+}
+
+func recordOutOfOrderPoint(id component.ID) {
+	_ = stats.RecordWithTags(
+		context.Background(),
+		[]tag.Mutator{tag.Upsert(receiverTagKey, id.String())},
+		mOutOfOrderPoints.M(int64(1)))
+}
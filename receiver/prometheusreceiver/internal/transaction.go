@@ -53,6 +53,7 @@ type transaction struct {
 	nodeResource   pcommon.Resource
 	logger         *zap.Logger
 	metricAdjuster MetricsAdjuster
+	metadataCache  *metadataCache
 	obsrecv        *obsreport.Receiver
 	// Used as buffer to calculate series ref hash.
 	bufBytes   []byte
@@ -66,13 +67,15 @@ func newTransaction(
 	externalLabels labels.Labels,
 	settings receiver.CreateSettings,
 	obsrecv *obsreport.Receiver,
-	registry *featuregate.Registry) *transaction {
+	registry *featuregate.Registry,
+	metadataCache *metadataCache) *transaction {
 	return &transaction{
 		ctx:            ctx,
 		families:       make(map[string]*metricFamily),
 		isNew:          true,
 		sink:           sink,
 		metricAdjuster: metricAdjuster,
+		metadataCache:  metadataCache,
 		externalLabels: externalLabels,
 		logger:         settings.Logger,
 		obsrecv:        obsrecv,
@@ -221,7 +224,7 @@ func (t *transaction) initTransaction(labels labels.Labels) error {
 	if !ok {
 		return errors.New("unable to find target in context")
 	}
-	t.mc, ok = scrape.MetricMetadataStoreFromContext(t.ctx)
+	mc, ok := scrape.MetricMetadataStoreFromContext(t.ctx)
 	if !ok {
 		return errors.New("unable to find MetricMetadataStore in context")
 	}
@@ -230,6 +233,7 @@ func (t *transaction) initTransaction(labels labels.Labels) error {
 	if job == "" || instance == "" {
 		return errNoJobInstance
 	}
+	t.mc = t.metadataCache.wrap(job+":"+instance, mc)
 	t.nodeResource = CreateResource(job, instance, target.DiscoveredLabels())
 	t.isNew = false
 	return nil
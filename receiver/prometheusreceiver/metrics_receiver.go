@@ -277,6 +277,8 @@ func (r *pReceiver) initPrometheusComponents(ctx context.Context, host component
 		featuregate.GetRegistry().IsEnabled(useCreatedMetricGateID),
 		r.cfg.PrometheusConfig.GlobalConfig.ExternalLabels,
 		r.registry,
+		r.cfg.MetadataCacheSize,
+		r.cfg.OutOfOrderTolerance,
 	)
 	if err != nil {
 		return err
@@ -16,8 +16,10 @@ package pulsarreceiver // import "github.com/open-telemetry/opentelemetry-collec
 
 import (
 	"errors"
+	"fmt"
 
 	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/linkedin/goavro/v2"
 	"go.opentelemetry.io/collector/component"
 )
 
@@ -29,6 +31,8 @@ type Config struct {
 	Topic string `mapstructure:"topic"`
 	// The Subscription that receiver will be consuming messages from (default "otlp_subscription")
 	Subscription string `mapstructure:"subscription"`
+	// The type of the subscription, one of "exclusive", "shared", "failover" or "key_shared" (default "failover")
+	SubscriptionType string `mapstructure:"subscription_type"`
 	// Encoding of the messages (default "otlp_proto")
 	Encoding string `mapstructure:"encoding"`
 	// Name specifies the consumer name.
@@ -38,6 +42,17 @@ type Config struct {
 	// Configure whether the Pulsar client accept untrusted TLS certificate from broker (default: false)
 	TLSAllowInsecureConnection bool           `mapstructure:"tls_allow_insecure_connection"`
 	Authentication             Authentication `mapstructure:"auth"`
+	// Schema configures the schema of the topic (default: no schema)
+	Schema Schema `mapstructure:"schema"`
+}
+
+type Schema struct {
+	// Type of the schema, "json" or "avro"
+	Type string `mapstructure:"type"`
+	// Avro definition of the JSON schema, required for the "json" type
+	Definition string `mapstructure:"definition"`
+	// Properties of the schema
+	Properties map[string]string `mapstructure:"properties"`
 }
 
 type Authentication struct {
@@ -74,8 +89,38 @@ type OAuth2 struct {
 
 var _ component.Config = (*Config)(nil)
 
+var subscriptionTypes = map[string]pulsar.SubscriptionType{
+	"exclusive":  pulsar.Exclusive,
+	"shared":     pulsar.Shared,
+	"failover":   pulsar.Failover,
+	"key_shared": pulsar.KeyShared,
+}
+
 // Validate checks the receiver configuration is valid
 func (cfg *Config) Validate() error {
+	if _, ok := subscriptionTypes[cfg.SubscriptionType]; !ok && cfg.SubscriptionType != "" {
+		return fmt.Errorf("subscription_type must be one of exclusive, shared, failover or key_shared, got %q", cfg.SubscriptionType)
+	}
+
+	switch cfg.Schema.Type {
+	case "":
+	case schemaTypeJSON:
+		if cfg.Encoding != "jaeger_json" && cfg.Encoding != "zipkin_json" {
+			return fmt.Errorf("schema.type %q requires a JSON encoding, got %q", schemaTypeJSON, cfg.Encoding)
+		}
+		if cfg.Schema.Definition == "" {
+			return errors.New("schema.definition is required for the json schema type")
+		}
+		if _, err := goavro.NewCodec(cfg.Schema.Definition); err != nil {
+			return fmt.Errorf("schema.definition is not a valid Avro schema: %w", err)
+		}
+	case schemaTypeAvro:
+		if cfg.Schema.Definition != "" {
+			return errors.New("schema.definition is not supported for the avro schema type")
+		}
+	default:
+		return fmt.Errorf("schema.type must be either %q or %q, got %q", schemaTypeJSON, schemaTypeAvro, cfg.Schema.Type)
+	}
 	return nil
 }
 
@@ -135,10 +180,21 @@ func (cfg *Config) consumerOptions() (pulsar.ConsumerOptions, error) {
 		SubscriptionName: cfg.Subscription,
 	}
 
+	if subscriptionType, ok := subscriptionTypes[cfg.SubscriptionType]; ok {
+		options.Type = subscriptionType
+	}
+
 	if len(cfg.ConsumerName) > 0 {
 		options.Name = cfg.ConsumerName
 	}
 
+	switch cfg.Schema.Type {
+	case schemaTypeJSON:
+		options.Schema = pulsar.NewJSONSchema(cfg.Schema.Definition, cfg.Schema.Properties)
+	case schemaTypeAvro:
+		options.Schema = pulsar.NewAvroSchema(avroPayloadSchema, cfg.Schema.Properties)
+	}
+
 	if options.SubscriptionName == "" || options.Topic == "" {
 		return options, errors.New("topic and subscription is required")
 	}
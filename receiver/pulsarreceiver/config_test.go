@@ -18,6 +18,7 @@ import (
 	"path/filepath"
 	"testing"
 
+	"github.com/apache/pulsar-client-go/pulsar"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component"
@@ -46,3 +47,90 @@ func TestLoadConfig(t *testing.T) {
 		cfg,
 	)
 }
+
+func TestLoadConfigKeyShared(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yml"))
+	require.NoError(t, err)
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig()
+
+	sub, err := cm.Sub(component.NewIDWithName(typeStr, "key_shared").String())
+	require.NoError(t, err)
+	require.NoError(t, component.UnmarshalConfig(sub, cfg))
+	require.NoError(t, component.ValidateConfig(cfg))
+
+	assert.Equal(t, &Config{
+		Topic:            "otel-pulsar",
+		Endpoint:         defaultServiceURL,
+		Subscription:     "otel-collector",
+		SubscriptionType: "key_shared",
+		Encoding:         defaultEncoding,
+		Schema: Schema{
+			Type:       schemaTypeAvro,
+			Properties: map[string]string{"owner": "otel"},
+		},
+	},
+		cfg,
+	)
+
+	options, err := cfg.(*Config).consumerOptions()
+	require.NoError(t, err)
+	assert.Equal(t, pulsar.KeyShared, options.Type)
+	require.NotNil(t, options.Schema)
+	assert.Equal(t, pulsar.AVRO, options.Schema.GetSchemaInfo().Type)
+}
+
+func TestValidateConfig(t *testing.T) {
+	tests := []struct {
+		name        string
+		config      *Config
+		expectedErr string
+	}{
+		{
+			name:        "invalid subscription type",
+			config:      &Config{Encoding: defaultEncoding, SubscriptionType: "unknown"},
+			expectedErr: `subscription_type must be one of exclusive, shared, failover or key_shared, got "unknown"`,
+		},
+		{
+			name:        "invalid schema type",
+			config:      &Config{Encoding: defaultEncoding, Schema: Schema{Type: "protobuf"}},
+			expectedErr: `schema.type must be either "json" or "avro", got "protobuf"`,
+		},
+		{
+			name:        "json schema with proto encoding",
+			config:      &Config{Encoding: defaultEncoding, Schema: Schema{Type: schemaTypeJSON, Definition: `{"type":"string"}`}},
+			expectedErr: `schema.type "json" requires a JSON encoding, got "otlp_proto"`,
+		},
+		{
+			name:        "json schema without definition",
+			config:      &Config{Encoding: "jaeger_json", Schema: Schema{Type: schemaTypeJSON}},
+			expectedErr: "schema.definition is required for the json schema type",
+		},
+		{
+			name:        "json schema with invalid definition",
+			config:      &Config{Encoding: "jaeger_json", Schema: Schema{Type: schemaTypeJSON, Definition: `{"type":"unknown"}`}},
+			expectedErr: "schema.definition is not a valid Avro schema",
+		},
+		{
+			name:        "avro schema with definition",
+			config:      &Config{Encoding: defaultEncoding, Schema: Schema{Type: schemaTypeAvro, Definition: `{"type":"string"}`}},
+			expectedErr: "schema.definition is not supported for the avro schema type",
+		},
+		{
+			name:   "json schema",
+			config: &Config{Encoding: "zipkin_json", SubscriptionType: "shared", Schema: Schema{Type: schemaTypeJSON, Definition: `{"type":"string"}`}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.Validate()
+			if tt.expectedErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.expectedErr)
+		})
+	}
+}
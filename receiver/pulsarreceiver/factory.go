@@ -32,6 +32,9 @@ const (
 	defaultConsumerName = ""
 	defaultSubscription = "otlp_subscription"
 	defaultServiceURL   = "pulsar://localhost:6650"
+
+	schemaTypeJSON = "json"
+	schemaTypeAvro = "avro"
 )
 
 // FactoryOption applies changes to PulsarExporterFactory.
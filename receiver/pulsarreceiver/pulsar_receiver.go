@@ -17,10 +17,12 @@ package pulsarreceiver // import "github.com/open-telemetry/opentelemetry-collec
 import (
 	"context"
 	"errors"
+	"fmt"
 	"strings"
 	"time"
 
 	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/linkedin/goavro/v2"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/receiver"
@@ -31,6 +33,44 @@ var errUnrecognizedEncoding = errors.New("unrecognized encoding")
 
 const alreadyClosedError = "AlreadyClosedError"
 
+// avroPayloadSchema is the schema of the messages published by the pulsar exporter with the avro schema type,
+// the marshaled payload is wrapped in a record along with its encoding.
+const avroPayloadSchema = `{"type":"record","name":"Payload","namespace":"io.opentelemetry.pulsar",` +
+	`"fields":[{"name":"encoding","type":"string"},{"name":"payload","type":"bytes"}]}`
+
+// avroPayloadCodec decodes the payload records with goavro native types. The pulsar avro
+// schema decodes the message values through JSON, which doesn't round trip the payload bytes.
+var avroPayloadCodec = mustNewCodec(avroPayloadSchema)
+
+func mustNewCodec(schema string) *goavro.Codec {
+	codec, err := goavro.NewCodec(schema)
+	if err != nil {
+		panic(err)
+	}
+	return codec
+}
+
+// messagePayload returns the payload to unmarshal, decoding the avro record with the avro schema type.
+func messagePayload(message pulsar.Message, schemaType string, encoding string) ([]byte, error) {
+	if schemaType != schemaTypeAvro {
+		return message.Payload(), nil
+	}
+	native, _, err := avroPayloadCodec.NativeFromBinary(message.Payload())
+	if err != nil {
+		return nil, err
+	}
+	record, ok := native.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("unexpected avro value of type %T", native)
+	}
+	valueEncoding, _ := record["encoding"].(string)
+	if valueEncoding != encoding {
+		return nil, fmt.Errorf("message encoding %q does not match the configured encoding %q", valueEncoding, encoding)
+	}
+	payload, _ := record["payload"].([]byte)
+	return payload, nil
+}
+
 type pulsarTracesConsumer struct {
 	tracesConsumer  consumer.Traces
 	topic           string
@@ -40,6 +80,7 @@ type pulsarTracesConsumer struct {
 	unmarshaler     TracesUnmarshaler
 	settings        receiver.CreateSettings
 	consumerOptions pulsar.ConsumerOptions
+	schemaType      string
 }
 
 func newTracesReceiver(config Config, set receiver.CreateSettings, unmarshalers map[string]TracesUnmarshaler, nextConsumer consumer.Traces) (*pulsarTracesConsumer, error) {
@@ -66,6 +107,7 @@ func newTracesReceiver(config Config, set receiver.CreateSettings, unmarshalers
 		settings:        set,
 		client:          client,
 		consumerOptions: consumerOptions,
+		schemaType:      config.Schema.Type,
 	}, nil
 }
 
@@ -105,11 +147,20 @@ func consumerTracesLoop(ctx context.Context, c *pulsarTracesConsumer) error {
 			continue
 		}
 
-		traces, err := unmarshaler.Unmarshal(message.Payload())
+		payload, err := messagePayload(message, c.schemaType, unmarshaler.Encoding())
+		if err != nil {
+			// The message can't be decoded on redelivery either, so it is acked and skipped.
+			c.settings.Logger.Error("failed to decode traces message, dropping it", zap.Error(err))
+			c.consumer.Ack(message)
+			continue
+		}
+
+		traces, err := unmarshaler.Unmarshal(payload)
 		if err != nil {
-			c.settings.Logger.Error("failed to unmarshaler traces message", zap.Error(err))
+			// The message can't be decoded on redelivery either, so it is acked and skipped.
+			c.settings.Logger.Error("failed to unmarshaler traces message, dropping it", zap.Error(err))
 			c.consumer.Ack(message)
-			return err
+			continue
 		}
 
 		if err := traceConsumer.ConsumeTraces(context.Background(), traces); err != nil {
@@ -138,6 +189,7 @@ type pulsarMetricsConsumer struct {
 	cancel          context.CancelFunc
 	settings        receiver.CreateSettings
 	consumerOptions pulsar.ConsumerOptions
+	schemaType      string
 }
 
 func newMetricsReceiver(config Config, set receiver.CreateSettings, unmarshalers map[string]MetricsUnmarshaler, nextConsumer consumer.Metrics) (*pulsarMetricsConsumer, error) {
@@ -164,6 +216,7 @@ func newMetricsReceiver(config Config, set receiver.CreateSettings, unmarshalers
 		settings:        set,
 		client:          client,
 		consumerOptions: consumerOptions,
+		schemaType:      config.Schema.Type,
 	}, nil
 }
 
@@ -205,11 +258,20 @@ func consumeMetricsLoop(ctx context.Context, c *pulsarMetricsConsumer) error {
 			continue
 		}
 
-		metrics, err := unmarshaler.Unmarshal(message.Payload())
+		payload, err := messagePayload(message, c.schemaType, unmarshaler.Encoding())
+		if err != nil {
+			// The message can't be decoded on redelivery either, so it is acked and skipped.
+			c.settings.Logger.Error("failed to decode metrics message, dropping it", zap.Error(err))
+			c.consumer.Ack(message)
+			continue
+		}
+
+		metrics, err := unmarshaler.Unmarshal(payload)
 		if err != nil {
-			c.settings.Logger.Error("failed to unmarshaler metrics message", zap.Error(err))
+			// The message can't be decoded on redelivery either, so it is acked and skipped.
+			c.settings.Logger.Error("failed to unmarshaler metrics message, dropping it", zap.Error(err))
 			c.consumer.Ack(message)
-			return err
+			continue
 		}
 
 		if err := metricsConsumer.ConsumeMetrics(context.Background(), metrics); err != nil {
@@ -239,6 +301,7 @@ type pulsarLogsConsumer struct {
 	cancel          context.CancelFunc
 	settings        receiver.CreateSettings
 	consumerOptions pulsar.ConsumerOptions
+	schemaType      string
 }
 
 func newLogsReceiver(config Config, set receiver.CreateSettings, unmarshalers map[string]LogsUnmarshaler, nextConsumer consumer.Logs) (*pulsarLogsConsumer, error) {
@@ -266,6 +329,7 @@ func newLogsReceiver(config Config, set receiver.CreateSettings, unmarshalers ma
 		settings:        set,
 		client:          client,
 		consumerOptions: consumerOptions,
+		schemaType:      config.Schema.Type,
 	}, nil
 }
 
@@ -305,11 +369,20 @@ func consumeLogsLoop(ctx context.Context, c *pulsarLogsConsumer) error {
 			continue
 		}
 
-		logs, err := unmarshaler.Unmarshal(message.Payload())
+		payload, err := messagePayload(message, c.schemaType, unmarshaler.Encoding())
+		if err != nil {
+			// The message can't be decoded on redelivery either, so it is acked and skipped.
+			c.settings.Logger.Error("failed to decode logs message, dropping it", zap.Error(err))
+			c.consumer.Ack(message)
+			continue
+		}
+
+		logs, err := unmarshaler.Unmarshal(payload)
 		if err != nil {
-			c.settings.Logger.Error("failed to unmarshaler logs message", zap.Error(err))
+			// The message can't be decoded on redelivery either, so it is acked and skipped.
+			c.settings.Logger.Error("failed to unmarshaler logs message, dropping it", zap.Error(err))
 			c.consumer.Ack(message)
-			return err
+			continue
 		}
 
 		if err := logsConsumer.ConsumeLogs(context.Background(), logs); err != nil {
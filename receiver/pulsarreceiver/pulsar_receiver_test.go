@@ -15,10 +15,15 @@
 package pulsarreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/pulsarreceiver"
 
 import (
+	"context"
 	"testing"
 
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/linkedin/goavro/v2"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/receiver/receivertest"
 )
 
@@ -29,3 +34,96 @@ func Test_newTracesReceiver_err(t *testing.T) {
 	_, err := newTracesReceiver(c, receivertest.NewNopCreateSettings(), defaultTracesUnmarshalers(), consumertest.NewNop())
 	assert.Error(t, err)
 }
+
+type mockMessage struct {
+	pulsar.Message
+	payload []byte
+}
+
+func (m mockMessage) Payload() []byte {
+	return m.payload
+}
+
+func Test_messagePayload(t *testing.T) {
+	message := mockMessage{payload: []byte("raw")}
+
+	payload, err := messagePayload(message, "", defaultEncoding)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("raw"), payload)
+
+	payload, err = messagePayload(message, schemaTypeJSON, defaultEncoding)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("raw"), payload)
+
+	// binary payloads, which aren't valid UTF-8, round trip through the avro record
+	wrapped := []byte{0x0a, 0xff, 0x00, 0xc3}
+	codec, err := goavro.NewCodec(avroPayloadSchema)
+	require.NoError(t, err)
+	record, err := codec.BinaryFromNative(nil, map[string]interface{}{"encoding": defaultEncoding, "payload": wrapped})
+	require.NoError(t, err)
+	message = mockMessage{payload: record}
+
+	payload, err = messagePayload(message, schemaTypeAvro, defaultEncoding)
+	require.NoError(t, err)
+	assert.Equal(t, wrapped, payload)
+
+	_, err = messagePayload(message, schemaTypeAvro, "jaeger_proto")
+	assert.EqualError(t, err, `message encoding "otlp_proto" does not match the configured encoding "jaeger_proto"`)
+
+	_, err = messagePayload(mockMessage{payload: []byte("raw")}, schemaTypeAvro, defaultEncoding)
+	assert.Error(t, err)
+}
+
+type mockConsumer struct {
+	pulsar.Consumer
+	messages chan pulsar.Message
+	acked    chan pulsar.Message
+}
+
+func (c *mockConsumer) Receive(ctx context.Context) (pulsar.Message, error) {
+	select {
+	case message := <-c.messages:
+		return message, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (c *mockConsumer) Ack(message pulsar.Message) {
+	c.acked <- message
+}
+
+func Test_consumeLogsLoop_skipsInvalidMessages(t *testing.T) {
+	ld := plog.NewLogs()
+	ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().Body().SetStr("log")
+	valid, err := (&plog.ProtoMarshaler{}).MarshalLogs(ld)
+	require.NoError(t, err)
+
+	mc := &mockConsumer{
+		messages: make(chan pulsar.Message, 2),
+		acked:    make(chan pulsar.Message, 2),
+	}
+	mc.messages <- mockMessage{payload: []byte{0xff, 0xff}}
+	mc.messages <- mockMessage{payload: valid}
+
+	sink := new(consumertest.LogsSink)
+	c := &pulsarLogsConsumer{
+		logsConsumer: sink,
+		unmarshaler:  defaultLogsUnmarshalers()[defaultEncoding],
+		consumer:     mc,
+		settings:     receivertest.NewNopCreateSettings(),
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		done <- consumeLogsLoop(ctx, c)
+	}()
+
+	// the invalid message is acked and the loop goes on with the next one
+	<-mc.acked
+	<-mc.acked
+	assert.Equal(t, 1, sink.LogRecordCount())
+
+	cancel()
+	assert.ErrorIs(t, <-done, context.Canceled)
+}
@@ -89,6 +89,8 @@ type Config struct {
 	// ResourceAttributes is a map of default resource attributes to add to each resource
 	// object received by this receiver from dynamically created receivers.
 	ResourceAttributes resourceAttributes `mapstructure:"resource_attributes"`
+	// Discovery configures the receivers created from the annotations of the discovered endpoints.
+	Discovery DiscoveryConfig `mapstructure:"discovery"`
 }
 
 func (cfg *Config) Unmarshal(componentParser *confmap.Conf) error {
@@ -113,6 +113,15 @@ func TestLoadConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			id: component.NewIDWithName(typeStr, "discovery"),
+			expected: func() component.Config {
+				cfg := createDefaultConfig().(*Config)
+				cfg.WatchObservers = []component.ID{component.NewID("mock_observer")}
+				cfg.Discovery.Enabled = true
+				return cfg
+			}(),
+		},
 	}
 
 	for _, tt := range tests {
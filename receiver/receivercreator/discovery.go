@@ -0,0 +1,180 @@
+// Copyright 2020, OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package receivercreator // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/receivercreator"
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	"go.opentelemetry.io/collector/component"
+	"gopkg.in/yaml.v3"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer"
+)
+
+const (
+	// logsEnabledAnnotation is the pod annotation that enables the collection of the pod logs.
+	logsEnabledAnnotation = "io.opentelemetry.discovery.logs/enabled"
+	// logsConfigAnnotation is the pod annotation holding the YAML config of the receiver collecting
+	// the pod logs. Setting it also enables the collection of the pod logs.
+	logsConfigAnnotation = "io.opentelemetry.discovery.logs/config"
+
+	// logsReceiverType is the type of the receiver created to collect the pod logs.
+	logsReceiverType = "filelog"
+	// logsReceiverName is the name of the receiver created to collect the pod logs.
+	logsReceiverName = "discovery"
+
+	// includeConfigKey is the filelog receiver key listing the files to read.
+	includeConfigKey = "include"
+	// operatorsConfigKey is the filelog receiver key listing the operators processing the logs.
+	operatorsConfigKey = "operators"
+	// podLogsPath is the pattern of the log files written by the kubelet for the containers
+	// of the pod, expanded from the pod endpoint.
+	podLogsPath = "/var/log/pods/`namespace`_`name`_`uid`/*/*.log"
+)
+
+// logsConfigKeys are the filelog receiver settings that can be set by the config annotation. The
+// settings selecting the files to read or delete, or where the receiver state is stored, are left
+// to the collector configuration.
+var logsConfigKeys = map[string]bool{
+	"attributes":                 true,
+	"encoding":                   true,
+	"force_flush_period":         true,
+	"include_file_name":          true,
+	"include_file_name_resolved": true,
+	"include_file_path":          true,
+	"include_file_path_resolved": true,
+	"max_log_size":               true,
+	"multiline":                  true,
+	operatorsConfigKey:           true,
+	"resource":                   true,
+	"start_at":                   true,
+}
+
+// logsOperatorTypes are the types of the operators that can be set by the config annotation. Only
+// parsers and transformers are allowed, so that pods can't read or write files through the input
+// and output operators.
+var logsOperatorTypes = map[string]bool{
+	"add":               true,
+	"copy":              true,
+	"csv_parser":        true,
+	"filter":            true,
+	"flatten":           true,
+	"json_parser":       true,
+	"key_value_parser":  true,
+	"move":              true,
+	"noop":              true,
+	"recombine":         true,
+	"regex_parser":      true,
+	"remove":            true,
+	"retain":            true,
+	"router":            true,
+	"scope_name_parser": true,
+	"severity_parser":   true,
+	"syslog_parser":     true,
+	"time_parser":       true,
+	"trace_parser":      true,
+	"uri_parser":        true,
+}
+
+// DiscoveryConfig configures the receivers created from the annotations of the discovered endpoints.
+type DiscoveryConfig struct {
+	// Enabled turns on the creation of receivers from the annotations of the discovered pods.
+	Enabled bool `mapstructure:"enabled"`
+}
+
+// logsReceiverTemplate returns the template of the receiver collecting the logs of the endpoint
+// as requested by its annotations. The returned bool is false if the endpoint didn't request its
+// logs to be collected.
+func logsReceiverTemplate(e observer.Endpoint) (receiverTemplate, bool, error) {
+	pod, ok := e.Details.(*observer.Pod)
+	if !ok {
+		return receiverTemplate{}, false, nil
+	}
+
+	rawConfig, enabled := pod.Annotations[logsConfigAnnotation]
+	if rawEnabled, ok := pod.Annotations[logsEnabledAnnotation]; ok {
+		var err error
+		if enabled, err = strconv.ParseBool(rawEnabled); err != nil {
+			return receiverTemplate{}, false, fmt.Errorf("invalid %q annotation value %q: %w", logsEnabledAnnotation, rawEnabled, err)
+		}
+	}
+	if !enabled {
+		return receiverTemplate{}, false, nil
+	}
+
+	cfg := userConfigMap{}
+	if err := yaml.Unmarshal([]byte(rawConfig), &cfg); err != nil {
+		return receiverTemplate{}, false, fmt.Errorf("invalid %q annotation: %w", logsConfigAnnotation, err)
+	}
+	if err := validateLogsConfig(cfg); err != nil {
+		return receiverTemplate{}, false, fmt.Errorf("invalid %q annotation: %w", logsConfigAnnotation, err)
+	}
+
+	return receiverTemplate{
+		receiverConfig: receiverConfig{
+			id:         component.NewIDWithName(logsReceiverType, logsReceiverName),
+			config:     cfg,
+			endpointID: e.ID,
+		},
+	}, true, nil
+}
+
+// validateLogsConfig checks that the config annotation only sets the allowed settings and operators.
+func validateLogsConfig(cfg userConfigMap) error {
+	keys := make([]string, 0, len(cfg))
+	for key := range cfg {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if !logsConfigKeys[key] {
+			return fmt.Errorf("setting %q is not allowed", key)
+		}
+	}
+
+	rawOperators, ok := cfg[operatorsConfigKey]
+	if !ok {
+		return nil
+	}
+	operators, ok := rawOperators.([]interface{})
+	if !ok {
+		return fmt.Errorf("%q must be a list", operatorsConfigKey)
+	}
+	for i, rawOperator := range operators {
+		operator, ok := rawOperator.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("operator %d must be a map", i)
+		}
+		operatorType, _ := operator["type"].(string)
+		if !logsOperatorTypes[operatorType] {
+			return fmt.Errorf("operator %d has type %q, which is not allowed", i, operatorType)
+		}
+	}
+	return nil
+}
+
+// podLogsConfig returns the config discovered for the receiver collecting the pod logs. It
+// always restricts the receiver to the log files of the pod containers.
+func podLogsConfig(env observer.EndpointEnv) (userConfigMap, error) {
+	path, err := evalBackticksInConfigValue(podLogsPath, env)
+	if err != nil {
+		return nil, err
+	}
+	return userConfigMap{
+		includeConfigKey: []interface{}{path},
+	}, nil
+}
@@ -0,0 +1,160 @@
+// Copyright 2020, OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package receivercreator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer"
+)
+
+func TestLogsReceiverTemplate(t *testing.T) {
+	podWithAnnotations := func(annotations map[string]string) observer.Endpoint {
+		p := pod
+		p.Annotations = annotations
+		return observer.Endpoint{ID: "pod-1", Target: "localhost", Details: &p}
+	}
+	discoveredID := component.NewIDWithName(logsReceiverType, logsReceiverName)
+
+	tests := []struct {
+		name       string
+		endpoint   observer.Endpoint
+		wantOK     bool
+		wantConfig userConfigMap
+		wantErr    string
+	}{
+		{
+			name:     "not_a_pod",
+			endpoint: portEndpoint,
+		},
+		{
+			name:     "no_annotations",
+			endpoint: podEndpoint,
+		},
+		{
+			name:       "enabled",
+			endpoint:   podWithAnnotations(map[string]string{logsEnabledAnnotation: "true"}),
+			wantOK:     true,
+			wantConfig: userConfigMap{},
+		},
+		{
+			name: "config",
+			endpoint: podWithAnnotations(map[string]string{
+				logsConfigAnnotation: "start_at: beginning\ninclude_file_path: true\n",
+			}),
+			wantOK: true,
+			wantConfig: userConfigMap{
+				"start_at":          "beginning",
+				"include_file_path": true,
+			},
+		},
+		{
+			name: "config_disabled",
+			endpoint: podWithAnnotations(map[string]string{
+				logsEnabledAnnotation: "false",
+				logsConfigAnnotation:  "start_at: beginning",
+			}),
+		},
+		{
+			name:     "invalid_enabled",
+			endpoint: podWithAnnotations(map[string]string{logsEnabledAnnotation: "yes please"}),
+			wantErr:  `invalid "io.opentelemetry.discovery.logs/enabled" annotation value "yes please"`,
+		},
+		{
+			name:     "invalid_config",
+			endpoint: podWithAnnotations(map[string]string{logsConfigAnnotation: "- not\n- a map"}),
+			wantErr:  `invalid "io.opentelemetry.discovery.logs/config" annotation`,
+		},
+		{
+			name: "operators",
+			endpoint: podWithAnnotations(map[string]string{
+				logsConfigAnnotation: "operators:\n  - type: json_parser\n  - type: move\n    from: attributes.msg\n    to: body\n",
+			}),
+			wantOK: true,
+			wantConfig: userConfigMap{
+				"operators": []interface{}{
+					map[string]interface{}{"type": "json_parser"},
+					map[string]interface{}{"type": "move", "from": "attributes.msg", "to": "body"},
+				},
+			},
+		},
+		{
+			name:     "include_override",
+			endpoint: podWithAnnotations(map[string]string{logsConfigAnnotation: "include: [/etc/shadow]"}),
+			wantErr:  `setting "include" is not allowed`,
+		},
+		{
+			name:     "storage",
+			endpoint: podWithAnnotations(map[string]string{logsConfigAnnotation: "storage: file_storage"}),
+			wantErr:  `setting "storage" is not allowed`,
+		},
+		{
+			name:     "delete_after_read",
+			endpoint: podWithAnnotations(map[string]string{logsConfigAnnotation: "delete_after_read: true"}),
+			wantErr:  `setting "delete_after_read" is not allowed`,
+		},
+		{
+			name: "output_operator",
+			endpoint: podWithAnnotations(map[string]string{
+				logsConfigAnnotation: "operators:\n  - type: json_parser\n  - type: file_output\n    path: /etc/passwd\n",
+			}),
+			wantErr: `operator 1 has type "file_output", which is not allowed`,
+		},
+		{
+			name: "input_operator",
+			endpoint: podWithAnnotations(map[string]string{
+				logsConfigAnnotation: "operators:\n  - type: file_input\n    include: [/var/log/*]\n",
+			}),
+			wantErr: `operator 0 has type "file_input", which is not allowed`,
+		},
+		{
+			name:     "operators_not_a_list",
+			endpoint: podWithAnnotations(map[string]string{logsConfigAnnotation: "operators: json_parser"}),
+			wantErr:  `"operators" must be a list`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			template, ok, err := logsReceiverTemplate(tt.endpoint)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.wantOK, ok)
+			if !ok {
+				return
+			}
+			assert.Equal(t, discoveredID, template.id)
+			assert.Equal(t, tt.endpoint.ID, template.endpointID)
+			assert.Equal(t, tt.wantConfig, template.config)
+		})
+	}
+}
+
+func TestPodLogsConfig(t *testing.T) {
+	env, err := podEndpoint.Env()
+	require.NoError(t, err)
+
+	cfg, err := podLogsConfig(env)
+	require.NoError(t, err)
+	assert.Equal(t, userConfigMap{
+		includeConfigKey: []interface{}{"/var/log/pods/default_pod-1_uid-1/*/*.log"},
+	}, cfg)
+}
@@ -23,6 +23,7 @@ import (
 	conventions "go.opentelemetry.io/collector/semconv/v1.6.1"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/sharedcomponent"
 )
 
 // This file implements factory for receiver_creator. A receiver_creator can create other receivers at runtime.
@@ -30,14 +31,22 @@ import (
 const (
 	typeStr   = "receiver_creator"
 	stability = component.StabilityLevelBeta
+	// logsStability is the stability level of the logs pipeline support.
+	logsStability = component.StabilityLevelAlpha
 )
 
+// receivers is the map of already created receiver_creator instances for particular configurations.
+// The same receiver_creator is shared by the metrics and logs pipelines so the observers are only
+// watched once and each discovered endpoint starts its receivers only once.
+var receivers = sharedcomponent.NewSharedComponents()
+
 // NewFactory creates a factory for receiver creator.
 func NewFactory() receiver.Factory {
 	return receiver.NewFactory(
 		typeStr,
 		createDefaultConfig,
-		receiver.WithMetrics(createMetricsReceiver, stability))
+		receiver.WithMetrics(createMetricsReceiver, stability),
+		receiver.WithLogs(createLogsReceiver, logsStability))
 }
 
 func createDefaultConfig() component.Config {
@@ -72,5 +81,28 @@ func createMetricsReceiver(
 	cfg component.Config,
 	consumer consumer.Metrics,
 ) (receiver.Metrics, error) {
-	return newReceiverCreator(params, cfg.(*Config), consumer)
+	if consumer == nil {
+		return nil, component.ErrNilNextConsumer
+	}
+	r := receivers.GetOrAdd(cfg, func() component.Component {
+		return newReceiverCreator(params, cfg.(*Config))
+	})
+	r.Unwrap().(*receiverCreator).nextConsumer = consumer
+	return r, nil
+}
+
+func createLogsReceiver(
+	ctx context.Context,
+	params receiver.CreateSettings,
+	cfg component.Config,
+	consumer consumer.Logs,
+) (receiver.Logs, error) {
+	if consumer == nil {
+		return nil, component.ErrNilNextConsumer
+	}
+	r := receivers.GetOrAdd(cfg, func() component.Component {
+		return newReceiverCreator(params, cfg.(*Config))
+	})
+	r.Unwrap().(*receiverCreator).nextLogsConsumer = consumer
+	return r, nil
 }
@@ -37,6 +37,10 @@ func TestCreateReceiver(t *testing.T) {
 	assert.NoError(t, err, "receiver creation failed")
 	assert.NotNil(t, tReceiver, "receiver creation failed")
 
+	lReceiver, err := factory.CreateLogsReceiver(context.Background(), params, cfg, consumertest.NewNop())
+	assert.NoError(t, err, "receiver creation failed")
+	assert.Same(t, tReceiver, lReceiver, "metrics and logs pipelines must share the receiver")
+
 	mReceiver, err := factory.CreateTracesReceiver(context.Background(), params, cfg, nil)
 	assert.Error(t, err)
 	assert.ErrorIs(t, err, component.ErrDataTypeIsNotSupported)
@@ -37,8 +37,10 @@ type observerHandler struct {
 	params receiver.CreateSettings
 	// receiversByEndpointID is a map of endpoint IDs to a receiver instance.
 	receiversByEndpointID receiverMap
-	// nextConsumer is the receiver_creator's own consumer
+	// nextConsumer is the receiver_creator's own metrics consumer
 	nextConsumer consumer.Metrics
+	// nextLogsConsumer is the receiver_creator's own logs consumer
+	nextLogsConsumer consumer.Logs
 	// runner starts and stops receiver instances.
 	runner runner
 }
@@ -116,49 +118,96 @@ func (obs *observerHandler) OnAdd(added []observer.Endpoint) {
 				continue
 			}
 
-			resAttrs := map[string]string{}
-			for k, v := range template.ResourceAttributes {
-				strVal, ok := v.(string)
-				if !ok {
-					obs.params.TelemetrySettings.Logger.Info(fmt.Sprintf("ignoring unsupported `resource_attributes` %q value %v", k, v))
-					continue
-				}
-				resAttrs[k] = strVal
-			}
+			obs.startReceiver(template, resolvedConfig, resolvedDiscoveredConfig, env, e)
+		}
 
-			// Adds default and/or configured resource attributes (e.g. k8s.pod.uid) to resources
-			// as telemetry is emitted.
-			resourceEnhancer, err := newResourceEnhancer(
-				obs.config.ResourceAttributes,
-				resAttrs,
-				env,
-				e,
-				obs.nextConsumer,
-			)
+		if obs.config.Discovery.Enabled {
+			obs.startDiscoveredReceivers(env, e)
+		}
+	}
+}
 
-			if err != nil {
-				obs.params.TelemetrySettings.Logger.Error("failed creating resource enhancer", zap.String("receiver", template.id.String()), zap.Error(err))
-				continue
-			}
+// startDiscoveredReceivers starts the receivers requested by the annotations of the endpoint.
+func (obs *observerHandler) startDiscoveredReceivers(env observer.EndpointEnv, e observer.Endpoint) {
+	template, ok, err := logsReceiverTemplate(e)
+	if err != nil {
+		obs.params.TelemetrySettings.Logger.Error("invalid logs discovery annotations", zap.String("endpoint_id", string(e.ID)), zap.Error(err))
+		return
+	}
+	if !ok {
+		return
+	}
 
-			rcvr, err := obs.runner.start(
-				receiverConfig{
-					id:         template.id,
-					config:     resolvedConfig,
-					endpointID: e.ID,
-				},
-				resolvedDiscoveredConfig,
-				resourceEnhancer,
-			)
+	obs.params.TelemetrySettings.Logger.Info("starting discovered receiver",
+		zap.String("name", template.id.String()),
+		zap.String("endpoint_id", string(e.ID)))
 
-			if err != nil {
-				obs.params.TelemetrySettings.Logger.Error("failed to start receiver", zap.String("receiver", template.id.String()), zap.Error(err))
-				continue
-			}
+	resolvedConfig, err := expandMap(template.config, env)
+	if err != nil {
+		obs.params.TelemetrySettings.Logger.Error("unable to resolve discovered logs config", zap.String("receiver", template.id.String()), zap.Error(err))
+		return
+	}
+
+	discoveredConfig, err := podLogsConfig(env)
+	if err != nil {
+		obs.params.TelemetrySettings.Logger.Error("unable to resolve discovered config", zap.String("receiver", template.id.String()), zap.Error(err))
+		return
+	}
+
+	obs.startReceiver(template, resolvedConfig, discoveredConfig, env, e)
+}
 
-			obs.receiversByEndpointID.Put(e.ID, rcvr)
+// startReceiver starts the receiver of the template for the given endpoint with its already
+// resolved configs.
+func (obs *observerHandler) startReceiver(
+	template receiverTemplate,
+	resolvedConfig userConfigMap,
+	resolvedDiscoveredConfig userConfigMap,
+	env observer.EndpointEnv,
+	e observer.Endpoint,
+) {
+	resAttrs := map[string]string{}
+	for k, v := range template.ResourceAttributes {
+		strVal, ok := v.(string)
+		if !ok {
+			obs.params.TelemetrySettings.Logger.Info(fmt.Sprintf("ignoring unsupported `resource_attributes` %q value %v", k, v))
+			continue
 		}
+		resAttrs[k] = strVal
+	}
+
+	// Adds default and/or configured resource attributes (e.g. k8s.pod.uid) to resources
+	// as telemetry is emitted.
+	resourceEnhancer, err := newResourceEnhancer(
+		obs.config.ResourceAttributes,
+		resAttrs,
+		env,
+		e,
+		obs.nextConsumer,
+		obs.nextLogsConsumer,
+	)
+
+	if err != nil {
+		obs.params.TelemetrySettings.Logger.Error("failed creating resource enhancer", zap.String("receiver", template.id.String()), zap.Error(err))
+		return
 	}
+
+	rcvr, err := obs.runner.start(
+		receiverConfig{
+			id:         template.id,
+			config:     resolvedConfig,
+			endpointID: e.ID,
+		},
+		resolvedDiscoveredConfig,
+		resourceEnhancer,
+	)
+
+	if err != nil {
+		obs.params.TelemetrySettings.Logger.Error("failed to start receiver", zap.String("receiver", template.id.String()), zap.Error(err))
+		return
+	}
+
+	obs.receiversByEndpointID.Put(e.ID, rcvr)
 }
 
 // OnRemove responds to endpoint removal notifications.
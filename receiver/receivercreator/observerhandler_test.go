@@ -20,7 +20,6 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"go.opentelemetry.io/collector/component"
-	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/receiver/receivertest"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer"
@@ -33,7 +32,7 @@ type mockRunner struct {
 func (run *mockRunner) start(
 	receiver receiverConfig,
 	discoveredConfig userConfigMap,
-	nextConsumer consumer.Metrics,
+	nextConsumer *resourceEnhancer,
 ) (component.Component, error) {
 	args := run.Called(receiver, discoveredConfig, nextConsumer)
 	return args.Get(0).(component.Component), args.Error(1)
@@ -170,3 +169,44 @@ func TestDynamicConfig(t *testing.T) {
 
 	runner.AssertExpectations(t)
 }
+
+func TestOnAddDiscoveredLogs(t *testing.T) {
+	runner := &mockRunner{}
+	set := receivertest.NewNopCreateSettings()
+	set.ID = component.NewID(typeStr)
+	cfg := createDefaultConfig().(*Config)
+	cfg.Discovery.Enabled = true
+	handler := &observerHandler{
+		params:                set,
+		config:                cfg,
+		receiversByEndpointID: receiverMap{},
+		runner:                runner,
+	}
+
+	annotatedPod := pod
+	annotatedPod.Annotations = map[string]string{
+		logsConfigAnnotation: "start_at: beginning\nattributes:\n  pod: '`name`'\n",
+	}
+
+	runner.On(
+		"start",
+		receiverConfig{
+			id: component.NewIDWithName(logsReceiverType, logsReceiverName),
+			config: userConfigMap{
+				"start_at":   "beginning",
+				"attributes": map[string]interface{}{"pod": "pod-1"},
+			},
+			endpointID: "pod-1",
+		},
+		userConfigMap{includeConfigKey: []interface{}{"/var/log/pods/default_pod-1_uid-1/*/*.log"}},
+		mock.IsType(&resourceEnhancer{}),
+	).Return(&nopWithEndpointReceiver{}, nil)
+
+	handler.OnAdd([]observer.Endpoint{
+		{ID: "pod-1", Target: "localhost", Details: &annotatedPod},
+		podEndpoint,
+	})
+
+	runner.AssertExpectations(t)
+	assert.Equal(t, 1, handler.receiversByEndpointID.Size())
+}
@@ -27,28 +27,25 @@ import (
 )
 
 var _ receiver.Metrics = (*receiverCreator)(nil)
+var _ receiver.Logs = (*receiverCreator)(nil)
 
-// receiverCreator implements consumer.Metrics.
+// receiverCreator implements receiver.Metrics and receiver.Logs.
 type receiverCreator struct {
-	params          receiver.CreateSettings
-	cfg             *Config
-	nextConsumer    consumer.Metrics
-	observerHandler *observerHandler
-	observables     []observer.Observable
+	params           receiver.CreateSettings
+	cfg              *Config
+	nextConsumer     consumer.Metrics
+	nextLogsConsumer consumer.Logs
+	observerHandler  *observerHandler
+	observables      []observer.Observable
 }
 
-// newReceiverCreator creates the receiver_creator with the given parameters.
-func newReceiverCreator(params receiver.CreateSettings, cfg *Config, nextConsumer consumer.Metrics) (receiver.Metrics, error) {
-	if nextConsumer == nil {
-		return nil, component.ErrNilNextConsumer
+// newReceiverCreator creates the receiver_creator with the given parameters, the next
+// consumers are set by the factory for each pipeline the receiver_creator is part of.
+func newReceiverCreator(params receiver.CreateSettings, cfg *Config) *receiverCreator {
+	return &receiverCreator{
+		params: params,
+		cfg:    cfg,
 	}
-
-	r := &receiverCreator{
-		params:       params,
-		cfg:          cfg,
-		nextConsumer: nextConsumer,
-	}
-	return r, nil
 }
 
 // loggingHost provides a safer version of host that logs errors instead of exiting the process.
@@ -71,6 +68,7 @@ func (rc *receiverCreator) Start(_ context.Context, host component.Host) error {
 		params:                rc.params,
 		receiversByEndpointID: receiverMap{},
 		nextConsumer:          rc.nextConsumer,
+		nextLogsConsumer:      rc.nextLogsConsumer,
 		runner: &receiverRunner{
 			params:      rc.params,
 			idNamespace: rc.params.ID,
@@ -19,19 +19,23 @@ import (
 	"fmt"
 
 	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer"
 )
 
 var _ consumer.Metrics = (*resourceEnhancer)(nil)
+var _ consumer.Logs = (*resourceEnhancer)(nil)
 
 // resourceEnhancer adds additional resource attribute entries
 // from the given endpoint environment. The added attributes vary based on the type
 // of the endpoint.
 type resourceEnhancer struct {
-	nextConsumer consumer.Metrics
-	attrs        map[string]string
+	nextConsumer     consumer.Metrics
+	nextLogsConsumer consumer.Logs
+	attrs            map[string]string
 }
 
 func newResourceEnhancer(
@@ -40,6 +44,7 @@ func newResourceEnhancer(
 	env observer.EndpointEnv,
 	endpoint observer.Endpoint,
 	nextConsumer consumer.Metrics,
+	nextLogsConsumer consumer.Logs,
 ) (*resourceEnhancer, error) {
 	attrs := map[string]string{}
 
@@ -65,8 +70,9 @@ func newResourceEnhancer(
 	}
 
 	return &resourceEnhancer{
-		nextConsumer: nextConsumer,
-		attrs:        attrs,
+		nextConsumer:     nextConsumer,
+		nextLogsConsumer: nextLogsConsumer,
+		attrs:            attrs,
 	}, nil
 }
 
@@ -77,15 +83,26 @@ func (r *resourceEnhancer) Capabilities() consumer.Capabilities {
 func (r *resourceEnhancer) ConsumeMetrics(ctx context.Context, md pmetric.Metrics) error {
 	rm := md.ResourceMetrics()
 	for i := 0; i < rm.Len(); i++ {
-		rms := rm.At(i)
-		attrs := rms.Resource().Attributes()
-
-		for attr, val := range r.attrs {
-			if _, found := attrs.Get(attr); !found {
-				attrs.PutStr(attr, val)
-			}
-		}
+		r.putAttributes(rm.At(i).Resource().Attributes())
 	}
 
 	return r.nextConsumer.ConsumeMetrics(ctx, md)
 }
+
+func (r *resourceEnhancer) ConsumeLogs(ctx context.Context, ld plog.Logs) error {
+	rl := ld.ResourceLogs()
+	for i := 0; i < rl.Len(); i++ {
+		r.putAttributes(rl.At(i).Resource().Attributes())
+	}
+
+	return r.nextLogsConsumer.ConsumeLogs(ctx, ld)
+}
+
+// putAttributes adds the enhancer attributes not already present in the resource attributes.
+func (r *resourceEnhancer) putAttributes(attrs pcommon.Map) {
+	for attr, val := range r.attrs {
+		if _, found := attrs.Get(attr); !found {
+			attrs.PutStr(attr, val)
+		}
+	}
+}
@@ -19,9 +19,11 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer"
@@ -184,7 +186,7 @@ func Test_newResourceEnhancer(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := newResourceEnhancer(tt.args.resources, tt.args.resourceAttributes, tt.args.env, tt.args.endpoint, tt.args.nextConsumer)
+			got, err := newResourceEnhancer(tt.args.resources, tt.args.resourceAttributes, tt.args.env, tt.args.endpoint, tt.args.nextConsumer, nil)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("newResourceEnhancer() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -256,3 +258,24 @@ func Test_resourceEnhancer_ConsumeMetrics(t *testing.T) {
 		})
 	}
 }
+
+func Test_resourceEnhancer_ConsumeLogs(t *testing.T) {
+	sink := &consumertest.LogsSink{}
+	r := &resourceEnhancer{
+		nextLogsConsumer: sink,
+		attrs: map[string]string{
+			"key1": "value1",
+			"key2": "value2",
+		},
+	}
+
+	ld := plog.NewLogs()
+	attrs := ld.ResourceLogs().AppendEmpty().Resource().Attributes()
+	attrs.PutStr("key2", "existing")
+	require.NoError(t, r.ConsumeLogs(context.Background(), ld))
+
+	logs := sink.AllLogs()
+	require.Len(t, logs, 1)
+	got := logs[0].ResourceLogs().At(0).Resource().Attributes().AsRaw()
+	assert.Equal(t, map[string]interface{}{"key1": "value1", "key2": "existing"}, got)
+}
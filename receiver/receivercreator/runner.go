@@ -21,7 +21,6 @@ import (
 	"github.com/spf13/cast"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/confmap"
-	"go.opentelemetry.io/collector/consumer"
 	rcvr "go.opentelemetry.io/collector/receiver"
 	"go.uber.org/zap"
 )
@@ -29,7 +28,7 @@ import (
 // runner starts and stops receiver instances.
 type runner interface {
 	// start a receiver instance from its static config and discovered config.
-	start(receiver receiverConfig, discoveredConfig userConfigMap, nextConsumer consumer.Metrics) (component.Component, error)
+	start(receiver receiverConfig, discoveredConfig userConfigMap, nextConsumer *resourceEnhancer) (component.Component, error)
 	// shutdown a receiver.
 	shutdown(rcvr component.Component) error
 }
@@ -47,7 +46,7 @@ var _ runner = (*receiverRunner)(nil)
 func (run *receiverRunner) start(
 	receiver receiverConfig,
 	discoveredConfig userConfigMap,
-	nextConsumer consumer.Metrics,
+	nextConsumer *resourceEnhancer,
 ) (component.Component, error) {
 	factory := run.host.GetFactory(component.KindReceiver, receiver.id.Type())
 
@@ -104,15 +103,23 @@ func (run *receiverRunner) loadRuntimeReceiverConfig(
 	return receiverCfg, cast.ToString(mergedConfig.Get(endpointConfigKey)), nil
 }
 
-// createRuntimeReceiver creates a receiver that is discovered at runtime.
+// createRuntimeReceiver creates a receiver that is discovered at runtime. A metrics receiver
+// is created if both the factory and the receiver_creator pipelines support metrics, otherwise
+// a logs receiver is created.
 func (run *receiverRunner) createRuntimeReceiver(
 	factory rcvr.Factory,
 	id component.ID,
 	cfg component.Config,
-	nextConsumer consumer.Metrics,
-) (rcvr.Metrics, error) {
+	nextConsumer *resourceEnhancer,
+) (component.Component, error) {
 	runParams := run.params
 	runParams.Logger = runParams.Logger.With(zap.String("name", id.String()))
 	runParams.ID = id
-	return factory.CreateMetricsReceiver(context.Background(), runParams, cfg, nextConsumer)
+	switch {
+	case nextConsumer.nextConsumer != nil && factory.MetricsReceiverStability() != component.StabilityLevelUndefined:
+		return factory.CreateMetricsReceiver(context.Background(), runParams, cfg, nextConsumer)
+	case nextConsumer.nextLogsConsumer != nil && factory.LogsReceiverStability() != component.StabilityLevelUndefined:
+		return factory.CreateLogsReceiver(context.Background(), runParams, cfg, nextConsumer)
+	}
+	return nil, fmt.Errorf("receiver %q does not support any of the receiver_creator pipeline types", id.String())
 }
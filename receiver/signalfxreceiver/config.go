@@ -31,6 +31,11 @@ type Config struct {
 	confighttp.HTTPServerSettings `mapstructure:",squash"` // squash ensures fields are correctly decoded in embedded struct
 
 	splunk.AccessTokenPassthroughConfig `mapstructure:",squash"`
+
+	// ReconstructHistograms combines the datapoints following the Prometheus histogram
+	// conventions ("<name>_bucket" with an "le" dimension, "<name>_count", "<name>_sum")
+	// into OTLP histograms instead of independent counters. Default is false.
+	ReconstructHistograms bool `mapstructure:"reconstruct_histograms"`
 }
 
 // Validate verifies that the endpoint is valid and the configured port is not 0
@@ -51,6 +51,7 @@ func TestLoadConfig(t *testing.T) {
 				AccessTokenPassthroughConfig: splunk.AccessTokenPassthroughConfig{
 					AccessTokenPassthrough: true,
 				},
+				ReconstructHistograms: true,
 			},
 		},
 		{
@@ -73,8 +73,6 @@ var (
 	errNextConsumerRespBody  = initJSONResponse(responseErrNextConsumer)
 	errLogsNotConfigured     = initJSONResponse(responseErrLogsNotConfigured)
 	errMetricsNotConfigured  = initJSONResponse(responseErrMetricsNotConfigured)
-
-	translator = &signalfx.ToTranslator{}
 )
 
 // sfxReceiver implements the receiver.Metrics for SignalFx metric protocol.
@@ -86,6 +84,7 @@ type sfxReceiver struct {
 	server          *http.Server
 	shutdownWG      sync.WaitGroup
 	obsrecv         *obsreport.Receiver
+	translator      *signalfx.ToTranslator
 }
 
 var _ receiver.Metrics = (*sfxReceiver)(nil)
@@ -111,6 +110,9 @@ func newReceiver(
 		settings: settings,
 		config:   &config,
 		obsrecv:  obsrecv,
+		translator: &signalfx.ToTranslator{
+			ReconstructHistograms: config.ReconstructHistograms,
+		},
 	}
 
 	return r, nil
@@ -250,7 +252,7 @@ func (r *sfxReceiver) handleDatapointReq(resp http.ResponseWriter, req *http.Req
 		return
 	}
 
-	md, err := translator.ToMetrics(msg.Datapoints)
+	md, err := r.translator.ToMetrics(msg.Datapoints)
 	if err != nil {
 		r.settings.Logger.Debug("SignalFx conversion error", zap.Error(err))
 	}
@@ -15,9 +15,13 @@
 package simpleprometheusreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/simpleprometheusreceiver"
 
 import (
+	"errors"
+	"fmt"
 	"net/url"
 	"time"
 
+	"github.com/prometheus/common/model"
+	"github.com/prometheus/prometheus/model/relabel"
 	"go.opentelemetry.io/collector/config/confighttp"
 )
 
@@ -36,6 +40,81 @@ type Config struct {
 	Labels map[string]string `mapstructure:"labels,omitempty"`
 	// Whether or not to use pod service account to authenticate.
 	UseServiceAccount bool `mapstructure:"use_service_account"`
+	// HonorLabels keeps the labels of the scraped metrics when they conflict
+	// with the static labels instead of renaming them to "exported_<label>".
+	HonorLabels bool `mapstructure:"honor_labels"`
+	// MetricRelabelConfigs are the rules applied to the scraped metrics before
+	// they are ingested.
+	MetricRelabelConfigs []RelabelConfig `mapstructure:"metric_relabel_configs"`
+}
+
+// RelabelConfig is the subset of the Prometheus relabel configuration supported
+// by the receiver.
+type RelabelConfig struct {
+	// Action is the action to perform, one of keep, drop or replace. Defaults to replace.
+	Action string `mapstructure:"action"`
+	// SourceLabels are the labels whose values, joined with ";", are matched
+	// against Regex. Use "__name__" to match the metric name.
+	SourceLabels []string `mapstructure:"source_labels"`
+	// Regex is the regular expression the source labels value is matched against. Defaults to "(.*)".
+	Regex string `mapstructure:"regex"`
+	// TargetLabel is the label set by the replace action. Use "__name__" to rename the metric.
+	TargetLabel string `mapstructure:"target_label"`
+	// Replacement is the value set by the replace action, it can reference
+	// the Regex capture groups. Defaults to "$1".
+	Replacement string `mapstructure:"replacement"`
+}
+
+// Validate checks if the receiver configuration is valid.
+func (cfg *Config) Validate() error {
+	for i, rc := range cfg.MetricRelabelConfigs {
+		if _, err := rc.relabelConfig(); err != nil {
+			return fmt.Errorf("invalid metric_relabel_configs[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// relabelConfig returns the Prometheus relabel configuration corresponding to rc.
+func (rc RelabelConfig) relabelConfig() (*relabel.Config, error) {
+	if len(rc.SourceLabels) == 0 {
+		return nil, errors.New("'source_labels' cannot be empty")
+	}
+
+	out := &relabel.Config{
+		Separator:   relabel.DefaultRelabelConfig.Separator,
+		Regex:       relabel.DefaultRelabelConfig.Regex,
+		TargetLabel: rc.TargetLabel,
+		Replacement: relabel.DefaultRelabelConfig.Replacement,
+	}
+	for _, label := range rc.SourceLabels {
+		out.SourceLabels = append(out.SourceLabels, model.LabelName(label))
+	}
+	if rc.Regex != "" {
+		regex, err := relabel.NewRegexp(rc.Regex)
+		if err != nil {
+			return nil, fmt.Errorf("invalid 'regex' %q: %w", rc.Regex, err)
+		}
+		out.Regex = regex
+	}
+	if rc.Replacement != "" {
+		out.Replacement = rc.Replacement
+	}
+
+	switch rc.Action {
+	case "", string(relabel.Replace):
+		if rc.TargetLabel == "" {
+			return nil, errors.New("'target_label' is required for the replace action")
+		}
+		out.Action = relabel.Replace
+	case string(relabel.Keep):
+		out.Action = relabel.Keep
+	case string(relabel.Drop):
+		out.Action = relabel.Drop
+	default:
+		return nil, fmt.Errorf("unsupported 'action' %q, must be one of keep, drop or replace", rc.Action)
+	}
+	return out, nil
 }
 
 // TODO: Move to a common package for use by other receivers and also pull
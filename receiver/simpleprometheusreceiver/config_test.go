@@ -85,6 +85,34 @@ func TestLoadConfig(t *testing.T) {
 				MetricsPath:        "/metrics",
 			},
 		},
+		{
+			id: component.NewIDWithName(typeStr, "relabel"),
+			expected: &Config{
+				HTTPClientSettings: confighttp.HTTPClientSettings{
+					Endpoint: "localhost:1234",
+				},
+				CollectionInterval: 30 * time.Second,
+				MetricsPath:        "/metrics",
+				HonorLabels:        true,
+				MetricRelabelConfigs: []RelabelConfig{
+					{
+						Action:       "drop",
+						SourceLabels: []string{"__name__"},
+						Regex:        "go_.*",
+					},
+					{
+						SourceLabels: []string{"__name__"},
+						Regex:        "app_(.*)",
+						TargetLabel:  "__name__",
+					},
+					{
+						Action:       "keep",
+						SourceLabels: []string{"env", "region"},
+						Regex:        "prod;.*",
+					},
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -101,3 +129,43 @@ func TestLoadConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestLoadInvalidConfig(t *testing.T) {
+	t.Parallel()
+
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+
+	tests := []struct {
+		id          component.ID
+		expectedErr string
+	}{
+		{
+			id:          component.NewIDWithName(typeStr, "invalid_action"),
+			expectedErr: `invalid metric_relabel_configs[0]: unsupported 'action' "labeldrop", must be one of keep, drop or replace`,
+		},
+		{
+			id:          component.NewIDWithName(typeStr, "invalid_regex"),
+			expectedErr: `invalid metric_relabel_configs[0]: invalid 'regex' "go_(.*"`,
+		},
+		{
+			id:          component.NewIDWithName(typeStr, "missing_target_label"),
+			expectedErr: "invalid metric_relabel_configs[0]: 'target_label' is required for the replace action",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			factory := NewFactory()
+			cfg := factory.CreateDefaultConfig()
+
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, component.UnmarshalConfig(sub, cfg))
+
+			err = component.ValidateConfig(cfg)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.expectedErr)
+		})
+	}
+}
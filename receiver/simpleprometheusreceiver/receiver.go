@@ -23,6 +23,7 @@ import (
 	"github.com/prometheus/common/model"
 	"github.com/prometheus/prometheus/config"
 	"github.com/prometheus/prometheus/discovery"
+	"github.com/prometheus/prometheus/model/relabel"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config/configtls"
 	"go.opentelemetry.io/collector/consumer"
@@ -113,6 +114,15 @@ func getPrometheusConfig(cfg *Config) (*prometheusreceiver.Config, error) {
 
 	httpConfig.BearerToken = configutil.Secret(bearerToken)
 
+	var metricRelabelConfigs []*relabel.Config
+	for _, rc := range cfg.MetricRelabelConfigs {
+		relabelConfig, err := rc.relabelConfig()
+		if err != nil {
+			return nil, err
+		}
+		metricRelabelConfigs = append(metricRelabelConfigs, relabelConfig)
+	}
+
 	labels := make(model.LabelSet, len(cfg.Labels)+1)
 	for k, v := range cfg.Labels {
 		labels[model.LabelName(k)] = model.LabelValue(v)
@@ -120,13 +130,15 @@ func getPrometheusConfig(cfg *Config) (*prometheusreceiver.Config, error) {
 	labels[model.AddressLabel] = model.LabelValue(cfg.Endpoint)
 
 	scrapeConfig := &config.ScrapeConfig{
-		ScrapeInterval:  model.Duration(cfg.CollectionInterval),
-		ScrapeTimeout:   model.Duration(cfg.CollectionInterval),
-		JobName:         fmt.Sprintf("%s/%s", typeStr, cfg.Endpoint),
-		HonorTimestamps: true,
-		Scheme:          scheme,
-		MetricsPath:     cfg.MetricsPath,
-		Params:          cfg.Params,
+		ScrapeInterval:       model.Duration(cfg.CollectionInterval),
+		ScrapeTimeout:        model.Duration(cfg.CollectionInterval),
+		JobName:              fmt.Sprintf("%s/%s", typeStr, cfg.Endpoint),
+		HonorTimestamps:      true,
+		HonorLabels:          cfg.HonorLabels,
+		Scheme:               scheme,
+		MetricsPath:          cfg.MetricsPath,
+		Params:               cfg.Params,
+		MetricRelabelConfigs: metricRelabelConfigs,
 		ServiceDiscoveryConfigs: discovery.Configs{
 			&discovery.StaticConfig{
 				{
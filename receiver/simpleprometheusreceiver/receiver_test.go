@@ -25,6 +25,7 @@ import (
 	"github.com/prometheus/common/model"
 	"github.com/prometheus/prometheus/config"
 	"github.com/prometheus/prometheus/discovery"
+	"github.com/prometheus/prometheus/model/relabel"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/config/confighttp"
@@ -205,6 +206,73 @@ func TestGetPrometheusConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Test with honor_labels and metric_relabel_configs",
+			config: &Config{
+				HTTPClientSettings: confighttp.HTTPClientSettings{
+					Endpoint: "localhost:1234",
+					TLSSetting: configtls.TLSClientSetting{
+						Insecure: true,
+					},
+				},
+				CollectionInterval: 10 * time.Second,
+				MetricsPath:        "/metrics",
+				HonorLabels:        true,
+				MetricRelabelConfigs: []RelabelConfig{
+					{
+						Action:       "drop",
+						SourceLabels: []string{"__name__"},
+						Regex:        "go_.*",
+					},
+					{
+						SourceLabels: []string{"__name__"},
+						Regex:        "app_(.*)",
+						TargetLabel:  "__name__",
+					},
+				},
+			},
+			want: &prometheusreceiver.Config{
+				PrometheusConfig: &config.Config{
+					ScrapeConfigs: []*config.ScrapeConfig{
+						{
+							ScrapeInterval:  model.Duration(10 * time.Second),
+							ScrapeTimeout:   model.Duration(10 * time.Second),
+							JobName:         "prometheus_simple/localhost:1234",
+							HonorTimestamps: true,
+							HonorLabels:     true,
+							Scheme:          "http",
+							MetricsPath:     "/metrics",
+							MetricRelabelConfigs: []*relabel.Config{
+								{
+									SourceLabels: model.LabelNames{"__name__"},
+									Separator:    ";",
+									Regex:        relabel.MustNewRegexp("go_.*"),
+									Replacement:  "$1",
+									Action:       relabel.Drop,
+								},
+								{
+									SourceLabels: model.LabelNames{"__name__"},
+									Separator:    ";",
+									Regex:        relabel.MustNewRegexp("app_(.*)"),
+									TargetLabel:  "__name__",
+									Replacement:  "$1",
+									Action:       relabel.Replace,
+								},
+							},
+							ServiceDiscoveryConfigs: discovery.Configs{
+								&discovery.StaticConfig{
+									{
+										Targets: []model.LabelSet{
+											{model.AddressLabel: model.LabelValue("localhost:1234")},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
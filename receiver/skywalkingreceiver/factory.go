@@ -28,6 +28,8 @@ import (
 	"go.opentelemetry.io/collector/config/confignet"
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/receiver"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/sharedcomponent"
 )
 
 const (
@@ -48,7 +50,9 @@ func NewFactory() receiver.Factory {
 	return receiver.NewFactory(
 		typeStr,
 		createDefaultConfig,
-		receiver.WithTraces(createTracesReceiver, stability))
+		receiver.WithTraces(createTracesReceiver, stability),
+		receiver.WithMetrics(createMetricsReceiver, component.StabilityLevelDevelopment),
+		receiver.WithLogs(createLogsReceiver, component.StabilityLevelDevelopment))
 }
 
 // CreateDefaultConfig creates the default configuration for Skywalking receiver.
@@ -75,7 +79,45 @@ func createTracesReceiver(
 	cfg component.Config,
 	nextConsumer consumer.Traces,
 ) (receiver.Traces, error) {
+	r, err := getOrCreateReceiver(set, cfg)
+	if err != nil {
+		return nil, err
+	}
+	r.Unwrap().(*swReceiver).registerTraceConsumer(nextConsumer)
+	return r, nil
+}
+
+// createMetricsReceiver creates a metrics receiver based on provided config.
+func createMetricsReceiver(
+	_ context.Context,
+	set receiver.CreateSettings,
+	cfg component.Config,
+	nextConsumer consumer.Metrics,
+) (receiver.Metrics, error) {
+	r, err := getOrCreateReceiver(set, cfg)
+	if err != nil {
+		return nil, err
+	}
+	r.Unwrap().(*swReceiver).registerMetricsConsumer(nextConsumer)
+	return r, nil
+}
 
+// createLogsReceiver creates a logs receiver based on provided config.
+func createLogsReceiver(
+	_ context.Context,
+	set receiver.CreateSettings,
+	cfg component.Config,
+	nextConsumer consumer.Logs,
+) (receiver.Logs, error) {
+	r, err := getOrCreateReceiver(set, cfg)
+	if err != nil {
+		return nil, err
+	}
+	r.Unwrap().(*swReceiver).registerLogsConsumer(nextConsumer)
+	return r, nil
+}
+
+func getOrCreateReceiver(set receiver.CreateSettings, cfg component.Config) (*sharedcomponent.SharedComponent, error) {
 	// Convert settings in the source c to configuration struct
 	// that Skywalking receiver understands.
 	rCfg := cfg.(*Config)
@@ -98,7 +140,15 @@ func createTracesReceiver(
 	}
 
 	// Create the receiver.
-	return newSkywalkingReceiver(&c, nextConsumer, set)
+	r := receivers.GetOrAdd(cfg, func() component.Component {
+		var rcv *swReceiver
+		rcv, err = newSkywalkingReceiver(&c, set)
+		return rcv
+	})
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
 }
 
 // extract the port number from string in "address:port" format. If the
@@ -117,3 +167,9 @@ func extractPortFromEndpoint(endpoint string) (int, error) {
 	}
 	return int(port), nil
 }
+
+// This is the map of already created Skywalking receivers for particular configurations.
+// We maintain this map because the Factory is asked trace, metric and log receivers separately
+// when it gets CreateTracesReceiver(), CreateMetricsReceiver() and CreateLogsReceiver() but they
+// must not create separate objects, they must use one swReceiver object per configuration.
+var receivers = sharedcomponent.NewSharedComponents()
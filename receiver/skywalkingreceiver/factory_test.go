@@ -29,6 +29,8 @@ import (
 	"go.opentelemetry.io/collector/config/configtls"
 	"go.opentelemetry.io/collector/confmap/confmaptest"
 	"go.opentelemetry.io/collector/receiver/receivertest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/sharedcomponent"
 )
 
 func TestTypeStr(t *testing.T) {
@@ -60,8 +62,12 @@ func TestCreateReceiver(t *testing.T) {
 	assert.NotNil(t, tReceiver, "receiver creation failed")
 
 	mReceiver, err := factory.CreateMetricsReceiver(context.Background(), set, cfg, nil)
-	assert.Equal(t, err, component.ErrDataTypeIsNotSupported)
-	assert.Nil(t, mReceiver)
+	assert.NoError(t, err, "receiver creation failed")
+	assert.Same(t, tReceiver, mReceiver, "receiver must be shared between signals")
+
+	lReceiver, err := factory.CreateLogsReceiver(context.Background(), set, cfg, nil)
+	assert.NoError(t, err, "receiver creation failed")
+	assert.Same(t, tReceiver, lReceiver, "receiver must be shared between signals")
 }
 
 func TestCreateReceiverGeneralConfig(t *testing.T) {
@@ -80,8 +86,12 @@ func TestCreateReceiverGeneralConfig(t *testing.T) {
 	assert.NotNil(t, tReceiver, "receiver creation failed")
 
 	mReceiver, err := factory.CreateMetricsReceiver(context.Background(), set, cfg, nil)
-	assert.Equal(t, err, component.ErrDataTypeIsNotSupported)
-	assert.Nil(t, mReceiver)
+	assert.NoError(t, err, "receiver creation failed")
+	assert.Same(t, tReceiver, mReceiver, "receiver must be shared between signals")
+
+	lReceiver, err := factory.CreateLogsReceiver(context.Background(), set, cfg, nil)
+	assert.NoError(t, err, "receiver creation failed")
+	assert.Same(t, tReceiver, lReceiver, "receiver must be shared between signals")
 }
 
 func TestCreateDefaultGRPCEndpoint(t *testing.T) {
@@ -98,7 +108,7 @@ func TestCreateDefaultGRPCEndpoint(t *testing.T) {
 	r, err := factory.CreateTracesReceiver(context.Background(), set, cfg, nil)
 
 	assert.NoError(t, err, "unexpected error creating receiver")
-	assert.Equal(t, 11800, r.(*swReceiver).config.CollectorGRPCPort, "grpc port should be default")
+	assert.Equal(t, 11800, r.(*sharedcomponent.SharedComponent).Unwrap().(*swReceiver).config.CollectorGRPCPort, "grpc port should be default")
 }
 
 func TestCreateTLSGPRCEndpoint(t *testing.T) {
@@ -154,5 +164,5 @@ func TestCreateInvalidHTTPEndpoint(t *testing.T) {
 	r, err := factory.CreateTracesReceiver(context.Background(), set, cfg, nil)
 
 	assert.NoError(t, err, "unexpected error creating receiver")
-	assert.Equal(t, 12800, r.(*swReceiver).config.CollectorHTTPPort, "http port should be default")
+	assert.Equal(t, 12800, r.(*sharedcomponent.SharedComponent).Unwrap().(*swReceiver).config.CollectorHTTPPort, "http port should be default")
 }
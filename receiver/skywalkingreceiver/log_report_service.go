@@ -0,0 +1,95 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package skywalkingreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/skywalkingreceiver"
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"go.opentelemetry.io/collector/obsreport"
+	"google.golang.org/protobuf/encoding/protojson"
+	common "skywalking.apache.org/repo/goapi/collect/common/v3"
+	logging "skywalking.apache.org/repo/goapi/collect/logging/v3"
+)
+
+type logReportService struct {
+	sr *swReceiver
+	logging.UnimplementedLogReportServiceServer
+}
+
+func (s *logReportService) Collect(stream logging.LogReportService_CollectServer) error {
+	var logs []*logging.LogData
+	for {
+		logData, err := stream.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				if err = s.sr.consumeLogs(stream.Context(), s.sr.grpcObsrecv, logs); err != nil {
+					return err
+				}
+				return stream.SendAndClose(&common.Commands{})
+			}
+			return err
+		}
+		logs = append(logs, logData)
+	}
+}
+
+func (sr *swReceiver) consumeLogs(ctx context.Context, obsrecv *obsreport.Receiver, logs []*logging.LogData) error {
+	if len(logs) == 0 {
+		return nil
+	}
+	ld := SkywalkingToLogs(logs)
+	ctx = obsrecv.StartLogsOp(ctx)
+	err := sr.logsConsumer.ConsumeLogs(ctx, ld)
+	obsrecv.EndLogsOp(ctx, typeStr, ld.LogRecordCount(), err)
+	return err
+}
+
+// httpLogsHandler handles the JSON array of logs sent to the Skywalking HTTP log endpoint.
+func (sr *swReceiver) httpLogsHandler(rsp http.ResponseWriter, r *http.Request) {
+	rsp.Header().Set("Content-Type", "application/json")
+	b, err := io.ReadAll(r.Body)
+	if err != nil {
+		response := &Response{Status: failing, Msg: err.Error()}
+		ResponseWithJSON(rsp, response, http.StatusBadRequest)
+		return
+	}
+
+	// The log bodies are protobuf oneofs, so every element must be decoded with protojson.
+	var rawLogs []json.RawMessage
+	if err = json.Unmarshal(b, &rawLogs); err != nil {
+		response := &Response{Status: failing, Msg: err.Error()}
+		ResponseWithJSON(rsp, response, http.StatusBadRequest)
+		return
+	}
+	logs := make([]*logging.LogData, 0, len(rawLogs))
+	for _, raw := range rawLogs {
+		logData := &logging.LogData{}
+		if err = protojson.Unmarshal(raw, logData); err != nil {
+			response := &Response{Status: failing, Msg: err.Error()}
+			ResponseWithJSON(rsp, response, http.StatusBadRequest)
+			return
+		}
+		logs = append(logs, logData)
+	}
+
+	if err = sr.consumeLogs(r.Context(), sr.httpObsrecv, logs); err != nil {
+		response := &Response{Status: failing, Msg: err.Error()}
+		ResponseWithJSON(rsp, response, http.StatusInternalServerError)
+	}
+}
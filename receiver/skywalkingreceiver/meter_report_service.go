@@ -0,0 +1,77 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package skywalkingreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/skywalkingreceiver"
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	common "skywalking.apache.org/repo/goapi/collect/common/v3"
+	agent "skywalking.apache.org/repo/goapi/collect/language/agent/v3"
+)
+
+type meterReportService struct {
+	sr *swReceiver
+	agent.UnimplementedMeterReportServiceServer
+}
+
+// Collect receives a stream of meters where, per the Skywalking protocol, only the
+// first element carries the service and instance names.
+func (s *meterReportService) Collect(stream agent.MeterReportService_CollectServer) error {
+	var meters []*agent.MeterData
+	for {
+		meterData, err := stream.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				if err = s.consumeMetrics(stream.Context(), meters); err != nil {
+					return err
+				}
+				return stream.SendAndClose(&common.Commands{})
+			}
+			return err
+		}
+		meters = append(meters, meterData)
+	}
+}
+
+// CollectBatch receives a stream of meter collections, each of them carrying the
+// service and instance names in its first element.
+func (s *meterReportService) CollectBatch(stream agent.MeterReportService_CollectBatchServer) error {
+	for {
+		collection, err := stream.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return stream.SendAndClose(&common.Commands{})
+			}
+			return err
+		}
+
+		if err = s.consumeMetrics(stream.Context(), collection.GetMeterData()); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *meterReportService) consumeMetrics(ctx context.Context, meters []*agent.MeterData) error {
+	if len(meters) == 0 {
+		return nil
+	}
+	md := SkywalkingToMetrics(meters)
+	ctx = s.sr.grpcObsrecv.StartMetricsOp(ctx)
+	err := s.sr.metricsConsumer.ConsumeMetrics(ctx, md)
+	s.sr.grpcObsrecv.EndMetricsOp(ctx, typeStr, md.DataPointCount(), err)
+	return err
+}
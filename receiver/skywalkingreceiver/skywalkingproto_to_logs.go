@@ -0,0 +1,86 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package skywalkingreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/skywalkingreceiver"
+
+import (
+	"go.opentelemetry.io/collector/pdata/plog"
+	conventions "go.opentelemetry.io/collector/semconv/v1.8.0"
+	logging "skywalking.apache.org/repo/goapi/collect/logging/v3"
+)
+
+const (
+	AttributeSkywalkingEndpoint = "sw8.endpoint"
+
+	// swLogLevelTag is the tag used by the Skywalking agents to report the level of a log.
+	swLogLevelTag = "level"
+)
+
+// SkywalkingToLogs converts the logs reported by a Skywalking agent to logs, grouping
+// consecutive logs of the same service instance under the same resource.
+func SkywalkingToLogs(logs []*logging.LogData) plog.Logs {
+	ld := plog.NewLogs()
+
+	var records plog.LogRecordSlice
+	var service, instance string
+	for _, logData := range logs {
+		if logData == nil {
+			continue
+		}
+		if ld.ResourceLogs().Len() == 0 || logData.GetService() != service || logData.GetServiceInstance() != instance {
+			service, instance = logData.GetService(), logData.GetServiceInstance()
+			rl := ld.ResourceLogs().AppendEmpty()
+			rl.Resource().Attributes().PutStr(conventions.AttributeServiceName, service)
+			rl.Resource().Attributes().PutStr(conventions.AttributeServiceInstanceID, instance)
+			records = rl.ScopeLogs().AppendEmpty().LogRecords()
+		}
+		swLogToLogRecord(logData, records.AppendEmpty())
+	}
+	return ld
+}
+
+func swLogToLogRecord(logData *logging.LogData, dest plog.LogRecord) {
+	dest.SetTimestamp(microsecondsToTimestamp(logData.GetTimestamp()))
+
+	body := logData.GetBody()
+	switch content := body.GetContent().(type) {
+	case *logging.LogDataBody_Text:
+		dest.Body().SetStr(content.Text.GetText())
+	case *logging.LogDataBody_Json:
+		dest.Body().SetStr(content.Json.GetJson())
+	case *logging.LogDataBody_Yaml:
+		dest.Body().SetStr(content.Yaml.GetYaml())
+	}
+
+	attrs := dest.Attributes()
+	if endpoint := logData.GetEndpoint(); endpoint != "" {
+		attrs.PutStr(AttributeSkywalkingEndpoint, endpoint)
+	}
+
+	for _, tag := range logData.GetTags().GetData() {
+		if tag.GetKey() == swLogLevelTag {
+			dest.SetSeverityText(tag.GetValue())
+			continue
+		}
+		attrs.PutStr(tag.GetKey(), tag.GetValue())
+	}
+
+	if tc := logData.GetTraceContext(); tc != nil && tc.GetTraceId() != "" {
+		dest.SetTraceID(swTraceIDToTraceID(tc.GetTraceId()))
+		dest.SetSpanID(segmentIDToSpanID(tc.GetTraceSegmentId(), uint32(tc.GetSpanId())))
+		attrs.PutStr(AttributeSkywalkingTraceID, tc.GetTraceId())
+		attrs.PutStr(AttributeSkywalkingSegmentID, tc.GetTraceSegmentId())
+		attrs.PutInt(AttributeSkywalkingSpanID, int64(tc.GetSpanId()))
+	}
+}
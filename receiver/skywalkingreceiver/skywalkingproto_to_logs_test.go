@@ -0,0 +1,96 @@
+// Copyright  OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package skywalkingreceiver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	conventions "go.opentelemetry.io/collector/semconv/v1.8.0"
+	common "skywalking.apache.org/repo/goapi/collect/common/v3"
+	logging "skywalking.apache.org/repo/goapi/collect/logging/v3"
+)
+
+func TestSkywalkingToLogs(t *testing.T) {
+	logs := []*logging.LogData{
+		{
+			Timestamp:       1658000000000,
+			Service:         "demo-service",
+			ServiceInstance: "demo-instance",
+			Endpoint:        "/api/users",
+			Body: &logging.LogDataBody{
+				Content: &logging.LogDataBody_Text{Text: &logging.TextLog{Text: "user created"}},
+			},
+			TraceContext: &logging.TraceContext{
+				TraceId:        "de5980b8-fce3-4a37-aab9-b4ac3af7eedd",
+				TraceSegmentId: "56a5e1c519ae4c76a2b8b11d92cead7f.12.16563474296430001",
+				SpanId:         1,
+			},
+			Tags: &logging.LogTags{
+				Data: []*common.KeyStringValuePair{
+					{Key: "level", Value: "INFO"},
+					{Key: "thread", Value: "main"},
+				},
+			},
+		},
+		{
+			Timestamp:       1658000000001,
+			Service:         "demo-service",
+			ServiceInstance: "demo-instance",
+			Body: &logging.LogDataBody{
+				Content: &logging.LogDataBody_Json{Json: &logging.JSONLog{Json: `{"msg":"done"}`}},
+			},
+		},
+		{
+			Timestamp:       1658000000002,
+			Service:         "other-service",
+			ServiceInstance: "other-instance",
+			Body: &logging.LogDataBody{
+				Content: &logging.LogDataBody_Yaml{Yaml: &logging.YAMLLog{Yaml: "msg: done"}},
+			},
+		},
+	}
+
+	ld := SkywalkingToLogs(logs)
+	require.Equal(t, 2, ld.ResourceLogs().Len())
+
+	rl := ld.ResourceLogs().At(0)
+	service, _ := rl.Resource().Attributes().Get(conventions.AttributeServiceName)
+	assert.Equal(t, "demo-service", service.Str())
+	records := rl.ScopeLogs().At(0).LogRecords()
+	require.Equal(t, 2, records.Len())
+
+	lr := records.At(0)
+	assert.Equal(t, microsecondsToTimestamp(1658000000000), lr.Timestamp())
+	assert.Equal(t, "user created", lr.Body().Str())
+	assert.Equal(t, "INFO", lr.SeverityText())
+	assert.Equal(t, swTraceIDToTraceID("de5980b8-fce3-4a37-aab9-b4ac3af7eedd"), lr.TraceID())
+	assert.Equal(t, segmentIDToSpanID("56a5e1c519ae4c76a2b8b11d92cead7f.12.16563474296430001", 1), lr.SpanID())
+	assert.Equal(t, map[string]interface{}{
+		AttributeSkywalkingEndpoint:  "/api/users",
+		"thread":                     "main",
+		AttributeSkywalkingTraceID:   "de5980b8-fce3-4a37-aab9-b4ac3af7eedd",
+		AttributeSkywalkingSegmentID: "56a5e1c519ae4c76a2b8b11d92cead7f.12.16563474296430001",
+		AttributeSkywalkingSpanID:    int64(1),
+	}, lr.Attributes().AsRaw())
+
+	assert.Equal(t, `{"msg":"done"}`, records.At(1).Body().Str())
+	assert.True(t, records.At(1).TraceID().IsEmpty())
+
+	other := ld.ResourceLogs().At(1).ScopeLogs().At(0).LogRecords()
+	require.Equal(t, 1, other.Len())
+	assert.Equal(t, "msg: done", other.At(0).Body().Str())
+}
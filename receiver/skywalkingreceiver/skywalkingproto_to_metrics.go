@@ -0,0 +1,125 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package skywalkingreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/skywalkingreceiver"
+
+import (
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	conventions "go.opentelemetry.io/collector/semconv/v1.8.0"
+	agentV3 "skywalking.apache.org/repo/goapi/collect/language/agent/v3"
+)
+
+// SkywalkingToMetrics converts the meters reported by a Skywalking agent to metrics, grouped by
+// service instance. Skywalking only sets the service and instance names on the first meter of a
+// report, every following meter without them belongs to the same service instance.
+func SkywalkingToMetrics(meters []*agentV3.MeterData) pmetric.Metrics {
+	md := pmetric.NewMetrics()
+
+	type serviceInstance struct {
+		service  string
+		instance string
+	}
+	type resourceMetrics struct {
+		metrics pmetric.MetricSlice
+		byName  map[string]pmetric.Metric
+	}
+	resources := map[serviceInstance]*resourceMetrics{}
+
+	var current *resourceMetrics
+	for _, meter := range meters {
+		if meter == nil {
+			continue
+		}
+		if current == nil || meter.GetService() != "" {
+			key := serviceInstance{service: meter.GetService(), instance: meter.GetServiceInstance()}
+			current = resources[key]
+			if current == nil {
+				rm := md.ResourceMetrics().AppendEmpty()
+				rm.Resource().Attributes().PutStr(conventions.AttributeServiceName, key.service)
+				rm.Resource().Attributes().PutStr(conventions.AttributeServiceInstanceID, key.instance)
+				current = &resourceMetrics{
+					metrics: rm.ScopeMetrics().AppendEmpty().Metrics(),
+					byName:  map[string]pmetric.Metric{},
+				}
+				resources[key] = current
+			}
+		}
+
+		ts := microsecondsToTimestamp(meter.GetTimestamp())
+		switch m := meter.GetMetric().(type) {
+		case *agentV3.MeterData_SingleValue:
+			swSingleValueToDataPoint(m.SingleValue, ts, getOrAddMetric(current.byName, current.metrics, m.SingleValue.GetName(), pmetric.MetricTypeGauge))
+		case *agentV3.MeterData_Histogram:
+			swHistogramToDataPoint(m.Histogram, ts, getOrAddMetric(current.byName, current.metrics, m.Histogram.GetName(), pmetric.MetricTypeHistogram))
+		}
+	}
+	return md
+}
+
+func getOrAddMetric(byName map[string]pmetric.Metric, metrics pmetric.MetricSlice, name string, metricType pmetric.MetricType) pmetric.Metric {
+	if m, ok := byName[name]; ok && m.Type() == metricType {
+		return m
+	}
+	m := metrics.AppendEmpty()
+	m.SetName(name)
+	switch metricType {
+	case pmetric.MetricTypeGauge:
+		m.SetEmptyGauge()
+	case pmetric.MetricTypeHistogram:
+		// Skywalking agents never reset the bucket counts of their histograms.
+		m.SetEmptyHistogram().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	}
+	byName[name] = m
+	return m
+}
+
+func swSingleValueToDataPoint(value *agentV3.MeterSingleValue, ts pcommon.Timestamp, dest pmetric.Metric) {
+	dp := dest.Gauge().DataPoints().AppendEmpty()
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(value.GetValue())
+	swLabelsToAttributes(value.GetLabels(), dp.Attributes())
+}
+
+// swHistogramToDataPoint converts a Skywalking histogram, whose buckets are identified by their
+// lower bound, to a histogram data point, whose buckets are identified by their upper bound.
+func swHistogramToDataPoint(histogram *agentV3.MeterHistogram, ts pcommon.Timestamp, dest pmetric.Metric) {
+	dp := dest.Histogram().DataPoints().AppendEmpty()
+	dp.SetTimestamp(ts)
+	swLabelsToAttributes(histogram.GetLabels(), dp.Attributes())
+
+	values := histogram.GetValues()
+	if len(values) == 0 {
+		return
+	}
+	bounds := make([]float64, 0, len(values)-1)
+	counts := make([]uint64, 0, len(values))
+	var count uint64
+	for i, value := range values {
+		if i > 0 {
+			bounds = append(bounds, value.GetBucket())
+		}
+		counts = append(counts, uint64(value.GetCount()))
+		count += uint64(value.GetCount())
+	}
+	dp.ExplicitBounds().FromRaw(bounds)
+	dp.BucketCounts().FromRaw(counts)
+	dp.SetCount(count)
+}
+
+func swLabelsToAttributes(labels []*agentV3.Label, dest pcommon.Map) {
+	for _, label := range labels {
+		dest.PutStr(label.GetName(), label.GetValue())
+	}
+}
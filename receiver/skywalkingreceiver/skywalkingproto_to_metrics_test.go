@@ -0,0 +1,152 @@
+// Copyright  OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package skywalkingreceiver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	conventions "go.opentelemetry.io/collector/semconv/v1.8.0"
+	agentV3 "skywalking.apache.org/repo/goapi/collect/language/agent/v3"
+)
+
+func TestSkywalkingToMetrics(t *testing.T) {
+	meters := []*agentV3.MeterData{
+		{
+			Service:         "demo-service",
+			ServiceInstance: "demo-instance",
+			Timestamp:       1658000000000,
+			Metric: &agentV3.MeterData_SingleValue{
+				SingleValue: &agentV3.MeterSingleValue{
+					Name:   "thread_pool_active",
+					Labels: []*agentV3.Label{{Name: "pool", Value: "http"}},
+					Value:  4,
+				},
+			},
+		},
+		{
+			Timestamp: 1658000000000,
+			Metric: &agentV3.MeterData_SingleValue{
+				SingleValue: &agentV3.MeterSingleValue{
+					Name:   "thread_pool_active",
+					Labels: []*agentV3.Label{{Name: "pool", Value: "grpc"}},
+					Value:  2,
+				},
+			},
+		},
+		{
+			Timestamp: 1658000000000,
+			Metric: &agentV3.MeterData_Histogram{
+				Histogram: &agentV3.MeterHistogram{
+					Name: "request_latency",
+					Values: []*agentV3.MeterBucketValue{
+						{Bucket: 0, Count: 3},
+						{Bucket: 10, Count: 5},
+						{Bucket: 50, Count: 1},
+					},
+				},
+			},
+		},
+		{
+			Service:         "other-service",
+			ServiceInstance: "other-instance",
+			Timestamp:       1658000000000,
+			Metric: &agentV3.MeterData_SingleValue{
+				SingleValue: &agentV3.MeterSingleValue{
+					Name:  "thread_pool_active",
+					Value: 1,
+				},
+			},
+		},
+	}
+
+	md := SkywalkingToMetrics(meters)
+	require.Equal(t, 2, md.ResourceMetrics().Len())
+
+	rm := md.ResourceMetrics().At(0)
+	service, _ := rm.Resource().Attributes().Get(conventions.AttributeServiceName)
+	assert.Equal(t, "demo-service", service.Str())
+	instance, _ := rm.Resource().Attributes().Get(conventions.AttributeServiceInstanceID)
+	assert.Equal(t, "demo-instance", instance.Str())
+
+	metrics := rm.ScopeMetrics().At(0).Metrics()
+	require.Equal(t, 2, metrics.Len())
+
+	gauge := metrics.At(0)
+	assert.Equal(t, "thread_pool_active", gauge.Name())
+	require.Equal(t, pmetric.MetricTypeGauge, gauge.Type())
+	require.Equal(t, 2, gauge.Gauge().DataPoints().Len())
+	dp := gauge.Gauge().DataPoints().At(1)
+	assert.Equal(t, 2.0, dp.DoubleValue())
+	assert.Equal(t, microsecondsToTimestamp(1658000000000), dp.Timestamp())
+	pool, _ := dp.Attributes().Get("pool")
+	assert.Equal(t, "grpc", pool.Str())
+
+	histogram := metrics.At(1)
+	assert.Equal(t, "request_latency", histogram.Name())
+	require.Equal(t, pmetric.MetricTypeHistogram, histogram.Type())
+	assert.Equal(t, pmetric.AggregationTemporalityCumulative, histogram.Histogram().AggregationTemporality())
+	hdp := histogram.Histogram().DataPoints().At(0)
+	assert.Equal(t, []float64{10, 50}, hdp.ExplicitBounds().AsRaw())
+	assert.Equal(t, []uint64{3, 5, 1}, hdp.BucketCounts().AsRaw())
+	assert.Equal(t, uint64(9), hdp.Count())
+
+	other := md.ResourceMetrics().At(1)
+	service, _ = other.Resource().Attributes().Get(conventions.AttributeServiceName)
+	assert.Equal(t, "other-service", service.Str())
+	assert.Equal(t, 1, other.ScopeMetrics().At(0).Metrics().Len())
+}
+
+func TestSkywalkingToMetricsGroupsByServiceInstance(t *testing.T) {
+	meter := func(service, instance, name string, value float64) *agentV3.MeterData {
+		return &agentV3.MeterData{
+			Service:         service,
+			ServiceInstance: instance,
+			Metric: &agentV3.MeterData_SingleValue{
+				SingleValue: &agentV3.MeterSingleValue{Name: name, Value: value},
+			},
+		}
+	}
+	meters := []*agentV3.MeterData{
+		meter("demo-service", "instance-1", "thread_pool_active", 1),
+		meter("demo-service", "instance-2", "thread_pool_active", 2),
+		meter("demo-service", "instance-1", "thread_pool_active", 3),
+		meter("demo-service", "instance-1", "heap_used", 4),
+		meter("", "", "heap_used", 5),
+	}
+
+	md := SkywalkingToMetrics(meters)
+	require.Equal(t, 2, md.ResourceMetrics().Len())
+
+	first := md.ResourceMetrics().At(0)
+	instance, _ := first.Resource().Attributes().Get(conventions.AttributeServiceInstanceID)
+	assert.Equal(t, "instance-1", instance.Str())
+	metrics := first.ScopeMetrics().At(0).Metrics()
+	require.Equal(t, 2, metrics.Len())
+	assert.Equal(t, 2, metrics.At(0).Gauge().DataPoints().Len())
+	assert.Equal(t, 2, metrics.At(1).Gauge().DataPoints().Len())
+
+	second := md.ResourceMetrics().At(1)
+	instance, _ = second.Resource().Attributes().Get(conventions.AttributeServiceInstanceID)
+	assert.Equal(t, "instance-2", instance.Str())
+	assert.Equal(t, 1, second.ScopeMetrics().At(0).Metrics().Len())
+}
+
+func TestSkywalkingToMetricsEmpty(t *testing.T) {
+	assert.Equal(t, 0, SkywalkingToMetrics(nil).ResourceMetrics().Len())
+	assert.Equal(t, 0, SkywalkingToMetrics([]*agentV3.MeterData{nil}).ResourceMetrics().Len())
+}
@@ -37,6 +37,7 @@ import (
 	event "skywalking.apache.org/repo/goapi/collect/event/v3"
 	v3 "skywalking.apache.org/repo/goapi/collect/language/agent/v3"
 	profile "skywalking.apache.org/repo/goapi/collect/language/profile/v3"
+	logging "skywalking.apache.org/repo/goapi/collect/logging/v3"
 	management "skywalking.apache.org/repo/goapi/collect/management/v3"
 )
 
@@ -49,10 +50,12 @@ type configuration struct {
 	CollectorGRPCServerSettings configgrpc.GRPCServerSettings
 }
 
-// Receiver type is used to receive spans that were originally intended to be sent to Skywaking.
+// Receiver type is used to receive spans, meters and logs that were originally intended to be sent to Skywaking.
 // This receiver is basically a Skywalking collector.
 type swReceiver struct {
-	nextConsumer consumer.Traces
+	traceConsumer   consumer.Traces
+	metricsConsumer consumer.Metrics
+	logsConsumer    consumer.Logs
 
 	config *configuration
 
@@ -66,6 +69,8 @@ type swReceiver struct {
 	grpcObsrecv          *obsreport.Receiver
 	httpObsrecv          *obsreport.Receiver
 	segmentReportService *traceSegmentReportService
+	meterReportService   *meterReportService
+	logReportService     *logReportService
 	dummyReportService   *dummyReportService
 }
 
@@ -75,10 +80,10 @@ const (
 	failing                = "failing"
 )
 
-// newSkywalkingReceiver creates a TracesReceiver that receives traffic as a Skywalking collector
+// newSkywalkingReceiver creates a receiver that receives traffic as a Skywalking collector,
+// the consumers of the signals it handles are registered once it is created.
 func newSkywalkingReceiver(
 	config *configuration,
-	nextConsumer consumer.Traces,
 	set receiver.CreateSettings,
 ) (*swReceiver, error) {
 
@@ -100,14 +105,25 @@ func newSkywalkingReceiver(
 	}
 
 	return &swReceiver{
-		config:       config,
-		nextConsumer: nextConsumer,
-		settings:     set,
-		grpcObsrecv:  grpcObsrecv,
-		httpObsrecv:  httpObsrecv,
+		config:      config,
+		settings:    set,
+		grpcObsrecv: grpcObsrecv,
+		httpObsrecv: httpObsrecv,
 	}, nil
 }
 
+func (sr *swReceiver) registerTraceConsumer(tc consumer.Traces) {
+	sr.traceConsumer = tc
+}
+
+func (sr *swReceiver) registerMetricsConsumer(mc consumer.Metrics) {
+	sr.metricsConsumer = mc
+}
+
+func (sr *swReceiver) registerLogsConsumer(lc consumer.Logs) {
+	sr.logsConsumer = lc
+}
+
 func (sr *swReceiver) collectorGRPCAddr() string {
 	var port int
 	if sr.config != nil {
@@ -157,7 +173,12 @@ func (sr *swReceiver) startCollector(host component.Host) error {
 		}
 
 		nr := mux.NewRouter()
-		nr.HandleFunc("/v3/segments", sr.httpHandler).Methods(http.MethodPost)
+		if sr.traceConsumer != nil {
+			nr.HandleFunc("/v3/segments", sr.httpHandler).Methods(http.MethodPost)
+		}
+		if sr.logsConsumer != nil {
+			nr.HandleFunc("/v3/logs", sr.httpLogsHandler).Methods(http.MethodPost)
+		}
 		sr.collectorServer, cerr = sr.config.CollectorHTTPSettings.ToServer(host, sr.settings.TelemetrySettings, nr)
 		if cerr != nil {
 			return cerr
@@ -185,16 +206,27 @@ func (sr *swReceiver) startCollector(host component.Host) error {
 			return fmt.Errorf("failed to bind to gRPC address %q: %w", gaddr, gerr)
 		}
 
-		sr.segmentReportService = &traceSegmentReportService{sr: sr}
-		v3.RegisterTraceSegmentReportServiceServer(sr.grpc, sr.segmentReportService)
 		sr.dummyReportService = &dummyReportService{}
+		if sr.traceConsumer != nil {
+			sr.segmentReportService = &traceSegmentReportService{sr: sr}
+			v3.RegisterTraceSegmentReportServiceServer(sr.grpc, sr.segmentReportService)
+		}
+		if sr.metricsConsumer != nil {
+			sr.meterReportService = &meterReportService{sr: sr}
+			v3.RegisterMeterReportServiceServer(sr.grpc, sr.meterReportService)
+		} else {
+			v3.RegisterMeterReportServiceServer(sr.grpc, &meterService{})
+		}
+		if sr.logsConsumer != nil {
+			sr.logReportService = &logReportService{sr: sr}
+			logging.RegisterLogReportServiceServer(sr.grpc, sr.logReportService)
+		}
 
 		management.RegisterManagementServiceServer(sr.grpc, sr.dummyReportService)
 		cds.RegisterConfigurationDiscoveryServiceServer(sr.grpc, sr.dummyReportService)
 		event.RegisterEventServiceServer(sr.grpc, &eventService{})
 		profile.RegisterProfileTaskServer(sr.grpc, sr.dummyReportService)
 		v3.RegisterJVMMetricReportServiceServer(sr.grpc, sr.dummyReportService)
-		v3.RegisterMeterReportServiceServer(sr.grpc, &meterService{})
 		v3.RegisterCLRMetricReportServiceServer(sr.grpc, &clrService{})
 		v3.RegisterBrowserPerfServiceServer(sr.grpc, sr.dummyReportService)
 
@@ -229,7 +261,7 @@ func (sr *swReceiver) httpHandler(rsp http.ResponseWriter, r *http.Request) {
 	}
 
 	for _, segment := range data {
-		err = consumeTraces(r.Context(), segment, sr.nextConsumer)
+		err = consumeTraces(r.Context(), segment, sr.traceConsumer)
 		if err != nil {
 			fmt.Printf("cannot consume traces, %v", err)
 		}
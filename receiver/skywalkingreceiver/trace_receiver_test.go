@@ -16,6 +16,7 @@ package skywalkingreceiver
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strconv"
 	"testing"
@@ -41,7 +42,7 @@ var (
 func TestTraceSource(t *testing.T) {
 	set := receivertest.NewNopCreateSettings()
 	set.ID = skywalkingReceiver
-	jr, err := newSkywalkingReceiver(&configuration{}, nil, set)
+	jr, err := newSkywalkingReceiver(&configuration{}, set)
 	require.NoError(t, err)
 	require.NotNil(t, jr)
 }
@@ -58,8 +59,9 @@ func TestStartAndShutdown(t *testing.T) {
 
 	set := receivertest.NewNopCreateSettings()
 	set.ID = skywalkingReceiver
-	sr, err := newSkywalkingReceiver(config, sink, set)
+	sr, err := newSkywalkingReceiver(config, set)
 	require.NoError(t, err)
+	sr.registerTraceConsumer(sink)
 
 	require.NoError(t, sr.Start(context.Background(), componenttest.NewNopHost()))
 	t.Cleanup(func() { require.NoError(t, sr.Shutdown(context.Background())) })
@@ -75,8 +77,9 @@ func TestGRPCReception(t *testing.T) {
 
 	set := receivertest.NewNopCreateSettings()
 	set.ID = skywalkingReceiver
-	swReceiver, err := newSkywalkingReceiver(config, sink, set)
+	swReceiver, err := newSkywalkingReceiver(config, set)
 	require.NoError(t, err)
+	swReceiver.registerTraceConsumer(sink)
 
 	require.NoError(t, swReceiver.Start(context.Background(), componenttest.NewNopHost()))
 
@@ -103,6 +106,88 @@ func TestGRPCReception(t *testing.T) {
 	assert.NotNil(t, commands)
 }
 
+func TestGRPCMeterReception(t *testing.T) {
+	config := &configuration{
+		CollectorGRPCPort: 11801,
+	}
+
+	sink := new(consumertest.MetricsSink)
+
+	set := receivertest.NewNopCreateSettings()
+	set.ID = skywalkingReceiver
+	swReceiver, err := newSkywalkingReceiver(config, set)
+	require.NoError(t, err)
+	swReceiver.registerMetricsConsumer(sink)
+
+	require.NoError(t, swReceiver.Start(context.Background(), componenttest.NewNopHost()))
+
+	t.Cleanup(func() { require.NoError(t, swReceiver.Shutdown(context.Background())) })
+
+	conn, err := grpc.Dial(fmt.Sprintf("0.0.0.0:%d", config.CollectorGRPCPort), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+
+	client := agent.NewMeterReportServiceClient(conn)
+	stream, err := client.Collect(context.Background())
+	require.NoError(t, err)
+	require.NoError(t, stream.Send(&agent.MeterData{
+		Service:         "demo-service",
+		ServiceInstance: "demo-instance",
+		Timestamp:       time.Now().UnixMilli(),
+		Metric: &agent.MeterData_SingleValue{
+			SingleValue: &agent.MeterSingleValue{Name: "thread_pool_active", Value: 4},
+		},
+	}))
+	require.NoError(t, stream.Send(&agent.MeterData{
+		Timestamp: time.Now().UnixMilli(),
+		Metric: &agent.MeterData_SingleValue{
+			SingleValue: &agent.MeterSingleValue{Name: "thread_pool_size", Value: 8},
+		},
+	}))
+	commands, err := stream.CloseAndRecv()
+	require.NoError(t, err)
+	assert.NotNil(t, commands)
+
+	require.Len(t, sink.AllMetrics(), 1)
+	assert.Equal(t, 2, sink.AllMetrics()[0].DataPointCount())
+}
+
+func TestGRPCMeterBatchConsumerError(t *testing.T) {
+	config := &configuration{
+		CollectorGRPCPort: 11802,
+	}
+
+	set := receivertest.NewNopCreateSettings()
+	set.ID = skywalkingReceiver
+	swReceiver, err := newSkywalkingReceiver(config, set)
+	require.NoError(t, err)
+	swReceiver.registerMetricsConsumer(consumertest.NewErr(errors.New("consumer error")))
+
+	require.NoError(t, swReceiver.Start(context.Background(), componenttest.NewNopHost()))
+
+	t.Cleanup(func() { require.NoError(t, swReceiver.Shutdown(context.Background())) })
+
+	conn, err := grpc.Dial(fmt.Sprintf("0.0.0.0:%d", config.CollectorGRPCPort), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+
+	client := agent.NewMeterReportServiceClient(conn)
+	stream, err := client.CollectBatch(context.Background())
+	require.NoError(t, err)
+	require.NoError(t, stream.Send(&agent.MeterDataCollection{
+		MeterData: []*agent.MeterData{{
+			Service:         "demo-service",
+			ServiceInstance: "demo-instance",
+			Timestamp:       time.Now().UnixMilli(),
+			Metric: &agent.MeterData_SingleValue{
+				SingleValue: &agent.MeterSingleValue{Name: "thread_pool_active", Value: 4},
+			},
+		}},
+	}))
+	_, err = stream.CloseAndRecv()
+	assert.ErrorContains(t, err, "consumer error", "the client must see the error to retry")
+}
+
 func mockGrpcTraceSegment(sequence int) *agent.SegmentObject {
 	seq := strconv.Itoa(sequence)
 	return &agent.SegmentObject{
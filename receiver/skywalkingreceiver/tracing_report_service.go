@@ -41,7 +41,7 @@ func (s *traceSegmentReportService) Collect(stream agent.TraceSegmentReportServi
 			return err
 		}
 
-		err = consumeTraces(stream.Context(), segmentObject, s.sr.nextConsumer)
+		err = consumeTraces(stream.Context(), segmentObject, s.sr.traceConsumer)
 		if err != nil {
 			return stream.SendAndClose(&common.Commands{})
 		}
@@ -54,7 +54,7 @@ func (s *traceSegmentReportService) CollectInSync(ctx context.Context, segments
 		if err != nil {
 			fmt.Printf("cannot marshal segemnt from sync, %v", err)
 		}
-		err = consumeTraces(ctx, segment, s.sr.nextConsumer)
+		err = consumeTraces(ctx, segment, s.sr.traceConsumer)
 		if err != nil {
 			fmt.Printf("cannot consume traces, %v", err)
 		}
@@ -18,6 +18,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"time"
 
 	sf "github.com/snowflakedb/gosnowflake"
 	"go.opentelemetry.io/collector/component"
@@ -34,6 +35,10 @@ var (
 	sessionMetricsQuery          = "select USER_NAME, count(distinct(SESSION_ID)) from Sessions where created_on >= DATEADD(hour, -24, current_timestamp()) group by 1;"
 	snowpipeMetricsQuery         = "select pipe_name, sum(credits_used), sum(bytes_inserted), sum(files_inserted) from pipe_usage_history where start_time >= DATEADD(hour, -24, current_timestamp()) group by 1;"
 	storageMetricsQuery          = "select STORAGE_BYTES, STAGE_BYTES, FAILSAFE_BYTES from STORAGE_USAGE ORDER BY USAGE_DATE DESC LIMIT 1;"
+
+	// incremental queries are bounded by (watermark, until] so that every row is collected exactly once
+	warehouseMeteringHistoryQuery = "select WAREHOUSE_NAME, sum(CREDITS_USED) from WAREHOUSE_METERING_HISTORY where end_time > ? and end_time <= ? group by 1;"
+	longRunningQueryHistoryQuery  = "select WAREHOUSE_NAME, USER_NAME, count(QUERY_ID), sum(TOTAL_ELAPSED_TIME) from QUERY_HISTORY where end_time > ? and end_time <= ? and TOTAL_ELAPSED_TIME >= ? group by 1, 2;"
 )
 
 // snowflake client is comprised of a sql.DB (the proper 'client' in question),
@@ -80,8 +85,8 @@ func newDefaultClient(settings component.TelemetrySettings, c Config) (*snowflak
 }
 
 // queries database and returns resulting rows
-func (c snowflakeClient) readDB(ctx context.Context, q string) (*sql.Rows, error) {
-	rows, err := c.client.QueryContext(ctx, q)
+func (c snowflakeClient) readDB(ctx context.Context, q string, args ...interface{}) (*sql.Rows, error) {
+	rows, err := c.client.QueryContext(ctx, q, args...)
 	if err != nil {
 		error := fmt.Sprintf("Query failed with %v", err)
 		c.logger.Error(error)
@@ -423,3 +428,61 @@ func (c snowflakeClient) FetchStorageMetrics(ctx context.Context) (*[]storageMet
 	}
 	return &res, nil
 }
+
+func (c snowflakeClient) FetchWarehouseMeteringHistory(ctx context.Context, since, until time.Time) (*[]whMeteringMetric, error) {
+	rows, err := c.readDB(ctx, warehouseMeteringHistoryQuery, since, until)
+	if err != nil {
+		return nil, err
+	}
+
+	if rows == nil {
+		err = fmt.Errorf("no rows returned by query: %v", warehouseMeteringHistoryQuery)
+		return nil, err
+	}
+
+	var res []whMeteringMetric
+
+	for rows.Next() {
+		var warehouseName sql.NullString
+		var creditsUsed float64
+		err := rows.Scan(&warehouseName, &creditsUsed)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, whMeteringMetric{
+			warehouseName: warehouseName,
+			creditsUsed:   creditsUsed,
+		})
+	}
+	return &res, nil
+}
+
+func (c snowflakeClient) FetchLongRunningQueries(ctx context.Context, since, until time.Time, threshold time.Duration) (*[]longRunningQueryMetric, error) {
+	rows, err := c.readDB(ctx, longRunningQueryHistoryQuery, since, until, threshold.Milliseconds())
+	if err != nil {
+		return nil, err
+	}
+
+	if rows == nil {
+		err = fmt.Errorf("no rows returned by query: %v", longRunningQueryHistoryQuery)
+		return nil, err
+	}
+
+	var res []longRunningQueryMetric
+
+	for rows.Next() {
+		var warehouseName, userName sql.NullString
+		var queryCount, totalElapsedTime int64
+		err := rows.Scan(&warehouseName, &userName, &queryCount, &totalElapsedTime)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, longRunningQueryMetric{
+			warehouseName:    warehouseName,
+			userName:         userName,
+			queryCount:       queryCount,
+			totalElapsedTime: totalElapsedTime,
+		})
+	}
+	return &res, nil
+}
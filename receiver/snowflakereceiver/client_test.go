@@ -21,6 +21,7 @@ import (
 	"reflect"
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/stretchr/testify/assert"
@@ -296,3 +297,45 @@ func TestMetricQueries(t *testing.T) {
 		})
 	}
 }
+
+func TestIncrementalMetricQueries(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatal("an error was not expected when opening mock db", err)
+	}
+	defer db.Close()
+
+	until := time.Date(2023, 1, 10, 12, 0, 0, 0, time.UTC)
+	since := until.Add(-30 * time.Minute)
+
+	mock.ExpectQuery(warehouseMeteringHistoryQuery).
+		WithArgs(since, until).
+		WillReturnRows(mock.NewRows([]string{"wh_name", "credits_used"}).AddRow("wh", 1.5))
+	mock.ExpectQuery(longRunningQueryHistoryQuery).
+		WithArgs(since, until, int64(60000)).
+		WillReturnRows(mock.NewRows([]string{"wh_name", "username", "count", "elapsed"}).AddRow("wh", "user", 2, 150000))
+
+	client := snowflakeClient{
+		client: db,
+		logger: receivertest.NewNopCreateSettings().Logger,
+	}
+	ctx := context.Background()
+
+	metering, err := client.FetchWarehouseMeteringHistory(ctx, since, until)
+	assert.NoError(t, err)
+	assert.Equal(t, []whMeteringMetric{{
+		warehouseName: sql.NullString{String: "wh", Valid: true},
+		creditsUsed:   1.5,
+	}}, *metering)
+
+	longRunning, err := client.FetchLongRunningQueries(ctx, since, until, time.Minute)
+	assert.NoError(t, err)
+	assert.Equal(t, []longRunningQueryMetric{{
+		warehouseName:    sql.NullString{String: "wh", Valid: true},
+		userName:         sql.NullString{String: "user", Valid: true},
+		queryCount:       2,
+		totalElapsedTime: 150000,
+	}}, *longRunning)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
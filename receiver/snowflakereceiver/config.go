@@ -16,7 +16,9 @@ package snowflakereceiver // import "github.com/open-telemetry/opentelemetry-col
 
 import (
 	"errors"
+	"time"
 
+	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
 	"go.uber.org/multierr"
 
@@ -28,6 +30,7 @@ var (
 	errMissingPassword  = errors.New("You must provide a password for the snowflake username")
 	errMissingAccount   = errors.New("You must provide a valid account name")
 	errMissingWarehouse = errors.New("You must provide a valid warehouse name")
+	errInvalidThreshold = errors.New("long_running_query_threshold must be positive")
 )
 
 type Config struct {
@@ -40,6 +43,12 @@ type Config struct {
 	Warehouse                               string                   `mapstructure:"warehouse"`
 	Database                                string                   `mapstructure:"database"`
 	Role                                    string                   `mapstructure:"role"`
+	// StorageID is the ID of a storage extension used to persist the watermarks of
+	// incrementally collected metrics across collector restarts.
+	StorageID *component.ID `mapstructure:"storage"`
+	// LongRunningQueryThreshold is the minimum elapsed time for a query to be
+	// reported by the long running query metrics.
+	LongRunningQueryThreshold time.Duration `mapstructure:"long_running_query_threshold"`
 }
 
 func (cfg *Config) Validate() error {
@@ -60,5 +69,9 @@ func (cfg *Config) Validate() error {
 		errs = multierr.Append(errs, errMissingWarehouse)
 	}
 
+	if cfg.LongRunningQueryThreshold <= 0 {
+		errs = multierr.Append(errs, errInvalidThreshold)
+	}
+
 	return errs
 }
@@ -16,6 +16,7 @@ package snowflakereceiver
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"go.uber.org/multierr"
@@ -74,6 +75,17 @@ func TestValidateConfig(t *testing.T) {
 				Warehouse: "",
 			},
 		},
+		{
+			desc:   "Non-positive long running query threshold",
+			expect: errInvalidThreshold,
+			conf: Config{
+				Username:                  "username",
+				Password:                  "password",
+				Account:                   "account",
+				Warehouse:                 "warehouse",
+				LongRunningQueryThreshold: -time.Second,
+			},
+		},
 		{
 			desc:   "Missing multiple check multierror",
 			expect: multierror,
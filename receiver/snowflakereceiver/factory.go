@@ -32,6 +32,8 @@ const (
 	defaultRole     = "ACCOUNTADMIN"
 	defaultDB       = "SNOWFLAKE"
 	defaultSchema   = "ACCOUNT_USAGE"
+
+	defaultLongRunningQueryThreshold = time.Minute
 )
 
 func createDefaultConfig() component.Config {
@@ -43,6 +45,8 @@ func createDefaultConfig() component.Config {
 		Schema:   defaultSchema,
 		Database: defaultDB,
 		Role:     defaultRole,
+
+		LongRunningQueryThreshold: defaultLongRunningQueryThreshold,
 	}
 }
 
@@ -43,6 +43,7 @@ func TestDefaultConfig(t *testing.T) {
 	require.EqualValues(t, defaultRole, cfg.Role)
 	require.EqualValues(t, defaultSchema, cfg.Schema)
 	require.EqualValues(t, defaultInterval, cfg.CollectionInterval)
+	require.EqualValues(t, defaultLongRunningQueryThreshold, cfg.LongRunningQueryThreshold)
 }
 
 func TestCreateMetricsReceiver(t *testing.T) {
@@ -57,6 +57,8 @@ type MetricsSettings struct {
 	SnowflakeQueryDataScannedCacheAvg              MetricSettings `mapstructure:"snowflake.query.data_scanned_cache.avg"`
 	SnowflakeQueryExecuted                         MetricSettings `mapstructure:"snowflake.query.executed"`
 	SnowflakeQueryExecutionTimeAvg                 MetricSettings `mapstructure:"snowflake.query.execution_time.avg"`
+	SnowflakeQueryLongRunningCount                 MetricSettings `mapstructure:"snowflake.query.long_running.count"`
+	SnowflakeQueryLongRunningElapsedTime           MetricSettings `mapstructure:"snowflake.query.long_running.elapsed_time"`
 	SnowflakeQueryPartitionsScannedAvg             MetricSettings `mapstructure:"snowflake.query.partitions_scanned.avg"`
 	SnowflakeQueryQueuedOverload                   MetricSettings `mapstructure:"snowflake.query.queued_overload"`
 	SnowflakeQueryQueuedProvision                  MetricSettings `mapstructure:"snowflake.query.queued_provision"`
@@ -73,6 +75,7 @@ type MetricsSettings struct {
 	SnowflakeStorageStageBytesTotal                MetricSettings `mapstructure:"snowflake.storage.stage_bytes.total"`
 	SnowflakeStorageStorageBytesTotal              MetricSettings `mapstructure:"snowflake.storage.storage_bytes.total"`
 	SnowflakeTotalElapsedTimeAvg                   MetricSettings `mapstructure:"snowflake.total_elapsed_time.avg"`
+	SnowflakeWarehouseCreditsUsed                  MetricSettings `mapstructure:"snowflake.warehouse.credits_used"`
 }
 
 func DefaultMetricsSettings() MetricsSettings {
@@ -134,6 +137,12 @@ func DefaultMetricsSettings() MetricsSettings {
 		SnowflakeQueryExecutionTimeAvg: MetricSettings{
 			Enabled: true,
 		},
+		SnowflakeQueryLongRunningCount: MetricSettings{
+			Enabled: false,
+		},
+		SnowflakeQueryLongRunningElapsedTime: MetricSettings{
+			Enabled: false,
+		},
 		SnowflakeQueryPartitionsScannedAvg: MetricSettings{
 			Enabled: false,
 		},
@@ -182,6 +191,9 @@ func DefaultMetricsSettings() MetricsSettings {
 		SnowflakeTotalElapsedTimeAvg: MetricSettings{
 			Enabled: true,
 		},
+		SnowflakeWarehouseCreditsUsed: MetricSettings{
+			Enabled: false,
+		},
 	}
 }
 
@@ -1210,6 +1222,114 @@ func newMetricSnowflakeQueryExecutionTimeAvg(settings MetricSettings) metricSnow
 	return m
 }
 
+type metricSnowflakeQueryLongRunningCount struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills snowflake.query.long_running.count metric with initial data.
+func (m *metricSnowflakeQueryLongRunningCount) init() {
+	m.data.SetName("snowflake.query.long_running.count")
+	m.data.SetDescription("Number of queries that ran longer than the configured threshold since the last collected watermark.")
+	m.data.SetUnit("{queries}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSnowflakeQueryLongRunningCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, warehouseNameAttributeValue string, userNameAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("warehouse_name", warehouseNameAttributeValue)
+	dp.Attributes().PutStr("user_name", userNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSnowflakeQueryLongRunningCount) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSnowflakeQueryLongRunningCount) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSnowflakeQueryLongRunningCount(settings MetricSettings) metricSnowflakeQueryLongRunningCount {
+	m := metricSnowflakeQueryLongRunningCount{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSnowflakeQueryLongRunningElapsedTime struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills snowflake.query.long_running.elapsed_time metric with initial data.
+func (m *metricSnowflakeQueryLongRunningElapsedTime) init() {
+	m.data.SetName("snowflake.query.long_running.elapsed_time")
+	m.data.SetDescription("Total elapsed time of queries that ran longer than the configured threshold since the last collected watermark.")
+	m.data.SetUnit("ms")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSnowflakeQueryLongRunningElapsedTime) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, warehouseNameAttributeValue string, userNameAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("warehouse_name", warehouseNameAttributeValue)
+	dp.Attributes().PutStr("user_name", userNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSnowflakeQueryLongRunningElapsedTime) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSnowflakeQueryLongRunningElapsedTime) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSnowflakeQueryLongRunningElapsedTime(settings MetricSettings) metricSnowflakeQueryLongRunningElapsedTime {
+	m := metricSnowflakeQueryLongRunningElapsedTime{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricSnowflakeQueryPartitionsScannedAvg struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
@@ -2080,6 +2200,59 @@ func newMetricSnowflakeTotalElapsedTimeAvg(settings MetricSettings) metricSnowfl
 	return m
 }
 
+type metricSnowflakeWarehouseCreditsUsed struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills snowflake.warehouse.credits_used metric with initial data.
+func (m *metricSnowflakeWarehouseCreditsUsed) init() {
+	m.data.SetName("snowflake.warehouse.credits_used")
+	m.data.SetDescription("Credits used by a warehouse since the last collected watermark.")
+	m.data.SetUnit("{credits}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSnowflakeWarehouseCreditsUsed) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, warehouseNameAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("warehouse_name", warehouseNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSnowflakeWarehouseCreditsUsed) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSnowflakeWarehouseCreditsUsed) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSnowflakeWarehouseCreditsUsed(settings MetricSettings) metricSnowflakeWarehouseCreditsUsed {
+	m := metricSnowflakeWarehouseCreditsUsed{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 // MetricsBuilder provides an interface for scrapers to report metrics while taking care of all the transformations
 // required to produce metric representation defined in metadata and user settings.
 type MetricsBuilder struct {
@@ -2107,6 +2280,8 @@ type MetricsBuilder struct {
 	metricSnowflakeQueryDataScannedCacheAvg              metricSnowflakeQueryDataScannedCacheAvg
 	metricSnowflakeQueryExecuted                         metricSnowflakeQueryExecuted
 	metricSnowflakeQueryExecutionTimeAvg                 metricSnowflakeQueryExecutionTimeAvg
+	metricSnowflakeQueryLongRunningCount                 metricSnowflakeQueryLongRunningCount
+	metricSnowflakeQueryLongRunningElapsedTime           metricSnowflakeQueryLongRunningElapsedTime
 	metricSnowflakeQueryPartitionsScannedAvg             metricSnowflakeQueryPartitionsScannedAvg
 	metricSnowflakeQueryQueuedOverload                   metricSnowflakeQueryQueuedOverload
 	metricSnowflakeQueryQueuedProvision                  metricSnowflakeQueryQueuedProvision
@@ -2123,6 +2298,7 @@ type MetricsBuilder struct {
 	metricSnowflakeStorageStageBytesTotal                metricSnowflakeStorageStageBytesTotal
 	metricSnowflakeStorageStorageBytesTotal              metricSnowflakeStorageStorageBytesTotal
 	metricSnowflakeTotalElapsedTimeAvg                   metricSnowflakeTotalElapsedTimeAvg
+	metricSnowflakeWarehouseCreditsUsed                  metricSnowflakeWarehouseCreditsUsed
 }
 
 // metricBuilderOption applies changes to default metrics builder.
@@ -2137,11 +2313,11 @@ func WithStartTime(startTime pcommon.Timestamp) metricBuilderOption {
 
 func NewMetricsBuilder(ms MetricsSettings, settings receiver.CreateSettings, options ...metricBuilderOption) *MetricsBuilder {
 	mb := &MetricsBuilder{
-		startTime:                                            pcommon.NewTimestampFromTime(time.Now()),
-		metricsBuffer:                                        pmetric.NewMetrics(),
-		buildInfo:                                            settings.BuildInfo,
-		metricSnowflakeBillingCloudServiceTotal:              newMetricSnowflakeBillingCloudServiceTotal(ms.SnowflakeBillingCloudServiceTotal),
-		metricSnowflakeBillingTotalCreditTotal:               newMetricSnowflakeBillingTotalCreditTotal(ms.SnowflakeBillingTotalCreditTotal),
+		startTime:                               pcommon.NewTimestampFromTime(time.Now()),
+		metricsBuffer:                           pmetric.NewMetrics(),
+		buildInfo:                               settings.BuildInfo,
+		metricSnowflakeBillingCloudServiceTotal: newMetricSnowflakeBillingCloudServiceTotal(ms.SnowflakeBillingCloudServiceTotal),
+		metricSnowflakeBillingTotalCreditTotal:  newMetricSnowflakeBillingTotalCreditTotal(ms.SnowflakeBillingTotalCreditTotal),
 		metricSnowflakeBillingVirtualWarehouseTotal:          newMetricSnowflakeBillingVirtualWarehouseTotal(ms.SnowflakeBillingVirtualWarehouseTotal),
 		metricSnowflakeBillingWarehouseCloudServiceTotal:     newMetricSnowflakeBillingWarehouseCloudServiceTotal(ms.SnowflakeBillingWarehouseCloudServiceTotal),
 		metricSnowflakeBillingWarehouseTotalCreditTotal:      newMetricSnowflakeBillingWarehouseTotalCreditTotal(ms.SnowflakeBillingWarehouseTotalCreditTotal),
@@ -2159,6 +2335,8 @@ func NewMetricsBuilder(ms MetricsSettings, settings receiver.CreateSettings, opt
 		metricSnowflakeQueryDataScannedCacheAvg:              newMetricSnowflakeQueryDataScannedCacheAvg(ms.SnowflakeQueryDataScannedCacheAvg),
 		metricSnowflakeQueryExecuted:                         newMetricSnowflakeQueryExecuted(ms.SnowflakeQueryExecuted),
 		metricSnowflakeQueryExecutionTimeAvg:                 newMetricSnowflakeQueryExecutionTimeAvg(ms.SnowflakeQueryExecutionTimeAvg),
+		metricSnowflakeQueryLongRunningCount:                 newMetricSnowflakeQueryLongRunningCount(ms.SnowflakeQueryLongRunningCount),
+		metricSnowflakeQueryLongRunningElapsedTime:           newMetricSnowflakeQueryLongRunningElapsedTime(ms.SnowflakeQueryLongRunningElapsedTime),
 		metricSnowflakeQueryPartitionsScannedAvg:             newMetricSnowflakeQueryPartitionsScannedAvg(ms.SnowflakeQueryPartitionsScannedAvg),
 		metricSnowflakeQueryQueuedOverload:                   newMetricSnowflakeQueryQueuedOverload(ms.SnowflakeQueryQueuedOverload),
 		metricSnowflakeQueryQueuedProvision:                  newMetricSnowflakeQueryQueuedProvision(ms.SnowflakeQueryQueuedProvision),
@@ -2175,6 +2353,7 @@ func NewMetricsBuilder(ms MetricsSettings, settings receiver.CreateSettings, opt
 		metricSnowflakeStorageStageBytesTotal:                newMetricSnowflakeStorageStageBytesTotal(ms.SnowflakeStorageStageBytesTotal),
 		metricSnowflakeStorageStorageBytesTotal:              newMetricSnowflakeStorageStorageBytesTotal(ms.SnowflakeStorageStorageBytesTotal),
 		metricSnowflakeTotalElapsedTimeAvg:                   newMetricSnowflakeTotalElapsedTimeAvg(ms.SnowflakeTotalElapsedTimeAvg),
+		metricSnowflakeWarehouseCreditsUsed:                  newMetricSnowflakeWarehouseCreditsUsed(ms.SnowflakeWarehouseCreditsUsed),
 	}
 	for _, op := range options {
 		op(mb)
@@ -2253,6 +2432,8 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	mb.metricSnowflakeQueryDataScannedCacheAvg.emit(ils.Metrics())
 	mb.metricSnowflakeQueryExecuted.emit(ils.Metrics())
 	mb.metricSnowflakeQueryExecutionTimeAvg.emit(ils.Metrics())
+	mb.metricSnowflakeQueryLongRunningCount.emit(ils.Metrics())
+	mb.metricSnowflakeQueryLongRunningElapsedTime.emit(ils.Metrics())
 	mb.metricSnowflakeQueryPartitionsScannedAvg.emit(ils.Metrics())
 	mb.metricSnowflakeQueryQueuedOverload.emit(ils.Metrics())
 	mb.metricSnowflakeQueryQueuedProvision.emit(ils.Metrics())
@@ -2269,6 +2450,7 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	mb.metricSnowflakeStorageStageBytesTotal.emit(ils.Metrics())
 	mb.metricSnowflakeStorageStorageBytesTotal.emit(ils.Metrics())
 	mb.metricSnowflakeTotalElapsedTimeAvg.emit(ils.Metrics())
+	mb.metricSnowflakeWarehouseCreditsUsed.emit(ils.Metrics())
 	for _, op := range rmo {
 		op(rm)
 	}
@@ -2383,6 +2565,16 @@ func (mb *MetricsBuilder) RecordSnowflakeQueryExecutionTimeAvgDataPoint(ts pcomm
 	mb.metricSnowflakeQueryExecutionTimeAvg.recordDataPoint(mb.startTime, ts, val, schemaNameAttributeValue, executionStatusAttributeValue, errorMessageAttributeValue, queryTypeAttributeValue, warehouseNameAttributeValue, databaseNameAttributeValue, warehouseSizeAttributeValue)
 }
 
+// RecordSnowflakeQueryLongRunningCountDataPoint adds a data point to snowflake.query.long_running.count metric.
+func (mb *MetricsBuilder) RecordSnowflakeQueryLongRunningCountDataPoint(ts pcommon.Timestamp, val int64, warehouseNameAttributeValue string, userNameAttributeValue string) {
+	mb.metricSnowflakeQueryLongRunningCount.recordDataPoint(mb.startTime, ts, val, warehouseNameAttributeValue, userNameAttributeValue)
+}
+
+// RecordSnowflakeQueryLongRunningElapsedTimeDataPoint adds a data point to snowflake.query.long_running.elapsed_time metric.
+func (mb *MetricsBuilder) RecordSnowflakeQueryLongRunningElapsedTimeDataPoint(ts pcommon.Timestamp, val int64, warehouseNameAttributeValue string, userNameAttributeValue string) {
+	mb.metricSnowflakeQueryLongRunningElapsedTime.recordDataPoint(mb.startTime, ts, val, warehouseNameAttributeValue, userNameAttributeValue)
+}
+
 // RecordSnowflakeQueryPartitionsScannedAvgDataPoint adds a data point to snowflake.query.partitions_scanned.avg metric.
 func (mb *MetricsBuilder) RecordSnowflakeQueryPartitionsScannedAvgDataPoint(ts pcommon.Timestamp, val float64, schemaNameAttributeValue string, executionStatusAttributeValue string, errorMessageAttributeValue string, queryTypeAttributeValue string, warehouseNameAttributeValue string, databaseNameAttributeValue string, warehouseSizeAttributeValue string) {
 	mb.metricSnowflakeQueryPartitionsScannedAvg.recordDataPoint(mb.startTime, ts, val, schemaNameAttributeValue, executionStatusAttributeValue, errorMessageAttributeValue, queryTypeAttributeValue, warehouseNameAttributeValue, databaseNameAttributeValue, warehouseSizeAttributeValue)
@@ -2463,6 +2655,11 @@ func (mb *MetricsBuilder) RecordSnowflakeTotalElapsedTimeAvgDataPoint(ts pcommon
 	mb.metricSnowflakeTotalElapsedTimeAvg.recordDataPoint(mb.startTime, ts, val, schemaNameAttributeValue, executionStatusAttributeValue, errorMessageAttributeValue, queryTypeAttributeValue, warehouseNameAttributeValue, databaseNameAttributeValue, warehouseSizeAttributeValue)
 }
 
+// RecordSnowflakeWarehouseCreditsUsedDataPoint adds a data point to snowflake.warehouse.credits_used metric.
+func (mb *MetricsBuilder) RecordSnowflakeWarehouseCreditsUsedDataPoint(ts pcommon.Timestamp, val float64, warehouseNameAttributeValue string) {
+	mb.metricSnowflakeWarehouseCreditsUsed.recordDataPoint(mb.startTime, ts, val, warehouseNameAttributeValue)
+}
+
 // Reset resets metrics builder to its initial state. It should be used when external metrics source is restarted,
 // and metrics builder should update its startTime and reset it's internal state accordingly.
 func (mb *MetricsBuilder) Reset(options ...metricBuilderOption) {
@@ -66,6 +66,10 @@ func TestDefaultMetrics(t *testing.T) {
 	enabledMetrics["snowflake.query.execution_time.avg"] = true
 	mb.RecordSnowflakeQueryExecutionTimeAvgDataPoint(ts, 1, "attr-val", "attr-val", "attr-val", "attr-val", "attr-val", "attr-val", "attr-val")
 
+	mb.RecordSnowflakeQueryLongRunningCountDataPoint(ts, 1, "attr-val", "attr-val")
+
+	mb.RecordSnowflakeQueryLongRunningElapsedTimeDataPoint(ts, 1, "attr-val", "attr-val")
+
 	mb.RecordSnowflakeQueryPartitionsScannedAvgDataPoint(ts, 1, "attr-val", "attr-val", "attr-val", "attr-val", "attr-val", "attr-val", "attr-val")
 
 	enabledMetrics["snowflake.query.queued_overload"] = true
@@ -106,6 +110,8 @@ func TestDefaultMetrics(t *testing.T) {
 	enabledMetrics["snowflake.total_elapsed_time.avg"] = true
 	mb.RecordSnowflakeTotalElapsedTimeAvgDataPoint(ts, 1, "attr-val", "attr-val", "attr-val", "attr-val", "attr-val", "attr-val", "attr-val")
 
+	mb.RecordSnowflakeWarehouseCreditsUsedDataPoint(ts, 1, "attr-val")
+
 	metrics := mb.Emit()
 
 	assert.Equal(t, 1, metrics.ResourceMetrics().Len())
@@ -144,6 +150,8 @@ func TestAllMetrics(t *testing.T) {
 		SnowflakeQueryDataScannedCacheAvg:              MetricSettings{Enabled: true},
 		SnowflakeQueryExecuted:                         MetricSettings{Enabled: true},
 		SnowflakeQueryExecutionTimeAvg:                 MetricSettings{Enabled: true},
+		SnowflakeQueryLongRunningCount:                 MetricSettings{Enabled: true},
+		SnowflakeQueryLongRunningElapsedTime:           MetricSettings{Enabled: true},
 		SnowflakeQueryPartitionsScannedAvg:             MetricSettings{Enabled: true},
 		SnowflakeQueryQueuedOverload:                   MetricSettings{Enabled: true},
 		SnowflakeQueryQueuedProvision:                  MetricSettings{Enabled: true},
@@ -160,6 +168,7 @@ func TestAllMetrics(t *testing.T) {
 		SnowflakeStorageStageBytesTotal:                MetricSettings{Enabled: true},
 		SnowflakeStorageStorageBytesTotal:              MetricSettings{Enabled: true},
 		SnowflakeTotalElapsedTimeAvg:                   MetricSettings{Enabled: true},
+		SnowflakeWarehouseCreditsUsed:                  MetricSettings{Enabled: true},
 	}
 	observedZapCore, observedLogs := observer.New(zap.WarnLevel)
 	settings := receivertest.NewNopCreateSettings()
@@ -187,6 +196,8 @@ func TestAllMetrics(t *testing.T) {
 	mb.RecordSnowflakeQueryDataScannedCacheAvgDataPoint(ts, 1, "attr-val", "attr-val", "attr-val", "attr-val", "attr-val", "attr-val", "attr-val")
 	mb.RecordSnowflakeQueryExecutedDataPoint(ts, 1, "attr-val")
 	mb.RecordSnowflakeQueryExecutionTimeAvgDataPoint(ts, 1, "attr-val", "attr-val", "attr-val", "attr-val", "attr-val", "attr-val", "attr-val")
+	mb.RecordSnowflakeQueryLongRunningCountDataPoint(ts, 1, "attr-val", "attr-val")
+	mb.RecordSnowflakeQueryLongRunningElapsedTimeDataPoint(ts, 1, "attr-val", "attr-val")
 	mb.RecordSnowflakeQueryPartitionsScannedAvgDataPoint(ts, 1, "attr-val", "attr-val", "attr-val", "attr-val", "attr-val", "attr-val", "attr-val")
 	mb.RecordSnowflakeQueryQueuedOverloadDataPoint(ts, 1, "attr-val")
 	mb.RecordSnowflakeQueryQueuedProvisionDataPoint(ts, 1, "attr-val")
@@ -203,6 +214,7 @@ func TestAllMetrics(t *testing.T) {
 	mb.RecordSnowflakeStorageStageBytesTotalDataPoint(ts, 1)
 	mb.RecordSnowflakeStorageStorageBytesTotalDataPoint(ts, 1)
 	mb.RecordSnowflakeTotalElapsedTimeAvgDataPoint(ts, 1, "attr-val", "attr-val", "attr-val", "attr-val", "attr-val", "attr-val", "attr-val")
+	mb.RecordSnowflakeWarehouseCreditsUsedDataPoint(ts, 1, "attr-val")
 
 	metrics := mb.Emit(WithSnowflakeAccountName("attr-val"))
 
@@ -656,6 +668,44 @@ func TestAllMetrics(t *testing.T) {
 			assert.True(t, ok)
 			assert.EqualValues(t, "attr-val", attrVal.Str())
 			validatedMetrics["snowflake.query.execution_time.avg"] = struct{}{}
+		case "snowflake.query.long_running.count":
+			assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+			assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+			assert.Equal(t, "Number of queries that ran longer than the configured threshold since the last collected watermark.", ms.At(i).Description())
+			assert.Equal(t, "{queries}", ms.At(i).Unit())
+			assert.Equal(t, true, ms.At(i).Sum().IsMonotonic())
+			assert.Equal(t, pmetric.AggregationTemporalityDelta, ms.At(i).Sum().AggregationTemporality())
+			dp := ms.At(i).Sum().DataPoints().At(0)
+			assert.Equal(t, start, dp.StartTimestamp())
+			assert.Equal(t, ts, dp.Timestamp())
+			assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+			assert.Equal(t, int64(1), dp.IntValue())
+			attrVal, ok := dp.Attributes().Get("warehouse_name")
+			assert.True(t, ok)
+			assert.EqualValues(t, "attr-val", attrVal.Str())
+			attrVal, ok = dp.Attributes().Get("user_name")
+			assert.True(t, ok)
+			assert.EqualValues(t, "attr-val", attrVal.Str())
+			validatedMetrics["snowflake.query.long_running.count"] = struct{}{}
+		case "snowflake.query.long_running.elapsed_time":
+			assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+			assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+			assert.Equal(t, "Total elapsed time of queries that ran longer than the configured threshold since the last collected watermark.", ms.At(i).Description())
+			assert.Equal(t, "ms", ms.At(i).Unit())
+			assert.Equal(t, true, ms.At(i).Sum().IsMonotonic())
+			assert.Equal(t, pmetric.AggregationTemporalityDelta, ms.At(i).Sum().AggregationTemporality())
+			dp := ms.At(i).Sum().DataPoints().At(0)
+			assert.Equal(t, start, dp.StartTimestamp())
+			assert.Equal(t, ts, dp.Timestamp())
+			assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+			assert.Equal(t, int64(1), dp.IntValue())
+			attrVal, ok := dp.Attributes().Get("warehouse_name")
+			assert.True(t, ok)
+			assert.EqualValues(t, "attr-val", attrVal.Str())
+			attrVal, ok = dp.Attributes().Get("user_name")
+			assert.True(t, ok)
+			assert.EqualValues(t, "attr-val", attrVal.Str())
+			validatedMetrics["snowflake.query.long_running.elapsed_time"] = struct{}{}
 		case "snowflake.query.partitions_scanned.avg":
 			assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
 			assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
@@ -1051,6 +1101,22 @@ func TestAllMetrics(t *testing.T) {
 			assert.True(t, ok)
 			assert.EqualValues(t, "attr-val", attrVal.Str())
 			validatedMetrics["snowflake.total_elapsed_time.avg"] = struct{}{}
+		case "snowflake.warehouse.credits_used":
+			assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+			assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+			assert.Equal(t, "Credits used by a warehouse since the last collected watermark.", ms.At(i).Description())
+			assert.Equal(t, "{credits}", ms.At(i).Unit())
+			assert.Equal(t, true, ms.At(i).Sum().IsMonotonic())
+			assert.Equal(t, pmetric.AggregationTemporalityDelta, ms.At(i).Sum().AggregationTemporality())
+			dp := ms.At(i).Sum().DataPoints().At(0)
+			assert.Equal(t, start, dp.StartTimestamp())
+			assert.Equal(t, ts, dp.Timestamp())
+			assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+			assert.Equal(t, float64(1), dp.DoubleValue())
+			attrVal, ok := dp.Attributes().Get("warehouse_name")
+			assert.True(t, ok)
+			assert.EqualValues(t, "attr-val", attrVal.Str())
+			validatedMetrics["snowflake.warehouse.credits_used"] = struct{}{}
 		}
 	}
 	assert.Equal(t, allMetricsCount, len(validatedMetrics))
@@ -1079,6 +1145,8 @@ func TestNoMetrics(t *testing.T) {
 		SnowflakeQueryDataScannedCacheAvg:              MetricSettings{Enabled: false},
 		SnowflakeQueryExecuted:                         MetricSettings{Enabled: false},
 		SnowflakeQueryExecutionTimeAvg:                 MetricSettings{Enabled: false},
+		SnowflakeQueryLongRunningCount:                 MetricSettings{Enabled: false},
+		SnowflakeQueryLongRunningElapsedTime:           MetricSettings{Enabled: false},
 		SnowflakeQueryPartitionsScannedAvg:             MetricSettings{Enabled: false},
 		SnowflakeQueryQueuedOverload:                   MetricSettings{Enabled: false},
 		SnowflakeQueryQueuedProvision:                  MetricSettings{Enabled: false},
@@ -1095,6 +1163,7 @@ func TestNoMetrics(t *testing.T) {
 		SnowflakeStorageStageBytesTotal:                MetricSettings{Enabled: false},
 		SnowflakeStorageStorageBytesTotal:              MetricSettings{Enabled: false},
 		SnowflakeTotalElapsedTimeAvg:                   MetricSettings{Enabled: false},
+		SnowflakeWarehouseCreditsUsed:                  MetricSettings{Enabled: false},
 	}
 	observedZapCore, observedLogs := observer.New(zap.WarnLevel)
 	settings := receivertest.NewNopCreateSettings()
@@ -1121,6 +1190,8 @@ func TestNoMetrics(t *testing.T) {
 	mb.RecordSnowflakeQueryDataScannedCacheAvgDataPoint(ts, 1, "attr-val", "attr-val", "attr-val", "attr-val", "attr-val", "attr-val", "attr-val")
 	mb.RecordSnowflakeQueryExecutedDataPoint(ts, 1, "attr-val")
 	mb.RecordSnowflakeQueryExecutionTimeAvgDataPoint(ts, 1, "attr-val", "attr-val", "attr-val", "attr-val", "attr-val", "attr-val", "attr-val")
+	mb.RecordSnowflakeQueryLongRunningCountDataPoint(ts, 1, "attr-val", "attr-val")
+	mb.RecordSnowflakeQueryLongRunningElapsedTimeDataPoint(ts, 1, "attr-val", "attr-val")
 	mb.RecordSnowflakeQueryPartitionsScannedAvgDataPoint(ts, 1, "attr-val", "attr-val", "attr-val", "attr-val", "attr-val", "attr-val", "attr-val")
 	mb.RecordSnowflakeQueryQueuedOverloadDataPoint(ts, 1, "attr-val")
 	mb.RecordSnowflakeQueryQueuedProvisionDataPoint(ts, 1, "attr-val")
@@ -1137,6 +1208,7 @@ func TestNoMetrics(t *testing.T) {
 	mb.RecordSnowflakeStorageStageBytesTotalDataPoint(ts, 1)
 	mb.RecordSnowflakeStorageStorageBytesTotalDataPoint(ts, 1)
 	mb.RecordSnowflakeTotalElapsedTimeAvgDataPoint(ts, 1, "attr-val", "attr-val", "attr-val", "attr-val", "attr-val", "attr-val", "attr-val")
+	mb.RecordSnowflakeWarehouseCreditsUsedDataPoint(ts, 1, "attr-val")
 
 	metrics := mb.Emit()
 
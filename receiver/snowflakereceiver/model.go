@@ -106,3 +106,17 @@ type storageMetric struct {
 	stageBytes    int64
 	failsafeBytes int64
 }
+
+// warehouse metering history query, bounded by the warehouse metering watermark
+type whMeteringMetric struct {
+	warehouseName sql.NullString
+	creditsUsed   float64
+}
+
+// long running query history query, bounded by the query history watermark
+type longRunningQueryMetric struct {
+	warehouseName    sql.NullString
+	userName         sql.NullString
+	queryCount       int64
+	totalElapsedTime int64
+}
@@ -19,44 +19,62 @@ import (
 	"time"
 
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/extension/experimental/storage"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver"
 	"go.opentelemetry.io/collector/receiver/scrapererror"
+	"go.uber.org/multierr"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/snowflakereceiver/internal/metadata"
 )
 
 type snowflakeMetricsScraper struct {
 	client   *snowflakeClient
+	id       component.ID
 	settings component.TelemetrySettings
 	conf     *Config
 	mb       *metadata.MetricsBuilder
+	// windowMB records the metrics of the incrementally collected views, whose start
+	// timestamp is the start of the collected window instead of the receiver start time.
+	windowMB      *metadata.MetricsBuilder
+	storageClient storage.Client
+	watermarks    watermarks
 }
 
 func newSnowflakeMetricsScraper(settings receiver.CreateSettings, conf *Config) *snowflakeMetricsScraper {
 	return &snowflakeMetricsScraper{
-		settings: settings.TelemetrySettings,
-		conf:     conf,
-		mb:       metadata.NewMetricsBuilder(conf.Metrics, settings),
+		id:            settings.ID,
+		settings:      settings.TelemetrySettings,
+		conf:          conf,
+		mb:            metadata.NewMetricsBuilder(conf.Metrics, settings),
+		windowMB:      metadata.NewMetricsBuilder(conf.Metrics, settings),
+		storageClient: storage.NewNopClient(),
 	}
 }
 
 // for use with receiver.scraperhelper
-func (s *snowflakeMetricsScraper) start(_ context.Context, _ component.Host) (err error) {
+func (s *snowflakeMetricsScraper) start(ctx context.Context, host component.Host) (err error) {
 	s.client, err = newDefaultClient(s.settings, *s.conf)
 	if err != nil {
 		return err
 	}
-	return nil
+
+	s.storageClient, err = getStorageClient(ctx, host, s.conf.StorageID, s.id)
+	if err != nil {
+		return err
+	}
+	return s.loadWatermarks(ctx)
 }
 
-func (s *snowflakeMetricsScraper) shutdown(_ context.Context) (err error) {
+func (s *snowflakeMetricsScraper) shutdown(ctx context.Context) (err error) {
+	if s.storageClient != nil {
+		err = s.storageClient.Close(ctx)
+	}
 	if s.client == nil {
-		return nil
+		return err
 	}
-	err = s.client.client.Close()
-	return err
+	return multierr.Append(err, s.client.client.Close())
 }
 
 // wrapper for all of the sub-scraping tasks, implements the scraper interface for
@@ -68,19 +86,43 @@ func (s *snowflakeMetricsScraper) scrape(ctx context.Context) (pmetric.Metrics,
 
 	// each client call has its own scrape function
 
-	s.scrapeBillingMetrics(ctx, now, *errs)
-	s.scrapeWarehouseBillingMetrics(ctx, now, *errs)
-	s.scrapeLoginMetrics(ctx, now, *errs)
-	s.scrapeHighLevelQueryMetrics(ctx, now, *errs)
-	s.scrapeDBMetrics(ctx, now, *errs)
-	s.scrapeSessionMetrics(ctx, now, *errs)
-	s.scrapeSnowpipeMetrics(ctx, now, *errs)
-	s.scrapeStorageMetrics(ctx, now, *errs)
+	s.scrapeBillingMetrics(ctx, now, errs)
+	s.scrapeWarehouseBillingMetrics(ctx, now, errs)
+	s.scrapeLoginMetrics(ctx, now, errs)
+	s.scrapeHighLevelQueryMetrics(ctx, now, errs)
+	s.scrapeDBMetrics(ctx, now, errs)
+	s.scrapeSessionMetrics(ctx, now, errs)
+	s.scrapeSnowpipeMetrics(ctx, now, errs)
+	s.scrapeStorageMetrics(ctx, now, errs)
+
+	previous := s.watermarks
+	s.scrapeWarehouseMeteringMetrics(ctx, now, errs)
+	s.scrapeLongRunningQueryMetrics(ctx, now, errs)
+	if !previous.WarehouseMetering.Equal(s.watermarks.WarehouseMetering) || !previous.QueryHistory.Equal(s.watermarks.QueryHistory) {
+		if err := s.saveWatermarks(ctx); err != nil {
+			errs.Add(err)
+		}
+	}
 
-	return s.mb.Emit(metadata.WithSnowflakeAccountName(s.conf.Account)), errs.Combine()
+	return s.emit(), errs.Combine()
+}
+
+// emit returns the metrics recorded by both metrics builders under a single resource.
+func (s *snowflakeMetricsScraper) emit() pmetric.Metrics {
+	metrics := s.mb.Emit(metadata.WithSnowflakeAccountName(s.conf.Account))
+	windowMetrics := s.windowMB.Emit(metadata.WithSnowflakeAccountName(s.conf.Account))
+	if windowMetrics.ResourceMetrics().Len() == 0 {
+		return metrics
+	}
+	if metrics.ResourceMetrics().Len() == 0 {
+		return windowMetrics
+	}
+	windowMetrics.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().MoveAndAppendTo(
+		metrics.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics())
+	return metrics
 }
 
-func (s *snowflakeMetricsScraper) scrapeBillingMetrics(ctx context.Context, t pcommon.Timestamp, errs scrapererror.ScrapeErrors) {
+func (s *snowflakeMetricsScraper) scrapeBillingMetrics(ctx context.Context, t pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
 	billingMetrics, err := s.client.FetchBillingMetrics(ctx)
 
 	if err != nil {
@@ -95,7 +137,7 @@ func (s *snowflakeMetricsScraper) scrapeBillingMetrics(ctx context.Context, t pc
 	}
 }
 
-func (s *snowflakeMetricsScraper) scrapeWarehouseBillingMetrics(ctx context.Context, t pcommon.Timestamp, errs scrapererror.ScrapeErrors) {
+func (s *snowflakeMetricsScraper) scrapeWarehouseBillingMetrics(ctx context.Context, t pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
 	warehouseBillingMetrics, err := s.client.FetchWarehouseBillingMetrics(ctx)
 
 	if err != nil {
@@ -110,7 +152,7 @@ func (s *snowflakeMetricsScraper) scrapeWarehouseBillingMetrics(ctx context.Cont
 	}
 }
 
-func (s *snowflakeMetricsScraper) scrapeLoginMetrics(ctx context.Context, t pcommon.Timestamp, errs scrapererror.ScrapeErrors) {
+func (s *snowflakeMetricsScraper) scrapeLoginMetrics(ctx context.Context, t pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
 	loginMetrics, err := s.client.FetchLoginMetrics(ctx)
 
 	if err != nil {
@@ -123,7 +165,7 @@ func (s *snowflakeMetricsScraper) scrapeLoginMetrics(ctx context.Context, t pcom
 	}
 }
 
-func (s *snowflakeMetricsScraper) scrapeHighLevelQueryMetrics(ctx context.Context, t pcommon.Timestamp, errs scrapererror.ScrapeErrors) {
+func (s *snowflakeMetricsScraper) scrapeHighLevelQueryMetrics(ctx context.Context, t pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
 	highLevelQueryMetrics, err := s.client.FetchHighLevelQueryMetrics(ctx)
 
 	if err != nil {
@@ -139,7 +181,7 @@ func (s *snowflakeMetricsScraper) scrapeHighLevelQueryMetrics(ctx context.Contex
 	}
 }
 
-func (s *snowflakeMetricsScraper) scrapeDBMetrics(ctx context.Context, t pcommon.Timestamp, errs scrapererror.ScrapeErrors) {
+func (s *snowflakeMetricsScraper) scrapeDBMetrics(ctx context.Context, t pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
 	DBMetrics, err := s.client.FetchDbMetrics(ctx)
 
 	if err != nil {
@@ -170,7 +212,7 @@ func (s *snowflakeMetricsScraper) scrapeDBMetrics(ctx context.Context, t pcommon
 	}
 }
 
-func (s *snowflakeMetricsScraper) scrapeSessionMetrics(ctx context.Context, t pcommon.Timestamp, errs scrapererror.ScrapeErrors) {
+func (s *snowflakeMetricsScraper) scrapeSessionMetrics(ctx context.Context, t pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
 	sessionMetrics, err := s.client.FetchSessionMetrics(ctx)
 
 	if err != nil {
@@ -183,7 +225,7 @@ func (s *snowflakeMetricsScraper) scrapeSessionMetrics(ctx context.Context, t pc
 	}
 }
 
-func (s *snowflakeMetricsScraper) scrapeSnowpipeMetrics(ctx context.Context, t pcommon.Timestamp, errs scrapererror.ScrapeErrors) {
+func (s *snowflakeMetricsScraper) scrapeSnowpipeMetrics(ctx context.Context, t pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
 	snowpipeMetrics, err := s.client.FetchSnowpipeMetrics(ctx)
 
 	if err != nil {
@@ -196,7 +238,7 @@ func (s *snowflakeMetricsScraper) scrapeSnowpipeMetrics(ctx context.Context, t p
 	}
 }
 
-func (s *snowflakeMetricsScraper) scrapeStorageMetrics(ctx context.Context, t pcommon.Timestamp, errs scrapererror.ScrapeErrors) {
+func (s *snowflakeMetricsScraper) scrapeStorageMetrics(ctx context.Context, t pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
 	storageMetrics, err := s.client.FetchStorageMetrics(ctx)
 
 	if err != nil {
@@ -210,3 +252,56 @@ func (s *snowflakeMetricsScraper) scrapeStorageMetrics(ctx context.Context, t pc
 		s.mb.RecordSnowflakeStorageFailsafeBytesTotalDataPoint(t, row.failsafeBytes)
 	}
 }
+
+// the incremental scrapes report the (since, until] window as the interval of their data points
+// and only advance their watermark once the window has been fetched successfully
+func (s *snowflakeMetricsScraper) scrapeWarehouseMeteringMetrics(ctx context.Context, t pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	if !s.conf.Metrics.SnowflakeWarehouseCreditsUsed.Enabled {
+		return
+	}
+
+	until := t.AsTime().Add(-warehouseMeteringLatency)
+	since, ok := s.nextWindow(s.watermarks.WarehouseMetering, until)
+	if !ok {
+		return
+	}
+
+	meteringMetrics, err := s.client.FetchWarehouseMeteringHistory(ctx, since, until)
+	if err != nil {
+		errs.Add(err)
+		return
+	}
+
+	s.windowMB.Reset(metadata.WithStartTime(pcommon.NewTimestampFromTime(since)))
+	end := pcommon.NewTimestampFromTime(until)
+	for _, row := range *meteringMetrics {
+		s.windowMB.RecordSnowflakeWarehouseCreditsUsedDataPoint(end, row.creditsUsed, row.warehouseName.String)
+	}
+	s.watermarks.WarehouseMetering = until
+}
+
+func (s *snowflakeMetricsScraper) scrapeLongRunningQueryMetrics(ctx context.Context, t pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	if !s.conf.Metrics.SnowflakeQueryLongRunningCount.Enabled && !s.conf.Metrics.SnowflakeQueryLongRunningElapsedTime.Enabled {
+		return
+	}
+
+	until := t.AsTime().Add(-queryHistoryLatency)
+	since, ok := s.nextWindow(s.watermarks.QueryHistory, until)
+	if !ok {
+		return
+	}
+
+	longRunningQueryMetrics, err := s.client.FetchLongRunningQueries(ctx, since, until, s.conf.LongRunningQueryThreshold)
+	if err != nil {
+		errs.Add(err)
+		return
+	}
+
+	s.windowMB.Reset(metadata.WithStartTime(pcommon.NewTimestampFromTime(since)))
+	end := pcommon.NewTimestampFromTime(until)
+	for _, row := range *longRunningQueryMetrics {
+		s.windowMB.RecordSnowflakeQueryLongRunningCountDataPoint(end, row.queryCount, row.warehouseName.String, row.userName.String)
+		s.windowMB.RecordSnowflakeQueryLongRunningElapsedTimeDataPoint(end, row.totalElapsedTime, row.warehouseName.String, row.userName.String)
+	}
+	s.watermarks.QueryHistory = until
+}
@@ -17,13 +17,19 @@ package snowflakereceiver
 import (
 	"context"
 	"database/sql/driver"
+	"encoding/json"
+	"errors"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/extension/experimental/storage"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver/receivertest"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/comparetest"
@@ -84,6 +90,145 @@ func TestStart(t *testing.T) {
 	require.NoError(t, err, "Problem starting scraper")
 }
 
+func TestScrapeIncrementalMetrics(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Account = "account"
+	cfg.Username = "uname"
+	cfg.Password = "pwd"
+	cfg.Warehouse = "warehouse"
+	cfg.Metrics.SnowflakeWarehouseCreditsUsed.Enabled = true
+	cfg.Metrics.SnowflakeQueryLongRunningCount.Enabled = true
+	cfg.Metrics.SnowflakeQueryLongRunningElapsedTime.Enabled = true
+	require.NoError(t, component.ValidateConfig(cfg))
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	mockDB := MockDB{mock}
+	mockDB.initMockDB()
+
+	meteringWatermark := time.Now().Add(-4 * time.Hour).UTC()
+	mock.ExpectQuery(warehouseMeteringHistoryQuery).
+		WithArgs(meteringWatermark, sqlmock.AnyArg()).
+		WillReturnRows(mock.NewRows([]string{"wh_name", "credits_used"}).AddRow("wh", 1.5))
+	mock.ExpectQuery(longRunningQueryHistoryQuery).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), cfg.LongRunningQueryThreshold.Milliseconds()).
+		WillReturnRows(mock.NewRows([]string{"wh_name", "username", "count", "elapsed"}).AddRow("wh", "user", 2, 150000))
+
+	scraper := newSnowflakeMetricsScraper(receivertest.NewNopCreateSettings(), cfg)
+	scraper.client = &snowflakeClient{
+		client: db,
+		logger: receivertest.NewNopCreateSettings().Logger,
+	}
+	storageClient := &mapStorageClient{data: map[string][]byte{}}
+	scraper.storageClient = storageClient
+	scraper.watermarks.WarehouseMetering = meteringWatermark
+
+	actualMetrics, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	found := map[string]pmetric.NumberDataPoint{}
+	ms := actualMetrics.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	for i := 0; i < ms.Len(); i++ {
+		if ms.At(i).Type() == pmetric.MetricTypeSum {
+			found[ms.At(i).Name()] = ms.At(i).Sum().DataPoints().At(0)
+		}
+	}
+	require.Equal(t, 1.5, found["snowflake.warehouse.credits_used"].DoubleValue())
+	require.Equal(t, int64(2), found["snowflake.query.long_running.count"].IntValue())
+	require.Equal(t, int64(150000), found["snowflake.query.long_running.elapsed_time"].IntValue())
+
+	// both watermarks moved forward and were persisted
+	require.True(t, scraper.watermarks.WarehouseMetering.After(meteringWatermark))
+	require.False(t, scraper.watermarks.QueryHistory.IsZero())
+
+	// the data points cover the collected windows
+	credits := found["snowflake.warehouse.credits_used"]
+	require.Equal(t, pcommon.NewTimestampFromTime(meteringWatermark), credits.StartTimestamp())
+	require.Equal(t, pcommon.NewTimestampFromTime(scraper.watermarks.WarehouseMetering), credits.Timestamp())
+	longRunning := found["snowflake.query.long_running.count"]
+	require.Equal(t, pcommon.NewTimestampFromTime(scraper.watermarks.QueryHistory), longRunning.Timestamp())
+	require.Equal(t, longRunning.Timestamp().AsTime().Add(-cfg.CollectionInterval), longRunning.StartTimestamp().AsTime())
+
+	var stored watermarks
+	require.NoError(t, json.Unmarshal(storageClient.data[watermarksStorageKey], &stored))
+	require.True(t, stored.WarehouseMetering.Equal(scraper.watermarks.WarehouseMetering))
+	require.True(t, stored.QueryHistory.Equal(scraper.watermarks.QueryHistory))
+
+	restarted := newSnowflakeMetricsScraper(receivertest.NewNopCreateSettings(), cfg)
+	restarted.storageClient = storageClient
+	require.NoError(t, restarted.loadWatermarks(context.Background()))
+	require.True(t, restarted.watermarks.WarehouseMetering.Equal(scraper.watermarks.WarehouseMetering))
+	require.True(t, restarted.watermarks.QueryHistory.Equal(scraper.watermarks.QueryHistory))
+}
+
+func TestScrapeIncrementalMetricsQueryFailure(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Metrics.SnowflakeWarehouseCreditsUsed.Enabled = true
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	mockDB := MockDB{mock}
+	mockDB.initMockDB()
+	mock.ExpectQuery(warehouseMeteringHistoryQuery).WillReturnError(errors.New("query failed"))
+
+	scraper := newSnowflakeMetricsScraper(receivertest.NewNopCreateSettings(), cfg)
+	scraper.client = &snowflakeClient{
+		client: db,
+		logger: receivertest.NewNopCreateSettings().Logger,
+	}
+	storageClient := &mapStorageClient{data: map[string][]byte{}}
+	scraper.storageClient = storageClient
+
+	_, err = scraper.scrape(context.Background())
+	require.ErrorContains(t, err, "query failed")
+
+	// a failed fetch must not skip the window on the next scrape
+	require.True(t, scraper.watermarks.WarehouseMetering.IsZero())
+	require.Empty(t, storageClient.data)
+}
+
+// mapStorageClient is a storage.Client kept in memory for tests
+type mapStorageClient struct {
+	data map[string][]byte
+}
+
+func (c *mapStorageClient) Get(_ context.Context, key string) ([]byte, error) {
+	return c.data[key], nil
+}
+
+func (c *mapStorageClient) Set(_ context.Context, key string, value []byte) error {
+	c.data[key] = value
+	return nil
+}
+
+func (c *mapStorageClient) Delete(_ context.Context, key string) error {
+	delete(c.data, key)
+	return nil
+}
+
+func (c *mapStorageClient) Batch(_ context.Context, ops ...storage.Operation) error {
+	for _, op := range ops {
+		switch op.Type {
+		case storage.Get:
+			op.Value = c.data[op.Key]
+		case storage.Set:
+			c.data[op.Key] = op.Value
+		case storage.Delete:
+			delete(c.data, op.Key)
+		}
+	}
+	return nil
+}
+
+func (c *mapStorageClient) Close(_ context.Context) error {
+	return nil
+}
+
 // wrapper type for convenience
 type MockDB struct {
 	mock sqlmock.Sqlmock
@@ -0,0 +1,144 @@
+// Copyright 2020, OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package splunkhecreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/splunkhecreceiver"
+
+import (
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	// splunkChannelHeader and splunkChannelQueryParam carry the channel a request is sent on.
+	splunkChannelHeader     = "X-Splunk-Request-Channel"
+	splunkChannelQueryParam = "channel"
+
+	// maxPendingAcksPerChannel bounds the memory used by a channel whose acks are never queried.
+	maxPendingAcksPerChannel = 10000
+)
+
+// ackRequest is the body of a request to the ack endpoint.
+type ackRequest struct {
+	Acks []uint64 `json:"acks"`
+}
+
+// ackResponse is the body of a response of the ack endpoint.
+type ackResponse struct {
+	Acks map[string]bool `json:"acks"`
+}
+
+// ackSuccessResponse is the body of a successful request made on a channel when acks are enabled.
+type ackSuccessResponse struct {
+	Text  string `json:"text"`
+	Code  int    `json:"code"`
+	AckID uint64 `json:"ackId"`
+}
+
+// ackManager emulates the Splunk indexer acknowledgement. Requests are only
+// acknowledged once the next consumer accepted their data, so an ackId is
+// issued for delivered requests only and is always reported as acknowledged.
+type ackManager struct {
+	mu          sync.Mutex
+	channels    map[string]*ackChannel
+	idleTimeout time.Duration
+	lastExpiry  time.Time
+	now         func() time.Time
+}
+
+type ackChannel struct {
+	nextAckID uint64
+	delivered map[uint64]struct{}
+	lastUsed  time.Time
+}
+
+func newAckManager(idleTimeout time.Duration) *ackManager {
+	return &ackManager{
+		channels:    make(map[string]*ackChannel),
+		idleTimeout: idleTimeout,
+		lastExpiry:  time.Now(),
+		now:         time.Now,
+	}
+}
+
+// delivered issues the ackId of a request successfully delivered on the channel.
+func (m *ackManager) delivered(channel string) uint64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := m.now()
+	m.expireIdle(now)
+	ch, ok := m.channels[channel]
+	if !ok {
+		ch = &ackChannel{delivered: make(map[uint64]struct{})}
+		m.channels[channel] = ch
+	}
+	ch.lastUsed = now
+
+	ackID := ch.nextAckID
+	ch.nextAckID++
+	ch.delivered[ackID] = struct{}{}
+	if ackID >= maxPendingAcksPerChannel {
+		delete(ch.delivered, ackID-maxPendingAcksPerChannel)
+	}
+	return ackID
+}
+
+// query returns the status of the given ackIds of the channel. Like Splunk,
+// an ackId reported as acknowledged is forgotten.
+func (m *ackManager) query(channel string, ackIDs []uint64) map[string]bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := m.now()
+	m.expireIdle(now)
+	statuses := make(map[string]bool, len(ackIDs))
+	ch, ok := m.channels[channel]
+	if ok {
+		ch.lastUsed = now
+	}
+	for _, ackID := range ackIDs {
+		acked := false
+		if ok {
+			if _, acked = ch.delivered[ackID]; acked {
+				delete(ch.delivered, ackID)
+			}
+		}
+		statuses[strconv.FormatUint(ackID, 10)] = acked
+	}
+	return statuses
+}
+
+// expireIdle drops the channels unused for longer than the idle timeout. The channels
+// are scanned at most once per idle timeout. It must be called with mu held.
+func (m *ackManager) expireIdle(now time.Time) {
+	if now.Sub(m.lastExpiry) < m.idleTimeout {
+		return
+	}
+	m.lastExpiry = now
+	for name, ch := range m.channels {
+		if now.Sub(ch.lastUsed) > m.idleTimeout {
+			delete(m.channels, name)
+		}
+	}
+}
+
+// channelFromRequest returns the channel of the request, from either the header or the query parameter.
+func channelFromRequest(req *http.Request) string {
+	if channel := req.Header.Get(splunkChannelHeader); channel != "" {
+		return channel
+	}
+	return req.URL.Query().Get(splunkChannelQueryParam)
+}
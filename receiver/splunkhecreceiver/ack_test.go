@@ -0,0 +1,54 @@
+// Copyright 2020, OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package splunkhecreceiver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAckManager(t *testing.T) {
+	now := time.Unix(1000, 0)
+	m := newAckManager(time.Minute)
+	m.lastExpiry = now
+	m.now = func() time.Time { return now }
+
+	assert.Equal(t, uint64(0), m.delivered("a"))
+	assert.Equal(t, uint64(1), m.delivered("a"))
+	assert.Equal(t, uint64(0), m.delivered("b"))
+
+	assert.Equal(t, map[string]bool{"0": true, "5": false}, m.query("a", []uint64{0, 5}))
+	// Acknowledged ackIds are forgotten once reported.
+	assert.Equal(t, map[string]bool{"0": false, "1": true}, m.query("a", []uint64{0, 1}))
+	assert.Equal(t, map[string]bool{"0": false}, m.query("unknown", []uint64{0}))
+
+	now = now.Add(30 * time.Second)
+	m.delivered("a")
+	now = now.Add(45 * time.Second)
+	m.query("a", nil)
+	assert.Contains(t, m.channels, "a")
+	assert.NotContains(t, m.channels, "b")
+}
+
+func TestAckManagerBoundsPendingAcks(t *testing.T) {
+	m := newAckManager(time.Minute)
+	for i := 0; i < maxPendingAcksPerChannel+10; i++ {
+		m.delivered("a")
+	}
+	assert.Len(t, m.channels["a"].delivered, maxPendingAcksPerChannel)
+	assert.Equal(t, map[string]bool{"9": false, "10": true}, m.query("a", []uint64{9, 10}))
+}
@@ -15,6 +15,9 @@
 package splunkhecreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/splunkhecreceiver"
 
 import (
+	"errors"
+	"time"
+
 	"go.opentelemetry.io/collector/config/confighttp"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/splunk"
@@ -31,4 +34,31 @@ type Config struct {
 	HealthPath string `mapstructure:"health_path"`
 	// HecToOtelAttrs creates a mapping from HEC metadata to attributes.
 	HecToOtelAttrs splunk.HecToOtelAttrs `mapstructure:"hec_metadata_to_otel_attrs"`
+	// Ack configures the indexer acknowledgement emulation.
+	Ack AckConfig `mapstructure:"ack"`
+}
+
+// AckConfig defines the indexer acknowledgement settings.
+type AckConfig struct {
+	// Enabled requires requests to be sent on a channel and returns an ackId
+	// for each of them once its data is accepted by the pipeline. Default is false.
+	Enabled bool `mapstructure:"enabled"`
+	// Path for the ack API, default is '/services/collector/ack'
+	Path string `mapstructure:"path"`
+	// ChannelIdleTimeout is the duration after which an unused channel and
+	// its unqueried acks are dropped, default is 10m.
+	ChannelIdleTimeout time.Duration `mapstructure:"channel_idle_timeout"`
+}
+
+// Validate checks the receiver configuration is valid.
+func (c *Config) Validate() error {
+	if c.Ack.Enabled {
+		if c.Ack.Path == "" {
+			return errors.New("ack path must be specified when ack is enabled")
+		}
+		if c.Ack.ChannelIdleTimeout <= 0 {
+			return errors.New("ack channel_idle_timeout must be positive")
+		}
+	}
+	return nil
 }
@@ -17,6 +17,7 @@ package splunkhecreceiver
 import (
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -59,6 +60,11 @@ func TestLoadConfig(t *testing.T) {
 					Index:      "myindex",
 					Host:       "myhostfield",
 				},
+				Ack: AckConfig{
+					Enabled:            true,
+					Path:               "/ack",
+					ChannelIdleTimeout: 5 * time.Minute,
+				},
 			},
 		},
 		{
@@ -84,6 +90,10 @@ func TestLoadConfig(t *testing.T) {
 					Index:      "com.splunk.index",
 					Host:       "host.name",
 				},
+				Ack: AckConfig{
+					Path:               "/services/collector/ack",
+					ChannelIdleTimeout: 10 * time.Minute,
+				},
 			},
 		},
 	}
@@ -102,3 +112,17 @@ func TestLoadConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateConfig(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	assert.NoError(t, cfg.Validate())
+
+	cfg.Ack.Enabled = true
+	assert.NoError(t, cfg.Validate())
+
+	cfg.Ack.ChannelIdleTimeout = 0
+	assert.EqualError(t, cfg.Validate(), "ack channel_idle_timeout must be positive")
+
+	cfg.Ack.Path = ""
+	assert.EqualError(t, cfg.Validate(), "ack path must be specified when ack is enabled")
+}
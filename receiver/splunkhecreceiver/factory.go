@@ -16,6 +16,7 @@ package splunkhecreceiver // import "github.com/open-telemetry/opentelemetry-col
 
 import (
 	"context"
+	"time"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config/confighttp"
@@ -36,6 +37,9 @@ const (
 
 	// Default endpoints to bind to.
 	defaultEndpoint = ":8088"
+
+	defaultAckPath               = "/services/collector/ack"
+	defaultAckChannelIdleTimeout = 10 * time.Minute
 )
 
 // NewFactory creates a factory for Splunk HEC receiver.
@@ -62,6 +66,10 @@ func createDefaultConfig() component.Config {
 		},
 		RawPath:    splunk.DefaultRawPath,
 		HealthPath: splunk.DefaultHealthPath,
+		Ack: AckConfig{
+			Path:               defaultAckPath,
+			ChannelIdleTimeout: defaultAckChannelIdleTimeout,
+		},
 	}
 }
 
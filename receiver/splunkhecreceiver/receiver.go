@@ -51,6 +51,9 @@ const (
 	responseErrInternalServerError    = "Internal Server Error"
 	responseErrUnsupportedMetricEvent = "Unsupported metric event"
 	responseErrUnsupportedLogEvent    = "Unsupported log event"
+	responseErrDataChannelMissing     = "Data channel is missing"
+	responseErrInvalidAckRequest      = "Invalid ack request"
+	responseSuccess                   = "Success"
 
 	// Centralizing some HTTP and related string constants.
 	gzipEncoding              = "gzip"
@@ -63,6 +66,7 @@ var (
 	errEmptyEndpoint          = errors.New("empty endpoint")
 	errInvalidMethod          = errors.New("invalid http method")
 	errInvalidEncoding        = errors.New("invalid encoding")
+	errMissingChannel         = errors.New("missing data channel")
 
 	okRespBody                = initJSONResponse(responseOK)
 	invalidMethodRespBody     = initJSONResponse(responseInvalidMethod)
@@ -72,6 +76,8 @@ var (
 	errInternalServerError    = initJSONResponse(responseErrInternalServerError)
 	errUnsupportedMetricEvent = initJSONResponse(responseErrUnsupportedMetricEvent)
 	errUnsupportedLogEvent    = initJSONResponse(responseErrUnsupportedLogEvent)
+	errDataChannelMissing     = initJSONResponse(responseErrDataChannelMissing)
+	errInvalidAckRequest      = initJSONResponse(responseErrInvalidAckRequest)
 )
 
 // splunkReceiver implements the receiver.Metrics for Splunk HEC metric protocol.
@@ -84,6 +90,8 @@ type splunkReceiver struct {
 	shutdownWG      sync.WaitGroup
 	obsrecv         *obsreport.Receiver
 	gzipReaderPool  *sync.Pool
+	// acks is nil when the indexer acknowledgement is disabled.
+	acks *ackManager
 }
 
 var _ receiver.Metrics = (*splunkReceiver)(nil)
@@ -129,6 +137,9 @@ func newMetricsReceiver(
 		obsrecv:        obsrecv,
 		gzipReaderPool: &sync.Pool{New: func() interface{} { return new(gzip.Reader) }},
 	}
+	if config.Ack.Enabled {
+		r.acks = newAckManager(config.Ack.ChannelIdleTimeout)
+	}
 
 	return r, nil
 }
@@ -174,6 +185,9 @@ func newLogsReceiver(
 		gzipReaderPool: &sync.Pool{New: func() interface{} { return new(gzip.Reader) }},
 		obsrecv:        obsrecv,
 	}
+	if config.Ack.Enabled {
+		r.acks = newAckManager(config.Ack.ChannelIdleTimeout)
+	}
 
 	return r, nil
 }
@@ -196,6 +210,9 @@ func (r *splunkReceiver) Start(_ context.Context, host component.Host) error {
 
 	mx := mux.NewRouter()
 	mx.NewRoute().Path(r.config.HealthPath).HandlerFunc(r.handleHealthReq)
+	if r.acks != nil {
+		mx.NewRoute().Path(r.config.Ack.Path).HandlerFunc(r.handleAckReq)
+	}
 	if r.logsConsumer != nil {
 		mx.NewRoute().Path(r.config.RawPath).HandlerFunc(r.handleRawReq)
 	}
@@ -245,6 +262,11 @@ func (r *splunkReceiver) handleRawReq(resp http.ResponseWriter, req *http.Reques
 		return
 	}
 
+	if r.acks != nil && channelFromRequest(req) == "" {
+		r.failRequest(ctx, resp, http.StatusBadRequest, errDataChannelMissing, 0, errMissingChannel)
+		return
+	}
+
 	if req.ContentLength == 0 {
 		r.obsrecv.EndLogsOp(ctx, typeStr, 0, nil)
 		return
@@ -286,6 +308,9 @@ func (r *splunkReceiver) handleRawReq(resp http.ResponseWriter, req *http.Reques
 
 	if consumerErr != nil {
 		r.failRequest(ctx, resp, http.StatusInternalServerError, errInternalServerError, sl.LogRecords().Len(), consumerErr)
+	} else if r.acks != nil {
+		r.obsrecv.EndLogsOp(ctx, typeStr, sl.LogRecords().Len(), nil)
+		r.writeAckSuccess(resp, req)
 	} else {
 		resp.WriteHeader(http.StatusOK)
 		r.obsrecv.EndLogsOp(ctx, typeStr, sl.LogRecords().Len(), nil)
@@ -311,6 +336,11 @@ func (r *splunkReceiver) handleReq(resp http.ResponseWriter, req *http.Request)
 		return
 	}
 
+	if r.acks != nil && channelFromRequest(req) == "" {
+		r.failRequest(ctx, resp, http.StatusBadRequest, errDataChannelMissing, 0, errMissingChannel)
+		return
+	}
+
 	bodyReader := req.Body
 	if encoding == gzipEncoding {
 		reader := r.gzipReaderPool.Get().(*gzip.Reader)
@@ -369,6 +399,8 @@ func (r *splunkReceiver) consumeMetrics(ctx context.Context, events []*splunk.Ev
 
 	if decodeErr != nil {
 		r.failRequest(ctx, resp, http.StatusInternalServerError, errInternalServerError, len(events), decodeErr)
+	} else if r.acks != nil {
+		r.writeAckSuccess(resp, req)
 	} else {
 		resp.WriteHeader(http.StatusOK)
 		_, err := resp.Write(okRespBody)
@@ -390,6 +422,8 @@ func (r *splunkReceiver) consumeLogs(ctx context.Context, events []*splunk.Event
 	r.obsrecv.EndLogsOp(ctx, typeStr, len(events), decodeErr)
 	if decodeErr != nil {
 		r.failRequest(ctx, resp, http.StatusInternalServerError, errInternalServerError, len(events), decodeErr)
+	} else if r.acks != nil {
+		r.writeAckSuccess(resp, req)
 	} else {
 		resp.WriteHeader(http.StatusOK)
 		if _, err := resp.Write(okRespBody); err != nil {
@@ -398,6 +432,62 @@ func (r *splunkReceiver) consumeLogs(ctx context.Context, events []*splunk.Event
 	}
 }
 
+// writeAckSuccess responds to a request whose data was accepted by the next consumer with its ackId.
+// The response body is built before the status is written, so that a failure can still be reported.
+func (r *splunkReceiver) writeAckSuccess(resp http.ResponseWriter, req *http.Request) {
+	body, err := jsoniter.Marshal(ackSuccessResponse{
+		Text:  responseSuccess,
+		AckID: r.acks.delivered(channelFromRequest(req)),
+	})
+	if err != nil {
+		r.writeAckError(resp, http.StatusInternalServerError, errInternalServerError)
+		return
+	}
+	resp.Header().Set("Content-Type", "application/json")
+	resp.WriteHeader(http.StatusOK)
+	if _, err = resp.Write(body); err != nil {
+		r.settings.Logger.Warn("Error writing HTTP response message", zap.Error(err))
+	}
+}
+
+// handleAckReq reports the status of the ackIds previously issued on the channel of the request.
+func (r *splunkReceiver) handleAckReq(resp http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		r.writeAckError(resp, http.StatusBadRequest, invalidMethodRespBody)
+		return
+	}
+	channel := channelFromRequest(req)
+	if channel == "" {
+		r.writeAckError(resp, http.StatusBadRequest, errDataChannelMissing)
+		return
+	}
+
+	var ackReq ackRequest
+	if err := jsoniter.NewDecoder(req.Body).Decode(&ackReq); err != nil {
+		r.writeAckError(resp, http.StatusBadRequest, errInvalidAckRequest)
+		return
+	}
+
+	body, err := jsoniter.Marshal(ackResponse{Acks: r.acks.query(channel, ackReq.Acks)})
+	if err != nil {
+		r.writeAckError(resp, http.StatusInternalServerError, errInternalServerError)
+		return
+	}
+	resp.Header().Set("Content-Type", "application/json")
+	resp.WriteHeader(http.StatusOK)
+	if _, err = resp.Write(body); err != nil {
+		r.settings.Logger.Warn("Error writing HTTP response message", zap.Error(err))
+	}
+}
+
+func (r *splunkReceiver) writeAckError(resp http.ResponseWriter, httpStatusCode int, jsonResponse []byte) {
+	resp.Header().Set("Content-Type", "application/json")
+	resp.WriteHeader(httpStatusCode)
+	if _, err := resp.Write(jsonResponse); err != nil {
+		r.settings.Logger.Warn("Error writing HTTP response message", zap.Error(err))
+	}
+}
+
 func (r *splunkReceiver) createResourceCustomizer(req *http.Request) func(resource pcommon.Resource) {
 	if r.config.AccessTokenPassthrough {
 		accessToken := req.Header.Get("Authorization")
@@ -1004,6 +1004,93 @@ func Test_splunkhecreceiver_handleHealthPath(t *testing.T) {
 	assert.Equal(t, 200, resp.StatusCode)
 }
 
+func Test_splunkhecreceiver_handleAckReq(t *testing.T) {
+	config := createDefaultConfig().(*Config)
+	config.Endpoint = "localhost:0" // Actually not creating the endpoint
+	config.Ack.Enabled = true
+	sink := new(consumertest.LogsSink)
+	rcv, err := newLogsReceiver(receivertest.NewNopCreateSettings(), *config, sink)
+	assert.NoError(t, err)
+
+	r := rcv.(*splunkReceiver)
+	assert.NoError(t, r.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() {
+		assert.NoError(t, r.Shutdown(context.Background()))
+	}()
+
+	msgBytes, err := json.Marshal(buildSplunkHecMsg(float64(time.Now().UnixNano())/1e6, 3))
+	require.NoError(t, err)
+
+	// Requests without a channel are rejected.
+	w := httptest.NewRecorder()
+	r.handleReq(w, httptest.NewRequest("POST", "http://localhost/foo", bytes.NewReader(msgBytes)))
+	assert.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+	assert.Equal(t, 0, sink.LogRecordCount())
+
+	for i := 0; i < 2; i++ {
+		req := httptest.NewRequest("POST", "http://localhost/foo", bytes.NewReader(msgBytes))
+		req.Header.Set(splunkChannelHeader, "my-channel")
+		w = httptest.NewRecorder()
+		r.handleReq(w, req)
+
+		resp := w.Result()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		var body ackSuccessResponse
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+		assert.Equal(t, ackSuccessResponse{Text: responseSuccess, AckID: uint64(i)}, body)
+	}
+
+	req := httptest.NewRequest("POST", "http://localhost/services/collector/ack?channel=my-channel", strings.NewReader(`{"acks":[0,1,2]}`))
+	w = httptest.NewRecorder()
+	r.handleAckReq(w, req)
+
+	resp := w.Result()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	var body ackResponse
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.Equal(t, map[string]bool{"0": true, "1": true, "2": false}, body.Acks)
+
+	w = httptest.NewRecorder()
+	r.handleAckReq(w, httptest.NewRequest("POST", "http://localhost/services/collector/ack", strings.NewReader(`{"acks":[0]}`)))
+	assert.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+}
+
+// failingResponseWriter fails to write the response body and records the written statuses.
+type failingResponseWriter struct {
+	*httptest.ResponseRecorder
+	statuses []int
+}
+
+func (w *failingResponseWriter) WriteHeader(statusCode int) {
+	w.statuses = append(w.statuses, statusCode)
+	w.ResponseRecorder.WriteHeader(statusCode)
+}
+
+func (w *failingResponseWriter) Write([]byte) (int, error) {
+	return 0, errors.New("connection reset")
+}
+
+func Test_splunkhecreceiver_handleReq_ackWriteFailure(t *testing.T) {
+	config := createDefaultConfig().(*Config)
+	config.Endpoint = "localhost:0" // Actually not creating the endpoint
+	config.Ack.Enabled = true
+	sink := new(consumertest.LogsSink)
+	rcv, err := newLogsReceiver(receivertest.NewNopCreateSettings(), *config, sink)
+	require.NoError(t, err)
+	r := rcv.(*splunkReceiver)
+
+	msgBytes, err := json.Marshal(buildSplunkHecMsg(float64(time.Now().UnixNano())/1e6, 3))
+	require.NoError(t, err)
+	req := httptest.NewRequest("POST", "http://localhost/foo", bytes.NewReader(msgBytes))
+	req.Header.Set(splunkChannelHeader, "my-channel")
+	w := &failingResponseWriter{ResponseRecorder: httptest.NewRecorder()}
+	r.handleReq(w, req)
+
+	// The data was accepted and its ackId issued, failing to write the body must not change the status.
+	assert.Equal(t, []int{http.StatusOK}, w.statuses)
+	assert.Equal(t, 1, sink.LogRecordCount())
+}
+
 func BenchmarkHandleReq(b *testing.B) {
 	config := createDefaultConfig().(*Config)
 	config.Endpoint = "localhost:0"
@@ -90,6 +90,29 @@ func (_m MockPerfCounterWatcher) ScrapeData() ([]winperfcounters.CounterValue, e
 	return r0, r1
 }
 
+// ScrapeRawValues provides a mock function with given fields:
+func (_m MockPerfCounterWatcher) ScrapeRawValues() ([]winperfcounters.RawCounterValue, error) {
+	ret := _m.Called()
+
+	var r0 []winperfcounters.RawCounterValue
+	if rf, ok := ret.Get(0).(func() []winperfcounters.RawCounterValue); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]winperfcounters.RawCounterValue)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 func TestSqlServerScraper(t *testing.T) {
 	factory := NewFactory()
 	cfg := factory.CreateDefaultConfig().(*Config)
@@ -27,11 +27,12 @@ import (
 
 // Config defines configuration for StatsD receiver.
 type Config struct {
-	NetAddr               confignet.NetAddr                `mapstructure:",squash"`
-	AggregationInterval   time.Duration                    `mapstructure:"aggregation_interval"`
-	EnableMetricType      bool                             `mapstructure:"enable_metric_type"`
-	IsMonotonicCounter    bool                             `mapstructure:"is_monotonic_counter"`
-	TimerHistogramMapping []protocol.TimerHistogramMapping `mapstructure:"timer_histogram_mapping"`
+	NetAddr                  confignet.NetAddr                `mapstructure:",squash"`
+	AggregationInterval      time.Duration                    `mapstructure:"aggregation_interval"`
+	EnableMetricType         bool                             `mapstructure:"enable_metric_type"`
+	IsMonotonicCounter       bool                             `mapstructure:"is_monotonic_counter"`
+	TimerHistogramMapping    []protocol.TimerHistogramMapping `mapstructure:"timer_histogram_mapping"`
+	AlignAggregationInterval bool                             `mapstructure:"align_aggregation_interval"`
 }
 
 func (c *Config) Validate() error {
@@ -66,6 +67,16 @@ func (c *Config) Validate() error {
 			errs = multierr.Append(errs, fmt.Errorf("observer_type is not supported: %s", eachMap.ObserverType))
 		}
 
+		if eachMap.ObserverType == protocol.SummaryObserver {
+			for _, pct := range eachMap.Summary.Percentiles {
+				if pct < 0 || pct > 100 {
+					errs = multierr.Append(errs, fmt.Errorf("summary percentile out of range: %v", pct))
+				}
+			}
+		} else if len(eachMap.Summary.Percentiles) > 0 {
+			errs = multierr.Append(errs, fmt.Errorf("summary configuration requires observer_type: summary"))
+		}
+
 		if eachMap.ObserverType == protocol.HistogramObserver {
 			if eachMap.Histogram.MaxSize != 0 && (eachMap.Histogram.MaxSize < structure.MinSize || eachMap.Histogram.MaxSize > structure.MaximumMaxSize) {
 				errs = multierr.Append(errs, fmt.Errorf("histogram max_size out of range: %v", eachMap.Histogram.MaxSize))
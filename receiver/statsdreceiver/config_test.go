@@ -51,11 +51,15 @@ func TestLoadConfig(t *testing.T) {
 					Endpoint:  "localhost:12345",
 					Transport: "custom_transport",
 				},
-				AggregationInterval: 70 * time.Second,
+				AggregationInterval:      70 * time.Second,
+				AlignAggregationInterval: true,
 				TimerHistogramMapping: []protocol.TimerHistogramMapping{
 					{
 						StatsdType:   "histogram",
-						ObserverType: "gauge",
+						ObserverType: "summary",
+						Summary: protocol.SummaryConfig{
+							Percentiles: []float64{50, 99, 99.9},
+						},
 					},
 					{
 						StatsdType:   "timing",
@@ -175,6 +179,38 @@ func TestValidate(t *testing.T) {
 			},
 			expectedErr: "aggregation_interval must be a positive duration",
 		},
+		{
+			name: "invalidSummary",
+			cfg: &Config{
+				AggregationInterval: 20 * time.Second,
+				TimerHistogramMapping: []protocol.TimerHistogramMapping{
+					{
+						StatsdType:   "timing",
+						ObserverType: "gauge",
+						Summary: protocol.SummaryConfig{
+							Percentiles: []float64{50},
+						},
+					},
+				},
+			},
+			expectedErr: "summary configuration requires observer_type: summary",
+		},
+		{
+			name: "summaryPercentileOutOfRange",
+			cfg: &Config{
+				AggregationInterval: 20 * time.Second,
+				TimerHistogramMapping: []protocol.TimerHistogramMapping{
+					{
+						StatsdType:   "timing",
+						ObserverType: "summary",
+						Summary: protocol.SummaryConfig{
+							Percentiles: []float64{50, 101},
+						},
+					},
+				},
+			},
+			expectedErr: "summary percentile out of range: 101",
+		},
 	}
 
 	for _, test := range tests {
@@ -63,19 +63,27 @@ type TimerHistogramMapping struct {
 	StatsdType   TypeName        `mapstructure:"statsd_type"`
 	ObserverType ObserverType    `mapstructure:"observer_type"`
 	Histogram    HistogramConfig `mapstructure:"histogram"`
+	Summary      SummaryConfig   `mapstructure:"summary"`
 }
 
 type HistogramConfig struct {
 	MaxSize int32 `mapstructure:"max_size"`
 }
 
+type SummaryConfig struct {
+	// Percentiles sent for the summary, between 0 and 100 (default 0, 10, 50, 90, 95, 100).
+	Percentiles []float64 `mapstructure:"percentiles"`
+}
+
 type ObserverCategory struct {
-	method          ObserverType
-	histogramConfig structure.Config
+	method             ObserverType
+	histogramConfig    structure.Config
+	summaryPercentiles []float64
 }
 
 var defaultObserverCategory = ObserverCategory{
-	method: DefaultObserverType,
+	method:             DefaultObserverType,
+	summaryPercentiles: statsDDefaultPercentiles,
 }
 
 // StatsDParser supports the Parse method for parsing StatsD messages with Tags.
@@ -158,9 +166,11 @@ func (p *StatsDParser) Initialize(enableMetricType bool, isMonotonicCounter bool
 		case HistogramTypeName:
 			p.histogramEvents.method = eachMap.ObserverType
 			p.histogramEvents.histogramConfig = expoHistogramConfig(eachMap.Histogram)
+			p.histogramEvents.summaryPercentiles = summaryPercentiles(eachMap.Summary)
 		case TimingTypeName, TimingAltTypeName:
 			p.timerEvents.method = eachMap.ObserverType
 			p.timerEvents.histogramConfig = expoHistogramConfig(eachMap.Histogram)
+			p.timerEvents.summaryPercentiles = summaryPercentiles(eachMap.Summary)
 		}
 	}
 	return nil
@@ -174,6 +184,13 @@ func expoHistogramConfig(opts HistogramConfig) structure.Config {
 	return structure.NewConfig(r...)
 }
 
+func summaryPercentiles(opts SummaryConfig) []float64 {
+	if len(opts.Percentiles) == 0 {
+		return statsDDefaultPercentiles
+	}
+	return opts.Percentiles
+}
+
 // GetMetrics gets the metrics preparing for flushing and reset the state.
 func (p *StatsDParser) GetMetrics() pmetric.Metrics {
 	metrics := pmetric.NewMetrics()
@@ -194,12 +211,16 @@ func (p *StatsDParser) GetMetrics() pmetric.Metrics {
 	now := timeNowFunc()
 
 	for desc, summaryMetric := range p.summaries {
+		percentiles := p.timerEvents.summaryPercentiles
+		if desc.metricType == HistogramType {
+			percentiles = p.histogramEvents.summaryPercentiles
+		}
 		buildSummaryMetric(
 			desc,
 			summaryMetric,
 			p.lastIntervalTime,
 			now,
-			statsDDefaultPercentiles,
+			percentiles,
 			rm.ScopeMetrics().AppendEmpty(),
 		)
 	}
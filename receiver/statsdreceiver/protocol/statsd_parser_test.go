@@ -890,6 +890,39 @@ func TestStatsDParser_AggregateTimerWithSummary(t *testing.T) {
 	}
 }
 
+func TestStatsDParser_SummaryPercentiles(t *testing.T) {
+	timeNowFunc = func() time.Time {
+		return time.Unix(711, 0)
+	}
+
+	p := &StatsDParser{}
+	assert.NoError(t, p.Initialize(false, false, []TimerHistogramMapping{
+		{StatsdType: "timer", ObserverType: "summary", Summary: SummaryConfig{Percentiles: []float64{50, 99}}},
+		{StatsdType: "histogram", ObserverType: "summary"},
+	}))
+	for _, line := range []string{
+		"statsdTestMetric1:1|ms",
+		"statsdTestMetric1:2|ms",
+		"statsdTestMetric2:1|h",
+	} {
+		assert.NoError(t, p.Aggregate(line))
+	}
+
+	quantiles := map[string][]float64{}
+	rm := p.GetMetrics().ResourceMetrics().At(0)
+	for i := 0; i < rm.ScopeMetrics().Len(); i++ {
+		metric := rm.ScopeMetrics().At(i).Metrics().At(0)
+		qvs := metric.Summary().DataPoints().At(0).QuantileValues()
+		for j := 0; j < qvs.Len(); j++ {
+			quantiles[metric.Name()] = append(quantiles[metric.Name()], qvs.At(j).Quantile())
+		}
+	}
+	assert.Equal(t, map[string][]float64{
+		"statsdTestMetric1": {0.5, 0.99},
+		"statsdTestMetric2": {0, 0.1, 0.5, 0.9, 0.95, 1},
+	}, quantiles)
+}
+
 func TestStatsDParser_Initialize(t *testing.T) {
 	p := &StatsDParser{}
 	assert.NoError(t, p.Initialize(true, false, []TimerHistogramMapping{{StatsdType: "timer", ObserverType: "gauge"}, {StatsdType: "histogram", ObserverType: "gauge"}}))
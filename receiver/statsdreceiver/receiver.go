@@ -75,16 +75,21 @@ func New(
 }
 
 func buildTransportServer(config Config) (transport.Server, error) {
-	// TODO: Add TCP/unix socket transport implementations
 	switch strings.ToLower(config.NetAddr.Transport) {
 	case "", "udp":
 		return transport.NewUDPServer(config.NetAddr.Endpoint)
+	case "tcp":
+		return transport.NewTCPServer(config.NetAddr.Endpoint)
+	case "unix":
+		return transport.NewUnixServer(config.NetAddr.Endpoint)
+	case "unixgram":
+		return transport.NewUnixgramServer(config.NetAddr.Endpoint)
 	}
 
 	return nil, fmt.Errorf("unsupported transport %q", config.NetAddr.Transport)
 }
 
-// Start starts a UDP server that can process StatsD messages.
+// Start starts a UDP, TCP or Unix domain socket server that can process StatsD messages.
 func (r *statsdReceiver) Start(ctx context.Context, host component.Host) error {
 	ctx, r.cancel = context.WithCancel(ctx)
 	server, err := buildTransportServer(*r.config)
@@ -93,7 +98,7 @@ func (r *statsdReceiver) Start(ctx context.Context, host component.Host) error {
 	}
 	r.server = server
 	var transferChan = make(chan string, 10)
-	ticker := time.NewTicker(r.config.AggregationInterval)
+	ticker := time.NewTicker(firstAggregationInterval(time.Now(), r.config.AggregationInterval, r.config.AlignAggregationInterval))
 	err = r.parser.Initialize(r.config.EnableMetricType, r.config.IsMonotonicCounter, r.config.TimerHistogramMapping)
 	if err != nil {
 		return err
@@ -109,6 +114,7 @@ func (r *statsdReceiver) Start(ctx context.Context, host component.Host) error {
 		for {
 			select {
 			case <-ticker.C:
+				ticker.Reset(r.config.AggregationInterval)
 				metrics := r.parser.GetMetrics()
 				if metrics.ResourceMetrics().At(0).ScopeMetrics().Len() > 0 {
 					r.Flush(ctx, metrics, r.nextConsumer)
@@ -125,6 +131,16 @@ func (r *statsdReceiver) Start(ctx context.Context, host component.Host) error {
 	return nil
 }
 
+// firstAggregationInterval returns the duration until the first flush. When aligned,
+// the metrics are flushed at the multiples of the interval, e.g. at :00, :10, :20 for
+// a 10s interval, so that the flushes of multiple collectors line up.
+func firstAggregationInterval(now time.Time, interval time.Duration, align bool) time.Duration {
+	if !align {
+		return interval
+	}
+	return interval - now.Sub(now.Truncate(interval))
+}
+
 // Shutdown stops the StatsD receiver.
 func (r *statsdReceiver) Shutdown(context.Context) error {
 	if r.cancel == nil {
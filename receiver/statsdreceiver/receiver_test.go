@@ -140,6 +140,23 @@ func Test_statsdreceiver_EndToEnd(t *testing.T) {
 				return c
 			},
 		},
+		{
+			name: "tcp with 9s interval",
+			configFn: func() *Config {
+				return &Config{
+					NetAddr: confignet.NetAddr{
+						Endpoint:  defaultBindEndpoint,
+						Transport: "tcp",
+					},
+					AggregationInterval: 9 * time.Second,
+				}
+			},
+			clientFn: func(t *testing.T) *client.StatsD {
+				c, err := client.NewStatsD(client.TCP, host, port)
+				require.NoError(t, err)
+				return c
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -181,3 +198,11 @@ func Test_statsdreceiver_EndToEnd(t *testing.T) {
 		})
 	}
 }
+
+func Test_firstAggregationInterval(t *testing.T) {
+	now := time.Date(2023, 1, 2, 3, 4, 17, 500, time.UTC)
+	assert.Equal(t, 10*time.Second, firstAggregationInterval(now, 10*time.Second, false))
+	assert.Equal(t, 3*time.Second-500*time.Nanosecond, firstAggregationInterval(now, 10*time.Second, true))
+	assert.Equal(t, 43*time.Second-500*time.Nanosecond, firstAggregationInterval(now, time.Minute, true))
+	assert.Equal(t, time.Minute, firstAggregationInterval(now.Truncate(time.Minute), time.Minute, true))
+}
@@ -17,6 +17,8 @@ package transport // import "github.com/open-telemetry/opentelemetry-collector-c
 import (
 	"context"
 	"errors"
+	"fmt"
+	"os"
 
 	"go.opentelemetry.io/collector/consumer"
 
@@ -27,6 +29,23 @@ var (
 	errNilListenAndServeParameters = errors.New("no parameter of ListenAndServe can be nil")
 )
 
+// removeStaleSocket removes the Unix domain socket file left at path by a previous
+// run that didn't shut down cleanly, so that the socket can be bound again. Files
+// that are not sockets are left untouched.
+func removeStaleSocket(path string) error {
+	fi, err := os.Lstat(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if fi.Mode()&os.ModeSocket == 0 {
+		return fmt.Errorf("%q exists and is not a socket", path)
+	}
+	return os.Remove(path)
+}
+
 // Server abstracts the type of transport being used and offer an
 // interface to handle serving clients over that transport.
 type Server interface {
@@ -0,0 +1,155 @@
+// Copyright 2020, OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package transport // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/statsdreceiver/transport"
+
+import (
+	"bufio"
+	"net"
+	"strings"
+	"sync"
+
+	"go.opentelemetry.io/collector/consumer"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/statsdreceiver/protocol"
+)
+
+// maxLineLength is the maximum length of a StatsD line received over a stream.
+const maxLineLength = 65527
+
+type streamServer struct {
+	listener net.Listener
+	network  string
+	reporter Reporter
+
+	mu     sync.Mutex
+	conns  map[net.Conn]struct{}
+	closed bool
+	wg     sync.WaitGroup
+}
+
+var _ (Server) = (*streamServer)(nil)
+
+// NewTCPServer creates a transport.Server using TCP as its transport.
+func NewTCPServer(addr string) (Server, error) {
+	return newStreamServer("tcp", addr)
+}
+
+// NewUnixServer creates a transport.Server using a Unix domain stream
+// socket as its transport.
+func NewUnixServer(path string) (Server, error) {
+	return newStreamServer("unix", path)
+}
+
+func newStreamServer(network, addr string) (Server, error) {
+	// The listener removes the socket file when closed, but not when the collector is killed.
+	if network == "unix" {
+		if err := removeStaleSocket(addr); err != nil {
+			return nil, err
+		}
+	}
+	listener, err := net.Listen(network, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	s := streamServer{
+		listener: listener,
+		network:  network,
+		conns:    map[net.Conn]struct{}{},
+	}
+	return &s, nil
+}
+
+func (s *streamServer) ListenAndServe(
+	parser protocol.Parser,
+	nextConsumer consumer.Metrics,
+	reporter Reporter,
+	transferChan chan<- string,
+) error {
+	if parser == nil || nextConsumer == nil || reporter == nil {
+		return errNilListenAndServeParameters
+	}
+
+	s.reporter = reporter
+
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			s.reporter.OnDebugf("%s Transport (%s) - Accept error: %v",
+				strings.ToUpper(s.network),
+				s.listener.Addr(),
+				err)
+			return err
+		}
+
+		// The connection is tracked under the lock, so that Close either closes it or
+		// waits for it: the WaitGroup is never incremented once Close is waiting on it.
+		s.mu.Lock()
+		if s.closed {
+			s.mu.Unlock()
+			_ = conn.Close()
+			return net.ErrClosed
+		}
+		s.conns[conn] = struct{}{}
+		s.wg.Add(1)
+		s.mu.Unlock()
+
+		go s.handleConn(conn, transferChan)
+	}
+}
+
+// Close stops accepting new connections and closes the open ones, waiting
+// for the lines already read to be passed on.
+func (s *streamServer) Close() error {
+	err := s.listener.Close()
+
+	s.mu.Lock()
+	s.closed = true
+	for conn := range s.conns {
+		_ = conn.Close()
+	}
+	s.mu.Unlock()
+
+	s.wg.Wait()
+	return err
+}
+
+func (s *streamServer) handleConn(
+	conn net.Conn,
+	transferChan chan<- string,
+) {
+	defer func() {
+		s.mu.Lock()
+		delete(s.conns, conn)
+		s.mu.Unlock()
+		_ = conn.Close()
+		s.wg.Done()
+	}()
+
+	scanner := bufio.NewScanner(conn)
+	scanner.Buffer(make([]byte, 0, 4096), maxLineLength)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line != "" {
+			transferChan <- line
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		s.reporter.OnDebugf("%s Transport (%s) - Read error: %v",
+			strings.ToUpper(s.network),
+			conn.RemoteAddr(),
+			err)
+	}
+}
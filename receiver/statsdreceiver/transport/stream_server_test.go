@@ -0,0 +1,144 @@
+// Copyright 2020, OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package transport
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/common/testutil"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/statsdreceiver/protocol"
+)
+
+func Test_StreamServer_ListenAndServe(t *testing.T) {
+	tests := []struct {
+		name          string
+		network       string
+		addr          func(t *testing.T) string
+		buildServerFn func(addr string) (Server, error)
+	}{
+		{
+			name:    "tcp",
+			network: "tcp",
+			addr: func(t *testing.T) string {
+				return testutil.GetAvailableLocalNetworkAddress(t, "tcp")
+			},
+			buildServerFn: NewTCPServer,
+		},
+		{
+			name:    "unix",
+			network: "unix",
+			addr: func(t *testing.T) string {
+				return filepath.Join(t.TempDir(), "statsd.sock")
+			},
+			buildServerFn: NewUnixServer,
+		},
+		{
+			name:    "unixgram",
+			network: "unixgram",
+			addr: func(t *testing.T) string {
+				return filepath.Join(t.TempDir(), "statsd.sock")
+			},
+			buildServerFn: NewUnixgramServer,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			addr := tt.addr(t)
+			srv, err := tt.buildServerFn(addr)
+			require.NoError(t, err)
+			require.NotNil(t, srv)
+
+			mc := new(consumertest.MetricsSink)
+			p := &protocol.StatsDParser{}
+			mr := NewMockReporter(1)
+			var transferChan = make(chan string, 10)
+
+			wgListenAndServe := sync.WaitGroup{}
+			wgListenAndServe.Add(1)
+			go func() {
+				defer wgListenAndServe.Done()
+				assert.Error(t, srv.ListenAndServe(p, mc, mr, transferChan))
+			}()
+
+			conn, err := net.Dial(tt.network, addr)
+			require.NoError(t, err)
+			_, err = conn.Write([]byte("test.metric:42|c\ntest.metric2:1|g\n"))
+			require.NoError(t, err)
+
+			assert.Eventually(t, func() bool {
+				return len(transferChan) == 2
+			}, 10*time.Second, 100*time.Millisecond)
+
+			// Close the server while the client connection is still open.
+			assert.NoError(t, srv.Close())
+			wgListenAndServe.Wait()
+			assert.NoError(t, conn.Close())
+
+			assert.Equal(t, "test.metric:42|c", <-transferChan)
+			assert.Equal(t, "test.metric2:1|g", <-transferChan)
+		})
+	}
+}
+
+func Test_UnixServer_StaleSocket(t *testing.T) {
+	tests := []struct {
+		name          string
+		buildServerFn func(addr string) (Server, error)
+	}{
+		{
+			name:          "unix",
+			buildServerFn: NewUnixServer,
+		},
+		{
+			name:          "unixgram",
+			buildServerFn: NewUnixgramServer,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			addr := filepath.Join(t.TempDir(), "statsd.sock")
+			// A closed datagram socket leaves its file behind, as a killed collector would.
+			stale, err := net.ListenPacket("unixgram", addr)
+			require.NoError(t, err)
+			require.NoError(t, stale.Close())
+			require.FileExists(t, addr)
+
+			srv, err := tt.buildServerFn(addr)
+			require.NoError(t, err)
+			require.NoError(t, srv.Close())
+			assert.NoFileExists(t, addr)
+		})
+	}
+}
+
+func Test_UnixServer_NotASocket(t *testing.T) {
+	addr := filepath.Join(t.TempDir(), "statsd.sock")
+	require.NoError(t, os.WriteFile(addr, []byte("data"), 0600))
+
+	_, err := NewUnixServer(addr)
+	assert.Error(t, err)
+	_, err = NewUnixgramServer(addr)
+	assert.Error(t, err)
+	assert.FileExists(t, addr)
+}
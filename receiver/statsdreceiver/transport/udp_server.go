@@ -19,6 +19,7 @@ import (
 	"errors"
 	"io"
 	"net"
+	"os"
 	"strings"
 
 	"go.opentelemetry.io/collector/consumer"
@@ -28,6 +29,8 @@ import (
 
 type udpServer struct {
 	packetConn net.PacketConn
+	network    string
+	addr       string
 	reporter   Reporter
 }
 
@@ -35,13 +38,30 @@ var _ (Server) = (*udpServer)(nil)
 
 // NewUDPServer creates a transport.Server using UDP as its transport.
 func NewUDPServer(addr string) (Server, error) {
-	packetConn, err := net.ListenPacket("udp", addr)
+	return newPacketServer("udp", addr)
+}
+
+// NewUnixgramServer creates a transport.Server using a Unix domain datagram
+// socket as its transport.
+func NewUnixgramServer(path string) (Server, error) {
+	return newPacketServer("unixgram", path)
+}
+
+func newPacketServer(network, addr string) (Server, error) {
+	if network == "unixgram" {
+		if err := removeStaleSocket(addr); err != nil {
+			return nil, err
+		}
+	}
+	packetConn, err := net.ListenPacket(network, addr)
 	if err != nil {
 		return nil, err
 	}
 
 	u := udpServer{
 		packetConn: packetConn,
+		network:    network,
+		addr:       addr,
 	}
 	return &u, nil
 }
@@ -67,7 +87,8 @@ func (u *udpServer) ListenAndServe(
 			u.handlePacket(bufCopy, transferChan)
 		}
 		if err != nil {
-			u.reporter.OnDebugf("UDP Transport (%s) - ReadFrom error: %v",
+			u.reporter.OnDebugf("%s Transport (%s) - ReadFrom error: %v",
+				strings.ToUpper(u.network),
 				u.packetConn.LocalAddr(),
 				err)
 			var netErr net.Error
@@ -82,7 +103,14 @@ func (u *udpServer) ListenAndServe(
 }
 
 func (u *udpServer) Close() error {
-	return u.packetConn.Close()
+	err := u.packetConn.Close()
+	// Unlike the Unix stream listeners, the datagram sockets don't remove their file when closed.
+	if u.network == "unixgram" {
+		if rmErr := os.Remove(u.addr); rmErr != nil && !errors.Is(rmErr, os.ErrNotExist) && err == nil {
+			err = rmErr
+		}
+	}
+	return err
 }
 
 func (u *udpServer) handlePacket(
@@ -0,0 +1,58 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package systemdreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/systemdreceiver"
+
+import (
+	"errors"
+	"fmt"
+	"path"
+
+	"go.opentelemetry.io/collector/receiver/scraperhelper"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/systemdreceiver/internal/metadata"
+)
+
+// Config defines the configuration of the systemd receiver.
+type Config struct {
+	scraperhelper.ScraperControllerSettings `mapstructure:",squash"`
+	// Units is the list of glob patterns matching the names of the units to monitor,
+	// e.g. "nginx.service" or "*.socket". It's required, since monitoring all the units of a host
+	// produces many series.
+	Units   []string                 `mapstructure:"units"`
+	Metrics metadata.MetricsSettings `mapstructure:"metrics"`
+}
+
+// Validate checks that units are given and that their patterns are well-formed.
+func (cfg *Config) Validate() error {
+	if len(cfg.Units) == 0 {
+		return errors.New("units must list the patterns of the units to monitor")
+	}
+	for _, pattern := range cfg.Units {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid unit pattern %q: %w", pattern, err)
+		}
+	}
+	return nil
+}
+
+// matchUnit returns true if the unit must be monitored.
+func (cfg *Config) matchUnit(name string) bool {
+	for _, pattern := range cfg.Units {
+		if ok, _ := path.Match(pattern, name); ok {
+			return true
+		}
+	}
+	return false
+}
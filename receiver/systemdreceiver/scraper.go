@@ -0,0 +1,165 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package systemdreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/systemdreceiver"
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/coreos/go-systemd/v22/dbus"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/systemdreceiver/internal/metadata"
+)
+
+// unitStates are the active states of a unit, in the order in which they are recorded.
+var unitStates = []metadata.AttributeState{
+	metadata.AttributeStateActive,
+	metadata.AttributeStateReloading,
+	metadata.AttributeStateInactive,
+	metadata.AttributeStateFailed,
+	metadata.AttributeStateActivating,
+	metadata.AttributeStateDeactivating,
+}
+
+// systemdClient is the subset of the systemd DBus API used by the scraper.
+type systemdClient interface {
+	ListUnitsContext(ctx context.Context) ([]dbus.UnitStatus, error)
+	GetUnitTypePropertyContext(ctx context.Context, unit string, unitType string, propertyName string) (*dbus.Property, error)
+	Close()
+}
+
+type clientFactory func(ctx context.Context) (systemdClient, error)
+
+func newClient(ctx context.Context) (systemdClient, error) {
+	conn, err := dbus.NewWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return conn, nil
+}
+
+type systemdScraper struct {
+	client    systemdClient
+	newClient clientFactory
+
+	logger *zap.Logger
+	cfg    *Config
+	mb     *metadata.MetricsBuilder
+}
+
+func newSystemdScraper(
+	settings receiver.CreateSettings,
+	cfg *Config,
+	newClient clientFactory,
+) *systemdScraper {
+	return &systemdScraper{
+		newClient: newClient,
+		logger:    settings.Logger,
+		cfg:       cfg,
+		mb:        metadata.NewMetricsBuilder(cfg.Metrics, settings),
+	}
+}
+
+func (s *systemdScraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
+	// Init client in scrape method in case there are transient errors in the constructor.
+	if s.client == nil {
+		client, err := s.newClient(ctx)
+		if err != nil {
+			return pmetric.Metrics{}, fmt.Errorf("failed to connect to systemd: %w", err)
+		}
+		s.client = client
+	}
+
+	units, err := s.client.ListUnitsContext(ctx)
+	if err != nil {
+		// The connection is reopened on the next scrape, e.g. after systemd was re-executed.
+		s.client.Close()
+		s.client = nil
+		return pmetric.Metrics{}, fmt.Errorf("failed to list the systemd units: %w", err)
+	}
+
+	now := pcommon.NewTimestampFromTime(time.Now())
+	var errs scrapererror.ScrapeErrors
+	for _, unit := range units {
+		if unit.LoadState == "not-found" || !s.cfg.matchUnit(unit.Name) {
+			continue
+		}
+		s.recordUnit(ctx, now, unit, &errs)
+	}
+
+	return s.mb.Emit(), errs.Combine()
+}
+
+func (s *systemdScraper) recordUnit(ctx context.Context, now pcommon.Timestamp, unit dbus.UnitStatus, errs *scrapererror.ScrapeErrors) {
+	for _, state := range unitStates {
+		var value int64
+		if unit.ActiveState == state.String() {
+			value = 1
+		}
+		s.mb.RecordSystemdUnitStateDataPoint(now, value, unit.Name, state)
+	}
+
+	switch {
+	case strings.HasSuffix(unit.Name, ".service"):
+		if restarts, err := s.unitProperty(ctx, unit.Name, "Service", "NRestarts"); err != nil {
+			errs.AddPartial(1, err)
+		} else {
+			s.mb.RecordSystemdUnitRestartsDataPoint(now, restarts, unit.Name)
+		}
+	case strings.HasSuffix(unit.Name, ".socket"):
+		if connections, err := s.unitProperty(ctx, unit.Name, "Socket", "NConnections"); err != nil {
+			errs.AddPartial(1, err)
+		} else {
+			s.mb.RecordSystemdSocketConnectionsDataPoint(now, connections, unit.Name)
+		}
+		if accepted, err := s.unitProperty(ctx, unit.Name, "Socket", "NAccepted"); err != nil {
+			errs.AddPartial(1, err)
+		} else {
+			s.mb.RecordSystemdSocketConnectionsAcceptedDataPoint(now, accepted, unit.Name)
+		}
+	}
+}
+
+// unitProperty returns the value of a numeric property of the unit.
+func (s *systemdScraper) unitProperty(ctx context.Context, unit string, unitType string, propertyName string) (int64, error) {
+	prop, err := s.client.GetUnitTypePropertyContext(ctx, unit, unitType, propertyName)
+	if err != nil {
+		s.logger.Debug("Failed to get the unit property", zap.String("unit", unit), zap.String("property", propertyName), zap.Error(err))
+		return 0, fmt.Errorf("failed to get the %s property of %s: %w", propertyName, unit, err)
+	}
+	switch value := prop.Value.Value().(type) {
+	case uint32:
+		return int64(value), nil
+	case uint64:
+		return int64(value), nil
+	default:
+		return 0, fmt.Errorf("unexpected type %T of the %s property of %s", value, propertyName, unit)
+	}
+}
+
+func (s *systemdScraper) shutdown(context.Context) error {
+	if s.client != nil {
+		s.client.Close()
+		s.client = nil
+	}
+	return nil
+}
@@ -0,0 +1,166 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package systemdreceiver
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/coreos/go-systemd/v22/dbus"
+	godbus "github.com/godbus/dbus/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/comparetest"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/comparetest/golden"
+)
+
+type fakeClient struct {
+	units      []dbus.UnitStatus
+	properties map[string]interface{}
+	listErr    error
+	closed     bool
+}
+
+func newFakeClient() *fakeClient {
+	return &fakeClient{
+		units: []dbus.UnitStatus{
+			{Name: "backup.service", LoadState: "loaded", ActiveState: "failed", SubState: "failed"},
+			{Name: "missing.service", LoadState: "not-found", ActiveState: "inactive", SubState: "dead"},
+			{Name: "nginx.service", LoadState: "loaded", ActiveState: "active", SubState: "running"},
+			{Name: "sshd.socket", LoadState: "loaded", ActiveState: "active", SubState: "listening"},
+		},
+		properties: map[string]interface{}{
+			"backup.service/NRestarts": uint32(0),
+			"nginx.service/NRestarts":  uint32(2),
+			"sshd.socket/NConnections": uint32(3),
+			"sshd.socket/NAccepted":    uint32(42),
+		},
+	}
+}
+
+func (c *fakeClient) ListUnitsContext(context.Context) ([]dbus.UnitStatus, error) {
+	return c.units, c.listErr
+}
+
+func (c *fakeClient) GetUnitTypePropertyContext(_ context.Context, unit string, _ string, propertyName string) (*dbus.Property, error) {
+	value, ok := c.properties[unit+"/"+propertyName]
+	if !ok {
+		return nil, errors.New("unknown property")
+	}
+	return &dbus.Property{Name: propertyName, Value: godbus.MakeVariant(value)}, nil
+}
+
+func (c *fakeClient) Close() {
+	c.closed = true
+}
+
+func newTestScraper(cfg *Config, client systemdClient) *systemdScraper {
+	return newSystemdScraper(receivertest.NewNopCreateSettings(), cfg, func(context.Context) (systemdClient, error) {
+		return client, nil
+	})
+}
+
+// newTestConfig returns a config monitoring all the units of the fake client.
+func newTestConfig() *Config {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Units = []string{"*"}
+	return cfg
+}
+
+func TestScraper(t *testing.T) {
+	cfg := newTestConfig()
+	require.NoError(t, component.ValidateConfig(cfg))
+
+	scraper := newTestScraper(cfg, newFakeClient())
+
+	actualMetrics, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+
+	expectedFile := filepath.Join("testdata", "scraper", "expected.yaml")
+	expectedMetrics, err := golden.ReadMetrics(expectedFile)
+	require.NoError(t, err)
+
+	golden.NormalizeMetrics(actualMetrics)
+	require.NoError(t, comparetest.CompareMetrics(expectedMetrics, actualMetrics))
+}
+
+func TestScraperUnitPatterns(t *testing.T) {
+	cfg := newTestConfig()
+	cfg.Units = []string{"*.socket"}
+	require.NoError(t, component.ValidateConfig(cfg))
+
+	scraper := newTestScraper(cfg, newFakeClient())
+
+	actualMetrics, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+
+	metrics := actualMetrics.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	for i := 0; i < metrics.Len(); i++ {
+		dps := metrics.At(i).Sum().DataPoints()
+		for j := 0; j < dps.Len(); j++ {
+			unit, ok := dps.At(j).Attributes().Get("unit")
+			require.True(t, ok)
+			assert.Equal(t, "sshd.socket", unit.Str())
+		}
+	}
+}
+
+func TestScraperPropertyError(t *testing.T) {
+	client := newFakeClient()
+	delete(client.properties, "nginx.service/NRestarts")
+	scraper := newTestScraper(newTestConfig(), client)
+
+	actualMetrics, err := scraper.scrape(context.Background())
+	require.Error(t, err)
+	assert.True(t, scrapererror.IsPartialScrapeError(err))
+	assert.Greater(t, actualMetrics.MetricCount(), 0)
+}
+
+func TestScraperListError(t *testing.T) {
+	client := newFakeClient()
+	client.listErr = errors.New("connection closed")
+	scraper := newTestScraper(newTestConfig(), client)
+
+	_, err := scraper.scrape(context.Background())
+	require.EqualError(t, err, "failed to list the systemd units: connection closed")
+	assert.True(t, client.closed)
+	assert.Nil(t, scraper.client)
+}
+
+func TestScraperConnectError(t *testing.T) {
+	scraper := newSystemdScraper(receivertest.NewNopCreateSettings(), newTestConfig(), func(context.Context) (systemdClient, error) {
+		return nil, errors.New("no such file or directory")
+	})
+
+	_, err := scraper.scrape(context.Background())
+	require.EqualError(t, err, "failed to connect to systemd: no such file or directory")
+	require.NoError(t, scraper.shutdown(context.Background()))
+}
+
+func TestScraperShutdown(t *testing.T) {
+	client := newFakeClient()
+	scraper := newTestScraper(newTestConfig(), client)
+
+	_, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+	require.NoError(t, scraper.shutdown(context.Background()))
+	assert.True(t, client.closed)
+}
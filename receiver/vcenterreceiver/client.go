@@ -35,6 +35,7 @@ type vcenterClient struct {
 	finder    *find.Finder
 	pc        *property.Collector
 	pm        *performance.Manager
+	inventory *inventory
 	cfg       *Config
 }
 
@@ -75,6 +76,8 @@ func (vc *vcenterClient) EnsureConnection(ctx context.Context) error {
 	}
 	vc.moClient = client
 	vc.vimDriver = client.Client
+	// the update session of the inventory belonged to the previous session
+	vc.inventory = nil
 	vc.pc = property.DefaultCollector(vc.vimDriver)
 	vc.finder = find.NewFinder(vc.vimDriver)
 	vc.pm = performance.NewManager(vc.vimDriver)
@@ -83,6 +86,10 @@ func (vc *vcenterClient) EnsureConnection(ctx context.Context) error {
 
 // Disconnect will logout of the autenticated session
 func (vc *vcenterClient) Disconnect(ctx context.Context) error {
+	if vc.inventory != nil {
+		vc.inventory.destroy(ctx)
+		vc.inventory = nil
+	}
 	if vc.moClient != nil {
 		return vc.moClient.Logout(ctx)
 	}
@@ -125,6 +132,24 @@ func (vc *vcenterClient) VMs(ctx context.Context) ([]*object.VirtualMachine, err
 	return vms, err
 }
 
+// Inventory returns the managed objects to scrape, updated with the changes that happened since the previous call
+func (vc *vcenterClient) Inventory(ctx context.Context) (*inventory, error) {
+	if vc.inventory == nil {
+		inv, err := newInventory(ctx, vc.vimDriver)
+		if err != nil {
+			return nil, fmt.Errorf("unable to create inventory update session: %w", err)
+		}
+		vc.inventory = inv
+	}
+	if err := vc.inventory.update(ctx); err != nil {
+		// start over with a new session on the next scrape
+		vc.inventory.destroy(ctx)
+		vc.inventory = nil
+		return nil, fmt.Errorf("unable to retrieve inventory updates: %w", err)
+	}
+	return vc.inventory, nil
+}
+
 type perfSampleResult struct {
 	counters map[string]*vt.PerfCounterInfo
 	results  []performance.EntityMetric
@@ -22,9 +22,11 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/vmware/govmomi"
 	"github.com/vmware/govmomi/find"
+	"github.com/vmware/govmomi/object"
 	"github.com/vmware/govmomi/session"
 	"github.com/vmware/govmomi/simulator"
 	"github.com/vmware/govmomi/vim25"
+	vt "github.com/vmware/govmomi/vim25/types"
 	"go.opentelemetry.io/collector/config/configtls"
 )
 
@@ -69,6 +71,46 @@ func TestGetVMs(t *testing.T) {
 	})
 }
 
+func TestInventory(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		client := vcenterClient{
+			vimDriver: c,
+		}
+		inv, err := client.Inventory(ctx)
+		require.NoError(t, err)
+
+		clusters := inv.clusters()
+		require.NotEmpty(t, clusters)
+		require.NotEmpty(t, inv.datastores(clusters[0].Datastore))
+		require.NotEmpty(t, inv.resourcePools())
+		hosts := inv.hosts(clusters[0].Reference())
+		require.NotEmpty(t, hosts)
+		vms := inv.vms(hosts[0].Reference())
+		require.NotEmpty(t, vms)
+		require.NotEmpty(t, vms[0].Name)
+		require.NotNil(t, vms[0].Config)
+		require.Equal(t, vt.VirtualMachinePowerStatePoweredOn, vms[0].Runtime.PowerState)
+
+		vm := object.NewVirtualMachine(c, vms[0].Reference())
+		task, err := vm.PowerOff(ctx)
+		require.NoError(t, err)
+		require.NoError(t, task.Wait(ctx))
+
+		_, err = client.Inventory(ctx)
+		require.NoError(t, err)
+		require.Equal(t, vt.VirtualMachinePowerStatePoweredOff, vms[0].Runtime.PowerState)
+
+		task, err = vm.Destroy(ctx)
+		require.NoError(t, err)
+		require.NoError(t, task.Wait(ctx))
+
+		inv, err = client.Inventory(ctx)
+		require.NoError(t, err)
+		require.NotContains(t, inv.objects, vm.Reference())
+		require.NoError(t, client.Disconnect(ctx))
+	})
+}
+
 func TestSessionReestablish(t *testing.T) {
 	simulator.Test(func(ctx context.Context, c *vim25.Client) {
 		sm := session.NewManager(c)
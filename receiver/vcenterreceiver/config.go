@@ -34,6 +34,9 @@ type Config struct {
 	Endpoint                                string                   `mapstructure:"endpoint"`
 	Username                                string                   `mapstructure:"username"`
 	Password                                string                   `mapstructure:"password"`
+	// IncrementalUpdates keeps the inventory up to date with the changes reported by a PropertyCollector
+	// update session, instead of retrieving all the managed objects on every scrape.
+	IncrementalUpdates bool `mapstructure:"incremental_updates"`
 }
 
 // Validate checks to see if the supplied config will work for the receiver
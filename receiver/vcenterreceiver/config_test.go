@@ -111,6 +111,7 @@ func TestLoadConfig(t *testing.T) {
 	expected.Metrics = metadata.DefaultMetricsSettings()
 	expected.Metrics.VcenterHostCPUUtilization.Enabled = false
 	expected.CollectionInterval = 5 * time.Minute
+	expected.IncrementalUpdates = true
 
 	if diff := cmp.Diff(expected, cfg, cmpopts.IgnoreUnexported(metadata.MetricSettings{})); diff != "" {
 		t.Errorf("Config mismatch (-expected +actual):\n%s", diff)
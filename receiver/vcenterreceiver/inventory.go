@@ -0,0 +1,237 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vcenterreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/vcenterreceiver"
+
+import (
+	"context"
+	"sort"
+
+	"github.com/vmware/govmomi/property"
+	"github.com/vmware/govmomi/view"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/methods"
+	"github.com/vmware/govmomi/vim25/mo"
+	vt "github.com/vmware/govmomi/vim25/types"
+)
+
+// inventoryProperties are the properties retrieved for each kind of managed object
+var inventoryProperties = map[string][]string{
+	"ClusterComputeResource": {"name", "summary", "datastore"},
+	"HostSystem":             {"name", "parent", "summary.hardware", "summary.quickStats"},
+	"VirtualMachine":         {"name", "config.instanceUuid", "runtime", "summary"},
+	"Datastore":              {"name", "summary"},
+	"ResourcePool":           {"name", "summary"},
+}
+
+// inventory is a cache of the managed objects scraped by the receiver. Instead of retrieving
+// all of them on every scrape, it is kept up to date with the changes reported by a dedicated
+// PropertyCollector, so that only the objects that changed are sent by vCenter.
+type inventory struct {
+	client  *vim25.Client
+	view    *view.ContainerView
+	pc      *property.Collector
+	version string
+	objects map[vt.ManagedObjectReference]mo.Reference
+}
+
+// newInventory creates the container view and the property filter of the update session
+func newInventory(ctx context.Context, client *vim25.Client) (*inventory, error) {
+	kinds := make([]string, 0, len(inventoryProperties))
+	propSet := make([]vt.PropertySpec, 0, len(inventoryProperties))
+	for kind, props := range inventoryProperties {
+		kinds = append(kinds, kind)
+		propSet = append(propSet, vt.PropertySpec{Type: kind, PathSet: props})
+	}
+
+	v, err := view.NewManager(client).CreateContainerView(ctx, client.ServiceContent.RootFolder, kinds, true)
+	if err != nil {
+		return nil, err
+	}
+	inv := &inventory{
+		client:  client,
+		view:    v,
+		objects: map[vt.ManagedObjectReference]mo.Reference{},
+	}
+	inv.pc, err = property.DefaultCollector(client).Create(ctx)
+	if err != nil {
+		inv.destroy(ctx)
+		return nil, err
+	}
+
+	req := vt.CreateFilter{
+		This: inv.pc.Reference(),
+		Spec: vt.PropertyFilterSpec{
+			ObjectSet: []vt.ObjectSpec{{
+				Obj:  v.Reference(),
+				Skip: vt.NewBool(true),
+				SelectSet: []vt.BaseSelectionSpec{
+					&vt.TraversalSpec{Type: "ContainerView", Path: "view"},
+				},
+			}},
+			PropSet: propSet,
+		},
+	}
+	if _, err = methods.CreateFilter(ctx, client, &req); err != nil {
+		inv.destroy(ctx)
+		return nil, err
+	}
+	return inv, nil
+}
+
+// update applies the changes reported since the previous update, the first one returning all the objects
+func (inv *inventory) update(ctx context.Context) error {
+	req := vt.WaitForUpdatesEx{
+		This:    inv.pc.Reference(),
+		Version: inv.version,
+		// don't block if nothing changed since the previous update
+		Options: &vt.WaitOptions{MaxWaitSeconds: vt.NewInt32(0)},
+	}
+	for {
+		res, err := methods.WaitForUpdatesEx(ctx, inv.client, &req)
+		if err != nil {
+			return err
+		}
+		set := res.Returnval
+		if set == nil {
+			return nil
+		}
+		for _, fs := range set.FilterSet {
+			for _, update := range fs.ObjectSet {
+				inv.apply(update)
+			}
+		}
+		inv.version = set.Version
+		req.Version = set.Version
+		if set.Truncated == nil || !*set.Truncated {
+			return nil
+		}
+	}
+}
+
+func (inv *inventory) apply(update vt.ObjectUpdate) {
+	switch update.Kind {
+	case vt.ObjectUpdateKindEnter, vt.ObjectUpdateKindModify:
+		obj, ok := inv.objects[update.Obj]
+		if !ok {
+			obj = newManagedObject(update.Obj)
+			if obj == nil {
+				return
+			}
+			inv.objects[update.Obj] = obj
+		}
+		mo.ApplyPropertyChange(obj, update.ChangeSet)
+	case vt.ObjectUpdateKindLeave:
+		delete(inv.objects, update.Obj)
+	}
+}
+
+func newManagedObject(ref vt.ManagedObjectReference) mo.Reference {
+	switch ref.Type {
+	case "ClusterComputeResource":
+		obj := &mo.ClusterComputeResource{}
+		obj.Self = ref
+		return obj
+	case "HostSystem":
+		obj := &mo.HostSystem{}
+		obj.Self = ref
+		return obj
+	case "VirtualMachine":
+		obj := &mo.VirtualMachine{}
+		obj.Self = ref
+		return obj
+	case "Datastore":
+		obj := &mo.Datastore{}
+		obj.Self = ref
+		return obj
+	case "ResourcePool":
+		obj := &mo.ResourcePool{}
+		obj.Self = ref
+		return obj
+	}
+	return nil
+}
+
+// destroy releases the property collector and the container view of the update session
+func (inv *inventory) destroy(ctx context.Context) {
+	if inv.pc != nil {
+		_ = inv.pc.Destroy(ctx)
+	}
+	_ = inv.view.Destroy(ctx)
+}
+
+// references returns the references of the cached objects of the given kind, sorted to scrape them in a stable order
+func (inv *inventory) references(kind string) []vt.ManagedObjectReference {
+	var refs []vt.ManagedObjectReference
+	for ref := range inv.objects {
+		if ref.Type == kind {
+			refs = append(refs, ref)
+		}
+	}
+	sort.Slice(refs, func(i, j int) bool {
+		return refs[i].Value < refs[j].Value
+	})
+	return refs
+}
+
+func (inv *inventory) clusters() []*mo.ClusterComputeResource {
+	var clusters []*mo.ClusterComputeResource
+	for _, ref := range inv.references("ClusterComputeResource") {
+		clusters = append(clusters, inv.objects[ref].(*mo.ClusterComputeResource))
+	}
+	return clusters
+}
+
+// hosts returns the hosts that are members of the cluster
+func (inv *inventory) hosts(cluster vt.ManagedObjectReference) []*mo.HostSystem {
+	var hosts []*mo.HostSystem
+	for _, ref := range inv.references("HostSystem") {
+		host := inv.objects[ref].(*mo.HostSystem)
+		if host.Parent != nil && *host.Parent == cluster {
+			hosts = append(hosts, host)
+		}
+	}
+	return hosts
+}
+
+// datastores returns the cached datastores among the given references
+func (inv *inventory) datastores(refs []vt.ManagedObjectReference) []*mo.Datastore {
+	var datastores []*mo.Datastore
+	for _, ref := range refs {
+		if ds, ok := inv.objects[ref].(*mo.Datastore); ok {
+			datastores = append(datastores, ds)
+		}
+	}
+	return datastores
+}
+
+// vms returns the virtual machines running on the given host
+func (inv *inventory) vms(host vt.ManagedObjectReference) []*mo.VirtualMachine {
+	var vms []*mo.VirtualMachine
+	for _, ref := range inv.references("VirtualMachine") {
+		vm := inv.objects[ref].(*mo.VirtualMachine)
+		if vm.Runtime.Host != nil && *vm.Runtime.Host == host {
+			vms = append(vms, vm)
+		}
+	}
+	return vms
+}
+
+func (inv *inventory) resourcePools() []*mo.ResourcePool {
+	var rps []*mo.ResourcePool
+	for _, ref := range inv.references("ResourcePool") {
+		rps = append(rps, inv.objects[ref].(*mo.ResourcePool))
+	}
+	return rps
+}
@@ -21,6 +21,7 @@ import (
 
 	"github.com/vmware/govmomi/object"
 	"github.com/vmware/govmomi/vim25/mo"
+	vt "github.com/vmware/govmomi/vim25/types"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/pmetric"
@@ -77,10 +78,56 @@ func (v *vcenterMetricScraper) scrape(ctx context.Context) (pmetric.Metrics, err
 		return pmetric.NewMetrics(), fmt.Errorf("unable to connect to vSphere SDK: %w", err)
 	}
 
+	if v.config.IncrementalUpdates {
+		err := v.collectInventory(ctx)
+		return v.mb.Emit(), err
+	}
+
 	err := v.collectDatacenters(ctx)
 	return v.mb.Emit(), err
 }
 
+// collectInventory scrapes the managed objects cached by the inventory update session of the client
+func (v *vcenterMetricScraper) collectInventory(ctx context.Context) error {
+	inv, err := v.client.Inventory(ctx)
+	if err != nil {
+		return err
+	}
+	errs := &scrapererror.ScrapeErrors{}
+	now := pcommon.NewTimestampFromTime(time.Now())
+
+	for _, c := range inv.clusters() {
+		var poweredOnVMs, poweredOffVMs int64
+		for _, host := range inv.hosts(c.Reference()) {
+			v.emitHost(ctx, now, *host, host.Name, c.Name, errs)
+			for _, vm := range inv.vms(host.Reference()) {
+				if vm.Runtime.PowerState == vt.VirtualMachinePowerStatePoweredOff {
+					poweredOffVMs++
+				} else {
+					poweredOnVMs++
+				}
+				if vm.Config == nil {
+					errs.AddPartial(1, fmt.Errorf("vm config empty for %s", host.Name))
+					continue
+				}
+				v.emitVM(ctx, now, *vm, vm.Name, host.Name, c.Name, errs)
+			}
+		}
+		for _, ds := range inv.datastores(c.Datastore) {
+			v.emitDatastore(now, *ds, c.Name)
+		}
+		var summary *vt.ComputeResourceSummary
+		if c.Summary != nil {
+			summary = c.Summary.GetComputeResourceSummary()
+		}
+		v.emitCluster(now, summary, poweredOnVMs, poweredOffVMs, c.Name)
+	}
+	for _, rp := range inv.resourcePools() {
+		v.emitResourcePool(now, *rp, rp.Name)
+	}
+	return errs.Combine()
+}
+
 func (v *vcenterMetricScraper) collectDatacenters(ctx context.Context) error {
 	datacenters, err := v.client.Datacenters(ctx)
 	if err != nil {
@@ -117,16 +164,29 @@ func (v *vcenterMetricScraper) collectCluster(
 	poweredOnVMs, poweredOffVMs int64,
 	errs *scrapererror.ScrapeErrors,
 ) {
-	v.mb.RecordVcenterClusterVMCountDataPoint(now, poweredOnVMs, metadata.AttributeVMCountPowerStateOn)
-	v.mb.RecordVcenterClusterVMCountDataPoint(now, poweredOffVMs, metadata.AttributeVMCountPowerStateOff)
-
 	var moCluster mo.ClusterComputeResource
 	err := c.Properties(ctx, c.Reference(), []string{"summary"}, &moCluster)
 	if err != nil {
+		v.mb.RecordVcenterClusterVMCountDataPoint(now, poweredOnVMs, metadata.AttributeVMCountPowerStateOn)
+		v.mb.RecordVcenterClusterVMCountDataPoint(now, poweredOffVMs, metadata.AttributeVMCountPowerStateOff)
 		errs.AddPartial(1, err)
 		return
 	}
-	s := moCluster.Summary.GetComputeResourceSummary()
+	v.emitCluster(now, moCluster.Summary.GetComputeResourceSummary(), poweredOnVMs, poweredOffVMs, c.Name())
+}
+
+func (v *vcenterMetricScraper) emitCluster(
+	now pcommon.Timestamp,
+	s *vt.ComputeResourceSummary,
+	poweredOnVMs, poweredOffVMs int64,
+	clusterName string,
+) {
+	v.mb.RecordVcenterClusterVMCountDataPoint(now, poweredOnVMs, metadata.AttributeVMCountPowerStateOn)
+	v.mb.RecordVcenterClusterVMCountDataPoint(now, poweredOffVMs, metadata.AttributeVMCountPowerStateOff)
+	if s == nil {
+		v.mb.EmitForResource(metadata.WithVcenterClusterName(clusterName))
+		return
+	}
 	v.mb.RecordVcenterClusterCPULimitDataPoint(now, int64(s.TotalCpu))
 	v.mb.RecordVcenterClusterCPUEffectiveDataPoint(now, int64(s.EffectiveCpu))
 	v.mb.RecordVcenterClusterMemoryEffectiveDataPoint(now, s.EffectiveMemory)
@@ -134,7 +194,7 @@ func (v *vcenterMetricScraper) collectCluster(
 	v.mb.RecordVcenterClusterHostCountDataPoint(now, int64(s.NumHosts-s.NumEffectiveHosts), false)
 	v.mb.RecordVcenterClusterHostCountDataPoint(now, int64(s.NumEffectiveHosts), true)
 	v.mb.EmitForResource(
-		metadata.WithVcenterClusterName(c.Name()),
+		metadata.WithVcenterClusterName(clusterName),
 	)
 }
 
@@ -169,9 +229,13 @@ func (v *vcenterMetricScraper) collectDatastore(
 		return
 	}
 
+	v.emitDatastore(now, moDS, cluster.Name())
+}
+
+func (v *vcenterMetricScraper) emitDatastore(now pcommon.Timestamp, moDS mo.Datastore, clusterName string) {
 	v.recordDatastoreProperties(now, moDS)
 	v.mb.EmitForResource(
-		metadata.WithVcenterClusterName(cluster.Name()),
+		metadata.WithVcenterClusterName(clusterName),
 		metadata.WithVcenterDatastoreName(moDS.Name),
 	)
 }
@@ -212,11 +276,21 @@ func (v *vcenterMetricScraper) collectHost(
 		errs.AddPartial(1, err)
 		return
 	}
+	v.emitHost(ctx, now, hwSum, host.Name(), cluster.Name(), errs)
+}
+
+func (v *vcenterMetricScraper) emitHost(
+	ctx context.Context,
+	now pcommon.Timestamp,
+	hwSum mo.HostSystem,
+	hostName, clusterName string,
+	errs *scrapererror.ScrapeErrors,
+) {
 	v.recordHostSystemMemoryUsage(now, hwSum)
 	v.recordHostPerformanceMetrics(ctx, hwSum, errs)
 	v.mb.EmitForResource(
-		metadata.WithVcenterHostName(host.Name()),
-		metadata.WithVcenterClusterName(cluster.Name()),
+		metadata.WithVcenterHostName(hostName),
+		metadata.WithVcenterClusterName(clusterName),
 	)
 }
 
@@ -241,11 +315,15 @@ func (v *vcenterMetricScraper) collectResourcePools(
 			errs.AddPartial(1, err)
 			continue
 		}
-		v.recordResourcePool(ts, moRP)
-		v.mb.EmitForResource(metadata.WithVcenterResourcePoolName(rp.Name()))
+		v.emitResourcePool(ts, moRP, rp.Name())
 	}
 }
 
+func (v *vcenterMetricScraper) emitResourcePool(ts pcommon.Timestamp, moRP mo.ResourcePool, rpName string) {
+	v.recordResourcePool(ts, moRP)
+	v.mb.EmitForResource(metadata.WithVcenterResourcePoolName(rpName))
+}
+
 func (v *vcenterMetricScraper) collectVMs(
 	ctx context.Context,
 	colTime pcommon.Timestamp,
@@ -291,19 +369,27 @@ func (v *vcenterMetricScraper) collectVMs(
 			errs.AddPartial(1, fmt.Errorf("vm config empty for %s", hostname))
 			continue
 		}
-		vmUUID := moVM.Config.InstanceUuid
-
-		v.collectVM(ctx, colTime, moVM, errs)
-		v.mb.EmitForResource(
-			metadata.WithVcenterVMName(vm.Name()),
-			metadata.WithVcenterVMID(vmUUID),
-			metadata.WithVcenterClusterName(cluster.Name()),
-			metadata.WithVcenterHostName(hostname),
-		)
+		v.emitVM(ctx, colTime, moVM, vm.Name(), hostname, cluster.Name(), errs)
 	}
 	return poweredOnVMs, poweredOffVMs
 }
 
+func (v *vcenterMetricScraper) emitVM(
+	ctx context.Context,
+	colTime pcommon.Timestamp,
+	moVM mo.VirtualMachine,
+	vmName, hostname, clusterName string,
+	errs *scrapererror.ScrapeErrors,
+) {
+	v.collectVM(ctx, colTime, moVM, errs)
+	v.mb.EmitForResource(
+		metadata.WithVcenterVMName(vmName),
+		metadata.WithVcenterVMID(moVM.Config.InstanceUuid),
+		metadata.WithVcenterClusterName(clusterName),
+		metadata.WithVcenterHostName(hostname),
+	)
+}
+
 func (v *vcenterMetricScraper) collectVM(
 	ctx context.Context,
 	colTime pcommon.Timestamp,
@@ -20,6 +20,10 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"github.com/vmware/govmomi"
+	"github.com/vmware/govmomi/session"
+	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/vim25"
 	"go.opentelemetry.io/collector/receiver/receivertest"
 	"go.uber.org/zap"
 
@@ -76,6 +80,42 @@ func testScrape(ctx context.Context, t *testing.T, cfg *Config) {
 	require.NoError(t, scraper.Shutdown(ctx))
 }
 
+func TestScrape_IncrementalUpdates(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		cfg := &Config{
+			Metrics:            metadata.DefaultMetricsSettings(),
+			IncrementalUpdates: true,
+		}
+		scraper := newVmwareVcenterScraper(zap.NewNop(), cfg, receivertest.NewNopCreateSettings())
+		scraper.client.vimDriver = c
+		scraper.client.moClient = &govmomi.Client{
+			Client:         c,
+			SessionManager: session.NewManager(c),
+		}
+
+		for i := 0; i < 2; i++ {
+			metrics, err := scraper.scrape(ctx)
+			require.NoError(t, err)
+
+			var vms, hosts int
+			rms := metrics.ResourceMetrics()
+			for j := 0; j < rms.Len(); j++ {
+				attrs := rms.At(j).Resource().Attributes()
+				if _, ok := attrs.Get("vcenter.vm.name"); ok {
+					vms++
+				} else if _, ok := attrs.Get("vcenter.host.name"); ok {
+					hosts++
+				}
+			}
+			require.NotZero(t, vms)
+			require.NotZero(t, hosts)
+		}
+		require.NotNil(t, scraper.client.inventory)
+		require.NoError(t, scraper.Shutdown(ctx))
+		require.Nil(t, scraper.client.inventory)
+	})
+}
+
 func TestScrape_NoClient(t *testing.T) {
 	ctx := context.Background()
 	scraper := &vcenterMetricScraper{
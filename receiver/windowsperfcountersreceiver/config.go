@@ -16,6 +16,7 @@ package windowsperfcountersreceiver // import "github.com/open-telemetry/opentel
 
 import (
 	"fmt"
+	"time"
 
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
 	"go.uber.org/multierr"
@@ -52,10 +53,22 @@ type ObjectConfig struct {
 	Counters  []CounterConfig `mapstructure:"counters"`
 }
 
+// minSamplingInterval is the shortest supported sampling interval of a counter. Every sample is a
+// PDH query, and most counters are not updated more often by their providers.
+const minSamplingInterval = 100 * time.Millisecond
+
 // CounterConfig defines the individual counter in an object.
 type CounterConfig struct {
 	Name      string `mapstructure:"name"`
 	MetricRep `mapstructure:",squash"`
+
+	// SamplingInterval enables the high-frequency sampling of the counter: it is polled through PDH at
+	// this interval between scrapes, and reported as the minimum, maximum and average of the samples.
+	SamplingInterval time.Duration `mapstructure:"sampling_interval"`
+
+	// Raw reports the raw value of the counter, e.g. the number of bytes rather than the bytes/sec rate.
+	// The counter must be reported as a monotonic sum, accounting for instance restarts and wrap-arounds.
+	Raw bool `mapstructure:"raw"`
 }
 
 type MetricRep struct {
@@ -102,6 +115,16 @@ func (c *Config) Validate() error {
 		}
 
 		for _, counter := range pc.Counters {
+			if counter.SamplingInterval != 0 {
+				errs = multierr.Append(errs, c.validateSampling(pc, counter))
+			}
+
+			if counter.Raw {
+				if metric, ok := c.MetricMetaData[counter.MetricRep.Name]; !ok || !metric.Sum.Monotonic {
+					errs = multierr.Append(errs, fmt.Errorf("counter %q of perf counter for object %q reports raw values but is not reported as a monotonic sum metric", counter.Name, pc.Object))
+				}
+			}
+
 			if counter.MetricRep.Name == "" {
 				continue
 			}
@@ -131,3 +154,17 @@ func (c *Config) Validate() error {
 
 	return errs
 }
+
+func (c *Config) validateSampling(pc ObjectConfig, counter CounterConfig) error {
+	var errs error
+
+	if counter.SamplingInterval < minSamplingInterval || counter.SamplingInterval >= c.CollectionInterval {
+		errs = multierr.Append(errs, fmt.Errorf("counter %q of perf counter for object %q has a sampling_interval that is not between %v and the collection_interval", counter.Name, pc.Object, minSamplingInterval))
+	}
+
+	if metric, ok := c.MetricMetaData[counter.MetricRep.Name]; ok && (metric.Sum != SumMetric{}) {
+		errs = multierr.Append(errs, fmt.Errorf("counter %q of perf counter for object %q is sampled but reported as the sum metric %q", counter.Name, pc.Object, counter.MetricRep.Name))
+	}
+
+	return errs
+}
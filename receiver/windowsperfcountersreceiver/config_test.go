@@ -33,6 +33,9 @@ const (
 	noObjectNameErr               = "must specify object name for all perf counters"
 	noCountersErr                 = `perf counter for object "%s" does not specify any counters`
 	emptyInstanceErr              = `perf counter for object "%s" includes an empty instance`
+	samplingIntervalErr           = `counter "%s" of perf counter for object "%s" has a sampling_interval that is not between 100ms and the collection_interval`
+	sampledSumErr                 = `counter "%s" of perf counter for object "%s" is sampled but reported as the sum metric "%s"`
+	rawNotMonotonicSumErr         = `counter "%s" of perf counter for object "%s" reports raw values but is not reported as a monotonic sum metric`
 )
 
 func TestLoadConfig(t *testing.T) {
@@ -163,6 +166,59 @@ func TestLoadConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			id: component.NewIDWithName(typeStr, "sampling"),
+			expected: &Config{
+				ScraperControllerSettings: scraperhelper.ScraperControllerSettings{
+					CollectionInterval: 10 * time.Second,
+				},
+				PerfCounters: []ObjectConfig{
+					{
+						Object: "object",
+						Counters: []CounterConfig{{
+							Name:             "counter1",
+							MetricRep:        MetricRep{Name: "metric"},
+							SamplingInterval: 100 * time.Millisecond,
+						}},
+					},
+				},
+				MetricMetaData: map[string]MetricConfig{
+					"metric": {
+						Description: "desc",
+						Unit:        "1",
+						Gauge:       GaugeMetric{},
+					},
+				},
+			},
+		},
+		{
+			id: component.NewIDWithName(typeStr, "raw"),
+			expected: &Config{
+				ScraperControllerSettings: scraperhelper.ScraperControllerSettings{
+					CollectionInterval: 60 * time.Second,
+				},
+				PerfCounters: []ObjectConfig{
+					{
+						Object: "object",
+						Counters: []CounterConfig{{
+							Name:      "counter1",
+							MetricRep: MetricRep{Name: "metric"},
+							Raw:       true,
+						}},
+					},
+				},
+				MetricMetaData: map[string]MetricConfig{
+					"metric": {
+						Description: "desc",
+						Unit:        "By",
+						Sum: SumMetric{
+							Aggregation: "cumulative",
+							Monotonic:   true,
+						},
+					},
+				},
+			},
+		},
 		{
 			id: component.NewIDWithName(typeStr, "unspecifiedmetrictype"),
 			expected: &Config{
@@ -210,6 +266,18 @@ func TestLoadConfig(t *testing.T) {
 				noObjectNameErr,
 			),
 		},
+		{
+			id: component.NewIDWithName(typeStr, "invalidsampling"),
+			expectedErr: fmt.Sprintf(
+				"%s; %s",
+				fmt.Sprintf(samplingIntervalErr, "counter1", "object"),
+				fmt.Sprintf(sampledSumErr, "counter1", "object", "metric"),
+			),
+		},
+		{
+			id:          component.NewIDWithName(typeStr, "invalidraw"),
+			expectedErr: fmt.Sprintf(rawNotMonotonicSumErr, "counter1", "object"),
+		},
 		{
 			id:          component.NewIDWithName(typeStr, "emptyinstance"),
 			expectedErr: fmt.Sprintf(emptyInstanceErr, "object"),
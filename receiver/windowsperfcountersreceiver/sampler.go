@@ -0,0 +1,140 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build windows
+// +build windows
+
+package windowsperfcountersreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/windowsperfcountersreceiver"
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/winperfcounters"
+)
+
+// sampledValue is the aggregate of the samples of a counter instance taken between two scrapes.
+type sampledValue struct {
+	InstanceName string
+	Min          float64
+	Max          float64
+	Sum          float64
+	Count        int
+}
+
+func (v *sampledValue) add(value float64) {
+	if v.Count == 0 || value < v.Min {
+		v.Min = value
+	}
+	if v.Count == 0 || value > v.Max {
+		v.Max = value
+	}
+	v.Sum += value
+	v.Count++
+}
+
+// Avg returns the average of the samples.
+func (v *sampledValue) Avg() float64 {
+	return v.Sum / float64(v.Count)
+}
+
+// counterSampler polls a counter through PDH at a sub-second interval in the background, and
+// pre-aggregates the samples until they are collected by the next scrape. Rate counters are computed
+// by PDH between two samples, so they reflect the rate over the sampling interval.
+type counterSampler struct {
+	watcher  winperfcounters.PerfCounterWatcher
+	interval time.Duration
+
+	mu     sync.Mutex
+	values map[string]*sampledValue
+	err    error
+
+	done chan struct{}
+	wg   sync.WaitGroup
+}
+
+func newCounterSampler(watcher winperfcounters.PerfCounterWatcher, interval time.Duration) *counterSampler {
+	return &counterSampler{
+		watcher:  watcher,
+		interval: interval,
+		values:   map[string]*sampledValue{},
+		done:     make(chan struct{}),
+	}
+}
+
+func (s *counterSampler) start() {
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				s.sample()
+			case <-s.done:
+				return
+			}
+		}
+	}()
+}
+
+func (s *counterSampler) sample() {
+	counterValues, err := s.watcher.ScrapeData()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err != nil {
+		// Only keep the last error, as the same error is likely to occur at every sample
+		s.err = err
+		return
+	}
+
+	for _, counterValue := range counterValues {
+		value, ok := s.values[counterValue.InstanceName]
+		if !ok {
+			value = &sampledValue{InstanceName: counterValue.InstanceName}
+			s.values[counterValue.InstanceName] = value
+		}
+		value.add(counterValue.Value)
+	}
+}
+
+// collect returns the aggregated samples by instance name, and the last error that occurred
+// while sampling, since the previous call.
+func (s *counterSampler) collect() ([]sampledValue, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	values := make([]sampledValue, 0, len(s.values))
+	for _, value := range s.values {
+		values = append(values, *value)
+	}
+	sort.Slice(values, func(i, j int) bool {
+		return values[i].InstanceName < values[j].InstanceName
+	})
+	err := s.err
+
+	s.values = map[string]*sampledValue{}
+	s.err = nil
+	return values, err
+}
+
+func (s *counterSampler) shutdown() {
+	close(s.done)
+	s.wg.Wait()
+}
@@ -0,0 +1,87 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build windows
+// +build windows
+
+package windowsperfcountersreceiver
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/winperfcounters"
+)
+
+// sequencePerfCounter returns the next values of a sequence at each scrape
+type sequencePerfCounter struct {
+	mockPerfCounter
+	sequence [][]winperfcounters.CounterValue
+	errs     []error
+	next     int
+}
+
+func (w *sequencePerfCounter) ScrapeData() ([]winperfcounters.CounterValue, error) {
+	i := w.next % len(w.sequence)
+	w.next++
+	return w.sequence[i], w.errs[i]
+}
+
+func TestCounterSampler(t *testing.T) {
+	watcher := &sequencePerfCounter{
+		sequence: [][]winperfcounters.CounterValue{
+			{{InstanceName: "1", Value: 10}, {InstanceName: "0", Value: 1}},
+			{{InstanceName: "1", Value: 30}, {InstanceName: "0", Value: 3}},
+			nil,
+			{{InstanceName: "1", Value: 20}, {InstanceName: "0", Value: 2}},
+		},
+		errs: []error{nil, nil, errors.New("sample failed"), nil},
+	}
+	sampler := newCounterSampler(watcher, time.Second)
+
+	for i := 0; i < 4; i++ {
+		sampler.sample()
+	}
+
+	values, err := sampler.collect()
+	assert.EqualError(t, err, "sample failed")
+	require.Equal(t, []sampledValue{
+		{InstanceName: "0", Min: 1, Max: 3, Sum: 6, Count: 3},
+		{InstanceName: "1", Min: 10, Max: 30, Sum: 60, Count: 3},
+	}, values)
+	assert.Equal(t, 2.0, values[0].Avg())
+	assert.Equal(t, 20.0, values[1].Avg())
+
+	values, err = sampler.collect()
+	assert.NoError(t, err)
+	assert.Empty(t, values, "the samples should be reset after being collected")
+}
+
+func TestCounterSamplerStartShutdown(t *testing.T) {
+	watcher := &mockPerfCounter{counterValues: []winperfcounters.CounterValue{{Value: 1}}}
+	sampler := newCounterSampler(watcher, minSamplingInterval)
+	sampler.start()
+
+	require.Eventually(t, func() bool {
+		sampler.mu.Lock()
+		defer sampler.mu.Unlock()
+		return len(sampler.values) == 1 && sampler.values[""].Count > 1
+	}, 5*time.Second, minSamplingInterval)
+
+	sampler.shutdown()
+}
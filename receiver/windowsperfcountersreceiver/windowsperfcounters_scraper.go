@@ -30,11 +30,19 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/winperfcounters"
 )
 
-const instanceLabelName = "instance"
+const (
+	instanceLabelName    = "instance"
+	aggregationLabelName = "aggregation"
+)
 
 type perfCounterMetricWatcher struct {
 	winperfcounters.PerfCounterWatcher
 	MetricRep
+
+	// sampler is set when the counter is sampled at a higher frequency than the collection interval
+	sampler *counterSampler
+	// tracker is set when the raw values of the counter are reported
+	tracker *winperfcounters.InstanceTracker
 }
 
 type newWatcherFunc func(string, string, string) (winperfcounters.PerfCounterWatcher, error)
@@ -59,6 +67,11 @@ func (s *scraper) start(context.Context, component.Host) error {
 		s.settings.Logger.Warn("some performance counters could not be initialized", zap.Error(err))
 	}
 	s.watchers = watchers
+	for _, watcher := range s.watchers {
+		if watcher.sampler != nil {
+			watcher.sampler.start()
+		}
+	}
 	return nil
 }
 
@@ -85,6 +98,12 @@ func (s *scraper) initWatchers() ([]perfCounterMetricWatcher, error) {
 						watcher.MetricRep.Attributes = counterCfg.MetricRep.Attributes
 					}
 				}
+				if counterCfg.SamplingInterval > 0 {
+					watcher.sampler = newCounterSampler(pcw, counterCfg.SamplingInterval)
+				}
+				if counterCfg.Raw {
+					watcher.tracker = winperfcounters.NewInstanceTracker()
+				}
 
 				watchers = append(watchers, watcher)
 			}
@@ -97,6 +116,9 @@ func (s *scraper) initWatchers() ([]perfCounterMetricWatcher, error) {
 func (s *scraper) shutdown(context.Context) error {
 	var errs error
 	for _, watcher := range s.watchers {
+		if watcher.sampler != nil {
+			watcher.sampler.shutdown()
+		}
 		err := watcher.Close()
 		if err != nil {
 			errs = multierr.Append(errs, err)
@@ -137,6 +159,15 @@ func (s *scraper) scrape(context.Context) (pmetric.Metrics, error) {
 	}
 
 	for _, watcher := range s.watchers {
+		if watcher.sampler != nil {
+			errs = multierr.Append(errs, scrapeSampled(watcher, metrics, metricSlice, now))
+			continue
+		}
+		if watcher.tracker != nil {
+			errs = multierr.Append(errs, scrapeRaw(watcher, metrics, metricSlice, now))
+			continue
+		}
+
 		counterVals, err := watcher.ScrapeData()
 		if err != nil {
 			errs = multierr.Append(errs, err)
@@ -144,24 +175,78 @@ func (s *scraper) scrape(context.Context) (pmetric.Metrics, error) {
 		}
 
 		for _, val := range counterVals {
-			var metric pmetric.Metric
-			if builtmetric, ok := metrics[watcher.MetricRep.Name]; ok {
-				metric = builtmetric
-			} else {
-				metric = metricSlice.AppendEmpty()
-				metric.SetName(watcher.MetricRep.Name)
-				metric.SetUnit("1")
-				metric.SetEmptyGauge()
-			}
-
+			metric := getOrCreateMetric(watcher.MetricRep.Name, metrics, metricSlice)
 			initializeMetricDps(metric, now, val, watcher.MetricRep.Attributes)
 		}
 	}
 	return md, errs
 }
 
+// scrapeSampled reports the minimum, maximum and average of the samples taken since the previous scrape.
+func scrapeSampled(watcher perfCounterMetricWatcher, metrics map[string]pmetric.Metric, metricSlice pmetric.MetricSlice,
+	now pcommon.Timestamp) error {
+	sampledVals, err := watcher.sampler.collect()
+
+	for _, val := range sampledVals {
+		metric := getOrCreateMetric(watcher.MetricRep.Name, metrics, metricSlice)
+		for _, agg := range []struct {
+			name  string
+			value float64
+		}{
+			{"min", val.Min},
+			{"max", val.Max},
+			{"avg", val.Avg()},
+		} {
+			counterValue := winperfcounters.CounterValue{InstanceName: val.InstanceName, Value: agg.value}
+			dp := initializeMetricDps(metric, now, counterValue, watcher.MetricRep.Attributes)
+			dp.Attributes().PutStr(aggregationLabelName, agg.name)
+		}
+	}
+	return err
+}
+
+func scrapeRaw(watcher perfCounterMetricWatcher, metrics map[string]pmetric.Metric, metricSlice pmetric.MetricSlice,
+	now pcommon.Timestamp) error {
+	rawVals, err := watcher.ScrapeRawValues()
+	if err != nil {
+		return err
+	}
+
+	metadata, _ := watcher.tracker.Track(rawVals, now.AsTime())
+	metric := getOrCreateMetric(watcher.MetricRep.Name, metrics, metricSlice)
+	isDelta := metric.Type() == pmetric.MetricTypeSum &&
+		metric.Sum().AggregationTemporality() == pmetric.AggregationTemporalityDelta
+	for i, val := range rawVals {
+		md := metadata[i]
+		value, startTime := md.Total, md.StartTime
+		if isDelta {
+			if md.Reset {
+				// There is no previous value to compute the increase from
+				continue
+			}
+			value, startTime = md.Delta, md.PreviousTime
+		}
+
+		counterValue := winperfcounters.CounterValue{InstanceName: val.InstanceName, Value: float64(value)}
+		dp := initializeMetricDps(metric, now, counterValue, watcher.MetricRep.Attributes)
+		dp.SetStartTimestamp(pcommon.NewTimestampFromTime(startTime))
+	}
+	return nil
+}
+
+func getOrCreateMetric(name string, metrics map[string]pmetric.Metric, metricSlice pmetric.MetricSlice) pmetric.Metric {
+	if builtmetric, ok := metrics[name]; ok {
+		return builtmetric
+	}
+	metric := metricSlice.AppendEmpty()
+	metric.SetName(name)
+	metric.SetUnit("1")
+	metric.SetEmptyGauge()
+	return metric
+}
+
 func initializeMetricDps(metric pmetric.Metric, now pcommon.Timestamp, counterValue winperfcounters.CounterValue,
-	attributes map[string]string) {
+	attributes map[string]string) pmetric.NumberDataPoint {
 	var dps pmetric.NumberDataPointSlice
 
 	if metric.Type() == pmetric.MetricTypeGauge {
@@ -182,6 +267,7 @@ func initializeMetricDps(metric pmetric.Metric, now pcommon.Timestamp, counterVa
 
 	dp.SetTimestamp(now)
 	dp.SetDoubleValue(counterValue.Value)
+	return dp
 }
 
 func instancesFromConfig(oc ObjectConfig) []string {
@@ -19,6 +19,7 @@ package windowsperfcountersreceiver
 
 import (
 	"context"
+	"math"
 	"path/filepath"
 	"testing"
 	"time"
@@ -40,6 +41,7 @@ import (
 
 type mockPerfCounter struct {
 	counterValues []winperfcounters.CounterValue
+	rawValues     [][]winperfcounters.RawCounterValue
 	metricRep     MetricRep
 	path          string
 	scrapeErr     error
@@ -54,6 +56,16 @@ func (w *mockPerfCounter) ScrapeData() ([]winperfcounters.CounterValue, error) {
 	return w.counterValues, w.scrapeErr
 }
 
+// ScrapeRawValues returns the next raw values at each scrape
+func (w *mockPerfCounter) ScrapeRawValues() ([]winperfcounters.RawCounterValue, error) {
+	if len(w.rawValues) == 0 {
+		return nil, w.scrapeErr
+	}
+	vals := w.rawValues[0]
+	w.rawValues = w.rawValues[1:]
+	return vals, w.scrapeErr
+}
+
 func (w *mockPerfCounter) Close() error {
 	return w.closeErr
 }
@@ -397,3 +409,105 @@ func TestScrape(t *testing.T) {
 		})
 	}
 }
+
+func TestScrapeSampled(t *testing.T) {
+	cfg := &Config{
+		PerfCounters: []ObjectConfig{
+			{
+				Counters: []CounterConfig{
+					{
+						MetricRep: MetricRep{
+							Name: "metric1",
+							Attributes: map[string]string{
+								"test.attribute": "test-value",
+							},
+						},
+						SamplingInterval: minSamplingInterval,
+					},
+				},
+			},
+		},
+		MetricMetaData: map[string]MetricConfig{
+			"metric1": {Description: "metric1 description", Unit: "1"},
+		},
+	}
+	mpc := mockPerfCounter{counterValues: []winperfcounters.CounterValue{{InstanceName: "Test Instance", Value: 1.0}}}
+	s := &scraper{cfg: cfg, newWatcher: mockPerfCounterFactory(mpc)}
+	require.NoError(t, s.start(context.Background(), componenttest.NewNopHost()))
+	defer func() {
+		require.NoError(t, s.shutdown(context.Background()))
+	}()
+
+	// Wait for the first samples
+	require.Eventually(t, func() bool {
+		s.watchers[0].sampler.mu.Lock()
+		defer s.watchers[0].sampler.mu.Unlock()
+		return len(s.watchers[0].sampler.values) > 0
+	}, 5*time.Second, minSamplingInterval)
+
+	m, err := s.scrape(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 1, m.MetricCount())
+
+	metric := m.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0)
+	assert.Equal(t, "metric1", metric.Name())
+	dps := metric.Gauge().DataPoints()
+	require.Equal(t, 3, dps.Len())
+	for i, aggregation := range []string{"min", "max", "avg"} {
+		dp := dps.At(i)
+		assert.Equal(t, 1.0, dp.DoubleValue())
+		assert.Equal(t, map[string]interface{}{
+			instanceLabelName:    "Test Instance",
+			aggregationLabelName: aggregation,
+			"test.attribute":     "test-value",
+		}, dp.Attributes().AsRaw())
+	}
+}
+
+func TestScrapeRaw(t *testing.T) {
+	for _, aggregation := range []string{"cumulative", "delta"} {
+		t.Run(aggregation, func(t *testing.T) {
+			cfg := &Config{
+				PerfCounters: []ObjectConfig{
+					{
+						Counters: []CounterConfig{{MetricRep: MetricRep{Name: "metric1"}, Raw: true}},
+					},
+				},
+				MetricMetaData: map[string]MetricConfig{
+					"metric1": {Unit: "By", Sum: SumMetric{Aggregation: aggregation, Monotonic: true}},
+				},
+			}
+			mpc := mockPerfCounter{rawValues: [][]winperfcounters.RawCounterValue{
+				{{InstanceName: "a", RawValue: math.MaxUint32 - 5}, {InstanceName: "b", RawValue: 100}},
+				{{InstanceName: "a", RawValue: 10}, {InstanceName: "b", RawValue: 20}},
+			}}
+			s := &scraper{cfg: cfg, newWatcher: mockPerfCounterFactory(mpc)}
+			require.NoError(t, s.start(context.Background(), componenttest.NewNopHost()))
+			defer func() {
+				require.NoError(t, s.shutdown(context.Background()))
+			}()
+
+			_, err := s.scrape(context.Background())
+			require.NoError(t, err)
+			m, err := s.scrape(context.Background())
+			require.NoError(t, err)
+			require.Equal(t, 1, m.MetricCount())
+
+			dps := m.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Sum().DataPoints()
+			if aggregation == "delta" {
+				// The restarted instance has no previous value to compute an increase from
+				require.Equal(t, 1, dps.Len())
+				assert.Equal(t, 16.0, dps.At(0).DoubleValue())
+				assert.LessOrEqual(t, dps.At(0).StartTimestamp(), dps.At(0).Timestamp())
+				return
+			}
+
+			// The wrapped around instance keeps counting, the restarted one starts over
+			require.Equal(t, 2, dps.Len())
+			assert.Equal(t, float64(math.MaxUint32+11), dps.At(0).DoubleValue())
+			assert.LessOrEqual(t, dps.At(0).StartTimestamp(), dps.At(0).Timestamp())
+			assert.Equal(t, 20.0, dps.At(1).DoubleValue())
+			assert.Equal(t, dps.At(1).Timestamp(), dps.At(1).StartTimestamp())
+		})
+	}
+}
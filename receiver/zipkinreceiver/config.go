@@ -17,6 +17,8 @@ package zipkinreceiver // import "github.com/open-telemetry/opentelemetry-collec
 import (
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config/confighttp"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/requestlimit"
 )
 
 // Config defines configuration for Zipkin receiver.
@@ -26,6 +28,9 @@ type Config struct {
 	// If enabled the zipkin receiver will attempt to parse string tags/binary annotations into int/bool/float.
 	// Disabled by default
 	ParseStringTags bool `mapstructure:"parse_string_tags"`
+	// Limits guards the receiver against oversized requests and clients sending too many spans.
+	// All limits are disabled by default.
+	Limits requestlimit.Settings `mapstructure:"limits"`
 }
 
 var _ component.Config = (*Config)(nil)
@@ -23,11 +23,15 @@ import (
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/confmap/confmaptest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/requestlimit"
 )
 
 func TestLoadConfig(t *testing.T) {
 	t.Parallel()
 
+	rateLimiterID := component.NewIDWithName("rate_limiter", "zipkin")
+
 	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
 	require.NoError(t, err)
 
@@ -57,6 +61,19 @@ func TestLoadConfig(t *testing.T) {
 				ParseStringTags: true,
 			},
 		},
+		{
+			id: component.NewIDWithName(typeStr, "limits"),
+			expected: &Config{
+				HTTPServerSettings: confighttp.HTTPServerSettings{
+					Endpoint: defaultBindEndpoint,
+				},
+				Limits: requestlimit.Settings{
+					MaxRequestBytes:    5242880,
+					MaxSpansPerRequest: 1000,
+					RateLimiter:        &rateLimiterID,
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -31,6 +31,7 @@ import (
 	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.opentelemetry.io/collector/receiver"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/requestlimit"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/translator/zipkin/zipkinv1"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/translator/zipkin/zipkinv2"
 )
@@ -51,6 +52,7 @@ type zipkinReceiver struct {
 	shutdownWG sync.WaitGroup
 	server     *http.Server
 	config     *Config
+	limiter    *requestlimit.Limiter
 
 	v1ThriftUnmarshaler      ptrace.Unmarshaler
 	v1JSONUnmarshaler        ptrace.Unmarshaler
@@ -87,6 +89,7 @@ func newReceiver(config *Config, nextConsumer consumer.Traces, settings receiver
 	zr := &zipkinReceiver{
 		nextConsumer:             nextConsumer,
 		config:                   config,
+		limiter:                  requestlimit.NewLimiter(config.Limits),
 		v1ThriftUnmarshaler:      zipkinv1.NewThriftTracesUnmarshaler(),
 		v1JSONUnmarshaler:        zipkinv1.NewJSONTracesUnmarshaler(config.ParseStringTags),
 		jsonUnmarshaler:          zipkinv2.NewJSONTracesUnmarshaler(config.ParseStringTags),
@@ -104,8 +107,12 @@ func (zr *zipkinReceiver) Start(_ context.Context, host component.Host) error {
 		return errors.New("nil host")
 	}
 
+	if err := zr.limiter.Start(host); err != nil {
+		return err
+	}
+
 	var err error
-	zr.server, err = zr.config.HTTPServerSettings.ToServer(host, zr.settings.TelemetrySettings, zr)
+	zr.server, err = zr.config.HTTPServerSettings.ToServer(host, zr.settings.TelemetrySettings, zr.limiter.Handler(zr))
 	if err != nil {
 		return err
 	}
@@ -223,32 +230,41 @@ func (zr *zipkinReceiver) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	obsrecv := zr.obsrecvrs[transportTag]
 	ctx = obsrecv.StartTracesOp(ctx)
 
+	receiverTagValue := zipkinV2TagValue
+	if asZipkinv1 {
+		receiverTagValue = zipkinV1TagValue
+	}
+
 	pr := processBodyIfNecessary(r)
-	slurp, _ := io.ReadAll(pr)
+	slurp, err := zr.limiter.ReadBody(pr)
 	if c, ok := pr.(io.Closer); ok {
 		_ = c.Close()
 	}
 	_ = r.Body.Close()
 
 	var td ptrace.Traces
-	var err error
-	if asZipkinv1 {
-		td, err = zr.v1ToTraceSpans(slurp, r.Header)
-	} else {
-		td, err = zr.v2ToTraceSpans(slurp, r.Header)
+	if err == nil {
+		if asZipkinv1 {
+			td, err = zr.v1ToTraceSpans(slurp, r.Header)
+		} else {
+			td, err = zr.v2ToTraceSpans(slurp, r.Header)
+		}
 	}
 
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusBadRequest)
+		obsrecv.EndTracesOp(ctx, receiverTagValue, 0, err)
+		requestlimit.WriteError(w, err, http.StatusBadRequest)
+		return
+	}
+
+	if err = zr.limiter.Check(ctx, td); err != nil {
+		obsrecv.EndTracesOp(ctx, receiverTagValue, td.SpanCount(), err)
+		requestlimit.WriteError(w, err, http.StatusInternalServerError)
 		return
 	}
 
 	consumerErr := zr.nextConsumer.ConsumeTraces(ctx, td)
 
-	receiverTagValue := zipkinV2TagValue
-	if asZipkinv1 {
-		receiverTagValue = zipkinV1TagValue
-	}
 	obsrecv.EndTracesOp(ctx, receiverTagValue, td.SpanCount(), consumerErr)
 
 	if consumerErr != nil {
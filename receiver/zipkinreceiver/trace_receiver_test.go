@@ -21,6 +21,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"net"
 	"net/http"
 	"net/http/httptest"
@@ -37,8 +38,11 @@ import (
 	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/receiver/receivertest"
 	conventions "go.opentelemetry.io/collector/semconv/v1.6.1"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/requestlimit"
 )
 
 const (
@@ -350,6 +354,121 @@ func compressZlib(body []byte) (*bytes.Buffer, error) {
 	return &buf, nil
 }
 
+type testRateLimiter struct {
+	component.StartFunc
+	component.ShutdownFunc
+
+	limited bool
+}
+
+type rateLimitedError struct{}
+
+func (rateLimitedError) Error() string {
+	return "rate limited"
+}
+
+func (rateLimitedError) WriteHTTP(w http.ResponseWriter) {
+	http.Error(w, "rate limited", http.StatusTooManyRequests)
+}
+
+func (r *testRateLimiter) Limit(context.Context, pcommon.Map, int) error {
+	if r.limited {
+		return rateLimitedError{}
+	}
+	return nil
+}
+
+type testHost struct {
+	component.Host
+	extensions map[component.ID]component.Component
+}
+
+func (h *testHost) GetExtensions() map[component.ID]component.Component {
+	return h.extensions
+}
+
+func TestReceiverLimits(t *testing.T) {
+	body, err := os.ReadFile(zipkinV1SingleBatch)
+	require.NoError(t, err)
+
+	rateLimiterID := component.NewID("rate_limiter")
+	rateLimiter := &testRateLimiter{}
+	host := &testHost{
+		Host:       componenttest.NewNopHost(),
+		extensions: map[component.ID]component.Component{rateLimiterID: rateLimiter},
+	}
+
+	cfg := &Config{
+		HTTPServerSettings: confighttp.HTTPServerSettings{
+			Endpoint: "localhost:9411",
+		},
+		Limits: requestlimit.Settings{
+			MaxRequestBytes:    int64(len(body)),
+			MaxSpansPerRequest: 2,
+			RateLimiter:        &rateLimiterID,
+		},
+	}
+	sink := new(consumertest.TracesSink)
+	zr, err := newReceiver(cfg, sink, receivertest.NewNopCreateSettings())
+	require.NoError(t, err)
+	require.NoError(t, zr.limiter.Start(host))
+	handler := zr.limiter.Handler(zr)
+
+	send := func(body io.Reader, contentLength int64, encoding string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest("POST", "/api/v1/spans", body)
+		r.ContentLength = contentLength
+		r.Header.Add("content-type", "application/json")
+		if encoding != "" {
+			r.Header.Add("content-encoding", encoding)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, r)
+		return rec
+	}
+
+	rec := send(bytes.NewReader(body), int64(len(body)), "")
+	require.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+	require.Contains(t, rec.Body.String(), "the maximum allowed is 2")
+
+	// bodies without a declared length are cut on the wire
+	rec = send(bytes.NewReader(append(body, ' ')), -1, "")
+	require.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+	require.Contains(t, rec.Body.String(), "request body exceeds the maximum")
+
+	// the limit also applies to the decompressed body
+	compressed, err := compressGzip(bytes.Repeat([]byte(" "), 10*len(body)))
+	require.NoError(t, err)
+	require.Less(t, compressed.Len(), len(body))
+	rec = send(compressed, int64(compressed.Len()), "gzip")
+	require.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+
+	rateLimiter.limited = true
+	zr.limiter = requestlimit.NewLimiter(requestlimit.Settings{RateLimiter: &rateLimiterID})
+	require.NoError(t, zr.limiter.Start(host))
+	rec = send(bytes.NewReader(body), int64(len(body)), "")
+	require.Equal(t, http.StatusTooManyRequests, rec.Code)
+
+	require.Empty(t, sink.AllTraces())
+
+	rateLimiter.limited = false
+	rec = send(bytes.NewReader(body), int64(len(body)), "")
+	require.Equal(t, http.StatusAccepted, rec.Code)
+	require.Len(t, sink.AllTraces(), 1)
+}
+
+func TestReceiverRateLimiterNotFound(t *testing.T) {
+	rateLimiterID := component.NewID("rate_limiter")
+	cfg := &Config{
+		HTTPServerSettings: confighttp.HTTPServerSettings{
+			Endpoint: "localhost:0",
+		},
+		Limits: requestlimit.Settings{RateLimiter: &rateLimiterID},
+	}
+	zr, err := newReceiver(cfg, consumertest.NewNop(), receivertest.NewNopCreateSettings())
+	require.NoError(t, err)
+	assert.EqualError(t, zr.Start(context.Background(), componenttest.NewNopHost()), "rate limiter extension 'rate_limiter' not found")
+}
+
 func TestConvertSpansToTraceSpans_JSONWithoutSerivceName(t *testing.T) {
 	blob, err := os.ReadFile("./testdata/sample2.json")
 	require.NoError(t, err, "Failed to read sample JSON file: %v", err)
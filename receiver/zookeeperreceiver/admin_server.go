@@ -0,0 +1,143 @@
+// Copyright 2020, OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zookeeperreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/zookeeperreceiver"
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/zookeeperreceiver/internal/metadata"
+)
+
+const (
+	monitorCommand = "monitor"
+	ruokCommand    = "ruok"
+
+	// adminServerKeyPrefix is the prefix of the mntr keys that the AdminServer omits.
+	adminServerKeyPrefix = "zk_"
+)
+
+// adminServerValueRE matches the same values as the value group of zookeeperFormatRE,
+// so that both APIs report identical resource attributes.
+var adminServerValueRE = regexp.MustCompile(`^[\w\.\-]+`)
+
+func validateAdminServerEndpoint(endpoint string) error {
+	if endpoint == "" {
+		return errors.New("admin_server endpoint must be specified")
+	}
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return fmt.Errorf("invalid admin_server endpoint: %w", err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("admin_server endpoint scheme must be http or https, got %q", u.Scheme)
+	}
+	return nil
+}
+
+// getAdminServerMetrics checks that the server is running with the `ruok` command of the AdminServer,
+// then collects the metrics from its `monitor` command, which reports the same keys as `mntr` without
+// their `zk_` prefix. The result of `ruok` is reported even when the other metrics can't be collected.
+func (z *zookeeperMetricsScraper) getAdminServerMetrics(ctx context.Context) (pmetric.Metrics, error) {
+	now := pcommon.NewTimestampFromTime(time.Now())
+	if _, err := z.sendAdminServerCmd(ctx, ruokCommand); err != nil {
+		z.logger.Error("failed to send command",
+			zap.Error(err),
+			zap.String("command", ruokCommand),
+		)
+		z.mb.RecordZookeeperRuokDataPoint(now, 0)
+		return z.mb.Emit(), scrapererror.NewPartialScrapeError(err, 0)
+	}
+	z.mb.RecordZookeeperRuokDataPoint(now, 1)
+
+	values, err := z.sendAdminServerCmd(ctx, monitorCommand)
+	if err != nil {
+		z.logger.Error("failed to send command",
+			zap.Error(err),
+			zap.String("command", monitorCommand),
+		)
+		return z.mb.Emit(), scrapererror.NewPartialScrapeError(err, 0)
+	}
+
+	creator := newMetricCreator(z.mb)
+	resourceOpts := make([]metadata.ResourceMetricsOption, 0, 2)
+	// Sorting the keys keeps the order of the data points stable between scrapes.
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		value := values[key]
+		var metricValue string
+		switch v := value.(type) {
+		case json.Number:
+			metricValue = v.String()
+		case string:
+			if metricValue = adminServerValueRE.FindString(v); metricValue == "" {
+				continue
+			}
+		default:
+			// Command metadata and structured values have no metric equivalent.
+			continue
+		}
+		resourceOpts = z.recordValue(creator, now, monitorCommand, adminServerKeyPrefix+key, metricValue, resourceOpts)
+	}
+
+	creator.generateComputedMetrics(z.logger, now)
+
+	return z.mb.Emit(resourceOpts...), nil
+}
+
+func (z *zookeeperMetricsScraper) sendAdminServerCmd(ctx context.Context, cmd string) (map[string]interface{}, error) {
+	endpoint := strings.TrimSuffix(z.config.AdminServer.Endpoint, "/") + "/" + cmd
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := z.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d from %s", resp.StatusCode, endpoint)
+	}
+
+	var values map[string]interface{}
+	decoder := json.NewDecoder(resp.Body)
+	decoder.UseNumber()
+	if err = decoder.Decode(&values); err != nil {
+		return nil, fmt.Errorf("failed to decode response from %s: %w", endpoint, err)
+	}
+	if cmdErr, ok := values["error"]; ok && cmdErr != nil {
+		return nil, fmt.Errorf("command %s failed: %v", cmd, cmdErr)
+	}
+	return values, nil
+}
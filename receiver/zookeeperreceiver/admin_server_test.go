@@ -0,0 +1,178 @@
+// Copyright  OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zookeeperreceiver
+
+import (
+	"context"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/config/configtls"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/comparetest"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/comparetest/golden"
+)
+
+func TestZookeeperMetricsScraperScrapeAdminServer(t *testing.T) {
+	monitor, err := os.ReadFile(filepath.Join("testdata", "monitor-3.5.5.json"))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		handler  http.HandlerFunc
+		ruokDown bool
+		tls      bool
+		wantErr  bool
+		wantRuok int64
+	}{
+		{
+			name: "Test correctness with v3.5.5",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, "/commands/monitor", r.URL.Path)
+				_, _ = w.Write(monitor)
+			},
+		},
+		{
+			name: "Test correctness with v3.5.5 over TLS",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write(monitor)
+			},
+			tls: true,
+		},
+		{
+			name: "Command disabled",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(`{"command":"monitor","error":"Command not allowed"}`))
+			},
+			wantErr:  true,
+			wantRuok: 1,
+		},
+		{
+			name: "Server not running",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				t.Error("monitor must not be sent when ruok fails")
+			},
+			ruokDown: true,
+			wantErr:  true,
+			wantRuok: 0,
+		},
+		{
+			name: "Unexpected status code",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusServiceUnavailable)
+			},
+			wantErr:  true,
+			wantRuok: 1,
+		},
+		{
+			name: "Invalid response",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(`zk_version 3.5.5`))
+			},
+			wantErr:  true,
+			wantRuok: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			cfg.AdminServer = &confighttp.HTTPClientSettings{}
+
+			handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/commands/ruok" {
+					tt.handler(w, r)
+					return
+				}
+				if tt.ruokDown {
+					w.WriteHeader(http.StatusServiceUnavailable)
+					return
+				}
+				_, _ = w.Write([]byte(`{"command":"ruok","error":null}`))
+			})
+
+			var server *httptest.Server
+			if tt.tls {
+				server = httptest.NewTLSServer(handler)
+				caFile := filepath.Join(t.TempDir(), "ca.pem")
+				ca := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+				require.NoError(t, os.WriteFile(caFile, ca, 0600))
+				cfg.AdminServer.TLSSetting = configtls.TLSClientSetting{
+					TLSSetting: configtls.TLSSetting{CAFile: caFile},
+				}
+			} else {
+				server = httptest.NewServer(handler)
+			}
+			defer server.Close()
+			cfg.AdminServer.Endpoint = server.URL + "/commands/"
+
+			z, err := newZookeeperMetricsScraper(receivertest.NewNopCreateSettings(), cfg)
+			require.NoError(t, err)
+
+			ctx := context.Background()
+			require.NoError(t, z.start(ctx, componenttest.NewNopHost()))
+			actualMetrics, err := z.scrape(ctx)
+			require.NoError(t, z.shutdown(ctx))
+
+			if tt.wantErr {
+				require.Error(t, err)
+				require.True(t, scrapererror.IsPartialScrapeError(err))
+				require.Equal(t, 1, actualMetrics.MetricCount())
+				ruok := actualMetrics.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0)
+				require.Equal(t, "zookeeper.ruok", ruok.Name())
+				require.Equal(t, tt.wantRuok, ruok.Gauge().DataPoints().At(0).IntValue())
+				return
+			}
+			require.NoError(t, err)
+
+			expectedMetrics, err := golden.ReadMetrics(filepath.Join("testdata", "scraper", "correctness-admin-server-v3.5.5.json"))
+			require.NoError(t, err)
+			require.NoError(t, comparetest.CompareMetrics(expectedMetrics, actualMetrics))
+		})
+	}
+}
+
+func TestNewZookeeperMetricsScraperAdminServerEndpoint(t *testing.T) {
+	tests := []struct {
+		name     string
+		endpoint string
+		wantErr  bool
+	}{
+		{name: "http", endpoint: "http://localhost:8080/commands"},
+		{name: "https", endpoint: "https://localhost:8080/commands"},
+		{name: "empty", wantErr: true},
+		{name: "missing scheme", endpoint: "localhost:8080", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			cfg.AdminServer = &confighttp.HTTPClientSettings{Endpoint: tt.endpoint}
+			_, err := newZookeeperMetricsScraper(receivertest.NewNopCreateSettings(), cfg)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
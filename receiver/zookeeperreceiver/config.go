@@ -17,6 +17,7 @@ package zookeeperreceiver // import "github.com/open-telemetry/opentelemetry-col
 import (
 	"time"
 
+	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/config/confignet"
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
 
@@ -30,4 +31,9 @@ type Config struct {
 
 	// Timeout within which requests should be completed.
 	Timeout time.Duration `mapstructure:"timeout"`
+
+	// AdminServer, when set, makes the receiver collect metrics from the `monitor` command
+	// of the ZooKeeper AdminServer HTTP API instead of the `mntr` four letter word command.
+	// The endpoint is the URL under which the AdminServer commands are served.
+	AdminServer *confighttp.HTTPClientSettings `mapstructure:"admin_server"`
 }
@@ -72,6 +72,7 @@ func createMetricsReceiver(
 	scrp, err := scraperhelper.NewScraper(
 		typeStr,
 		zms.scrape,
+		scraperhelper.WithStart(zms.start),
 		scraperhelper.WithShutdown(zms.shutdown),
 	)
 	if err != nil {
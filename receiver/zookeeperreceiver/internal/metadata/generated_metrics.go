@@ -45,6 +45,7 @@ type MetricsSettings struct {
 	ZookeeperLatencyMin                  MetricSettings `mapstructure:"zookeeper.latency.min"`
 	ZookeeperPacketCount                 MetricSettings `mapstructure:"zookeeper.packet.count"`
 	ZookeeperRequestActive               MetricSettings `mapstructure:"zookeeper.request.active"`
+	ZookeeperRuok                        MetricSettings `mapstructure:"zookeeper.ruok"`
 	ZookeeperSyncPending                 MetricSettings `mapstructure:"zookeeper.sync.pending"`
 	ZookeeperWatchCount                  MetricSettings `mapstructure:"zookeeper.watch.count"`
 	ZookeeperZnodeCount                  MetricSettings `mapstructure:"zookeeper.znode.count"`
@@ -88,6 +89,9 @@ func DefaultMetricsSettings() MetricsSettings {
 		ZookeeperRequestActive: MetricSettings{
 			Enabled: true,
 		},
+		ZookeeperRuok: MetricSettings{
+			Enabled: true,
+		},
 		ZookeeperSyncPending: MetricSettings{
 			Enabled: true,
 		},
@@ -796,6 +800,55 @@ func newMetricZookeeperRequestActive(settings MetricSettings) metricZookeeperReq
 	return m
 }
 
+type metricZookeeperRuok struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills zookeeper.ruok metric with initial data.
+func (m *metricZookeeperRuok) init() {
+	m.data.SetName("zookeeper.ruok")
+	m.data.SetDescription("Response from the ruok command of the AdminServer, 1 when the server is running and 0 otherwise.")
+	m.data.SetUnit("1")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricZookeeperRuok) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricZookeeperRuok) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricZookeeperRuok) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricZookeeperRuok(settings MetricSettings) metricZookeeperRuok {
+	m := metricZookeeperRuok{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricZookeeperSyncPending struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
@@ -970,6 +1023,7 @@ type MetricsBuilder struct {
 	metricZookeeperLatencyMin                  metricZookeeperLatencyMin
 	metricZookeeperPacketCount                 metricZookeeperPacketCount
 	metricZookeeperRequestActive               metricZookeeperRequestActive
+	metricZookeeperRuok                        metricZookeeperRuok
 	metricZookeeperSyncPending                 metricZookeeperSyncPending
 	metricZookeeperWatchCount                  metricZookeeperWatchCount
 	metricZookeeperZnodeCount                  metricZookeeperZnodeCount
@@ -1010,6 +1064,7 @@ func NewMetricsBuilder(ms MetricsSettings, settings receiver.CreateSettings, opt
 		metricZookeeperLatencyMin:                  newMetricZookeeperLatencyMin(ms.ZookeeperLatencyMin),
 		metricZookeeperPacketCount:                 newMetricZookeeperPacketCount(ms.ZookeeperPacketCount),
 		metricZookeeperRequestActive:               newMetricZookeeperRequestActive(ms.ZookeeperRequestActive),
+		metricZookeeperRuok:                        newMetricZookeeperRuok(ms.ZookeeperRuok),
 		metricZookeeperSyncPending:                 newMetricZookeeperSyncPending(ms.ZookeeperSyncPending),
 		metricZookeeperWatchCount:                  newMetricZookeeperWatchCount(ms.ZookeeperWatchCount),
 		metricZookeeperZnodeCount:                  newMetricZookeeperZnodeCount(ms.ZookeeperZnodeCount),
@@ -1095,6 +1150,7 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	mb.metricZookeeperLatencyMin.emit(ils.Metrics())
 	mb.metricZookeeperPacketCount.emit(ils.Metrics())
 	mb.metricZookeeperRequestActive.emit(ils.Metrics())
+	mb.metricZookeeperRuok.emit(ils.Metrics())
 	mb.metricZookeeperSyncPending.emit(ils.Metrics())
 	mb.metricZookeeperWatchCount.emit(ils.Metrics())
 	mb.metricZookeeperZnodeCount.emit(ils.Metrics())
@@ -1178,6 +1234,11 @@ func (mb *MetricsBuilder) RecordZookeeperRequestActiveDataPoint(ts pcommon.Times
 	mb.metricZookeeperRequestActive.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordZookeeperRuokDataPoint adds a data point to zookeeper.ruok metric.
+func (mb *MetricsBuilder) RecordZookeeperRuokDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricZookeeperRuok.recordDataPoint(mb.startTime, ts, val)
+}
+
 // RecordZookeeperSyncPendingDataPoint adds a data point to zookeeper.sync.pending metric.
 func (mb *MetricsBuilder) RecordZookeeperSyncPendingDataPoint(ts pcommon.Timestamp, val int64) {
 	mb.metricZookeeperSyncPending.recordDataPoint(mb.startTime, ts, val)
@@ -20,10 +20,12 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"net/http"
 	"regexp"
 	"strconv"
 	"time"
 
+	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver"
@@ -39,10 +41,14 @@ const (
 )
 
 type zookeeperMetricsScraper struct {
-	logger *zap.Logger
-	config *Config
-	cancel context.CancelFunc
-	mb     *metadata.MetricsBuilder
+	logger   *zap.Logger
+	settings component.TelemetrySettings
+	config   *Config
+	cancel   context.CancelFunc
+	mb       *metadata.MetricsBuilder
+
+	// httpClient is only set when metrics are collected from the AdminServer.
+	httpClient *http.Client
 
 	// For mocking.
 	closeConnection       func(net.Conn) error
@@ -55,8 +61,11 @@ func (z *zookeeperMetricsScraper) Name() string {
 }
 
 func newZookeeperMetricsScraper(settings receiver.CreateSettings, config *Config) (*zookeeperMetricsScraper, error) {
-	_, _, err := net.SplitHostPort(config.TCPAddr.Endpoint)
-	if err != nil {
+	if config.AdminServer != nil {
+		if err := validateAdminServerEndpoint(config.AdminServer.Endpoint); err != nil {
+			return nil, err
+		}
+	} else if _, _, err := net.SplitHostPort(config.TCPAddr.Endpoint); err != nil {
 		return nil, err
 	}
 
@@ -66,6 +75,7 @@ func newZookeeperMetricsScraper(settings receiver.CreateSettings, config *Config
 
 	z := &zookeeperMetricsScraper{
 		logger:                settings.Logger,
+		settings:              settings.TelemetrySettings,
 		config:                config,
 		mb:                    metadata.NewMetricsBuilder(config.Metrics, settings),
 		closeConnection:       closeConnection,
@@ -76,6 +86,18 @@ func newZookeeperMetricsScraper(settings receiver.CreateSettings, config *Config
 	return z, nil
 }
 
+func (z *zookeeperMetricsScraper) start(_ context.Context, host component.Host) error {
+	if z.config.AdminServer == nil {
+		return nil
+	}
+	httpClient, err := z.config.AdminServer.ToClient(host, z.settings)
+	if err != nil {
+		return err
+	}
+	z.httpClient = httpClient
+	return nil
+}
+
 func (z *zookeeperMetricsScraper) shutdown(_ context.Context) error {
 	if z.cancel != nil {
 		z.cancel()
@@ -88,6 +110,10 @@ func (z *zookeeperMetricsScraper) scrape(ctx context.Context) (pmetric.Metrics,
 	var ctxWithTimeout context.Context
 	ctxWithTimeout, z.cancel = context.WithTimeout(ctx, z.config.Timeout)
 
+	if z.httpClient != nil {
+		return z.getAdminServerMetrics(ctxWithTimeout)
+	}
+
 	conn, err := z.config.Dial()
 	if err != nil {
 		z.logger.Error("failed to establish connection",
@@ -136,32 +162,7 @@ func (z *zookeeperMetricsScraper) getResourceMetrics(conn net.Conn) (pmetric.Met
 			continue
 		}
 
-		metricKey := parts[1]
-		metricValue := parts[2]
-		switch metricKey {
-		case zkVersionKey:
-			resourceOpts = append(resourceOpts, metadata.WithZkVersion(metricValue))
-			continue
-		case serverStateKey:
-			resourceOpts = append(resourceOpts, metadata.WithServerState(metricValue))
-			continue
-		default:
-			// Skip metric if there is no descriptor associated with it.
-			recordDataPoints := creator.recordDataPointsFunc(metricKey)
-			if recordDataPoints == nil {
-				// Unexported metric, just move to the next line.
-				continue
-			}
-			int64Val, err := strconv.ParseInt(metricValue, 10, 64)
-			if err != nil {
-				z.logger.Debug(
-					fmt.Sprintf("non-integer value from %s", mntrCommand),
-					zap.String("value", metricValue),
-				)
-				continue
-			}
-			recordDataPoints(now, int64Val)
-		}
+		resourceOpts = z.recordValue(creator, now, mntrCommand, parts[1], parts[2], resourceOpts)
 	}
 
 	// Generate computed metrics
@@ -170,6 +171,41 @@ func (z *zookeeperMetricsScraper) getResourceMetrics(conn net.Conn) (pmetric.Met
 	return z.mb.Emit(resourceOpts...), nil
 }
 
+// recordValue records the value of a key reported by ZooKeeper, returning the resource
+// options extended with the key if it describes the server rather than a metric.
+func (z *zookeeperMetricsScraper) recordValue(
+	creator *metricCreator,
+	now pcommon.Timestamp,
+	command string,
+	metricKey string,
+	metricValue string,
+	resourceOpts []metadata.ResourceMetricsOption,
+) []metadata.ResourceMetricsOption {
+	switch metricKey {
+	case zkVersionKey:
+		return append(resourceOpts, metadata.WithZkVersion(metricValue))
+	case serverStateKey:
+		return append(resourceOpts, metadata.WithServerState(metricValue))
+	}
+
+	// Skip metric if there is no descriptor associated with it.
+	recordDataPoints := creator.recordDataPointsFunc(metricKey)
+	if recordDataPoints == nil {
+		// Unexported metric.
+		return resourceOpts
+	}
+	int64Val, err := strconv.ParseInt(metricValue, 10, 64)
+	if err != nil {
+		z.logger.Debug(
+			fmt.Sprintf("non-integer value from %s", command),
+			zap.String("value", metricValue),
+		)
+		return resourceOpts
+	}
+	recordDataPoints(now, int64Val)
+	return resourceOpts
+}
+
 func closeConnection(conn net.Conn) error {
 	return conn.Close()
 }